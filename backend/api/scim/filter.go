@@ -0,0 +1,231 @@
+package scim
+
+import (
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/pkg/errors"
+)
+
+// filter is a parsed SCIM filter.
+//
+// We support the attribute expressions joined by "and" and "or", where "and" takes precedence.
+// Grouping with parentheses, "not" and value paths such as emails[type eq "work"] are not supported.
+//
+// Docs: https://datatracker.ietf.org/doc/html/rfc7644#section-3.4.2.2.
+type filter struct {
+	// or is the disjunction of the conjunctions.
+	or [][]*attributeExpression
+}
+
+type attributeExpression struct {
+	// attribute is the lower-cased attribute path, e.g. "username", "emails.value".
+	attribute string
+	operator  string
+	// value is the comparison value. It's a string, bool or nil.
+	value any
+}
+
+var supportedOperators = map[string]bool{
+	"eq": true,
+	"ne": true,
+	"co": true,
+	"sw": true,
+	"ew": true,
+	"pr": true,
+}
+
+// parseFilter parses the SCIM filter. An empty filter matches all resources.
+func parseFilter(s string) (*filter, error) {
+	tokens, err := tokenizeFilter(s)
+	if err != nil {
+		return nil, err
+	}
+	f := &filter{}
+	if len(tokens) == 0 {
+		return f, nil
+	}
+
+	var and []*attributeExpression
+	for i := 0; ; {
+		if i+1 >= len(tokens) {
+			return nil, errors.Errorf("incomplete expression in filter %q", s)
+		}
+		expression := &attributeExpression{
+			attribute: strings.ToLower(tokens[i].text),
+			operator:  strings.ToLower(tokens[i+1].text),
+		}
+		if tokens[i].quoted || tokens[i+1].quoted {
+			return nil, errors.Errorf("invalid expression in filter %q", s)
+		}
+		if !supportedOperators[expression.operator] {
+			return nil, errors.Errorf("unsupported operator %q", tokens[i+1].text)
+		}
+		i += 2
+		if expression.operator != "pr" {
+			if i >= len(tokens) {
+				return nil, errors.Errorf("missing value of %q in filter %q", expression.attribute, s)
+			}
+			value, err := parseFilterValue(tokens[i])
+			if err != nil {
+				return nil, err
+			}
+			expression.value = value
+			i++
+		}
+		and = append(and, expression)
+
+		if i == len(tokens) {
+			f.or = append(f.or, and)
+			return f, nil
+		}
+		if tokens[i].quoted {
+			return nil, errors.Errorf("unexpected value %q in filter %q", tokens[i].text, s)
+		}
+		switch strings.ToLower(tokens[i].text) {
+		case "and":
+		case "or":
+			f.or = append(f.or, and)
+			and = nil
+		default:
+			return nil, errors.Errorf("unsupported logical operator %q", tokens[i].text)
+		}
+		i++
+	}
+}
+
+type filterToken struct {
+	text string
+	// quoted is true for the string values.
+	quoted bool
+}
+
+func tokenizeFilter(s string) ([]filterToken, error) {
+	var tokens []filterToken
+	runes := []rune(s)
+	for i := 0; i < len(runes); {
+		r := runes[i]
+		switch {
+		case unicode.IsSpace(r):
+			i++
+		case r == '"':
+			// The string value is a JSON string.
+			j := i + 1
+			for ; j < len(runes) && runes[j] != '"'; j++ {
+				if runes[j] == '\\' {
+					j++
+				}
+			}
+			if j >= len(runes) {
+				return nil, errors.Errorf("unterminated string in filter %q", s)
+			}
+			text, err := strconv.Unquote(string(runes[i : j+1]))
+			if err != nil {
+				return nil, errors.Wrapf(err, "invalid string in filter %q", s)
+			}
+			tokens = append(tokens, filterToken{text: text, quoted: true})
+			i = j + 1
+		case r == '(' || r == ')' || r == '[' || r == ']':
+			return nil, errors.Errorf("grouping is not supported in filter %q", s)
+		default:
+			j := i
+			for j < len(runes) && !unicode.IsSpace(runes[j]) && runes[j] != '"' {
+				j++
+			}
+			tokens = append(tokens, filterToken{text: string(runes[i:j])})
+			i = j
+		}
+	}
+	return tokens, nil
+}
+
+func parseFilterValue(token filterToken) (any, error) {
+	if token.quoted {
+		return token.text, nil
+	}
+	switch token.text {
+	case "true":
+		return true, nil
+	case "false":
+		return false, nil
+	case "null":
+		return nil, nil
+	}
+	return nil, errors.Errorf("unsupported value %q", token.text)
+}
+
+// match returns whether the attributes match the filter. The attributes are keyed by the lower-cased path,
+// and the multi-valued attribute matches if any of its values matches.
+// The strings are compared case-insensitively.
+func (f *filter) match(attributes map[string][]any) bool {
+	if len(f.or) == 0 {
+		return true
+	}
+	for _, and := range f.or {
+		matched := true
+		for _, expression := range and {
+			if !expression.match(attributes[expression.attribute]) {
+				matched = false
+				break
+			}
+		}
+		if matched {
+			return true
+		}
+	}
+	return false
+}
+
+func (e *attributeExpression) match(values []any) bool {
+	if e.operator == "pr" {
+		return len(values) > 0
+	}
+	if e.operator == "ne" {
+		for _, value := range values {
+			if equalValue(value, e.value) {
+				return false
+			}
+		}
+		return true
+	}
+	for _, value := range values {
+		if e.matchValue(value) {
+			return true
+		}
+	}
+	// The absent attribute equals null.
+	return len(values) == 0 && e.operator == "eq" && e.value == nil
+}
+
+func (e *attributeExpression) matchValue(value any) bool {
+	if e.operator == "eq" {
+		return equalValue(value, e.value)
+	}
+	s, ok := value.(string)
+	if !ok {
+		return false
+	}
+	t, ok := e.value.(string)
+	if !ok {
+		return false
+	}
+	s, t = strings.ToLower(s), strings.ToLower(t)
+	switch e.operator {
+	case "co":
+		return strings.Contains(s, t)
+	case "sw":
+		return strings.HasPrefix(s, t)
+	case "ew":
+		return strings.HasSuffix(s, t)
+	}
+	return false
+}
+
+func equalValue(a, b any) bool {
+	if s, ok := a.(string); ok {
+		t, ok := b.(string)
+		return ok && strings.EqualFold(s, t)
+	}
+	return a == b
+}
@@ -0,0 +1,66 @@
+package scim
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFilter(t *testing.T) {
+	attributes := map[string][]any{
+		"username":     {"Alice@Example.com"},
+		"emails.value": {"alice@example.com", "alice@home.com"},
+		"displayname":  {"Alice"},
+		"active":       {true},
+	}
+	tests := []struct {
+		filter string
+		want   bool
+	}{
+		{filter: "", want: true},
+		{filter: `userName eq "alice@example.com"`, want: true},
+		{filter: `USERNAME EQ "alice@example.com"`, want: true},
+		{filter: `userName eq "bob@example.com"`, want: false},
+		{filter: `userName ne "bob@example.com"`, want: true},
+		{filter: `emails.value eq "alice@home.com"`, want: true},
+		{filter: `emails.value ne "alice@home.com"`, want: false},
+		{filter: `displayName co "lic"`, want: true},
+		{filter: `displayName sw "Al"`, want: true},
+		{filter: `displayName ew "ce"`, want: true},
+		{filter: `displayName sw "ce"`, want: false},
+		{filter: `active eq true`, want: true},
+		{filter: `active eq false`, want: false},
+		{filter: `externalId pr`, want: false},
+		{filter: `externalId eq null`, want: true},
+		{filter: `active eq true and displayName eq "Bob"`, want: false},
+		{filter: `displayName eq "Bob" or userName eq "alice@example.com"`, want: true},
+		{filter: `displayName eq "Bob" or active eq true and userName sw "alice"`, want: true},
+		{filter: `displayName eq "Bob" or active eq false and userName sw "alice"`, want: false},
+		{filter: `displayName eq "say \"hi\""`, want: false},
+	}
+	for _, test := range tests {
+		f, err := parseFilter(test.filter)
+		require.NoError(t, err, test.filter)
+		require.Equal(t, test.want, f.match(attributes), test.filter)
+	}
+}
+
+func TestParseFilterError(t *testing.T) {
+	tests := []string{
+		`userName`,
+		`userName eq`,
+		`userName gt "a"`,
+		`userName eq alice`,
+		`userName eq "alice`,
+		`userName eq "alice" and`,
+		`userName eq "alice" xor active eq true`,
+		`"userName" eq "alice"`,
+		`(userName eq "alice")`,
+		`emails[type eq "work"]`,
+		`not userName eq "alice"`,
+	}
+	for _, test := range tests {
+		_, err := parseFilter(test)
+		require.Error(t, err, test)
+	}
+}
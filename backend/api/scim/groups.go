@@ -0,0 +1,448 @@
+package scim
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+	"google.golang.org/genproto/googleapis/type/expr"
+
+	"github.com/bytebase/bytebase/backend/common/log"
+	"github.com/bytebase/bytebase/backend/component/activity"
+	api "github.com/bytebase/bytebase/backend/legacyapi"
+	"github.com/bytebase/bytebase/backend/store"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+// The SCIM groups are the groups of the group mappings in the SCIM setting.
+//
+// Each group mapping owns a binding of the project role, which is identified by the description of its condition.
+// The members of the group are the members of its owned bindings, so the groups can't be created or renamed
+// by the SCIM client. The other bindings are never touched, so the roles granted manually are kept.
+
+// group is the SCIM group resource.
+type group struct {
+	Schemas     []string  `json:"schemas"`
+	ID          string    `json:"id"`
+	DisplayName string    `json:"displayName"`
+	Members     []*member `json:"members"`
+	Meta        *meta     `json:"meta,omitempty"`
+}
+
+type member struct {
+	// Value is the user ID.
+	Value   string `json:"value"`
+	Display string `json:"display,omitempty"`
+}
+
+// memberPathRegexp matches the path of the member filter, e.g. members[value eq "101"].
+var memberPathRegexp = regexp.MustCompile(`(?i)^members\[\s*value\s+eq\s+"([^"]*)"\s*\]$`)
+
+// getGroupID returns the opaque ID of the group, which is stable as long as the group name is unchanged.
+func getGroupID(groupName string) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(groupName))
+}
+
+// getGroupBindingDescription returns the condition description of the binding owned by the group mapping.
+func getGroupBindingDescription(groupName string) string {
+	return fmt.Sprintf("SCIM group: %s", groupName)
+}
+
+func isGroupBinding(binding *store.PolicyBinding, groupName string) bool {
+	return binding.Condition != nil && binding.Condition.Expression == "" && binding.Condition.Description == getGroupBindingDescription(groupName)
+}
+
+func getGroupAttributes(g *group) map[string][]any {
+	attributes := map[string][]any{
+		"id":          {g.ID},
+		"displayname": {g.DisplayName},
+	}
+	for _, m := range g.Members {
+		attributes["members"] = append(attributes["members"], m.Value)
+		attributes["members.value"] = append(attributes["members.value"], m.Value)
+	}
+	return attributes
+}
+
+func (s *Service) listGroups(c echo.Context) error {
+	ctx := c.Request().Context()
+	setting, err := s.store.GetWorkspaceSCIMSetting(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to get SCIM setting")
+	}
+	var groups []*group
+	for _, groupName := range getGroupNames(setting) {
+		g, err := s.getGroupResource(ctx, setting, groupName)
+		if err != nil {
+			return err
+		}
+		groups = append(groups, g)
+	}
+	return listResources(c, groups, getGroupAttributes)
+}
+
+func (s *Service) getGroup(c echo.Context) error {
+	ctx := c.Request().Context()
+	setting, groupName, err := s.findGroup(c)
+	if err != nil {
+		return err
+	}
+	g, err := s.getGroupResource(ctx, setting, groupName)
+	if err != nil {
+		return err
+	}
+	return response(c, http.StatusOK, g)
+}
+
+// createGroup sets the members of the mapped group, since the groups are defined by the group mappings.
+func (s *Service) createGroup(c echo.Context) error {
+	ctx := c.Request().Context()
+	var request group
+	if err := decodeRequest(c, &request); err != nil {
+		return err
+	}
+	setting, err := s.store.GetWorkspaceSCIMSetting(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to get SCIM setting")
+	}
+	if !hasGroup(setting, request.DisplayName) {
+		return newError(http.StatusBadRequest, "invalidValue", "group %q isn't mapped to any project role in the SCIM setting", request.DisplayName)
+	}
+	users, err := s.getMemberUsers(ctx, request.Members)
+	if err != nil {
+		return err
+	}
+	if err := s.setGroupMembers(ctx, setting, request.DisplayName, users); err != nil {
+		return err
+	}
+	g, err := s.getGroupResource(ctx, setting, request.DisplayName)
+	if err != nil {
+		return err
+	}
+	return response(c, http.StatusCreated, g)
+}
+
+func (s *Service) replaceGroup(c echo.Context) error {
+	ctx := c.Request().Context()
+	setting, groupName, err := s.findGroup(c)
+	if err != nil {
+		return err
+	}
+	var request group
+	if err := decodeRequest(c, &request); err != nil {
+		return err
+	}
+	if request.DisplayName != "" && request.DisplayName != groupName {
+		return newError(http.StatusBadRequest, "mutability", "the displayName of group %q can't be changed", groupName)
+	}
+	users, err := s.getMemberUsers(ctx, request.Members)
+	if err != nil {
+		return err
+	}
+	if err := s.setGroupMembers(ctx, setting, groupName, users); err != nil {
+		return err
+	}
+	g, err := s.getGroupResource(ctx, setting, groupName)
+	if err != nil {
+		return err
+	}
+	return response(c, http.StatusOK, g)
+}
+
+func (s *Service) patchGroup(c echo.Context) error {
+	ctx := c.Request().Context()
+	setting, groupName, err := s.findGroup(c)
+	if err != nil {
+		return err
+	}
+	var request patchRequest
+	if err := decodeRequest(c, &request); err != nil {
+		return err
+	}
+	g, err := s.getGroupResource(ctx, setting, groupName)
+	if err != nil {
+		return err
+	}
+	memberIDs := make(map[string]bool)
+	for _, m := range g.Members {
+		memberIDs[m.Value] = true
+	}
+	for _, operation := range request.Operations {
+		if err := applyGroupPatch(groupName, memberIDs, operation); err != nil {
+			return err
+		}
+	}
+
+	var members []*member
+	for id := range memberIDs {
+		members = append(members, &member{Value: id})
+	}
+	users, err := s.getMemberUsers(ctx, members)
+	if err != nil {
+		return err
+	}
+	if err := s.setGroupMembers(ctx, setting, groupName, users); err != nil {
+		return err
+	}
+	if g, err = s.getGroupResource(ctx, setting, groupName); err != nil {
+		return err
+	}
+	return response(c, http.StatusOK, g)
+}
+
+// applyGroupPatch applies the patch operation to the member IDs of the group.
+func applyGroupPatch(groupName string, memberIDs map[string]bool, operation *patchOperation) error {
+	op := strings.ToLower(operation.Op)
+	path := operation.Path
+	value := operation.Value
+	if path == "" {
+		// The value is the object of the attributes without the path.
+		var values map[string]json.RawMessage
+		if err := json.Unmarshal(value, &values); err != nil {
+			return newError(http.StatusBadRequest, "invalidValue", "the value must be an object without the path")
+		}
+		for k, v := range values {
+			if err := applyGroupPatch(groupName, memberIDs, &patchOperation{Op: operation.Op, Path: k, Value: v}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	if matches := memberPathRegexp.FindStringSubmatch(path); matches != nil {
+		if op != "remove" {
+			return newError(http.StatusBadRequest, "invalidPath", "unsupported operation %q on %q", operation.Op, path)
+		}
+		delete(memberIDs, matches[1])
+		return nil
+	}
+	switch strings.ToLower(path) {
+	case "displayname":
+		var displayName string
+		if err := json.Unmarshal(value, &displayName); err != nil || displayName != groupName {
+			return newError(http.StatusBadRequest, "mutability", "the displayName of group %q can't be changed", groupName)
+		}
+		return nil
+	case "members":
+	default:
+		return newError(http.StatusBadRequest, "invalidPath", "unsupported path %q on group", path)
+	}
+
+	var members []*member
+	if len(value) > 0 {
+		if err := json.Unmarshal(value, &members); err != nil {
+			return newError(http.StatusBadRequest, "invalidValue", "invalid members: %v", err)
+		}
+	}
+	switch op {
+	case "add":
+		for _, m := range members {
+			memberIDs[m.Value] = true
+		}
+	case "remove":
+		// Remove all members if the value is absent.
+		if len(value) == 0 {
+			for id := range memberIDs {
+				delete(memberIDs, id)
+			}
+		}
+		for _, m := range members {
+			delete(memberIDs, m.Value)
+		}
+	case "replace":
+		for id := range memberIDs {
+			delete(memberIDs, id)
+		}
+		for _, m := range members {
+			memberIDs[m.Value] = true
+		}
+	default:
+		return newError(http.StatusBadRequest, "invalidValue", "unsupported operation %q on group", operation.Op)
+	}
+	return nil
+}
+
+// deleteGroup removes all members of the group. The group is kept as long as it's mapped in the SCIM setting.
+func (s *Service) deleteGroup(c echo.Context) error {
+	ctx := c.Request().Context()
+	setting, groupName, err := s.findGroup(c)
+	if err != nil {
+		return err
+	}
+	if err := s.setGroupMembers(ctx, setting, groupName, nil); err != nil {
+		return err
+	}
+	return c.NoContent(http.StatusNoContent)
+}
+
+// findGroup finds the mapped group of the id path parameter.
+func (s *Service) findGroup(c echo.Context) (*storepb.SCIMSetting, string, error) {
+	setting, err := s.store.GetWorkspaceSCIMSetting(c.Request().Context())
+	if err != nil {
+		return nil, "", errors.Wrap(err, "failed to get SCIM setting")
+	}
+	for _, groupName := range getGroupNames(setting) {
+		if getGroupID(groupName) == c.Param("id") {
+			return setting, groupName, nil
+		}
+	}
+	return nil, "", newError(http.StatusNotFound, "", "group %q not found", c.Param("id"))
+}
+
+// getGroupResource returns the group with the members of its owned bindings.
+func (s *Service) getGroupResource(ctx context.Context, setting *storepb.SCIMSetting, groupName string) (*group, error) {
+	users := make(map[int]*store.UserMessage)
+	for _, projectID := range getGroupProjects(setting, groupName) {
+		project, err := s.store.GetProjectV2(ctx, &store.FindProjectMessage{ResourceID: &projectID})
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to get project %q", projectID)
+		}
+		if project == nil || project.Deleted {
+			continue
+		}
+		policy, err := s.store.GetProjectPolicy(ctx, &store.GetProjectPolicyMessage{UID: &project.UID})
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to get policy of project %q", projectID)
+		}
+		for _, binding := range policy.Bindings {
+			if !isGroupBinding(binding, groupName) {
+				continue
+			}
+			for _, u := range binding.Members {
+				users[u.ID] = u
+			}
+		}
+	}
+
+	members := []*member{}
+	for _, u := range users {
+		members = append(members, &member{Value: strconv.Itoa(u.ID), Display: u.Email})
+	}
+	sort.Slice(members, func(i, j int) bool {
+		return members[i].Value < members[j].Value
+	})
+	return &group{
+		Schemas:     []string{groupSchema},
+		ID:          getGroupID(groupName),
+		DisplayName: groupName,
+		Members:     members,
+		Meta:        &meta{ResourceType: "Group"},
+	}, nil
+}
+
+// getMemberUsers returns the end users of the members.
+func (s *Service) getMemberUsers(ctx context.Context, members []*member) ([]*store.UserMessage, error) {
+	var users []*store.UserMessage
+	for _, m := range members {
+		id, err := strconv.Atoi(m.Value)
+		if err != nil {
+			return nil, newError(http.StatusBadRequest, "invalidValue", "invalid member %q", m.Value)
+		}
+		u, err := s.store.GetUserByID(ctx, id)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to get user %d", id)
+		}
+		if u == nil || u.Type != api.EndUser {
+			return nil, newError(http.StatusBadRequest, "invalidValue", "member %q not found", m.Value)
+		}
+		users = append(users, u)
+	}
+	return users, nil
+}
+
+// setGroupMembers replaces the members of the bindings owned by the group in the mapped projects.
+func (s *Service) setGroupMembers(ctx context.Context, setting *storepb.SCIMSetting, groupName string, users []*store.UserMessage) error {
+	for _, projectID := range getGroupProjects(setting, groupName) {
+		project, err := s.store.GetProjectV2(ctx, &store.FindProjectMessage{ResourceID: &projectID})
+		if err != nil {
+			return errors.Wrapf(err, "failed to get project %q", projectID)
+		}
+		if project == nil || project.Deleted {
+			log.Warn("Skip the nonexistent project of the SCIM group mapping", zap.String("group", groupName), zap.String("project", projectID))
+			continue
+		}
+		policy, err := s.store.GetProjectPolicy(ctx, &store.GetProjectPolicyMessage{UID: &project.UID})
+		if err != nil {
+			return errors.Wrapf(err, "failed to get policy of project %q", projectID)
+		}
+
+		var bindings []*store.PolicyBinding
+		for _, binding := range policy.Bindings {
+			if !isGroupBinding(binding, groupName) {
+				bindings = append(bindings, binding)
+			}
+		}
+		var roles []string
+		for _, groupMapping := range setting.GroupMappings {
+			if groupMapping.Group != groupName || groupMapping.Project != projectID {
+				continue
+			}
+			roles = append(roles, groupMapping.Role)
+			if len(users) > 0 {
+				bindings = append(bindings, &store.PolicyBinding{
+					Role:      api.Role(groupMapping.Role),
+					Members:   users,
+					Condition: &expr.Expr{Description: getGroupBindingDescription(groupName)},
+				})
+			}
+		}
+
+		if _, err := s.store.SetProjectIAMPolicy(ctx, &store.IAMPolicyMessage{Bindings: bindings}, api.SystemBotID, project.UID); err != nil {
+			return errors.Wrapf(err, "failed to set policy of project %q", projectID)
+		}
+		if _, err := s.activityManager.CreateActivity(ctx, &store.ActivityMessage{
+			CreatorUID:   api.SystemBotID,
+			ContainerUID: project.UID,
+			Type:         api.ActivityProjectMemberCreate,
+			Level:        api.ActivityInfo,
+			Comment:      fmt.Sprintf("Synced %d member(s) of SCIM group %q as %s.", len(users), groupName, strings.Join(roles, ", ")),
+		}, &activity.Metadata{}); err != nil {
+			log.Warn("Failed to create project activity", zap.Error(err))
+		}
+	}
+	return nil
+}
+
+// getGroupNames returns the distinct group names of the group mappings.
+func getGroupNames(setting *storepb.SCIMSetting) []string {
+	var groupNames []string
+	seen := make(map[string]bool)
+	for _, groupMapping := range setting.GroupMappings {
+		if !seen[groupMapping.Group] {
+			seen[groupMapping.Group] = true
+			groupNames = append(groupNames, groupMapping.Group)
+		}
+	}
+	return groupNames
+}
+
+func hasGroup(setting *storepb.SCIMSetting, groupName string) bool {
+	for _, groupMapping := range setting.GroupMappings {
+		if groupMapping.Group == groupName {
+			return true
+		}
+	}
+	return false
+}
+
+// getGroupProjects returns the distinct projects mapped by the group.
+func getGroupProjects(setting *storepb.SCIMSetting, groupName string) []string {
+	var projectIDs []string
+	seen := make(map[string]bool)
+	for _, groupMapping := range setting.GroupMappings {
+		if groupMapping.Group == groupName && !seen[groupMapping.Project] {
+			seen[groupMapping.Project] = true
+			projectIDs = append(projectIDs, groupMapping.Project)
+		}
+	}
+	return projectIDs
+}
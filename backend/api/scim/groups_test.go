@@ -0,0 +1,74 @@
+package scim
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestApplyGroupPatch(t *testing.T) {
+	tests := []struct {
+		operations string
+		want       []string
+		wantErr    bool
+	}{
+		{
+			operations: `[{"op": "add", "path": "members", "value": [{"value": "103"}, {"value": "104"}]}]`,
+			want:       []string{"101", "102", "103", "104"},
+		},
+		{
+			operations: `[{"op": "Remove", "path": "members[value eq \"101\"]"}]`,
+			want:       []string{"102"},
+		},
+		{
+			operations: `[{"op": "remove", "path": "members", "value": [{"value": "102"}]}]`,
+			want:       []string{"101"},
+		},
+		{
+			operations: `[{"op": "remove", "path": "members"}]`,
+			want:       []string{},
+		},
+		{
+			operations: `[{"op": "replace", "path": "members", "value": [{"value": "105"}]}]`,
+			want:       []string{"105"},
+		},
+		{
+			operations: `[{"op": "replace", "value": {"displayName": "dba", "members": [{"value": "105"}]}}]`,
+			want:       []string{"105"},
+		},
+		{
+			operations: `[{"op": "replace", "path": "displayName", "value": "developer"}]`,
+			wantErr:    true,
+		},
+		{
+			operations: `[{"op": "add", "path": "members[value eq \"103\"]"}]`,
+			wantErr:    true,
+		},
+		{
+			operations: `[{"op": "add", "path": "externalId", "value": "abc"}]`,
+			wantErr:    true,
+		},
+	}
+	for _, test := range tests {
+		var operations []*patchOperation
+		require.NoError(t, json.Unmarshal([]byte(test.operations), &operations))
+		memberIDs := map[string]bool{"101": true, "102": true}
+		var err error
+		for _, operation := range operations {
+			if err = applyGroupPatch("dba", memberIDs, operation); err != nil {
+				break
+			}
+		}
+		if test.wantErr {
+			require.Error(t, err, test.operations)
+			continue
+		}
+		require.NoError(t, err, test.operations)
+		got := []string{}
+		for id := range memberIDs {
+			got = append(got, id)
+		}
+		require.ElementsMatch(t, test.want, got, test.operations)
+	}
+}
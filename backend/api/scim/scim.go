@@ -0,0 +1,263 @@
+// Package scim is the SCIM 2.0 service provisioning the users and the group memberships
+// from the identity providers.
+//
+// Docs: https://datatracker.ietf.org/doc/html/rfc7644.
+package scim
+
+import (
+	"crypto/subtle"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+
+	"github.com/bytebase/bytebase/backend/common/log"
+	"github.com/bytebase/bytebase/backend/component/activity"
+	enterpriseAPI "github.com/bytebase/bytebase/backend/enterprise/api"
+	api "github.com/bytebase/bytebase/backend/legacyapi"
+	"github.com/bytebase/bytebase/backend/store"
+)
+
+const (
+	// Prefix is the path prefix of the SCIM service.
+	Prefix = "/scim/v2"
+
+	contentType = "application/scim+json"
+
+	userSchema          = "urn:ietf:params:scim:schemas:core:2.0:User"
+	groupSchema         = "urn:ietf:params:scim:schemas:core:2.0:Group"
+	listResponseSchema  = "urn:ietf:params:scim:api:messages:2.0:ListResponse"
+	errorSchema         = "urn:ietf:params:scim:api:messages:2.0:Error"
+	serviceConfigSchema = "urn:ietf:params:scim:schemas:core:2.0:ServiceProviderConfig"
+
+	// maxResults is the maximum number of resources returned in a list response.
+	maxResults = 1000
+)
+
+// Service is the SCIM service.
+type Service struct {
+	store           *store.Store
+	activityManager *activity.Manager
+	licenseService  enterpriseAPI.LicenseService
+}
+
+// NewService creates a new SCIM service.
+func NewService(store *store.Store, activityManager *activity.Manager, licenseService enterpriseAPI.LicenseService) *Service {
+	return &Service{
+		store:           store,
+		activityManager: activityManager,
+		licenseService:  licenseService,
+	}
+}
+
+// RegisterRoutes registers the SCIM endpoints, which are authenticated by the bearer token in the SCIM setting.
+func (s *Service) RegisterRoutes(g *echo.Group) {
+	g.Use(handleError, s.authenticate)
+
+	g.GET("/ServiceProviderConfig", s.getServiceProviderConfig)
+
+	g.GET("/Users", s.listUsers)
+	g.POST("/Users", s.createUser)
+	g.GET("/Users/:id", s.getUser)
+	g.PUT("/Users/:id", s.replaceUser)
+	g.PATCH("/Users/:id", s.patchUser)
+	g.DELETE("/Users/:id", s.deleteUser)
+
+	g.GET("/Groups", s.listGroups)
+	g.POST("/Groups", s.createGroup)
+	g.GET("/Groups/:id", s.getGroup)
+	g.PUT("/Groups/:id", s.replaceGroup)
+	g.PATCH("/Groups/:id", s.patchGroup)
+	g.DELETE("/Groups/:id", s.deleteGroup)
+}
+
+func (s *Service) authenticate(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		if !s.licenseService.IsFeatureEnabled(api.FeatureSSO) {
+			return newError(http.StatusForbidden, "", "%s", api.FeatureSSO.AccessErrorMessage())
+		}
+		setting, err := s.store.GetWorkspaceSCIMSetting(c.Request().Context())
+		if err != nil {
+			return errors.Wrap(err, "failed to get SCIM setting")
+		}
+		token, ok := strings.CutPrefix(c.Request().Header.Get(echo.HeaderAuthorization), "Bearer ")
+		if setting.Token == "" || !ok || subtle.ConstantTimeCompare([]byte(token), []byte(setting.Token)) != 1 {
+			return newError(http.StatusUnauthorized, "", "invalid bearer token")
+		}
+		return next(c)
+	}
+}
+
+func (*Service) getServiceProviderConfig(c echo.Context) error {
+	supported := func(v bool) map[string]any {
+		return map[string]any{"supported": v}
+	}
+	return response(c, http.StatusOK, map[string]any{
+		"schemas": []string{serviceConfigSchema},
+		"patch":   supported(true),
+		"bulk": map[string]any{
+			"supported":      false,
+			"maxOperations":  0,
+			"maxPayloadSize": 0,
+		},
+		"filter": map[string]any{
+			"supported":  true,
+			"maxResults": maxResults,
+		},
+		"changePassword": supported(false),
+		"sort":           supported(false),
+		"etag":           supported(false),
+		"authenticationSchemes": []map[string]any{
+			{
+				"type":        "oauthbearertoken",
+				"name":        "OAuth Bearer Token",
+				"description": "Authentication scheme using the bearer token in the SCIM setting",
+			},
+		},
+	})
+}
+
+// errorResponse is the SCIM error response.
+type errorResponse struct {
+	Schemas  []string `json:"schemas"`
+	Status   string   `json:"status"`
+	ScimType string   `json:"scimType,omitempty"`
+	Detail   string   `json:"detail"`
+}
+
+// listResponse is the SCIM list response.
+type listResponse struct {
+	Schemas      []string `json:"schemas"`
+	TotalResults int      `json:"totalResults"`
+	StartIndex   int      `json:"startIndex"`
+	ItemsPerPage int      `json:"itemsPerPage"`
+	Resources    []any    `json:"Resources"`
+}
+
+// patchRequest is the SCIM PATCH request.
+type patchRequest struct {
+	Schemas    []string          `json:"schemas"`
+	Operations []*patchOperation `json:"Operations"`
+}
+
+type patchOperation struct {
+	Op    string          `json:"op"`
+	Path  string          `json:"path"`
+	Value json.RawMessage `json:"value"`
+}
+
+type meta struct {
+	ResourceType string `json:"resourceType"`
+}
+
+// scimError is the error returned to the SCIM client.
+type scimError struct {
+	status   int
+	scimType string
+	detail   string
+}
+
+func (e *scimError) Error() string {
+	return e.detail
+}
+
+func newError(status int, scimType string, format string, args ...any) *scimError {
+	return &scimError{
+		status:   status,
+		scimType: scimType,
+		detail:   fmt.Sprintf(format, args...),
+	}
+}
+
+// handleError writes the SCIM error response. The errors other than scimError are internal errors.
+func handleError(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		err := next(c)
+		if err == nil {
+			return nil
+		}
+		var e *scimError
+		if !errors.As(err, &e) {
+			log.Error("Failed to handle SCIM request", zap.String("method", c.Request().Method), zap.String("path", c.Path()), zap.Error(err))
+			e = newError(http.StatusInternalServerError, "", "internal error")
+		}
+		return response(c, e.status, &errorResponse{
+			Schemas:  []string{errorSchema},
+			Status:   strconv.Itoa(e.status),
+			ScimType: e.scimType,
+			Detail:   e.detail,
+		})
+	}
+}
+
+func response(c echo.Context, status int, body any) error {
+	bytes, err := json.Marshal(body)
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal response")
+	}
+	return c.Blob(status, contentType, bytes)
+}
+
+func decodeRequest(c echo.Context, v any) error {
+	if err := json.NewDecoder(c.Request().Body).Decode(v); err != nil {
+		return newError(http.StatusBadRequest, "invalidSyntax", "failed to decode request: %v", err)
+	}
+	return nil
+}
+
+// listResources returns the list response of the resources matching the filter.
+// The resources are returned with their attributes used for filtering.
+func listResources[T any](c echo.Context, resources []T, getAttributes func(T) map[string][]any) error {
+	f, err := parseFilter(c.QueryParam("filter"))
+	if err != nil {
+		return newError(http.StatusBadRequest, "invalidFilter", "%s", err.Error())
+	}
+	var matched []any
+	for _, resource := range resources {
+		if f.match(getAttributes(resource)) {
+			matched = append(matched, resource)
+		}
+	}
+
+	// The startIndex is 1-based.
+	startIndex, count := 1, maxResults
+	if v := c.QueryParam("startIndex"); v != "" {
+		if startIndex, err = strconv.Atoi(v); err != nil {
+			return newError(http.StatusBadRequest, "invalidValue", "invalid startIndex %q", v)
+		}
+		if startIndex < 1 {
+			startIndex = 1
+		}
+	}
+	if v := c.QueryParam("count"); v != "" {
+		if count, err = strconv.Atoi(v); err != nil {
+			return newError(http.StatusBadRequest, "invalidValue", "invalid count %q", v)
+		}
+		if count < 0 {
+			count = 0
+		}
+		if count > maxResults {
+			count = maxResults
+		}
+	}
+	page := []any{}
+	if startIndex <= len(matched) {
+		end := startIndex - 1 + count
+		if end > len(matched) {
+			end = len(matched)
+		}
+		page = matched[startIndex-1 : end]
+	}
+	return response(c, http.StatusOK, &listResponse{
+		Schemas:      []string{listResponseSchema},
+		TotalResults: len(matched),
+		StartIndex:   startIndex,
+		ItemsPerPage: len(page),
+		Resources:    page,
+	})
+}
@@ -0,0 +1,380 @@
+package scim
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/mail"
+	"strconv"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+	"github.com/pkg/errors"
+	"golang.org/x/crypto/bcrypt"
+
+	"github.com/bytebase/bytebase/backend/common"
+	api "github.com/bytebase/bytebase/backend/legacyapi"
+	"github.com/bytebase/bytebase/backend/store"
+)
+
+// user is the SCIM user resource. The userName is the email of the user.
+type user struct {
+	Schemas     []string `json:"schemas"`
+	ID          string   `json:"id"`
+	UserName    string   `json:"userName"`
+	Name        *name    `json:"name,omitempty"`
+	DisplayName string   `json:"displayName"`
+	Emails      []*email `json:"emails,omitempty"`
+	// Active is a pointer so that the absent value is distinguished from false in the requests.
+	Active *bool `json:"active,omitempty"`
+	Meta   *meta `json:"meta,omitempty"`
+}
+
+type name struct {
+	Formatted  string `json:"formatted,omitempty"`
+	GivenName  string `json:"givenName,omitempty"`
+	FamilyName string `json:"familyName,omitempty"`
+}
+
+type email struct {
+	Value   string `json:"value"`
+	Type    string `json:"type,omitempty"`
+	Primary bool   `json:"primary,omitempty"`
+}
+
+// getEmail returns the email of the user, which is the userName or the primary email.
+func (u *user) getEmail() string {
+	if _, err := mail.ParseAddress(u.UserName); err == nil {
+		return strings.ToLower(u.UserName)
+	}
+	for _, email := range u.Emails {
+		if email.Primary {
+			return strings.ToLower(email.Value)
+		}
+	}
+	if len(u.Emails) > 0 {
+		return strings.ToLower(u.Emails[0].Value)
+	}
+	return strings.ToLower(u.UserName)
+}
+
+// getDisplayName returns the display name of the user, which falls back to the name and the email.
+func (u *user) getDisplayName() string {
+	if u.DisplayName != "" {
+		return u.DisplayName
+	}
+	if u.Name != nil {
+		if u.Name.Formatted != "" {
+			return u.Name.Formatted
+		}
+		if v := strings.TrimSpace(fmt.Sprintf("%s %s", u.Name.GivenName, u.Name.FamilyName)); v != "" {
+			return v
+		}
+	}
+	return u.getEmail()
+}
+
+func convertToUser(u *store.UserMessage) *user {
+	active := !u.MemberDeleted
+	return &user{
+		Schemas:     []string{userSchema},
+		ID:          strconv.Itoa(u.ID),
+		UserName:    u.Email,
+		Name:        &name{Formatted: u.Name},
+		DisplayName: u.Name,
+		Emails:      []*email{{Value: u.Email, Type: "work", Primary: true}},
+		Active:      &active,
+		Meta:        &meta{ResourceType: "User"},
+	}
+}
+
+func getUserAttributes(u *user) map[string][]any {
+	return map[string][]any{
+		"id":             {u.ID},
+		"username":       {u.UserName},
+		"displayname":    {u.DisplayName},
+		"name.formatted": {u.Name.Formatted},
+		"emails":         {u.Emails[0].Value},
+		"emails.value":   {u.Emails[0].Value},
+		"active":         {*u.Active},
+	}
+}
+
+func (s *Service) listUsers(c echo.Context) error {
+	endUser := api.EndUser
+	users, err := s.store.ListUsers(c.Request().Context(), &store.FindUserMessage{
+		Type:        &endUser,
+		ShowDeleted: true,
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to list users")
+	}
+	var resources []*user
+	for _, u := range users {
+		resources = append(resources, convertToUser(u))
+	}
+	return listResources(c, resources, getUserAttributes)
+}
+
+func (s *Service) getUser(c echo.Context) error {
+	u, err := s.findUser(c)
+	if err != nil {
+		return err
+	}
+	return response(c, http.StatusOK, convertToUser(u))
+}
+
+func (s *Service) createUser(c echo.Context) error {
+	ctx := c.Request().Context()
+	var request user
+	if err := decodeRequest(c, &request); err != nil {
+		return err
+	}
+	email := request.getEmail()
+	if _, err := mail.ParseAddress(email); err != nil {
+		return newError(http.StatusBadRequest, "invalidValue", "invalid email %q", email)
+	}
+	existingUser, err := s.store.GetUser(ctx, &store.FindUserMessage{
+		Email:       &email,
+		ShowDeleted: true,
+	})
+	if err != nil {
+		return errors.Wrapf(err, "failed to get user %q", email)
+	}
+	if existingUser != nil {
+		return newError(http.StatusConflict, "uniqueness", "user %q already exists", email)
+	}
+
+	// The provisioned users sign in with the identity provider, so the password is random.
+	password, err := common.RandomString(20)
+	if err != nil {
+		return errors.Wrap(err, "failed to generate random password")
+	}
+	passwordHash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	if err != nil {
+		return errors.Wrap(err, "failed to generate password hash")
+	}
+	u, err := s.store.CreateUser(ctx, &store.UserMessage{
+		Email:        email,
+		Name:         request.getDisplayName(),
+		Type:         api.EndUser,
+		PasswordHash: string(passwordHash),
+	}, api.SystemBotID)
+	if err != nil {
+		return errors.Wrap(err, "failed to create user")
+	}
+	if err := s.createMemberActivity(ctx, u, api.ActivityMemberCreate, api.ActivityMemberCreatePayload{
+		PrincipalID:    u.ID,
+		PrincipalName:  u.Name,
+		PrincipalEmail: u.Email,
+		MemberStatus:   api.Active,
+		Role:           u.Role,
+	}); err != nil {
+		return err
+	}
+	if request.Active != nil && !*request.Active {
+		if u, err = s.setUserActive(ctx, u, false); err != nil {
+			return err
+		}
+	}
+	return response(c, http.StatusCreated, convertToUser(u))
+}
+
+func (s *Service) replaceUser(c echo.Context) error {
+	u, err := s.findUser(c)
+	if err != nil {
+		return err
+	}
+	var request user
+	if err := decodeRequest(c, &request); err != nil {
+		return err
+	}
+	patch := &userPatch{
+		email:       request.getEmail(),
+		displayName: request.getDisplayName(),
+		active:      request.Active,
+	}
+	if u, err = s.updateUser(c, u, patch); err != nil {
+		return err
+	}
+	return response(c, http.StatusOK, convertToUser(u))
+}
+
+func (s *Service) patchUser(c echo.Context) error {
+	u, err := s.findUser(c)
+	if err != nil {
+		return err
+	}
+	var request patchRequest
+	if err := decodeRequest(c, &request); err != nil {
+		return err
+	}
+	patch := &userPatch{}
+	for _, operation := range request.Operations {
+		op := strings.ToLower(operation.Op)
+		if op != "add" && op != "replace" {
+			return newError(http.StatusBadRequest, "invalidValue", "unsupported operation %q on user", operation.Op)
+		}
+		values := map[string]json.RawMessage{}
+		if operation.Path == "" {
+			if err := json.Unmarshal(operation.Value, &values); err != nil {
+				return newError(http.StatusBadRequest, "invalidValue", "the value must be an object without the path")
+			}
+		} else {
+			values[operation.Path] = operation.Value
+		}
+		for path, value := range values {
+			if err := patch.apply(path, value); err != nil {
+				return err
+			}
+		}
+	}
+	if u, err = s.updateUser(c, u, patch); err != nil {
+		return err
+	}
+	return response(c, http.StatusOK, convertToUser(u))
+}
+
+// deleteUser deactivates the user. The user is kept so that the history is kept.
+func (s *Service) deleteUser(c echo.Context) error {
+	u, err := s.findUser(c)
+	if err != nil {
+		return err
+	}
+	if !u.MemberDeleted {
+		if _, err := s.setUserActive(c.Request().Context(), u, false); err != nil {
+			return err
+		}
+	}
+	return c.NoContent(http.StatusNoContent)
+}
+
+// userPatch is the patch of the SCIM user. The empty fields are not changed.
+type userPatch struct {
+	email       string
+	displayName string
+	active      *bool
+}
+
+// apply applies the value of the attribute path to the patch.
+// The attributes we don't store such as the phone numbers are ignored, so that the identity providers
+// pushing all the attributes are supported.
+func (p *userPatch) apply(path string, value json.RawMessage) error {
+	var err error
+	switch strings.ToLower(path) {
+	case "active":
+		var active bool
+		// Some identity providers send the boolean as a string, e.g. "False".
+		var s string
+		if err = json.Unmarshal(value, &s); err == nil {
+			active, err = strconv.ParseBool(strings.ToLower(s))
+		} else {
+			err = json.Unmarshal(value, &active)
+		}
+		p.active = &active
+	case "username":
+		err = json.Unmarshal(value, &p.email)
+		p.email = strings.ToLower(p.email)
+	case "displayname", "name.formatted":
+		err = json.Unmarshal(value, &p.displayName)
+	case "name":
+		var n name
+		err = json.Unmarshal(value, &n)
+		if n.Formatted != "" {
+			p.displayName = n.Formatted
+		}
+	}
+	if err != nil {
+		return newError(http.StatusBadRequest, "invalidValue", "invalid value of %q: %v", path, err)
+	}
+	return nil
+}
+
+func (s *Service) updateUser(c echo.Context, u *store.UserMessage, patch *userPatch) (*store.UserMessage, error) {
+	ctx := c.Request().Context()
+	update := &store.UpdateUserMessage{}
+	if patch.email != "" && patch.email != u.Email {
+		if _, err := mail.ParseAddress(patch.email); err != nil {
+			return nil, newError(http.StatusBadRequest, "invalidValue", "invalid email %q", patch.email)
+		}
+		existingUser, err := s.store.GetUser(ctx, &store.FindUserMessage{
+			Email:       &patch.email,
+			ShowDeleted: true,
+		})
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to get user %q", patch.email)
+		}
+		if existingUser != nil {
+			return nil, newError(http.StatusConflict, "uniqueness", "user %q already exists", patch.email)
+		}
+		update.Email = &patch.email
+	}
+	if patch.displayName != "" && patch.displayName != u.Name {
+		update.Name = &patch.displayName
+	}
+	if update.Email != nil || update.Name != nil {
+		var err error
+		if u, err = s.store.UpdateUser(ctx, u.ID, update, api.SystemBotID); err != nil {
+			return nil, errors.Wrap(err, "failed to update user")
+		}
+	}
+	if patch.active != nil && *patch.active == u.MemberDeleted {
+		return s.setUserActive(ctx, u, *patch.active)
+	}
+	return u, nil
+}
+
+func (s *Service) setUserActive(ctx context.Context, u *store.UserMessage, active bool) (*store.UserMessage, error) {
+	deleted := !active
+	u, err := s.store.UpdateUser(ctx, u.ID, &store.UpdateUserMessage{Delete: &deleted}, api.SystemBotID)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to update user")
+	}
+	activityType := api.ActivityMemberActivate
+	if !active {
+		activityType = api.ActivityMemberDeactivate
+	}
+	if err := s.createMemberActivity(ctx, u, activityType, api.ActivityMemberActivateDeactivatePayload{
+		PrincipalID:    u.ID,
+		PrincipalName:  u.Name,
+		PrincipalEmail: u.Email,
+		Role:           u.Role,
+	}); err != nil {
+		return nil, err
+	}
+	return u, nil
+}
+
+func (s *Service) createMemberActivity(ctx context.Context, u *store.UserMessage, activityType api.ActivityType, payload any) error {
+	bytes, err := json.Marshal(payload)
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal activity payload")
+	}
+	if _, err := s.store.CreateActivityV2(ctx, &store.ActivityMessage{
+		CreatorUID:   api.SystemBotID,
+		ContainerUID: u.ID,
+		Type:         activityType,
+		Level:        api.ActivityInfo,
+		Payload:      string(bytes),
+	}); err != nil {
+		return errors.Wrap(err, "failed to create activity")
+	}
+	return nil
+}
+
+// findUser finds the end user of the id path parameter.
+func (s *Service) findUser(c echo.Context) (*store.UserMessage, error) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return nil, newError(http.StatusNotFound, "", "user %q not found", c.Param("id"))
+	}
+	u, err := s.store.GetUserByID(c.Request().Context(), id)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get user %d", id)
+	}
+	if u == nil || u.Type != api.EndUser {
+		return nil, newError(http.StatusNotFound, "", "user %q not found", c.Param("id"))
+	}
+	return u, nil
+}
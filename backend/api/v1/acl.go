@@ -214,6 +214,18 @@ func getProjectIDs(req any) ([]string, error) {
 			return nil, err
 		}
 		return []string{projectID}, nil
+	case *v1pb.ListAccessGrantsRequest:
+		projectID, err := getProjectID(request.Parent)
+		if err != nil {
+			return nil, err
+		}
+		return []string{projectID}, nil
+	case *v1pb.RevokeAccessGrantRequest:
+		projectID, _, err := getProjectIDAccessGrantID(request.Name)
+		if err != nil {
+			return nil, err
+		}
+		return []string{projectID}, nil
 	}
 
 	return nil, nil
@@ -40,6 +40,8 @@ var projectOwnerMethods = map[string]bool{
 	"ProjectService/DeleteProject":           true,
 	"ProjectService/UndeleteProject":         true,
 	"ProjectService/SetIamPolicy":            true,
+	"ProjectService/ListAccessGrants":        true,
+	"ProjectService/RevokeAccessGrant":       true,
 	"SubscriptionService/UpdateSubscription": true,
 }
 
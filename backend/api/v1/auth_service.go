@@ -26,8 +26,10 @@ import (
 	enterpriseAPI "github.com/bytebase/bytebase/backend/enterprise/api"
 	api "github.com/bytebase/bytebase/backend/legacyapi"
 	metricAPI "github.com/bytebase/bytebase/backend/metric"
+	"github.com/bytebase/bytebase/backend/plugin/idp/ldap"
 	"github.com/bytebase/bytebase/backend/plugin/idp/oauth2"
 	"github.com/bytebase/bytebase/backend/plugin/idp/oidc"
+	"github.com/bytebase/bytebase/backend/plugin/idp/saml"
 	"github.com/bytebase/bytebase/backend/plugin/metric"
 	"github.com/bytebase/bytebase/backend/runner/metricreport"
 	"github.com/bytebase/bytebase/backend/store"
@@ -43,17 +45,19 @@ type AuthService struct {
 	licenseService enterpriseAPI.LicenseService
 	metricReporter *metricreport.Reporter
 	profile        *config.Profile
+	samlCache      *saml.Cache
 	postCreateUser func(ctx context.Context, user *store.UserMessage, firstEndUser bool) error
 }
 
 // NewAuthService creates a new AuthService.
-func NewAuthService(store *store.Store, secret string, licenseService enterpriseAPI.LicenseService, metricReporter *metricreport.Reporter, profile *config.Profile, postCreateUser func(ctx context.Context, user *store.UserMessage, firstEndUser bool) error) *AuthService {
+func NewAuthService(store *store.Store, secret string, licenseService enterpriseAPI.LicenseService, metricReporter *metricreport.Reporter, profile *config.Profile, samlCache *saml.Cache, postCreateUser func(ctx context.Context, user *store.UserMessage, firstEndUser bool) error) *AuthService {
 	return &AuthService{
 		store:          store,
 		secret:         secret,
 		licenseService: licenseService,
 		metricReporter: metricReporter,
 		profile:        profile,
+		samlCache:      samlCache,
 		postCreateUser: postCreateUser,
 	}
 }
@@ -730,6 +734,31 @@ func (s *AuthService) getOrCreateUserWithIDP(ctx context.Context, request *v1pb.
 			return nil, status.Errorf(codes.Internal, "failed to get user info: %v", err)
 		}
 		fieldMapping = idp.Config.GetOidcConfig().FieldMapping
+	} else if idp.Type == storepb.IdentityProviderType_LDAP {
+		ldapContext := request.IdpContext.GetLdapContext()
+		if ldapContext == nil {
+			return nil, status.Errorf(codes.InvalidArgument, "missing LDAP context")
+		}
+		ldapIDP, err := ldap.NewIdentityProvider(idp.Config.GetLdapConfig())
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to create new LDAP identity provider: %v", err)
+		}
+		userInfo, err = ldapIDP.Authenticate(ldapContext.Username, ldapContext.Password)
+		if err != nil {
+			return nil, status.Errorf(codes.Unauthenticated, "failed to authenticate with LDAP: %v", err)
+		}
+		fieldMapping = idp.Config.GetLdapConfig().FieldMapping
+	} else if idp.Type == storepb.IdentityProviderType_SAML {
+		samlContext := request.IdpContext.GetSamlContext()
+		if samlContext == nil {
+			return nil, status.Errorf(codes.InvalidArgument, "missing SAML context")
+		}
+		// The SAML response has been validated by the assertion consumer service, which issues the code.
+		userInfo, err = s.samlCache.ExchangeCode(idp.ResourceID, samlContext.Code)
+		if err != nil {
+			return nil, status.Errorf(codes.Unauthenticated, "failed to exchange SAML code: %v", err)
+		}
+		fieldMapping = idp.Config.GetSamlConfig().FieldMapping
 	} else {
 		return nil, status.Errorf(codes.InvalidArgument, "identity provider type %s not supported", idp.Type.String())
 	}
@@ -739,7 +768,13 @@ func (s *AuthService) getOrCreateUserWithIDP(ctx context.Context, request *v1pb.
 
 	// The userinfo's email comes from identity provider, it has to be converted to lower-case.
 	var email string
-	if fieldMapping.Identifier == fieldMapping.Email {
+	if idp.Type == storepb.IdentityProviderType_SAML {
+		samlEmail, err := getSAMLUserEmail(userInfo, fieldMapping, idp.Domain)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "failed to get the email of SAML user: %v", err)
+		}
+		email = strings.ToLower(samlEmail)
+	} else if fieldMapping.Identifier == fieldMapping.Email {
 		email = strings.ToLower(userInfo.Email)
 	} else {
 		email = strings.ToLower(fmt.Sprintf("%s@%s", userInfo.Identifier, idp.Domain))
@@ -786,6 +821,22 @@ func (s *AuthService) getOrCreateUserWithIDP(ctx context.Context, request *v1pb.
 	return user, nil
 }
 
+// getSAMLUserEmail returns the email of the SAML user by the same rule as the other identity providers.
+// It's the mapped email if the identifier is mapped to the email, otherwise it's the identifier in the domain.
+// The identifier which is an address already is rejected, so it can't name a user in another domain.
+func getSAMLUserEmail(userInfo *storepb.IdentityProviderUserInfo, fieldMapping *storepb.FieldMapping, domain string) (string, error) {
+	if fieldMapping.GetIdentifier() == fieldMapping.GetEmail() {
+		return userInfo.Email, nil
+	}
+	if strings.Contains(userInfo.Identifier, "@") {
+		return "", errors.New("the identifier must not contain @ if it isn't mapped to the email")
+	}
+	if domain == "" {
+		return "", errors.New("the domain of the identity provider is required if the identifier isn't mapped to the email")
+	}
+	return fmt.Sprintf("%s@%s", userInfo.Identifier, domain), nil
+}
+
 func challengeMFACode(user *store.UserMessage, mfaCode string) error {
 	if !validateWithCodeAndSecret(mfaCode, user.MFAConfig.OtpSecret) {
 		return status.Errorf(codes.Unauthenticated, "invalid MFA code")
@@ -1,6 +1,10 @@
 package v1
 
-import "testing"
+import (
+	"testing"
+
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
 
 func TestValidatePhone(t *testing.T) {
 	tests := []struct {
@@ -25,3 +29,59 @@ func TestValidatePhone(t *testing.T) {
 		}
 	}
 }
+
+func TestGetSAMLUserEmail(t *testing.T) {
+	nameID := &storepb.FieldMapping{}
+	attributes := &storepb.FieldMapping{Identifier: "uid", Email: "mail"}
+	tests := []struct {
+		userInfo     *storepb.IdentityProviderUserInfo
+		fieldMapping *storepb.FieldMapping
+		domain       string
+		want         string
+		wantErr      bool
+	}{
+		{
+			// The NameID is the email if neither the identifier nor the email is mapped.
+			userInfo:     &storepb.IdentityProviderUserInfo{Identifier: "alice@example.com", Email: "alice@example.com"},
+			fieldMapping: nameID,
+			domain:       "example.com",
+			want:         "alice@example.com",
+		},
+		{
+			userInfo:     &storepb.IdentityProviderUserInfo{Identifier: "alice", Email: "alice@example.org"},
+			fieldMapping: attributes,
+			domain:       "example.com",
+			want:         "alice@example.com",
+		},
+		{
+			// The identifier can't name a user in another domain.
+			userInfo:     &storepb.IdentityProviderUserInfo{Identifier: "bob@example.org", Email: "alice@example.org"},
+			fieldMapping: attributes,
+			domain:       "example.com",
+			wantErr:      true,
+		},
+		{
+			userInfo:     &storepb.IdentityProviderUserInfo{Identifier: "alice", Email: "alice@example.org"},
+			fieldMapping: attributes,
+			domain:       "",
+			wantErr:      true,
+		},
+	}
+
+	for _, test := range tests {
+		got, err := getSAMLUserEmail(test.userInfo, test.fieldMapping, test.domain)
+		if test.wantErr {
+			if err == nil {
+				t.Errorf("getSAMLUserEmail %v with domain %q, got %q, want error", test.userInfo, test.domain, got)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("getSAMLUserEmail %v with domain %q, got error %v", test.userInfo, test.domain, err)
+			continue
+		}
+		if got != test.want {
+			t.Errorf("getSAMLUserEmail %v with domain %q, got %q, want %q", test.userInfo, test.domain, got, test.want)
+		}
+	}
+}
@@ -40,6 +40,7 @@ const (
 	stagePrefix                  = "stages/"
 	taskPrefix                   = "tasks/"
 	planPrefix                   = "plans/"
+	planCheckRunPrefix           = "planCheckRuns/"
 	rolePrefix                   = "roles/"
 	secretNamePrefix             = "secrets/"
 	webhookIDPrefix              = "webhooks/"
@@ -51,6 +52,7 @@ const (
 	pipelineNamePrefix           = "pipelines/"
 	logNamePrefix                = "logs/"
 	inboxNamePrefix              = "inbox/"
+	accessGrantPrefix            = "accessGrants/"
 
 	deploymentConfigSuffix = "/deploymentConfig"
 	backupSettingSuffix    = "/backupSetting"
@@ -114,6 +116,18 @@ func getProjectIDWebhookID(name string) (string, string, error) {
 	return tokens[0], tokens[1], nil
 }
 
+func getProjectIDAccessGrantID(name string) (string, int, error) {
+	tokens, err := getNameParentTokens(name, projectNamePrefix, accessGrantPrefix)
+	if err != nil {
+		return "", 0, err
+	}
+	accessGrantID, err := strconv.Atoi(tokens[1])
+	if err != nil {
+		return "", 0, errors.Errorf("invalid access grant ID %q", tokens[1])
+	}
+	return tokens[0], accessGrantID, nil
+}
+
 func getUIDFromName(name, prefix string) (int, error) {
 	tokens, err := getNameParentTokens(name, prefix)
 	if err != nil {
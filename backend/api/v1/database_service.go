@@ -32,6 +32,7 @@ import (
 	api "github.com/bytebase/bytebase/backend/legacyapi"
 	"github.com/bytebase/bytebase/backend/plugin/db"
 	"github.com/bytebase/bytebase/backend/plugin/db/util"
+	"github.com/bytebase/bytebase/backend/plugin/indexadvisor"
 	parser "github.com/bytebase/bytebase/backend/plugin/parser/sql"
 	"github.com/bytebase/bytebase/backend/plugin/parser/sql/ast"
 	"github.com/bytebase/bytebase/backend/plugin/parser/sql/transform"
@@ -822,6 +823,10 @@ func convertToVcsType(t storepb.VcsType) v1pb.VcsType {
 		return v1pb.VcsType_GITHUB
 	case storepb.VcsType_BITBUCKET:
 		return v1pb.VcsType_BITBUCKET
+	case storepb.VcsType_GITEA:
+		return v1pb.VcsType_GITEA
+	case storepb.VcsType_AZURE_DEVOPS:
+		return v1pb.VcsType_AZURE_DEVOPS
 	case storepb.VcsType_VCS_TYPE_UNSPECIFIED:
 		return v1pb.VcsType_VCS_TYPE_UNSPECIFIED
 	default:
@@ -1793,9 +1798,14 @@ func isUpperCaseLetter(c rune) bool {
 }
 
 // AdviseIndex advises the index of a table.
+// It uses OpenAI if configured, otherwise falls back to the built-in index advisor.
 func (s *DatabaseService) AdviseIndex(ctx context.Context, request *v1pb.AdviseIndexRequest) (*v1pb.AdviseIndexResponse, error) {
-	if !s.licenseService.IsFeatureEnabled(api.FeaturePluginOpenAI) {
-		return nil, status.Errorf(codes.PermissionDenied, api.FeaturePluginOpenAI.AccessErrorMessage())
+	useOpenAI, err := s.isOpenAIAvailable(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !useOpenAI && !s.licenseService.IsFeatureEnabled(api.FeatureIndexAdvisor) {
+		return nil, status.Errorf(codes.PermissionDenied, api.FeatureIndexAdvisor.AccessErrorMessage())
 	}
 	instanceID, databaseName, err := getInstanceDatabaseID(request.Parent)
 	if err != nil {
@@ -1819,6 +1829,9 @@ func (s *DatabaseService) AdviseIndex(ctx context.Context, request *v1pb.AdviseI
 		return nil, status.Errorf(codes.Internal, "Failed to get instance: %v", err)
 	}
 
+	if !useOpenAI {
+		return s.builtinAdviseIndex(ctx, request, instance, database)
+	}
 	switch instance.Engine {
 	case db.Postgres:
 		return s.pgAdviseIndex(ctx, request, database)
@@ -1829,6 +1842,141 @@ func (s *DatabaseService) AdviseIndex(ctx context.Context, request *v1pb.AdviseI
 	}
 }
 
+func (s *DatabaseService) isOpenAIAvailable(ctx context.Context) (bool, error) {
+	if !s.licenseService.IsFeatureEnabled(api.FeaturePluginOpenAI) {
+		return false, nil
+	}
+	openaiKeyName := api.SettingPluginOpenAIKey
+	key, err := s.store.GetSettingV2(ctx, &store.FindSettingMessage{Name: &openaiKeyName})
+	if err != nil {
+		return false, status.Errorf(codes.Internal, "Failed to get setting: %v", err)
+	}
+	return key != nil && key.Value != "", nil
+}
+
+func (s *DatabaseService) builtinAdviseIndex(ctx context.Context, request *v1pb.AdviseIndexRequest, instance *store.InstanceMessage, database *store.DatabaseMessage) (*v1pb.AdviseIndexResponse, error) {
+	engine := convertToParserEngine(instance.Engine)
+	if !indexadvisor.IsEngineSupported(engine) {
+		return nil, status.Errorf(codes.InvalidArgument, "AdviseIndex is not implemented for engine: %v", instance.Engine)
+	}
+	schema, err := s.store.GetDBSchema(ctx, database.UID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to get database schema: %v", err)
+	}
+	if schema == nil {
+		return nil, status.Errorf(codes.NotFound, "database schema %q not found", database.DatabaseName)
+	}
+	recommendations, err := indexadvisor.AdviseStatement(engine, schema.Metadata, request.Statement)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Failed to advise index: %v", err)
+	}
+
+	resp := &v1pb.AdviseIndexResponse{
+		CurrentIndex: "No usable index",
+		Suggestion:   "N/A",
+	}
+	if len(recommendations) == 0 {
+		resp.CurrentIndex = "The existing indexes serve the statement"
+		return resp, nil
+	}
+	// Only the recommendation with the most columns is returned, which is the first one because all of them have no benefit statistics.
+	recommendation := recommendations[0]
+	if recommendation.CurrentIndex != "" {
+		if index := schema.FindIndex(recommendation.Schema, recommendation.Table, recommendation.CurrentIndex); index != nil {
+			resp.CurrentIndex = fmt.Sprintf("USING %s (%s)", index.Type, strings.Join(index.Expressions, ", "))
+		}
+	}
+	resp.Suggestion = fmt.Sprintf("USING BTREE (%s)", strings.Join(recommendation.Columns, ", "))
+	resp.CreateIndexStatement = recommendation.CreateIndexStatement
+	return resp, nil
+}
+
+// ListIndexRecommendations lists the composite indexes proposed by the built-in index advisor from the slow query statistics of the database.
+func (s *DatabaseService) ListIndexRecommendations(ctx context.Context, request *v1pb.ListIndexRecommendationsRequest) (*v1pb.ListIndexRecommendationsResponse, error) {
+	if !s.licenseService.IsFeatureEnabled(api.FeatureIndexAdvisor) {
+		return nil, status.Errorf(codes.PermissionDenied, api.FeatureIndexAdvisor.AccessErrorMessage())
+	}
+	instanceID, databaseName, err := getInstanceDatabaseID(request.Parent)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	instance, err := s.store.GetInstanceV2(ctx, &store.FindInstanceMessage{ResourceID: &instanceID})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to get instance: %v", err)
+	}
+	if instance == nil {
+		return nil, status.Errorf(codes.NotFound, "instance %q not found", instanceID)
+	}
+	database, err := s.store.GetDatabaseV2(ctx, &store.FindDatabaseMessage{
+		InstanceID:   &instanceID,
+		DatabaseName: &databaseName,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to get database: %v", err)
+	}
+	if database == nil {
+		return nil, status.Errorf(codes.NotFound, "database %q not found", databaseName)
+	}
+	engine := convertToParserEngine(instance.Engine)
+	if !indexadvisor.IsEngineSupported(engine) {
+		return nil, status.Errorf(codes.InvalidArgument, "index advisor is not supported for engine: %v", instance.Engine)
+	}
+
+	schema, err := s.store.GetDBSchema(ctx, database.UID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to get database schema: %v", err)
+	}
+	if schema == nil {
+		return nil, status.Errorf(codes.NotFound, "database schema %q not found", databaseName)
+	}
+	logs, err := s.store.ListSlowQuery(ctx, &store.ListSlowQueryMessage{
+		InstanceUID: &instance.UID,
+		DatabaseUID: &database.UID,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to list slow query: %v", err)
+	}
+
+	var queries []*indexadvisor.SlowQuery
+	for _, log := range logs {
+		statistics := log.Statistics
+		if statistics == nil {
+			continue
+		}
+		query := &indexadvisor.SlowQuery{
+			Fingerprint:       statistics.SqlFingerprint,
+			Count:             statistics.Count,
+			TotalQueryTime:    statistics.AverageQueryTime.AsDuration() * time.Duration(statistics.Count),
+			TotalRowsExamined: statistics.AverageRowsExamined * statistics.Count,
+			TotalRowsSent:     statistics.AverageRowsSent * statistics.Count,
+		}
+		for _, sample := range statistics.Samples {
+			query.Samples = append(query.Samples, sample.SqlText)
+		}
+		queries = append(queries, query)
+	}
+	recommendations, err := indexadvisor.Advise(engine, schema.Metadata, queries)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to advise index: %v", err)
+	}
+
+	response := &v1pb.ListIndexRecommendationsResponse{}
+	for _, recommendation := range recommendations {
+		response.IndexRecommendations = append(response.IndexRecommendations, &v1pb.IndexRecommendation{
+			Schema:                recommendation.Schema,
+			Table:                 recommendation.Table,
+			Columns:               recommendation.Columns,
+			CurrentIndex:          recommendation.CurrentIndex,
+			CreateIndexStatement:  recommendation.CreateIndexStatement,
+			SqlFingerprints:       recommendation.Fingerprints,
+			QueryCount:            recommendation.QueryCount,
+			TotalQueryTime:        durationpb.New(recommendation.TotalQueryTime),
+			ReducibleRowsExamined: recommendation.ReducibleRowsExamined,
+		})
+	}
+	return response, nil
+}
+
 func (s *DatabaseService) mysqlAdviseIndex(ctx context.Context, request *v1pb.AdviseIndexRequest, database *store.DatabaseMessage) (*v1pb.AdviseIndexResponse, error) {
 	openaiKeyName := api.SettingPluginOpenAIKey
 	key, err := s.store.GetSettingV2(ctx, &store.FindSettingMessage{Name: &openaiKeyName})
@@ -208,7 +208,7 @@ func (s *ExternalVersionControlService) ExchangeToken(ctx context.Context, reque
 			return nil, status.Errorf(codes.Internal, err.Error())
 		}
 		vcsType = tp
-		if vcsType != vcs.GitLab && vcsType != vcs.GitHub && vcsType != vcs.Bitbucket {
+		if vcsType != vcs.GitLab && vcsType != vcs.GitHub && vcsType != vcs.Bitbucket && vcsType != vcs.Gitea && vcsType != vcs.AzureDevOps {
 			return nil, status.Errorf(codes.InvalidArgument, "unsupport vcs type %v", request.ExchangeToken.Type)
 		}
 
@@ -310,6 +310,10 @@ func convertToExternalVersionControl(externalVersionControl *store.ExternalVersi
 		tp = v1pb.ExternalVersionControl_GITLAB
 	case vcs.Bitbucket:
 		tp = v1pb.ExternalVersionControl_BITBUCKET
+	case vcs.Gitea:
+		tp = v1pb.ExternalVersionControl_GITEA
+	case vcs.AzureDevOps:
+		tp = v1pb.ExternalVersionControl_AZURE_DEVOPS
 	}
 
 	return &v1pb.ExternalVersionControl{
@@ -363,6 +367,10 @@ func convertExternalVersionControlTypeToVCSType(tp v1pb.ExternalVersionControl_T
 		return vcs.GitLab, nil
 	case v1pb.ExternalVersionControl_BITBUCKET:
 		return vcs.Bitbucket, nil
+	case v1pb.ExternalVersionControl_GITEA:
+		return vcs.Gitea, nil
+	case v1pb.ExternalVersionControl_AZURE_DEVOPS:
+		return vcs.AzureDevOps, nil
 	}
 	return "", errors.Errorf("unknown external version control type: %v", tp)
 }
@@ -12,8 +12,10 @@ import (
 	"github.com/pkg/errors"
 
 	enterpriseAPI "github.com/bytebase/bytebase/backend/enterprise/api"
+	"github.com/bytebase/bytebase/backend/plugin/idp/ldap"
 	"github.com/bytebase/bytebase/backend/plugin/idp/oauth2"
 	"github.com/bytebase/bytebase/backend/plugin/idp/oidc"
+	"github.com/bytebase/bytebase/backend/plugin/idp/saml"
 	"github.com/bytebase/bytebase/backend/store"
 	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
 	v1pb "github.com/bytebase/bytebase/proto/generated-go/v1"
@@ -24,13 +26,15 @@ type IdentityProviderService struct {
 	v1pb.UnimplementedIdentityProviderServiceServer
 	store          *store.Store
 	licenseService enterpriseAPI.LicenseService
+	samlCache      *saml.Cache
 }
 
 // NewIdentityProviderService creates a new IdentityProviderService.
-func NewIdentityProviderService(store *store.Store, licenseService enterpriseAPI.LicenseService) *IdentityProviderService {
+func NewIdentityProviderService(store *store.Store, licenseService enterpriseAPI.LicenseService, samlCache *saml.Cache) *IdentityProviderService {
 	return &IdentityProviderService{
 		store:          store,
 		licenseService: licenseService,
+		samlCache:      samlCache,
 	}
 }
 
@@ -139,6 +143,14 @@ func (s *IdentityProviderService) UpdateIdentityProvider(ctx context.Context, re
 			if request.IdentityProvider.Config.GetOidcConfig().ClientSecret == "" {
 				patch.Config.GetOidcConfig().ClientSecret = identityProvider.Config.GetOidcConfig().ClientSecret
 			}
+		} else if identityProvider.Type == storepb.IdentityProviderType_LDAP {
+			if request.IdentityProvider.Config.GetLdapConfig().BindPassword == "" {
+				patch.Config.GetLdapConfig().BindPassword = identityProvider.Config.GetLdapConfig().BindPassword
+			}
+		} else if identityProvider.Type == storepb.IdentityProviderType_SAML {
+			if request.IdentityProvider.Config.GetSamlConfig().SpPrivateKey == "" {
+				patch.Config.GetSamlConfig().SpPrivateKey = identityProvider.Config.GetSamlConfig().SpPrivateKey
+			}
 		}
 	}
 
@@ -273,6 +285,58 @@ func (s *IdentityProviderService) TestIdentityProvider(ctx context.Context, requ
 		if _, err := oidcIdentityProvider.UserInfo(ctx, token, ""); err != nil {
 			return nil, status.Errorf(codes.InvalidArgument, "failed to get user info, error: %s", err.Error())
 		}
+	} else if identityProvider.Type == v1pb.IdentityProviderType_LDAP {
+		// Find bind password for those existed identity providers.
+		if request.IdentityProvider.Config.GetLdapConfig().BindPassword == "" {
+			storedIdentityProvider, err := s.getIdentityProviderMessage(ctx, request.IdentityProvider.Name)
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to find identity provider, error: %s", err.Error())
+			}
+			if storedIdentityProvider == nil {
+				return nil, status.Errorf(codes.Internal, "identity provider %s not found", request.IdentityProvider.Name)
+			}
+			request.IdentityProvider.Config.GetLdapConfig().BindPassword = storedIdentityProvider.Config.GetLdapConfig().BindPassword
+		}
+		ldapContext := request.GetLdapContext()
+		if ldapContext == nil {
+			return nil, status.Errorf(codes.InvalidArgument, "missing LDAP context")
+		}
+		identityProviderConfig := convertIdentityProviderConfigToStore(identityProvider.Config)
+		ldapIdentityProvider, err := ldap.NewIdentityProvider(identityProviderConfig.GetLdapConfig())
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "failed to create new LDAP identity provider: %v", err)
+		}
+		if _, err := ldapIdentityProvider.Authenticate(ldapContext.Username, ldapContext.Password); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "failed to authenticate, error: %s", err.Error())
+		}
+	} else if identityProvider.Type == v1pb.IdentityProviderType_SAML {
+		identityProviderID, err := getIdentityProviderID(request.IdentityProvider.Name)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, err.Error())
+		}
+		// Find service provider private key for those existed identity providers.
+		if request.IdentityProvider.Config.GetSamlConfig().SpPrivateKey == "" {
+			storedIdentityProvider, err := s.getIdentityProviderMessage(ctx, request.IdentityProvider.Name)
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to find identity provider, error: %s", err.Error())
+			}
+			if storedIdentityProvider == nil {
+				return nil, status.Errorf(codes.Internal, "identity provider %s not found", request.IdentityProvider.Name)
+			}
+			request.IdentityProvider.Config.GetSamlConfig().SpPrivateKey = storedIdentityProvider.Config.GetSamlConfig().SpPrivateKey
+		}
+		samlContext := request.GetSamlContext()
+		if samlContext == nil {
+			return nil, status.Errorf(codes.InvalidArgument, "missing SAML context")
+		}
+		identityProviderConfig := convertIdentityProviderConfigToStore(identityProvider.Config)
+		if _, err := saml.NewIdentityProvider(identityProviderConfig.GetSamlConfig(), setting.ExternalUrl, identityProviderID, s.samlCache); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "failed to create new SAML identity provider: %v", err)
+		}
+		// The SAML response has been validated by the assertion consumer service, which issues the code.
+		if _, err := s.samlCache.ExchangeCode(identityProviderID, samlContext.Code); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "failed to exchange SAML code, error: %s", err.Error())
+		}
 	} else {
 		return nil, status.Errorf(codes.InvalidArgument, "identity provider type %s not supported", identityProvider.Type.String())
 	}
@@ -351,6 +415,55 @@ func convertIdentityProviderConfigFromStore(identityProviderConfig *storepb.Iden
 				},
 			},
 		}
+	} else if v := identityProviderConfig.GetLdapConfig(); v != nil {
+		fieldMapping := v1pb.FieldMapping{
+			Identifier:  v.FieldMapping.Identifier,
+			DisplayName: v.FieldMapping.DisplayName,
+			Email:       v.FieldMapping.Email,
+		}
+		var groupMappings []*v1pb.LDAPGroupMapping
+		for _, groupMapping := range v.GroupMappings {
+			groupMappings = append(groupMappings, &v1pb.LDAPGroupMapping{
+				Group:   groupMapping.Group,
+				Project: fmt.Sprintf("%s%s", projectNamePrefix, groupMapping.Project),
+				Role:    fmt.Sprintf("%s%s", rolePrefix, groupMapping.Role),
+			})
+		}
+		return &v1pb.IdentityProviderConfig{
+			Config: &v1pb.IdentityProviderConfig_LdapConfig{
+				LdapConfig: &v1pb.LDAPIdentityProviderConfig{
+					Host:                 v.Host,
+					Port:                 v.Port,
+					SkipTlsVerify:        v.SkipTlsVerify,
+					BindDn:               v.BindDn,
+					BindPassword:         "", // SECURITY: We do not expose the bind password
+					BaseDn:               v.BaseDn,
+					UserFilter:           v.UserFilter,
+					SecurityProtocol:     v1pb.LDAPSecurityProtocol(v.SecurityProtocol),
+					FieldMapping:         &fieldMapping,
+					GroupMemberAttribute: v.GroupMemberAttribute,
+					GroupMappings:        groupMappings,
+				},
+			},
+		}
+	} else if v := identityProviderConfig.GetSamlConfig(); v != nil {
+		fieldMapping := v1pb.FieldMapping{
+			Identifier:  v.FieldMapping.Identifier,
+			DisplayName: v.FieldMapping.DisplayName,
+			Email:       v.FieldMapping.Email,
+		}
+		return &v1pb.IdentityProviderConfig{
+			Config: &v1pb.IdentityProviderConfig_SamlConfig{
+				SamlConfig: &v1pb.SAMLIdentityProviderConfig{
+					SsoUrl:        v.SsoUrl,
+					EntityId:      v.EntityId,
+					Certificate:   v.Certificate,
+					SpPrivateKey:  "", // SECURITY: We do not expose the service provider private key
+					SpCertificate: v.SpCertificate,
+					FieldMapping:  &fieldMapping,
+				},
+			},
+		}
 	}
 	return nil
 }
@@ -393,6 +506,55 @@ func convertIdentityProviderConfigToStore(identityProviderConfig *v1pb.IdentityP
 				},
 			},
 		}
+	} else if v := identityProviderConfig.GetLdapConfig(); v != nil {
+		fieldMapping := storepb.FieldMapping{
+			Identifier:  v.FieldMapping.Identifier,
+			DisplayName: v.FieldMapping.DisplayName,
+			Email:       v.FieldMapping.Email,
+		}
+		var groupMappings []*storepb.LDAPGroupMapping
+		for _, groupMapping := range v.GroupMappings {
+			groupMappings = append(groupMappings, &storepb.LDAPGroupMapping{
+				Group:   groupMapping.Group,
+				Project: strings.TrimPrefix(groupMapping.Project, projectNamePrefix),
+				Role:    strings.TrimPrefix(groupMapping.Role, rolePrefix),
+			})
+		}
+		return &storepb.IdentityProviderConfig{
+			Config: &storepb.IdentityProviderConfig_LdapConfig{
+				LdapConfig: &storepb.LDAPIdentityProviderConfig{
+					Host:                 v.Host,
+					Port:                 v.Port,
+					SkipTlsVerify:        v.SkipTlsVerify,
+					BindDn:               v.BindDn,
+					BindPassword:         v.BindPassword,
+					BaseDn:               v.BaseDn,
+					UserFilter:           v.UserFilter,
+					SecurityProtocol:     storepb.LDAPSecurityProtocol(v.SecurityProtocol),
+					FieldMapping:         &fieldMapping,
+					GroupMemberAttribute: v.GroupMemberAttribute,
+					GroupMappings:        groupMappings,
+				},
+			},
+		}
+	} else if v := identityProviderConfig.GetSamlConfig(); v != nil {
+		fieldMapping := storepb.FieldMapping{
+			Identifier:  v.FieldMapping.Identifier,
+			DisplayName: v.FieldMapping.DisplayName,
+			Email:       v.FieldMapping.Email,
+		}
+		return &storepb.IdentityProviderConfig{
+			Config: &storepb.IdentityProviderConfig_SamlConfig{
+				SamlConfig: &storepb.SAMLIdentityProviderConfig{
+					SsoUrl:        v.SsoUrl,
+					EntityId:      v.EntityId,
+					Certificate:   v.Certificate,
+					SpPrivateKey:  v.SpPrivateKey,
+					SpCertificate: v.SpCertificate,
+					FieldMapping:  &fieldMapping,
+				},
+			},
+		}
 	} else {
 		return nil
 	}
@@ -408,6 +570,33 @@ func validIdentityProviderConfig(identityProviderType v1pb.IdentityProviderType,
 		if identityProviderConfig.GetOidcConfig() == nil {
 			return errors.Errorf("unexpected provider config value")
 		}
+	} else if identityProviderType == v1pb.IdentityProviderType_LDAP {
+		ldapConfig := identityProviderConfig.GetLdapConfig()
+		if ldapConfig == nil {
+			return errors.Errorf("unexpected provider config value")
+		}
+		if ldapConfig.FieldMapping == nil {
+			return errors.Errorf("field mapping is required")
+		}
+		for _, groupMapping := range ldapConfig.GroupMappings {
+			if groupMapping.Group == "" {
+				return errors.Errorf("group is required in the group mapping")
+			}
+			if _, err := getProjectID(groupMapping.Project); err != nil {
+				return errors.Wrapf(err, "invalid project in the group mapping of %q", groupMapping.Group)
+			}
+			if _, err := getRoleID(groupMapping.Role); err != nil {
+				return errors.Wrapf(err, "invalid role in the group mapping of %q", groupMapping.Group)
+			}
+		}
+	} else if identityProviderType == v1pb.IdentityProviderType_SAML {
+		samlConfig := identityProviderConfig.GetSamlConfig()
+		if samlConfig == nil {
+			return errors.Errorf("unexpected provider config value")
+		}
+		if samlConfig.FieldMapping == nil {
+			return errors.Errorf("field mapping is required")
+		}
 	} else {
 		return errors.Errorf("unexpected provider type %s", identityProviderType)
 	}
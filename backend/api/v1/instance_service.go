@@ -22,9 +22,11 @@ import (
 	"github.com/bytebase/bytebase/backend/plugin/db"
 	"github.com/bytebase/bytebase/backend/plugin/db/pg"
 	"github.com/bytebase/bytebase/backend/plugin/metric"
+	"github.com/bytebase/bytebase/backend/plugin/secret"
 	"github.com/bytebase/bytebase/backend/runner/metricreport"
 	"github.com/bytebase/bytebase/backend/runner/schemasync"
 	"github.com/bytebase/bytebase/backend/store"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
 	v1pb "github.com/bytebase/bytebase/proto/generated-go/v1"
 )
 
@@ -341,6 +343,7 @@ func (s *InstanceService) DeleteInstance(ctx context.Context, request *v1pb.Dele
 	}, -1 /* don't need to pass the instance limition */); err != nil {
 		return nil, status.Errorf(codes.Internal, err.Error())
 	}
+	s.dbFactory.ClearDriverPool(instance.UID)
 
 	return &emptypb.Empty{}, nil
 }
@@ -412,6 +415,11 @@ func (s *InstanceService) AddDataSource(ctx context.Context, request *v1pb.AddDa
 	if instance.Deleted {
 		return nil, status.Errorf(codes.InvalidArgument, "instance %q has been deleted", request.Instance)
 	}
+	for _, ds := range instance.DataSources {
+		if ds.Title == dataSource.Title {
+			return nil, status.Errorf(codes.AlreadyExists, "data source %q already exists", dataSource.Title)
+		}
+	}
 
 	// Test connection.
 	if request.ValidateOnly {
@@ -470,23 +478,17 @@ func (s *InstanceService) UpdateDataSource(ctx context.Context, request *v1pb.Up
 	if instance.Deleted {
 		return nil, status.Errorf(codes.InvalidArgument, "instance %q has been deleted", request.Instance)
 	}
-	// We create a new variable dataSource to not modify existing data source in the memory.
-	var dataSource store.DataSourceMessage
-	found := false
-	for _, ds := range instance.DataSources {
-		if ds.Type == tp {
-			dataSource = *ds
-			found = true
-			break
-		}
-	}
-	if !found {
+	existing := findDataSource(instance, tp, request.DataSource.Title)
+	if existing == nil {
 		return nil, status.Errorf(codes.NotFound, "data source not found")
 	}
+	// We create a new variable dataSource to not modify existing data source in the memory.
+	dataSource := *existing
 
 	patch := &store.UpdateDataSourceMessage{
 		UpdaterID:   ctx.Value(common.PrincipalIDContextKey).(int),
 		InstanceUID: instance.UID,
+		UID:         dataSource.UID,
 		Type:        tp,
 		InstanceID:  instance.ResourceID,
 	}
@@ -547,10 +549,42 @@ func (s *InstanceService) UpdateDataSource(ctx context.Context, request *v1pb.Up
 			obfuscated := common.Obfuscate(request.DataSource.SshPrivateKey, s.secret)
 			patch.SSHObfuscatedPrivateKey = &obfuscated
 			dataSource.SSHObfuscatedPrivateKey = obfuscated
+		case "external_secret":
+			externalSecret, err := convertToStoreDataSourceExternalSecret(request.DataSource.ExternalSecret, s.dbFactory.GetSecretAllowlist())
+			if err != nil {
+				return nil, status.Errorf(codes.InvalidArgument, err.Error())
+			}
+			if externalSecret == nil {
+				externalSecret = &storepb.DataSourceExternalSecret{}
+			}
+			patch.ExternalSecret = externalSecret
+			dataSource.ExternalSecret = externalSecret
+		case "authentication_type":
+			authenticationType := storepb.DataSourceAuthenticationType(request.DataSource.AuthenticationType)
+			patch.AuthenticationType = &authenticationType
+			dataSource.AuthenticationType = authenticationType
+		case "vault_database_credential_path":
+			patch.VaultDatabaseCredentialPath = &request.DataSource.VaultDatabaseCredentialPath
+			dataSource.VaultDatabaseCredentialPath = request.DataSource.VaultDatabaseCredentialPath
+		case "aws_region":
+			patch.AWSRegion = &request.DataSource.AwsRegion
+			dataSource.AWSRegion = request.DataSource.AwsRegion
+		case "max_replication_lag_seconds":
+			if request.DataSource.MaxReplicationLagSeconds < 0 {
+				return nil, status.Errorf(codes.InvalidArgument, "max replication lag seconds must not be negative")
+			}
+			patch.MaxReplicationLagSeconds = &request.DataSource.MaxReplicationLagSeconds
+			dataSource.MaxReplicationLagSeconds = request.DataSource.MaxReplicationLagSeconds
+		case "use_for_sync":
+			patch.UseForSync = &request.DataSource.UseForSync
+			dataSource.UseForSync = request.DataSource.UseForSync
 		default:
 			return nil, status.Errorf(codes.InvalidArgument, `unsupport update_mask "%s"`, path)
 		}
 	}
+	if err := validateDataSourceAuthentication(&dataSource, s.dbFactory.GetSecretAllowlist()); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
 
 	// Test connection.
 	if request.ValidateOnly {
@@ -574,6 +608,8 @@ func (s *InstanceService) UpdateDataSource(ctx context.Context, request *v1pb.Up
 	if err := s.store.UpdateDataSourceV2(ctx, patch); err != nil {
 		return nil, status.Errorf(codes.Internal, err.Error())
 	}
+	// Close the pooled drivers connected with the old credentials.
+	s.dbFactory.ClearDriverPool(instance.UID)
 
 	instance, err = s.store.GetInstanceV2(ctx, &store.FindInstanceMessage{
 		UID: &instance.UID,
@@ -608,9 +644,15 @@ func (s *InstanceService) RemoveDataSource(ctx context.Context, request *v1pb.Re
 		return nil, status.Errorf(codes.InvalidArgument, "instance %q has been deleted", request.Instance)
 	}
 
-	if err := s.store.RemoveDataSourceV2(ctx, instance.UID, instance.ResourceID, dataSource.Type); err != nil {
+	existing := findDataSource(instance, dataSource.Type, dataSource.Title)
+	if existing == nil {
+		return nil, status.Errorf(codes.NotFound, "data source not found")
+	}
+
+	if err := s.store.RemoveDataSourceV2(ctx, instance.UID, instance.ResourceID, existing.UID); err != nil {
 		return nil, status.Errorf(codes.Internal, err.Error())
 	}
+	s.dbFactory.ClearDriverPool(instance.UID)
 
 	instance, err = s.store.GetInstanceV2(ctx, &store.FindInstanceMessage{
 		ResourceID: &instance.ResourceID,
@@ -688,13 +730,19 @@ func convertToInstance(instance *store.InstanceMessage) *v1pb.Instance {
 			Type:     dataSourceType,
 			Username: ds.Username,
 			// We don't return the password and SSLs on reads.
-			Host:                   ds.Host,
-			Port:                   ds.Port,
-			Database:               ds.Database,
-			Srv:                    ds.SRV,
-			AuthenticationDatabase: ds.AuthenticationDatabase,
-			Sid:                    ds.SID,
-			ServiceName:            ds.ServiceName,
+			Host:                        ds.Host,
+			Port:                        ds.Port,
+			Database:                    ds.Database,
+			Srv:                         ds.SRV,
+			AuthenticationDatabase:      ds.AuthenticationDatabase,
+			Sid:                         ds.SID,
+			ServiceName:                 ds.ServiceName,
+			ExternalSecret:              convertToV1DataSourceExternalSecret(ds.ExternalSecret),
+			AuthenticationType:          v1pb.DataSourceAuthenticationType(ds.AuthenticationType),
+			VaultDatabaseCredentialPath: ds.VaultDatabaseCredentialPath,
+			AwsRegion:                   ds.AWSRegion,
+			MaxReplicationLagSeconds:    ds.MaxReplicationLagSeconds,
+			UseForSync:                  ds.UseForSync,
 		})
 	}
 
@@ -752,29 +800,145 @@ func (s *InstanceService) convertToDataSourceMessage(dataSource *v1pb.DataSource
 		return nil, err
 	}
 
-	return &store.DataSourceMessage{
-		Title:                   dataSource.Title,
-		Type:                    dsType,
-		Username:                dataSource.Username,
-		ObfuscatedPassword:      common.Obfuscate(dataSource.Password, s.secret),
-		ObfuscatedSslCa:         common.Obfuscate(dataSource.SslCa, s.secret),
-		ObfuscatedSslCert:       common.Obfuscate(dataSource.SslCert, s.secret),
-		ObfuscatedSslKey:        common.Obfuscate(dataSource.SslKey, s.secret),
-		Host:                    dataSource.Host,
-		Port:                    dataSource.Port,
-		Database:                dataSource.Database,
-		SRV:                     dataSource.Srv,
-		AuthenticationDatabase:  dataSource.AuthenticationDatabase,
-		SID:                     dataSource.Sid,
-		ServiceName:             dataSource.ServiceName,
-		SSHHost:                 dataSource.SshHost,
-		SSHPort:                 dataSource.SshPort,
-		SSHUser:                 dataSource.SshUser,
-		SSHObfuscatedPassword:   common.Obfuscate(dataSource.SshPassword, s.secret),
-		SSHObfuscatedPrivateKey: common.Obfuscate(dataSource.SshPrivateKey, s.secret),
+	externalSecret, err := convertToStoreDataSourceExternalSecret(dataSource.ExternalSecret, s.dbFactory.GetSecretAllowlist())
+	if err != nil {
+		return nil, err
+	}
+
+	dataSourceMessage := &store.DataSourceMessage{
+		Title:                       dataSource.Title,
+		Type:                        dsType,
+		Username:                    dataSource.Username,
+		ObfuscatedPassword:          common.Obfuscate(dataSource.Password, s.secret),
+		ObfuscatedSslCa:             common.Obfuscate(dataSource.SslCa, s.secret),
+		ObfuscatedSslCert:           common.Obfuscate(dataSource.SslCert, s.secret),
+		ObfuscatedSslKey:            common.Obfuscate(dataSource.SslKey, s.secret),
+		Host:                        dataSource.Host,
+		Port:                        dataSource.Port,
+		Database:                    dataSource.Database,
+		SRV:                         dataSource.Srv,
+		AuthenticationDatabase:      dataSource.AuthenticationDatabase,
+		SID:                         dataSource.Sid,
+		ServiceName:                 dataSource.ServiceName,
+		SSHHost:                     dataSource.SshHost,
+		SSHPort:                     dataSource.SshPort,
+		SSHUser:                     dataSource.SshUser,
+		SSHObfuscatedPassword:       common.Obfuscate(dataSource.SshPassword, s.secret),
+		SSHObfuscatedPrivateKey:     common.Obfuscate(dataSource.SshPrivateKey, s.secret),
+		ExternalSecret:              externalSecret,
+		AuthenticationType:          storepb.DataSourceAuthenticationType(dataSource.AuthenticationType),
+		VaultDatabaseCredentialPath: dataSource.VaultDatabaseCredentialPath,
+		AWSRegion:                   dataSource.AwsRegion,
+		MaxReplicationLagSeconds:    dataSource.MaxReplicationLagSeconds,
+		UseForSync:                  dataSource.UseForSync,
+	}
+	if err := validateDataSourceAuthentication(dataSourceMessage, s.dbFactory.GetSecretAllowlist()); err != nil {
+		return nil, err
+	}
+	if dataSourceMessage.MaxReplicationLagSeconds < 0 {
+		return nil, errors.Errorf("max replication lag seconds must not be negative")
+	}
+	return dataSourceMessage, nil
+}
+
+// findDataSource finds the data source of the instance by type and title.
+// For compatibility, the only data source of the type is returned if no title matches.
+func findDataSource(instance *store.InstanceMessage, tp api.DataSourceType, title string) *store.DataSourceMessage {
+	var dataSources []*store.DataSourceMessage
+	for _, ds := range instance.DataSources {
+		if ds.Type != tp {
+			continue
+		}
+		if ds.Title == title {
+			return ds
+		}
+		dataSources = append(dataSources, ds)
+	}
+	if len(dataSources) == 1 {
+		return dataSources[0]
+	}
+	return nil
+}
+
+// validateDataSourceAuthentication validates the settings required by the authentication type.
+func validateDataSourceAuthentication(dataSource *store.DataSourceMessage, allowlist *secret.Allowlist) error {
+	switch dataSource.AuthenticationType {
+	case storepb.DataSourceAuthenticationType_DATA_SOURCE_AUTHENTICATION_TYPE_UNSPECIFIED:
+	case storepb.DataSourceAuthenticationType_VAULT_DATABASE_SECRET:
+		if dataSource.VaultDatabaseCredentialPath == "" {
+			return errors.Errorf("vault database credential path is required for the Vault database secret authentication")
+		}
+		if err := allowlist.CheckVaultPath(dataSource.VaultDatabaseCredentialPath); err != nil {
+			return err
+		}
+	case storepb.DataSourceAuthenticationType_AWS_RDS_IAM:
+		if dataSource.AWSRegion == "" {
+			return errors.Errorf("AWS region is required for the AWS RDS IAM authentication")
+		}
+		if dataSource.Username == "" {
+			return errors.Errorf("username is required for the AWS RDS IAM authentication")
+		}
+	case storepb.DataSourceAuthenticationType_GCP_CLOUD_SQL_IAM:
+		if dataSource.Username == "" {
+			return errors.Errorf("username is required for the GCP Cloud SQL IAM authentication")
+		}
+	default:
+		return errors.Errorf("unsupported authentication type %q", dataSource.AuthenticationType)
+	}
+	return nil
+}
+
+// convertToStoreDataSourceExternalSecret validates the secret references against the allowlist and converts them to the store message.
+func convertToStoreDataSourceExternalSecret(externalSecret *v1pb.DataSourceExternalSecret, allowlist *secret.Allowlist) (*storepb.DataSourceExternalSecret, error) {
+	if externalSecret == nil {
+		return nil, nil
+	}
+	for _, reference := range []string{
+		externalSecret.Password,
+		externalSecret.SslCa,
+		externalSecret.SslCert,
+		externalSecret.SslKey,
+		externalSecret.SshPassword,
+		externalSecret.SshPrivateKey,
+	} {
+		if reference == "" {
+			continue
+		}
+		ref, err := secret.ParseReference(reference)
+		if err != nil {
+			return nil, err
+		}
+		if _, err := secret.Get(ref.Scheme, secret.ProviderConfig{}); err != nil {
+			return nil, err
+		}
+		if err := allowlist.Check(ref); err != nil {
+			return nil, err
+		}
+	}
+	return &storepb.DataSourceExternalSecret{
+		Password:      externalSecret.Password,
+		SslCa:         externalSecret.SslCa,
+		SslCert:       externalSecret.SslCert,
+		SslKey:        externalSecret.SslKey,
+		SshPassword:   externalSecret.SshPassword,
+		SshPrivateKey: externalSecret.SshPrivateKey,
 	}, nil
 }
 
+func convertToV1DataSourceExternalSecret(externalSecret *storepb.DataSourceExternalSecret) *v1pb.DataSourceExternalSecret {
+	if externalSecret == nil {
+		return nil
+	}
+	return &v1pb.DataSourceExternalSecret{
+		Password:      externalSecret.Password,
+		SslCa:         externalSecret.SslCa,
+		SslCert:       externalSecret.SslCert,
+		SslKey:        externalSecret.SslKey,
+		SshPassword:   externalSecret.SshPassword,
+		SshPrivateKey: externalSecret.SshPrivateKey,
+	}
+}
+
 func convertDataSourceTp(tp v1pb.DataSourceType) (api.DataSourceType, error) {
 	var dsType api.DataSourceType
 	switch tp {
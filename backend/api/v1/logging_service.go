@@ -2,6 +2,7 @@ package v1
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"strconv"
 	"strings"
@@ -116,6 +117,10 @@ func (s *LoggingService) ListLogs(ctx context.Context, request *v1pb.ListLogsReq
 		activityFind.Order = &order
 	}
 
+	// sqlResource is the table or column accessed by the SQL statements, matched against the SQL editor query and export payloads.
+	sqlResource := make(map[string]any)
+	var sqlInstanceUID *int
+	var sqlColumn string
 	for _, spec := range filters {
 		switch spec.key {
 		case "creator":
@@ -232,10 +237,69 @@ func (s *LoggingService) ListLogs(ctx context.Context, request *v1pb.ListLogsReq
 			} else {
 				activityFind.CreatedTsBefore = &ts
 			}
+		case "database":
+			if spec.operator != comparatorTypeEqual {
+				return nil, status.Errorf(codes.InvalidArgument, `only support "=" operation for "database" filter`)
+			}
+			instanceID, databaseName, err := getInstanceDatabaseID(spec.value)
+			if err != nil {
+				return nil, status.Errorf(codes.InvalidArgument, err.Error())
+			}
+			instance, err := s.store.GetInstanceV2(ctx, &store.FindInstanceMessage{
+				ResourceID:  &instanceID,
+				ShowDeleted: true,
+			})
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, err.Error())
+			}
+			if instance == nil {
+				return nil, status.Errorf(codes.NotFound, "instance %q not found", instanceID)
+			}
+			sqlInstanceUID = &instance.UID
+			sqlResource["database"] = databaseName
+		case "table":
+			if spec.operator != comparatorTypeEqual {
+				return nil, status.Errorf(codes.InvalidArgument, `only support "=" operation for "table" filter`)
+			}
+			if schema, table, ok := strings.Cut(spec.value, "."); ok {
+				sqlResource["schema"] = schema
+				sqlResource["table"] = table
+			} else {
+				sqlResource["table"] = spec.value
+			}
+		case "column":
+			if spec.operator != comparatorTypeEqual {
+				return nil, status.Errorf(codes.InvalidArgument, `only support "=" operation for "column" filter`)
+			}
+			if spec.value == "" {
+				return nil, status.Errorf(codes.InvalidArgument, "invalid empty column")
+			}
+			sqlColumn = spec.value
 		default:
 			return nil, status.Errorf(codes.InvalidArgument, "invalid filter %s", spec.key)
 		}
 	}
+	if sqlInstanceUID != nil || len(sqlResource) > 0 || sqlColumn != "" {
+		payload := make(map[string]any)
+		if sqlInstanceUID != nil {
+			payload["instanceId"] = *sqlInstanceUID
+		}
+		if sqlColumn != "" {
+			sqlResource["column"] = sqlColumn
+			payload["fields"] = []any{sqlResource}
+		} else {
+			payload["resources"] = []any{sqlResource}
+		}
+		payloadBytes, err := json.Marshal(payload)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to marshal payload filter: %v", err)
+		}
+		payloadContains := string(payloadBytes)
+		activityFind.PayloadContains = &payloadContains
+		if len(activityFind.TypeList) == 0 {
+			activityFind.TypeList = []api.ActivityType{api.ActivitySQLEditorQuery, api.ActivitySQLExport}
+		}
+	}
 
 	activityList, err := s.store.ListActivityV2(ctx, activityFind)
 	if err != nil {
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"path"
 	"reflect"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
@@ -14,24 +15,32 @@ import (
 	"github.com/gosimple/slug"
 	"github.com/pkg/errors"
 	"go.uber.org/zap"
+	"google.golang.org/genproto/googleapis/type/expr"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 	"gopkg.in/yaml.v3"
 
 	"github.com/bytebase/bytebase/backend/common/log"
 	"github.com/bytebase/bytebase/backend/component/activity"
 	enterpriseAPI "github.com/bytebase/bytebase/backend/enterprise/api"
 	vcsPlugin "github.com/bytebase/bytebase/backend/plugin/vcs"
+	"github.com/bytebase/bytebase/backend/plugin/vcs/azure"
 	"github.com/bytebase/bytebase/backend/plugin/vcs/bitbucket"
+	"github.com/bytebase/bytebase/backend/plugin/vcs/gitea"
 	"github.com/bytebase/bytebase/backend/plugin/vcs/github"
 	"github.com/bytebase/bytebase/backend/plugin/vcs/gitlab"
 	webhookPlugin "github.com/bytebase/bytebase/backend/plugin/webhook"
+	"github.com/bytebase/bytebase/backend/runner/accessgrant"
 	"github.com/bytebase/bytebase/backend/utils"
 
 	"github.com/bytebase/bytebase/backend/common"
 	api "github.com/bytebase/bytebase/backend/legacyapi"
 	"github.com/bytebase/bytebase/backend/store"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
 	v1pb "github.com/bytebase/bytebase/proto/generated-go/v1"
 )
 
@@ -354,6 +363,236 @@ func (s *ProjectService) SetIamPolicy(ctx context.Context, request *v1pb.SetIamP
 	return iamPolicy, nil
 }
 
+// ListAccessGrants lists the active access grants of the approved grant requests.
+func (s *ProjectService) ListAccessGrants(ctx context.Context, request *v1pb.ListAccessGrantsRequest) (*v1pb.ListAccessGrantsResponse, error) {
+	projectID, err := getProjectID(request.Parent)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	find := &store.FindProjectMessage{}
+	if projectID != "-" {
+		find.ResourceID = &projectID
+	}
+	projects, err := s.store.ListProjectV2(ctx, find)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, err.Error())
+	}
+	if projectID != "-" && len(projects) == 0 {
+		return nil, status.Errorf(codes.NotFound, "project %q not found", request.Parent)
+	}
+
+	now := time.Now()
+	response := &v1pb.ListAccessGrantsResponse{}
+	for _, project := range projects {
+		grants, err := s.listAccessGrants(ctx, project, now)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to list access grants of project %q, error: %v", project.ResourceID, err)
+		}
+		for _, grant := range grants {
+			accessGrant := convertToAccessGrant(project, grant)
+			queries, err := s.listAccessGrantQueries(ctx, project, grant, now)
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to list queries of access grant %q, error: %v", accessGrant.Name, err)
+			}
+			accessGrant.Queries = queries
+			response.AccessGrants = append(response.AccessGrants, accessGrant)
+		}
+	}
+	// The grants expiring earlier come first, and the grants never expire come last.
+	sort.SliceStable(response.AccessGrants, func(i, j int) bool {
+		a, b := response.AccessGrants[i].ExpireTime, response.AccessGrants[j].ExpireTime
+		if a == nil || b == nil {
+			return a != nil
+		}
+		return a.AsTime().Before(b.AsTime())
+	})
+	return response, nil
+}
+
+// RevokeAccessGrant removes the access grant from the project IAM policy.
+func (s *ProjectService) RevokeAccessGrant(ctx context.Context, request *v1pb.RevokeAccessGrantRequest) (*v1pb.AccessGrant, error) {
+	projectID, issueUID, err := getProjectIDAccessGrantID(request.Name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	principalID, ok := ctx.Value(common.PrincipalIDContextKey).(int)
+	if !ok {
+		return nil, status.Errorf(codes.Internal, "cannot get principal ID from context")
+	}
+	principal, err := s.store.GetUserByID(ctx, principalID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, err.Error())
+	}
+	if principal == nil {
+		return nil, status.Errorf(codes.NotFound, "user %d not found", principalID)
+	}
+	project, err := s.getProjectMessage(ctx, fmt.Sprintf("%s%s", projectNamePrefix, projectID))
+	if err != nil {
+		return nil, err
+	}
+	if project.Deleted {
+		return nil, status.Errorf(codes.InvalidArgument, "project %q has been deleted", projectID)
+	}
+
+	grants, err := s.listAccessGrants(ctx, project, time.Now())
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list access grants, error: %v", err)
+	}
+	var grant *projectAccessGrant
+	for _, g := range grants {
+		if g.issue.UID == issueUID {
+			grant = g
+			break
+		}
+	}
+	if grant == nil {
+		return nil, status.Errorf(codes.NotFound, "access grant %q not found", request.Name)
+	}
+	revoked, err := accessgrant.Revoke(ctx, s.store, s.activityManager, project, []*accessgrant.Member{grant.member}, principalID, fmt.Sprintf("it is revoked by %s", principal.Name))
+	if err != nil {
+		if common.ErrorCode(err) == common.Conflict {
+			return nil, status.Errorf(codes.FailedPrecondition, "failed to revoke access grant, error: %v", err)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to revoke access grant, error: %v", err)
+	}
+	if len(revoked) == 0 {
+		return nil, status.Errorf(codes.NotFound, "access grant %q not found", request.Name)
+	}
+	return convertToAccessGrant(project, grant), nil
+}
+
+// projectAccessGrant is the project IAM policy binding member granted by an approved grant request issue.
+type projectAccessGrant struct {
+	issue      *store.IssueMessage
+	member     *accessgrant.Member
+	condition  *expr.Expr
+	expireTime *time.Time
+}
+
+// listAccessGrants lists the grants of the approved grant request issues which are still in the project IAM policy and not expired.
+func (s *ProjectService) listAccessGrants(ctx context.Context, project *store.ProjectMessage, now time.Time) ([]*projectAccessGrant, error) {
+	issues, err := s.store.ListIssueV2(ctx, &store.FindIssueMessage{
+		ProjectUID: &project.UID,
+		StatusList: []api.IssueStatus{api.IssueDone},
+		TypeList:   []api.IssueType{api.IssueGrantRequest},
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list grant request issues")
+	}
+	if len(issues) == 0 {
+		return nil, nil
+	}
+	policy, err := s.store.GetProjectPolicy(ctx, &store.GetProjectPolicyMessage{UID: &project.UID})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get project policy")
+	}
+
+	var grants []*projectAccessGrant
+	for _, issue := range issues {
+		payload := &storepb.IssuePayload{}
+		if err := protojson.Unmarshal([]byte(issue.Payload), payload); err != nil {
+			return nil, errors.Wrapf(err, "failed to unmarshal the payload of issue %d", issue.UID)
+		}
+		grantRequest := payload.GrantRequest
+		if grantRequest == nil {
+			continue
+		}
+		role := api.Role(strings.TrimPrefix(grantRequest.Role, rolePrefix))
+		userID, err := getUserID(grantRequest.User)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid user in the grant request of issue %d", issue.UID)
+		}
+		expression := grantRequest.Condition.GetExpression()
+		expireTime, err := accessgrant.GetExpireTime(expression)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to get the expire time of issue %d", issue.UID)
+		}
+		if expireTime != nil && !expireTime.After(now) {
+			continue
+		}
+		for _, binding := range policy.Bindings {
+			if binding.Role != role || binding.Condition.GetExpression() != expression {
+				continue
+			}
+			for _, user := range binding.Members {
+				if user.ID != userID {
+					continue
+				}
+				grants = append(grants, &projectAccessGrant{
+					issue:      issue,
+					member:     &accessgrant.Member{Role: role, Expression: expression, User: user},
+					condition:  binding.Condition,
+					expireTime: expireTime,
+				})
+			}
+		}
+	}
+	return grants, nil
+}
+
+// listAccessGrantQueries lists the SQL Editor queries executed by the user on the databases of the project during the grant window.
+func (s *ProjectService) listAccessGrantQueries(ctx context.Context, project *store.ProjectMessage, grant *projectAccessGrant, now time.Time) ([]*v1pb.AccessGrantQuery, error) {
+	grantTs := grant.issue.UpdatedTime.Unix()
+	endTs := now.Unix()
+	if grant.expireTime != nil && grant.expireTime.Before(now) {
+		endTs = grant.expireTime.Unix()
+	}
+	order := api.ASC
+	activities, err := s.store.ListActivityV2(ctx, &store.FindActivityMessage{
+		CreatorUID:      &grant.member.User.ID,
+		TypeList:        []api.ActivityType{api.ActivitySQLEditorQuery},
+		CreatedTsAfter:  &grantTs,
+		CreatedTsBefore: &endTs,
+		Order:           &order,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list query activities")
+	}
+
+	databases := make(map[int]*store.DatabaseMessage)
+	var queries []*v1pb.AccessGrantQuery
+	for _, a := range activities {
+		var payload api.ActivitySQLEditorQueryPayload
+		if err := json.Unmarshal([]byte(a.Payload), &payload); err != nil {
+			return nil, errors.Wrapf(err, "failed to unmarshal the payload of activity %d", a.UID)
+		}
+		database, ok := databases[payload.DatabaseID]
+		if !ok {
+			database, err = s.store.GetDatabaseV2(ctx, &store.FindDatabaseMessage{UID: &payload.DatabaseID, ShowDeleted: true})
+			if err != nil {
+				return nil, errors.Wrapf(err, "failed to get database %d", payload.DatabaseID)
+			}
+			databases[payload.DatabaseID] = database
+		}
+		if database == nil || database.ProjectID != project.ResourceID {
+			continue
+		}
+		queries = append(queries, &v1pb.AccessGrantQuery{
+			Time:      timestamppb.New(time.Unix(a.CreatedTs, 0)),
+			Database:  fmt.Sprintf("%s%s/%s%s", instanceNamePrefix, database.InstanceID, databaseIDPrefix, database.DatabaseName),
+			Statement: payload.Statement,
+			Duration:  durationpb.New(time.Duration(payload.DurationNs)),
+			Error:     payload.Error,
+		})
+	}
+	return queries, nil
+}
+
+func convertToAccessGrant(project *store.ProjectMessage, grant *projectAccessGrant) *v1pb.AccessGrant {
+	accessGrant := &v1pb.AccessGrant{
+		Name:      fmt.Sprintf("%s%s/%s%d", projectNamePrefix, project.ResourceID, accessGrantPrefix, grant.issue.UID),
+		Role:      convertToProjectRole(grant.member.Role),
+		User:      fmt.Sprintf("%s%d", userNamePrefix, grant.member.User.ID),
+		Condition: grant.condition,
+		Issue:     fmt.Sprintf("%s%s/%s%d", projectNamePrefix, project.ResourceID, issueNamePrefix, grant.issue.UID),
+		GrantTime: timestamppb.New(grant.issue.UpdatedTime),
+	}
+	if grant.expireTime != nil {
+		accessGrant.ExpireTime = timestamppb.New(*grant.expireTime)
+	}
+	return accessGrant
+}
+
 // GetProjectGitOpsInfo gets the GitOps info for a project.
 func (s *ProjectService) GetProjectGitOpsInfo(ctx context.Context, request *v1pb.GetProjectGitOpsInfoRequest) (*v1pb.ProjectGitOpsInfo, error) {
 	projectName, err := trimSuffix(request.Name, gitOpsInfoSuffix)
@@ -430,6 +669,10 @@ func (s *ProjectService) UpdateProjectGitOpsInfo(ctx context.Context, request *v
 			patch.SheetPathTemplate = &request.ProjectGitopsInfo.SheetPathTemplate
 		case "enable_sql_review_ci":
 			patch.EnableSQLReviewCI = &request.ProjectGitopsInfo.EnableSqlReviewCi
+		case "enable_pull_request_flow":
+			patch.EnablePullRequestFlow = &request.ProjectGitopsInfo.EnablePullRequestFlow
+		case "enable_schema_write_back_pull_request":
+			patch.EnableSchemaWriteBackPullRequest = &request.ProjectGitopsInfo.EnableSchemaWriteBackPullRequest
 		}
 	}
 
@@ -481,6 +724,16 @@ func (s *ProjectService) UpdateProjectGitOpsInfo(ctx context.Context, request *v
 		return nil, status.Errorf(codes.InvalidArgument, "invalid base directory and filepath template combination: %v", err.Error())
 	}
 
+	if patch.BaseDirectory != nil || patch.BranchFilter != nil {
+		newBranchFilter := repo.BranchFilter
+		if patch.BranchFilter != nil {
+			newBranchFilter = *patch.BranchFilter
+		}
+		if err := s.validateRepositoryMapping(ctx, repo.WebURL, project.ResourceID, newBaseDirectory, newBranchFilter); err != nil {
+			return nil, err
+		}
+	}
+
 	updatedRepo, err := s.store.PatchRepositoryV2(ctx, patch, ctx.Value(common.PrincipalIDContextKey).(int))
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to update repository with error: %v", err.Error())
@@ -836,20 +1089,22 @@ func (s *ProjectService) createProjectGitOpsInfo(ctx context.Context, request *v
 	}
 
 	repositoryCreate := &store.RepositoryMessage{
-		VCSUID:             int(vcsID),
-		ProjectResourceID:  project.ResourceID,
-		WebhookURLHost:     setting.ExternalUrl,
-		Title:              request.ProjectGitopsInfo.Title,
-		FullPath:           request.ProjectGitopsInfo.FullPath,
-		WebURL:             request.ProjectGitopsInfo.WebUrl,
-		BranchFilter:       request.ProjectGitopsInfo.BranchFilter,
-		BaseDirectory:      request.ProjectGitopsInfo.BaseDirectory,
-		FilePathTemplate:   request.ProjectGitopsInfo.FilePathTemplate,
-		SchemaPathTemplate: request.ProjectGitopsInfo.SchemaPathTemplate,
-		SheetPathTemplate:  request.ProjectGitopsInfo.SheetPathTemplate,
-		ExternalID:         request.ProjectGitopsInfo.ExternalId,
-		AccessToken:        request.ProjectGitopsInfo.AccessToken,
-		RefreshToken:       request.ProjectGitopsInfo.RefreshToken,
+		VCSUID:                           int(vcsID),
+		ProjectResourceID:                project.ResourceID,
+		WebhookURLHost:                   setting.ExternalUrl,
+		Title:                            request.ProjectGitopsInfo.Title,
+		FullPath:                         request.ProjectGitopsInfo.FullPath,
+		WebURL:                           request.ProjectGitopsInfo.WebUrl,
+		BranchFilter:                     request.ProjectGitopsInfo.BranchFilter,
+		BaseDirectory:                    request.ProjectGitopsInfo.BaseDirectory,
+		FilePathTemplate:                 request.ProjectGitopsInfo.FilePathTemplate,
+		SchemaPathTemplate:               request.ProjectGitopsInfo.SchemaPathTemplate,
+		SheetPathTemplate:                request.ProjectGitopsInfo.SheetPathTemplate,
+		ExternalID:                       request.ProjectGitopsInfo.ExternalId,
+		AccessToken:                      request.ProjectGitopsInfo.AccessToken,
+		RefreshToken:                     request.ProjectGitopsInfo.RefreshToken,
+		EnablePullRequestFlow:            request.ProjectGitopsInfo.EnablePullRequestFlow,
+		EnableSchemaWriteBackPullRequest: request.ProjectGitopsInfo.EnableSchemaWriteBackPullRequest,
 	}
 	if request.ProjectGitopsInfo.ExpiresTime != nil {
 		repositoryCreate.ExpiresTs = request.ProjectGitopsInfo.ExpiresTime.AsTime().Unix()
@@ -901,6 +1156,12 @@ func (s *ProjectService) createProjectGitOpsInfo(ctx context.Context, request *v
 		}
 	}
 
+	// Remove enclosing /
+	repositoryCreate.BaseDirectory = strings.Trim(repositoryCreate.BaseDirectory, "/")
+	if err := s.validateRepositoryMapping(ctx, repositoryCreate.WebURL, project.ResourceID, repositoryCreate.BaseDirectory, repositoryCreate.BranchFilter); err != nil {
+		return nil, err
+	}
+
 	// For a particular VCS repo, all Bytebase projects share the same webhook.
 	repositories, err := s.store.ListRepositoryV2(ctx, &store.FindRepositoryMessage{
 		WebURL: &repositoryCreate.WebURL,
@@ -939,8 +1200,6 @@ func (s *ProjectService) createProjectGitOpsInfo(ctx context.Context, request *v
 		repositoryCreate.ExternalWebhookID = webhookID
 	}
 
-	// Remove enclosing /
-	repositoryCreate.BaseDirectory = strings.Trim(repositoryCreate.BaseDirectory, "/")
 	repository, err := s.store.CreateRepositoryV2(ctx, repositoryCreate, ctx.Value(common.PrincipalIDContextKey).(int))
 	if err != nil {
 		if common.ErrorCode(err) == common.Conflict {
@@ -952,7 +1211,37 @@ func (s *ProjectService) createProjectGitOpsInfo(ctx context.Context, request *v
 	return convertToProjectGitOpsInfo(repository), nil
 }
 
+// validateRepositoryMapping validates the mapping from the VCS repository to the project.
+// A repository can be linked to multiple projects, e.g. a monorepo containing the migrations of
+// several services. The push events are routed to the project with the most specific base directory,
+// so the projects cannot map the same base directory on the same branch.
+func (s *ProjectService) validateRepositoryMapping(ctx context.Context, webURL, projectID, baseDirectory, branchFilter string) error {
+	repositories, err := s.store.ListRepositoryV2(ctx, &store.FindRepositoryMessage{
+		WebURL: &webURL,
+	})
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to find repository with web url %s: %v", webURL, err.Error())
+	}
+	for _, repository := range repositories {
+		if repository.ProjectResourceID == projectID {
+			continue
+		}
+		if repository.BaseDirectory == baseDirectory && repository.BranchFilter == branchFilter {
+			return status.Errorf(codes.AlreadyExists, "base directory %q on branch %q of repository %s is already linked to project %s", baseDirectory, branchFilter, webURL, repository.ProjectResourceID)
+		}
+	}
+	return nil
+}
+
 func (s *ProjectService) setupVCSSQLReviewCI(ctx context.Context, repository *store.RepositoryMessage, vcs *store.ExternalVersionControlMessage) (*vcsPlugin.PullRequest, error) {
+	if vcs.Type == vcsPlugin.AzureDevOps {
+		// Azure DevOps pull requests are reviewed through the service hooks created with the
+		// repository, so there is no CI file to set up. Guide users to the pull requests instead.
+		return &vcsPlugin.PullRequest{
+			URL: fmt.Sprintf("%s/pullrequests", repository.WebURL),
+		}, nil
+	}
+
 	setting, err := s.store.GetWorkspaceGeneralSetting(ctx)
 	if err != nil {
 		return nil, err
@@ -991,6 +1280,10 @@ func (s *ProjectService) setupVCSSQLReviewCI(ctx context.Context, repository *st
 		if err := s.setupVCSSQLReviewCIForGitLab(ctx, repository, vcs, branch, sqlReviewEndpoint); err != nil {
 			return nil, err
 		}
+	case vcsPlugin.Gitea:
+		if err := s.setupVCSSQLReviewCIForGitea(ctx, repository, vcs, branch, sqlReviewEndpoint); err != nil {
+			return nil, err
+		}
 	}
 
 	return vcsPlugin.Get(vcs.Type, vcsPlugin.ProviderConfig{}).CreatePullRequest(
@@ -1118,6 +1411,46 @@ func (s *ProjectService) setupVCSSQLReviewCIForGitHub(
 	)
 }
 
+// setupVCSSQLReviewCIForGitea will create the pull request in Gitea to setup SQL review action.
+// Unlike GitHub, Gitea uses different APIs to create and update the file.
+func (s *ProjectService) setupVCSSQLReviewCIForGitea(
+	ctx context.Context,
+	repository *store.RepositoryMessage,
+	vcs *store.ExternalVersionControlMessage,
+	branch *vcsPlugin.BranchInfo,
+	sqlReviewEndpoint string,
+) error {
+	oauthContext := common.OauthContext{
+		ClientID:     vcs.ApplicationID,
+		ClientSecret: vcs.Secret,
+		AccessToken:  repository.AccessToken,
+		RefreshToken: repository.RefreshToken,
+		Refresher:    utils.RefreshToken(ctx, s.store, repository.WebURL),
+	}
+	provider := vcsPlugin.Get(vcs.Type, vcsPlugin.ProviderConfig{})
+	fileCommit := vcsPlugin.FileCommitCreate{
+		Branch:        branch.Name,
+		CommitMessage: sqlReviewInVCSPRTitle,
+		Content:       gitea.SetupSQLReviewCI(sqlReviewEndpoint),
+	}
+
+	fileMeta, err := provider.ReadFileMeta(ctx, oauthContext, vcs.InstanceURL, repository.ExternalID, gitea.SQLReviewActionFilePath, branch.Name)
+	if err != nil {
+		log.Debug(
+			"Failed to get file meta",
+			zap.String("file", gitea.SQLReviewActionFilePath),
+			zap.String("last_commit", branch.LastCommitID),
+			zap.Int("code", common.ErrorCode(err).Int()),
+			zap.Error(err),
+		)
+		return provider.CreateFile(ctx, oauthContext, vcs.InstanceURL, repository.ExternalID, gitea.SQLReviewActionFilePath, fileCommit)
+	}
+
+	fileCommit.LastCommitID = fileMeta.LastCommitID
+	fileCommit.SHA = fileMeta.SHA
+	return provider.OverwriteFile(ctx, oauthContext, vcs.InstanceURL, repository.ExternalID, gitea.SQLReviewActionFilePath, fileCommit)
+}
+
 // setupVCSSQLReviewCIForGitLab will create or update SQL review related files in GitLab to setup SQL review CI.
 func (s *ProjectService) setupVCSSQLReviewCIForGitLab(
 	ctx context.Context,
@@ -2715,19 +3048,21 @@ func isProjectMember(policy *store.IAMPolicyMessage, userID int) bool {
 
 func convertToProjectGitOpsInfo(repository *store.RepositoryMessage) *v1pb.ProjectGitOpsInfo {
 	return &v1pb.ProjectGitOpsInfo{
-		Name:               fmt.Sprintf("%s%s/gitOpsInfo", projectNamePrefix, repository.ProjectResourceID),
-		VcsUid:             fmt.Sprintf("%d", repository.VCSUID),
-		Title:              repository.Title,
-		FullPath:           repository.FullPath,
-		WebUrl:             repository.WebURL,
-		BranchFilter:       repository.BranchFilter,
-		BaseDirectory:      repository.BaseDirectory,
-		FilePathTemplate:   repository.FilePathTemplate,
-		SchemaPathTemplate: repository.SchemaPathTemplate,
-		SheetPathTemplate:  repository.SheetPathTemplate,
-		EnableSqlReviewCi:  repository.EnableSQLReviewCI,
-		WebhookEndpointId:  repository.WebhookEndpointID,
-		ExternalId:         repository.ExternalID,
+		Name:                             fmt.Sprintf("%s%s/gitOpsInfo", projectNamePrefix, repository.ProjectResourceID),
+		VcsUid:                           fmt.Sprintf("%d", repository.VCSUID),
+		Title:                            repository.Title,
+		FullPath:                         repository.FullPath,
+		WebUrl:                           repository.WebURL,
+		BranchFilter:                     repository.BranchFilter,
+		BaseDirectory:                    repository.BaseDirectory,
+		FilePathTemplate:                 repository.FilePathTemplate,
+		SchemaPathTemplate:               repository.SchemaPathTemplate,
+		SheetPathTemplate:                repository.SheetPathTemplate,
+		EnableSqlReviewCi:                repository.EnableSQLReviewCI,
+		WebhookEndpointId:                repository.WebhookEndpointID,
+		ExternalId:                       repository.ExternalID,
+		EnablePullRequestFlow:            repository.EnablePullRequestFlow,
+		EnableSchemaWriteBackPullRequest: repository.EnableSchemaWriteBackPullRequest,
 	}
 }
 
@@ -2763,6 +3098,7 @@ func createVCSWebhook(ctx context.Context, vcsType vcsPlugin.Type, webhookEndpoi
 			URL:                   fmt.Sprintf("%s/hook/gitlab/%s", gitopsWebhookURL, webhookEndpointID),
 			SecretToken:           secretToken,
 			PushEvents:            true,
+			MergeRequestsEvents:   true,  // Used by the pull request flow, ignored until the flow is enabled.
 			EnableSSLVerification: false, // TODO(tianzhou): This is set to false, be lax to not enable_ssl_verification
 		}
 		webhookCreatePayload, err = json.Marshal(webhookCreate)
@@ -2777,7 +3113,8 @@ func createVCSWebhook(ctx context.Context, vcsType vcsPlugin.Type, webhookEndpoi
 				Secret:      secretToken,
 				InsecureSSL: 1, // TODO: Allow user to specify this value through api.RepositoryCreate
 			},
-			Events: []string{"push"},
+			// The pull request events are used for the pull request flow, they are ignored until the flow is enabled.
+			Events: []string{string(github.WebhookPush), string(github.WebhookPullRequest)},
 		}
 		webhookCreatePayload, err = json.Marshal(webhookPost)
 		if err != nil {
@@ -2788,7 +3125,43 @@ func createVCSWebhook(ctx context.Context, vcsType vcsPlugin.Type, webhookEndpoi
 			Description: "Bytebase GitOps",
 			URL:         fmt.Sprintf("%s/hook/bitbucket/%s", gitopsWebhookURL, webhookEndpointID),
 			Active:      true,
-			Events:      []string{"repo:push"},
+			// The pull request events are used for the pull request flow, they are ignored until the flow is enabled.
+			Events: []string{
+				"repo:push",
+				"pullrequest:created",
+				"pullrequest:updated",
+				"pullrequest:fulfilled",
+				"pullrequest:rejected",
+			},
+		}
+		webhookCreatePayload, err = json.Marshal(webhookPost)
+		if err != nil {
+			return "", errors.Wrap(err, "failed to marshal request body for creating webhook")
+		}
+	case vcsPlugin.AzureDevOps:
+		webhookPost := azure.WebhookCreateOrUpdate{
+			URL:      fmt.Sprintf("%s/hook/azure/%s", gitopsWebhookURL, webhookEndpointID),
+			Username: "bytebase",
+			Password: secretToken,
+			// The pull request events are used for SQL review and the pull request flow, they are ignored
+			// until the SQL review CI or the pull request flow is enabled.
+			EventTypes: []azure.WebhookEventType{azure.WebhookPush, azure.WebhookPullRequestCreated, azure.WebhookPullRequestUpdated},
+		}
+		webhookCreatePayload, err = json.Marshal(webhookPost)
+		if err != nil {
+			return "", errors.Wrap(err, "failed to marshal request body for creating webhook")
+		}
+	case vcsPlugin.Gitea:
+		webhookPost := gitea.WebhookCreateOrUpdate{
+			Type: "gitea",
+			Config: gitea.WebhookConfig{
+				URL:         fmt.Sprintf("%s/hook/gitea/%s", gitopsWebhookURL, webhookEndpointID),
+				ContentType: "json",
+				Secret:      secretToken,
+			},
+			// The pull request events are used for the pull request flow, they are ignored until the flow is enabled.
+			Events: []string{string(gitea.WebhookPush), string(gitea.WebhookPullRequest)},
+			Active: true,
 		}
 		webhookCreatePayload, err = json.Marshal(webhookPost)
 		if err != nil {
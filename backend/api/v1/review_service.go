@@ -10,6 +10,7 @@ import (
 
 	"go.uber.org/multierr"
 	"go.uber.org/zap"
+	"golang.org/x/exp/slices"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/encoding/protojson"
@@ -98,6 +99,55 @@ func (s *ReviewService) GetReview(ctx context.Context, request *v1pb.GetReviewRe
 	return review, nil
 }
 
+// ListOverdueReviews lists the open reviews whose pending approval step is overdue.
+func (s *ReviewService) ListOverdueReviews(ctx context.Context, request *v1pb.ListOverdueReviewsRequest) (*v1pb.ListOverdueReviewsResponse, error) {
+	projectID, err := getProjectID(request.Parent)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	find := &store.FindIssueMessage{
+		StatusList: []api.IssueStatus{api.IssueOpen},
+	}
+	if projectID != "-" {
+		project, err := s.store.GetProjectV2(ctx, &store.FindProjectMessage{ResourceID: &projectID})
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get project, error: %v", err)
+		}
+		if project == nil || project.Deleted {
+			return nil, status.Errorf(codes.NotFound, "project %q not found", projectID)
+		}
+		find.ProjectUID = &project.UID
+	}
+	issues, err := s.store.ListIssueV2(ctx, find)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list issues, error: %v", err)
+	}
+
+	now := time.Now()
+	var reviews []*v1pb.Review
+	for _, issue := range issues {
+		payload := &storepb.IssuePayload{}
+		if err := protojson.Unmarshal([]byte(issue.Payload), payload); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to unmarshal issue payload, error: %v", err)
+		}
+		dueTime := utils.GetApprovalDueTime(payload.Approval)
+		if dueTime == nil || dueTime.After(now) {
+			continue
+		}
+		review, err := convertToReview(ctx, s.store, issue)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to convert to review, error: %v", err)
+		}
+		reviews = append(reviews, review)
+	}
+	slices.SortFunc(reviews, func(a, b *v1pb.Review) bool {
+		return a.ApprovalDueTime.AsTime().Before(b.ApprovalDueTime.AsTime())
+	})
+	return &v1pb.ListOverdueReviewsResponse{
+		Reviews: reviews,
+	}, nil
+}
+
 // ApproveReview approves the approval flow of the review.
 func (s *ReviewService) ApproveReview(ctx context.Context, request *v1pb.ApproveReviewRequest) (*v1pb.Review, error) {
 	issue, err := s.getIssue(ctx, request.Name)
@@ -142,17 +192,35 @@ func (s *ReviewService) ApproveReview(ctx context.Context, request *v1pb.Approve
 		return nil, status.Errorf(codes.Internal, "failed to get project policy, error: %v", err)
 	}
 
-	canApprove, err := isUserReviewer(step, user, policy)
+	if step.ForbidSelfApproval && issue.Creator.ID == principalID {
+		return nil, status.Errorf(codes.PermissionDenied, "cannot approve because the issue creator cannot approve their own issue")
+	}
+	stepApprovers := utils.FindPendingStepApprovers(payload.Approval.ApprovalTemplates[0], payload.Approval.Approvers)
+	for _, approver := range stepApprovers {
+		if int(approver.PrincipalId) == principalID {
+			return nil, status.Errorf(codes.InvalidArgument, "cannot approve because the user has approved the step")
+		}
+	}
+	nodeIndex, err := findReviewerNode(step, stepApprovers, user, policy)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to check if principal can approve step, error: %v", err)
 	}
-	if !canApprove {
-		return nil, status.Errorf(codes.PermissionDenied, "cannot approve because the user does not have the required permission")
+	if nodeIndex < 0 {
+		isEscalationReviewer, err := isEscalationReviewer(payload.Approval, user, policy)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to check if principal can approve escalated step, error: %v", err)
+		}
+		if !isEscalationReviewer {
+			return nil, status.Errorf(codes.PermissionDenied, "cannot approve because the user does not have the required permission")
+		}
+		// The escalation approval is recorded on the virtual node after the step nodes.
+		nodeIndex = len(step.Nodes)
 	}
 
 	payload.Approval.Approvers = append(payload.Approval.Approvers, &storepb.IssuePayloadApproval_Approver{
 		Status:      storepb.IssuePayloadApproval_Approver_APPROVED,
 		PrincipalId: int32(principalID),
+		NodeIndex:   int32(nodeIndex),
 	})
 
 	approved, err := utils.CheckApprovalApproved(payload.Approval)
@@ -374,6 +442,12 @@ func (s *ReviewService) RejectReview(ctx context.Context, request *v1pb.RejectRe
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to check if principal can reject step, error: %v", err)
 	}
+	if !canApprove {
+		canApprove, err = isEscalationReviewer(payload.Approval, user, policy)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to check if principal can reject escalated step, error: %v", err)
+		}
+	}
 	if !canApprove {
 		return nil, status.Errorf(codes.PermissionDenied, "cannot reject because the user does not have the required permission")
 	}
@@ -479,6 +553,8 @@ func (s *ReviewService) RequestReview(ctx context.Context, request *v1pb.Request
 		newApprovers = append(newApprovers, approver)
 	}
 	payload.Approval.Approvers = newApprovers
+	// The SLA is tracked from the new request.
+	payload.Approval.SlaState = nil
 
 	newApprovers, activityCreates, err := utils.HandleIncomingApprovalSteps(ctx, s.store, s.relayRunner.Client, issue, payload.Approval)
 	if err != nil {
@@ -554,40 +630,58 @@ func (s *ReviewService) UpdateReview(ctx context.Context, request *v1pb.UpdateRe
 		return nil, err
 	}
 
+	payload := &storepb.IssuePayload{}
+	if err := protojson.Unmarshal([]byte(issue.Payload), payload); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to unmarshal issue payload, error: %v", err)
+	}
 	patch := &store.UpdateIssueMessage{}
 	for _, path := range request.UpdateMask.Paths {
-		if path == "approval_finding_done" {
+		switch path {
+		case "approval_finding_done":
 			if request.Review.ApprovalFindingDone {
 				return nil, status.Errorf(codes.InvalidArgument, "cannot set approval_finding_done to true")
 			}
-			payload := &storepb.IssuePayload{}
-			if err := protojson.Unmarshal([]byte(issue.Payload), payload); err != nil {
-				return nil, status.Errorf(codes.Internal, "failed to unmarshal issue payload, error: %v", err)
-			}
 			if payload.Approval == nil {
 				return nil, status.Errorf(codes.Internal, "issue payload approval is nil")
 			}
 			if !payload.Approval.ApprovalFindingDone {
 				return nil, status.Errorf(codes.FailedPrecondition, "approval template finding is not done")
 			}
-			payloadBytes, err := protojson.Marshal(&storepb.IssuePayload{
-				Approval: &storepb.IssuePayloadApproval{
-					ApprovalFindingDone: false,
-				},
-			})
-			if err != nil {
-				return nil, status.Errorf(codes.Internal, "failed to marshal issue payload, error: %v", err)
+			payload.Approval = &storepb.IssuePayloadApproval{
+				ApprovalFindingDone: false,
 			}
-			payloadStr := string(payloadBytes)
-			patch.Payload = &payloadStr
 
 			if issue.PipelineUID != nil {
 				if err := s.taskCheckScheduler.SchedulePipelineTaskCheckReport(ctx, *issue.PipelineUID); err != nil {
 					return nil, status.Errorf(codes.Internal, "failed to schedule pipeline task check report, error: %v", err)
 				}
 			}
+		case "labels":
+			if issue.Status != api.IssueOpen {
+				return nil, status.Errorf(codes.FailedPrecondition, "cannot update the labels of a closed review")
+			}
+			labels, err := utils.NormalizeIssueLabels(request.Review.Labels)
+			if err != nil {
+				return nil, status.Errorf(codes.InvalidArgument, "invalid labels, error: %v", err)
+			}
+			if slices.Equal(labels, payload.Labels) {
+				continue
+			}
+			payload.Labels = labels
+			// The labels are approval factors, so the approval template is found again.
+			payload.Approval = &storepb.IssuePayloadApproval{
+				ApprovalFindingDone: false,
+			}
 		}
 	}
+	if len(request.UpdateMask.Paths) > 0 {
+		payloadBytes, err := protojson.Marshal(payload)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to marshal issue payload, error: %v", err)
+		}
+		payloadStr := string(payloadBytes)
+		patch.Payload = &payloadStr
+	}
 
 	issue, err = s.store.UpdateIssueV2(ctx, issue.UID, patch, principalID)
 	if err != nil {
@@ -734,13 +828,58 @@ func canRequestReview(issueCreator *store.UserMessage, user *store.UserMessage)
 }
 
 func isUserReviewer(step *storepb.ApprovalStep, user *store.UserMessage, policy *store.IAMPolicyMessage) (bool, error) {
-	if len(step.Nodes) != 1 {
-		return false, errors.Errorf("expecting one node but got %v", len(step.Nodes))
+	nodeIndex, err := findReviewerNode(step, nil, user, policy)
+	if err != nil {
+		return false, err
+	}
+	return nodeIndex >= 0, nil
+}
+
+// findReviewerNode returns the index of the node approvable by the user in the step, or -1 if there isn't one.
+// The approved nodes of the ALL step are skipped, since every node must be approved by a different user.
+func findReviewerNode(step *storepb.ApprovalStep, stepApprovers []*storepb.IssuePayloadApproval_Approver, user *store.UserMessage, policy *store.IAMPolicyMessage) (int, error) {
+	if len(step.Nodes) == 0 {
+		return -1, errors.Errorf("expecting at least one node but got 0")
 	}
-	if step.Type != storepb.ApprovalStep_ANY {
-		return false, errors.Errorf("expecting ANY step type but got %v", step.Type)
+	if step.Type != storepb.ApprovalStep_ANY && step.Type != storepb.ApprovalStep_ALL {
+		return -1, errors.Errorf("expecting ANY or ALL step type but got %v", step.Type)
 	}
-	node := step.Nodes[0]
+	approvedNodes := map[int32]bool{}
+	if step.Type == storepb.ApprovalStep_ALL {
+		for _, approver := range stepApprovers {
+			approvedNodes[approver.NodeIndex] = true
+		}
+	}
+	for i, node := range step.Nodes {
+		if approvedNodes[int32(i)] {
+			continue
+		}
+		ok, err := isNodeReviewer(node, user, policy)
+		if err != nil {
+			return -1, err
+		}
+		if ok {
+			return i, nil
+		}
+	}
+	return -1, nil
+}
+
+// isEscalationReviewer returns true if the user is in the SLA escalation node of the escalated pending step.
+func isEscalationReviewer(approval *storepb.IssuePayloadApproval, user *store.UserMessage, policy *store.IAMPolicyMessage) (bool, error) {
+	slaState := approval.GetSlaState()
+	if len(approval.ApprovalTemplates) != 1 || !slaState.GetEscalated() {
+		return false, nil
+	}
+	template := approval.ApprovalTemplates[0]
+	escalationNode := template.GetSla().GetEscalationNode()
+	if escalationNode == nil || utils.FindNextPendingStepIndex(template, approval.Approvers) != int(slaState.StepIndex) {
+		return false, nil
+	}
+	return isNodeReviewer(escalationNode, user, policy)
+}
+
+func isNodeReviewer(node *storepb.ApprovalNode, user *store.UserMessage, policy *store.IAMPolicyMessage) (bool, error) {
 	if node.Type != storepb.ApprovalNode_ANY_IN_GROUP {
 		return false, errors.Errorf("expecting ANY_IN_GROUP node type but got %v", node.Type)
 	}
@@ -774,6 +913,8 @@ func isUserReviewer(step *storepb.ApprovalStep, user *store.UserMessage, policy
 		if userHasProjectRole[val.Role] {
 			return true, nil
 		}
+	case *storepb.ApprovalNode_User:
+		return val.User == fmt.Sprintf("%s%s", userNamePrefix, user.Email), nil
 	case *storepb.ApprovalNode_ExternalNodeId:
 		return true, nil
 	default:
@@ -800,6 +941,7 @@ func convertToReview(ctx context.Context, s *store.Store, issue *store.IssueMess
 		Creator:           fmt.Sprintf("%s%s", userNamePrefix, issue.Creator.Email),
 		CreateTime:        timestamppb.New(issue.CreatedTime),
 		UpdateTime:        timestamppb.New(issue.UpdatedTime),
+		Labels:            issuePayload.Labels,
 	}
 
 	for _, subscriber := range issue.Subscribers {
@@ -820,11 +962,25 @@ func convertToReview(ctx context.Context, s *store.Store, issue *store.IssueMess
 	if issuePayload.Approval != nil {
 		review.ApprovalFindingDone = issuePayload.Approval.ApprovalFindingDone
 		review.ApprovalFindingError = issuePayload.Approval.ApprovalFindingError
+		if dueTime := utils.GetApprovalDueTime(issuePayload.Approval); dueTime != nil {
+			review.ApprovalDueTime = timestamppb.New(*dueTime)
+		}
+		review.ApprovalEscalated = issuePayload.Approval.GetSlaState().GetEscalated()
 		for _, template := range issuePayload.Approval.ApprovalTemplates {
 			review.ApprovalTemplates = append(review.ApprovalTemplates, convertToApprovalTemplate(template))
 		}
-		for _, approver := range issuePayload.Approval.Approvers {
-			convertedApprover := &v1pb.Review_Approver{Status: v1pb.Review_Approver_Status(approver.Status)}
+		var stepIndexes []int
+		if len(issuePayload.Approval.ApprovalTemplates) == 1 {
+			stepIndexes = utils.GetApproverStepIndexes(issuePayload.Approval.ApprovalTemplates[0], issuePayload.Approval.Approvers)
+		}
+		for i, approver := range issuePayload.Approval.Approvers {
+			convertedApprover := &v1pb.Review_Approver{
+				Status:    v1pb.Review_Approver_Status(approver.Status),
+				NodeIndex: approver.NodeIndex,
+			}
+			if stepIndexes != nil {
+				convertedApprover.StepIndex = int32(stepIndexes[i])
+			}
 			user, err := s.GetUserByID(ctx, int(approver.PrincipalId))
 			if err != nil {
 				return nil, errors.Wrapf(err, "failed to find user by id %v", approver.PrincipalId)
@@ -855,7 +1011,24 @@ func convertToApprovalTemplate(template *storepb.ApprovalTemplate) *v1pb.Approva
 		Flow:        convertToApprovalFlow(template.Flow),
 		Title:       template.Title,
 		Description: template.Description,
+		Sla:         convertToApprovalSLA(template.Sla),
+	}
+}
+
+func convertToApprovalSLA(sla *storepb.ApprovalSLA) *v1pb.ApprovalSLA {
+	if sla == nil {
+		return nil
+	}
+	convertedSLA := &v1pb.ApprovalSLA{
+		ReminderInterval:  sla.ReminderInterval,
+		EscalationTimeout: sla.EscalationTimeout,
+		ExpirationTimeout: sla.ExpirationTimeout,
+		ExpirationAction:  v1pb.ApprovalSLA_ExpirationAction(sla.ExpirationAction),
 	}
+	if sla.EscalationNode != nil {
+		convertedSLA.EscalationNode = convertToApprovalNode(sla.EscalationNode)
+	}
+	return convertedSLA
 }
 
 func convertToApprovalFlow(flow *storepb.ApprovalFlow) *v1pb.ApprovalFlow {
@@ -868,7 +1041,9 @@ func convertToApprovalFlow(flow *storepb.ApprovalFlow) *v1pb.ApprovalFlow {
 
 func convertToApprovalStep(step *storepb.ApprovalStep) *v1pb.ApprovalStep {
 	convertedStep := &v1pb.ApprovalStep{
-		Type: v1pb.ApprovalStep_Type(step.Type),
+		Type:               v1pb.ApprovalStep_Type(step.Type),
+		Quorum:             step.Quorum,
+		ForbidSelfApproval: step.ForbidSelfApproval,
 	}
 	for _, node := range step.Nodes {
 		convertedStep.Nodes = append(convertedStep.Nodes, convertToApprovalNode(node))
@@ -892,6 +1067,10 @@ func convertToApprovalNode(node *storepb.ApprovalNode) *v1pb.ApprovalNode {
 		v1node.Payload = &v1pb.ApprovalNode_ExternalNodeId{
 			ExternalNodeId: payload.ExternalNodeId,
 		}
+	case *storepb.ApprovalNode_User:
+		v1node.Payload = &v1pb.ApprovalNode_User{
+			User: payload.User,
+		}
 	}
 	return v1node
 }
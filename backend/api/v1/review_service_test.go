@@ -188,3 +188,80 @@ func TestCanUserApproveStep(t *testing.T) {
 		a.Equal(test.want, got)
 	}
 }
+
+func TestFindReviewerNode(t *testing.T) {
+	dbaNode := &storepb.ApprovalNode{
+		Type:    storepb.ApprovalNode_ANY_IN_GROUP,
+		Payload: &storepb.ApprovalNode_GroupValue_{GroupValue: storepb.ApprovalNode_WORKSPACE_DBA},
+	}
+	userNode := &storepb.ApprovalNode{
+		Type:    storepb.ApprovalNode_ANY_IN_GROUP,
+		Payload: &storepb.ApprovalNode_User{User: "users/alice@example.com"},
+	}
+	roleNode := &storepb.ApprovalNode{
+		Type:    storepb.ApprovalNode_ANY_IN_GROUP,
+		Payload: &storepb.ApprovalNode_Role{Role: "roles/ProjectDBA"},
+	}
+	alice := &store.UserMessage{ID: 101, Email: "alice@example.com", Role: api.DBA}
+	bob := &store.UserMessage{ID: 102, Email: "bob@example.com", Role: api.Developer}
+	policy := &store.IAMPolicyMessage{
+		Bindings: []*store.PolicyBinding{
+			{
+				Role:    "ProjectDBA",
+				Members: []*store.UserMessage{bob},
+			},
+		},
+	}
+
+	tests := []struct {
+		step          *storepb.ApprovalStep
+		stepApprovers []*storepb.IssuePayloadApproval_Approver
+		user          *store.UserMessage
+		want          int
+	}{
+		{
+			step: &storepb.ApprovalStep{Type: storepb.ApprovalStep_ANY, Nodes: []*storepb.ApprovalNode{userNode}},
+			user: alice,
+			want: 0,
+		},
+		{
+			step: &storepb.ApprovalStep{Type: storepb.ApprovalStep_ANY, Nodes: []*storepb.ApprovalNode{userNode}},
+			user: bob,
+			want: -1,
+		},
+		{
+			step: &storepb.ApprovalStep{Type: storepb.ApprovalStep_ANY, Nodes: []*storepb.ApprovalNode{userNode, roleNode}},
+			user: bob,
+			want: 1,
+		},
+		{
+			step: &storepb.ApprovalStep{Type: storepb.ApprovalStep_ALL, Nodes: []*storepb.ApprovalNode{dbaNode, userNode}},
+			user: alice,
+			want: 0,
+		},
+		{
+			// The node approved by another user is skipped in the ALL step.
+			step: &storepb.ApprovalStep{Type: storepb.ApprovalStep_ALL, Nodes: []*storepb.ApprovalNode{dbaNode, userNode}},
+			stepApprovers: []*storepb.IssuePayloadApproval_Approver{
+				{Status: storepb.IssuePayloadApproval_Approver_APPROVED, PrincipalId: 103, NodeIndex: 0},
+			},
+			user: alice,
+			want: 1,
+		},
+		{
+			step: &storepb.ApprovalStep{Type: storepb.ApprovalStep_ALL, Nodes: []*storepb.ApprovalNode{dbaNode, userNode}},
+			stepApprovers: []*storepb.IssuePayloadApproval_Approver{
+				{Status: storepb.IssuePayloadApproval_Approver_APPROVED, PrincipalId: 103, NodeIndex: 1},
+			},
+			user: bob,
+			want: -1,
+		},
+	}
+
+	a := require.New(t)
+	for _, test := range tests {
+		got, err := findReviewerNode(test.step, test.stepApprovers, test.user, policy)
+		a.NoError(err)
+		a.Equal(test.want, got)
+	}
+}
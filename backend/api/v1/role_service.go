@@ -12,6 +12,7 @@ import (
 	enterpriseAPI "github.com/bytebase/bytebase/backend/enterprise/api"
 	api "github.com/bytebase/bytebase/backend/legacyapi"
 	"github.com/bytebase/bytebase/backend/store"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
 	v1pb "github.com/bytebase/bytebase/proto/generated-go/v1"
 )
 
@@ -122,12 +123,35 @@ func (s *RoleService) DeleteRole(ctx context.Context, request *v1pb.DeleteRoleRe
 	if has {
 		return nil, status.Errorf(codes.FailedPrecondition, "cannot delete because role %s is used in project %s", convertToRoleName(roleID), fmt.Sprintf("%s%s", projectNamePrefix, project))
 	}
+	approvalSetting, err := s.store.GetWorkspaceApprovalSetting(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get workspace approval setting: %v", err)
+	}
+	if isRoleUsedInApprovalSetting(approvalSetting, convertToRoleName(roleID)) {
+		return nil, status.Errorf(codes.FailedPrecondition, "cannot delete because role %s is used in the approval flow", convertToRoleName(roleID))
+	}
 	if err := s.store.DeleteRole(ctx, roleID); err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to delete role: %v", err)
 	}
 	return &emptypb.Empty{}, nil
 }
 
+// isRoleUsedInApprovalSetting returns whether the role is used by the nodes of the approval templates.
+func isRoleUsedInApprovalSetting(setting *storepb.WorkspaceApprovalSetting, role string) bool {
+	for _, rule := range setting.GetRules() {
+		nodes := []*storepb.ApprovalNode{rule.GetTemplate().GetSla().GetEscalationNode()}
+		for _, step := range rule.GetTemplate().GetFlow().GetSteps() {
+			nodes = append(nodes, step.Nodes...)
+		}
+		for _, node := range nodes {
+			if node.GetRole() == role {
+				return true
+			}
+		}
+	}
+	return false
+}
+
 func convertToRoles(roleMessages []*store.RoleMessage) []*v1pb.Role {
 	var roles []*v1pb.Role
 	for _, roleMessage := range roleMessages {
@@ -73,6 +73,58 @@ func (s *RolloutService) GetPlan(ctx context.Context, request *v1pb.GetPlanReque
 	return convertToPlan(plan), nil
 }
 
+// ListPlanCheckRuns lists the check runs of a plan.
+// The plan checks are backed by the task checks of the plan pipeline.
+func (s *RolloutService) ListPlanCheckRuns(ctx context.Context, request *v1pb.ListPlanCheckRunsRequest) (*v1pb.ListPlanCheckRunsResponse, error) {
+	planID, err := getPlanID(request.Parent)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	plan, err := s.store.GetPlan(ctx, planID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get plan, error: %v", err)
+	}
+	if plan == nil {
+		return nil, status.Errorf(codes.NotFound, "plan not found for id: %d", planID)
+	}
+	if plan.PipelineUID == nil {
+		return &v1pb.ListPlanCheckRunsResponse{}, nil
+	}
+
+	tasks, err := s.store.ListTasks(ctx, &api.TaskFind{PipelineID: plan.PipelineUID})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list tasks, error: %v", err)
+	}
+	taskTargets := map[int]string{}
+	for _, task := range tasks {
+		if task.DatabaseID == nil {
+			continue
+		}
+		database, err := s.store.GetDatabaseV2(ctx, &store.FindDatabaseMessage{UID: task.DatabaseID})
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get database, error: %v", err)
+		}
+		if database == nil {
+			continue
+		}
+		taskTargets[task.ID] = fmt.Sprintf("%s%s/%s%s", instanceNamePrefix, database.InstanceID, databaseIDPrefix, database.DatabaseName)
+	}
+
+	taskCheckRuns, err := s.store.ListTaskCheckRuns(ctx, &store.TaskCheckRunFind{PipelineID: plan.PipelineUID})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list task check runs, error: %v", err)
+	}
+	response := &v1pb.ListPlanCheckRunsResponse{}
+	for _, taskCheckRun := range taskCheckRuns {
+		planCheckRun, err := convertToPlanCheckRun(plan, taskCheckRun, taskTargets[taskCheckRun.TaskID])
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to convert task check run %d, error: %v", taskCheckRun.ID, err)
+		}
+		response.PlanCheckRuns = append(response.PlanCheckRuns, planCheckRun)
+	}
+	return response, nil
+}
+
 // CreatePlan creates a new plan.
 func (s *RolloutService) CreatePlan(ctx context.Context, request *v1pb.CreatePlanRequest) (*v1pb.Plan, error) {
 	creatorID := ctx.Value(common.PrincipalIDContextKey).(int)
@@ -1627,6 +1679,99 @@ func convertToPlan(plan *store.PlanMessage) *v1pb.Plan {
 	}
 }
 
+func convertToPlanCheckRun(plan *store.PlanMessage, taskCheckRun *store.TaskCheckRunMessage, target string) (*v1pb.PlanCheckRun, error) {
+	planCheckRun := &v1pb.PlanCheckRun{
+		Name:   fmt.Sprintf("%s%s/%s%d/%s%d", projectNamePrefix, plan.ProjectID, planPrefix, plan.UID, planCheckRunPrefix, taskCheckRun.ID),
+		Uid:    fmt.Sprintf("%d", taskCheckRun.ID),
+		Type:   convertToPlanCheckRunType(taskCheckRun.Type),
+		Status: convertToPlanCheckRunStatus(taskCheckRun.Status),
+		Target: target,
+	}
+	if taskCheckRun.Result == "" {
+		return planCheckRun, nil
+	}
+	payload := &api.TaskCheckRunResultPayload{}
+	if err := json.Unmarshal([]byte(taskCheckRun.Result), payload); err != nil {
+		return nil, errors.Wrapf(err, "failed to unmarshal task check run result")
+	}
+	planCheckRun.Detail = payload.Detail
+	for _, result := range payload.ResultList {
+		planCheckRun.Results = append(planCheckRun.Results, &v1pb.PlanCheckRun_Result{
+			Namespace: convertToPlanCheckRunResultNamespace(result.Namespace),
+			Code:      int64(result.Code),
+			Status:    convertToPlanCheckRunResultStatus(result.Status),
+			Title:     result.Title,
+			Content:   result.Content,
+			Line:      int64(result.Line),
+			Detail:    result.Details,
+			Fix:       convertAdviceFix(result.Fix),
+		})
+	}
+	return planCheckRun, nil
+}
+
+func convertToPlanCheckRunType(checkType api.TaskCheckType) v1pb.PlanCheckRun_Type {
+	switch checkType {
+	case api.TaskCheckDatabaseStatementFakeAdvise:
+		return v1pb.PlanCheckRun_DATABASE_STATEMENT_FAKE_ADVISE
+	case api.TaskCheckDatabaseStatementSyntax:
+		return v1pb.PlanCheckRun_DATABASE_STATEMENT_SYNTAX
+	case api.TaskCheckDatabaseStatementCompatibility:
+		return v1pb.PlanCheckRun_DATABASE_STATEMENT_COMPATIBILITY
+	case api.TaskCheckDatabaseStatementAdvise:
+		return v1pb.PlanCheckRun_DATABASE_STATEMENT_ADVISE
+	case api.TaskCheckDatabaseStatementType:
+		return v1pb.PlanCheckRun_DATABASE_STATEMENT_TYPE
+	case api.TaskCheckDatabaseStatementTypeReport:
+		return v1pb.PlanCheckRun_DATABASE_STATEMENT_TYPE_REPORT
+	case api.TaskCheckDatabaseStatementAffectedRowsReport:
+		return v1pb.PlanCheckRun_DATABASE_STATEMENT_AFFECTED_ROWS_REPORT
+	case api.TaskCheckDatabaseConnect:
+		return v1pb.PlanCheckRun_DATABASE_CONNECT
+	case api.TaskCheckGhostSync:
+		return v1pb.PlanCheckRun_DATABASE_GHOST_SYNC
+	case api.TaskCheckPITRMySQL:
+		return v1pb.PlanCheckRun_DATABASE_PITR_MYSQL
+	}
+	return v1pb.PlanCheckRun_TYPE_UNSPECIFIED
+}
+
+func convertToPlanCheckRunStatus(status api.TaskCheckRunStatus) v1pb.PlanCheckRun_Status {
+	switch status {
+	case api.TaskCheckRunRunning:
+		return v1pb.PlanCheckRun_RUNNING
+	case api.TaskCheckRunDone:
+		return v1pb.PlanCheckRun_DONE
+	case api.TaskCheckRunFailed:
+		return v1pb.PlanCheckRun_FAILED
+	case api.TaskCheckRunCanceled:
+		return v1pb.PlanCheckRun_CANCELED
+	}
+	return v1pb.PlanCheckRun_STATUS_UNSPECIFIED
+}
+
+func convertToPlanCheckRunResultNamespace(namespace api.Namespace) v1pb.PlanCheckRun_Result_Namespace {
+	switch namespace {
+	case api.BBNamespace:
+		return v1pb.PlanCheckRun_Result_BYTEBASE
+	case api.AdvisorNamespace:
+		return v1pb.PlanCheckRun_Result_ADVISOR
+	}
+	return v1pb.PlanCheckRun_Result_NAMESPACE_UNSPECIFIED
+}
+
+func convertToPlanCheckRunResultStatus(status api.TaskCheckStatus) v1pb.PlanCheckRun_Result_Status {
+	switch status {
+	case api.TaskCheckStatusError:
+		return v1pb.PlanCheckRun_Result_ERROR
+	case api.TaskCheckStatusWarn:
+		return v1pb.PlanCheckRun_Result_WARNING
+	case api.TaskCheckStatusSuccess:
+		return v1pb.PlanCheckRun_Result_SUCCESS
+	}
+	return v1pb.PlanCheckRun_Result_STATUS_UNSPECIFIED
+}
+
 func convertToPlanSteps(steps []*storepb.PlanConfig_Step) []*v1pb.Plan_Step {
 	v1Steps := make([]*v1pb.Plan_Step, len(steps))
 	for i := range steps {
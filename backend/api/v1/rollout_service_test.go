@@ -0,0 +1,41 @@
+package v1
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	api "github.com/bytebase/bytebase/backend/legacyapi"
+	"github.com/bytebase/bytebase/backend/store"
+	v1pb "github.com/bytebase/bytebase/proto/generated-go/v1"
+)
+
+func TestConvertToPlanCheckRun(t *testing.T) {
+	a := require.New(t)
+	plan := &store.PlanMessage{ProjectID: "hello", UID: 101}
+	taskCheckRun := &store.TaskCheckRunMessage{
+		ID:     7,
+		TaskID: 3,
+		Type:   api.TaskCheckDatabaseStatementAdvise,
+		Status: api.TaskCheckRunDone,
+		Result: `{"resultList":[{"namespace":"bb.advisor","code":814,"status":"WARN","title":"index.create-concurrently","content":"Creating indexes will block writes on the table, unless use CONCURRENTLY","line":1,"fix":{"title":"Create the index concurrently","safe":true,"edits":[{"start":12,"end":12,"newText":" CONCURRENTLY"}]}}]}`,
+	}
+
+	got, err := convertToPlanCheckRun(plan, taskCheckRun, "instances/i/databases/db")
+	a.NoError(err)
+	a.Equal("projects/hello/plans/101/planCheckRuns/7", got.Name)
+	a.Equal(v1pb.PlanCheckRun_DATABASE_STATEMENT_ADVISE, got.Type)
+	a.Equal(v1pb.PlanCheckRun_DONE, got.Status)
+	a.Equal("instances/i/databases/db", got.Target)
+	a.Len(got.Results, 1)
+	result := got.Results[0]
+	a.Equal(v1pb.PlanCheckRun_Result_ADVISOR, result.Namespace)
+	a.Equal(v1pb.PlanCheckRun_Result_WARNING, result.Status)
+	a.Equal(int64(814), result.Code)
+	a.NotNil(result.Fix)
+	a.True(result.Fix.Safe)
+	a.Equal("Create the index concurrently", result.Fix.Title)
+	a.Len(result.Fix.Edits, 1)
+	a.Equal(int32(12), result.Fix.Edits[0].Start)
+	a.Equal(" CONCURRENTLY", result.Fix.Edits[0].NewText)
+}
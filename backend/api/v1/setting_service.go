@@ -6,12 +6,15 @@ import (
 	"embed"
 	"encoding/json"
 	"fmt"
+	"path/filepath"
 	"strings"
+	"time"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/encoding/protojson"
 	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/durationpb"
 
 	"github.com/pkg/errors"
 
@@ -21,7 +24,10 @@ import (
 	enterpriseAPI "github.com/bytebase/bytebase/backend/enterprise/api"
 	api "github.com/bytebase/bytebase/backend/legacyapi"
 	"github.com/bytebase/bytebase/backend/plugin/app/feishu"
+	"github.com/bytebase/bytebase/backend/plugin/app/slack"
+	"github.com/bytebase/bytebase/backend/plugin/app/teams"
 	"github.com/bytebase/bytebase/backend/plugin/mail"
+	"github.com/bytebase/bytebase/backend/runner/auditlogexport"
 	"github.com/bytebase/bytebase/backend/store"
 	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
 	v1pb "github.com/bytebase/bytebase/proto/generated-go/v1"
@@ -35,6 +41,8 @@ type SettingService struct {
 	licenseService enterpriseAPI.LicenseService
 	stateCfg       *state.State
 	feishuProvider *feishu.Provider
+	slackProvider  *slack.Provider
+	teamsProvider  *teams.Provider
 }
 
 // NewSettingService creates a new setting service.
@@ -44,6 +52,8 @@ func NewSettingService(
 	licenseService enterpriseAPI.LicenseService,
 	stateCfg *state.State,
 	feishuProvider *feishu.Provider,
+	slackProvider *slack.Provider,
+	teamsProvider *teams.Provider,
 ) *SettingService {
 	return &SettingService{
 		store:          store,
@@ -51,6 +61,8 @@ func NewSettingService(
 		licenseService: licenseService,
 		stateCfg:       stateCfg,
 		feishuProvider: feishuProvider,
+		slackProvider:  slackProvider,
+		teamsProvider:  teamsProvider,
 	}
 }
 
@@ -65,6 +77,8 @@ var whitelistSettings = []api.SettingName{
 	api.SettingWorkspaceMailDelivery,
 	api.SettingWorkspaceProfile,
 	api.SettingWorkspaceExternalApproval,
+	api.SettingWorkspaceSCIM,
+	api.SettingWorkspaceAuditLogExport,
 }
 
 var (
@@ -180,6 +194,32 @@ func (s *SettingService) SetSetting(ctx context.Context, request *v1pb.SetSettin
 			if err := validateApprovalTemplate(rule.Template); err != nil {
 				return nil, status.Errorf(codes.InvalidArgument, "invalid approval template: %v, err: %v", rule.Template, err)
 			}
+			nodes := []*v1pb.ApprovalNode{rule.Template.Sla.GetEscalationNode()}
+			for _, step := range rule.Template.Flow.Steps {
+				nodes = append(nodes, step.Nodes...)
+			}
+			for _, node := range nodes {
+				if node.GetRole() != "" {
+					if err := s.validateApprovalNodeRole(ctx, node.GetRole()); err != nil {
+						return nil, err
+					}
+					continue
+				}
+				if node.GetUser() == "" {
+					continue
+				}
+				email, err := getUserEmail(node.GetUser())
+				if err != nil {
+					return nil, status.Errorf(codes.InvalidArgument, "invalid approval node user %q: %v", node.GetUser(), err)
+				}
+				user, err := s.store.GetUser(ctx, &store.FindUserMessage{Email: &email})
+				if err != nil {
+					return nil, status.Errorf(codes.Internal, "failed to get user %q: %v", email, err)
+				}
+				if user == nil {
+					return nil, status.Errorf(codes.InvalidArgument, "approval node user %q not found", node.GetUser())
+				}
+			}
 
 			creatorID := 0
 			email, err := getUserEmail(rule.Template.Creator)
@@ -205,6 +245,13 @@ func (s *SettingService) SetSetting(ctx context.Context, request *v1pb.SetSettin
 			if err := convertV1PbToStorePb(rule.Template.Flow, flow); err != nil {
 				return nil, status.Errorf(codes.Internal, "failed to unmarshal approval flow with error: %v", err)
 			}
+			var sla *storepb.ApprovalSLA
+			if rule.Template.Sla != nil {
+				sla = new(storepb.ApprovalSLA)
+				if err := convertV1PbToStorePb(rule.Template.Sla, sla); err != nil {
+					return nil, status.Errorf(codes.Internal, "failed to unmarshal approval SLA with error: %v", err)
+				}
+			}
 			payload.Rules = append(payload.Rules, &storepb.WorkspaceApprovalSetting_Rule{
 				Condition: rule.Condition,
 				Template: &storepb.ApprovalTemplate{
@@ -212,6 +259,7 @@ func (s *SettingService) SetSetting(ctx context.Context, request *v1pb.SetSettin
 					Title:       rule.Template.Title,
 					Description: rule.Template.Description,
 					CreatorId:   int32(creatorID),
+					Sla:         sla,
 				},
 			})
 		}
@@ -297,22 +345,64 @@ func (s *SettingService) SetSetting(ctx context.Context, request *v1pb.SetSettin
 			return nil, err
 		}
 		payload := &api.SettingAppIMValue{
-			IMType:    imType,
-			AppID:     settingValue.AppId,
-			AppSecret: settingValue.AppSecret,
+			IMType:        imType,
+			AppID:         settingValue.AppId,
+			AppSecret:     settingValue.AppSecret,
+			SigningSecret: settingValue.SigningSecret,
+			Channel:       settingValue.Channel,
+			ServiceURL:    settingValue.ServiceUrl,
+			TenantID:      settingValue.TenantId,
 			ExternalApproval: api.ExternalApproval{
 				Enabled:              settingValue.ExternalApproval.Enabled,
 				ApprovalDefinitionID: settingValue.ExternalApproval.ApprovalDefinitionId,
 			},
 		}
-		if payload.IMType != api.IMTypeFeishu {
-			return nil, status.Errorf(codes.InvalidArgument, fmt.Sprintf("unknown IM Type %s", payload.IMType))
+		if payload.ExternalApproval.Enabled && !s.licenseService.IsFeatureEnabled(api.FeatureIMApproval) {
+			return nil, status.Errorf(codes.PermissionDenied, api.FeatureIMApproval.AccessErrorMessage())
 		}
-		if payload.ExternalApproval.Enabled {
-			if !s.licenseService.IsFeatureEnabled(api.FeatureIMApproval) {
-				return nil, status.Errorf(codes.PermissionDenied, api.FeatureIMApproval.AccessErrorMessage())
+		// We will fill the secrets read from the store if they are not set.
+		if payload.AppSecret == "" || payload.SigningSecret == "" {
+			oldSetting, err := s.store.GetSettingV2(ctx, &store.FindSettingMessage{Name: &apiSettingName})
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to get setting %s: %v", apiSettingName, err)
+			}
+			if oldSetting != nil && oldSetting.Value != "" {
+				oldValue := new(api.SettingAppIMValue)
+				if err := json.Unmarshal([]byte(oldSetting.Value), oldValue); err != nil {
+					return nil, status.Errorf(codes.Internal, "failed to unmarshal setting value for %s with error: %v", apiSettingName, err)
+				}
+				fillAppIMSecrets(payload, oldValue)
+			}
+		}
+		switch payload.IMType {
+		case api.IMTypeSlack:
+			if !payload.ExternalApproval.Enabled {
+				break
+			}
+			if payload.AppSecret == "" || payload.SigningSecret == "" || payload.Channel == "" {
+				return nil, status.Errorf(codes.InvalidArgument, "bot token, signing secret and channel cannot be empty")
+			}
+			if err := s.slackProvider.AuthTest(ctx, payload.AppSecret); err != nil {
+				return nil, status.Errorf(codes.InvalidArgument, "failed to authenticate the Slack bot token: %v", err)
+			}
+		case api.IMTypeTeams:
+			if !payload.ExternalApproval.Enabled {
+				break
+			}
+			if payload.AppID == "" || payload.AppSecret == "" || payload.Channel == "" || payload.ServiceURL == "" {
+				return nil, status.Errorf(codes.InvalidArgument, "application ID, secret, conversation and service URL cannot be empty")
+			}
+			if _, err := s.teamsProvider.GetToken(ctx, teams.TokenCtx{
+				AppID:     payload.AppID,
+				AppSecret: payload.AppSecret,
+				TenantID:  payload.TenantID,
+			}); err != nil {
+				return nil, status.Errorf(codes.InvalidArgument, "failed to get the Teams bot token: %v", err)
+			}
+		case api.IMTypeFeishu:
+			if !payload.ExternalApproval.Enabled {
+				break
 			}
-
 			if payload.AppID == "" || payload.AppSecret == "" {
 				return nil, status.Errorf(codes.InvalidArgument, "application ID and secret cannot be empty")
 			}
@@ -338,6 +428,8 @@ func (s *SettingService) SetSetting(ctx context.Context, request *v1pb.SetSettin
 				return nil, status.Errorf(codes.Internal, "failed to create approval definition: %v", err)
 			}
 			payload.ExternalApproval.ApprovalDefinitionID = approvalDefinitionID
+		default:
+			return nil, status.Errorf(codes.InvalidArgument, fmt.Sprintf("unknown IM Type %s", payload.IMType))
 		}
 
 		s, err := json.Marshal(payload)
@@ -394,6 +486,52 @@ func (s *SettingService) SetSetting(ctx context.Context, request *v1pb.SetSettin
 			return nil, status.Errorf(codes.Internal, "failed to marshal external approval setting, error: %v", err)
 		}
 		storeSettingValue = string(bytes)
+	case api.SettingWorkspaceSCIM:
+		if !s.licenseService.IsFeatureEnabled(api.FeatureSSO) {
+			return nil, status.Errorf(codes.PermissionDenied, api.FeatureSSO.AccessErrorMessage())
+		}
+		scimSetting := request.Setting.Value.GetScimSettingValue()
+		if scimSetting == nil {
+			return nil, status.Errorf(codes.InvalidArgument, "value cannot be nil when setting SCIM setting")
+		}
+		storeValue, err := convertToStorePbSCIMSetting(scimSetting)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid SCIM setting: %v", err)
+		}
+		// We will fill the token read from the store if it is not set.
+		if storeValue.Token == "" {
+			oldSetting, err := s.store.GetWorkspaceSCIMSetting(ctx)
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to get workspace SCIM setting: %v", err)
+			}
+			storeValue.Token = oldSetting.Token
+		}
+		bytes, err := protojson.Marshal(storeValue)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to marshal SCIM setting, error: %v", err)
+		}
+		storeSettingValue = string(bytes)
+	case api.SettingWorkspaceAuditLogExport:
+		if !s.licenseService.IsFeatureEnabled(api.FeatureAuditLog) {
+			return nil, status.Errorf(codes.PermissionDenied, api.FeatureAuditLog.AccessErrorMessage())
+		}
+		auditLogExportSetting := request.Setting.Value.GetAuditLogExportSettingValue()
+		if auditLogExportSetting == nil {
+			return nil, status.Errorf(codes.InvalidArgument, "value cannot be nil when setting audit log export setting")
+		}
+		oldSetting, err := s.store.GetWorkspaceAuditLogExportSetting(ctx)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get workspace audit log export setting: %v", err)
+		}
+		storeValue, err := convertToStorePbAuditLogExportSetting(auditLogExportSetting, oldSetting, s.profile.AuditLogFileDir)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid audit log export setting: %v", err)
+		}
+		bytes, err := protojson.Marshal(storeValue)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to marshal audit log export setting, error: %v", err)
+		}
+		storeSettingValue = string(bytes)
 	default:
 		storeSettingValue = request.Setting.Value.GetStringValue()
 	}
@@ -459,14 +597,17 @@ func (s *SettingService) convertToSettingMessage(ctx context.Context, setting *s
 		if err := json.Unmarshal([]byte(stringValue), apiValue); err != nil {
 			return nil, status.Errorf(codes.Internal, "failed to unmarshal setting value for %s with error: %v", setting.Name, err)
 		}
+		// The secrets are not returned to the clients.
 		return &v1pb.Setting{
 			Name: settingName,
 			Value: &v1pb.Value{
 				Value: &v1pb.Value_AppImSettingValue{
 					AppImSettingValue: &v1pb.AppIMSetting{
-						ImType:    convertV1IMType(apiValue.IMType),
-						AppId:     apiValue.AppID,
-						AppSecret: apiValue.AppSecret,
+						ImType:     convertV1IMType(apiValue.IMType),
+						AppId:      apiValue.AppID,
+						Channel:    apiValue.Channel,
+						ServiceUrl: apiValue.ServiceURL,
+						TenantId:   apiValue.TenantID,
 						ExternalApproval: &v1pb.AppIMSetting_ExternalApproval{
 							Enabled:              apiValue.ExternalApproval.Enabled,
 							ApprovalDefinitionId: apiValue.ExternalApproval.ApprovalDefinitionID,
@@ -543,6 +684,32 @@ func (s *SettingService) convertToSettingMessage(ctx context.Context, setting *s
 				},
 			},
 		}, nil
+	case api.SettingWorkspaceSCIM:
+		storeValue := new(storepb.SCIMSetting)
+		if err := protojson.Unmarshal([]byte(setting.Value), storeValue); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to unmarshal setting value for %s with error: %v", setting.Name, err)
+		}
+		return &v1pb.Setting{
+			Name: settingName,
+			Value: &v1pb.Value{
+				Value: &v1pb.Value_ScimSettingValue{
+					ScimSettingValue: convertToSCIMSetting(storeValue),
+				},
+			},
+		}, nil
+	case api.SettingWorkspaceAuditLogExport:
+		storeValue := new(storepb.AuditLogExportSetting)
+		if err := protojson.Unmarshal([]byte(setting.Value), storeValue); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to unmarshal setting value for %s with error: %v", setting.Name, err)
+		}
+		return &v1pb.Setting{
+			Name: settingName,
+			Value: &v1pb.Value{
+				Value: &v1pb.Value_AuditLogExportSettingValue{
+					AuditLogExportSettingValue: convertToAuditLogExportSetting(storeValue),
+				},
+			},
+		}, nil
 	default:
 		return &v1pb.Setting{
 			Name: settingName,
@@ -555,11 +722,28 @@ func (s *SettingService) convertToSettingMessage(ctx context.Context, setting *s
 	}
 }
 
+// fillAppIMSecrets fills the secrets that are not set from the stored setting of the same IM type.
+func fillAppIMSecrets(value, oldValue *api.SettingAppIMValue) {
+	if value.IMType != oldValue.IMType {
+		return
+	}
+	if value.AppSecret == "" {
+		value.AppSecret = oldValue.AppSecret
+	}
+	if value.SigningSecret == "" {
+		value.SigningSecret = oldValue.SigningSecret
+	}
+}
+
 func convertToIMType(imType v1pb.AppIMSetting_IMType) (api.IMType, error) {
 	var resp api.IMType
 	switch imType {
 	case v1pb.AppIMSetting_FEISHU:
 		resp = api.IMTypeFeishu
+	case v1pb.AppIMSetting_SLACK:
+		resp = api.IMTypeSlack
+	case v1pb.AppIMSetting_TEAMS:
+		resp = api.IMTypeTeams
 	default:
 		return resp, status.Errorf(codes.InvalidArgument, "unknown im type %v", imType.String())
 	}
@@ -570,11 +754,187 @@ func convertV1IMType(imType api.IMType) v1pb.AppIMSetting_IMType {
 	switch imType {
 	case api.IMTypeFeishu:
 		return v1pb.AppIMSetting_FEISHU
+	case api.IMTypeSlack:
+		return v1pb.AppIMSetting_SLACK
+	case api.IMTypeTeams:
+		return v1pb.AppIMSetting_TEAMS
 	default:
 		return v1pb.AppIMSetting_IM_TYPE_UNSPECIFIED
 	}
 }
 
+func convertToSCIMSetting(setting *storepb.SCIMSetting) *v1pb.SCIMSetting {
+	v1Setting := &v1pb.SCIMSetting{
+		// SECURITY: We do not expose the token.
+		Token: "",
+	}
+	for _, groupMapping := range setting.GroupMappings {
+		v1Setting.GroupMappings = append(v1Setting.GroupMappings, &v1pb.SCIMSetting_GroupMapping{
+			Group:   groupMapping.Group,
+			Project: fmt.Sprintf("%s%s", projectNamePrefix, groupMapping.Project),
+			Role:    fmt.Sprintf("%s%s", rolePrefix, groupMapping.Role),
+		})
+	}
+	return v1Setting
+}
+
+func convertToStorePbSCIMSetting(setting *v1pb.SCIMSetting) (*storepb.SCIMSetting, error) {
+	storeSetting := &storepb.SCIMSetting{
+		Token: setting.Token,
+	}
+	for _, groupMapping := range setting.GroupMappings {
+		if groupMapping.Group == "" {
+			return nil, errors.Errorf("group is required in the group mapping")
+		}
+		projectID, err := getProjectID(groupMapping.Project)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid project in the group mapping of %q", groupMapping.Group)
+		}
+		roleID, err := getRoleID(groupMapping.Role)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid role in the group mapping of %q", groupMapping.Group)
+		}
+		storeSetting.GroupMappings = append(storeSetting.GroupMappings, &storepb.SCIMSetting_GroupMapping{
+			Group:   groupMapping.Group,
+			Project: projectID,
+			Role:    roleID,
+		})
+	}
+	return storeSetting, nil
+}
+
+func convertToAuditLogExportSetting(setting *storepb.AuditLogExportSetting) *v1pb.AuditLogExportSetting {
+	v1Setting := &v1pb.AuditLogExportSetting{}
+	for _, destination := range setting.Destinations {
+		v1Destination := &v1pb.AuditLogExportSetting_Destination{
+			Name: destination.Name,
+			Type: convertToAuditLogExportDestinationType(destination.Type),
+		}
+		if v := destination.Syslog; v != nil {
+			v1Destination.Syslog = &v1pb.AuditLogExportSetting_Destination_SyslogConfig{
+				Network:  v.Network,
+				Address:  v.Address,
+				Facility: v.Facility,
+				AppName:  v.AppName,
+			}
+		}
+		if v := destination.Http; v != nil {
+			v1Destination.Http = &v1pb.AuditLogExportSetting_Destination_HTTPConfig{
+				Url:     v.Url,
+				Headers: map[string]string{},
+			}
+			for key := range v.Headers {
+				// SECURITY: We do not expose the header values, which usually contain the tokens.
+				v1Destination.Http.Headers[key] = ""
+			}
+		}
+		if v := destination.File; v != nil {
+			v1Destination.File = &v1pb.AuditLogExportSetting_Destination_FileConfig{
+				Path:       v.Path,
+				MaxSize:    v.MaxSize,
+				MaxBackups: v.MaxBackups,
+			}
+		}
+		v1Setting.Destinations = append(v1Setting.Destinations, v1Destination)
+	}
+	return v1Setting
+}
+
+// convertToStorePbAuditLogExportSetting converts and validates the setting, and fills the empty header values from the old setting.
+// The file destinations can only write to the fileDir.
+func convertToStorePbAuditLogExportSetting(setting *v1pb.AuditLogExportSetting, oldSetting *storepb.AuditLogExportSetting, fileDir string) (*storepb.AuditLogExportSetting, error) {
+	oldDestinations := map[string]*storepb.AuditLogExportSetting_Destination{}
+	for _, destination := range oldSetting.Destinations {
+		oldDestinations[destination.Name] = destination
+	}
+
+	storeSetting := &storepb.AuditLogExportSetting{}
+	names := map[string]bool{}
+	for _, destination := range setting.Destinations {
+		if destination.Name == "" {
+			return nil, errors.Errorf("destination name is required")
+		}
+		if names[destination.Name] {
+			return nil, errors.Errorf("duplicate destination name %q", destination.Name)
+		}
+		names[destination.Name] = true
+		destinationType, err := convertToStorePbAuditLogExportDestinationType(destination.Type)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid destination %q", destination.Name)
+		}
+		storeDestination := &storepb.AuditLogExportSetting_Destination{
+			Name: destination.Name,
+			Type: destinationType,
+		}
+		if v := destination.Syslog; v != nil {
+			storeDestination.Syslog = &storepb.AuditLogExportSetting_Destination_SyslogConfig{
+				Network:  v.Network,
+				Address:  v.Address,
+				Facility: v.Facility,
+				AppName:  v.AppName,
+			}
+		}
+		if v := destination.Http; v != nil {
+			storeDestination.Http = &storepb.AuditLogExportSetting_Destination_HTTPConfig{
+				Url:     v.Url,
+				Headers: map[string]string{},
+			}
+			for key, value := range v.Headers {
+				if value == "" {
+					value = oldDestinations[destination.Name].GetHttp().GetHeaders()[key]
+				}
+				storeDestination.Http.Headers[key] = value
+			}
+		}
+		if v := destination.File; v != nil {
+			path := v.Path
+			if path != "" {
+				path = filepath.Clean(path)
+			}
+			storeDestination.File = &storepb.AuditLogExportSetting_Destination_FileConfig{
+				Path:       path,
+				MaxSize:    v.MaxSize,
+				MaxBackups: v.MaxBackups,
+			}
+		}
+		exporter, err := auditlogexport.NewExporter(storeDestination, fileDir)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid destination %q", destination.Name)
+		}
+		if err := exporter.Close(); err != nil {
+			return nil, errors.Wrapf(err, "failed to close the exporter of destination %q", destination.Name)
+		}
+		storeSetting.Destinations = append(storeSetting.Destinations, storeDestination)
+	}
+	return storeSetting, nil
+}
+
+func convertToAuditLogExportDestinationType(destinationType storepb.AuditLogExportSetting_Destination_Type) v1pb.AuditLogExportSetting_Destination_Type {
+	switch destinationType {
+	case storepb.AuditLogExportSetting_Destination_SYSLOG:
+		return v1pb.AuditLogExportSetting_Destination_SYSLOG
+	case storepb.AuditLogExportSetting_Destination_HTTP:
+		return v1pb.AuditLogExportSetting_Destination_HTTP
+	case storepb.AuditLogExportSetting_Destination_FILE:
+		return v1pb.AuditLogExportSetting_Destination_FILE
+	default:
+		return v1pb.AuditLogExportSetting_Destination_TYPE_UNSPECIFIED
+	}
+}
+
+func convertToStorePbAuditLogExportDestinationType(destinationType v1pb.AuditLogExportSetting_Destination_Type) (storepb.AuditLogExportSetting_Destination_Type, error) {
+	switch destinationType {
+	case v1pb.AuditLogExportSetting_Destination_SYSLOG:
+		return storepb.AuditLogExportSetting_Destination_SYSLOG, nil
+	case v1pb.AuditLogExportSetting_Destination_HTTP:
+		return storepb.AuditLogExportSetting_Destination_HTTP, nil
+	case v1pb.AuditLogExportSetting_Destination_FILE:
+		return storepb.AuditLogExportSetting_Destination_FILE, nil
+	default:
+		return storepb.AuditLogExportSetting_Destination_TYPE_UNSPECIFIED, errors.Errorf("unsupported destination type %v", destinationType)
+	}
+}
+
 func settingInWhitelist(name api.SettingName) bool {
 	for _, whitelist := range whitelistSettings {
 		if name == whitelist {
@@ -592,12 +952,129 @@ func validateApprovalTemplate(template *v1pb.ApprovalTemplate) error {
 		return errors.Errorf("approval template cannot have 0 step")
 	}
 	for _, step := range template.Flow.Steps {
-		if step.Type != v1pb.ApprovalStep_ANY {
+		if step.Type != v1pb.ApprovalStep_ANY && step.Type != v1pb.ApprovalStep_ALL {
 			return errors.Errorf("invalid approval step type: %v", step.Type)
 		}
-		if len(step.Nodes) != 1 {
-			return errors.Errorf("expect 1 node in approval step, got: %v", len(step.Nodes))
+		if len(step.Nodes) == 0 {
+			return errors.Errorf("expect at least 1 node in approval step")
+		}
+		if step.Quorum < 0 {
+			return errors.Errorf("invalid quorum %d", step.Quorum)
+		}
+		if step.Type == v1pb.ApprovalStep_ALL && step.Quorum != 0 {
+			return errors.Errorf("quorum is only supported by the ANY step")
+		}
+		stepUsers := map[string]bool{}
+		onlyUserNodes := true
+		for _, node := range step.Nodes {
+			if err := validateApprovalNode(node); err != nil {
+				return err
+			}
+			// The external approval is done by the relay service for the step.
+			if node.GetExternalNodeId() != "" && (step.Type != v1pb.ApprovalStep_ANY || len(step.Nodes) != 1 || step.Quorum > 1) {
+				return errors.Errorf("the external approval node must be the only node of the ANY step")
+			}
+			if node.GetUser() != "" {
+				stepUsers[node.GetUser()] = true
+			} else {
+				onlyUserNodes = false
+			}
+		}
+		// The step of the named users can never be approved if there aren't enough different users.
+		// The steps of the groups and the roles are checked when the issue is waiting for the approval, since the members may change.
+		if onlyUserNodes {
+			if step.Type == v1pb.ApprovalStep_ANY && int(step.Quorum) > len(stepUsers) {
+				return errors.Errorf("quorum %d is more than the %d users of the approval step", step.Quorum, len(stepUsers))
+			}
+			if step.Type == v1pb.ApprovalStep_ALL && len(stepUsers) < len(step.Nodes) {
+				return errors.Errorf("every node of the ALL step must be approved by a different user")
+			}
+		}
+	}
+	return validateApprovalSLA(template.Sla)
+}
+
+// validateApprovalNodeRole checks the role of the approval node is a built-in project role or an existing custom role.
+func (s *SettingService) validateApprovalNodeRole(ctx context.Context, role string) error {
+	roleID, err := getRoleID(role)
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, "invalid approval node role %q: %v", role, err)
+	}
+	switch api.Role(roleID) {
+	case api.Owner, api.Developer, api.Exporter, api.Querier:
+		return nil
+	}
+	roleMessage, err := s.store.GetRole(ctx, roleID)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to get role %q: %v", role, err)
+	}
+	if roleMessage == nil {
+		return status.Errorf(codes.InvalidArgument, "approval node role %q not found", role)
+	}
+	return nil
+}
+
+func validateApprovalNode(node *v1pb.ApprovalNode) error {
+	if node.Type != v1pb.ApprovalNode_ANY_IN_GROUP {
+		return errors.Errorf("invalid approval node type: %v", node.Type)
+	}
+	switch payload := node.Payload.(type) {
+	case *v1pb.ApprovalNode_GroupValue_:
+		if payload.GroupValue == v1pb.ApprovalNode_GROUP_VALUE_UNSPECIFILED {
+			return errors.Errorf("invalid group value")
+		}
+	case *v1pb.ApprovalNode_Role:
+		if _, err := getRoleID(payload.Role); err != nil {
+			return errors.Wrapf(err, "invalid role %q", payload.Role)
+		}
+	case *v1pb.ApprovalNode_User:
+		if _, err := getUserEmail(payload.User); err != nil {
+			return errors.Wrapf(err, "invalid user %q", payload.User)
+		}
+	case *v1pb.ApprovalNode_ExternalNodeId:
+	default:
+		return errors.Errorf("invalid approval node payload")
+	}
+	return nil
+}
+
+func validateApprovalSLA(sla *v1pb.ApprovalSLA) error {
+	if sla == nil {
+		return nil
+	}
+	for _, d := range []*durationpb.Duration{sla.ReminderInterval, sla.EscalationTimeout, sla.ExpirationTimeout} {
+		if d == nil {
+			continue
+		}
+		if err := d.CheckValid(); err != nil {
+			return errors.Wrapf(err, "invalid SLA duration")
+		}
+		if d.AsDuration() < 0 {
+			return errors.Errorf("SLA duration cannot be negative")
+		}
+	}
+	if sla.ReminderInterval.AsDuration() > 0 && sla.ReminderInterval.AsDuration() < time.Minute {
+		return errors.Errorf("SLA reminder interval must be at least 1 minute")
+	}
+	if sla.EscalationTimeout.AsDuration() > 0 {
+		if sla.EscalationNode == nil {
+			return errors.Errorf("SLA escalation node is required with the escalation timeout")
+		}
+		if err := validateApprovalNode(sla.EscalationNode); err != nil {
+			return errors.Wrapf(err, "invalid SLA escalation node")
+		}
+		if sla.EscalationNode.GetExternalNodeId() != "" {
+			return errors.Errorf("SLA escalation node cannot be an external approval node")
+		}
+	} else if sla.EscalationNode != nil {
+		return errors.Errorf("SLA escalation timeout is required with the escalation node")
+	}
+	if sla.ExpirationTimeout.AsDuration() > 0 {
+		if sla.ExpirationAction != v1pb.ApprovalSLA_REJECT && sla.ExpirationAction != v1pb.ApprovalSLA_CANCEL {
+			return errors.Errorf("SLA expiration action is required with the expiration timeout")
 		}
+	} else if sla.ExpirationAction != v1pb.ApprovalSLA_EXPIRATION_ACTION_UNSPECIFIED {
+		return errors.Errorf("SLA expiration timeout is required with the expiration action")
 	}
 	return nil
 }
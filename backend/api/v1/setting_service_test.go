@@ -0,0 +1,91 @@
+package v1
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	api "github.com/bytebase/bytebase/backend/legacyapi"
+	"github.com/bytebase/bytebase/backend/store"
+	v1pb "github.com/bytebase/bytebase/proto/generated-go/v1"
+)
+
+func TestValidateApprovalTemplate(t *testing.T) {
+	userNode := func(email string) *v1pb.ApprovalNode {
+		return &v1pb.ApprovalNode{
+			Type:    v1pb.ApprovalNode_ANY_IN_GROUP,
+			Payload: &v1pb.ApprovalNode_User{User: "users/" + email},
+		}
+	}
+	roleNode := &v1pb.ApprovalNode{
+		Type:    v1pb.ApprovalNode_ANY_IN_GROUP,
+		Payload: &v1pb.ApprovalNode_Role{Role: "roles/OWNER"},
+	}
+
+	tests := []struct {
+		step    *v1pb.ApprovalStep
+		wantErr bool
+	}{
+		{
+			step: &v1pb.ApprovalStep{Type: v1pb.ApprovalStep_ANY, Nodes: []*v1pb.ApprovalNode{userNode("a@bytebase.com"), userNode("b@bytebase.com")}, Quorum: 2},
+		},
+		{
+			// The quorum is more than the named users.
+			step:    &v1pb.ApprovalStep{Type: v1pb.ApprovalStep_ANY, Nodes: []*v1pb.ApprovalNode{userNode("a@bytebase.com"), userNode("a@bytebase.com")}, Quorum: 2},
+			wantErr: true,
+		},
+		{
+			// The members of the role are checked when the issue is waiting for the approval.
+			step: &v1pb.ApprovalStep{Type: v1pb.ApprovalStep_ANY, Nodes: []*v1pb.ApprovalNode{userNode("a@bytebase.com"), roleNode}, Quorum: 3},
+		},
+		{
+			step:    &v1pb.ApprovalStep{Type: v1pb.ApprovalStep_ALL, Nodes: []*v1pb.ApprovalNode{userNode("a@bytebase.com"), userNode("a@bytebase.com")}},
+			wantErr: true,
+		},
+		{
+			step: &v1pb.ApprovalStep{Type: v1pb.ApprovalStep_ALL, Nodes: []*v1pb.ApprovalNode{userNode("a@bytebase.com"), roleNode}},
+		},
+	}
+	for i, test := range tests {
+		err := validateApprovalTemplate(&v1pb.ApprovalTemplate{
+			Flow: &v1pb.ApprovalFlow{Steps: []*v1pb.ApprovalStep{test.step}},
+		})
+		if test.wantErr {
+			require.Error(t, err, i)
+		} else {
+			require.NoError(t, err, i)
+		}
+	}
+}
+
+func TestAppIMSettingSecrets(t *testing.T) {
+	stored := &api.SettingAppIMValue{
+		IMType:        api.IMTypeSlack,
+		AppSecret:     "xoxb-token",
+		SigningSecret: "signing-secret",
+		Channel:       "C0123",
+	}
+	bytes, err := json.Marshal(stored)
+	require.NoError(t, err)
+
+	// The secrets are not returned.
+	setting, err := (&SettingService{}).convertToSettingMessage(context.Background(), &store.SettingMessage{Name: api.SettingAppIM, Value: string(bytes)})
+	require.NoError(t, err)
+	value := setting.Value.GetAppImSettingValue()
+	require.Equal(t, v1pb.AppIMSetting_SLACK, value.ImType)
+	require.Equal(t, "C0123", value.Channel)
+	require.Empty(t, value.AppSecret)
+	require.Empty(t, value.SigningSecret)
+
+	// The secrets that are not set are kept, unless the IM type changes.
+	updated := &api.SettingAppIMValue{IMType: api.IMTypeSlack, SigningSecret: "new-signing-secret"}
+	fillAppIMSecrets(updated, stored)
+	require.Equal(t, "xoxb-token", updated.AppSecret)
+	require.Equal(t, "new-signing-secret", updated.SigningSecret)
+	updated = &api.SettingAppIMValue{IMType: api.IMTypeTeams}
+	fillAppIMSecrets(updated, stored)
+	require.Empty(t, updated.AppSecret)
+	require.Empty(t, updated.SigningSecret)
+}
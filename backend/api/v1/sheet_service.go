@@ -20,8 +20,11 @@ import (
 
 	"github.com/bytebase/bytebase/backend/common"
 	"github.com/bytebase/bytebase/backend/common/log"
+	"github.com/bytebase/bytebase/backend/component/dbfactory"
 	enterpriseAPI "github.com/bytebase/bytebase/backend/enterprise/api"
 	api "github.com/bytebase/bytebase/backend/legacyapi"
+	"github.com/bytebase/bytebase/backend/plugin/advisor"
+	advisorDB "github.com/bytebase/bytebase/backend/plugin/advisor/db"
 	vcsPlugin "github.com/bytebase/bytebase/backend/plugin/vcs"
 	"github.com/bytebase/bytebase/backend/store"
 	"github.com/bytebase/bytebase/backend/utils"
@@ -33,13 +36,15 @@ type SheetService struct {
 	v1pb.UnimplementedSheetServiceServer
 	store          *store.Store
 	licenseService enterpriseAPI.LicenseService
+	dbFactory      *dbfactory.DBFactory
 }
 
 // NewSheetService creates a new SheetService.
-func NewSheetService(store *store.Store, licenseService enterpriseAPI.LicenseService) *SheetService {
+func NewSheetService(store *store.Store, licenseService enterpriseAPI.LicenseService, dbFactory *dbfactory.DBFactory) *SheetService {
 	return &SheetService{
 		store:          store,
 		licenseService: licenseService,
+		dbFactory:      dbFactory,
 	}
 }
 
@@ -594,6 +599,10 @@ func (s *SheetService) SyncSheets(ctx context.Context, request *v1pb.SyncSheetsR
 			sheetSource = api.SheetFromGitHub
 		case vcsPlugin.Bitbucket:
 			sheetSource = api.SheetFromBitbucket
+		case vcsPlugin.Gitea:
+			sheetSource = api.SheetFromGitea
+		case vcsPlugin.AzureDevOps:
+			sheetSource = api.SheetFromAzureDevOps
 		}
 		vscSheetType := api.SheetForSQL
 		sheetFind := &store.FindSheetMessage{
@@ -698,6 +707,153 @@ func (s *SheetService) UpdateSheetOrganizer(ctx context.Context, request *v1pb.U
 	}, nil
 }
 
+// ApplySheetFixes applies the safe SQL review fixes to the sheet content.
+func (s *SheetService) ApplySheetFixes(ctx context.Context, request *v1pb.ApplySheetFixesRequest) (*v1pb.ApplySheetFixesResponse, error) {
+	_, sheetID, err := getProjectResourceIDSheetID(request.Name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	sheetIntID, err := strconv.Atoi(sheetID)
+	if err != nil || sheetIntID <= 0 {
+		return nil, status.Errorf(codes.InvalidArgument, fmt.Sprintf("invalid sheet id %s, must be positive integer", sheetID))
+	}
+	sheet, err := s.findSheet(ctx, &store.FindSheetMessage{
+		UID:      &sheetIntID,
+		LoadFull: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+	canAccess, err := s.canWriteSheet(ctx, sheet)
+	if request.ValidateOnly {
+		canAccess, err = s.canReadSheet(ctx, sheet)
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, fmt.Sprintf("failed to check access with error: %v", err))
+	}
+	if !canAccess {
+		return nil, status.Errorf(codes.PermissionDenied, "cannot access sheet %s", sheet.Name)
+	}
+
+	find := &store.FindDatabaseMessage{UID: sheet.DatabaseUID}
+	if request.Database != "" {
+		instanceResourceID, databaseName, err := getInstanceDatabaseID(request.Database)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, err.Error())
+		}
+		find = &store.FindDatabaseMessage{InstanceID: &instanceResourceID, DatabaseName: &databaseName}
+	} else if sheet.DatabaseUID == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "database must be set for the sheet without database")
+	}
+	database, err := s.store.GetDatabaseV2(ctx, find)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, fmt.Sprintf("failed to get database: %v", err))
+	}
+	if database == nil {
+		return nil, status.Errorf(codes.NotFound, "database not found")
+	}
+	// The review reads the database schema, so the principal must be able to get the database.
+	canGetDatabase, err := s.canGetDatabase(ctx, database)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, fmt.Sprintf("failed to check database access with error: %v", err))
+	}
+	if !canGetDatabase {
+		return nil, status.Errorf(codes.PermissionDenied, "cannot access database %s", database.DatabaseName)
+	}
+
+	adviceList, err := s.sqlReviewCheck(ctx, database, sheet.Statement)
+	if err != nil {
+		return nil, err
+	}
+	statement, appliedAdviceList, err := advisor.ApplyFixes(sheet.Statement, adviceList)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, fmt.Sprintf("failed to apply fixes: %v", err))
+	}
+
+	if !request.ValidateOnly && statement != sheet.Statement {
+		currentPrincipalID := ctx.Value(common.PrincipalIDContextKey).(int)
+		sheet, err = s.store.PatchSheetV2(ctx, &store.PatchSheetMessage{
+			UID:       sheet.UID,
+			UpdaterID: currentPrincipalID,
+			Statement: &statement,
+		})
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, fmt.Sprintf("failed to update sheet: %v", err))
+		}
+	}
+	v1pbSheet, err := s.convertToAPISheetMessage(ctx, sheet)
+	if err != nil {
+		return nil, err
+	}
+	// The patched sheet doesn't load the full statement, so we always return the fixed content.
+	v1pbSheet.Content = []byte(statement)
+	return &v1pb.ApplySheetFixesResponse{
+		Sheet:          v1pbSheet,
+		AppliedAdvices: convertAdviceList(appliedAdviceList),
+	}, nil
+}
+
+// sqlReviewCheck reviews the statement with the SQL review policy of the database environment.
+func (s *SheetService) sqlReviewCheck(ctx context.Context, database *store.DatabaseMessage, statement string) ([]advisor.Advice, error) {
+	instance, err := s.store.GetInstanceV2(ctx, &store.FindInstanceMessage{ResourceID: &database.InstanceID})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, fmt.Sprintf("failed to get instance: %v", err))
+	}
+	if instance == nil {
+		return nil, status.Errorf(codes.NotFound, "instance %q not found", database.InstanceID)
+	}
+	if !IsSQLReviewSupported(instance.Engine) {
+		return nil, status.Errorf(codes.InvalidArgument, "SQL review is not supported for engine %s", instance.Engine)
+	}
+	environment, err := s.store.GetEnvironmentV2(ctx, &store.FindEnvironmentMessage{ResourceID: &database.EnvironmentID})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, fmt.Sprintf("failed to get environment: %v", err))
+	}
+	if environment == nil {
+		return nil, status.Errorf(codes.NotFound, "environment %q not found", database.EnvironmentID)
+	}
+	policy, err := s.store.GetSQLReviewPolicy(ctx, environment.UID)
+	if err != nil {
+		if e, ok := err.(*common.Error); ok && e.Code == common.NotFound {
+			return nil, nil
+		}
+		return nil, status.Errorf(codes.Internal, fmt.Sprintf("failed to get SQL review policy: %v", err))
+	}
+	dbSchema, err := s.store.GetDBSchema(ctx, database.UID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, fmt.Sprintf("failed to get database schema: %v", err))
+	}
+	if dbSchema == nil {
+		return nil, status.Errorf(codes.NotFound, "database schema %d not found", database.UID)
+	}
+	dbType, err := advisorDB.ConvertToAdvisorDBType(string(instance.Engine))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, fmt.Sprintf("failed to convert engine to advisor db type: %v", err))
+	}
+	catalog, err := s.store.NewCatalog(ctx, database.UID, instance.Engine, advisor.SyntaxModeNormal)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, fmt.Sprintf("failed to create a catalog: %v", err))
+	}
+	driver, err := s.dbFactory.GetReadOnlyDatabaseDriver(ctx, instance, database)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, fmt.Sprintf("failed to get database driver: %v", err))
+	}
+	defer driver.Close(ctx)
+
+	adviceList, err := advisor.SQLReviewCheck(statement, policy.RuleList, advisor.SQLReviewCheckContext{
+		Charset:   dbSchema.Metadata.CharacterSet,
+		Collation: dbSchema.Metadata.Collation,
+		DbType:    dbType,
+		Catalog:   catalog,
+		Driver:    driver.GetDB(),
+		Context:   ctx,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, fmt.Sprintf("failed to check SQL review policy: %v", err))
+	}
+	return adviceList, nil
+}
+
 func (s *SheetService) findSheet(ctx context.Context, find *store.FindSheetMessage) (*store.SheetMessage, error) {
 	currentPrincipalID := ctx.Value(common.PrincipalIDContextKey).(int)
 	sheet, err := s.store.GetSheetV2(ctx, find, currentPrincipalID)
@@ -763,6 +919,21 @@ func (s *SheetService) canReadSheet(ctx context.Context, sheet *store.SheetMessa
 	return false, nil
 }
 
+// canGetDatabase check if the principal can get the database.
+// The database is accessible to workspace Owner and DBA, and the members in the database project.
+func (s *SheetService) canGetDatabase(ctx context.Context, database *store.DatabaseMessage) (bool, error) {
+	currentPrincipalID := ctx.Value(common.PrincipalIDContextKey).(int)
+	role := ctx.Value(common.RoleContextKey).(api.Role)
+	if isOwnerOrDBA(role) {
+		return true, nil
+	}
+	policy, err := s.store.GetProjectPolicy(ctx, &store.GetProjectPolicyMessage{ProjectID: &database.ProjectID})
+	if err != nil {
+		return false, err
+	}
+	return isProjectMember(policy, currentPrincipalID), nil
+}
+
 func (s *SheetService) findProjectRoles(ctx context.Context, projectUID int, principalUID int) (map[common.ProjectRole]bool, error) {
 	policy, err := s.store.GetProjectPolicy(ctx, &store.GetProjectPolicyMessage{UID: &projectUID})
 	if err != nil {
@@ -817,6 +988,10 @@ func (s *SheetService) convertToAPISheetMessage(ctx context.Context, sheet *stor
 		source = v1pb.Sheet_SOURCE_GITHUB
 	case api.SheetFromBitbucket:
 		source = v1pb.Sheet_SOURCE_BITBUCKET
+	case api.SheetFromGitea:
+		source = v1pb.Sheet_SOURCE_GITEA
+	case api.SheetFromAzureDevOps:
+		source = v1pb.Sheet_SOURCE_AZURE_DEVOPS
 	}
 
 	tp := v1pb.Sheet_TYPE_UNSPECIFIED
@@ -877,6 +1052,10 @@ func convertToStoreSheetMessage(projectUID int, databaseUID *int, creatorID int,
 		source = api.SheetFromGitHub
 	case v1pb.Sheet_SOURCE_BITBUCKET:
 		source = api.SheetFromBitbucket
+	case v1pb.Sheet_SOURCE_GITEA:
+		source = api.SheetFromGitea
+	case v1pb.Sheet_SOURCE_AZURE_DEVOPS:
+		source = api.SheetFromAzureDevOps
 	default:
 		return nil, status.Errorf(codes.InvalidArgument, fmt.Sprintf("invalid source %q", sheet.Source))
 	}
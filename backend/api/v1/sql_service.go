@@ -8,6 +8,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"net"
 	"net/http"
 	"reflect"
 	"strconv"
@@ -16,6 +17,8 @@ import (
 
 	"go.uber.org/zap"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/encoding/protojson"
 	"google.golang.org/protobuf/proto"
@@ -36,6 +39,7 @@ import (
 	"github.com/bytebase/bytebase/backend/plugin/advisor/catalog"
 	advisorDB "github.com/bytebase/bytebase/backend/plugin/advisor/db"
 	"github.com/bytebase/bytebase/backend/plugin/db"
+	"github.com/bytebase/bytebase/backend/plugin/indexadvisor"
 	parser "github.com/bytebase/bytebase/backend/plugin/parser/sql"
 	"github.com/bytebase/bytebase/backend/plugin/parser/sql/ast"
 	"github.com/bytebase/bytebase/backend/plugin/parser/sql/transform"
@@ -49,6 +53,8 @@ const (
 	// The maximum number of bytes for sql results in response body.
 	// 10 MB.
 	maximumSQLResultSize = 10 * 1024 * 1024
+	// The minimum row count of the table to warn the full table scan in SQL Editor.
+	largeTableRowCount = 100000
 )
 
 // SQLService is the service for SQL.
@@ -58,15 +64,18 @@ type SQLService struct {
 	schemaSyncer    *schemasync.Syncer
 	dbFactory       *dbfactory.DBFactory
 	activityManager *activity.Manager
+	// trustedProxies is the networks of the reverse proxies whose X-Forwarded-For header is trusted.
+	trustedProxies []*net.IPNet
 }
 
 // NewSQLService creates a SQLService.
-func NewSQLService(store *store.Store, schemaSyncer *schemasync.Syncer, dbFactory *dbfactory.DBFactory, activityManager *activity.Manager) *SQLService {
+func NewSQLService(store *store.Store, schemaSyncer *schemasync.Syncer, dbFactory *dbfactory.DBFactory, activityManager *activity.Manager, trustedProxies []*net.IPNet) *SQLService {
 	return &SQLService{
 		store:           store,
 		schemaSyncer:    schemaSyncer,
 		dbFactory:       dbFactory,
 		activityManager: activityManager,
+		trustedProxies:  trustedProxies,
 	}
 }
 
@@ -99,12 +108,9 @@ func (s *SQLService) AdminExecute(server v1pb.SQLService_AdminExecuteServer) err
 	ctx := server.Context()
 	var driver db.Driver
 	var conn *sql.Conn
+	releaseConn := func() {}
 	defer func() {
-		if conn != nil {
-			if err := conn.Close(); err != nil {
-				log.Warn("failed to close connection", zap.Error(err))
-			}
-		}
+		releaseConn()
 		if driver != nil {
 			driver.Close(ctx)
 		}
@@ -130,18 +136,16 @@ func (s *SQLService) AdminExecute(server v1pb.SQLService_AdminExecuteServer) err
 				return status.Errorf(codes.Internal, "failed to get database driver: %v", err)
 			}
 
-			sqlDB := driver.GetDB()
-			if sqlDB != nil {
-				conn, err = sqlDB.Conn(ctx)
-				if err != nil {
-					return status.Errorf(codes.Internal, "failed to get database connection: %v", err)
-				}
+			conn, releaseConn, err = dbfactory.GetDedicatedConn(ctx, driver)
+			if err != nil {
+				releaseConn = func() {}
+				return status.Errorf(codes.Internal, "failed to get database connection: %v", err)
 			}
 		}
 
-		result, durationNs, queryErr := s.doAdminExecute(ctx, driver, conn, request)
+		result, audit, queryErr := s.doAdminExecute(ctx, driver, conn, request)
 
-		if err := s.postAdminExecute(ctx, activity, durationNs, queryErr); err != nil {
+		if err := s.postAdminExecute(ctx, request, instance, activity, audit, queryErr); err != nil {
 			return err
 		}
 
@@ -157,14 +161,24 @@ func (s *SQLService) AdminExecute(server v1pb.SQLService_AdminExecuteServer) err
 	}
 }
 
-func (s *SQLService) postAdminExecute(ctx context.Context, activity *store.ActivityMessage, durationNs int64, queryErr error) error {
+func (s *SQLService) postAdminExecute(ctx context.Context, request *v1pb.AdminExecuteRequest, instance *store.InstanceMessage, activity *store.ActivityMessage, audit *queryAudit, queryErr error) error {
 	var payload api.ActivitySQLEditorQueryPayload
 	if err := json.Unmarshal([]byte(activity.Payload), &payload); err != nil {
 		return status.Errorf(codes.Internal, "failed to unmarshal activity payload: %v", err)
 	}
 
+	resources, fields, err := s.getQueryResourcesAndFields(ctx, instance, request.ConnectionDatabase, request.Statement, audit.results)
+	if err != nil {
+		return err
+	}
+
 	var newLevel *api.ActivityLevel
-	payload.DurationNs = durationNs
+	payload.DurationNs = audit.durationNs
+	payload.DataSourceID = audit.dataSourceID
+	payload.DataSourceType = audit.dataSourceType
+	payload.Resources = resources
+	payload.Fields = fields
+	payload.RowCount = countRows(audit.results)
 	if queryErr != nil {
 		payload.Error = queryErr.Error()
 		errorLevel := api.ActivityError
@@ -196,10 +210,13 @@ func (s *SQLService) postAdminExecute(ctx context.Context, activity *store.Activ
 	return nil
 }
 
-func (*SQLService) doAdminExecute(ctx context.Context, driver db.Driver, conn *sql.Conn, request *v1pb.AdminExecuteRequest) ([]*v1pb.QueryResult, int64, error) {
+func (*SQLService) doAdminExecute(ctx context.Context, driver db.Driver, conn *sql.Conn, request *v1pb.AdminExecuteRequest) ([]*v1pb.QueryResult, *queryAudit, error) {
+	audit := newQueryAudit(driver)
 	start := time.Now().UnixNano()
 	result, err := driver.RunStatement(ctx, conn, request.Statement)
-	return result, time.Now().UnixNano() - start, err
+	audit.durationNs = time.Now().UnixNano() - start
+	audit.results = result
+	return result, audit, err
 }
 
 func (s *SQLService) preAdminExecute(ctx context.Context, request *v1pb.AdminExecuteRequest) (*store.InstanceMessage, *store.DatabaseMessage, *store.ActivityMessage, error) {
@@ -214,6 +231,7 @@ func (s *SQLService) preAdminExecute(ctx context.Context, request *v1pb.AdminExe
 		DeprecatedInstanceName: instance.Title,
 		DatabaseID:             database.UID,
 		DatabaseName:           request.ConnectionDatabase,
+		ClientIP:               getClientIP(ctx, s.trustedProxies),
 	})
 	if err != nil {
 		return nil, nil, nil, err
@@ -229,9 +247,9 @@ func (s *SQLService) Export(ctx context.Context, request *v1pb.ExportRequest) (*
 		return nil, err
 	}
 
-	bytes, durationNs, exportErr := s.doExport(ctx, request, instance, database, sensitiveSchemaInfo)
+	bytes, audit, exportErr := s.doExport(ctx, request, instance, database, sensitiveSchemaInfo)
 
-	if err := s.postExport(ctx, activity, durationNs, exportErr); err != nil {
+	if err := s.postExport(ctx, request, instance, activity, audit, exportErr); err != nil {
 		return nil, err
 	}
 
@@ -244,15 +262,25 @@ func (s *SQLService) Export(ctx context.Context, request *v1pb.ExportRequest) (*
 	}, nil
 }
 
-func (s *SQLService) postExport(ctx context.Context, activity *store.ActivityMessage, durationNs int64, queryErr error) error {
+func (s *SQLService) postExport(ctx context.Context, request *v1pb.ExportRequest, instance *store.InstanceMessage, activity *store.ActivityMessage, audit *queryAudit, queryErr error) error {
 	// Update the activity
 	var payload api.ActivitySQLExportPayload
 	if err := json.Unmarshal([]byte(activity.Payload), &payload); err != nil {
 		return status.Errorf(codes.Internal, "failed to unmarshal activity payload: %v", err)
 	}
 
+	resources, fields, err := s.getQueryResourcesAndFields(ctx, instance, request.ConnectionDatabase, request.Statement, audit.results)
+	if err != nil {
+		return err
+	}
+
 	var newLevel *api.ActivityLevel
-	payload.DurationNs = durationNs
+	payload.DurationNs = audit.durationNs
+	payload.DataSourceID = audit.dataSourceID
+	payload.DataSourceType = audit.dataSourceType
+	payload.Resources = resources
+	payload.Fields = fields
+	payload.RowCount = countRows(audit.results)
 	if queryErr != nil {
 		payload.Error = queryErr.Error()
 		errorLevel := api.ActivityError
@@ -284,22 +312,19 @@ func (s *SQLService) postExport(ctx context.Context, activity *store.ActivityMes
 	return nil
 }
 
-func (s *SQLService) doExport(ctx context.Context, request *v1pb.ExportRequest, instance *store.InstanceMessage, database *store.DatabaseMessage, sensitiveSchemaInfo *db.SensitiveSchemaInfo) ([]byte, int64, error) {
+func (s *SQLService) doExport(ctx context.Context, request *v1pb.ExportRequest, instance *store.InstanceMessage, database *store.DatabaseMessage, sensitiveSchemaInfo *db.SensitiveSchemaInfo) ([]byte, *queryAudit, error) {
 	driver, err := s.dbFactory.GetReadOnlyDatabaseDriver(ctx, instance, database)
 	if err != nil {
-		return nil, 0, err
+		return nil, &queryAudit{}, err
 	}
 	defer driver.Close(ctx)
+	audit := newQueryAudit(driver)
 
-	sqlDB := driver.GetDB()
-	var conn *sql.Conn
-	if sqlDB != nil {
-		conn, err = sqlDB.Conn(ctx)
-		if err != nil {
-			return nil, 0, err
-		}
-		defer conn.Close()
+	conn, releaseConn, err := dbfactory.GetDedicatedConn(ctx, driver)
+	if err != nil {
+		return nil, audit, err
 	}
+	defer releaseConn()
 
 	start := time.Now().UnixNano()
 	result, err := driver.QueryConn2(ctx, conn, request.Statement, &db.QueryContext{
@@ -310,28 +335,29 @@ func (s *SQLService) doExport(ctx context.Context, request *v1pb.ExportRequest,
 		SensitiveDataMaskType: db.SensitiveDataMaskTypeDefault,
 		SensitiveSchemaInfo:   sensitiveSchemaInfo,
 	})
-	durationNs := time.Now().UnixNano() - start
+	audit.durationNs = time.Now().UnixNano() - start
+	audit.results = result
 	if err != nil {
-		return nil, durationNs, err
+		return nil, audit, err
 	}
 	if len(result) != 1 {
-		return nil, durationNs, errors.Errorf("expecting 1 result, but got %d", len(result))
+		return nil, audit, errors.Errorf("expecting 1 result, but got %d", len(result))
 	}
 
 	var content []byte
 	switch request.Format {
 	case v1pb.ExportRequest_CSV:
 		if content, err = s.exportCSV(result[0]); err != nil {
-			return nil, durationNs, err
+			return nil, audit, err
 		}
 	case v1pb.ExportRequest_JSON:
 		if content, err = s.exportJSON(result[0]); err != nil {
-			return nil, durationNs, err
+			return nil, audit, err
 		}
 	default:
-		return nil, durationNs, status.Errorf(codes.InvalidArgument, "unsupported export format: %s", request.Format.String())
+		return nil, audit, status.Errorf(codes.InvalidArgument, "unsupported export format: %s", request.Format.String())
 	}
-	return content, durationNs, nil
+	return content, audit, nil
 }
 
 func (*SQLService) exportCSV(result *v1pb.QueryResult) ([]byte, error) {
@@ -496,6 +522,7 @@ func (s *SQLService) preExport(ctx context.Context, request *v1pb.ExportRequest)
 		InstanceID:   instance.UID,
 		DatabaseID:   database.UID,
 		DatabaseName: request.ConnectionDatabase,
+		ClientIP:     getClientIP(ctx, s.trustedProxies),
 	})
 	if err != nil {
 		return nil, nil, nil, nil, err
@@ -546,12 +573,12 @@ func (s *SQLService) Query(ctx context.Context, request *v1pb.QueryRequest) (*v1
 
 	var results []*v1pb.QueryResult
 	var queryErr error
-	var durationNs int64
+	audit := &queryAudit{}
 	if adviceStatus != advisor.Error {
-		results, durationNs, queryErr = s.doQuery(ctx, request, instance, database, sensitiveSchemaInfo)
+		results, audit, queryErr = s.doQuery(ctx, request, instance, database, sensitiveSchemaInfo)
 	}
 
-	adviceList, err = s.postQuery(ctx, request, adviceStatus, adviceList, instance, activity, durationNs, queryErr)
+	adviceList, err = s.postQuery(ctx, request, adviceStatus, adviceList, instance, database, activity, audit, queryErr)
 	if err != nil {
 		return nil, err
 	}
@@ -580,8 +607,8 @@ func (s *SQLService) Query(ctx context.Context, request *v1pb.QueryRequest) (*v1
 // postQuery does the following:
 //  1. Check index hit Explain statements
 //  2. Update SQL query activity
-func (s *SQLService) postQuery(ctx context.Context, _ *v1pb.QueryRequest, adviceStatus advisor.Status, adviceList []*v1pb.Advice, _ *store.InstanceMessage, activity *store.ActivityMessage, durationNs int64, queryErr error) ([]*v1pb.Advice, error) {
-	indexHitAdvices, err := s.checkIndexHit()
+func (s *SQLService) postQuery(ctx context.Context, request *v1pb.QueryRequest, adviceStatus advisor.Status, adviceList []*v1pb.Advice, instance *store.InstanceMessage, database *store.DatabaseMessage, activity *store.ActivityMessage, audit *queryAudit, queryErr error) ([]*v1pb.Advice, error) {
+	indexHitAdvices, err := s.checkIndexHit(ctx, request, instance, database)
 	if err != nil {
 		return nil, err
 	}
@@ -595,7 +622,9 @@ func (s *SQLService) postQuery(ctx context.Context, _ *v1pb.QueryRequest, advice
 			finalAdviceList = append(finalAdviceList, adviceList...)
 		}
 		finalAdviceList = append(finalAdviceList, indexHitAdvices...)
-		newLevel = api.ActivityError
+		if newLevel != api.ActivityError {
+			newLevel = api.ActivityWarn
+		}
 	}
 
 	// Update the activity
@@ -604,7 +633,17 @@ func (s *SQLService) postQuery(ctx context.Context, _ *v1pb.QueryRequest, advice
 		return nil, status.Errorf(codes.Internal, "failed to unmarshal activity payload: %v", err)
 	}
 
-	payload.DurationNs = durationNs
+	resources, fields, err := s.getQueryResourcesAndFields(ctx, instance, request.ConnectionDatabase, request.Statement, audit.results)
+	if err != nil {
+		return nil, err
+	}
+
+	payload.DurationNs = audit.durationNs
+	payload.DataSourceID = audit.dataSourceID
+	payload.DataSourceType = audit.dataSourceType
+	payload.Resources = resources
+	payload.Fields = fields
+	payload.RowCount = countRows(audit.results)
 	if queryErr != nil {
 		payload.Error = queryErr.Error()
 		newLevel = api.ActivityError
@@ -635,27 +674,66 @@ func (s *SQLService) postQuery(ctx context.Context, _ *v1pb.QueryRequest, advice
 	return finalAdviceList, nil
 }
 
-func (*SQLService) checkIndexHit() ([]*v1pb.Advice, error) {
-	// TODO(rebelice): implement checkIndexHit
-	return nil, nil
+// checkIndexHit warns the queries scanning large tables fully because they don't filter the tables, or no index matches their predicates.
+func (s *SQLService) checkIndexHit(ctx context.Context, request *v1pb.QueryRequest, instance *store.InstanceMessage, database *store.DatabaseMessage) ([]*v1pb.Advice, error) {
+	if database == nil {
+		return nil, nil
+	}
+	engine := convertToParserEngine(instance.Engine)
+	if !indexadvisor.IsEngineSupported(engine) {
+		return nil, nil
+	}
+	schema, err := s.store.GetDBSchema(ctx, database.UID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to get database schema: %v", err)
+	}
+	if schema == nil {
+		return nil, nil
+	}
+	fullScans, err := indexadvisor.CheckFullScan(engine, schema.Metadata, request.Statement, largeTableRowCount)
+	if err != nil {
+		// The statement is checked by the query anyway, so ignore the statements the index advisor can't parse.
+		log.Debug("Failed to check index hit", zap.String("statement", request.Statement), zap.Error(err))
+		return nil, nil
+	}
+
+	var result []*v1pb.Advice
+	for _, fullScan := range fullScans {
+		result = append(result, convertToFullScanAdvice(fullScan))
+	}
+	return result, nil
+}
+
+func convertToFullScanAdvice(fullScan *indexadvisor.FullScan) *v1pb.Advice {
+	table := fullScan.Table
+	if fullScan.Schema != "" {
+		table = fmt.Sprintf("%s.%s", fullScan.Schema, fullScan.Table)
+	}
+	content := fmt.Sprintf("The query scans the whole table %q with about %d rows because no index matches the columns %s", table, fullScan.RowCount, strings.Join(fullScan.Columns, ", "))
+	if len(fullScan.Columns) == 0 {
+		content = fmt.Sprintf("The query scans the whole table %q with about %d rows because it doesn't filter the table", table, fullScan.RowCount)
+	}
+	return &v1pb.Advice{
+		Status:  v1pb.Advice_WARNING,
+		Code:    int32(advisor.NotUseIndex),
+		Title:   "Full table scan",
+		Content: content,
+	}
 }
 
-func (s *SQLService) doQuery(ctx context.Context, request *v1pb.QueryRequest, instance *store.InstanceMessage, database *store.DatabaseMessage, sensitiveSchemaInfo *db.SensitiveSchemaInfo) ([]*v1pb.QueryResult, int64, error) {
+func (s *SQLService) doQuery(ctx context.Context, request *v1pb.QueryRequest, instance *store.InstanceMessage, database *store.DatabaseMessage, sensitiveSchemaInfo *db.SensitiveSchemaInfo) ([]*v1pb.QueryResult, *queryAudit, error) {
 	driver, err := s.dbFactory.GetReadOnlyDatabaseDriver(ctx, instance, database)
 	if err != nil {
-		return nil, 0, err
+		return nil, &queryAudit{}, err
 	}
 	defer driver.Close(ctx)
+	audit := newQueryAudit(driver)
 
-	sqlDB := driver.GetDB()
-	var conn *sql.Conn
-	if sqlDB != nil {
-		conn, err = sqlDB.Conn(ctx)
-		if err != nil {
-			return nil, 0, err
-		}
-		defer conn.Close()
+	conn, releaseConn, err := dbfactory.GetDedicatedConn(ctx, driver)
+	if err != nil {
+		return nil, audit, err
 	}
+	defer releaseConn()
 
 	start := time.Now().UnixNano()
 	result, err := driver.QueryConn2(ctx, conn, request.Statement, &db.QueryContext{
@@ -666,7 +744,9 @@ func (s *SQLService) doQuery(ctx context.Context, request *v1pb.QueryRequest, in
 		SensitiveDataMaskType: db.SensitiveDataMaskTypeDefault,
 		SensitiveSchemaInfo:   sensitiveSchemaInfo,
 	})
-	return result, time.Now().UnixNano() - start, err
+	audit.durationNs = time.Now().UnixNano() - start
+	audit.results = result
+	return result, audit, err
 }
 
 // preQuery does the following:
@@ -742,6 +822,7 @@ func (s *SQLService) preQuery(ctx context.Context, request *v1pb.QueryRequest) (
 		DeprecatedInstanceName: instance.Title,
 		DatabaseID:             database.UID,
 		DatabaseName:           request.ConnectionDatabase,
+		ClientIP:               getClientIP(ctx, s.trustedProxies),
 		// TODO: here we should use []*v1pb.Advice instead of []advisor.Advice
 		// This should fix when we migrate to v1 activity API
 		// AdviceList:             adviceList,
@@ -782,6 +863,186 @@ func (s *SQLService) createQueryActivity(ctx context.Context, user *store.UserMe
 	return activity, nil
 }
 
+// queryAudit is the audit info collected when executing the statement.
+type queryAudit struct {
+	durationNs     int64
+	dataSourceID   int
+	dataSourceType api.DataSourceType
+	results        []*v1pb.QueryResult
+}
+
+func newQueryAudit(driver db.Driver) *queryAudit {
+	audit := &queryAudit{}
+	if dataSourceID, dataSourceType, ok := dbfactory.GetDriverDataSource(driver); ok {
+		audit.dataSourceID = dataSourceID
+		audit.dataSourceType = dataSourceType
+	}
+	return audit
+}
+
+// getClientIP returns the IP of the client.
+// The X-Forwarded-For header can be set to anything by the client, so it's walked from the peer address to the left,
+// and the first address that isn't a trusted proxy is the client IP. The loopback peer is trusted because the grpc-gateway
+// connects over the loopback and appends the address of the HTTP client to the header.
+func getClientIP(ctx context.Context, trustedProxies []*net.IPNet) string {
+	p, ok := peer.FromContext(ctx)
+	if !ok || p.Addr == nil {
+		return ""
+	}
+	peerIP := p.Addr.String()
+	if host, _, err := net.SplitHostPort(peerIP); err == nil {
+		peerIP = host
+	}
+
+	var hops []string
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		for _, value := range md.Get("x-forwarded-for") {
+			for _, hop := range strings.Split(value, ",") {
+				hops = append(hops, strings.TrimSpace(hop))
+			}
+		}
+	}
+	hops = append(hops, peerIP)
+
+	clientIP := ""
+	for i := len(hops) - 1; i >= 0; i-- {
+		ip := net.ParseIP(hops[i])
+		if ip == nil {
+			break
+		}
+		clientIP = hops[i]
+		if !isTrustedProxy(ip, trustedProxies) {
+			break
+		}
+	}
+	return clientIP
+}
+
+func isTrustedProxy(ip net.IP, trustedProxies []*net.IPNet) bool {
+	if ip.IsLoopback() {
+		return true
+	}
+	for _, network := range trustedProxies {
+		if network.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+func countRows(results []*v1pb.QueryResult) int64 {
+	var count int64
+	for _, result := range results {
+		count += int64(len(result.Rows))
+	}
+	return count
+}
+
+// getQueryResourcesAndFields returns the tables accessed by the statement, and the table columns in the results.
+// The result columns are resolved by name against the columns of the accessed tables,
+// so the computed and aliased columns are not recorded, and a column might be resolved to several tables.
+func (s *SQLService) getQueryResourcesAndFields(ctx context.Context, instance *store.InstanceMessage, databaseName string, statement string, results []*v1pb.QueryResult) ([]api.ActivitySQLResource, []api.ActivitySQLField, error) {
+	resourceList, err := s.extractResourceList(ctx, convertToParserEngine(instance.Engine), databaseName, statement, instance)
+	if err != nil {
+		// The statements not supported by the parser are still executed, e.g. the DDL in admin mode.
+		log.Debug("Failed to extract resource list for query audit", zap.String("statement", statement), zap.Error(err))
+		return nil, nil, nil
+	}
+
+	type columnRef struct {
+		resource  parser.SchemaResource
+		column    string
+		sensitive bool
+	}
+	type databaseInfo struct {
+		dbSchema  *store.DBSchema
+		sensitive map[api.SensitiveData]bool
+	}
+	var resources []api.ActivitySQLResource
+	// columnMap is the map from the lowercase column name to the columns of the accessed tables.
+	columnMap := make(map[string][]columnRef)
+	databaseMap := make(map[string]*databaseInfo)
+	for _, resource := range resourceList {
+		resources = append(resources, api.ActivitySQLResource{
+			Database: resource.Database,
+			Schema:   resource.Schema,
+			Table:    resource.Table,
+		})
+
+		info, ok := databaseMap[resource.Database]
+		if !ok {
+			database, err := s.store.GetDatabaseV2(ctx, &store.FindDatabaseMessage{InstanceID: &instance.ResourceID, DatabaseName: &resource.Database})
+			if err != nil {
+				return nil, nil, status.Errorf(codes.Internal, "failed to fetch database %q: %v", resource.Database, err)
+			}
+			if database != nil {
+				dbSchema, err := s.store.GetDBSchema(ctx, database.UID)
+				if err != nil {
+					return nil, nil, status.Errorf(codes.Internal, "failed to fetch database schema: %v", err)
+				}
+				policy, err := s.store.GetSensitiveDataPolicy(ctx, database.UID)
+				if err != nil {
+					return nil, nil, status.Errorf(codes.Internal, "failed to find sensitive data policy for database %q: %v", resource.Database, err)
+				}
+				if dbSchema != nil {
+					info = &databaseInfo{dbSchema: dbSchema, sensitive: make(map[api.SensitiveData]bool)}
+					for _, data := range policy.SensitiveDataList {
+						info.sensitive[api.SensitiveData{
+							Schema: data.Schema,
+							Table:  data.Table,
+							Column: data.Column,
+						}] = true
+					}
+				}
+			}
+			databaseMap[resource.Database] = info
+		}
+		if info == nil {
+			continue
+		}
+		table := info.dbSchema.FindTable(resource.Schema, resource.Table)
+		if table == nil {
+			continue
+		}
+		for _, column := range table.Columns {
+			key := strings.ToLower(column.Name)
+			columnMap[key] = append(columnMap[key], columnRef{
+				resource: resource,
+				column:   column.Name,
+				sensitive: info.sensitive[api.SensitiveData{
+					Schema: resource.Schema,
+					Table:  resource.Table,
+					Column: column.Name,
+				}],
+			})
+		}
+	}
+
+	var fields []api.ActivitySQLField
+	fieldIndex := make(map[columnRef]int)
+	for _, result := range results {
+		for i, name := range result.ColumnNames {
+			masked := i < len(result.Masked) && result.Masked[i]
+			for _, ref := range columnMap[strings.ToLower(name)] {
+				if index, ok := fieldIndex[ref]; ok {
+					fields[index].Masked = fields[index].Masked || masked
+					continue
+				}
+				fieldIndex[ref] = len(fields)
+				fields = append(fields, api.ActivitySQLField{
+					Database:  ref.resource.Database,
+					Schema:    ref.resource.Schema,
+					Table:     ref.resource.Table,
+					Column:    ref.column,
+					Sensitive: ref.sensitive,
+					Masked:    masked,
+				})
+			}
+		}
+	}
+	return resources, fields, nil
+}
+
 func (s *SQLService) getSensitiveSchemaInfo(ctx context.Context, instance *store.InstanceMessage, databaseList []string, currentDatabase string) (*db.SensitiveSchemaInfo, error) {
 	type sensitiveDataMap map[api.SensitiveData]api.SensitiveDataMaskType
 	isEmpty := true
@@ -970,7 +1231,7 @@ func (s *SQLService) sqlReviewCheck(ctx context.Context, request *v1pb.QueryRequ
 		return advisor.Error, nil, status.Errorf(codes.Internal, "Failed to get database driver: %v", err)
 	}
 	defer driver.Close(ctx)
-	connection := driver.GetDB()
+	connection := dbfactory.GetExplainDB(driver)
 	adviceLevel, adviceList, err := s.sqlCheck(
 		ctx,
 		dbType,
@@ -998,6 +1259,25 @@ func convertAdviceList(list []advisor.Advice) []*v1pb.Advice {
 			Content: advice.Content,
 			Line:    int32(advice.Line),
 			Detail:  advice.Details,
+			Fix:     convertAdviceFix(advice.Fix),
+		})
+	}
+	return result
+}
+
+func convertAdviceFix(fix *advisor.Fix) *v1pb.Fix {
+	if fix == nil {
+		return nil
+	}
+	result := &v1pb.Fix{
+		Title: fix.Title,
+		Safe:  fix.Safe,
+	}
+	for _, edit := range fix.Edits {
+		result.Edits = append(result.Edits, &v1pb.Edit{
+			Start:   int32(edit.Start),
+			End:     int32(edit.End),
+			NewText: edit.NewText,
 		})
 	}
 	return result
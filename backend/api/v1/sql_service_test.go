@@ -0,0 +1,133 @@
+package v1
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+
+	"github.com/bytebase/bytebase/backend/common"
+	"github.com/bytebase/bytebase/backend/plugin/indexadvisor"
+	parser "github.com/bytebase/bytebase/backend/plugin/parser/sql"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+func TestGetClientIP(t *testing.T) {
+	trustedProxies, err := common.ParseTrustedProxies([]string{"10.0.0.0/8"})
+	require.NoError(t, err)
+
+	tests := []struct {
+		name          string
+		peer          string
+		xForwardedFor []string
+		want          string
+	}{
+		{
+			name: "direct",
+			peer: "1.2.3.4:5678",
+			want: "1.2.3.4",
+		},
+		{
+			name:          "forged by untrusted peer",
+			peer:          "1.2.3.4:5678",
+			xForwardedFor: []string{"5.6.7.8"},
+			want:          "1.2.3.4",
+		},
+		{
+			name:          "grpc-gateway",
+			peer:          "127.0.0.1:5678",
+			xForwardedFor: []string{"1.2.3.4"},
+			want:          "1.2.3.4",
+		},
+		{
+			name:          "forged through grpc-gateway",
+			peer:          "127.0.0.1:5678",
+			xForwardedFor: []string{"5.6.7.8, 1.2.3.4"},
+			want:          "1.2.3.4",
+		},
+		{
+			name:          "trusted proxy",
+			peer:          "127.0.0.1:5678",
+			xForwardedFor: []string{"5.6.7.8, 1.2.3.4, 10.0.0.1"},
+			want:          "1.2.3.4",
+		},
+		{
+			name:          "multiple headers",
+			peer:          "10.0.0.2:5678",
+			xForwardedFor: []string{"5.6.7.8", "1.2.3.4, 10.0.0.1"},
+			want:          "1.2.3.4",
+		},
+		{
+			name:          "all trusted",
+			peer:          "127.0.0.1:5678",
+			xForwardedFor: []string{"10.0.0.1"},
+			want:          "10.0.0.1",
+		},
+		{
+			name:          "invalid address",
+			peer:          "127.0.0.1:5678",
+			xForwardedFor: []string{"1.2.3.4, invalid, 10.0.0.1"},
+			want:          "10.0.0.1",
+		},
+	}
+
+	for _, test := range tests {
+		addr, err := net.ResolveTCPAddr("tcp", test.peer)
+		require.NoError(t, err)
+		ctx := peer.NewContext(context.Background(), &peer.Peer{Addr: addr})
+		if len(test.xForwardedFor) > 0 {
+			md := metadata.MD{}
+			md.Append("x-forwarded-for", test.xForwardedFor...)
+			ctx = metadata.NewIncomingContext(ctx, md)
+		}
+		require.Equal(t, test.want, getClientIP(ctx, trustedProxies), test.name)
+	}
+	require.Equal(t, "", getClientIP(context.Background(), trustedProxies))
+}
+
+func TestConvertToFullScanAdvice(t *testing.T) {
+	a := require.New(t)
+	metadata := &storepb.DatabaseMetadata{
+		Name: "db",
+		Schemas: []*storepb.SchemaMetadata{
+			{
+				Tables: []*storepb.TableMetadata{
+					{
+						Name:     "big_table",
+						RowCount: largeTableRowCount + 1,
+						Columns:  []*storepb.ColumnMetadata{{Name: "id"}, {Name: "name"}},
+						Indexes:  []*storepb.IndexMetadata{{Name: "PRIMARY", Primary: true, Unique: true, Expressions: []string{"id"}}},
+					},
+				},
+			},
+		},
+	}
+	tests := []struct {
+		statement string
+		want      []string
+	}{
+		{
+			statement: "SELECT * FROM big_table",
+			want:      []string{`The query scans the whole table "big_table" with about 100001 rows because it doesn't filter the table`},
+		},
+		{
+			statement: "SELECT * FROM big_table WHERE name = 'a'",
+			want:      []string{`The query scans the whole table "big_table" with about 100001 rows because no index matches the columns name`},
+		},
+		{
+			statement: "SELECT * FROM big_table WHERE id = 1",
+		},
+	}
+	for _, test := range tests {
+		fullScans, err := indexadvisor.CheckFullScan(parser.MySQL, metadata, test.statement, largeTableRowCount)
+		a.NoError(err, test.statement)
+		var got []string
+		for _, fullScan := range fullScans {
+			got = append(got, convertToFullScanAdvice(fullScan).Content)
+		}
+		a.Equal(test.want, got, test.statement)
+	}
+}
@@ -0,0 +1,84 @@
+package cmd
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/bytebase/bytebase/backend/plugin/auditlog"
+)
+
+func init() {
+	verifyAuditLogCmd.Flags().StringVar(&verifyAuditLogKeyFile, "key-file", "", "file of the key for the audit log hash chain, the same as --audit-log-key-file of the server")
+	rootCmd.AddCommand(verifyAuditLogCmd)
+}
+
+// verifyAuditLogKeyFile is the file of the key for the audit log hash chain.
+var verifyAuditLogKeyFile string
+
+// maxAuditLogLineSize is the max size of an exported audit log line, which contains the full SQL statement.
+const maxAuditLogLineSize = 64 * 1024 * 1024
+
+var verifyAuditLogCmd = &cobra.Command{
+	Use:   "verify-audit-log FILE...",
+	Short: "Verify the hash chain of the exported audit logs",
+	Long: `Verify the hash chain of the audit logs exported by the file destination, or the syslog messages dumped line by line.
+The files must be given from the oldest to the newest, e.g. audit.log.2 audit.log.1 audit.log.
+The --key-file must be given if the server runs with --audit-log-key-file.`,
+	Args:         cobra.MinimumNArgs(1),
+	SilenceUsage: true,
+	RunE: func(_ *cobra.Command, args []string) error {
+		var key []byte
+		if verifyAuditLogKeyFile != "" {
+			var err error
+			key, err = auditlog.ReadKeyFile(verifyAuditLogKeyFile)
+			if err != nil {
+				return err
+			}
+		}
+		verifier := auditlog.NewVerifier(key)
+		for _, path := range args {
+			if err := verifyAuditLogFile(verifier, path); err != nil {
+				return err
+			}
+		}
+		if verifier.Count == 0 {
+			return errors.Errorf("no audit log is found")
+		}
+		fmt.Printf("Verified %d audit logs from %d to %d, the last hash is %s.\n", verifier.Count, verifier.FirstID, verifier.LastID, verifier.LastHash)
+		if len(key) == 0 {
+			fmt.Println("Warning: the audit logs are verified without the key, which doesn't detect the audit logs rewritten with the database access.")
+		}
+		return nil
+	},
+}
+
+func verifyAuditLogFile(verifier *auditlog.Verifier, path string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return errors.Wrapf(err, "failed to open %s", path)
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 0, 64*1024), maxAuditLogLineSize)
+	for lineNumber := 1; scanner.Scan(); lineNumber++ {
+		if len(scanner.Bytes()) == 0 {
+			continue
+		}
+		record, err := auditlog.ParseRecord(scanner.Bytes())
+		if err != nil {
+			return errors.Wrapf(err, "%s:%d", path, lineNumber)
+		}
+		if err := verifier.Add(record); err != nil {
+			return errors.Wrapf(err, "%s:%d", path, lineNumber)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return errors.Wrapf(err, "failed to read %s", path)
+	}
+	return nil
+}
@@ -83,6 +83,16 @@ var (
 		backupRegion     string
 		backupBucket     string
 		backupCredential string
+
+		// External secret configs.
+		secretFileDir         string
+		secretEnvPrefix       string
+		secretVaultPathPrefix string
+
+		auditLogKeyFile string
+		auditLogFileDir string
+
+		trustedProxies []string
 	}
 
 	rootCmd = &cobra.Command{
@@ -131,6 +141,22 @@ func init() {
 	rootCmd.PersistentFlags().StringVar(&flags.backupBucket, "backup-bucket", "", "bucket where Bytebase stores backup data, e.g., s3://example-bucket. When provided, Bytebase will store data to the S3 bucket.")
 	rootCmd.PersistentFlags().StringVar(&flags.backupRegion, "backup-region", "", "region of the backup bucket, e.g., us-west-2 for AWS S3.")
 	rootCmd.PersistentFlags().StringVar(&flags.backupCredential, "backup-credential", "", "credentials file to use for the backup bucket. It should be the same format as the AWS/GCP credential files.")
+
+	// External secret related flags.
+	// The data source secret references are resolved with the server's identity, so they are restricted to the allowlisted ones.
+	// Each kind of secret reference is disabled if its flag is not provided.
+	rootCmd.PersistentFlags().StringVar(&flags.secretFileDir, "secret-file-dir", "", "directory of the files that the data source secret references can read, e.g., /run/secrets. Empty means file:// references are disabled.")
+	rootCmd.PersistentFlags().StringVar(&flags.secretEnvPrefix, "secret-env-prefix", "", "name prefix of the environment variables that the data source secret references can read, e.g., BB_SECRET_. Empty means env:// references are disabled.")
+	rootCmd.PersistentFlags().StringVar(&flags.secretVaultPathPrefix, "secret-vault-path-prefix", "", "path prefix of the Vault secrets that the data sources can read, e.g., secret/data/bytebase. Empty means Vault secrets are disabled.")
+
+	// The key of the audit log hash chain is held outside the database, so that the chain can't be rewritten with the database access alone.
+	rootCmd.PersistentFlags().StringVar(&flags.auditLogKeyFile, "audit-log-key-file", "", "file of the key for the audit log hash chain. The exported audit logs are verified with the same key. Empty means the chain isn't keyed.")
+	// The audit logs are exported to the files with the server's identity, so the file destinations are restricted to the allowlisted directory.
+	rootCmd.PersistentFlags().StringVar(&flags.auditLogFileDir, "audit-log-file-dir", "", "directory that the audit log file export destinations can write to, e.g., /var/log/bytebase. Empty means the file destinations are disabled.")
+
+	// The client IP is taken from the X-Forwarded-For header only if the request comes from a trusted proxy,
+	// since the clients can set the header to anything.
+	rootCmd.PersistentFlags().StringSliceVar(&flags.trustedProxies, "trusted-proxies", nil, "comma separated IP addresses or CIDRs of the reverse proxies in front of Bytebase, e.g., 10.0.0.0/8. The client IP is the rightmost X-Forwarded-For address that isn't a trusted proxy. Empty means the peer address is the client IP.")
 }
 
 // -----------------------------------Command Line Config END--------------------------------------
@@ -155,6 +181,28 @@ func checkDataDir() error {
 	return nil
 }
 
+func checkSecretFlags() error {
+	if flags.secretFileDir == "" {
+		return nil
+	}
+	if !filepath.IsAbs(flags.secretFileDir) {
+		return errors.Errorf("--secret-file-dir must be an absolute path, got %s", flags.secretFileDir)
+	}
+	flags.secretFileDir = filepath.Clean(flags.secretFileDir)
+	return nil
+}
+
+func checkAuditLogFlags() error {
+	if flags.auditLogFileDir == "" {
+		return nil
+	}
+	if !filepath.IsAbs(flags.auditLogFileDir) {
+		return errors.Errorf("--audit-log-file-dir must be an absolute path, got %s", flags.auditLogFileDir)
+	}
+	flags.auditLogFileDir = filepath.Clean(flags.auditLogFileDir)
+	return nil
+}
+
 func checkCloudBackupFlags() error {
 	if flags.backupBucket == "" {
 		return nil
@@ -201,6 +249,16 @@ func start() {
 		return
 	}
 
+	if err := checkSecretFlags(); err != nil {
+		log.Error(err.Error())
+		return
+	}
+
+	if err := checkAuditLogFlags(); err != nil {
+		log.Error(err.Error())
+		return
+	}
+
 	if err := checkCloudBackupFlags(); err != nil {
 		log.Error("invalid flags for cloud backup", zap.Error(err))
 		return
@@ -19,15 +19,38 @@ var RiskFactors = []cel.EnvOption{
 	cel.Variable("database_name", cel.StringType),
 	cel.Variable("db_engine", cel.StringType),
 	cel.Variable("sql_type", cel.StringType),
+	// the strongest table lock of the statement, e.g. "ACCESS EXCLUSIVE" for PostgreSQL or "SHARED" for MySQL.
+	cel.Variable("lock_mode", cel.StringType),
 
 	// number factors
 	cel.Variable("affected_rows", cel.IntType),
+	// the estimated rows rewritten or scanned while the statement blocks writes.
+	cel.Variable("blocking_rows", cel.IntType),
 }
 
 // ApprovalFactors are the variables when finding the approval template.
+// The statement factors are evaluated for each statement of the issue, and the rule matches if any statement matches.
 var ApprovalFactors = []cel.EnvOption{
 	cel.Variable("level", cel.IntType),
 	cel.Variable("source", cel.IntType),
+
+	// issue factors
+	cel.Variable("labels", cel.ListType(cel.StringType)),
+	// the workspace role of the issue creator, e.g. "DBA".
+	cel.Variable("creator_role", cel.StringType),
+	// the number of tasks pending approval in the issue.
+	cel.Variable("task_count", cel.IntType),
+
+	// statement factors
+	// use environment.resource_id
+	cel.Variable("environment_id", cel.StringType),
+	// use project.resource_id
+	cel.Variable("project_id", cel.StringType),
+	cel.Variable("db_engine", cel.StringType),
+	cel.Variable("sql_type", cel.StringType),
+	cel.Variable("affected_rows", cel.IntType),
+	// the names of the tables touched by the task of the statement.
+	cel.Variable("table_names", cel.ListType(cel.StringType)),
 }
 
 // ConvertParsedRisk converts parsed risk to unparsed format.
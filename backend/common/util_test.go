@@ -226,3 +226,18 @@ func TestNormalizeExternalURL(t *testing.T) {
 		})
 	}
 }
+
+func TestParseTrustedProxies(t *testing.T) {
+	a := require.New(t)
+	networks, err := ParseTrustedProxies([]string{"10.0.0.0/8", " 192.168.1.1 ", "", "::1"})
+	a.NoError(err)
+	a.Len(networks, 3)
+	a.Equal("10.0.0.0/8", networks[0].String())
+	a.Equal("192.168.1.1/32", networks[1].String())
+	a.Equal("::1/128", networks[2].String())
+
+	_, err = ParseTrustedProxies([]string{"10.0.0.256"})
+	a.ErrorContains(err, "invalid trusted proxy")
+	_, err = ParseTrustedProxies([]string{"10.0.0.0/33"})
+	a.ErrorContains(err, "invalid trusted proxy")
+}
@@ -7,7 +7,6 @@ import (
 	"fmt"
 	"sort"
 	"strconv"
-	"strings"
 	"time"
 
 	"github.com/gosimple/slug"
@@ -16,6 +15,7 @@ import (
 
 	"github.com/bytebase/bytebase/backend/common"
 	"github.com/bytebase/bytebase/backend/common/log"
+	"github.com/bytebase/bytebase/backend/component/state"
 	api "github.com/bytebase/bytebase/backend/legacyapi"
 	"github.com/bytebase/bytebase/backend/plugin/webhook"
 	"github.com/bytebase/bytebase/backend/store"
@@ -28,7 +28,8 @@ import (
 
 // Manager is the activity manager.
 type Manager struct {
-	store *store.Store
+	store    *store.Store
+	stateCfg *state.State
 }
 
 // Metadata is the activity metadata.
@@ -37,9 +38,10 @@ type Metadata struct {
 }
 
 // NewManager creates an activity manager.
-func NewManager(store *store.Store) *Manager {
+func NewManager(store *store.Store, stateCfg *state.State) *Manager {
 	return &Manager{
-		store: store,
+		store:    store,
+		stateCfg: stateCfg,
 	}
 }
 
@@ -76,6 +78,7 @@ func (m *Manager) BatchCreateTaskStatusUpdateApprovalActivity(ctx context.Contex
 		return errors.Errorf("failed to create any activity")
 	}
 	anyActivity := activityList[0]
+	m.stateCfg.CommitStatusReporting.Store(issue.UID, issue)
 
 	activityType := api.ActivityPipelineTaskStatusUpdate
 	webhookList, err := m.store.FindProjectWebhookV2(ctx, &store.FindProjectWebhookMessage{
@@ -137,6 +140,8 @@ func (m *Manager) CreateActivity(ctx context.Context, create *store.ActivityMess
 	if meta.Issue == nil {
 		return activity, nil
 	}
+	// The issue activities are created on issue progress, e.g. approval, task and stage status changes.
+	m.stateCfg.CommitStatusReporting.Store(meta.Issue.UID, meta.Issue)
 	postInbox, err := shouldPostInbox(activity, create.Type)
 	if err != nil {
 		return nil, errors.Wrapf(err, "failed to post webhook event after changing the issue task status: %s", meta.Issue.Title)
@@ -426,52 +431,34 @@ func (m *Manager) getWebhookContext(ctx context.Context, activity *store.Activit
 		}
 		pendingStep := protoPayload.ApprovalStep
 
-		title = "Issue approval needed - " + meta.Issue.Title
-
-		if len(pendingStep.Nodes) != 1 {
-			log.Warn("Failed to post webhook event after changing the issue approval node status, pending step nodes length is not 1")
-			return nil, errors.Errorf("pending step nodes length is not 1, got %v", len(pendingStep.Nodes))
-		}
-
-		node := pendingStep.Nodes[0]
-
-		var usersGetter func(ctx context.Context) ([]*store.UserMessage, error)
-
-		switch val := node.Payload.(type) {
-		case *storepb.ApprovalNode_GroupValue_:
-			switch val.GroupValue {
-			case storepb.ApprovalNode_GROUP_VALUE_UNSPECIFILED:
-				return nil, errors.Errorf("invalid group value")
-			case storepb.ApprovalNode_WORKSPACE_OWNER:
-				usersGetter = getUsersFromWorkspaceRole(m.store, api.Owner)
-			case storepb.ApprovalNode_WORKSPACE_DBA:
-				usersGetter = getUsersFromWorkspaceRole(m.store, api.DBA)
-			case storepb.ApprovalNode_PROJECT_OWNER:
-				usersGetter = getUsersFromProjectRole(m.store, api.Owner, meta.Issue.Project.ResourceID)
-			case storepb.ApprovalNode_PROJECT_MEMBER:
-				usersGetter = getUsersFromProjectRole(m.store, api.Developer, meta.Issue.Project.ResourceID)
-			default:
-				return nil, errors.Errorf("invalid group value")
-			}
-		case *storepb.ApprovalNode_Role:
-			role := api.Role(strings.TrimPrefix(val.Role, "roles/"))
-			usersGetter = getUsersFromProjectRole(m.store, role, meta.Issue.Project.ResourceID)
-		case *storepb.ApprovalNode_ExternalNodeId:
-			usersGetter = func(ctx context.Context) ([]*store.UserMessage, error) {
-				return nil, nil
-			}
+		switch protoPayload.Type {
+		case storepb.ActivityIssueApprovalNotifyPayload_REMINDER:
+			level = webhook.WebhookWarn
+			title = "Issue approval overdue - " + meta.Issue.Title
+		case storepb.ActivityIssueApprovalNotifyPayload_ESCALATION:
+			level = webhook.WebhookWarn
+			title = "Issue approval escalated - " + meta.Issue.Title
 		default:
-			return nil, errors.Errorf("invalid node payload type")
+			title = "Issue approval needed - " + meta.Issue.Title
 		}
 
-		users, err := usersGetter(ctx)
-		if err != nil {
-			log.Warn("Failed to post webhook event after changing the issue approval node status, failed to get users",
-				zap.String("issue_name", meta.Issue.Title),
-				zap.Error(err))
-			return nil, err
+		var users []*store.UserMessage
+		for _, node := range pendingStep.GetNodes() {
+			nodeUsers, err := utils.ListApprovalNodeUsers(ctx, m.store, node, meta.Issue.Project.ResourceID)
+			if err != nil {
+				log.Warn("Failed to post webhook event after changing the issue approval node status, failed to get users",
+					zap.String("issue_name", meta.Issue.Title),
+					zap.Error(err))
+				return nil, err
+			}
+			users = append(users, nodeUsers...)
 		}
+		mentioned := map[int]bool{}
 		for _, user := range users {
+			if mentioned[user.ID] {
+				continue
+			}
+			mentioned[user.ID] = true
 			phoneNumber, err := phonenumbers.Parse(user.Phone, "")
 			if err != nil {
 				log.Warn("Failed to post webhook event after changing the issue approval node status, failed to parse phone number",
@@ -571,29 +558,3 @@ func shouldPostInbox(activity *store.ActivityMessage, createType api.ActivityTyp
 	}
 	return false, nil
 }
-
-func getUsersFromWorkspaceRole(s *store.Store, role api.Role) func(context.Context) ([]*store.UserMessage, error) {
-	return func(ctx context.Context) ([]*store.UserMessage, error) {
-		return s.ListUsers(ctx, &store.FindUserMessage{
-			Role: &role,
-		})
-	}
-}
-
-func getUsersFromProjectRole(s *store.Store, role api.Role, projectID string) func(context.Context) ([]*store.UserMessage, error) {
-	return func(ctx context.Context) ([]*store.UserMessage, error) {
-		projectIAM, err := s.GetProjectPolicy(ctx, &store.GetProjectPolicyMessage{
-			ProjectID: &projectID,
-		})
-		if err != nil {
-			return nil, err
-		}
-		var users []*store.UserMessage
-		for _, binding := range projectIAM.Bindings {
-			if binding.Role == role {
-				users = append(users, binding.Members...)
-			}
-		}
-		return users, nil
-	}
-}
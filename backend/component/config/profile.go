@@ -51,6 +51,22 @@ type Profile struct {
 	BackupBucket         string
 	BackupCredentialFile string
 
+	// External secret related fields
+	// SecretFileDir is the directory of the files that the data source secret references can read.
+	SecretFileDir string
+	// SecretEnvPrefix is the name prefix of the environment variables that the data source secret references can read.
+	SecretEnvPrefix string
+	// SecretVaultPathPrefix is the path prefix of the Vault secrets that the data source secret references can read.
+	SecretVaultPathPrefix string
+
+	// AuditLogKeyFile is the file of the key for the audit log hash chain. Empty means the chain isn't keyed.
+	AuditLogKeyFile string
+	// AuditLogFileDir is the directory that the audit log file export destinations can write to. Empty means the file destinations are disabled.
+	AuditLogFileDir string
+
+	// TrustedProxies is the IP addresses and CIDRs of the reverse proxies whose X-Forwarded-For header is trusted.
+	TrustedProxies []string
+
 	// IM integration related fields
 	// FeishuAPIURL is the URL of Feishu API server.
 	FeishuAPIURL string
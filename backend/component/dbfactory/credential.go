@@ -0,0 +1,155 @@
+package dbfactory
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/bytebase/bytebase/backend/common/log"
+	"github.com/bytebase/bytebase/backend/plugin/db"
+	secretplugin "github.com/bytebase/bytebase/backend/plugin/secret"
+	"github.com/bytebase/bytebase/backend/plugin/secret/cloudiam"
+	"github.com/bytebase/bytebase/backend/plugin/secret/vault"
+	"github.com/bytebase/bytebase/backend/store"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+// leaseRevokeTimeout is the timeout to revoke the credential lease when the driver is closed.
+const leaseRevokeTimeout = 10 * time.Second
+
+// shortLivedCredential is the credential obtained for a single connection.
+type shortLivedCredential struct {
+	username          string
+	password          string
+	iamAuthentication bool
+	// refreshPassword obtains a fresh token for the new connections, since the cloud IAM authentication token expires in minutes.
+	refreshPassword func(ctx context.Context) (string, error)
+	// lease is set for the credential leased from the Vault database secrets engine.
+	lease         *vault.DatabaseCredential
+	vaultProvider *vault.Provider
+}
+
+// getShortLivedCredential obtains the credential by the authentication type of the data source.
+// It returns nil if the data source uses the static username and password.
+func getShortLivedCredential(ctx context.Context, dataSource *store.DataSourceMessage, secretAllowlist *secretplugin.Allowlist) (*shortLivedCredential, error) {
+	switch dataSource.AuthenticationType {
+	case storepb.DataSourceAuthenticationType_VAULT_DATABASE_SECRET:
+		if err := secretAllowlist.CheckVaultPath(dataSource.VaultDatabaseCredentialPath); err != nil {
+			return nil, err
+		}
+		provider := vault.NewProvider(secretplugin.ProviderConfig{})
+		lease, err := provider.GetDatabaseCredential(ctx, dataSource.VaultDatabaseCredentialPath)
+		if err != nil {
+			return nil, err
+		}
+		return &shortLivedCredential{
+			username:      lease.Username,
+			password:      lease.Password,
+			lease:         lease,
+			vaultProvider: provider,
+		}, nil
+	case storepb.DataSourceAuthenticationType_AWS_RDS_IAM:
+		refreshPassword := func(ctx context.Context) (string, error) {
+			return cloudiam.GetAWSRDSAuthToken(ctx, dataSource.Host, dataSource.Port, dataSource.AWSRegion, dataSource.Username)
+		}
+		token, err := refreshPassword(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return &shortLivedCredential{
+			username:          dataSource.Username,
+			password:          token,
+			iamAuthentication: true,
+			refreshPassword:   refreshPassword,
+		}, nil
+	case storepb.DataSourceAuthenticationType_GCP_CLOUD_SQL_IAM:
+		token, err := cloudiam.GetGCPCloudSQLAccessToken(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return &shortLivedCredential{
+			username:          dataSource.Username,
+			password:          token,
+			iamAuthentication: true,
+			refreshPassword:   cloudiam.GetGCPCloudSQLAccessToken,
+		}, nil
+	default:
+		return nil, nil
+	}
+}
+
+// revokeLease revokes the credential lease if any. It's called when the driver is closed or fails to open.
+func (c *shortLivedCredential) revokeLease() {
+	if c.lease == nil || c.lease.LeaseID == "" {
+		return
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), leaseRevokeTimeout)
+	defer cancel()
+	if err := c.vaultProvider.RevokeLease(ctx, c.lease.LeaseID); err != nil {
+		log.Warn("Failed to revoke the database credential lease", zap.String("leaseID", c.lease.LeaseID), zap.Error(err))
+	}
+}
+
+// leasedDriver is the driver connected with the leased credential.
+// The lease is renewed in the background while the driver is open, and revoked when the driver is closed.
+type leasedDriver struct {
+	db.Driver
+	credential *shortLivedCredential
+
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+func newLeasedDriver(driver db.Driver, credential *shortLivedCredential) *leasedDriver {
+	ctx, cancel := context.WithCancel(context.Background())
+	d := &leasedDriver{
+		Driver:     driver,
+		credential: credential,
+		cancel:     cancel,
+		done:       make(chan struct{}),
+	}
+	go d.renewLease(ctx)
+	return d
+}
+
+// renewLease renews the lease at the half of the lease duration until the driver is closed or the lease can't be renewed.
+func (d *leasedDriver) renewLease(ctx context.Context) {
+	defer close(d.done)
+	lease := d.credential.lease
+	if !lease.Renewable || lease.LeaseID == "" {
+		return
+	}
+	duration := lease.LeaseDuration
+	for duration > 0 {
+		timer := time.NewTimer(duration / 2)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return
+		case <-timer.C:
+		}
+		renewed, err := d.credential.vaultProvider.RenewLease(ctx, lease.LeaseID, lease.LeaseDuration)
+		if err != nil {
+			if ctx.Err() == nil {
+				log.Warn("Failed to renew the database credential lease", zap.String("leaseID", lease.LeaseID), zap.Error(err))
+			}
+			return
+		}
+		duration = renewed
+	}
+}
+
+// Close closes the driver and revokes the lease.
+func (d *leasedDriver) Close(ctx context.Context) error {
+	err := d.Driver.Close(ctx)
+	d.cancel()
+	<-d.done
+	d.credential.revokeLease()
+	return err
+}
+
+// Unwrap returns the engine driver.
+func (d *leasedDriver) Unwrap() db.Driver {
+	return d.Driver
+}
@@ -0,0 +1,86 @@
+package dbfactory
+
+import (
+	"context"
+	"database/sql"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/bytebase/bytebase/backend/plugin/db"
+	secretplugin "github.com/bytebase/bytebase/backend/plugin/secret"
+	"github.com/bytebase/bytebase/backend/plugin/secret/vault"
+	v1pb "github.com/bytebase/bytebase/proto/generated-go/v1"
+)
+
+type fakeDriver struct {
+	db.Driver
+	closed  bool
+	pingErr error
+	sqlDB   *sql.DB
+}
+
+func (d *fakeDriver) Ping(context.Context) error {
+	return d.pingErr
+}
+
+func (d *fakeDriver) GetDB() *sql.DB {
+	return d.sqlDB
+}
+
+func (*fakeDriver) QueryConn2(context.Context, *sql.Conn, string, *db.QueryContext) ([]*v1pb.QueryResult, error) {
+	return nil, nil
+}
+
+func (d *fakeDriver) Close(context.Context) error {
+	d.closed = true
+	return nil
+}
+
+func TestLeasedDriver(t *testing.T) {
+	var mu sync.Mutex
+	var renewed, revoked int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		switch r.URL.Path {
+		case "/v1/sys/leases/renew":
+			renewed++
+			_, _ = w.Write([]byte(`{"lease_id": "database/creds/readonly/abc", "lease_duration": 1, "renewable": true}`))
+		case "/v1/sys/leases/revoke":
+			revoked++
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+	t.Setenv("VAULT_ADDR", server.URL)
+
+	engineDriver := &fakeDriver{}
+	driver := newLeasedDriver(engineDriver, &shortLivedCredential{
+		lease: &vault.DatabaseCredential{
+			LeaseID:       "database/creds/readonly/abc",
+			LeaseDuration: time.Second,
+			Renewable:     true,
+		},
+		vaultProvider: vault.NewProvider(secretplugin.ProviderConfig{}),
+	})
+	require.Equal(t, engineDriver, db.Unwrap(driver))
+
+	require.Eventually(t, func() bool {
+		mu.Lock()
+		defer mu.Unlock()
+		return renewed > 0
+	}, 5*time.Second, 50*time.Millisecond)
+
+	require.NoError(t, driver.Close(context.Background()))
+	require.True(t, engineDriver.closed)
+	mu.Lock()
+	defer mu.Unlock()
+	require.Equal(t, 1, revoked)
+}
@@ -3,14 +3,24 @@ package dbfactory
 
 import (
 	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
 
 	"github.com/bytebase/bytebase/backend/common"
+	"github.com/bytebase/bytebase/backend/common/log"
 	api "github.com/bytebase/bytebase/backend/legacyapi"
 	"github.com/bytebase/bytebase/backend/plugin/db"
+	secretplugin "github.com/bytebase/bytebase/backend/plugin/secret"
 	"github.com/bytebase/bytebase/backend/store"
 	"github.com/bytebase/bytebase/backend/utils"
 )
 
+// externalSecretTTL is the duration to cache the credentials resolved from the external secret manager.
+const externalSecretTTL = 5 * time.Minute
+
 // DBFactory is the factory for building database driver.
 type DBFactory struct {
 	mysqlBinDir string
@@ -18,21 +28,70 @@ type DBFactory struct {
 	mongoBinDir string
 	dataDir     string
 	secret      string
+
+	secretAllowlist *secretplugin.Allowlist
+	secretResolver  *secretplugin.Resolver
+	driverPool      *driverPool
+	replicaRouter   *replicaRouter
 }
 
 // New creates a new database driver factory.
-func New(mysqlBinDir, mongoBinDir, pgBinDir, dataDir, secret string) *DBFactory {
+// The secretAllowlist restricts the external secrets that the data sources can read.
+func New(mysqlBinDir, mongoBinDir, pgBinDir, dataDir, secret string, secretAllowlist *secretplugin.Allowlist) *DBFactory {
 	return &DBFactory{
 		mysqlBinDir: mysqlBinDir,
 		mongoBinDir: mongoBinDir,
 		pgBinDir:    pgBinDir,
 		dataDir:     dataDir,
 		secret:      secret,
+
+		secretAllowlist: secretAllowlist,
+		secretResolver:  secretplugin.NewResolver(externalSecretTTL, secretAllowlist),
+		driverPool:      newDriverPool(),
+		replicaRouter:   newReplicaRouter(),
+	}
+}
+
+// GetSecretAllowlist returns the allowlist of the external secrets that the data sources can read.
+func (d *DBFactory) GetSecretAllowlist() *secretplugin.Allowlist {
+	return d.secretAllowlist
+}
+
+// Run evicts the idle pooled drivers periodically.
+func (d *DBFactory) Run(ctx context.Context, wg *sync.WaitGroup) {
+	ticker := time.NewTicker(driverPoolEvictionInterval)
+	defer ticker.Stop()
+	defer wg.Done()
+	log.Debug(fmt.Sprintf("Driver pool evictor started and will run every %v", driverPoolEvictionInterval))
+	for {
+		select {
+		case <-ticker.C:
+			d.driverPool.evict()
+		case <-ctx.Done():
+			return
+		}
 	}
 }
 
+// Close closes the idle pooled drivers.
+func (d *DBFactory) Close() {
+	d.driverPool.closeAll()
+}
+
+// ClearDriverPool closes the idle pooled drivers of the instance, e.g. when the data sources are changed
+// or the databases are going to be renamed.
+func (d *DBFactory) ClearDriverPool(instanceUID int) {
+	d.driverPool.invalidate(instanceUID)
+}
+
+// GetDriverPoolStats returns the usage statistics of the driver pool.
+func (d *DBFactory) GetDriverPoolStats() DriverPoolStats {
+	return d.driverPool.getStats()
+}
+
 // GetAdminDatabaseDriver gets the admin database driver using the instance's admin data source.
-// Upon successful return, caller must call driver.Close(). Otherwise, it will leak the database connection.
+// The driver is checked out from the driver pool.
+// Upon successful return, caller must call driver.Close() to return it to the pool. Otherwise, it will leak the database connection.
 func (d *DBFactory) GetAdminDatabaseDriver(ctx context.Context, instance *store.InstanceMessage, database *store.DatabaseMessage) (db.Driver, error) {
 	dataSource := utils.DataSourceFromInstanceWithType(instance, api.Admin)
 	if dataSource == nil {
@@ -46,31 +105,92 @@ func (d *DBFactory) GetAdminDatabaseDriver(ctx context.Context, instance *store.
 	if database != nil && database.DataShare {
 		datashare = true
 	}
-	return d.GetDataSourceDriver(ctx, instance.Engine, dataSource, databaseName, instance.ResourceID, instance.UID, datashare, false /* readOnly */)
+	return d.getPooledDataSourceDriver(ctx, instance, dataSource, databaseName, datashare, false /* readOnly */)
 }
 
-// GetReadOnlyDatabaseDriver gets the read-only database driver using the instance's read-only data source.
+// GetReadOnlyDatabaseDriver gets the read-only database driver using the instance's read-only data sources.
+// The checkouts are balanced across the healthy read-only data sources within the max replication lag.
+// The lag isn't enforced on the data sources whose lag can't be read, e.g. the user lacks the privilege to read it.
+// If none of them is healthy, it returns an error instead of using an unreachable or lagging replica. We don't fall back to
+// the admin data source in that case because the read-only data sources may use a less privileged user.
 // If the read-only data source is not defined, we will fallback to admin data source.
-// Upon successful return, caller must call driver.Close(). Otherwise, it will leak the database connection.
+// The driver is checked out from the driver pool.
+// Upon successful return, caller must call driver.Close() to return it to the pool. Otherwise, it will leak the database connection.
 func (d *DBFactory) GetReadOnlyDatabaseDriver(ctx context.Context, instance *store.InstanceMessage, database *store.DatabaseMessage) (db.Driver, error) {
-	dataSource := utils.DataSourceFromInstanceWithType(instance, api.RO)
-	adminDataSource := utils.DataSourceFromInstanceWithType(instance, api.Admin)
-	// If there are no read-only data source, fall back to admin data source.
-	if dataSource == nil {
-		dataSource = adminDataSource
+	databaseName := ""
+	datashare := false
+	if database != nil {
+		databaseName = database.DatabaseName
+		datashare = database.DataShare
 	}
-	if dataSource == nil {
+
+	if dataSources := getDataSourcesWithType(instance, api.RO); len(dataSources) > 0 {
+		if driver := d.getHealthyReplicaDriver(ctx, instance, dataSources, databaseName, datashare); driver != nil {
+			return driver, nil
+		}
+		return nil, common.Errorf(common.DbConnectionFailure, "no read-only data source of instance %q is reachable within the max replication lag", instance.Title)
+	}
+	// If there are no read-only data source, fall back to admin data source.
+	adminDataSource := utils.DataSourceFromInstanceWithType(instance, api.Admin)
+	if adminDataSource == nil {
 		return nil, common.Errorf(common.Internal, "data source not found for instance %q", instance.Title)
 	}
+	return d.getPooledDataSourceDriver(ctx, instance, adminDataSource, databaseName, datashare, true /* readOnly */)
+}
 
-	databaseName := ""
-	if database != nil {
-		databaseName = database.DatabaseName
+// GetReplicaPreferredDatabaseDriver gets the database driver for the read workloads like schema sync, which prefer
+// the healthy read-only data sources used for sync so that the primary isn't hit. It falls back to the admin data source.
+// Upon successful return, caller must call driver.Close() to return it to the pool. Otherwise, it will leak the database connection.
+func (d *DBFactory) GetReplicaPreferredDatabaseDriver(ctx context.Context, instance *store.InstanceMessage, database *store.DatabaseMessage) (db.Driver, error) {
+	var dataSources []*store.DataSourceMessage
+	for _, dataSource := range getDataSourcesWithType(instance, api.RO) {
+		if dataSource.UseForSync {
+			dataSources = append(dataSources, dataSource)
+		}
+	}
+	if len(dataSources) > 0 {
+		databaseName := ""
+		datashare := false
+		if database != nil {
+			databaseName = database.DatabaseName
+			datashare = database.DataShare
+		}
+		if driver := d.getHealthyReplicaDriver(ctx, instance, dataSources, databaseName, datashare); driver != nil {
+			return driver, nil
+		}
+	}
+	return d.GetAdminDatabaseDriver(ctx, instance, database)
+}
+
+func (d *DBFactory) getPooledDataSourceDriver(ctx context.Context, instance *store.InstanceMessage, dataSource *store.DataSourceMessage, databaseName string, datashare, readOnly bool) (db.Driver, error) {
+	fingerprint, err := getDataSourceFingerprint(instance.Engine, dataSource)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get data source fingerprint")
+	}
+	key := driverPoolKey{
+		instanceUID:    instance.UID,
+		databaseName:   databaseName,
+		dataSourceUID:  dataSource.UID,
+		dataSourceType: dataSource.Type,
+		datashare:      datashare,
+		readOnly:       readOnly,
+	}
+	return d.driverPool.get(ctx, key, fingerprint, getDriverMaxLifetime(dataSource), func() (db.Driver, error) {
+		return d.GetDataSourceDriver(ctx, instance.Engine, dataSource, databaseName, instance.ResourceID, instance.UID, datashare, readOnly)
+	})
+}
+
+func getDataSourcesWithType(instance *store.InstanceMessage, dataSourceType api.DataSourceType) []*store.DataSourceMessage {
+	var dataSources []*store.DataSourceMessage
+	for _, dataSource := range instance.DataSources {
+		if dataSource.Type == dataSourceType {
+			dataSources = append(dataSources, dataSource)
+		}
 	}
-	return d.GetDataSourceDriver(ctx, instance.Engine, dataSource, databaseName, instance.ResourceID, instance.UID, database.DataShare, true /* readOnly */)
+	return dataSources
 }
 
-// GetDataSourceDriver returns the database driver for a data source.
+// GetDataSourceDriver returns the database driver for a data source. The driver is not pooled.
 func (d *DBFactory) GetDataSourceDriver(ctx context.Context, engine db.Type, dataSource *store.DataSourceMessage, databaseName, instanceID string, instanceUID int, datashare, readOnly bool) (db.Driver, error) {
 	dbBinDir := ""
 	switch engine {
@@ -114,6 +234,40 @@ func (d *DBFactory) GetDataSourceDriver(ctx context.Context, engine db.Type, dat
 	if err != nil {
 		return nil, err
 	}
+	// The credentials in the external secret manager take precedence over the ones stored in the data source.
+	if externalSecret := dataSource.ExternalSecret; externalSecret != nil {
+		for _, credential := range []struct {
+			reference string
+			value     *string
+		}{
+			{reference: externalSecret.Password, value: &password},
+			{reference: externalSecret.SslCa, value: &sslCA},
+			{reference: externalSecret.SslCert, value: &sslCert},
+			{reference: externalSecret.SslKey, value: &sslKey},
+			{reference: externalSecret.SshPassword, value: &sshPassword},
+			{reference: externalSecret.SshPrivateKey, value: &sshPrivateKey},
+		} {
+			if credential.reference == "" {
+				continue
+			}
+			value, err := d.secretResolver.Resolve(ctx, credential.reference)
+			if err != nil {
+				return nil, errors.Wrapf(err, "failed to resolve the external secret")
+			}
+			*credential.value = value
+		}
+	}
+	username := dataSource.Username
+	credential, err := getShortLivedCredential(ctx, dataSource, d.secretAllowlist)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to obtain the short-lived credential for %s authentication", dataSource.AuthenticationType)
+	}
+	iamAuthentication := false
+	var refreshPassword func(ctx context.Context) (string, error)
+	if credential != nil {
+		username, password, iamAuthentication = credential.username, credential.password, credential.iamAuthentication
+		refreshPassword = credential.refreshPassword
+	}
 	sshConfig := db.SSHConfig{
 		Host:       dataSource.SSHHost,
 		Port:       dataSource.SSHPort,
@@ -129,7 +283,7 @@ func (d *DBFactory) GetDataSourceDriver(ctx context.Context, engine db.Type, dat
 			BinlogDir: common.GetBinlogAbsDir(d.dataDir, instanceUID),
 		},
 		db.ConnectionConfig{
-			Username: dataSource.Username,
+			Username: username,
 			Password: password,
 			TLSConfig: db.TLSConfig{
 				SslCA:   sslCA,
@@ -146,14 +300,22 @@ func (d *DBFactory) GetDataSourceDriver(ctx context.Context, engine db.Type, dat
 			ServiceName:            dataSource.ServiceName,
 			SSHConfig:              sshConfig,
 			ReadOnly:               readOnly,
+			IAMAuthentication:      iamAuthentication,
+			RefreshPassword:        refreshPassword,
 		},
 		db.ConnectionContext{
 			InstanceID: instanceID,
 		},
 	)
 	if err != nil {
+		if credential != nil {
+			credential.revokeLease()
+		}
 		return nil, err
 	}
+	if credential != nil && credential.lease != nil {
+		return newLeasedDriver(driver, credential), nil
+	}
 
 	return driver, nil
 }
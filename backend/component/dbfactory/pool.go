@@ -0,0 +1,429 @@
+package dbfactory
+
+import (
+	"context"
+	"crypto/sha256"
+	"database/sql"
+	sqldriver "database/sql/driver"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/bytebase/bytebase/backend/common/log"
+	api "github.com/bytebase/bytebase/backend/legacyapi"
+	"github.com/bytebase/bytebase/backend/plugin/db"
+	"github.com/bytebase/bytebase/backend/store"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+	v1pb "github.com/bytebase/bytebase/proto/generated-go/v1"
+)
+
+const (
+	// maxIdleDriversPerKey is the maximum number of idle drivers kept for the same database and data source.
+	maxIdleDriversPerKey = 2
+	// maxIdleDrivers is the maximum number of idle drivers kept in total.
+	maxIdleDrivers = 256
+	// driverIdleTimeout is the duration after which an idle driver is closed.
+	driverIdleTimeout = 5 * time.Minute
+	// driverMaxLifetime is the maximum duration a driver is reused since it's opened.
+	driverMaxLifetime = time.Hour
+	// shortLivedCredentialDriverMaxLifetime is the maximum lifetime of the drivers connected with the short-lived credentials.
+	// It's shorter than the 15 minutes lifetime of the cloud IAM authentication tokens so that the new connections
+	// opened by the driver don't use an expired token.
+	shortLivedCredentialDriverMaxLifetime = 10 * time.Minute
+	// driverPingTimeout is the timeout to check the health of an idle driver before reusing it.
+	driverPingTimeout = 3 * time.Second
+	// driverPoolEvictionInterval is the interval to close the idle drivers exceeding the idle timeout.
+	driverPoolEvictionInterval = time.Minute
+)
+
+// driverPoolKey identifies the drivers connecting to the same database with the same data source.
+type driverPoolKey struct {
+	instanceUID    int
+	databaseName   string
+	dataSourceUID  int
+	dataSourceType api.DataSourceType
+	datashare      bool
+	readOnly       bool
+}
+
+// DriverPoolStats is the usage statistics of the driver pool.
+type DriverPoolStats struct {
+	// Open is the number of the drivers opened by the pool, both idle and in use.
+	Open int
+	// Idle is the number of the idle drivers.
+	Idle int
+	// Hits is the number of the checkouts served by the idle drivers.
+	Hits int
+	// Misses is the number of the checkouts opening new drivers.
+	Misses int
+	// Evictions is the number of the drivers closed for being idle, unhealthy, expired or having stale credentials.
+	Evictions int
+}
+
+// driverPool pools the drivers so that we don't pay the TCP, TLS and SSH handshakes on every checkout.
+// A driver is used by one caller at a time. Closing the checked-out driver returns it to the pool.
+// The drivers share the database sessions with the later checkouts, so a driver that may have changed the session state,
+// e.g. SET ROLE, USE, search_path, session variables or an open transaction, is closed instead of being returned.
+// The statements run on the dedicated connections from GetDedicatedConn don't count, since the connections are discarded.
+type driverPool struct {
+	now func() time.Time
+
+	mu    sync.Mutex
+	idle  map[driverPoolKey][]*pooledDriver
+	stats DriverPoolStats
+}
+
+func newDriverPool() *driverPool {
+	return &driverPool{
+		now:  time.Now,
+		idle: make(map[driverPoolKey][]*pooledDriver),
+	}
+}
+
+// pooledDriver is the driver checked out from the pool.
+type pooledDriver struct {
+	db.Driver
+	pool *driverPool
+	key  driverPoolKey
+	// fingerprint is the fingerprint of the data source when the driver is opened.
+	// The driver is discarded if the data source is changed, e.g. the password is rotated.
+	fingerprint string
+	expireAt    time.Time
+	idleSince   time.Time
+	// sessionDirty is true if the checkout runs statements or gets the raw connections,
+	// which may change the session state of the connections.
+	sessionDirty atomic.Bool
+	// dedicatedConns are the connections from GetDedicatedConn which are not released yet.
+	dedicatedConns sync.Map
+
+	releaseOnce sync.Once
+}
+
+// GetDB returns the database connection pool of the engine driver.
+// The driver isn't reused since the caller may change the session state with the raw connections.
+func (d *pooledDriver) GetDB() *sql.DB {
+	d.sessionDirty.Store(true)
+	return d.Driver.GetDB()
+}
+
+// Execute executes the statement with the engine driver.
+func (d *pooledDriver) Execute(ctx context.Context, statement string, createDatabase bool, opts db.ExecuteOptions) (int64, error) {
+	d.sessionDirty.Store(true)
+	return d.Driver.Execute(ctx, statement, createDatabase, opts)
+}
+
+// QueryConn queries with the engine driver.
+func (d *pooledDriver) QueryConn(ctx context.Context, conn *sql.Conn, statement string, queryContext *db.QueryContext) ([]any, error) {
+	d.markSessionDirty(conn)
+	return d.Driver.QueryConn(ctx, conn, statement, queryContext)
+}
+
+// QueryConn2 queries with the engine driver.
+func (d *pooledDriver) QueryConn2(ctx context.Context, conn *sql.Conn, statement string, queryContext *db.QueryContext) ([]*v1pb.QueryResult, error) {
+	d.markSessionDirty(conn)
+	return d.Driver.QueryConn2(ctx, conn, statement, queryContext)
+}
+
+// RunStatement runs the statement with the engine driver.
+func (d *pooledDriver) RunStatement(ctx context.Context, conn *sql.Conn, statement string) ([]*v1pb.QueryResult, error) {
+	d.markSessionDirty(conn)
+	return d.Driver.RunStatement(ctx, conn, statement)
+}
+
+// Restore restores the database with the engine driver.
+func (d *pooledDriver) Restore(ctx context.Context, src io.Reader) error {
+	d.sessionDirty.Store(true)
+	return d.Driver.Restore(ctx, src)
+}
+
+// markSessionDirty marks the session dirty unless the statement runs on a dedicated connection.
+func (d *pooledDriver) markSessionDirty(conn *sql.Conn) {
+	if conn != nil {
+		if _, ok := d.dedicatedConns.Load(conn); ok {
+			return
+		}
+	}
+	d.sessionDirty.Store(true)
+}
+
+// Close returns the driver to the pool. It's safe to call Close more than once.
+func (d *pooledDriver) Close(context.Context) error {
+	d.releaseOnce.Do(func() {
+		d.pool.put(d)
+	})
+	return nil
+}
+
+// Unwrap returns the engine driver.
+// The driver isn't reused since the caller may change the session state with the engine driver.
+func (d *pooledDriver) Unwrap() db.Driver {
+	d.sessionDirty.Store(true)
+	return d.Driver
+}
+
+// getEngineDriver unwraps the driver to the engine driver. Unlike db.Unwrap, it doesn't mark the session of the pooled driver dirty,
+// so it's only for running the statements which don't change the session state.
+func getEngineDriver(driver db.Driver) db.Driver {
+	if d, ok := driver.(*pooledDriver); ok {
+		driver = d.Driver
+	}
+	return db.Unwrap(driver)
+}
+
+// GetDedicatedConn returns a connection of the driver dedicated to the caller, and the function releasing it.
+// The connection is discarded instead of being returned to the connection pool of the driver when it's released,
+// so the session state changed by the statements run on it dies with it, and the pooled driver stays reusable.
+// The connection is nil if the driver doesn't have a database connection pool.
+func GetDedicatedConn(ctx context.Context, driver db.Driver) (*sql.Conn, func(), error) {
+	pooled, isPooled := driver.(*pooledDriver)
+	var sqlDB *sql.DB
+	if isPooled {
+		sqlDB = pooled.Driver.GetDB()
+	} else {
+		sqlDB = driver.GetDB()
+	}
+	if sqlDB == nil {
+		return nil, func() {}, nil
+	}
+	conn, err := sqlDB.Conn(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	if isPooled {
+		pooled.dedicatedConns.Store(conn, true)
+	}
+	release := func() {
+		// The connection is closed instead of being reused if the function returns driver.ErrBadConn.
+		_ = conn.Raw(func(any) error {
+			return sqldriver.ErrBadConn
+		})
+		_ = conn.Close()
+		if isPooled {
+			pooled.dedicatedConns.Delete(conn)
+		}
+	}
+	return conn, release, nil
+}
+
+// GetExplainDB returns the database connection pool of the driver for the statements which don't change the session state,
+// e.g. the EXPLAIN statements of the SQL review. Unlike GetDB, it doesn't prevent the pooled driver from being reused.
+func GetExplainDB(driver db.Driver) *sql.DB {
+	if d, ok := driver.(*pooledDriver); ok {
+		return d.Driver.GetDB()
+	}
+	return driver.GetDB()
+}
+
+// GetDriverDataSource returns the UID and the type of the data source used by the driver checked out from the pool.
+// It returns false if the driver isn't pooled.
+func GetDriverDataSource(driver db.Driver) (int, api.DataSourceType, bool) {
+	d, ok := driver.(*pooledDriver)
+	if !ok {
+		return 0, "", false
+	}
+	return d.key.dataSourceUID, d.key.dataSourceType, true
+}
+
+// get returns a healthy idle driver of the key with the same fingerprint, or opens a new driver.
+func (p *driverPool) get(ctx context.Context, key driverPoolKey, fingerprint string, maxLifetime time.Duration, open func() (db.Driver, error)) (db.Driver, error) {
+	for {
+		driver, stale := p.take(key, fingerprint)
+		p.closeDrivers(stale)
+		if driver == nil {
+			break
+		}
+		if err := ping(ctx, driver.Driver); err != nil {
+			log.Debug("Close unhealthy pooled driver", zap.Int("instance", key.instanceUID), zap.String("database", key.databaseName), zap.Error(err))
+			p.closeDrivers([]*pooledDriver{driver})
+			continue
+		}
+		p.mu.Lock()
+		p.stats.Hits++
+		p.mu.Unlock()
+		// Each checkout releases the driver once.
+		return &pooledDriver{
+			Driver:      driver.Driver,
+			pool:        p,
+			key:         key,
+			fingerprint: fingerprint,
+			expireAt:    driver.expireAt,
+		}, nil
+	}
+
+	driver, err := open()
+	if err != nil {
+		return nil, err
+	}
+	p.mu.Lock()
+	p.stats.Misses++
+	p.stats.Open++
+	p.mu.Unlock()
+	return &pooledDriver{
+		Driver:      driver,
+		pool:        p,
+		key:         key,
+		fingerprint: fingerprint,
+		expireAt:    p.now().Add(maxLifetime),
+	}, nil
+}
+
+// take pops the most recently used idle driver of the key with the fingerprint.
+// It also removes and returns the stale drivers of the key, which the caller must close.
+func (p *driverPool) take(key driverPoolKey, fingerprint string) (*pooledDriver, []*pooledDriver) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	now := p.now()
+	var stale []*pooledDriver
+	idle := p.idle[key]
+	for len(idle) > 0 {
+		driver := idle[len(idle)-1]
+		idle = idle[:len(idle)-1]
+		p.stats.Idle--
+		if driver.fingerprint != fingerprint || !now.Before(driver.expireAt) {
+			stale = append(stale, driver)
+			continue
+		}
+		p.setIdle(key, idle)
+		return driver, stale
+	}
+	p.setIdle(key, idle)
+	return nil, stale
+}
+
+// put returns the driver to the pool, or closes it if the pool is full, the driver is expired or its session state may be changed.
+func (p *driverPool) put(driver *pooledDriver) {
+	p.mu.Lock()
+	now := p.now()
+	idle := p.idle[driver.key]
+	if driver.sessionDirty.Load() || !now.Before(driver.expireAt) || len(idle) >= maxIdleDriversPerKey || p.stats.Idle >= maxIdleDrivers {
+		p.mu.Unlock()
+		p.closeDrivers([]*pooledDriver{driver})
+		return
+	}
+	driver.idleSince = now
+	p.idle[driver.key] = append(idle, driver)
+	p.stats.Idle++
+	p.mu.Unlock()
+}
+
+// evict closes the idle drivers exceeding the idle timeout or the max lifetime.
+func (p *driverPool) evict() {
+	p.mu.Lock()
+	now := p.now()
+	var evicted []*pooledDriver
+	for key, idle := range p.idle {
+		var kept []*pooledDriver
+		for _, driver := range idle {
+			if now.Sub(driver.idleSince) >= driverIdleTimeout || !now.Before(driver.expireAt) {
+				evicted = append(evicted, driver)
+				p.stats.Idle--
+				continue
+			}
+			kept = append(kept, driver)
+		}
+		p.setIdle(key, kept)
+	}
+	p.mu.Unlock()
+
+	p.closeDrivers(evicted)
+}
+
+// invalidate closes the idle drivers of the instance.
+func (p *driverPool) invalidate(instanceUID int) {
+	p.mu.Lock()
+	var drivers []*pooledDriver
+	for key, idle := range p.idle {
+		if key.instanceUID != instanceUID {
+			continue
+		}
+		drivers = append(drivers, idle...)
+		p.stats.Idle -= len(idle)
+		delete(p.idle, key)
+	}
+	p.mu.Unlock()
+
+	p.closeDrivers(drivers)
+}
+
+// closeAll closes all the idle drivers.
+func (p *driverPool) closeAll() {
+	p.mu.Lock()
+	var drivers []*pooledDriver
+	for _, idle := range p.idle {
+		drivers = append(drivers, idle...)
+	}
+	p.idle = make(map[driverPoolKey][]*pooledDriver)
+	p.stats.Idle = 0
+	p.mu.Unlock()
+
+	p.closeDrivers(drivers)
+}
+
+// closeDrivers closes the engine drivers. It must be called without holding the lock because closing
+// the driver may block on the network.
+func (p *driverPool) closeDrivers(drivers []*pooledDriver) {
+	if len(drivers) == 0 {
+		return
+	}
+	p.mu.Lock()
+	p.stats.Open -= len(drivers)
+	p.stats.Evictions += len(drivers)
+	p.mu.Unlock()
+
+	for _, driver := range drivers {
+		if err := driver.Driver.Close(context.Background()); err != nil {
+			log.Debug("Failed to close pooled driver", zap.Int("instance", driver.key.instanceUID), zap.String("database", driver.key.databaseName), zap.Error(err))
+		}
+	}
+}
+
+func (p *driverPool) setIdle(key driverPoolKey, idle []*pooledDriver) {
+	if len(idle) == 0 {
+		delete(p.idle, key)
+		return
+	}
+	p.idle[key] = idle
+}
+
+func (p *driverPool) getStats() DriverPoolStats {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.stats
+}
+
+func ping(ctx context.Context, driver db.Driver) error {
+	ctx, cancel := context.WithTimeout(ctx, driverPingTimeout)
+	defer cancel()
+	return driver.Ping(ctx)
+}
+
+// getDataSourceFingerprint returns the fingerprint of the data source and the engine.
+// The drivers opened with a different fingerprint are not reused.
+func getDataSourceFingerprint(engine db.Type, dataSource *store.DataSourceMessage) (string, error) {
+	bytes, err := json.Marshal(struct {
+		Engine     db.Type
+		DataSource *store.DataSourceMessage
+	}{
+		Engine:     engine,
+		DataSource: dataSource,
+	})
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(bytes)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// getDriverMaxLifetime returns the max lifetime of the drivers of the data source.
+func getDriverMaxLifetime(dataSource *store.DataSourceMessage) time.Duration {
+	if dataSource.AuthenticationType != storepb.DataSourceAuthenticationType_DATA_SOURCE_AUTHENTICATION_TYPE_UNSPECIFIED {
+		return shortLivedCredentialDriverMaxLifetime
+	}
+	return driverMaxLifetime
+}
@@ -0,0 +1,197 @@
+package dbfactory
+
+import (
+	"context"
+	"database/sql"
+	sqldriver "database/sql/driver"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	api "github.com/bytebase/bytebase/backend/legacyapi"
+	"github.com/bytebase/bytebase/backend/plugin/db"
+	"github.com/bytebase/bytebase/backend/store"
+)
+
+func TestDriverPool(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2023, 10, 1, 0, 0, 0, 0, time.UTC)
+	p := newDriverPool()
+	p.now = func() time.Time { return now }
+
+	var opened []*fakeDriver
+	open := func() (db.Driver, error) {
+		driver := &fakeDriver{}
+		opened = append(opened, driver)
+		return driver, nil
+	}
+	key := driverPoolKey{instanceUID: 1, databaseName: "db", dataSourceUID: 3, dataSourceType: api.Admin}
+
+	// The first checkout opens a new driver, and the second one reuses it after it's returned.
+	d1, err := p.get(ctx, key, "v1", time.Hour, open)
+	require.NoError(t, err)
+	require.NoError(t, d1.Close(ctx))
+	require.NoError(t, d1.Close(ctx))
+	d2, err := p.get(ctx, key, "v1", time.Hour, open)
+	require.NoError(t, err)
+	require.Len(t, opened, 1)
+	require.Equal(t, opened[0], d2.(*pooledDriver).Driver)
+	dataSourceUID, dataSourceType, ok := GetDriverDataSource(d2)
+	require.True(t, ok)
+	require.Equal(t, 3, dataSourceUID)
+	require.Equal(t, api.Admin, dataSourceType)
+	_, _, ok = GetDriverDataSource(opened[0])
+	require.False(t, ok)
+
+	// A concurrent checkout opens another driver, and at most maxIdleDriversPerKey drivers are kept idle.
+	d3, err := p.get(ctx, key, "v1", time.Hour, open)
+	require.NoError(t, err)
+	d4, err := p.get(ctx, key, "v1", time.Hour, open)
+	require.NoError(t, err)
+	require.Len(t, opened, 3)
+	require.NoError(t, d2.Close(ctx))
+	require.NoError(t, d3.Close(ctx))
+	require.NoError(t, d4.Close(ctx))
+	require.True(t, opened[2].closed)
+	require.Equal(t, DriverPoolStats{Open: 2, Idle: 2, Hits: 1, Misses: 3, Evictions: 1}, p.getStats())
+
+	// The drivers with the stale fingerprint are closed.
+	d5, err := p.get(ctx, key, "v2", time.Hour, open)
+	require.NoError(t, err)
+	require.True(t, opened[0].closed)
+	require.True(t, opened[1].closed)
+	require.Equal(t, opened[3], d5.(*pooledDriver).Driver)
+	require.NoError(t, d5.Close(ctx))
+
+	// The unhealthy drivers are closed.
+	opened[3].pingErr = errors.New("connection reset")
+	d6, err := p.get(ctx, key, "v2", time.Hour, open)
+	require.NoError(t, err)
+	require.True(t, opened[3].closed)
+	require.Equal(t, opened[4], d6.(*pooledDriver).Driver)
+	require.NoError(t, d6.Close(ctx))
+
+	// The idle drivers are evicted after the idle timeout.
+	now = now.Add(driverIdleTimeout)
+	p.evict()
+	require.True(t, opened[4].closed)
+	require.Equal(t, DriverPoolStats{Open: 0, Idle: 0, Hits: 1, Misses: 5, Evictions: 5}, p.getStats())
+
+	// The expired drivers are not returned to the pool.
+	d7, err := p.get(ctx, key, "v2", time.Minute, open)
+	require.NoError(t, err)
+	now = now.Add(time.Minute)
+	require.NoError(t, d7.Close(ctx))
+	require.True(t, opened[5].closed)
+
+	// The idle drivers of the instance are closed on invalidation.
+	d8, err := p.get(ctx, key, "v2", time.Hour, open)
+	require.NoError(t, err)
+	require.NoError(t, d8.Close(ctx))
+	p.invalidate(1)
+	require.True(t, opened[6].closed)
+	require.Equal(t, 0, p.getStats().Open)
+
+	// The drivers that may have changed the session state are not returned to the pool.
+	d9, err := p.get(ctx, key, "v2", time.Hour, open)
+	require.NoError(t, err)
+	d9.GetDB()
+	require.NoError(t, d9.Close(ctx))
+	require.True(t, opened[7].closed)
+	d10, err := p.get(ctx, key, "v2", time.Hour, open)
+	require.NoError(t, err)
+	require.Equal(t, opened[8], db.Unwrap(d10))
+	require.NoError(t, d10.Close(ctx))
+	require.True(t, opened[8].closed)
+	require.Equal(t, 0, p.getStats().Open)
+}
+
+// countingConnector opens the connections which only count how many are open.
+type countingConnector struct {
+	open atomic.Int32
+}
+
+func (c *countingConnector) Connect(context.Context) (sqldriver.Conn, error) {
+	c.open.Add(1)
+	return &countingConn{connector: c}, nil
+}
+
+func (c *countingConnector) Driver() sqldriver.Driver {
+	return nil
+}
+
+type countingConn struct {
+	connector *countingConnector
+}
+
+func (*countingConn) Prepare(string) (sqldriver.Stmt, error) {
+	return nil, sqldriver.ErrSkip
+}
+
+func (c *countingConn) Close() error {
+	c.connector.open.Add(-1)
+	return nil
+}
+
+func (*countingConn) Begin() (sqldriver.Tx, error) {
+	return nil, sqldriver.ErrSkip
+}
+
+func TestGetDedicatedConn(t *testing.T) {
+	ctx := context.Background()
+	p := newDriverPool()
+	connector := &countingConnector{}
+	sqlDB := sql.OpenDB(connector)
+	defer sqlDB.Close()
+	var opened []*fakeDriver
+	open := func() (db.Driver, error) {
+		driver := &fakeDriver{sqlDB: sqlDB}
+		opened = append(opened, driver)
+		return driver, nil
+	}
+	key := driverPoolKey{instanceUID: 1, databaseName: "db", dataSourceUID: 3, dataSourceType: api.RO}
+
+	// The statements on the dedicated connection don't prevent the driver from being reused,
+	// and the connection is discarded instead of being returned to the connection pool.
+	d1, err := p.get(ctx, key, "v1", time.Hour, open)
+	require.NoError(t, err)
+	conn, release, err := GetDedicatedConn(ctx, d1)
+	require.NoError(t, err)
+	require.NotNil(t, conn)
+	require.Equal(t, int32(1), connector.open.Load())
+	_, err = d1.QueryConn2(ctx, conn, "SELECT 1", &db.QueryContext{})
+	require.NoError(t, err)
+	require.Equal(t, 1, GetExplainDB(d1).Stats().OpenConnections)
+	release()
+	require.Equal(t, int32(0), connector.open.Load())
+	require.NoError(t, d1.Close(ctx))
+	require.False(t, opened[0].closed)
+
+	// The statements on the other connections still do.
+	d2, err := p.get(ctx, key, "v1", time.Hour, open)
+	require.NoError(t, err)
+	require.Equal(t, opened[0], d2.(*pooledDriver).Driver)
+	_, err = d2.QueryConn2(ctx, nil, "SELECT 1", &db.QueryContext{})
+	require.NoError(t, err)
+	require.NoError(t, d2.Close(ctx))
+	require.True(t, opened[0].closed)
+}
+
+func TestGetDataSourceFingerprint(t *testing.T) {
+	dataSource := &store.DataSourceMessage{Type: api.Admin, Host: "localhost", Port: "3306", Username: "root", ObfuscatedPassword: "a"}
+	f1, err := getDataSourceFingerprint(db.MySQL, dataSource)
+	require.NoError(t, err)
+
+	rotated := *dataSource
+	rotated.ObfuscatedPassword = "b"
+	f2, err := getDataSourceFingerprint(db.MySQL, &rotated)
+	require.NoError(t, err)
+	require.NotEqual(t, f1, f2)
+
+	f3, err := getDataSourceFingerprint(db.MySQL, dataSource)
+	require.NoError(t, err)
+	require.Equal(t, f1, f3)
+}
@@ -0,0 +1,135 @@
+package dbfactory
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+
+	"github.com/bytebase/bytebase/backend/common"
+	"github.com/bytebase/bytebase/backend/common/log"
+	"github.com/bytebase/bytebase/backend/plugin/db"
+	"github.com/bytebase/bytebase/backend/store"
+)
+
+const (
+	// replicaHealthCheckInterval is the interval to recheck the health and replication lag of a read-only data source.
+	replicaHealthCheckInterval = 10 * time.Second
+	// defaultMaxReplicationLag is the max replication lag if it's not set in the data source.
+	defaultMaxReplicationLag = 60 * time.Second
+)
+
+// replicaHealth is the last health check result of a read-only data source.
+type replicaHealth struct {
+	healthy   bool
+	checkedAt time.Time
+}
+
+// replicaRouter balances the checkouts across the healthy read-only data sources in round-robin.
+type replicaRouter struct {
+	now  func() time.Time
+	next atomic.Uint64
+
+	mu     sync.Mutex
+	health map[int]replicaHealth
+}
+
+func newReplicaRouter() *replicaRouter {
+	return &replicaRouter{
+		now:    time.Now,
+		health: make(map[int]replicaHealth),
+	}
+}
+
+// order returns the data sources in the order to try, starting from the next one in round-robin.
+func (r *replicaRouter) order(dataSources []*store.DataSourceMessage) []*store.DataSourceMessage {
+	if len(dataSources) == 0 {
+		return nil
+	}
+	start := int((r.next.Add(1) - 1) % uint64(len(dataSources)))
+	var ordered []*store.DataSourceMessage
+	ordered = append(ordered, dataSources[start:]...)
+	ordered = append(ordered, dataSources[:start]...)
+	return ordered
+}
+
+// getHealth returns the cached health of the data source and whether it's still fresh.
+func (r *replicaRouter) getHealth(dataSourceUID int) (bool, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	health, ok := r.health[dataSourceUID]
+	if !ok || r.now().Sub(health.checkedAt) >= replicaHealthCheckInterval {
+		return false, false
+	}
+	return health.healthy, true
+}
+
+func (r *replicaRouter) setHealth(dataSourceUID int, healthy bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.health[dataSourceUID] = replicaHealth{
+		healthy:   healthy,
+		checkedAt: r.now(),
+	}
+}
+
+// getHealthyReplicaDriver returns the driver of a healthy read-only data source within the max replication lag,
+// or nil if there's none. The unhealthy data sources are skipped until they are rechecked.
+// The lag isn't enforced on the reachable data sources whose lag can't be read, e.g. the user lacks the privilege.
+func (d *DBFactory) getHealthyReplicaDriver(ctx context.Context, instance *store.InstanceMessage, dataSources []*store.DataSourceMessage, databaseName string, datashare bool) db.Driver {
+	for _, dataSource := range d.replicaRouter.order(dataSources) {
+		healthy, fresh := d.replicaRouter.getHealth(dataSource.UID)
+		if fresh && !healthy {
+			continue
+		}
+		driver, err := d.getPooledDataSourceDriver(ctx, instance, dataSource, databaseName, datashare, true /* readOnly */)
+		if err != nil {
+			log.Debug("Skip unavailable read-only data source", zap.String("instance", instance.ResourceID), zap.String("dataSource", dataSource.Title), zap.Error(err))
+			d.replicaRouter.setHealth(dataSource.UID, false)
+			continue
+		}
+		if fresh {
+			return driver
+		}
+		lag, err := getReplicationLag(ctx, driver)
+		if err != nil {
+			log.Warn("Failed to get the replication lag of read-only data source, use it without checking the lag", zap.String("instance", instance.ResourceID), zap.String("dataSource", dataSource.Title), zap.Error(err))
+		} else if maxLag := getMaxReplicationLag(dataSource); lag > maxLag {
+			log.Debug("Skip lagging read-only data source", zap.String("instance", instance.ResourceID), zap.String("dataSource", dataSource.Title), zap.Duration("lag", lag), zap.Duration("maxLag", maxLag))
+			d.replicaRouter.setHealth(dataSource.UID, false)
+			driver.Close(ctx)
+			continue
+		}
+		d.replicaRouter.setHealth(dataSource.UID, true)
+		return driver
+	}
+	return nil
+}
+
+// getReplicationLag returns the replication lag of the data source, or zero if the driver doesn't support it.
+// The error code is NotAuthorized if the data source user doesn't have the privilege to get the lag.
+// The lag query doesn't change the session state, so the pooled driver can still be reused afterwards.
+func getReplicationLag(ctx context.Context, driver db.Driver) (time.Duration, error) {
+	lagGetter, ok := getEngineDriver(driver).(db.ReplicationLagGetter)
+	if !ok {
+		return 0, nil
+	}
+	lag, err := lagGetter.GetReplicationLag(ctx)
+	if err != nil {
+		if common.ErrorCode(err) == common.NotAuthorized {
+			return 0, err
+		}
+		return 0, errors.Wrapf(err, "failed to get replication lag")
+	}
+	return lag, nil
+}
+
+func getMaxReplicationLag(dataSource *store.DataSourceMessage) time.Duration {
+	if dataSource.MaxReplicationLagSeconds > 0 {
+		return time.Duration(dataSource.MaxReplicationLagSeconds) * time.Second
+	}
+	return defaultMaxReplicationLag
+}
@@ -0,0 +1,129 @@
+package dbfactory
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bytebase/bytebase/backend/common"
+	api "github.com/bytebase/bytebase/backend/legacyapi"
+	"github.com/bytebase/bytebase/backend/plugin/db"
+	"github.com/bytebase/bytebase/backend/store"
+)
+
+type fakeReplicaDriver struct {
+	fakeDriver
+	lag    time.Duration
+	lagErr error
+}
+
+func (d *fakeReplicaDriver) GetReplicationLag(context.Context) (time.Duration, error) {
+	return d.lag, d.lagErr
+}
+
+func TestReplicaRouter(t *testing.T) {
+	now := time.Date(2023, 10, 1, 0, 0, 0, 0, time.UTC)
+	r := newReplicaRouter()
+	r.now = func() time.Time { return now }
+
+	dataSources := []*store.DataSourceMessage{{UID: 1}, {UID: 2}, {UID: 3}}
+	var starts []int
+	for i := 0; i < 4; i++ {
+		starts = append(starts, r.order(dataSources)[0].UID)
+	}
+	require.Equal(t, []int{1, 2, 3, 1}, starts)
+	require.Len(t, r.order(dataSources), 3)
+
+	_, fresh := r.getHealth(1)
+	require.False(t, fresh)
+	r.setHealth(1, false)
+	healthy, fresh := r.getHealth(1)
+	require.True(t, fresh)
+	require.False(t, healthy)
+	now = now.Add(replicaHealthCheckInterval)
+	_, fresh = r.getHealth(1)
+	require.False(t, fresh)
+}
+
+func TestGetReplicationLag(t *testing.T) {
+	ctx := context.Background()
+	lag, err := getReplicationLag(ctx, &fakeDriver{})
+	require.NoError(t, err)
+	require.Zero(t, lag)
+	lag, err = getReplicationLag(ctx, &fakeReplicaDriver{lag: time.Second})
+	require.NoError(t, err)
+	require.Equal(t, time.Second, lag)
+	_, err = getReplicationLag(ctx, &fakeReplicaDriver{lagErr: errors.New("replication is not running")})
+	require.Error(t, err)
+	_, err = getReplicationLag(ctx, &fakeReplicaDriver{lagErr: common.Errorf(common.NotAuthorized, "the REPLICATION CLIENT privilege is required")})
+	require.Equal(t, common.NotAuthorized, common.ErrorCode(err))
+
+	// Getting the lag doesn't prevent the pooled driver from being reused.
+	p := newDriverPool()
+	replica := &fakeReplicaDriver{lag: 2 * time.Second}
+	driver, err := p.get(ctx, driverPoolKey{instanceUID: 1, dataSourceUID: 2, dataSourceType: api.RO, readOnly: true}, "v1", time.Hour, func() (db.Driver, error) {
+		return replica, nil
+	})
+	require.NoError(t, err)
+	_, err = getReplicationLag(ctx, driver)
+	require.NoError(t, err)
+	require.False(t, driver.(*pooledDriver).sessionDirty.Load())
+
+	require.Equal(t, defaultMaxReplicationLag, getMaxReplicationLag(&store.DataSourceMessage{}))
+	require.Equal(t, 5*time.Second, getMaxReplicationLag(&store.DataSourceMessage{MaxReplicationLagSeconds: 5}))
+}
+
+func TestGetHealthyReplicaDriver(t *testing.T) {
+	ctx := context.Background()
+	d := New("", "", "", "", "", nil)
+	instance := &store.InstanceMessage{UID: 1, Title: "prod"}
+	lagging := &store.DataSourceMessage{UID: 2, Type: api.RO, MaxReplicationLagSeconds: 1}
+	unauthorized := &store.DataSourceMessage{UID: 3, Type: api.RO}
+	replicas := map[int]*fakeReplicaDriver{
+		lagging.UID:      {lag: 2 * time.Second},
+		unauthorized.UID: {lagErr: common.Errorf(common.NotAuthorized, "the REPLICATION CLIENT privilege is required")},
+	}
+	// Put the fake drivers into the pool so that they are checked out instead of connecting to the databases.
+	for _, dataSource := range []*store.DataSourceMessage{lagging, unauthorized} {
+		fingerprint, err := getDataSourceFingerprint(instance.Engine, dataSource)
+		require.NoError(t, err)
+		key := driverPoolKey{instanceUID: instance.UID, dataSourceUID: dataSource.UID, dataSourceType: api.RO, readOnly: true}
+		driver, err := d.driverPool.get(ctx, key, fingerprint, time.Hour, func() (db.Driver, error) {
+			return replicas[dataSource.UID], nil
+		})
+		require.NoError(t, err)
+		require.NoError(t, driver.Close(ctx))
+	}
+
+	// The lagging data source is skipped, but the one whose lag can't be read is still used.
+	driver := d.getHealthyReplicaDriver(ctx, instance, []*store.DataSourceMessage{lagging, unauthorized}, "", false)
+	require.NotNil(t, driver)
+	require.Equal(t, replicas[unauthorized.UID], driver.(*pooledDriver).Driver)
+	require.NoError(t, driver.Close(ctx))
+	healthy, fresh := d.replicaRouter.getHealth(lagging.UID)
+	require.True(t, fresh)
+	require.False(t, healthy)
+	healthy, fresh = d.replicaRouter.getHealth(unauthorized.UID)
+	require.True(t, fresh)
+	require.True(t, healthy)
+}
+
+func TestGetReadOnlyDatabaseDriverWithoutHealthyReplica(t *testing.T) {
+	d := New("", "", "", "", "", nil)
+	instance := &store.InstanceMessage{
+		UID:   1,
+		Title: "prod",
+		DataSources: []*store.DataSourceMessage{
+			{UID: 1, Type: api.Admin},
+			{UID: 2, Type: api.RO},
+		},
+	}
+	d.replicaRouter.setHealth(2, false)
+
+	// The unhealthy replica is neither used unchecked nor replaced by the admin data source.
+	_, err := d.GetReadOnlyDatabaseDriver(context.Background(), instance, nil)
+	require.Equal(t, common.DbConnectionFailure, common.ErrorCode(err))
+}
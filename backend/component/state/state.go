@@ -24,6 +24,8 @@ type State struct {
 
 	// ApprovalFinding is the set of issues for finding the approval template.
 	ApprovalFinding sync.Map // map[issue.ID]*store.IssueMessage
+	// CommitStatusReporting is the set of issues whose progress should be reported as the VCS commit status.
+	CommitStatusReporting sync.Map // map[issue.ID]*store.IssueMessage
 
 	// TaskProgress is the map from task ID to task progress.
 	TaskProgress sync.Map // map[taskID]api.Progress
@@ -249,6 +249,15 @@ type ActivitySQLEditorQueryPayload struct {
 	DatabaseName           string           `json:"databaseName"`
 	Error                  string           `json:"error"`
 	AdviceList             []advisor.Advice `json:"adviceList"`
+	// DataSourceID is the UID of the data source executing the statement.
+	DataSourceID   int            `json:"dataSourceId"`
+	DataSourceType DataSourceType `json:"dataSourceType"`
+	ClientIP       string         `json:"clientIp"`
+	// Resources is the list of the tables accessed by the statement.
+	Resources []ActivitySQLResource `json:"resources"`
+	// Fields is the list of the table columns returned by the statement.
+	Fields   []ActivitySQLField `json:"fields"`
+	RowCount int64              `json:"rowCount"`
 }
 
 // ActivitySQLExportPayload is the API message payloads for the exported SQL info.
@@ -260,4 +269,32 @@ type ActivitySQLExportPayload struct {
 	DatabaseID   int    `json:"databaseId"`
 	DatabaseName string `json:"databaseName"`
 	Error        string `json:"error"`
+	// DataSourceID is the UID of the data source executing the statement.
+	DataSourceID   int            `json:"dataSourceId"`
+	DataSourceType DataSourceType `json:"dataSourceType"`
+	ClientIP       string         `json:"clientIp"`
+	// Resources is the list of the tables accessed by the statement.
+	Resources []ActivitySQLResource `json:"resources"`
+	// Fields is the list of the table columns returned by the statement.
+	Fields   []ActivitySQLField `json:"fields"`
+	RowCount int64              `json:"rowCount"`
+}
+
+// ActivitySQLResource is the table accessed by the SQL statement.
+type ActivitySQLResource struct {
+	Database string `json:"database"`
+	Schema   string `json:"schema"`
+	Table    string `json:"table"`
+}
+
+// ActivitySQLField is the table column returned by the SQL statement.
+type ActivitySQLField struct {
+	Database string `json:"database"`
+	Schema   string `json:"schema"`
+	Table    string `json:"table"`
+	Column   string `json:"column"`
+	// Sensitive is true if the column is in the sensitive data policy of the database.
+	Sensitive bool `json:"sensitive"`
+	// Masked is true if the column is masked in the result.
+	Masked bool `json:"masked"`
 }
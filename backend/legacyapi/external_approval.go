@@ -8,6 +8,10 @@ const (
 	ExternalApprovalTypeFeishu ExternalApprovalType = "bb.plugin.app.feishu"
 	// ExternalApprovalTypeRelay is the ExternalApproval from relay.
 	ExternalApprovalTypeRelay ExternalApprovalType = "bb.plugin.app.relay"
+	// ExternalApprovalTypeSlack is the ExternalApproval from the Slack interactive message.
+	ExternalApprovalTypeSlack ExternalApprovalType = "bb.plugin.app.slack"
+	// ExternalApprovalTypeTeams is the ExternalApproval from the Teams interactive message.
+	ExternalApprovalTypeTeams ExternalApprovalType = "bb.plugin.app.teams"
 )
 
 // ExternalApprovalPayloadFeishu is the payload for feishu type ExternalApproval.
@@ -28,6 +32,18 @@ type ExternalApprovalPayloadRelay struct {
 	URI                    string `json:"uri"`
 }
 
+// ExternalApprovalPayloadIM is the payload for the Slack and Teams type ExternalApproval.
+// It is the interactive approval message of a review step.
+type ExternalApprovalPayloadIM struct {
+	StepIndex int `json:"stepIndex"`
+	// Channel is the Slack channel ID or the Teams conversation ID.
+	Channel string `json:"channel"`
+	// MessageID is the Slack message timestamp or the Teams activity ID.
+	MessageID string `json:"messageId"`
+	// Status is the status line of the message last posted.
+	Status string `json:"status"`
+}
+
 // ExternalApprovalEventActionType is the type of the action which the user took.
 type ExternalApprovalEventActionType string
 
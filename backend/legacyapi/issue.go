@@ -3,6 +3,7 @@ package api
 import (
 	"github.com/bytebase/bytebase/backend/plugin/db"
 	"github.com/bytebase/bytebase/backend/plugin/vcs"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
 )
 
 // IssueStatus is the status of an issue.
@@ -115,6 +116,12 @@ type IssueCreate struct {
 	CreateContext string `jsonapi:"attr,createContext"`
 	// ValidateOnly validates the request and previews the review, but does not actually post it.
 	ValidateOnly bool `jsonapi:"attr,validateOnly"`
+	// Labels are the labels of the issue, which are used to find the approval template.
+	Labels []string `jsonapi:"attr,labels"`
+	// PullRequest is the VCS pull request the issue is drafted from, it's set by the pull request flow only.
+	PullRequest *storepb.IssuePayloadPullRequest
+	// VCSCommit is the VCS commit the issue is created from, it's set by the GitOps workflow only.
+	VCSCommit *storepb.IssuePayloadVCSCommit
 }
 
 // CreateDatabaseContext is the issue create context for creating a database.
@@ -38,13 +38,23 @@ const (
 	SettingPluginAgent SettingName = "bb.plugin.agent"
 	// SettingWorkspaceMailDelivery is the setting name for workspace mail delivery.
 	SettingWorkspaceMailDelivery SettingName = "bb.workspace.mail-delivery"
+	// SettingWorkspaceSCIM is the setting name for the SCIM provisioning endpoint.
+	SettingWorkspaceSCIM SettingName = "bb.workspace.scim"
+	// SettingWorkspaceAuditLogExport is the setting name for the audit log export destinations.
+	SettingWorkspaceAuditLogExport SettingName = "bb.workspace.audit-log-export"
 )
 
 // IMType is the type of IM.
 type IMType string
 
-// IMTypeFeishu is IM feishu.
-const IMTypeFeishu IMType = "im.feishu"
+const (
+	// IMTypeFeishu is IM feishu.
+	IMTypeFeishu IMType = "im.feishu"
+	// IMTypeSlack is IM Slack.
+	IMTypeSlack IMType = "im.slack"
+	// IMTypeTeams is IM Microsoft Teams.
+	IMTypeTeams IMType = "im.teams"
+)
 
 // ExternalApproval is the external approval setting for app IM.
 type ExternalApproval struct {
@@ -58,6 +68,14 @@ type SettingAppIMValue struct {
 	AppID            string           `json:"appId"`
 	AppSecret        string           `json:"appSecret"`
 	ExternalApproval ExternalApproval `json:"externalApproval"`
+	// SigningSecret is the signing secret of the Slack app.
+	SigningSecret string `json:"signingSecret"`
+	// Channel is the channel of Slack or Teams to post the interactive approval messages.
+	Channel string `json:"channel"`
+	// ServiceURL is the Bot Framework service URL of the Teams bot.
+	ServiceURL string `json:"serviceUrl"`
+	// TenantID is the tenant ID of the single-tenant Teams bot.
+	TenantID string `json:"tenantId"`
 }
 
 // SettingWorkspaceMailDeliveryValue is the setting value of SettingMailDelivery type setting.
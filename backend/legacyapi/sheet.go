@@ -26,6 +26,10 @@ const (
 	SheetFromGitHub SheetSource = "GITHUB"
 	// SheetFromBitbucket is the sheet synced from Bitbucket.
 	SheetFromBitbucket SheetSource = "BITBUCKET"
+	// SheetFromGitea is the sheet synced from Gitea (for both Gitea and Forgejo).
+	SheetFromGitea SheetSource = "GITEA"
+	// SheetFromAzureDevOps is the sheet synced from Azure DevOps Repos.
+	SheetFromAzureDevOps SheetSource = "AZURE_DEVOPS"
 )
 
 // SheetType is the type of sheet.
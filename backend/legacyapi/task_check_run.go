@@ -71,6 +71,8 @@ const (
 	TaskCheckDatabaseStatementTypeReport TaskCheckType = "bb.task-check.database.statement.type.report"
 	// TaskCheckDatabaseStatementAffectedRowsReport is the task check type for statement affected rows.
 	TaskCheckDatabaseStatementAffectedRowsReport TaskCheckType = "bb.task-check.database.statement.affected-rows.report"
+	// TaskCheckDatabaseStatementLockReport is the task check type for statement lock impact report.
+	TaskCheckDatabaseStatementLockReport TaskCheckType = "bb.task-check.database.statement.lock.report"
 	// TaskCheckDatabaseConnect is the task check type for database connection.
 	TaskCheckDatabaseConnect TaskCheckType = "bb.task-check.database.connect"
 	// TaskCheckGhostSync is the task check type for the gh-ost sync task.
@@ -98,6 +100,37 @@ type TaskCheckResult struct {
 	Content   string          `json:"content,omitempty"`
 	Line      int             `json:"line,omitempty"`
 	Details   string          `json:"details,omitempty"`
+	// Fix is the machine-applicable fix of the advisor result.
+	Fix *advisor.Fix `json:"fix,omitempty"`
+}
+
+// StatementLockReport is the lock impact of a statement.
+// It's marshaled into the details of the statement lock report result.
+type StatementLockReport struct {
+	// LockMode is the strongest lock the statement takes on the table.
+	// For PostgreSQL, it's the table-level lock mode such as ACCESS EXCLUSIVE.
+	// For MySQL, it's the metadata lock level held during the DDL: NONE, SHARED or EXCLUSIVE.
+	LockMode string `json:"lockMode,omitempty"`
+	// Algorithm is the MySQL online DDL algorithm: INSTANT, INPLACE or COPY.
+	Algorithm   string `json:"algorithm,omitempty"`
+	BlockReads  bool   `json:"blockReads,omitempty"`
+	BlockWrites bool   `json:"blockWrites,omitempty"`
+	// Rewrite is true if the statement rewrites the whole table.
+	Rewrite bool `json:"rewrite,omitempty"`
+	// Scan is true if the statement scans the whole table, e.g. to validate a constraint or to build an index.
+	Scan  bool   `json:"scan,omitempty"`
+	Table string `json:"table,omitempty"`
+	// EstimatedRows is the estimated row count of the table from the synced table stats.
+	EstimatedRows int64 `json:"estimatedRows,omitempty"`
+}
+
+// BlockingRows returns the estimated rows processed while the statement blocks writes.
+// It's zero if the statement doesn't block writes or only changes the metadata.
+func (r *StatementLockReport) BlockingRows() int64 {
+	if !r.BlockWrites || (!r.Rewrite && !r.Scan) {
+		return 0
+	}
+	return r.EstimatedRows
 }
 
 // TaskCheckRunResultPayload is the result payload of a task check run.
@@ -178,6 +211,16 @@ func IsTaskCheckReportSupported(dbType db.Type) bool {
 	}
 }
 
+// IsStatementLockReportSupported checks if the statement lock report supports the engine type.
+func IsStatementLockReportSupported(dbType db.Type) bool {
+	switch dbType {
+	case db.Postgres, db.MySQL:
+		return true
+	default:
+		return false
+	}
+}
+
 // IsTaskCheckReportNeededForTaskType checks if the task report is needed for the task type.
 func IsTaskCheckReportNeededForTaskType(taskType TaskType) bool {
 	switch taskType {
@@ -19,6 +19,17 @@ type VCSExchangeToken struct {
 type VCSSQLReviewResult struct {
 	Status  advisor.Status `json:"status"`
 	Content []string       `json:"content"`
+	// FixList is the machine-applicable fixes, which the CI can apply or suggest on the pull request.
+	FixList []VCSSQLReviewFix `json:"fixList,omitempty"`
+}
+
+// VCSSQLReviewFix is the machine-applicable fix for a SQL review advice on a file.
+// The edits of the fix are byte ranges in the file content.
+type VCSSQLReviewFix struct {
+	FilePath string       `json:"filePath"`
+	Line     int          `json:"line"`
+	Code     int          `json:"code"`
+	Fix      *advisor.Fix `json:"fix"`
 }
 
 // VCSSQLReviewRequest is the request from SQL review CI in VCS workflow.
@@ -0,0 +1,42 @@
+package collector
+
+import (
+	"context"
+
+	"github.com/bytebase/bytebase/backend/component/dbfactory"
+	metricAPI "github.com/bytebase/bytebase/backend/metric"
+	"github.com/bytebase/bytebase/backend/plugin/metric"
+)
+
+var _ metric.Collector = (*driverPoolCollector)(nil)
+
+// driverPoolCollector is the metric data collector for the database driver pool.
+type driverPoolCollector struct {
+	dbFactory *dbfactory.DBFactory
+}
+
+// NewDriverPoolCollector creates a new instance of driverPoolCollector.
+func NewDriverPoolCollector(dbFactory *dbfactory.DBFactory) metric.Collector {
+	return &driverPoolCollector{
+		dbFactory: dbFactory,
+	}
+}
+
+// Collect will collect the metric for the driver pool.
+// The value is the number of the open drivers, and the labels are the usage statistics since the server started.
+func (c *driverPoolCollector) Collect(_ context.Context) ([]*metric.Metric, error) {
+	stats := c.dbFactory.GetDriverPoolStats()
+	return []*metric.Metric{
+		{
+			Name:  metricAPI.DriverPoolMetricName,
+			Value: stats.Open,
+			Labels: map[string]any{
+				"idle":      stats.Idle,
+				"in_use":    stats.Open - stats.Idle,
+				"hits":      stats.Hits,
+				"misses":    stats.Misses,
+				"evictions": stats.Evictions,
+			},
+		},
+	}, nil
+}
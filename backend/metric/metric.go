@@ -46,6 +46,8 @@ const (
 	APIRequestMetricName metric.Name = "bb.api.request"
 	// InstanceCreateMetricName is the metric name for instance creation event.
 	InstanceCreateMetricName metric.Name = "bb.instance.create"
+	// DriverPoolMetricName is the metric name for database driver pool usage.
+	DriverPoolMetricName metric.Name = "bb.driver-pool.usage"
 )
 
 // InstanceCountMetric is the API message for bb.instance.count.
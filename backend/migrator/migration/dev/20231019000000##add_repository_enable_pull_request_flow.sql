@@ -0,0 +1 @@
+ALTER TABLE repository ADD COLUMN enable_pull_request_flow BOOLEAN NOT NULL DEFAULT false;
@@ -0,0 +1 @@
+ALTER TABLE repository ADD COLUMN enable_schema_write_back_pull_request BOOLEAN NOT NULL DEFAULT false;
@@ -0,0 +1,13 @@
+CREATE TABLE audit_log (
+    id BIGSERIAL PRIMARY KEY,
+    created_ts BIGINT NOT NULL DEFAULT extract(epoch from now()),
+    entry TEXT NOT NULL,
+    prev_hash TEXT NOT NULL,
+    hash TEXT NOT NULL
+);
+
+CREATE TABLE audit_log_export_cursor (
+    destination TEXT PRIMARY KEY,
+    updated_ts BIGINT NOT NULL DEFAULT extract(epoch from now()),
+    audit_log_id BIGINT NOT NULL
+);
@@ -19,7 +19,7 @@ CREATE TABLE idp (
   resource_id TEXT NOT NULL,
   name TEXT NOT NULL,
   domain TEXT NOT NULL,
-  type TEXT NOT NULL CONSTRAINT idp_type_check CHECK (type IN ('OAUTH2', 'OIDC')),
+  type TEXT NOT NULL CONSTRAINT idp_type_check CHECK (type IN ('OAUTH2', 'OIDC', 'LDAP', 'SAML')),
   -- config stores the corresponding configuration of the IdP, which may vary depending on the type of the IdP.
   config JSONB NOT NULL DEFAULT '{}'
 );
@@ -862,6 +862,24 @@ CREATE INDEX idx_inbox_receiver_id_status ON inbox(receiver_id, status);
 
 ALTER SEQUENCE inbox_id_seq RESTART WITH 101;
 
+-- audit_log table stores the hash chained snapshots of the activities when they are created or updated.
+-- The entry is stored as TEXT instead of JSONB, because the hash is computed from the exact text.
+-- Each record links to the hash of the previous one, so that removing or modifying the records breaks the chain.
+CREATE TABLE audit_log (
+    id BIGSERIAL PRIMARY KEY,
+    created_ts BIGINT NOT NULL DEFAULT extract(epoch from now()),
+    entry TEXT NOT NULL,
+    prev_hash TEXT NOT NULL,
+    hash TEXT NOT NULL
+);
+
+-- audit_log_export_cursor table stores the ID of the last audit log exported to each destination.
+CREATE TABLE audit_log_export_cursor (
+    destination TEXT PRIMARY KEY,
+    updated_ts BIGINT NOT NULL DEFAULT extract(epoch from now()),
+    audit_log_id BIGINT NOT NULL
+);
+
 -- bookmark table stores the bookmark for the user
 CREATE TABLE bookmark (
     id SERIAL PRIMARY KEY,
@@ -893,7 +911,7 @@ CREATE TABLE vcs (
     updater_id INTEGER NOT NULL REFERENCES principal (id),
     updated_ts BIGINT NOT NULL DEFAULT extract(epoch from now()),
     name TEXT NOT NULL,
-    type TEXT NOT NULL CHECK (type IN ('GITLAB', 'GITHUB', 'BITBUCKET')),
+    type TEXT NOT NULL CHECK (type IN ('GITLAB', 'GITHUB', 'BITBUCKET', 'GITEA', 'AZURE_DEVOPS')),
     instance_url TEXT NOT NULL CHECK ((instance_url LIKE 'http://%' OR instance_url LIKE 'https://%') AND instance_url = rtrim(instance_url, '/')),
     api_url TEXT NOT NULL CHECK ((api_url LIKE 'http://%' OR api_url LIKE 'https://%') AND api_url = rtrim(api_url, '/')),
     application_id TEXT NOT NULL,
@@ -938,6 +956,10 @@ CREATE TABLE repository (
     file_path_template TEXT NOT NULL DEFAULT '',
     -- If enable the SQL review CI in VCS repository.
     enable_sql_review_ci BOOLEAN NOT NULL DEFAULT false,
+    -- If enable the pull request flow, which reviews the migration files on PR/MRs and rolls them out on merge.
+    enable_pull_request_flow BOOLEAN NOT NULL DEFAULT false,
+    -- If write back the latest schema by pull requests instead of committing to the branch directly.
+    enable_schema_write_back_pull_request BOOLEAN NOT NULL DEFAULT false,
     -- The file path template for storing the latest schema auto-generated by Bytebase after migration.
     -- If empty, then Bytebase won't auto generate it.
     schema_path_template TEXT NOT NULL DEFAULT '',
@@ -1106,7 +1128,7 @@ CREATE TABLE sheet (
     name TEXT NOT NULL,
     statement TEXT NOT NULL,
     visibility TEXT NOT NULL CHECK (visibility IN ('PRIVATE', 'PROJECT', 'PUBLIC')) DEFAULT 'PRIVATE',
-    source TEXT NOT NULL CONSTRAINT sheet_source_check CHECK (source IN ('BYTEBASE', 'GITLAB', 'GITHUB', 'BITBUCKET', 'BYTEBASE_ARTIFACT')) DEFAULT 'BYTEBASE',
+    source TEXT NOT NULL CONSTRAINT sheet_source_check CHECK (source IN ('BYTEBASE', 'GITLAB', 'GITHUB', 'BITBUCKET', 'GITEA', 'AZURE_DEVOPS', 'BYTEBASE_ARTIFACT')) DEFAULT 'BYTEBASE',
     type TEXT NOT NULL CHECK (type IN ('SQL')) DEFAULT 'SQL',
     payload JSONB NOT NULL DEFAULT '{}'
 );
@@ -52,7 +52,7 @@ func MigrateSchema(ctx context.Context, storeDB *store.DB, strictUseDb bool, pgB
 	if err != nil {
 		return nil, err
 	}
-	storeInstance := store.New(storeDB)
+	storeInstance := store.New(storeDB, nil)
 	// Calculate prod cutoffSchemaVersion.
 	cutoffSchemaVersion, err := getProdCutoffVersion()
 	if err != nil {
@@ -192,7 +192,7 @@ func TestMigrationCompatibility(t *testing.T) {
 	db := store.NewDB(metadataConnConfig, pgBinDir, "", false, "", common.ReleaseModeDev)
 	err = db.Open(ctx)
 	require.NoError(t, err)
-	storeInstance := store.New(db)
+	storeInstance := store.New(db, nil)
 
 	releaseVersion, err := getProdCutoffVersion()
 	require.NoError(t, err)
@@ -438,6 +438,8 @@ type Advice struct {
 	Content string `json:"content"`
 	Line    int    `json:"line"`
 	Details string `json:"details,omitempty"`
+	// Fix is the optional machine-applicable fix for the advice.
+	Fix *Fix `json:"fix,omitempty" yaml:"fix,omitempty"`
 }
 
 // MarshalLogObject constructs a field that carries Advice.
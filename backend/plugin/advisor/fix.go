@@ -0,0 +1,196 @@
+package advisor
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// Edit is a machine-applicable text edit on the reviewed statement.
+type Edit struct {
+	// Start is the byte offset in the reviewed statement where the replaced range begins.
+	Start int `json:"start"`
+	// End is the byte offset in the reviewed statement where the replaced range ends, exclusive.
+	// An edit with End equal to Start is an insertion.
+	End int `json:"end"`
+	// NewText is the text replacing the range.
+	NewText string `json:"newText" yaml:"newText"`
+}
+
+// Fix is the suggested fix of an advice.
+type Fix struct {
+	Title string `json:"title"`
+	// Safe is true if the fix only resolves the finding and doesn't change the intent of the statement,
+	// so it can be applied without human review.
+	Safe  bool   `json:"safe"`
+	Edits []Edit `json:"edits"`
+}
+
+// ApplyFixes applies the safe fixes in the advice list to the statement.
+// Fixes overlapping with an already accepted fix are skipped.
+// It returns the fixed statement and the advices whose fixes are applied.
+func ApplyFixes(statement string, adviceList []Advice) (string, []Advice, error) {
+	type candidate struct {
+		advice Advice
+		start  int
+		end    int
+	}
+	var candidates []candidate
+	for _, advice := range adviceList {
+		if advice.Fix == nil || !advice.Fix.Safe || len(advice.Fix.Edits) == 0 {
+			continue
+		}
+		start, end := len(statement), 0
+		for _, edit := range advice.Fix.Edits {
+			if edit.Start < 0 || edit.End < edit.Start || edit.End > len(statement) {
+				return "", nil, errors.Errorf("invalid edit range [%d, %d) for statement with length %d", edit.Start, edit.End, len(statement))
+			}
+			if edit.Start < start {
+				start = edit.Start
+			}
+			if edit.End > end {
+				end = edit.End
+			}
+		}
+		candidates = append(candidates, candidate{advice: advice, start: start, end: end})
+	}
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return candidates[i].start < candidates[j].start
+	})
+
+	var applied []Advice
+	var edits []Edit
+	lastEnd := -1
+	for _, c := range candidates {
+		// Two insertions at the same position are ambiguous, so treat them as overlapping too.
+		if c.start < lastEnd || (c.start == lastEnd && c.start == c.end) {
+			continue
+		}
+		applied = append(applied, c.advice)
+		edits = append(edits, c.advice.Fix.Edits...)
+		lastEnd = c.end
+	}
+
+	sort.SliceStable(edits, func(i, j int) bool {
+		return edits[i].Start > edits[j].Start
+	})
+	for _, edit := range edits {
+		statement = statement[:edit.Start] + edit.NewText + statement[edit.End:]
+	}
+	return statement, applied, nil
+}
+
+// ExpectedNameFromTemplate returns the name expected by the naming rule template, e.g. "^$|^idx_{{table}}_{{column_list}}$".
+// The tokens in the template are replaced, and the first alternative that is a plain name within the max length is returned.
+// It returns false if no alternative is a plain name, e.g. the template uses other regular expression syntax.
+func ExpectedNameFromTemplate(template string, templateList []string, tokens map[string]string, maxLength int) (string, bool) {
+	for _, key := range templateList {
+		token, ok := tokens[key]
+		if !ok {
+			return "", false
+		}
+		template = strings.ReplaceAll(template, key, token)
+	}
+	for _, alternative := range strings.Split(template, "|") {
+		name := strings.TrimSuffix(strings.TrimPrefix(alternative, "^"), "$")
+		if name == "" || regexp.QuoteMeta(name) != name {
+			continue
+		}
+		if maxLength > 0 && len(name) > maxLength {
+			continue
+		}
+		return name, true
+	}
+	return "", false
+}
+
+// IdentifierRenamer builds the fixes renaming the identifier that follows one of the keywords, e.g., the index name after INDEX.
+// The keyword pattern is compiled once, and the identifier is matched without compiling a pattern for every fix.
+type IdentifierRenamer struct {
+	keywordReg         *regexp.Regexp
+	quote              byte
+	plainIdentifierReg *regexp.Regexp
+}
+
+// NewIdentifierRenamer creates the renamer for the dialect using the quote to quote identifiers.
+// The new names not matched by plainIdentifierReg need to be quoted, and are not fixed.
+func NewIdentifierRenamer(quote byte, plainIdentifierReg *regexp.Regexp, keywords ...string) *IdentifierRenamer {
+	return &IdentifierRenamer{
+		keywordReg:         regexp.MustCompile(fmt.Sprintf(`(?i)\b(?:%s)\s+`, strings.Join(keywords, "|"))),
+		quote:              quote,
+		plainIdentifierReg: plainIdentifierReg,
+	}
+}
+
+// Fix returns the fix renaming the identifier following the keywords in the statement text at the offset.
+// It returns nil if the identifier isn't located exactly once, or the new name needs to be quoted.
+// The fix is safe only if the old name isn't referenced anywhere else in the reviewed statement.
+func (r *IdentifierRenamer) Fix(title string, statement string, text string, offset int, oldName string, newName string) *Fix {
+	if offset < 0 || oldName == "" || !r.plainIdentifierReg.MatchString(newName) {
+		return nil
+	}
+	start := -1
+	for _, loc := range r.keywordReg.FindAllStringIndex(text, -1) {
+		pos := r.matchIdentifier(text, loc[1], oldName)
+		if pos < 0 {
+			continue
+		}
+		if start >= 0 {
+			return nil
+		}
+		start = pos
+	}
+	if start < 0 {
+		return nil
+	}
+	return &Fix{
+		Title: title,
+		Safe:  countIdentifier(statement, oldName) == 1,
+		Edits: []Edit{
+			{Start: offset + start, End: offset + start + len(oldName), NewText: newName},
+		},
+	}
+}
+
+// matchIdentifier returns the start of the name, optionally quoted, at the pos of the text, or -1 if not matched.
+func (r *IdentifierRenamer) matchIdentifier(text string, pos int, name string) int {
+	if pos < len(text) && text[pos] == r.quote {
+		pos++
+	}
+	end := pos + len(name)
+	if end > len(text) || !strings.EqualFold(text[pos:end], name) {
+		return -1
+	}
+	if end < len(text) && text[end] == r.quote {
+		end++
+	}
+	if end < len(text) && !strings.ContainsRune("\t\n\f\r (;", rune(text[end])) {
+		return -1
+	}
+	return pos
+}
+
+// countIdentifier returns the number of the case-insensitive occurrences of the name as a whole identifier in the statement.
+func countIdentifier(statement string, name string) int {
+	count := 0
+	for i := 0; i+len(name) <= len(statement); i++ {
+		if !strings.EqualFold(statement[i:i+len(name)], name) {
+			continue
+		}
+		if i > 0 && isIdentifierByte(statement[i-1]) {
+			continue
+		}
+		if end := i + len(name); end < len(statement) && isIdentifierByte(statement[end]) {
+			continue
+		}
+		count++
+	}
+	return count
+}
+
+func isIdentifierByte(c byte) bool {
+	return c == '_' || c == '$' || ('0' <= c && c <= '9') || ('a' <= c && c <= 'z') || ('A' <= c && c <= 'Z')
+}
@@ -0,0 +1,167 @@
+package advisor
+
+import (
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestApplyFixes(t *testing.T) {
+	insert := func(pos int, text string, safe bool) Advice {
+		return Advice{
+			Status: Warn,
+			Fix: &Fix{
+				Safe:  safe,
+				Edits: []Edit{{Start: pos, End: pos, NewText: text}},
+			},
+		}
+	}
+	tests := []struct {
+		statement  string
+		adviceList []Advice
+		want       string
+		wantCount  int
+	}{
+		{
+			statement:  "CREATE INDEX idx ON t(a);",
+			adviceList: []Advice{{Status: Warn}, insert(12, " CONCURRENTLY", true)},
+			want:       "CREATE INDEX CONCURRENTLY idx ON t(a);",
+			wantCount:  1,
+		},
+		{
+			statement:  "CREATE INDEX idx ON t(a);",
+			adviceList: []Advice{insert(12, " CONCURRENTLY", false)},
+			want:       "CREATE INDEX idx ON t(a);",
+			wantCount:  0,
+		},
+		{
+			statement: "CREATE INDEX a ON t(a);\nCREATE INDEX b ON t(b);",
+			adviceList: []Advice{
+				insert(36, " CONCURRENTLY", true),
+				insert(12, " CONCURRENTLY", true),
+			},
+			want:      "CREATE INDEX CONCURRENTLY a ON t(a);\nCREATE INDEX CONCURRENTLY b ON t(b);",
+			wantCount: 2,
+		},
+		{
+			// The second insertion at the same position is skipped.
+			statement:  "SELECT 1",
+			adviceList: []Advice{insert(8, ";", true), insert(8, ";", true)},
+			want:       "SELECT 1;",
+			wantCount:  1,
+		},
+		{
+			statement: "ALTER TABLE t ADD CHECK (a > 0);",
+			adviceList: []Advice{
+				{Fix: &Fix{Safe: true, Edits: []Edit{{Start: 18, End: 31, NewText: "CHECK (a > 1)"}}}},
+				insert(24, "", true),
+			},
+			want:      "ALTER TABLE t ADD CHECK (a > 1);",
+			wantCount: 1,
+		},
+	}
+
+	for _, test := range tests {
+		got, applied, err := ApplyFixes(test.statement, test.adviceList)
+		require.NoError(t, err)
+		require.Equal(t, test.want, got)
+		require.Len(t, applied, test.wantCount)
+	}
+
+	_, _, err := ApplyFixes("SELECT 1", []Advice{insert(100, "x", true)})
+	require.Error(t, err)
+}
+
+func TestExpectedNameFromTemplate(t *testing.T) {
+	templateList := []string{TableNameTemplateToken, ColumnListTemplateToken}
+	tokens := map[string]string{
+		TableNameTemplateToken:  "tech_book",
+		ColumnListTemplateToken: "id_name",
+	}
+	tests := []struct {
+		template  string
+		maxLength int
+		want      string
+		wantOK    bool
+	}{
+		{template: "^$|^idx_{{table}}_{{column_list}}$", maxLength: 64, want: "idx_tech_book_id_name", wantOK: true},
+		{template: "^idx_{{table}}_{{column_list}}$", maxLength: 0, want: "idx_tech_book_id_name", wantOK: true},
+		{template: "^idx_{{table}}_{{column_list}}$", maxLength: 10, wantOK: false},
+		{template: "^idx_{{table}}_.*$", maxLength: 64, wantOK: false},
+		{template: "^$|^idx_[a-z]+$|^idx_{{table}}$", maxLength: 64, want: "idx_tech_book", wantOK: true},
+	}
+	a := require.New(t)
+	for _, test := range tests {
+		got, ok := ExpectedNameFromTemplate(test.template, templateList, tokens, test.maxLength)
+		a.Equal(test.wantOK, ok, test.template)
+		a.Equal(test.want, got, test.template)
+	}
+}
+
+func TestIdentifierRenamer(t *testing.T) {
+	a := require.New(t)
+	renamer := NewIdentifierRenamer('"', regexp.MustCompile(`^[a-z_][a-z0-9_$]*$`), "INDEX", "CONCURRENTLY", "EXISTS")
+	tests := []struct {
+		// statement is the reviewed statement, and the fix renames the index in its first statement.
+		statement string
+		oldName   string
+		newName   string
+		// want is the statement after the fix is applied, empty means no fix.
+		want     string
+		wantSafe bool
+	}{
+		{
+			statement: "CREATE INDEX Idx ON t(a)",
+			oldName:   "idx",
+			newName:   "idx_t_a",
+			want:      "CREATE INDEX idx_t_a ON t(a)",
+			wantSafe:  true,
+		},
+		{
+			statement: `CREATE INDEX CONCURRENTLY IF NOT EXISTS "idx"(a)`,
+			oldName:   "idx",
+			newName:   "idx_t_a",
+			want:      `CREATE INDEX CONCURRENTLY IF NOT EXISTS "idx_t_a"(a)`,
+			wantSafe:  true,
+		},
+		{
+			// The old name is referenced by the other statement.
+			statement: "CREATE INDEX idx ON t(a); COMMENT ON INDEX idx IS 'a'",
+			oldName:   "idx",
+			newName:   "idx_t_a",
+			want:      "CREATE INDEX idx_t_a ON t(a); COMMENT ON INDEX idx IS 'a'",
+		},
+		{
+			// The old name is located twice.
+			statement: "CREATE INDEX idx ON t(a) INDEX idx",
+			oldName:   "idx",
+			newName:   "idx_t_a",
+		},
+		{
+			// The new name needs to be quoted.
+			statement: "CREATE INDEX idx ON t(a)",
+			oldName:   "idx",
+			newName:   "Idx_t_a",
+		},
+		{
+			statement: "CREATE INDEX idx_a ON t(a)",
+			oldName:   "idx",
+			newName:   "idx_t_a",
+		},
+	}
+	for _, test := range tests {
+		text, _, _ := strings.Cut(test.statement, ";")
+		fix := renamer.Fix("rename", test.statement, text, 0, test.oldName, test.newName)
+		if test.want == "" {
+			a.Nil(fix, test.statement)
+			continue
+		}
+		a.NotNil(fix, test.statement)
+		a.Equal(test.wantSafe, fix.Safe, test.statement)
+		a.Len(fix.Edits, 1, test.statement)
+		edit := fix.Edits[0]
+		a.Equal(test.want, test.statement[:edit.Start]+edit.NewText+test.statement[edit.End:], test.statement)
+	}
+}
@@ -4,6 +4,7 @@ package mysql
 
 import (
 	"fmt"
+	"regexp"
 
 	"github.com/pingcap/tidb/parser/ast"
 
@@ -16,6 +17,9 @@ var (
 	_ ast.Visitor     = (*columnDisallowChangingOrderChecker)(nil)
 )
 
+// columnPositionSuffixReg matches the column position clause at the end of the ALTER TABLE statement.
+var columnPositionSuffixReg = regexp.MustCompile("(?is)\\s+(?:FIRST|AFTER\\s+(?:`[^`]+`|[A-Za-z0-9_$]+))\\s*$")
+
 func init() {
 	advisor.Register(db.MySQL, advisor.MySQLColumnDisallowChangingOrder, &ColumnDisallowChangingOrderAdvisor{})
 	advisor.Register(db.TiDB, advisor.MySQLColumnDisallowChangingOrder, &ColumnDisallowChangingOrderAdvisor{})
@@ -43,9 +47,11 @@ func (*ColumnDisallowChangingOrderAdvisor) Check(ctx advisor.Context, statement
 		title: string(ctx.Rule.Type),
 	}
 
+	locator := newStatementLocator(statement)
 	for _, stmt := range stmtList {
 		checker.text = stmt.Text()
 		checker.line = stmt.OriginTextPosition()
+		checker.trimmedText, checker.offset = locator.locate(stmt)
 		(stmt).Accept(checker)
 	}
 
@@ -66,6 +72,9 @@ type columnDisallowChangingOrderChecker struct {
 	title      string
 	text       string
 	line       int
+	// trimmedText is the statement text without the trailing delimiter, at the offset of the reviewed statement.
+	trimmedText string
+	offset      int
 }
 
 // Enter implements the ast.Visitor interface.
@@ -80,6 +89,7 @@ func (checker *columnDisallowChangingOrderChecker) Enter(in ast.Node) (ast.Node,
 					Title:   checker.title,
 					Content: fmt.Sprintf("\"%s\" changes column order", checker.text),
 					Line:    checker.line,
+					Fix:     checker.fix(len(node.Specs)),
 				})
 				break
 			}
@@ -89,6 +99,26 @@ func (checker *columnDisallowChangingOrderChecker) Enter(in ast.Node) (ast.Node,
 	return in, false
 }
 
+// fix removes the column position clause at the end of the ALTER TABLE statement.
+// It's only suggested for the statement with a single alter spec, so the clause always belongs to the changed column.
+// The fix is not safe because the column is left in its original position, which may not be what the author expects.
+func (checker *columnDisallowChangingOrderChecker) fix(specCount int) *advisor.Fix {
+	if checker.offset < 0 || specCount != 1 {
+		return nil
+	}
+	loc := columnPositionSuffixReg.FindStringIndex(checker.trimmedText)
+	if loc == nil {
+		return nil
+	}
+	return &advisor.Fix{
+		Title: "Keep the column order",
+		Safe:  false,
+		Edits: []advisor.Edit{
+			{Start: checker.offset + loc[0], End: checker.offset + loc[1], NewText: ""},
+		},
+	}
+}
+
 // Leave implements the ast.Visitor interface.
 func (*columnDisallowChangingOrderChecker) Leave(in ast.Node) (ast.Node, bool) {
 	return in, true
@@ -29,7 +29,7 @@ type InsertDisallowOrderByRandAdvisor struct {
 
 // Check checks for to disallow order by rand in INSERT statements.
 func (*InsertDisallowOrderByRandAdvisor) Check(ctx advisor.Context, statement string) ([]advisor.Advice, error) {
-	stmtList, errAdvice := parseStatement(statement, ctx.Charset, ctx.Collation)
+	stmtList, errAdvice := parseStatementWithRoutineBody(statement, ctx.Charset, ctx.Collation)
 	if errAdvice != nil {
 		return errAdvice, nil
 	}
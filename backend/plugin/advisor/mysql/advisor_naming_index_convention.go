@@ -17,6 +17,9 @@ var (
 	_ ast.Visitor     = (*namingIndexConventionChecker)(nil)
 )
 
+// indexNameRenamer renames the index name following the keywords of the index statements.
+var indexNameRenamer = advisor.NewIdentifierRenamer('`', plainIdentifierReg, "INDEX", "KEY", "TO")
+
 func init() {
 	advisor.Register(db.MySQL, advisor.MySQLNamingIndexConvention, &NamingIndexConventionAdvisor{})
 	advisor.Register(db.TiDB, advisor.MySQLNamingIndexConvention, &NamingIndexConventionAdvisor{})
@@ -51,8 +54,11 @@ func (*NamingIndexConventionAdvisor) Check(ctx advisor.Context, statement string
 		maxLength:    maxLength,
 		templateList: templateList,
 		catalog:      ctx.Catalog,
+		statement:    statement,
 	}
+	locator := newStatementLocator(statement)
 	for _, stmtNode := range root {
+		checker.text, checker.offset = locator.locate(stmtNode)
 		(stmtNode).Accept(checker)
 	}
 
@@ -76,6 +82,9 @@ type namingIndexConventionChecker struct {
 	maxLength    int
 	templateList []string
 	catalog      *catalog.Finder
+	statement    string
+	text         string
+	offset       int
 }
 
 // Enter implements the ast.Visitor interface.
@@ -100,6 +109,7 @@ func (checker *namingIndexConventionChecker) Enter(in ast.Node) (ast.Node, bool)
 				Title:   checker.title,
 				Content: fmt.Sprintf("Index in table `%s` mismatches the naming convention, expect %q but found `%s`", indexData.tableName, regex, indexData.indexName),
 				Line:    indexData.line,
+				Fix:     checker.fix(indexData),
 			})
 		}
 		if checker.maxLength > 0 && len(indexData.indexName) > checker.maxLength {
@@ -116,6 +126,15 @@ func (checker *namingIndexConventionChecker) Enter(in ast.Node) (ast.Node, bool)
 	return in, false
 }
 
+// fix renames the index to the name expected by the naming convention.
+func (checker *namingIndexConventionChecker) fix(indexData *indexMetaData) *advisor.Fix {
+	name, ok := advisor.ExpectedNameFromTemplate(checker.format, checker.templateList, indexData.metaData, checker.maxLength)
+	if !ok {
+		return nil
+	}
+	return indexNameRenamer.Fix(fmt.Sprintf("Rename the index to `%s`", name), checker.statement, checker.text, checker.offset, indexData.indexName, name)
+}
+
 // Leave implements the ast.Visitor interface.
 func (*namingIndexConventionChecker) Leave(in ast.Node) (ast.Node, bool) {
 	return in, true
@@ -29,7 +29,7 @@ type StatementDisallowCommitAdvisor struct {
 
 // Check checks for index type no blob.
 func (*StatementDisallowCommitAdvisor) Check(ctx advisor.Context, statement string) ([]advisor.Advice, error) {
-	stmtList, errAdvice := parseStatement(statement, ctx.Charset, ctx.Collation)
+	stmtList, errAdvice := parseStatementWithRoutineBody(statement, ctx.Charset, ctx.Collation)
 	if errAdvice != nil {
 		return errAdvice, nil
 	}
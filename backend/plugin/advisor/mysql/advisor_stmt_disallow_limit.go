@@ -29,7 +29,7 @@ type DisallowLimitAdvisor struct {
 
 // Check checks for no LIMIT clause in INSERT/UPDATE statement.
 func (*DisallowLimitAdvisor) Check(ctx advisor.Context, statement string) ([]advisor.Advice, error) {
-	stmtList, errAdvice := parseStatement(statement, ctx.Charset, ctx.Collation)
+	stmtList, errAdvice := parseStatementWithRoutineBody(statement, ctx.Charset, ctx.Collation)
 	if errAdvice != nil {
 		return errAdvice, nil
 	}
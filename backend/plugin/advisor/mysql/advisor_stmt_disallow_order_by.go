@@ -29,7 +29,7 @@ type DisallowOrderByAdvisor struct {
 
 // Check checks for no ORDER BY clause in DELETE/UPDATE statements.
 func (*DisallowOrderByAdvisor) Check(ctx advisor.Context, statement string) ([]advisor.Advice, error) {
-	stmtList, errAdvice := parseStatement(statement, ctx.Charset, ctx.Collation)
+	stmtList, errAdvice := parseStatementWithRoutineBody(statement, ctx.Charset, ctx.Collation)
 	if errAdvice != nil {
 		return errAdvice, nil
 	}
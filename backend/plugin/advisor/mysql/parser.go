@@ -1,6 +1,7 @@
 package mysql
 
 import (
+	"github.com/antlr4-go/antlr/v4"
 	tidbparser "github.com/pingcap/tidb/parser"
 	"github.com/pingcap/tidb/parser/ast"
 
@@ -22,6 +23,17 @@ func newParser() *tidbparser.Parser {
 }
 
 func parseStatement(statement string, charset string, collation string) ([]ast.StmtNode, []advisor.Advice) {
+	return parseStatementImpl(statement, charset, collation, false /* includeRoutineBody */)
+}
+
+// parseStatementWithRoutineBody parses the statement like parseStatement, and also returns the statements in the bodies of
+// the CREATE PROCEDURE, CREATE FUNCTION, CREATE TRIGGER and CREATE EVENT statements, so the statement-level rules apply to them.
+// The OriginTextPosition of the body statements is the line in the original statement.
+func parseStatementWithRoutineBody(statement string, charset string, collation string) ([]ast.StmtNode, []advisor.Advice) {
+	return parseStatementImpl(statement, charset, collation, true /* includeRoutineBody */)
+}
+
+func parseStatementImpl(statement string, charset string, collation string, includeRoutineBody bool) ([]ast.StmtNode, []advisor.Advice) {
 	tree, tokens, err := parser.ParseMySQL(statement)
 	if err != nil {
 		if syntaxErr, ok := err.(*parser.SyntaxError); ok {
@@ -80,8 +92,59 @@ func parseStatement(statement string, charset string, collation string) ([]ast.S
 				}
 				returnNodes = append(returnNodes, node)
 			}
+
+			if !includeRoutineBody {
+				continue
+			}
+			for _, bodyStatement := range extractRoutineBodyStatements(query) {
+				text := tokens.GetTextFromRuleContext(bodyStatement)
+				nodes, _, err := p.Parse(text, charset, collation)
+				if err != nil || len(nodes) != 1 {
+					// TiDB parser doesn't support some statements in the routine body, such as SELECT ... INTO variables.
+					continue
+				}
+				node := nodes[0]
+				node.SetText(nil, text)
+				node.SetOriginTextPosition(bodyStatement.GetStop().GetLine())
+				returnNodes = append(returnNodes, node)
+			}
 		}
 	}
 
 	return returnNodes, adviceList
 }
+
+// extractRoutineBodyStatements returns the simple statements in the body of the stored program created by the query.
+func extractRoutineBodyStatements(query mysqlparser.IQueryContext) []mysqlparser.ISimpleStatementContext {
+	if query.SimpleStatement() == nil || query.SimpleStatement().CreateStatement() == nil {
+		return nil
+	}
+	create := query.SimpleStatement().CreateStatement()
+	var body mysqlparser.ICompoundStatementContext
+	switch {
+	case create.CreateProcedure() != nil:
+		body = create.CreateProcedure().CompoundStatement()
+	case create.CreateFunction() != nil:
+		body = create.CreateFunction().CompoundStatement()
+	case create.CreateTrigger() != nil:
+		body = create.CreateTrigger().CompoundStatement()
+	case create.CreateEvent() != nil:
+		body = create.CreateEvent().CompoundStatement()
+	}
+	if body == nil {
+		return nil
+	}
+	var result []mysqlparser.ISimpleStatementContext
+	collectSimpleStatements(body, &result)
+	return result
+}
+
+func collectSimpleStatements(tree antlr.Tree, result *[]mysqlparser.ISimpleStatementContext) {
+	if simpleStatement, ok := tree.(mysqlparser.ISimpleStatementContext); ok {
+		*result = append(*result, simpleStatement)
+		return
+	}
+	for _, child := range tree.GetChildren() {
+		collectSimpleStatements(child, result)
+	}
+}
@@ -2,6 +2,7 @@
 package mysql
 
 import (
+	"regexp"
 	"sort"
 	"strings"
 
@@ -9,6 +10,9 @@ import (
 	"github.com/pingcap/tidb/parser/format"
 )
 
+// plainIdentifierReg matches the identifier that doesn't need to be quoted.
+var plainIdentifierReg = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_$]*$`)
+
 type columnSet map[string]bool
 
 func newColumnSet(columns []string) columnSet {
@@ -51,3 +55,31 @@ func restoreNode(node ast.Node, flag format.RestoreFlags) (string, error) {
 	}
 	return buffer.String(), nil
 }
+
+// statementLocator locates the parsed statements in the original statement text.
+// The statements must be located in the order they appear.
+type statementLocator struct {
+	statement string
+	cursor    int
+}
+
+func newStatementLocator(statement string) *statementLocator {
+	return &statementLocator{statement: statement}
+}
+
+// locate returns the statement text without the trailing delimiter, and its byte offset in the original statement.
+// The offset is -1 if the text is not found.
+func (l *statementLocator) locate(node ast.StmtNode) (string, int) {
+	text := strings.TrimSpace(node.Text())
+	text = strings.TrimSpace(strings.TrimSuffix(text, ";"))
+	if text == "" {
+		return "", -1
+	}
+	pos := strings.Index(l.statement[l.cursor:], text)
+	if pos < 0 {
+		return text, -1
+	}
+	start := l.cursor + pos
+	l.cursor = start + len(text)
+	return text, start
+}
@@ -3,6 +3,8 @@ package pg
 // Framework code is generated by the generator.
 
 import (
+	"regexp"
+
 	"github.com/bytebase/bytebase/backend/plugin/advisor"
 	"github.com/bytebase/bytebase/backend/plugin/advisor/db"
 	"github.com/bytebase/bytebase/backend/plugin/parser/sql/ast"
@@ -13,6 +15,9 @@ var (
 	_ ast.Visitor     = (*indexCreateConcurrentlyChecker)(nil)
 )
 
+// createIndexPrefixReg matches CREATE [ UNIQUE ] INDEX at the beginning of the statement.
+var createIndexPrefixReg = regexp.MustCompile(`(?is)^CREATE\s+(UNIQUE\s+)?INDEX\b`)
+
 func init() {
 	advisor.Register(db.Postgres, advisor.PostgreSQLCreateIndexConcurrently, &IndexCreateConcurrentlyAdvisor{})
 }
@@ -37,7 +42,10 @@ func (*IndexCreateConcurrentlyAdvisor) Check(ctx advisor.Context, statement stri
 		title: string(ctx.Rule.Type),
 	}
 
+	locator := newStatementLocator(statement)
 	for _, stmt := range stmtList {
+		checker.text = stmt.Text()
+		checker.offset = locator.locate(stmt)
 		ast.Walk(checker, stmt)
 	}
 
@@ -56,6 +64,8 @@ type indexCreateConcurrentlyChecker struct {
 	adviceList []advisor.Advice
 	level      advisor.Status
 	title      string
+	text       string
+	offset     int
 }
 
 // Visit implements ast.Visitor interface.
@@ -68,9 +78,29 @@ func (checker *indexCreateConcurrentlyChecker) Visit(in ast.Node) ast.Visitor {
 				Title:   checker.title,
 				Content: "Creating indexes will block writes on the table, unless use CONCURRENTLY",
 				Line:    in.LastLine(),
+				Fix:     checker.fix(),
 			})
 		}
 	}
 
 	return checker
 }
+
+func (checker *indexCreateConcurrentlyChecker) fix() *advisor.Fix {
+	if checker.offset < 0 {
+		return nil
+	}
+	loc := createIndexPrefixReg.FindStringIndex(checker.text)
+	if loc == nil {
+		return nil
+	}
+	pos := checker.offset + loc[1]
+	return &advisor.Fix{
+		Title: "Create the index concurrently",
+		// The PostgreSQL driver executes CREATE INDEX CONCURRENTLY outside the transaction block.
+		Safe: true,
+		Edits: []advisor.Edit{
+			{Start: pos, End: pos, NewText: " CONCURRENTLY"},
+		},
+	}
+}
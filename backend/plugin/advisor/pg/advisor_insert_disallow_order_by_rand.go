@@ -26,7 +26,7 @@ type InsertDisallowOrderByRandAdvisor struct {
 
 // Check checks for to disallow order by rand in INSERT statements.
 func (*InsertDisallowOrderByRandAdvisor) Check(ctx advisor.Context, statement string) ([]advisor.Advice, error) {
-	stmtList, errAdvice := parseStatement(statement)
+	stmtList, errAdvice := parseStatementWithRoutineBody(statement)
 	if errAdvice != nil {
 		return errAdvice, nil
 	}
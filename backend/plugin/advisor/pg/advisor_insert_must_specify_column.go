@@ -25,7 +25,7 @@ type InsertMustSpecifyColumnAdvisor struct {
 
 // Check checks for to enforce column specified.
 func (*InsertMustSpecifyColumnAdvisor) Check(ctx advisor.Context, statement string) ([]advisor.Advice, error) {
-	stmtList, errAdvice := parseStatement(statement)
+	stmtList, errAdvice := parseStatementWithRoutineBody(statement)
 	if errAdvice != nil {
 		return errAdvice, nil
 	}
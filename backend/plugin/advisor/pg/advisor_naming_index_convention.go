@@ -15,6 +15,9 @@ var (
 	_ ast.Visitor     = (*namingIndexConventionChecker)(nil)
 )
 
+// indexNameRenamer renames the index name following the keywords of the index statements.
+var indexNameRenamer = advisor.NewIdentifierRenamer('"', plainIdentifierReg, "INDEX", "CONCURRENTLY", "EXISTS", "TO")
+
 func init() {
 	advisor.Register(db.Postgres, advisor.PostgreSQLNamingIndexConvention, &NamingIndexConventionAdvisor{})
 }
@@ -47,8 +50,12 @@ func (*NamingIndexConventionAdvisor) Check(ctx advisor.Context, statement string
 		maxLength:    maxLength,
 		templateList: templateList,
 		catalog:      ctx.Catalog,
+		statement:    statement,
 	}
+	locator := newStatementLocator(statement)
 	for _, stmt := range stmts {
+		checker.text = stmt.Text()
+		checker.offset = locator.locate(stmt)
 		ast.Walk(checker, stmt)
 	}
 
@@ -72,6 +79,9 @@ type namingIndexConventionChecker struct {
 	maxLength    int
 	templateList []string
 	catalog      *catalog.Finder
+	statement    string
+	text         string
+	offset       int
 }
 
 // Visit implements ast.Visitor interface.
@@ -96,6 +106,7 @@ func (checker *namingIndexConventionChecker) Visit(node ast.Node) ast.Visitor {
 				Title:   checker.title,
 				Content: fmt.Sprintf("Index in table %q mismatches the naming convention, expect %q but found %q", indexData.tableName, regex, indexData.indexName),
 				Line:    node.LastLine(),
+				Fix:     checker.fix(indexData),
 			})
 		}
 		if checker.maxLength > 0 && len(indexData.indexName) > checker.maxLength {
@@ -111,6 +122,15 @@ func (checker *namingIndexConventionChecker) Visit(node ast.Node) ast.Visitor {
 	return checker
 }
 
+// fix renames the index to the name expected by the naming convention.
+func (checker *namingIndexConventionChecker) fix(indexData *indexMetaData) *advisor.Fix {
+	name, ok := advisor.ExpectedNameFromTemplate(checker.format, checker.templateList, indexData.metaData, checker.maxLength)
+	if !ok {
+		return nil
+	}
+	return indexNameRenamer.Fix(fmt.Sprintf("Rename the index to %q", name), checker.statement, checker.text, checker.offset, indexData.indexName, name)
+}
+
 func (checker *namingIndexConventionChecker) getMetaDataList(in ast.Node) []*indexMetaData {
 	var res []*indexMetaData
 
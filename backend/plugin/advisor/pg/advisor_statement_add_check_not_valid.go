@@ -3,6 +3,8 @@ package pg
 // Framework code is generated by the generator.
 
 import (
+	"strings"
+
 	"github.com/bytebase/bytebase/backend/plugin/advisor"
 	"github.com/bytebase/bytebase/backend/plugin/advisor/db"
 	"github.com/bytebase/bytebase/backend/plugin/parser/sql/ast"
@@ -37,8 +39,15 @@ func (*StatementAddCheckNotValidAdvisor) Check(ctx advisor.Context, statement st
 		title: string(ctx.Rule.Type),
 	}
 
+	locator := newStatementLocator(statement)
 	for _, stmt := range stmtList {
 		checker.line = stmt.LastLine()
+		checker.text = stmt.Text()
+		checker.offset = locator.locate(stmt)
+		checker.singleAlterItem = false
+		if alterTable, ok := stmt.(*ast.AlterTableStmt); ok {
+			checker.singleAlterItem = len(alterTable.AlterItemList) == 1
+		}
 		ast.Walk(checker, stmt)
 	}
 
@@ -58,6 +67,10 @@ type statementAddCheckNotValidChecker struct {
 	level      advisor.Status
 	title      string
 	line       int
+	text       string
+	offset     int
+	// singleAlterItem is true if the current statement is ALTER TABLE with only one alter item.
+	singleAlterItem bool
 }
 
 // Visit implements ast.Visitor interface.
@@ -70,9 +83,28 @@ func (checker *statementAddCheckNotValidChecker) Visit(in ast.Node) ast.Visitor
 				Title:   checker.title,
 				Content: "Adding check constraints with validation will block reads and writes. You can add check constraints not valid and then validate separately",
 				Line:    checker.line,
+				Fix:     checker.fix(),
 			})
 		}
 	}
 
 	return checker
 }
+
+// fix appends NOT VALID to the end of the ALTER TABLE statement.
+// It's only suggested for the statement with a single alter item, so NOT VALID is always attached to the constraint.
+// The fix is not safe because the existing rows are left unchecked until the constraint is validated separately.
+func (checker *statementAddCheckNotValidChecker) fix() *advisor.Fix {
+	if checker.offset < 0 || !checker.singleAlterItem {
+		return nil
+	}
+	text := strings.TrimRight(strings.TrimSuffix(checker.text, ";"), " \t\r\n")
+	pos := checker.offset + len(text)
+	return &advisor.Fix{
+		Title: "Add the check constraint with NOT VALID",
+		Safe:  false,
+		Edits: []advisor.Edit{
+			{Start: pos, End: pos, NewText: " NOT VALID"},
+		},
+	}
+}
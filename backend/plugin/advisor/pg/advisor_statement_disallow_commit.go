@@ -25,7 +25,7 @@ type StatementDisallowCommitAdvisor struct {
 
 // Check checks for to disallow commit.
 func (*StatementDisallowCommitAdvisor) Check(ctx advisor.Context, statement string) ([]advisor.Advice, error) {
-	stmtList, errAdvice := parseStatement(statement)
+	stmtList, errAdvice := parseStatementWithRoutineBody(statement)
 	if errAdvice != nil {
 		return errAdvice, nil
 	}
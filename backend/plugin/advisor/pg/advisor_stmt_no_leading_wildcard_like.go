@@ -27,7 +27,7 @@ type NoLeadingWildcardLikeAdvisor struct {
 
 // Check checks for no leading wildcard LIKE.
 func (*NoLeadingWildcardLikeAdvisor) Check(ctx advisor.Context, statement string) ([]advisor.Advice, error) {
-	stmts, errAdvice := parseStatement(statement)
+	stmts, errAdvice := parseStatementWithRoutineBody(statement)
 	if errAdvice != nil {
 		return errAdvice, nil
 	}
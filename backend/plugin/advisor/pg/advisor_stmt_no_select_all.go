@@ -23,7 +23,7 @@ type NoSelectAllAdvisor struct {
 
 // Check checks for no "select *".
 func (*NoSelectAllAdvisor) Check(ctx advisor.Context, statement string) ([]advisor.Advice, error) {
-	stmts, errAdvice := parseStatement(statement)
+	stmts, errAdvice := parseStatementWithRoutineBody(statement)
 	if errAdvice != nil {
 		return errAdvice, nil
 	}
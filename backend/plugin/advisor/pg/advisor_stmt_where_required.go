@@ -23,7 +23,7 @@ type WhereRequirementAdvisor struct {
 
 // Check checks for the WHERE clause requirement.
 func (*WhereRequirementAdvisor) Check(ctx advisor.Context, statement string) ([]advisor.Advice, error) {
-	stmts, errAdvice := parseStatement(statement)
+	stmts, errAdvice := parseStatementWithRoutineBody(statement)
 	if errAdvice != nil {
 		return errAdvice, nil
 	}
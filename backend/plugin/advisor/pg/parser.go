@@ -1,6 +1,8 @@
 package pg
 
 import (
+	"strings"
+
 	"github.com/bytebase/bytebase/backend/plugin/advisor"
 	parser "github.com/bytebase/bytebase/backend/plugin/parser/sql"
 	"github.com/bytebase/bytebase/backend/plugin/parser/sql/ast"
@@ -39,6 +41,43 @@ func parseStatement(statement string) ([]ast.Node, []advisor.Advice) {
 	return res, nil
 }
 
+// parseStatementWithRoutineBody parses the statement like parseStatement, and also returns the statements in the
+// function and procedure bodies following the CREATE FUNCTION and CREATE PROCEDURE statements,
+// so the statement-level rules apply to them. The line of the body statements is the line in the original statement.
+func parseStatementWithRoutineBody(statement string) ([]ast.Node, []advisor.Advice) {
+	nodes, adviceList := parseStatement(statement)
+	if adviceList != nil {
+		return nil, adviceList
+	}
+	var res []ast.Node
+	for _, node := range nodes {
+		res = append(res, node)
+		if _, ok := node.(*ast.CreateFunctionStmt); !ok {
+			continue
+		}
+		bodyList, err := parser.ExtractPostgresRoutineStatements(node.Text())
+		if err != nil {
+			// PostgreSQL validates the body when creating the function, so skip the body we can't parse.
+			continue
+		}
+		firstLine := node.LastLine() - strings.Count(node.Text(), "\n")
+		for _, body := range bodyList {
+			bodyNodes, err := parser.Parse(parser.Postgres, parser.ParseContext{}, body.Text)
+			if err != nil {
+				continue
+			}
+			for _, bodyNode := range bodyNodes {
+				if bodyNode == nil {
+					continue
+				}
+				bodyNode.SetLastLine(firstLine + body.LastLine - 1)
+				res = append(res, bodyNode)
+			}
+		}
+	}
+	return res, nil
+}
+
 func calculateErrorLine(statement string) int {
 	statementList, err := parser.SplitMultiSQL(parser.Postgres, statement)
 	if err != nil {
@@ -3,10 +3,15 @@ package pg
 
 import (
 	"fmt"
+	"regexp"
+	"strings"
 
 	"github.com/bytebase/bytebase/backend/plugin/parser/sql/ast"
 )
 
+// plainIdentifierReg matches the identifier that doesn't need to be quoted.
+var plainIdentifierReg = regexp.MustCompile(`^[a-z_][a-z0-9_$]*$`)
+
 const (
 	// PostgreSQLPublicSchema is the string for PostgreSQL public schema.
 	PostgreSQLPublicSchema = "public"
@@ -46,3 +51,29 @@ func normalizeTableName(table *ast.TableDef, defaultSchema string) string {
 	}
 	return fmt.Sprintf("%q.%q", schema, table.Name)
 }
+
+// statementLocator locates the parsed statements in the original statement text.
+// The statements must be located in the order they appear.
+type statementLocator struct {
+	statement string
+	cursor    int
+}
+
+func newStatementLocator(statement string) *statementLocator {
+	return &statementLocator{statement: statement}
+}
+
+// locate returns the byte offset of the node text in the original statement, or -1 if not found.
+func (l *statementLocator) locate(node ast.Node) int {
+	text := node.Text()
+	if text == "" {
+		return -1
+	}
+	pos := strings.Index(l.statement[l.cursor:], text)
+	if pos < 0 {
+		return -1
+	}
+	start := l.cursor + pos
+	l.cursor = start + len(text)
+	return start
+}
@@ -0,0 +1,412 @@
+// Package slack implements the Slack Web API callers and the interactive approval messages.
+package slack
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	timeout = 30 * time.Second
+	// APIPath is the path of the Slack Web API server.
+	APIPath = "https://slack.com/api"
+
+	// maxRequestAge is the max age of the interactive requests, which prevents the replay attacks.
+	// https://api.slack.com/authentication/verifying-requests-from-slack
+	maxRequestAge = 5 * time.Minute
+)
+
+const (
+	// ActionApprove is the action ID of the approve button.
+	ActionApprove = "approve"
+	// ActionReject is the action ID of the reject button.
+	ActionReject = "reject"
+
+	commentBlockID  = "comment"
+	commentActionID = "comment"
+	actionsBlockID  = "review"
+)
+
+// Provider is the provider for IM Slack.
+type Provider struct {
+	APIPath string
+	client  *http.Client
+}
+
+// NewProvider returns a Provider.
+func NewProvider(apiPath string) *Provider {
+	return &Provider{
+		APIPath: apiPath,
+		client: &http.Client{
+			Timeout: timeout,
+		},
+	}
+}
+
+// Field is a field of the approval message.
+type Field struct {
+	Name  string
+	Value string
+}
+
+// Content is the content of the interactive approval message.
+type Content struct {
+	Title  string
+	Link   string
+	Fields []Field
+	// Status is the status line of the review step.
+	Status string
+	// Pending shows the comment input and the approve and reject buttons if the review step is pending.
+	Pending bool
+	// ReviewName is the name of the review in projects/{project}/reviews/{review} format.
+	ReviewName string
+	StepIndex  int
+}
+
+// Interaction is the button click on the interactive approval message.
+type Interaction struct {
+	// Action is ActionApprove or ActionReject.
+	Action     string
+	ReviewName string
+	StepIndex  int
+	Comment    string
+	// UserID is the Slack user ID who clicks the button.
+	UserID      string
+	Channel     string
+	MessageTS   string
+	ResponseURL string
+}
+
+// actionValue is the value of the approve and reject buttons.
+type actionValue struct {
+	Review string `json:"review"`
+	Step   int    `json:"step"`
+}
+
+type text struct {
+	Type string `json:"type"`
+	Text string `json:"text"`
+}
+
+type element struct {
+	Type      string `json:"type"`
+	ActionID  string `json:"action_id,omitempty"`
+	Text      *text  `json:"text,omitempty"`
+	Value     string `json:"value,omitempty"`
+	Style     string `json:"style,omitempty"`
+	URL       string `json:"url,omitempty"`
+	Multiline bool   `json:"multiline,omitempty"`
+}
+
+type block struct {
+	Type     string    `json:"type"`
+	BlockID  string    `json:"block_id,omitempty"`
+	Text     *text     `json:"text,omitempty"`
+	Fields   []*text   `json:"fields,omitempty"`
+	Elements []element `json:"elements,omitempty"`
+	Label    *text     `json:"label,omitempty"`
+	Element  *element  `json:"element,omitempty"`
+	Optional bool      `json:"optional,omitempty"`
+}
+
+type message struct {
+	Channel string   `json:"channel"`
+	TS      string   `json:"ts,omitempty"`
+	Text    string   `json:"text"`
+	Blocks  []*block `json:"blocks"`
+}
+
+// response is the common part of the Slack Web API responses.
+type response struct {
+	OK    bool   `json:"ok"`
+	Error string `json:"error"`
+}
+
+type postMessageResponse struct {
+	response
+	Channel string `json:"channel"`
+	TS      string `json:"ts"`
+}
+
+type userInfoResponse struct {
+	response
+	User struct {
+		ID      string `json:"id"`
+		Profile struct {
+			Email string `json:"email"`
+		} `json:"profile"`
+	} `json:"user"`
+}
+
+type interactionPayload struct {
+	Type string `json:"type"`
+	User struct {
+		ID string `json:"id"`
+	} `json:"user"`
+	Container struct {
+		ChannelID string `json:"channel_id"`
+		MessageTS string `json:"message_ts"`
+	} `json:"container"`
+	ResponseURL string `json:"response_url"`
+	Actions     []struct {
+		ActionID string `json:"action_id"`
+		Value    string `json:"value"`
+	} `json:"actions"`
+	State struct {
+		Values map[string]map[string]struct {
+			Value string `json:"value"`
+		} `json:"values"`
+	} `json:"state"`
+}
+
+// AuthTest checks the bot token.
+func (p *Provider) AuthTest(ctx context.Context, token string) error {
+	resp := &response{}
+	return p.call(ctx, token, http.MethodPost, "auth.test", nil, resp)
+}
+
+// PostMessage posts the interactive approval message to the channel and returns the message timestamp.
+func (p *Provider) PostMessage(ctx context.Context, token string, channel string, content Content) (string, error) {
+	msg, err := formatMessage(content)
+	if err != nil {
+		return "", err
+	}
+	msg.Channel = channel
+	resp := &postMessageResponse{}
+	if err := p.call(ctx, token, http.MethodPost, "chat.postMessage", msg, resp); err != nil {
+		return "", err
+	}
+	return resp.TS, nil
+}
+
+// UpdateMessage updates the interactive approval message in place.
+func (p *Provider) UpdateMessage(ctx context.Context, token string, channel string, ts string, content Content) error {
+	msg, err := formatMessage(content)
+	if err != nil {
+		return err
+	}
+	msg.Channel = channel
+	msg.TS = ts
+	resp := &response{}
+	return p.call(ctx, token, http.MethodPost, "chat.update", msg, resp)
+}
+
+// GetUserEmail gets the email of the Slack user, which requires the users:read.email scope.
+func (p *Provider) GetUserEmail(ctx context.Context, token string, userID string) (string, error) {
+	resp := &userInfoResponse{}
+	if err := p.call(ctx, token, http.MethodGet, "users.info?user="+url.QueryEscape(userID), nil, resp); err != nil {
+		return "", err
+	}
+	if resp.User.Profile.Email == "" {
+		return "", errors.Errorf("the email of Slack user %s is not found", userID)
+	}
+	return resp.User.Profile.Email, nil
+}
+
+// Respond responds the interaction with an ephemeral message, which is only visible to the user.
+func (p *Provider) Respond(ctx context.Context, responseURL string, msg string) error {
+	body, err := json.Marshal(map[string]any{
+		"response_type":    "ephemeral",
+		"replace_original": false,
+		"text":             msg,
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal response")
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, responseURL, bytes.NewReader(body))
+	if err != nil {
+		return errors.Wrap(err, "failed to construct request")
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return errors.Wrap(err, "failed to respond the interaction")
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return errors.Errorf("failed to respond the interaction, status code: %d", resp.StatusCode)
+	}
+	return nil
+}
+
+func (p *Provider) call(ctx context.Context, token string, method string, api string, payload any, result any) error {
+	var body io.Reader
+	if payload != nil {
+		b, err := json.Marshal(payload)
+		if err != nil {
+			return errors.Wrapf(err, "failed to marshal %s request", api)
+		}
+		body = bytes.NewReader(b)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, fmt.Sprintf("%s/%s", p.APIPath, api), body)
+	if err != nil {
+		return errors.Wrapf(err, "failed to construct %s request", api)
+	}
+	req.Header.Set("Authorization", "Bearer "+token)
+	if payload != nil {
+		req.Header.Set("Content-Type", "application/json; charset=utf-8")
+	}
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return errors.Wrapf(err, "failed to call %s", api)
+	}
+	defer resp.Body.Close()
+	b, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return errors.Wrapf(err, "failed to read %s response", api)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return errors.Errorf("failed to call %s, status code: %d, body: %s", api, resp.StatusCode, b)
+	}
+	if err := json.Unmarshal(b, result); err != nil {
+		return errors.Wrapf(err, "failed to unmarshal %s response", api)
+	}
+	// Every response embeds the common part.
+	common := &response{}
+	if err := json.Unmarshal(b, common); err != nil {
+		return errors.Wrapf(err, "failed to unmarshal %s response", api)
+	}
+	if !common.OK {
+		return errors.Errorf("failed to call %s, error: %s", api, common.Error)
+	}
+	return nil
+}
+
+// VerifySignature verifies the signature of the request from Slack.
+// https://api.slack.com/authentication/verifying-requests-from-slack
+func VerifySignature(signingSecret string, timestamp string, signature string, body []byte, now time.Time) error {
+	if signingSecret == "" {
+		return errors.New("the signing secret is not configured")
+	}
+	ts, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil {
+		return errors.Errorf("invalid request timestamp %q", timestamp)
+	}
+	if age := now.Sub(time.Unix(ts, 0)); age > maxRequestAge || age < -maxRequestAge {
+		return errors.Errorf("the request timestamp %q is too old", timestamp)
+	}
+	mac := hmac.New(sha256.New, []byte(signingSecret))
+	fmt.Fprintf(mac, "v0:%s:", timestamp)
+	mac.Write(body)
+	want := "v0=" + hex.EncodeToString(mac.Sum(nil))
+	if !hmac.Equal([]byte(want), []byte(signature)) {
+		return errors.New("invalid request signature")
+	}
+	return nil
+}
+
+// ParseInteraction parses the form-encoded interaction request body.
+// It returns nil if the request is not a click on the approve or reject button.
+func ParseInteraction(body []byte) (*Interaction, error) {
+	form, err := url.ParseQuery(string(body))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse the interaction form")
+	}
+	payload := &interactionPayload{}
+	if err := json.Unmarshal([]byte(form.Get("payload")), payload); err != nil {
+		return nil, errors.Wrap(err, "failed to unmarshal the interaction payload")
+	}
+	if payload.Type != "block_actions" {
+		return nil, nil
+	}
+	for _, action := range payload.Actions {
+		if action.ActionID != ActionApprove && action.ActionID != ActionReject {
+			continue
+		}
+		value := &actionValue{}
+		if err := json.Unmarshal([]byte(action.Value), value); err != nil {
+			return nil, errors.Wrapf(err, "invalid action value %q", action.Value)
+		}
+		return &Interaction{
+			Action:      action.ActionID,
+			ReviewName:  value.Review,
+			StepIndex:   value.Step,
+			Comment:     payload.State.Values[commentBlockID][commentActionID].Value,
+			UserID:      payload.User.ID,
+			Channel:     payload.Container.ChannelID,
+			MessageTS:   payload.Container.MessageTS,
+			ResponseURL: payload.ResponseURL,
+		}, nil
+	}
+	return nil, nil
+}
+
+func formatMessage(content Content) (*message, error) {
+	blocks := []*block{
+		{
+			Type: "section",
+			Text: &text{Type: "mrkdwn", Text: fmt.Sprintf("*<%s|%s>*", content.Link, escape(content.Title))},
+		},
+	}
+	if len(content.Fields) > 0 {
+		fields := &block{Type: "section"}
+		for _, field := range content.Fields {
+			fields.Fields = append(fields.Fields, &text{Type: "mrkdwn", Text: fmt.Sprintf("*%s*\n%s", escape(field.Name), escape(field.Value))})
+		}
+		blocks = append(blocks, fields)
+	}
+	blocks = append(blocks, &block{
+		Type: "section",
+		Text: &text{Type: "mrkdwn", Text: escape(content.Status)},
+	})
+
+	if content.Pending {
+		value, err := json.Marshal(actionValue{Review: content.ReviewName, Step: content.StepIndex})
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to marshal action value")
+		}
+		blocks = append(blocks,
+			&block{
+				Type:     "input",
+				BlockID:  commentBlockID,
+				Optional: true,
+				Label:    &text{Type: "plain_text", Text: "Comment"},
+				Element:  &element{Type: "plain_text_input", ActionID: commentActionID, Multiline: true},
+			},
+			&block{
+				Type:    "actions",
+				BlockID: actionsBlockID,
+				Elements: []element{
+					{Type: "button", ActionID: ActionApprove, Text: &text{Type: "plain_text", Text: "Approve"}, Value: string(value), Style: "primary"},
+					{Type: "button", ActionID: ActionReject, Text: &text{Type: "plain_text", Text: "Reject"}, Value: string(value), Style: "danger"},
+				},
+			},
+		)
+	}
+	return &message{
+		// The text is the fallback of the notifications.
+		Text:   fmt.Sprintf("%s - %s", content.Title, content.Status),
+		Blocks: blocks,
+	}, nil
+}
+
+// escape escapes the control characters of the Slack mrkdwn text.
+// https://api.slack.com/reference/surfaces/formatting#escaping
+func escape(s string) string {
+	var buf bytes.Buffer
+	for _, r := range s {
+		switch r {
+		case '&':
+			buf.WriteString("&amp;")
+		case '<':
+			buf.WriteString("&lt;")
+		case '>':
+			buf.WriteString("&gt;")
+		default:
+			buf.WriteRune(r)
+		}
+	}
+	return buf.String()
+}
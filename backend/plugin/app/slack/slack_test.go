@@ -0,0 +1,137 @@
+package slack
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/bytebase/bytebase/backend/common"
+)
+
+func TestProvider_PostMessage(t *testing.T) {
+	a := require.New(t)
+	p := NewProvider(APIPath)
+	p.client = &http.Client{
+		Transport: &common.MockRoundTripper{
+			MockRoundTrip: func(r *http.Request) (*http.Response, error) {
+				return &http.Response{
+					StatusCode: http.StatusOK,
+					Body: io.NopCloser(strings.NewReader(`
+{
+    "ok": true,
+    "channel": "C123ABC456",
+    "ts": "1503435956.000247"
+}
+`)),
+				}, nil
+			},
+		},
+	}
+	ctx := context.Background()
+	ts, err := p.PostMessage(ctx, "", "C123ABC456", Content{Pending: true})
+	a.NoError(err)
+	a.Equal("1503435956.000247", ts)
+}
+
+func TestProvider_GetUserEmail(t *testing.T) {
+	a := require.New(t)
+	p := NewProvider(APIPath)
+	p.client = &http.Client{
+		Transport: &common.MockRoundTripper{
+			MockRoundTrip: func(r *http.Request) (*http.Response, error) {
+				return &http.Response{
+					StatusCode: http.StatusOK,
+					Body: io.NopCloser(strings.NewReader(`
+{
+    "ok": true,
+    "user": {
+        "id": "W012A3CDE",
+        "profile": {
+            "email": "spengler@ghostbusters.example.com"
+        }
+    }
+}
+`)),
+				}, nil
+			},
+		},
+	}
+	ctx := context.Background()
+	email, err := p.GetUserEmail(ctx, "", "W012A3CDE")
+	a.NoError(err)
+	a.Equal("spengler@ghostbusters.example.com", email)
+}
+
+func TestProvider_Error(t *testing.T) {
+	a := require.New(t)
+	p := NewProvider(APIPath)
+	p.client = &http.Client{
+		Transport: &common.MockRoundTripper{
+			MockRoundTrip: func(r *http.Request) (*http.Response, error) {
+				return &http.Response{
+					StatusCode: http.StatusOK,
+					Body:       io.NopCloser(strings.NewReader(`{"ok": false, "error": "channel_not_found"}`)),
+				}, nil
+			},
+		},
+	}
+	ctx := context.Background()
+	err := p.UpdateMessage(ctx, "", "C123ABC456", "1503435956.000247", Content{})
+	a.ErrorContains(err, "channel_not_found")
+}
+
+func TestVerifySignature(t *testing.T) {
+	a := require.New(t)
+	secret := "8f742231b10e8888abcd99yyyzzz85a5"
+	body := []byte("payload=%7B%7D")
+	now := time.Unix(1531420618, 0)
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte("v0:1531420618:"))
+	mac.Write(body)
+	signature := "v0=" + hex.EncodeToString(mac.Sum(nil))
+
+	a.NoError(VerifySignature(secret, "1531420618", signature, body, now))
+	a.Error(VerifySignature(secret, "1531420618", signature, []byte("payload=%7B%22a%22%7D"), now))
+	a.Error(VerifySignature("another", "1531420618", signature, body, now))
+	a.Error(VerifySignature(secret, "1531420618", signature, body, now.Add(10*time.Minute)))
+	a.Error(VerifySignature("", "1531420618", signature, body, now))
+}
+
+func TestParseInteraction(t *testing.T) {
+	a := require.New(t)
+	payload := `{
+    "type": "block_actions",
+    "user": {"id": "U123"},
+    "container": {"channel_id": "C123", "message_ts": "1503435956.000247"},
+    "response_url": "https://hooks.slack.com/actions/T123/456/abc",
+    "actions": [{"action_id": "reject", "value": "{\"review\":\"projects/p1/reviews/101\",\"step\":1}"}],
+    "state": {"values": {"comment": {"comment": {"value": "missing rollback"}}}}
+}`
+	body := []byte(url.Values{"payload": []string{payload}}.Encode())
+	interaction, err := ParseInteraction(body)
+	a.NoError(err)
+	a.Equal(&Interaction{
+		Action:      ActionReject,
+		ReviewName:  "projects/p1/reviews/101",
+		StepIndex:   1,
+		Comment:     "missing rollback",
+		UserID:      "U123",
+		Channel:     "C123",
+		MessageTS:   "1503435956.000247",
+		ResponseURL: "https://hooks.slack.com/actions/T123/456/abc",
+	}, interaction)
+
+	body = []byte(url.Values{"payload": []string{`{"type": "view_submission"}`}}.Encode())
+	interaction, err = ParseInteraction(body)
+	a.NoError(err)
+	a.Nil(interaction)
+}
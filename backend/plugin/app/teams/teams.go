@@ -0,0 +1,420 @@
+// Package teams implements the Microsoft Bot Framework callers and the interactive approval cards for Microsoft Teams.
+package teams
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/coreos/go-oidc"
+	"github.com/pkg/errors"
+)
+
+const (
+	timeout = 30 * time.Second
+	// LoginPath is the path of the Microsoft identity platform.
+	LoginPath = "https://login.microsoftonline.com"
+
+	// botFrameworkTenant is the tenant of the multi-tenant bots.
+	botFrameworkTenant = "botframework.com"
+	botFrameworkScope  = "https://api.botframework.com/.default"
+	// botFrameworkIssuer and botFrameworkKeysURL are used to verify the requests from the Bot Framework Service.
+	// https://learn.microsoft.com/en-us/azure/bot-service/rest-api/bot-framework-rest-connector-authentication
+	botFrameworkIssuer  = "https://api.botframework.com"
+	botFrameworkKeysURL = "https://login.botframework.com/v1/.well-known/keys"
+
+	// tokenExpiryMargin refreshes the access token a bit earlier than it expires.
+	tokenExpiryMargin = time.Minute
+)
+
+const (
+	// ActionApprove is the action of the approve button.
+	ActionApprove = "approve"
+	// ActionReject is the action of the reject button.
+	ActionReject = "reject"
+
+	commentInputID = "comment"
+)
+
+// Provider is the provider for IM Microsoft Teams.
+type Provider struct {
+	LoginPath string
+	client    *http.Client
+
+	mu     sync.Mutex
+	tokens map[string]*token
+
+	keySetOnce sync.Once
+	keySet     oidc.KeySet
+}
+
+type token struct {
+	value    string
+	expireAt time.Time
+}
+
+// NewProvider returns a Provider.
+func NewProvider(loginPath string) *Provider {
+	return &Provider{
+		LoginPath: loginPath,
+		client: &http.Client{
+			Timeout: timeout,
+		},
+		tokens: make(map[string]*token),
+	}
+}
+
+// TokenCtx is the token context to access the Bot Framework APIs.
+type TokenCtx struct {
+	AppID     string
+	AppSecret string
+	// TenantID is the tenant of the single-tenant bots, it's empty for the multi-tenant bots.
+	TenantID string
+}
+
+// Conversation is the Teams conversation to post the approval cards.
+type Conversation struct {
+	ServiceURL string
+	ID         string
+}
+
+// Fact is a fact of the approval card.
+type Fact struct {
+	Title string
+	Value string
+}
+
+// Content is the content of the interactive approval card.
+type Content struct {
+	Title string
+	Link  string
+	Facts []Fact
+	// Status is the status line of the review step.
+	Status string
+	// Pending shows the comment input and the approve and reject buttons if the review step is pending.
+	Pending bool
+	// ReviewName is the name of the review in projects/{project}/reviews/{review} format.
+	ReviewName string
+	StepIndex  int
+}
+
+// Activity is the button click on the interactive approval card.
+type Activity struct {
+	// Action is ActionApprove or ActionReject.
+	Action     string
+	ReviewName string
+	StepIndex  int
+	Comment    string
+	// UserID is the Teams user ID who clicks the button.
+	UserID         string
+	ServiceURL     string
+	ConversationID string
+	// ReplyToID is the ID of the approval card.
+	ReplyToID string
+}
+
+type activity struct {
+	Type       string `json:"type"`
+	ServiceURL string `json:"serviceUrl,omitempty"`
+	From       *struct {
+		ID string `json:"id"`
+	} `json:"from,omitempty"`
+	Conversation *struct {
+		ID string `json:"id"`
+	} `json:"conversation,omitempty"`
+	ReplyToID   string          `json:"replyToId,omitempty"`
+	Text        string          `json:"text,omitempty"`
+	Value       json.RawMessage `json:"value,omitempty"`
+	Attachments []*attachment   `json:"attachments,omitempty"`
+}
+
+type attachment struct {
+	ContentType string `json:"contentType"`
+	Content     any    `json:"content"`
+}
+
+// submitValue is the data of the approve and reject buttons, merged with the inputs by Teams.
+type submitValue struct {
+	Action  string `json:"action"`
+	Review  string `json:"review"`
+	Step    int    `json:"step"`
+	Comment string `json:"comment,omitempty"`
+}
+
+type tokenResponse struct {
+	AccessToken      string `json:"access_token"`
+	ExpiresIn        int    `json:"expires_in"`
+	Error            string `json:"error"`
+	ErrorDescription string `json:"error_description"`
+}
+
+type resourceResponse struct {
+	ID string `json:"id"`
+}
+
+type memberResponse struct {
+	ID                string `json:"id"`
+	Email             string `json:"email"`
+	UserPrincipalName string `json:"userPrincipalName"`
+}
+
+// GetToken gets the access token of the bot, the token is cached until it expires.
+func (p *Provider) GetToken(ctx context.Context, tokenCtx TokenCtx) (string, error) {
+	key := fmt.Sprintf("%s/%s/%s", tokenCtx.TenantID, tokenCtx.AppID, tokenCtx.AppSecret)
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if t, ok := p.tokens[key]; ok && time.Now().Before(t.expireAt) {
+		return t.value, nil
+	}
+
+	tenant := tokenCtx.TenantID
+	if tenant == "" {
+		tenant = botFrameworkTenant
+	}
+	form := url.Values{
+		"grant_type":    []string{"client_credentials"},
+		"client_id":     []string{tokenCtx.AppID},
+		"client_secret": []string{tokenCtx.AppSecret},
+		"scope":         []string{botFrameworkScope},
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, fmt.Sprintf("%s/%s/oauth2/v2.0/token", p.LoginPath, url.PathEscape(tenant)), strings.NewReader(form.Encode()))
+	if err != nil {
+		return "", errors.Wrap(err, "failed to construct token request")
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to get access token")
+	}
+	defer resp.Body.Close()
+	b, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to read token response")
+	}
+	response := &tokenResponse{}
+	if err := json.Unmarshal(b, response); err != nil {
+		return "", errors.Wrapf(err, "failed to unmarshal token response, status code: %d", resp.StatusCode)
+	}
+	if resp.StatusCode != http.StatusOK || response.AccessToken == "" {
+		return "", errors.Errorf("failed to get access token, status code: %d, error: %s, description: %s", resp.StatusCode, response.Error, response.ErrorDescription)
+	}
+	// Drop the stale tokens since the credentials may have been changed.
+	p.tokens = map[string]*token{
+		key: {
+			value:    response.AccessToken,
+			expireAt: time.Now().Add(time.Duration(response.ExpiresIn)*time.Second - tokenExpiryMargin),
+		},
+	}
+	return response.AccessToken, nil
+}
+
+// PostMessage posts the interactive approval card to the conversation and returns the activity ID.
+func (p *Provider) PostMessage(ctx context.Context, tokenCtx TokenCtx, conversation Conversation, content Content) (string, error) {
+	resp := &resourceResponse{}
+	if err := p.call(ctx, tokenCtx, http.MethodPost, activitiesURL(conversation, ""), formatCard(content), resp); err != nil {
+		return "", err
+	}
+	return resp.ID, nil
+}
+
+// UpdateMessage updates the interactive approval card in place.
+func (p *Provider) UpdateMessage(ctx context.Context, tokenCtx TokenCtx, conversation Conversation, activityID string, content Content) error {
+	return p.call(ctx, tokenCtx, http.MethodPut, activitiesURL(conversation, activityID), formatCard(content), &resourceResponse{})
+}
+
+// Reply replies the activity with a text message.
+func (p *Provider) Reply(ctx context.Context, tokenCtx TokenCtx, conversation Conversation, activityID string, text string) error {
+	msg := &activity{
+		Type:      "message",
+		ReplyToID: activityID,
+		Text:      text,
+	}
+	return p.call(ctx, tokenCtx, http.MethodPost, activitiesURL(conversation, activityID), msg, &resourceResponse{})
+}
+
+// GetMemberEmail gets the email of the conversation member.
+func (p *Provider) GetMemberEmail(ctx context.Context, tokenCtx TokenCtx, conversation Conversation, userID string) (string, error) {
+	resp := &memberResponse{}
+	u := fmt.Sprintf("%s/v3/conversations/%s/members/%s", strings.TrimSuffix(conversation.ServiceURL, "/"), url.PathEscape(conversation.ID), url.PathEscape(userID))
+	if err := p.call(ctx, tokenCtx, http.MethodGet, u, nil, resp); err != nil {
+		return "", err
+	}
+	if resp.Email != "" {
+		return resp.Email, nil
+	}
+	if resp.UserPrincipalName != "" {
+		return resp.UserPrincipalName, nil
+	}
+	return "", errors.Errorf("the email of Teams user %s is not found", userID)
+}
+
+func (p *Provider) call(ctx context.Context, tokenCtx TokenCtx, method string, u string, payload any, result any) error {
+	t, err := p.GetToken(ctx, tokenCtx)
+	if err != nil {
+		return err
+	}
+	var body io.Reader
+	if payload != nil {
+		b, err := json.Marshal(payload)
+		if err != nil {
+			return errors.Wrap(err, "failed to marshal request")
+		}
+		body = bytes.NewReader(b)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, u, body)
+	if err != nil {
+		return errors.Wrapf(err, "failed to construct request %s %s", method, u)
+	}
+	req.Header.Set("Authorization", "Bearer "+t)
+	if payload != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return errors.Wrapf(err, "failed to request %s %s", method, u)
+	}
+	defer resp.Body.Close()
+	b, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return errors.Wrapf(err, "failed to read response of %s %s", method, u)
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return errors.Errorf("failed to request %s %s, status code: %d, body: %s", method, u, resp.StatusCode, b)
+	}
+	if len(b) == 0 {
+		return nil
+	}
+	if err := json.Unmarshal(b, result); err != nil {
+		return errors.Wrapf(err, "failed to unmarshal response of %s %s", method, u)
+	}
+	return nil
+}
+
+// VerifyRequest verifies the bearer token of the request from the Bot Framework Service,
+// and the service URL of the activity must match the one in the token.
+func (p *Provider) VerifyRequest(ctx context.Context, appID string, authorization string, serviceURL string) error {
+	if appID == "" {
+		return errors.New("the app ID is not configured")
+	}
+	rawToken, ok := strings.CutPrefix(authorization, "Bearer ")
+	if !ok || rawToken == "" {
+		return errors.New("missing bearer token")
+	}
+	p.keySetOnce.Do(func() {
+		// The key set is cached and refreshed in the background, so it must not be bound to the request context.
+		p.keySet = oidc.NewRemoteKeySet(context.Background(), botFrameworkKeysURL)
+	})
+	verifier := oidc.NewVerifier(botFrameworkIssuer, p.keySet, &oidc.Config{ClientID: appID})
+	idToken, err := verifier.Verify(ctx, rawToken)
+	if err != nil {
+		return errors.Wrap(err, "invalid bearer token")
+	}
+	var claims struct {
+		ServiceURL string `json:"serviceurl"`
+	}
+	if err := idToken.Claims(&claims); err != nil {
+		return errors.Wrap(err, "failed to parse token claims")
+	}
+	if claims.ServiceURL != serviceURL {
+		return errors.Errorf("the service URL %q does not match the token", serviceURL)
+	}
+	return nil
+}
+
+// ParseActivity parses the activity request body, and returns the service URL of the activity.
+// The returned activity is nil if the request is not a click on the approve or reject button.
+func ParseActivity(body []byte) (*Activity, string, error) {
+	a := &activity{}
+	if err := json.Unmarshal(body, a); err != nil {
+		return nil, "", errors.Wrap(err, "failed to unmarshal activity")
+	}
+	if a.Type != "message" || len(a.Value) == 0 || a.From == nil || a.Conversation == nil {
+		return nil, a.ServiceURL, nil
+	}
+	value := &submitValue{}
+	if err := json.Unmarshal(a.Value, value); err != nil {
+		return nil, a.ServiceURL, errors.Wrap(err, "failed to unmarshal activity value")
+	}
+	if value.Action != ActionApprove && value.Action != ActionReject {
+		return nil, a.ServiceURL, nil
+	}
+	return &Activity{
+		Action:         value.Action,
+		ReviewName:     value.Review,
+		StepIndex:      value.Step,
+		Comment:        value.Comment,
+		UserID:         a.From.ID,
+		ServiceURL:     a.ServiceURL,
+		ConversationID: a.Conversation.ID,
+		ReplyToID:      a.ReplyToID,
+	}, a.ServiceURL, nil
+}
+
+func activitiesURL(conversation Conversation, activityID string) string {
+	u := fmt.Sprintf("%s/v3/conversations/%s/activities", strings.TrimSuffix(conversation.ServiceURL, "/"), url.PathEscape(conversation.ID))
+	if activityID != "" {
+		u += "/" + url.PathEscape(activityID)
+	}
+	return u
+}
+
+// formatCard formats the content to an Adaptive Card.
+// https://adaptivecards.io/explorer/
+func formatCard(content Content) *activity {
+	var facts []map[string]string
+	for _, fact := range content.Facts {
+		facts = append(facts, map[string]string{"title": fact.Title, "value": fact.Value})
+	}
+	body := []map[string]any{
+		{"type": "TextBlock", "text": content.Title, "weight": "Bolder", "size": "Medium", "wrap": true},
+	}
+	if len(facts) > 0 {
+		body = append(body, map[string]any{"type": "FactSet", "facts": facts})
+	}
+	body = append(body, map[string]any{"type": "TextBlock", "text": content.Status, "wrap": true})
+
+	var actions []map[string]any
+	if content.Pending {
+		body = append(body, map[string]any{"type": "Input.Text", "id": commentInputID, "placeholder": "Comment", "isMultiline": true})
+		for _, action := range []struct {
+			action string
+			title  string
+			style  string
+		}{
+			{action: ActionApprove, title: "Approve", style: "positive"},
+			{action: ActionReject, title: "Reject", style: "destructive"},
+		} {
+			actions = append(actions, map[string]any{
+				"type":  "Action.Submit",
+				"title": action.title,
+				"style": action.style,
+				"data":  submitValue{Action: action.action, Review: content.ReviewName, Step: content.StepIndex},
+			})
+		}
+	}
+	if content.Link != "" {
+		actions = append(actions, map[string]any{"type": "Action.OpenUrl", "title": "View in Bytebase", "url": content.Link})
+	}
+	return &activity{
+		Type: "message",
+		Attachments: []*attachment{
+			{
+				ContentType: "application/vnd.microsoft.card.adaptive",
+				Content: map[string]any{
+					"$schema": "http://adaptivecards.io/schemas/adaptive-card.json",
+					"type":    "AdaptiveCard",
+					"version": "1.4",
+					"body":    body,
+					"actions": actions,
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,101 @@
+package teams
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/bytebase/bytebase/backend/common"
+)
+
+func TestProvider_PostMessage(t *testing.T) {
+	a := require.New(t)
+	p := NewProvider(LoginPath)
+	var requests []string
+	p.client = &http.Client{
+		Transport: &common.MockRoundTripper{
+			MockRoundTrip: func(r *http.Request) (*http.Response, error) {
+				requests = append(requests, r.Method+" "+r.URL.String())
+				body := `{"id": "1:1pCYx1GxqfzRBhoeG2vzMtqqcy6pcvVPJF1iOblT1rTOs"}`
+				if strings.HasPrefix(r.URL.String(), LoginPath) {
+					body = `{"token_type": "Bearer", "expires_in": 3599, "access_token": "eyJ0eXAi"}`
+				}
+				return &http.Response{
+					StatusCode: http.StatusOK,
+					Body:       io.NopCloser(strings.NewReader(body)),
+				}, nil
+			},
+		},
+	}
+	ctx := context.Background()
+	conversation := Conversation{ServiceURL: "https://smba.trafficmanager.net/amer/", ID: "19:abc@thread.tacv2"}
+	id, err := p.PostMessage(ctx, TokenCtx{AppID: "app"}, conversation, Content{Pending: true})
+	a.NoError(err)
+	a.Equal("1:1pCYx1GxqfzRBhoeG2vzMtqqcy6pcvVPJF1iOblT1rTOs", id)
+
+	// The access token is cached.
+	err = p.UpdateMessage(ctx, TokenCtx{AppID: "app"}, conversation, id, Content{})
+	a.NoError(err)
+	a.Equal([]string{
+		"POST https://login.microsoftonline.com/botframework.com/oauth2/v2.0/token",
+		"POST https://smba.trafficmanager.net/amer/v3/conversations/19:abc@thread.tacv2/activities",
+		"PUT https://smba.trafficmanager.net/amer/v3/conversations/19:abc@thread.tacv2/activities/1:1pCYx1GxqfzRBhoeG2vzMtqqcy6pcvVPJF1iOblT1rTOs",
+	}, requests)
+}
+
+func TestProvider_GetMemberEmail(t *testing.T) {
+	a := require.New(t)
+	p := NewProvider(LoginPath)
+	p.client = &http.Client{
+		Transport: &common.MockRoundTripper{
+			MockRoundTrip: func(r *http.Request) (*http.Response, error) {
+				body := `{"id": "29:1GcS4EyB", "name": "Larry Brown", "userPrincipalName": "larryb@contoso.com"}`
+				if strings.HasPrefix(r.URL.String(), LoginPath) {
+					body = `{"token_type": "Bearer", "expires_in": 3599, "access_token": "eyJ0eXAi"}`
+				}
+				return &http.Response{
+					StatusCode: http.StatusOK,
+					Body:       io.NopCloser(strings.NewReader(body)),
+				}, nil
+			},
+		},
+	}
+	ctx := context.Background()
+	email, err := p.GetMemberEmail(ctx, TokenCtx{}, Conversation{ServiceURL: "https://smba.trafficmanager.net/amer/", ID: "19:abc"}, "29:1GcS4EyB")
+	a.NoError(err)
+	a.Equal("larryb@contoso.com", email)
+}
+
+func TestParseActivity(t *testing.T) {
+	a := require.New(t)
+	body := []byte(`{
+    "type": "message",
+    "id": "f:123",
+    "serviceUrl": "https://smba.trafficmanager.net/amer/",
+    "from": {"id": "29:1GcS4EyB", "aadObjectId": "6a7b"},
+    "conversation": {"id": "19:abc@thread.tacv2"},
+    "replyToId": "1:1pCYx1Gxqf",
+    "value": {"action": "approve", "review": "projects/p1/reviews/101", "step": 0, "comment": "LGTM"}
+}`)
+	activity, serviceURL, err := ParseActivity(body)
+	a.NoError(err)
+	a.Equal("https://smba.trafficmanager.net/amer/", serviceURL)
+	a.Equal(&Activity{
+		Action:         ActionApprove,
+		ReviewName:     "projects/p1/reviews/101",
+		StepIndex:      0,
+		Comment:        "LGTM",
+		UserID:         "29:1GcS4EyB",
+		ServiceURL:     "https://smba.trafficmanager.net/amer/",
+		ConversationID: "19:abc@thread.tacv2",
+		ReplyToID:      "1:1pCYx1Gxqf",
+	}, activity)
+
+	activity, _, err = ParseActivity([]byte(`{"type": "conversationUpdate", "serviceUrl": "https://smba.trafficmanager.net/amer/"}`))
+	a.NoError(err)
+	a.Nil(activity)
+}
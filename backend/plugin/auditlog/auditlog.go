@@ -0,0 +1,169 @@
+// Package auditlog provides the hash chained audit log format, the verifier and the exporters to the SIEM systems.
+package auditlog
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/pkg/errors"
+)
+
+// Action is the action on the activity recorded by the audit log.
+type Action string
+
+const (
+	// ActionCreate is the action creating the activity.
+	ActionCreate Action = "CREATE"
+	// ActionUpdate is the action updating the activity, e.g. editing the issue comment.
+	ActionUpdate Action = "UPDATE"
+)
+
+// Entry is the snapshot of the activity when it's created or updated.
+// The hash of the audit log is computed from the JSON encoding of the entry, so the field order must not be changed.
+type Entry struct {
+	Action      Action          `json:"action"`
+	ActivityID  int             `json:"activityId"`
+	CreatorID   int             `json:"creatorId"`
+	CreatedTs   int64           `json:"createdTs"`
+	UpdaterID   int             `json:"updaterId"`
+	UpdatedTs   int64           `json:"updatedTs"`
+	ContainerID int             `json:"containerId"`
+	Type        string          `json:"type"`
+	Level       string          `json:"level"`
+	Comment     string          `json:"comment"`
+	Payload     json.RawMessage `json:"payload"`
+}
+
+// Record is the hash chained audit log.
+// It's the unit exported to the destinations, and the unit checked by the verifier.
+type Record struct {
+	ID int64 `json:"id"`
+	// PrevHash is the hash of the previous record, it's empty for the first record.
+	PrevHash string `json:"prevHash"`
+	Hash     string `json:"hash"`
+	// Entry is the JSON encoding of the Entry.
+	Entry json.RawMessage `json:"entry"`
+}
+
+// Exporter exports the audit log records to the destination.
+// Export returns after the records are delivered, so that the caller could advance the export cursor.
+type Exporter interface {
+	Export(ctx context.Context, records []*Record) error
+	Close() error
+}
+
+// MarshalEntry returns the JSON encoding of the entry.
+func MarshalEntry(entry *Entry) (string, error) {
+	if len(entry.Payload) == 0 {
+		entry.Payload = json.RawMessage("{}")
+	}
+	b, err := json.Marshal(entry)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to marshal audit log entry")
+	}
+	return string(b), nil
+}
+
+// ReadKeyFile reads the key of the hash chain from the file. The surrounding whitespaces are trimmed.
+// The key is held by the server outside the database, so that the chain can't be rewritten with the database access alone.
+func ReadKeyFile(path string) ([]byte, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to read audit log key file %s", path)
+	}
+	key := bytes.TrimSpace(b)
+	if len(key) == 0 {
+		return nil, errors.Errorf("audit log key file %s is empty", path)
+	}
+	return key, nil
+}
+
+// ComputeHash computes the hash of the record, which covers the record ID, the hash of the previous record and the entry.
+// It's the HMAC-SHA256 with the key, or the plain SHA-256 if the key is empty.
+func ComputeHash(key []byte, id int64, prevHash string, entry string) string {
+	message := []byte(fmt.Sprintf("%d\n%s\n%s", id, prevHash, entry))
+	if len(key) == 0 {
+		hash := sha256.Sum256(message)
+		return hex.EncodeToString(hash[:])
+	}
+	mac := hmac.New(sha256.New, key)
+	_, _ = mac.Write(message)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// Verifier checks the integrity of the hash chain of the records.
+// The records must be added in the order of the IDs.
+// The records redelivered by the at-least-once delivery are accepted if they are identical to the verified ones.
+type Verifier struct {
+	key    []byte
+	hashes map[int64]string
+	// Count is the number of the verified records, excluding the duplicates.
+	Count int
+	// FirstID is the ID of the first verified record.
+	FirstID int64
+	// LastID is the ID of the last verified record.
+	LastID int64
+	// LastHash is the hash of the last verified record.
+	LastHash string
+}
+
+// NewVerifier creates a new verifier with the key of the hash chain.
+// Without the key, the verifier only checks the chain of the server running without the key,
+// which doesn't detect the records rewritten by someone with the database access.
+func NewVerifier(key []byte) *Verifier {
+	return &Verifier{key: key, hashes: map[int64]string{}}
+}
+
+// Add verifies the record and appends it to the verified chain.
+func (v *Verifier) Add(record *Record) error {
+	if hash := ComputeHash(v.key, record.ID, record.PrevHash, string(record.Entry)); !hmac.Equal([]byte(hash), []byte(record.Hash)) {
+		return errors.Errorf("record %d is modified or hashed with a different key, the hash is %s but computed %s", record.ID, record.Hash, hash)
+	}
+	if hash, ok := v.hashes[record.ID]; ok {
+		if hash != record.Hash {
+			return errors.Errorf("record %d is duplicated with different hashes %s and %s", record.ID, hash, record.Hash)
+		}
+		return nil
+	}
+	if v.Count == 0 {
+		// The previous records might be rotated out, so the chain starts from the first record we see.
+		// But the first record of the whole chain must not link to any record.
+		if record.ID == 1 && record.PrevHash != "" {
+			return errors.Errorf("record 1 links to the previous hash %s", record.PrevHash)
+		}
+		v.FirstID = record.ID
+	} else {
+		if record.ID < v.LastID {
+			return errors.Errorf("record %d is out of order after record %d", record.ID, v.LastID)
+		}
+		// The IDs might have gaps if the transactions are rolled back, so the chain is linked by the hashes.
+		if record.PrevHash != v.LastHash {
+			return errors.Errorf("record %d links to the previous hash %s, but the hash of record %d is %s, the records in between are removed or modified", record.ID, record.PrevHash, v.LastID, v.LastHash)
+		}
+	}
+	v.hashes[record.ID] = record.Hash
+	v.Count++
+	v.LastID = record.ID
+	v.LastHash = record.Hash
+	return nil
+}
+
+// ParseRecord parses the record from the exported line.
+// The line is either the JSON encoding of the record, or the syslog message whose MSG is the JSON encoding of the record.
+func ParseRecord(line []byte) (*Record, error) {
+	i := bytes.IndexByte(line, '{')
+	if i < 0 {
+		return nil, errors.Errorf("no record is found")
+	}
+	var record Record
+	if err := json.Unmarshal(line[i:], &record); err != nil {
+		return nil, errors.Wrap(err, "failed to unmarshal record")
+	}
+	return &record, nil
+}
@@ -0,0 +1,193 @@
+package auditlog
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+const defaultFileMaxSize = 100 * 1024 * 1024
+
+// FileExporter exports the records as the JSON lines.
+// The file is rotated to {path}.1 once it exceeds the max size, and the older ones are shifted to {path}.2, {path}.3 and so on.
+// The file must be in the allowed directory, so that the users managing the setting can't write arbitrary files with the server's identity.
+type FileExporter struct {
+	path       string
+	dir        string
+	maxSize    int64
+	maxBackups int
+
+	file *os.File
+	size int64
+}
+
+// NewFileExporter creates a new file exporter writing the file in the allowed directory dir.
+// The file destinations are disabled if dir is empty.
+func NewFileExporter(path, dir string, maxSize int64, maxBackups int) (*FileExporter, error) {
+	if path == "" {
+		return nil, errors.Errorf("file path is required")
+	}
+	if err := checkFilePath(path, dir); err != nil {
+		return nil, err
+	}
+	if maxSize <= 0 {
+		maxSize = defaultFileMaxSize
+	}
+	if maxBackups < 0 {
+		return nil, errors.Errorf("invalid max backups %d", maxBackups)
+	}
+	return &FileExporter{
+		path:       path,
+		dir:        dir,
+		maxSize:    maxSize,
+		maxBackups: maxBackups,
+	}, nil
+}
+
+// Export appends the records to the file, and syncs the file to the disk.
+func (e *FileExporter) Export(_ context.Context, records []*Record) error {
+	if e.file == nil {
+		if err := e.open(); err != nil {
+			return err
+		}
+	}
+	for _, record := range records {
+		line, err := json.Marshal(record)
+		if err != nil {
+			return errors.Wrapf(err, "failed to marshal record %d", record.ID)
+		}
+		line = append(line, '\n')
+		if e.size > 0 && e.size+int64(len(line)) > e.maxSize {
+			if err := e.rotate(); err != nil {
+				return err
+			}
+		}
+		n, err := e.file.Write(line)
+		e.size += int64(n)
+		if err != nil {
+			return errors.Wrapf(err, "failed to write record %d to %s", record.ID, e.path)
+		}
+	}
+	if err := e.file.Sync(); err != nil {
+		return errors.Wrapf(err, "failed to sync %s", e.path)
+	}
+	return nil
+}
+
+// Close closes the file.
+func (e *FileExporter) Close() error {
+	if e.file == nil {
+		return nil
+	}
+	err := e.file.Close()
+	e.file = nil
+	return err
+}
+
+func (e *FileExporter) open() error {
+	if err := os.MkdirAll(filepath.Dir(e.path), 0755); err != nil {
+		return errors.Wrapf(err, "failed to create the directory of %s", e.path)
+	}
+	// The directories or the file may be replaced by the symbolic links after the exporter is created.
+	if err := checkFilePath(e.path, e.dir); err != nil {
+		return err
+	}
+	file, err := os.OpenFile(e.path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0600)
+	if err != nil {
+		return errors.Wrapf(err, "failed to open %s", e.path)
+	}
+	info, err := file.Stat()
+	if err != nil {
+		file.Close()
+		return errors.Wrapf(err, "failed to stat %s", e.path)
+	}
+	e.file = file
+	e.size = info.Size()
+	return nil
+}
+
+func (e *FileExporter) rotate() error {
+	if err := e.file.Sync(); err != nil {
+		return errors.Wrapf(err, "failed to sync %s", e.path)
+	}
+	if err := e.Close(); err != nil {
+		return errors.Wrapf(err, "failed to close %s", e.path)
+	}
+	n := e.maxBackups
+	if n == 0 {
+		// Keep all the rotated files.
+		for n = 1; ; n++ {
+			if _, err := os.Stat(backupPath(e.path, n)); os.IsNotExist(err) {
+				break
+			}
+		}
+	} else if err := os.Remove(backupPath(e.path, n)); err != nil && !os.IsNotExist(err) {
+		return errors.Wrapf(err, "failed to remove %s", backupPath(e.path, n))
+	}
+	for i := n - 1; i >= 1; i-- {
+		if err := os.Rename(backupPath(e.path, i), backupPath(e.path, i+1)); err != nil && !os.IsNotExist(err) {
+			return errors.Wrapf(err, "failed to rotate %s", backupPath(e.path, i))
+		}
+	}
+	if err := os.Rename(e.path, backupPath(e.path, 1)); err != nil {
+		return errors.Wrapf(err, "failed to rotate %s", e.path)
+	}
+	return e.open()
+}
+
+// checkFilePath returns an error if the path is not a clean absolute path in the directory after following the symbolic links,
+// or the file is a symbolic link.
+func checkFilePath(path, dir string) error {
+	if dir == "" {
+		return errors.Errorf("file destinations are disabled, set --audit-log-file-dir to allow them")
+	}
+	if !filepath.IsAbs(path) || filepath.Clean(path) != path {
+		return errors.Errorf("file path %q must be a clean absolute path", path)
+	}
+	if !isPathInDir(path, dir) {
+		return errors.Errorf("file %q is not in the allowed directory %q", path, dir)
+	}
+	if info, err := os.Lstat(path); err == nil && info.Mode()&os.ModeSymlink != 0 {
+		return errors.Errorf("file %q cannot be a symbolic link", path)
+	}
+	// Resolve the symbolic links of the existing parent directories.
+	parent := filepath.Dir(path)
+	for {
+		if _, err := os.Lstat(parent); err == nil {
+			break
+		}
+		if filepath.Dir(parent) == parent {
+			break
+		}
+		parent = filepath.Dir(parent)
+	}
+	resolvedParent, err := filepath.EvalSymlinks(parent)
+	if err != nil {
+		return errors.Wrapf(err, "failed to resolve %s", parent)
+	}
+	resolvedDir, err := filepath.EvalSymlinks(dir)
+	if err != nil {
+		return errors.Wrapf(err, "failed to resolve the allowed directory %s", dir)
+	}
+	if resolvedParent != resolvedDir && !isPathInDir(resolvedParent, resolvedDir) {
+		return errors.Errorf("file %q is not in the allowed directory %q", path, dir)
+	}
+	return nil
+}
+
+func isPathInDir(path, dir string) bool {
+	rel, err := filepath.Rel(dir, path)
+	if err != nil {
+		return false
+	}
+	return rel != "." && rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator))
+}
+
+func backupPath(path string, n int) string {
+	return fmt.Sprintf("%s.%d", path, n)
+}
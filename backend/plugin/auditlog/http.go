@@ -0,0 +1,124 @@
+package auditlog
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"syscall"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const httpTimeout = 30 * time.Second
+
+// HTTPExporter exports the records by the HTTP POST requests, each request body is the JSON array of the records.
+type HTTPExporter struct {
+	url     string
+	headers map[string]string
+	client  *http.Client
+}
+
+// NewHTTPExporter creates a new HTTP exporter.
+// The URL must be an http or https URL. The requests to the loopback, link-local, multicast and unspecified addresses
+// are rejected when dialing, so that the users managing the setting can't reach the services on the Bytebase host or
+// the cloud metadata service. The private network addresses are allowed since the SIEM systems are usually there.
+// The redirects are not followed, and the requests don't go through the proxy so that every dialed address is checked.
+func NewHTTPExporter(rawURL string, headers map[string]string) (*HTTPExporter, error) {
+	if rawURL == "" {
+		return nil, errors.Errorf("HTTP URL is required")
+	}
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid HTTP URL %q", rawURL)
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return nil, errors.Errorf("HTTP URL %q must start with http:// or https://", rawURL)
+	}
+	if u.Hostname() == "" {
+		return nil, errors.Errorf("HTTP URL %q must have the host", rawURL)
+	}
+	if u.User != nil {
+		return nil, errors.Errorf("HTTP URL %q cannot have the credentials, set them in the headers instead", rawURL)
+	}
+	if ip := net.ParseIP(u.Hostname()); ip != nil {
+		if err := checkHTTPExportIP(ip); err != nil {
+			return nil, err
+		}
+	}
+
+	dialer := &net.Dialer{
+		Timeout: httpTimeout,
+		Control: func(_, address string, _ syscall.RawConn) error {
+			host, _, err := net.SplitHostPort(address)
+			if err != nil {
+				return err
+			}
+			ip := net.ParseIP(host)
+			if ip == nil {
+				return errors.Errorf("invalid address %q", address)
+			}
+			return checkHTTPExportIP(ip)
+		},
+	}
+	return &HTTPExporter{
+		url:     rawURL,
+		headers: headers,
+		client: &http.Client{
+			Timeout: httpTimeout,
+			Transport: &http.Transport{
+				DialContext:         dialer.DialContext,
+				TLSHandshakeTimeout: httpTimeout,
+				MaxIdleConns:        1,
+				IdleConnTimeout:     time.Minute,
+			},
+			CheckRedirect: func(*http.Request, []*http.Request) error {
+				return http.ErrUseLastResponse
+			},
+		},
+	}, nil
+}
+
+// checkHTTPExportIP returns an error if the IP is not allowed to be the destination of the HTTP exporter.
+func checkHTTPExportIP(ip net.IP) error {
+	if ip.IsLoopback() || ip.IsLinkLocalUnicast() || ip.IsLinkLocalMulticast() || ip.IsInterfaceLocalMulticast() || ip.IsMulticast() || ip.IsUnspecified() {
+		return errors.Errorf("HTTP destination address %s is not allowed", ip)
+	}
+	return nil
+}
+
+// Export posts the records, the records are delivered if the response status is 2xx.
+func (e *HTTPExporter) Export(ctx context.Context, records []*Record) error {
+	body, err := json.Marshal(records)
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal records")
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, e.url, bytes.NewReader(body))
+	if err != nil {
+		return errors.Wrap(err, "failed to construct the request")
+	}
+	req.Header.Set("Content-Type", "application/json")
+	for key, value := range e.headers {
+		req.Header.Set(key, value)
+	}
+	resp, err := e.client.Do(req)
+	if err != nil {
+		return errors.Wrapf(err, "failed to post records to %s", e.url)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		b, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return errors.Errorf("failed to post records to %s, status %d, body %s", e.url, resp.StatusCode, string(b))
+	}
+	return nil
+}
+
+// Close closes the exporter.
+func (e *HTTPExporter) Close() error {
+	e.client.CloseIdleConnections()
+	return nil
+}
@@ -0,0 +1,158 @@
+package auditlog
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"net"
+	"os"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	// defaultSyslogFacility is the log audit facility.
+	defaultSyslogFacility = 13
+	defaultSyslogAppName  = "bytebase"
+	syslogMsgID           = "audit"
+	syslogDialTimeout     = 10 * time.Second
+	syslogWriteTimeout    = 30 * time.Second
+)
+
+// SyslogExporter exports the records as the RFC 5424 syslog messages.
+// The TCP and TLS transports use the octet counting framing of RFC 6587.
+type SyslogExporter struct {
+	network  string
+	address  string
+	facility int
+	appName  string
+	hostname string
+
+	conn net.Conn
+}
+
+// NewSyslogExporter creates a new syslog exporter.
+func NewSyslogExporter(network, address string, facility int, appName string) (*SyslogExporter, error) {
+	switch network {
+	case "udp", "tcp", "tls":
+	default:
+		return nil, errors.Errorf("unsupported syslog network %q", network)
+	}
+	if address == "" {
+		return nil, errors.Errorf("syslog address is required")
+	}
+	if facility == 0 {
+		facility = defaultSyslogFacility
+	}
+	if facility < 0 || facility > 23 {
+		return nil, errors.Errorf("invalid syslog facility %d", facility)
+	}
+	if appName == "" {
+		appName = defaultSyslogAppName
+	}
+	hostname, err := os.Hostname()
+	if err != nil || hostname == "" {
+		hostname = "-"
+	}
+	return &SyslogExporter{
+		network:  network,
+		address:  address,
+		facility: facility,
+		appName:  appName,
+		hostname: hostname,
+	}, nil
+}
+
+// Export sends a syslog message for each record.
+func (e *SyslogExporter) Export(ctx context.Context, records []*Record) error {
+	if e.conn == nil {
+		conn, err := e.dial(ctx)
+		if err != nil {
+			return err
+		}
+		e.conn = conn
+	}
+	if err := e.conn.SetWriteDeadline(time.Now().Add(syslogWriteTimeout)); err != nil {
+		return e.reset(errors.Wrap(err, "failed to set syslog write deadline"))
+	}
+	for _, record := range records {
+		message, err := e.format(record)
+		if err != nil {
+			return err
+		}
+		if e.network != "udp" {
+			message = fmt.Sprintf("%d %s", len(message), message)
+		}
+		if _, err := e.conn.Write([]byte(message)); err != nil {
+			return e.reset(errors.Wrapf(err, "failed to send record %d to syslog server %s", record.ID, e.address))
+		}
+	}
+	return nil
+}
+
+// Close closes the connection to the syslog server.
+func (e *SyslogExporter) Close() error {
+	if e.conn == nil {
+		return nil
+	}
+	return e.reset(nil)
+}
+
+func (e *SyslogExporter) dial(ctx context.Context) (net.Conn, error) {
+	dialer := &net.Dialer{Timeout: syslogDialTimeout}
+	if e.network == "tls" {
+		tlsDialer := &tls.Dialer{NetDialer: dialer}
+		conn, err := tlsDialer.DialContext(ctx, "tcp", e.address)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to dial syslog server %s", e.address)
+		}
+		return conn, nil
+	}
+	conn, err := dialer.DialContext(ctx, e.network, e.address)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to dial syslog server %s", e.address)
+	}
+	return conn, nil
+}
+
+// reset closes the connection, so that the next export reconnects to the server.
+func (e *SyslogExporter) reset(err error) error {
+	if closeErr := e.conn.Close(); closeErr != nil && err == nil {
+		err = errors.Wrap(closeErr, "failed to close syslog connection")
+	}
+	e.conn = nil
+	return err
+}
+
+// format formats the record as the RFC 5424 message, and the MSG is the JSON encoding of the record.
+func (e *SyslogExporter) format(record *Record) (string, error) {
+	var entry Entry
+	if err := json.Unmarshal(record.Entry, &entry); err != nil {
+		return "", errors.Wrapf(err, "failed to unmarshal the entry of record %d", record.ID)
+	}
+	msg, err := json.Marshal(record)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to marshal record %d", record.ID)
+	}
+	ts := entry.CreatedTs
+	if entry.Action == ActionUpdate {
+		ts = entry.UpdatedTs
+	}
+	priority := e.facility*8 + getSyslogSeverity(entry.Level)
+	timestamp := time.Unix(ts, 0).UTC().Format(time.RFC3339)
+	return fmt.Sprintf("<%d>1 %s %s %s %d %s - %s", priority, timestamp, e.hostname, e.appName, os.Getpid(), syslogMsgID, msg), nil
+}
+
+func getSyslogSeverity(level string) int {
+	switch level {
+	case "ERROR":
+		return 3
+	case "WARN":
+		return 4
+	default:
+		// Informational.
+		return 6
+	}
+}
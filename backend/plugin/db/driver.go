@@ -428,6 +428,20 @@ type ConnectionConfig struct {
 	SID         string
 	ServiceName string
 	SSHConfig   SSHConfig
+	// IAMAuthentication is true if the password is a short-lived cloud IAM authentication token,
+	// which MySQL requires to be sent in cleartext over TLS.
+	IAMAuthentication bool
+	// RefreshPassword returns a fresh password if the password expires, e.g. the cloud IAM authentication token.
+	// The drivers use it for every new connection instead of the Password. It's nil if the password doesn't expire.
+	RefreshPassword func(ctx context.Context) (string, error)
+}
+
+// GetPassword returns the password for a new connection.
+func (c *ConnectionConfig) GetPassword(ctx context.Context) (string, error) {
+	if c.RefreshPassword == nil {
+		return c.Password, nil
+	}
+	return c.RefreshPassword(ctx)
 }
 
 // SSHConfig is the configuration for connection over SSH.
@@ -539,6 +553,12 @@ type Driver interface {
 	Restore(ctx context.Context, src io.Reader) error
 }
 
+// ReplicationLagGetter is the optional interface for the drivers which can report the replication lag of a read replica.
+type ReplicationLagGetter interface {
+	// GetReplicationLag returns the replication lag of the connected replica. It returns 0 if the server is not a replica.
+	GetReplicationLag(ctx context.Context) (time.Duration, error)
+}
+
 // Register makes a database driver available by the provided type.
 // If Register is called twice with the same name or if driver is nil,
 // it panics.
@@ -571,6 +591,18 @@ func Open(ctx context.Context, dbType Type, driverConfig DriverConfig, connectio
 	return driver, nil
 }
 
+// Unwrap returns the underlying engine driver if the driver wraps another driver, e.g. the driver holding a credential lease.
+// Use it before asserting the engine driver type.
+func Unwrap(driver Driver) Driver {
+	for {
+		wrapper, ok := driver.(interface{ Unwrap() Driver })
+		if !ok {
+			return driver
+		}
+		driver = wrapper.Unwrap()
+	}
+}
+
 // ExecuteOptions is the options for execute.
 type ExecuteOptions struct {
 	BeginFunc          func(ctx context.Context, conn *sql.Conn) error
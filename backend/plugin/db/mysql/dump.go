@@ -787,8 +787,16 @@ func (driver *Driver) restoreImpl(ctx context.Context, backup io.Reader, databas
 	if driver.connCfg.Port != "" {
 		mysqlArgs = append(mysqlArgs, "--port", driver.connCfg.Port)
 	}
-	if driver.connCfg.Password != "" {
-		mysqlArgs = append(mysqlArgs, fmt.Sprintf("--password=%s", driver.connCfg.Password))
+	password, err := driver.connCfg.GetPassword(ctx)
+	if err != nil {
+		return err
+	}
+	if password != "" {
+		mysqlArgs = append(mysqlArgs, fmt.Sprintf("--password=%s", password))
+	}
+	if driver.connCfg.IAMAuthentication {
+		// The IAM authentication token is sent in cleartext, so TLS is required.
+		mysqlArgs = append(mysqlArgs, "--enable-cleartext-plugin", "--ssl-mode=REQUIRED")
 	}
 	mysqlCmd := exec.CommandContext(ctx, mysqlutil.GetPath(mysqlutil.MySQL, driver.dbBinDir), mysqlArgs...)
 
@@ -4,6 +4,7 @@ package mysql
 import (
 	"context"
 	"database/sql"
+	sqldriver "database/sql/driver"
 	"fmt"
 	"net"
 	"strings"
@@ -96,11 +97,29 @@ func (driver *Driver) Open(_ context.Context, dbType db.Type, connCfg db.Connect
 		defer mysql.DeregisterTLSConfig(tlsKey)
 		params = append(params, fmt.Sprintf("tls=%s", tlsKey))
 	}
+	if connCfg.IAMAuthentication {
+		// The IAM authentication token is sent with the cleartext client plugin, so TLS is required.
+		// The server certificate is verified with the data source SSL CA, or the system roots if it's not set.
+		params = append(params, "allowCleartextPasswords=true")
+		if tlsConfig == nil {
+			params = append(params, "tls=true")
+		}
+	}
 
 	dsn := fmt.Sprintf("%s:%s@%s(%s:%s)/%s?%s", connCfg.Username, connCfg.Password, protocol, connCfg.Host, connCfg.Port, connCfg.Database, strings.Join(params, "&"))
-	db, err := sql.Open("mysql", dsn)
-	if err != nil {
-		return nil, err
+	var db *sql.DB
+	if connCfg.RefreshPassword != nil {
+		// The TLS config is resolved on parsing, so it's fine to deregister it afterwards.
+		cfg, err := mysql.ParseDSN(dsn)
+		if err != nil {
+			return nil, err
+		}
+		db = sql.OpenDB(&refreshPasswordConnector{cfg: cfg, refreshPassword: connCfg.RefreshPassword})
+	} else {
+		db, err = sql.Open("mysql", dsn)
+		if err != nil {
+			return nil, err
+		}
 	}
 	driver.dbType = dbType
 	driver.db = db
@@ -115,6 +134,33 @@ func (driver *Driver) Open(_ context.Context, dbType db.Type, connCfg db.Connect
 	return driver, nil
 }
 
+// refreshPasswordConnector connects with a fresh password for every new connection,
+// since sql.DB opens the connections lazily and the cloud IAM authentication token in the DSN expires in minutes.
+type refreshPasswordConnector struct {
+	cfg             *mysql.Config
+	refreshPassword func(ctx context.Context) (string, error)
+}
+
+// Connect implements the driver.Connector interface.
+func (c *refreshPasswordConnector) Connect(ctx context.Context) (sqldriver.Conn, error) {
+	password, err := c.refreshPassword(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to refresh the password")
+	}
+	cfg := c.cfg.Clone()
+	cfg.Passwd = password
+	connector, err := mysql.NewConnector(cfg)
+	if err != nil {
+		return nil, err
+	}
+	return connector.Connect(ctx)
+}
+
+// Driver implements the driver.Connector interface.
+func (*refreshPasswordConnector) Driver() sqldriver.Driver {
+	return &mysql.MySQLDriver{}
+}
+
 // Close closes the driver.
 func (driver *Driver) Close(context.Context) error {
 	var err error
@@ -0,0 +1,93 @@
+package mysql
+
+import (
+	"context"
+	"database/sql"
+	"strconv"
+	"time"
+
+	"github.com/go-sql-driver/mysql"
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/backend/common"
+	"github.com/bytebase/bytebase/backend/plugin/db"
+)
+
+const (
+	// mysqlErrParse is the error number of ER_PARSE_ERROR.
+	mysqlErrParse = 1064
+	// mysqlErrSpecificAccessDenied is the error number of ER_SPECIFIC_ACCESS_DENIED_ERROR.
+	mysqlErrSpecificAccessDenied = 1227
+)
+
+var _ db.ReplicationLagGetter = (*Driver)(nil)
+
+// GetReplicationLag returns the replication lag of the replica by the seconds behind the source.
+// SHOW REPLICA STATUS is introduced in MySQL 8.0.22, so we fall back to SHOW SLAVE STATUS for the older versions and MariaDB.
+// Both statements require the REPLICATION CLIENT privilege, the error code is NotAuthorized if the user doesn't have it.
+func (driver *Driver) GetReplicationLag(ctx context.Context) (time.Duration, error) {
+	status, err := driver.getReplicaStatus(ctx, "SHOW REPLICA STATUS")
+	if isMySQLError(err, mysqlErrParse) {
+		status, err = driver.getReplicaStatus(ctx, "SHOW SLAVE STATUS")
+	}
+	if err != nil {
+		if isMySQLError(err, mysqlErrSpecificAccessDenied) {
+			return 0, common.Wrapf(err, common.NotAuthorized, "the REPLICATION CLIENT privilege is required to check the replication lag")
+		}
+		return 0, err
+	}
+	// The server is not a replica.
+	if status == nil {
+		return 0, nil
+	}
+	for _, column := range []string{"Seconds_Behind_Source", "Seconds_Behind_Master"} {
+		value, ok := status[column]
+		if !ok {
+			continue
+		}
+		// The seconds behind is NULL if the replication SQL or IO thread is not running.
+		if !value.Valid {
+			return 0, errors.New("replication is not running")
+		}
+		seconds, err := strconv.ParseInt(value.String, 10, 64)
+		if err != nil {
+			return 0, errors.Wrapf(err, "failed to parse %s %q", column, value.String)
+		}
+		return time.Duration(seconds) * time.Second, nil
+	}
+	return 0, errors.New("seconds behind source not found in replica status")
+}
+
+// getReplicaStatus returns the first row of the replica status keyed by column name, or nil if it's empty.
+func (driver *Driver) getReplicaStatus(ctx context.Context, statement string) (map[string]sql.NullString, error) {
+	rows, err := driver.db.QueryContext(ctx, statement)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	columns, err := rows.Columns()
+	if err != nil {
+		return nil, err
+	}
+	if !rows.Next() {
+		return nil, rows.Err()
+	}
+	values := make([]sql.NullString, len(columns))
+	dest := make([]any, len(columns))
+	for i := range values {
+		dest[i] = &values[i]
+	}
+	if err := rows.Scan(dest...); err != nil {
+		return nil, err
+	}
+	status := make(map[string]sql.NullString)
+	for i, column := range columns {
+		status[column] = values[i]
+	}
+	return status, nil
+}
+
+func isMySQLError(err error, number uint16) bool {
+	var mysqlErr *mysql.MySQLError
+	return errors.As(err, &mysqlErr) && mysqlErr.Number == number
+}
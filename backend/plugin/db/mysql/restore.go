@@ -184,10 +184,18 @@ func (driver *Driver) replayBinlogFromDir(ctx context.Context, originalDatabase,
 	if driver.connCfg.Port != "" {
 		mysqlArgs = append(mysqlArgs, "--port", driver.connCfg.Port)
 	}
-	if driver.connCfg.Password != "" {
+	password, err := driver.connCfg.GetPassword(ctx)
+	if err != nil {
+		return err
+	}
+	if password != "" {
 		// The --password parameter of mysql/mysqlbinlog does not support the "--password PASSWORD" format (split by space).
 		// If provided like that, the program will hang.
-		mysqlArgs = append(mysqlArgs, fmt.Sprintf("--password=%s", driver.connCfg.Password))
+		mysqlArgs = append(mysqlArgs, fmt.Sprintf("--password=%s", password))
+	}
+	if driver.connCfg.IAMAuthentication {
+		// The IAM authentication token is sent in cleartext, so TLS is required.
+		mysqlArgs = append(mysqlArgs, "--enable-cleartext-plugin", "--ssl-mode=REQUIRED")
 	}
 
 	mysqlbinlogCmd := exec.CommandContext(ctx, mysqlutil.GetPath(mysqlutil.MySQLBinlog, driver.dbBinDir), mysqlbinlogArgs...)
@@ -717,12 +725,23 @@ func (driver *Driver) downloadBinlogFile(ctx context.Context, binlogFileToDownlo
 	if driver.connCfg.Port != "" {
 		args = append(args, "--port", driver.connCfg.Port)
 	}
+	if driver.connCfg.IAMAuthentication {
+		args = append(args, "--ssl-mode=REQUIRED")
+	}
+	password, err := driver.connCfg.GetPassword(ctx)
+	if err != nil {
+		return err
+	}
 
 	cmd := exec.CommandContext(ctx, mysqlutil.GetPath(mysqlutil.MySQLBinlog, driver.dbBinDir), args...)
 	// We cannot set password as a flag. Otherwise, there is warning message
 	// "mysqlbinlog: [Warning] Using a password on the command line interface can be insecure."
-	if driver.connCfg.Password != "" {
-		cmd.Env = append(cmd.Env, fmt.Sprintf("MYSQL_PWD=%s", driver.connCfg.Password))
+	if password != "" {
+		cmd.Env = append(cmd.Env, fmt.Sprintf("MYSQL_PWD=%s", password))
+	}
+	if driver.connCfg.IAMAuthentication {
+		// mysqlbinlog has no --enable-cleartext-plugin option.
+		cmd.Env = append(cmd.Env, "LIBMYSQL_ENABLE_CLEARTEXT_PLUGIN=Y")
 	}
 	cmd.Stdout = os.Stderr
 	cmd.Stderr = os.Stderr
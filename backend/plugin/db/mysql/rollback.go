@@ -82,10 +82,21 @@ func (driver *Driver) GenerateRollbackSQL(ctx context.Context, binlogSizeLimit i
 	if driver.connCfg.Port != "" {
 		args = append(args, "--port", driver.connCfg.Port)
 	}
+	if driver.connCfg.IAMAuthentication {
+		args = append(args, "--ssl-mode=REQUIRED")
+	}
+	password, err := driver.connCfg.GetPassword(ctx)
+	if err != nil {
+		return "", err
+	}
 	cmd := exec.CommandContext(ctx, mysqlutil.GetPath(mysqlutil.MySQLBinlog, driver.dbBinDir), args...)
 	log.Debug("mysqlbinlog", zap.String("command", cmd.String()))
-	if driver.connCfg.Password != "" {
-		cmd.Env = append(cmd.Env, fmt.Sprintf("MYSQL_PWD=%s", driver.connCfg.Password))
+	if password != "" {
+		cmd.Env = append(cmd.Env, fmt.Sprintf("MYSQL_PWD=%s", password))
+	}
+	if driver.connCfg.IAMAuthentication {
+		// mysqlbinlog has no --enable-cleartext-plugin option.
+		cmd.Env = append(cmd.Env, "LIBMYSQL_ENABLE_CLEARTEXT_PLUGIN=Y")
 	}
 	pr, err := cmd.StdoutPipe()
 	if err != nil {
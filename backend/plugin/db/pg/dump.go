@@ -59,9 +59,13 @@ func (driver *Driver) Dump(ctx context.Context, out io.Writer, schemaOnly bool)
 }
 
 func (driver *Driver) dumpOneDatabaseWithPgDump(ctx context.Context, database string, out io.Writer, schemaOnly bool) error {
+	password, err := driver.config.GetPassword(ctx)
+	if err != nil {
+		return err
+	}
 	var args []string
 	args = append(args, fmt.Sprintf("--username=%s", driver.config.Username))
-	if driver.config.Password == "" {
+	if password == "" {
 		args = append(args, "--no-password")
 	}
 	if driver.sshClient == nil {
@@ -96,8 +100,8 @@ func (driver *Driver) dumpOneDatabaseWithPgDump(ctx context.Context, database st
 	pgDumpPath := filepath.Join(driver.dbBinDir, "pg_dump")
 	cmd := exec.CommandContext(ctx, pgDumpPath, args...)
 	// Unlike MySQL, PostgreSQL does not support specifying commands in commands, we can do this by means of environment variables.
-	if driver.config.Password != "" {
-		cmd.Env = append(cmd.Env, fmt.Sprintf("PGPASSWORD=%s", driver.config.Password))
+	if password != "" {
+		cmd.Env = append(cmd.Env, fmt.Sprintf("PGPASSWORD=%s", password))
 	}
 	if driver.config.TLSConfig.SslCert != "" {
 		cmd.Env = append(cmd.Env, fmt.Sprintf("PGSSLCERT=%s", driver.config.TLSConfig.SslCert))
@@ -101,6 +101,9 @@ func (driver *Driver) Open(_ context.Context, _ db.Type, config db.ConnectionCon
 	// https://neon.tech/docs/connect/connectivity-issues#c-set-verify-full-for-golang-based-clients
 	if strings.HasSuffix(config.Host, ".neon.tech") {
 		connStr += " sslmode=verify-full"
+	} else if config.IAMAuthentication {
+		// The IAM authentication token is sent as a cleartext password, so TLS is required.
+		connStr += " sslmode=require"
 	}
 	connConfig, err := pgx.ParseConfig(connStr)
 	if err != nil {
@@ -146,6 +149,18 @@ func (driver *Driver) Open(_ context.Context, _ db.Type, config db.ConnectionCon
 	}
 	driver.config = config
 
+	if config.RefreshPassword != nil {
+		// Connect with a fresh password for every new connection, since the cloud IAM authentication token expires in minutes.
+		driver.db = stdlib.OpenDB(*connConfig, stdlib.OptionBeforeConnect(func(ctx context.Context, connConfig *pgx.ConnConfig) error {
+			password, err := config.RefreshPassword(ctx)
+			if err != nil {
+				return errors.Wrap(err, "failed to refresh the password")
+			}
+			connConfig.Password = password
+			return nil
+		}))
+		return driver, nil
+	}
 	driver.connectionString = stdlib.RegisterConnConfig(connConfig)
 	db, err := sql.Open(driverName, driver.connectionString)
 	if err != nil {
@@ -0,0 +1,26 @@
+package pg
+
+import (
+	"context"
+	"time"
+
+	"github.com/bytebase/bytebase/backend/plugin/db"
+)
+
+var _ db.ReplicationLagGetter = (*Driver)(nil)
+
+// GetReplicationLag returns the replication lag of the standby by the time since the last replayed transaction.
+// The lag is 0 if all the received WAL has been replayed, so that an idle primary doesn't make the standby look lagging.
+func (driver *Driver) GetReplicationLag(ctx context.Context) (time.Duration, error) {
+	var seconds float64
+	if err := driver.db.QueryRowContext(ctx, `
+		SELECT CASE
+			WHEN NOT pg_is_in_recovery() THEN 0
+			WHEN pg_last_wal_receive_lsn() = pg_last_wal_replay_lsn() THEN 0
+			ELSE COALESCE(EXTRACT(EPOCH FROM now() - pg_last_xact_replay_timestamp()), 0)
+		END`,
+	).Scan(&seconds); err != nil {
+		return 0, err
+	}
+	return time.Duration(seconds * float64(time.Second)), nil
+}
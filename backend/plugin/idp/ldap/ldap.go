@@ -0,0 +1,234 @@
+// Package ldap is the plugin for LDAP Identity Provider.
+package ldap
+
+import (
+	"crypto/tls"
+	"fmt"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/go-ldap/ldap/v3"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+
+	"github.com/bytebase/bytebase/backend/common/log"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+const (
+	dialTimeout    = 10 * time.Second
+	requestTimeout = 30 * time.Second
+	// defaultGroupMemberAttribute is the attribute of the groupOfNames entry listing the member DNs.
+	defaultGroupMemberAttribute = "member"
+)
+
+// IdentityProvider represents an LDAP Identity Provider.
+type IdentityProvider struct {
+	config *storepb.LDAPIdentityProviderConfig
+}
+
+// NewIdentityProvider initializes a new LDAP Identity Provider with the given
+// configuration.
+func NewIdentityProvider(config *storepb.LDAPIdentityProviderConfig) (*IdentityProvider, error) {
+	for v, field := range map[string]string{
+		config.Host:                         "host",
+		config.BindDn:                       "bindDn",
+		config.BindPassword:                 "bindPassword",
+		config.BaseDn:                       "baseDn",
+		config.UserFilter:                   "userFilter",
+		config.GetFieldMapping().Identifier: "fieldMapping.identifier",
+	} {
+		if v == "" {
+			return nil, errors.Errorf("the field %q is empty but required", field)
+		}
+	}
+	if config.Port <= 0 || config.Port > 65535 {
+		return nil, errors.Errorf("invalid port %d", config.Port)
+	}
+	if strings.Count(config.UserFilter, "%s") != 1 {
+		return nil, errors.Errorf("the user filter %q must contain exactly one %%s for the username", config.UserFilter)
+	}
+	if _, err := ldap.CompileFilter(fmt.Sprintf(config.UserFilter, "username")); err != nil {
+		return nil, errors.Wrapf(err, "invalid user filter %q", config.UserFilter)
+	}
+
+	return &IdentityProvider{
+		config: config,
+	}, nil
+}
+
+// Authenticate verifies the password of the user by binding as the user found with
+// the user filter, and returns the user info.
+func (p *IdentityProvider) Authenticate(username, password string) (*storepb.IdentityProviderUserInfo, error) {
+	if username == "" {
+		return nil, errors.New("username is required")
+	}
+	// The bind with an empty password is an unauthenticated bind, which succeeds without verifying anything.
+	if password == "" {
+		return nil, errors.New("password is required")
+	}
+
+	conn, err := p.connect()
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	filter := fmt.Sprintf(p.config.UserFilter, ldap.EscapeFilter(username))
+	result, err := conn.Search(ldap.NewSearchRequest(
+		p.config.BaseDn,
+		ldap.ScopeWholeSubtree,
+		ldap.NeverDerefAliases,
+		2, /* sizeLimit */
+		int(requestTimeout.Seconds()),
+		false, /* typesOnly */
+		filter,
+		p.getUserAttributes(),
+		nil, /* controls */
+	))
+	if err != nil && !ldap.IsErrorWithCode(err, ldap.LDAPResultSizeLimitExceeded) {
+		return nil, errors.Wrapf(err, "failed to search user with filter %q", filter)
+	}
+	if result == nil || len(result.Entries) == 0 {
+		return nil, errors.Errorf("user %q not found", username)
+	}
+	if len(result.Entries) > 1 {
+		return nil, errors.Errorf("found multiple users with filter %q", filter)
+	}
+
+	entry := result.Entries[0]
+	if err := conn.Bind(entry.DN, password); err != nil {
+		if ldap.IsErrorWithCode(err, ldap.LDAPResultInvalidCredentials) {
+			return nil, errors.Errorf("incorrect password for user %q", username)
+		}
+		return nil, errors.Wrapf(err, "failed to bind as %q", entry.DN)
+	}
+	return p.getUserInfo(entry)
+}
+
+// ListGroupMembers returns the user info of the members of the group.
+// The members which aren't found or don't have the identifier attribute are skipped.
+// The nested groups aren't expanded.
+func (p *IdentityProvider) ListGroupMembers(group string) ([]*storepb.IdentityProviderUserInfo, error) {
+	conn, err := p.connect()
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	memberAttribute := p.config.GroupMemberAttribute
+	if memberAttribute == "" {
+		memberAttribute = defaultGroupMemberAttribute
+	}
+	groupEntry, err := getEntry(conn, group, []string{memberAttribute})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get group %q", group)
+	}
+
+	var userInfos []*storepb.IdentityProviderUserInfo
+	for _, memberDN := range groupEntry.GetAttributeValues(memberAttribute) {
+		entry, err := getEntry(conn, memberDN, p.getUserAttributes())
+		if err != nil {
+			if ldap.IsErrorWithCode(err, ldap.LDAPResultNoSuchObject) {
+				log.Debug("Skip nonexistent LDAP group member", zap.String("group", group), zap.String("member", memberDN))
+				continue
+			}
+			return nil, errors.Wrapf(err, "failed to get group member %q", memberDN)
+		}
+		userInfo, err := p.getUserInfo(entry)
+		if err != nil {
+			log.Debug("Skip LDAP group member", zap.String("group", group), zap.String("member", memberDN), zap.Error(err))
+			continue
+		}
+		userInfos = append(userInfos, userInfo)
+	}
+	return userInfos, nil
+}
+
+// connect dials the LDAP server and binds as the service account.
+func (p *IdentityProvider) connect() (*ldap.Conn, error) {
+	tlsConfig := &tls.Config{
+		ServerName:         p.config.Host,
+		InsecureSkipVerify: p.config.SkipTlsVerify,
+	}
+	scheme := "ldap"
+	if p.config.SecurityProtocol == storepb.LDAPSecurityProtocol_LDAPS {
+		scheme = "ldaps"
+	}
+	address := fmt.Sprintf("%s://%s", scheme, net.JoinHostPort(p.config.Host, fmt.Sprintf("%d", p.config.Port)))
+	conn, err := ldap.DialURL(
+		address,
+		ldap.DialWithDialer(&net.Dialer{Timeout: dialTimeout}),
+		ldap.DialWithTLSConfig(tlsConfig),
+	)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to dial %q", address)
+	}
+	conn.SetTimeout(requestTimeout)
+
+	if p.config.SecurityProtocol == storepb.LDAPSecurityProtocol_START_TLS {
+		if err := conn.StartTLS(tlsConfig); err != nil {
+			conn.Close()
+			return nil, errors.Wrap(err, "failed to start TLS")
+		}
+	}
+	if err := conn.Bind(p.config.BindDn, p.config.BindPassword); err != nil {
+		conn.Close()
+		return nil, errors.Wrapf(err, "failed to bind as %q", p.config.BindDn)
+	}
+	return conn, nil
+}
+
+func (p *IdentityProvider) getUserAttributes() []string {
+	attributes := []string{p.config.FieldMapping.Identifier}
+	if v := p.config.FieldMapping.DisplayName; v != "" {
+		attributes = append(attributes, v)
+	}
+	if v := p.config.FieldMapping.Email; v != "" {
+		attributes = append(attributes, v)
+	}
+	return attributes
+}
+
+func (p *IdentityProvider) getUserInfo(entry *ldap.Entry) (*storepb.IdentityProviderUserInfo, error) {
+	fieldMapping := p.config.FieldMapping
+	userInfo := &storepb.IdentityProviderUserInfo{
+		Identifier: entry.GetAttributeValue(fieldMapping.Identifier),
+	}
+	if userInfo.Identifier == "" {
+		return nil, errors.Errorf("the attribute %q is not found in %q", fieldMapping.Identifier, entry.DN)
+	}
+	if fieldMapping.DisplayName != "" {
+		userInfo.DisplayName = entry.GetAttributeValue(fieldMapping.DisplayName)
+	}
+	if userInfo.DisplayName == "" {
+		userInfo.DisplayName = userInfo.Identifier
+	}
+	if fieldMapping.Email != "" {
+		userInfo.Email = entry.GetAttributeValue(fieldMapping.Email)
+	}
+	return userInfo, nil
+}
+
+// getEntry returns the entry of the DN.
+func getEntry(conn *ldap.Conn, dn string, attributes []string) (*ldap.Entry, error) {
+	result, err := conn.Search(ldap.NewSearchRequest(
+		dn,
+		ldap.ScopeBaseObject,
+		ldap.NeverDerefAliases,
+		1, /* sizeLimit */
+		int(requestTimeout.Seconds()),
+		false, /* typesOnly */
+		"(objectClass=*)",
+		attributes,
+		nil, /* controls */
+	))
+	if err != nil {
+		return nil, err
+	}
+	if len(result.Entries) == 0 {
+		return nil, ldap.NewError(ldap.LDAPResultNoSuchObject, errors.Errorf("entry %q not found", dn))
+	}
+	return result.Entries[0], nil
+}
@@ -0,0 +1,352 @@
+package ldap
+
+import (
+	"net"
+	"regexp"
+	"strings"
+	"testing"
+
+	ber "github.com/go-asn1-ber/asn1-ber"
+	"github.com/go-ldap/ldap/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+// testEntry is an entry of the in-process LDAP server.
+type testEntry struct {
+	password   string
+	attributes map[string][]string
+}
+
+// testServer is a minimal in-process LDAP server supporting the simple bind and the equality
+// and presence search filters.
+type testServer struct {
+	listener net.Listener
+	entries  map[string]*testEntry
+}
+
+var equalityFilterRegexp = regexp.MustCompile(`^\(([^=()]+)=([^()]*)\)$`)
+
+func newTestServer(t *testing.T, entries map[string]*testEntry) *testServer {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	s := &testServer{
+		listener: listener,
+		entries:  entries,
+	}
+	go s.serve()
+	t.Cleanup(func() {
+		_ = listener.Close()
+	})
+	return s
+}
+
+func (s *testServer) port() int32 {
+	return int32(s.listener.Addr().(*net.TCPAddr).Port)
+}
+
+func (s *testServer) serve() {
+	for {
+		conn, err := s.listener.Accept()
+		if err != nil {
+			return
+		}
+		go s.handle(conn)
+	}
+}
+
+func (s *testServer) handle(conn net.Conn) {
+	defer conn.Close()
+	for {
+		packet, err := ber.ReadPacket(conn)
+		if err != nil || len(packet.Children) < 2 {
+			return
+		}
+		messageID := packet.Children[0].Value.(int64)
+		op := packet.Children[1]
+		var responses []*ber.Packet
+		switch op.Tag {
+		case ldap.ApplicationBindRequest:
+			responses = append(responses, s.bind(op))
+		case ldap.ApplicationSearchRequest:
+			responses = s.search(op)
+		default:
+			// Unbind and the unsupported requests close the connection.
+			return
+		}
+		for _, response := range responses {
+			envelope := ber.Encode(ber.ClassUniversal, ber.TypeConstructed, ber.TagSequence, nil, "LDAP Response")
+			envelope.AppendChild(ber.NewInteger(ber.ClassUniversal, ber.TypePrimitive, ber.TagInteger, messageID, "MessageID"))
+			envelope.AppendChild(response)
+			if _, err := conn.Write(envelope.Bytes()); err != nil {
+				return
+			}
+		}
+	}
+}
+
+func (s *testServer) bind(op *ber.Packet) *ber.Packet {
+	dn := op.Children[1].Value.(string)
+	password := op.Children[2].Data.String()
+	resultCode := ldap.LDAPResultInvalidCredentials
+	if entry, ok := s.entries[dn]; ok && entry.password != "" && entry.password == password {
+		resultCode = ldap.LDAPResultSuccess
+	}
+	return newResult(ldap.ApplicationBindResponse, uint16(resultCode))
+}
+
+func (s *testServer) search(op *ber.Packet) []*ber.Packet {
+	baseDN := op.Children[0].Value.(string)
+	scope := op.Children[1].Value.(int64)
+	filter, err := ldap.DecompileFilter(op.Children[6])
+	if err != nil {
+		return []*ber.Packet{newResult(ldap.ApplicationSearchResultDone, ldap.LDAPResultProtocolError)}
+	}
+	var attributes []string
+	for _, attribute := range op.Children[7].Children {
+		attributes = append(attributes, attribute.Value.(string))
+	}
+
+	if scope == int64(ldap.ScopeBaseObject) {
+		if _, ok := s.entries[baseDN]; !ok {
+			return []*ber.Packet{newResult(ldap.ApplicationSearchResultDone, ldap.LDAPResultNoSuchObject)}
+		}
+	}
+	var responses []*ber.Packet
+	for dn, entry := range s.entries {
+		if scope == int64(ldap.ScopeBaseObject) && dn != baseDN {
+			continue
+		}
+		if scope == int64(ldap.ScopeWholeSubtree) && !strings.HasSuffix(dn, ","+baseDN) {
+			continue
+		}
+		if !entry.match(filter) {
+			continue
+		}
+		responses = append(responses, newSearchResultEntry(dn, entry, attributes))
+	}
+	return append(responses, newResult(ldap.ApplicationSearchResultDone, ldap.LDAPResultSuccess))
+}
+
+func (e *testEntry) match(filter string) bool {
+	matches := equalityFilterRegexp.FindStringSubmatch(filter)
+	if matches == nil {
+		return false
+	}
+	if strings.EqualFold(matches[1], "objectClass") && matches[2] == "*" {
+		return true
+	}
+	for _, value := range e.attributes[matches[1]] {
+		if value == matches[2] {
+			return true
+		}
+	}
+	return false
+}
+
+func newResult(tag ber.Tag, resultCode uint16) *ber.Packet {
+	result := ber.Encode(ber.ClassApplication, ber.TypeConstructed, tag, nil, "Result")
+	result.AppendChild(ber.NewInteger(ber.ClassUniversal, ber.TypePrimitive, ber.TagEnumerated, int64(resultCode), "Result Code"))
+	result.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, "", "Matched DN"))
+	result.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, "", "Diagnostic Message"))
+	return result
+}
+
+func newSearchResultEntry(dn string, entry *testEntry, attributes []string) *ber.Packet {
+	result := ber.Encode(ber.ClassApplication, ber.TypeConstructed, ldap.ApplicationSearchResultEntry, nil, "Search Result Entry")
+	result.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, dn, "Object Name"))
+	attributeList := ber.Encode(ber.ClassUniversal, ber.TypeConstructed, ber.TagSequence, nil, "Attributes")
+	for _, name := range attributes {
+		values, ok := entry.attributes[name]
+		if !ok {
+			continue
+		}
+		attribute := ber.Encode(ber.ClassUniversal, ber.TypeConstructed, ber.TagSequence, nil, "Attribute")
+		attribute.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, name, "Type"))
+		valueSet := ber.Encode(ber.ClassUniversal, ber.TypeConstructed, ber.TagSet, nil, "Values")
+		for _, value := range values {
+			valueSet.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, value, "Value"))
+		}
+		attribute.AppendChild(valueSet)
+		attributeList.AppendChild(attribute)
+	}
+	result.AppendChild(attributeList)
+	return result
+}
+
+func newTestIdentityProvider(t *testing.T) *IdentityProvider {
+	server := newTestServer(t, map[string]*testEntry{
+		"cn=admin,dc=example,dc=com": {
+			password: "admin-password",
+		},
+		"uid=alice,ou=users,dc=example,dc=com": {
+			password: "alice-password",
+			attributes: map[string][]string{
+				"uid":  {"alice"},
+				"cn":   {"Alice"},
+				"mail": {"alice@example.com"},
+			},
+		},
+		"uid=bob,ou=users,dc=example,dc=com": {
+			password: "bob-password",
+			attributes: map[string][]string{
+				"uid": {"bob"},
+			},
+		},
+		"cn=dba,ou=groups,dc=example,dc=com": {
+			attributes: map[string][]string{
+				"member": {
+					"uid=alice,ou=users,dc=example,dc=com",
+					"uid=bob,ou=users,dc=example,dc=com",
+					"uid=carol,ou=users,dc=example,dc=com",
+				},
+			},
+		},
+	})
+	identityProvider, err := NewIdentityProvider(&storepb.LDAPIdentityProviderConfig{
+		Host:         "127.0.0.1",
+		Port:         server.port(),
+		BindDn:       "cn=admin,dc=example,dc=com",
+		BindPassword: "admin-password",
+		BaseDn:       "ou=users,dc=example,dc=com",
+		UserFilter:   "(uid=%s)",
+		FieldMapping: &storepb.FieldMapping{
+			Identifier:  "uid",
+			DisplayName: "cn",
+			Email:       "mail",
+		},
+	})
+	require.NoError(t, err)
+	return identityProvider
+}
+
+func TestNewIdentityProvider(t *testing.T) {
+	tests := []struct {
+		name        string
+		config      *storepb.LDAPIdentityProviderConfig
+		containsErr string
+	}{
+		{
+			name: "no host",
+			config: &storepb.LDAPIdentityProviderConfig{
+				Port:         389,
+				BindDn:       "cn=admin,dc=example,dc=com",
+				BindPassword: "admin-password",
+				BaseDn:       "dc=example,dc=com",
+				UserFilter:   "(uid=%s)",
+				FieldMapping: &storepb.FieldMapping{Identifier: "uid"},
+			},
+			containsErr: `the field "host" is empty but required`,
+		},
+		{
+			name: "invalid port",
+			config: &storepb.LDAPIdentityProviderConfig{
+				Host:         "ldap.example.com",
+				BindDn:       "cn=admin,dc=example,dc=com",
+				BindPassword: "admin-password",
+				BaseDn:       "dc=example,dc=com",
+				UserFilter:   "(uid=%s)",
+				FieldMapping: &storepb.FieldMapping{Identifier: "uid"},
+			},
+			containsErr: "invalid port 0",
+		},
+		{
+			name: "user filter without placeholder",
+			config: &storepb.LDAPIdentityProviderConfig{
+				Host:         "ldap.example.com",
+				Port:         389,
+				BindDn:       "cn=admin,dc=example,dc=com",
+				BindPassword: "admin-password",
+				BaseDn:       "dc=example,dc=com",
+				UserFilter:   "(uid=alice)",
+				FieldMapping: &storepb.FieldMapping{Identifier: "uid"},
+			},
+			containsErr: "must contain exactly one %s",
+		},
+		{
+			name: "invalid user filter",
+			config: &storepb.LDAPIdentityProviderConfig{
+				Host:         "ldap.example.com",
+				Port:         389,
+				BindDn:       "cn=admin,dc=example,dc=com",
+				BindPassword: "admin-password",
+				BaseDn:       "dc=example,dc=com",
+				UserFilter:   "(uid=%s",
+				FieldMapping: &storepb.FieldMapping{Identifier: "uid"},
+			},
+			containsErr: "invalid user filter",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := NewIdentityProvider(test.config)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), test.containsErr)
+		})
+	}
+}
+
+func TestAuthenticate(t *testing.T) {
+	identityProvider := newTestIdentityProvider(t)
+
+	userInfo, err := identityProvider.Authenticate("alice", "alice-password")
+	require.NoError(t, err)
+	assert.Equal(t, &storepb.IdentityProviderUserInfo{
+		Identifier:  "alice",
+		DisplayName: "Alice",
+		Email:       "alice@example.com",
+	}, userInfo)
+
+	// The display name falls back to the identifier.
+	userInfo, err = identityProvider.Authenticate("bob", "bob-password")
+	require.NoError(t, err)
+	assert.Equal(t, &storepb.IdentityProviderUserInfo{
+		Identifier:  "bob",
+		DisplayName: "bob",
+	}, userInfo)
+
+	_, err = identityProvider.Authenticate("alice", "wrong-password")
+	require.ErrorContains(t, err, `incorrect password for user "alice"`)
+
+	_, err = identityProvider.Authenticate("alice", "")
+	require.ErrorContains(t, err, "password is required")
+
+	_, err = identityProvider.Authenticate("carol", "carol-password")
+	require.ErrorContains(t, err, `user "carol" not found`)
+
+	// The username is escaped in the filter.
+	_, err = identityProvider.Authenticate("*", "alice-password")
+	require.ErrorContains(t, err, `user "*" not found`)
+}
+
+func TestAuthenticateBindFailure(t *testing.T) {
+	identityProvider := newTestIdentityProvider(t)
+	identityProvider.config.BindPassword = "wrong-password"
+
+	_, err := identityProvider.Authenticate("alice", "alice-password")
+	require.ErrorContains(t, err, `failed to bind as "cn=admin,dc=example,dc=com"`)
+}
+
+func TestListGroupMembers(t *testing.T) {
+	identityProvider := newTestIdentityProvider(t)
+
+	userInfos, err := identityProvider.ListGroupMembers("cn=dba,ou=groups,dc=example,dc=com")
+	require.NoError(t, err)
+	// The nonexistent member carol is skipped.
+	assert.Equal(t, []*storepb.IdentityProviderUserInfo{
+		{
+			Identifier:  "alice",
+			DisplayName: "Alice",
+			Email:       "alice@example.com",
+		},
+		{
+			Identifier:  "bob",
+			DisplayName: "bob",
+		},
+	}, userInfos)
+
+	_, err = identityProvider.ListGroupMembers("cn=nonexistent,ou=groups,dc=example,dc=com")
+	require.ErrorContains(t, err, `failed to get group "cn=nonexistent,ou=groups,dc=example,dc=com"`)
+}
@@ -0,0 +1,196 @@
+package saml
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+const (
+	// authnRequestTTL is the duration for the user to sign in with the identity provider after the authentication request is sent.
+	authnRequestTTL = 10 * time.Minute
+	// codeTTL is the duration for the frontend to exchange the one-time code for signing in.
+	codeTTL = time.Minute
+	// maxCacheEntries is the maximum number of the outstanding authentication requests, the consumed assertions and the codes each.
+	// The oldest outstanding authentication requests are evicted when it's reached, because anyone can start the sign-in.
+	maxCacheEntries = 10000
+)
+
+// Cache is the in-memory state of the SAML sign-in flow shared by the identity providers.
+// It keeps the outstanding authentication requests so that only the responses to them are accepted,
+// the consumed assertions so that a response can't be replayed, and the one-time codes exchanged for the
+// validated responses so that the signed response never reaches the browser.
+type Cache struct {
+	now func() time.Time
+
+	mu sync.Mutex
+	// requests is the outstanding authentication requests keyed by the request ID.
+	requests map[string]*authnRequest
+	// assertions is the expiration of the consumed assertions keyed by the identity provider and assertion ID.
+	assertions map[assertionKey]time.Time
+	// codes is the user info of the validated responses keyed by the one-time code.
+	codes map[string]*codeEntry
+}
+
+type authnRequest struct {
+	identityProviderID string
+	relayState         string
+	expireAt           time.Time
+}
+
+type assertionKey struct {
+	identityProviderID string
+	assertionID        string
+}
+
+type codeEntry struct {
+	identityProviderID string
+	userInfo           *storepb.IdentityProviderUserInfo
+	expireAt           time.Time
+}
+
+// NewCache creates a new SAML cache.
+func NewCache() *Cache {
+	return &Cache{
+		now:        time.Now,
+		requests:   make(map[string]*authnRequest),
+		assertions: make(map[assertionKey]time.Time),
+		codes:      make(map[string]*codeEntry),
+	}
+}
+
+// CreateCode returns a one-time code for the user info of the validated SAML response.
+func (c *Cache) CreateCode(identityProviderID string, userInfo *storepb.IdentityProviderUserInfo) (string, error) {
+	code, err := newRandomString()
+	if err != nil {
+		return "", errors.Wrap(err, "failed to generate code")
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := c.now()
+	if len(c.codes) >= maxCacheEntries {
+		c.evictLocked(now)
+		if len(c.codes) >= maxCacheEntries {
+			return "", errors.New("too many pending SAML sign-ins")
+		}
+	}
+	c.codes[code] = &codeEntry{
+		identityProviderID: identityProviderID,
+		userInfo:           userInfo,
+		expireAt:           now.Add(codeTTL),
+	}
+	return code, nil
+}
+
+// ExchangeCode returns the user info of the one-time code issued for the identity provider.
+// The code is consumed no matter whether it's valid.
+func (c *Cache) ExchangeCode(identityProviderID, code string) (*storepb.IdentityProviderUserInfo, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry, ok := c.codes[code]
+	if !ok {
+		return nil, errors.New("the code is invalid or used")
+	}
+	delete(c.codes, code)
+	if entry.identityProviderID != identityProviderID {
+		return nil, errors.New("the code isn't issued for the identity provider")
+	}
+	if !c.now().Before(entry.expireAt) {
+		return nil, errors.New("the code is expired")
+	}
+	return entry.userInfo, nil
+}
+
+// addRequest records the outstanding authentication request, and evicts the oldest one if there are too many.
+func (c *Cache) addRequest(requestID, identityProviderID, relayState string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := c.now()
+	if len(c.requests) >= maxCacheEntries {
+		c.evictLocked(now)
+	}
+	for len(c.requests) >= maxCacheEntries {
+		c.evictOldestRequestLocked()
+	}
+	c.requests[requestID] = &authnRequest{
+		identityProviderID: identityProviderID,
+		relayState:         relayState,
+		expireAt:           now.Add(authnRequestTTL),
+	}
+}
+
+// consume consumes the authentication request that the response is in response to, and the assertion of the response.
+// The assertion is remembered until it expires so that it can't be used again.
+func (c *Cache) consume(identityProviderID, requestID, relayState, assertionID string, assertionExpireAt time.Time) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := c.now()
+	key := assertionKey{identityProviderID: identityProviderID, assertionID: assertionID}
+	if _, ok := c.assertions[key]; ok {
+		return errors.Errorf("the assertion %q has been used", assertionID)
+	}
+	request, ok := c.requests[requestID]
+	if !ok || request.identityProviderID != identityProviderID || !now.Before(request.expireAt) {
+		return errors.New("the response isn't in response to an outstanding authentication request")
+	}
+	if request.relayState != relayState {
+		return errors.New("the relay state doesn't match the authentication request")
+	}
+	if len(c.assertions) >= maxCacheEntries {
+		c.evictLocked(now)
+		if len(c.assertions) >= maxCacheEntries {
+			return errors.New("too many consumed SAML assertions")
+		}
+	}
+	delete(c.requests, requestID)
+	c.assertions[key] = assertionExpireAt
+	return nil
+}
+
+// evictLocked removes the expired entries. It must be called with the lock held.
+func (c *Cache) evictLocked(now time.Time) {
+	for id, request := range c.requests {
+		if !now.Before(request.expireAt) {
+			delete(c.requests, id)
+		}
+	}
+	for key, expireAt := range c.assertions {
+		if !now.Before(expireAt) {
+			delete(c.assertions, key)
+		}
+	}
+	for code, entry := range c.codes {
+		if !now.Before(entry.expireAt) {
+			delete(c.codes, code)
+		}
+	}
+}
+
+// evictOldestRequestLocked removes the oldest outstanding authentication request. It must be called with the lock held.
+func (c *Cache) evictOldestRequestLocked() {
+	var oldestID string
+	var oldest *authnRequest
+	for id, request := range c.requests {
+		if oldest == nil || request.expireAt.Before(oldest.expireAt) {
+			oldestID, oldest = id, request
+		}
+	}
+	delete(c.requests, oldestID)
+}
+
+func newRandomString() (string, error) {
+	b := make([]byte, 20)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
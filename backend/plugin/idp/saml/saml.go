@@ -0,0 +1,504 @@
+// Package saml is the plugin for SAML 2.0 Identity Provider.
+package saml
+
+import (
+	"bytes"
+	"compress/flate"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/beevik/etree"
+	"github.com/pkg/errors"
+	dsig "github.com/russellhaering/goxmldsig"
+	"github.com/russellhaering/goxmldsig/etreeutils"
+
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+const (
+	protocolNamespace  = "urn:oasis:names:tc:SAML:2.0:protocol"
+	assertionNamespace = "urn:oasis:names:tc:SAML:2.0:assertion"
+	metadataNamespace  = "urn:oasis:names:tc:SAML:2.0:metadata"
+	signatureNamespace = "http://www.w3.org/2000/09/xmldsig#"
+
+	httpPostBinding          = "urn:oasis:names:tc:SAML:2.0:bindings:HTTP-POST"
+	statusSuccess            = "urn:oasis:names:tc:SAML:2.0:status:Success"
+	nameIDFormatUnspecified  = "urn:oasis:names:tc:SAML:1.1:nameid-format:unspecified"
+	bearerConfirmationMethod = "urn:oasis:names:tc:SAML:2.0:cm:bearer"
+
+	// maxClockSkew is the allowed clock skew between the identity provider and us when validating the time conditions.
+	maxClockSkew = 3 * time.Minute
+)
+
+// IdentityProvider represents a SAML Identity Provider. Bytebase is the service provider.
+type IdentityProvider struct {
+	config *storepb.SAMLIdentityProviderConfig
+	// identityProviderID is the resource ID of the identity provider.
+	identityProviderID string
+	// entityID is the entity ID of the service provider, which is the URL of the metadata.
+	entityID string
+	// acsURL is the URL of the assertion consumer service where the identity provider posts the response.
+	acsURL string
+
+	certificates  []*x509.Certificate
+	spPrivateKey  *rsa.PrivateKey
+	spCertificate *x509.Certificate
+	cache         *Cache
+	now           func() time.Time
+}
+
+// GetMetadataURL returns the URL of the service provider metadata, which is also the entity ID of the service provider.
+func GetMetadataURL(externalURL, identityProviderID string) string {
+	return fmt.Sprintf("%s/saml/metadata/%s", strings.TrimSuffix(externalURL, "/"), identityProviderID)
+}
+
+// GetAssertionConsumerServiceURL returns the URL of the assertion consumer service.
+func GetAssertionConsumerServiceURL(externalURL, identityProviderID string) string {
+	return fmt.Sprintf("%s/saml/acs/%s", strings.TrimSuffix(externalURL, "/"), identityProviderID)
+}
+
+// NewIdentityProvider initializes a new SAML Identity Provider with the given
+// configuration. The external URL and the identity provider ID are used to build
+// the service provider endpoints. The cache tracks the authentication requests and
+// the assertions across the identity providers.
+func NewIdentityProvider(config *storepb.SAMLIdentityProviderConfig, externalURL, identityProviderID string, cache *Cache) (*IdentityProvider, error) {
+	for v, field := range map[string]string{
+		config.SsoUrl:        "ssoUrl",
+		config.EntityId:      "entityId",
+		config.Certificate:   "certificate",
+		config.SpPrivateKey:  "spPrivateKey",
+		config.SpCertificate: "spCertificate",
+	} {
+		if v == "" {
+			return nil, errors.Errorf("the field %q is empty but required", field)
+		}
+	}
+	if config.FieldMapping == nil {
+		return nil, errors.Errorf("the field %q is empty but required", "fieldMapping")
+	}
+	if _, err := url.ParseRequestURI(config.SsoUrl); err != nil {
+		return nil, errors.Wrapf(err, "invalid SSO URL %q", config.SsoUrl)
+	}
+
+	certificates, err := parseCertificates(config.Certificate)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid certificate")
+	}
+	spCertificates, err := parseCertificates(config.SpCertificate)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid service provider certificate")
+	}
+	spPrivateKey, err := parsePrivateKey(config.SpPrivateKey)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid service provider private key")
+	}
+	if !spPrivateKey.PublicKey.Equal(spCertificates[0].PublicKey) {
+		return nil, errors.New("the service provider private key doesn't match the certificate")
+	}
+
+	return &IdentityProvider{
+		config:             config,
+		identityProviderID: identityProviderID,
+		entityID:           GetMetadataURL(externalURL, identityProviderID),
+		acsURL:             GetAssertionConsumerServiceURL(externalURL, identityProviderID),
+		certificates:       certificates,
+		spPrivateKey:       spPrivateKey,
+		spCertificate:      spCertificates[0],
+		cache:              cache,
+		now:                time.Now,
+	}, nil
+}
+
+// Metadata returns the service provider metadata to be imported by the identity provider.
+func (p *IdentityProvider) Metadata() ([]byte, error) {
+	doc := etree.NewDocument()
+	doc.CreateProcInst("xml", `version="1.0" encoding="UTF-8"`)
+	entityDescriptor := doc.CreateElement("md:EntityDescriptor")
+	entityDescriptor.CreateAttr("xmlns:md", metadataNamespace)
+	entityDescriptor.CreateAttr("xmlns:ds", signatureNamespace)
+	entityDescriptor.CreateAttr("entityID", p.entityID)
+
+	spSSODescriptor := entityDescriptor.CreateElement("md:SPSSODescriptor")
+	spSSODescriptor.CreateAttr("AuthnRequestsSigned", "true")
+	spSSODescriptor.CreateAttr("protocolSupportEnumeration", protocolNamespace)
+	keyDescriptor := spSSODescriptor.CreateElement("md:KeyDescriptor")
+	keyDescriptor.CreateAttr("use", "signing")
+	keyDescriptor.CreateElement("ds:KeyInfo").CreateElement("ds:X509Data").CreateElement("ds:X509Certificate").SetText(base64.StdEncoding.EncodeToString(p.spCertificate.Raw))
+	spSSODescriptor.CreateElement("md:NameIDFormat").SetText(nameIDFormatUnspecified)
+	assertionConsumerService := spSSODescriptor.CreateElement("md:AssertionConsumerService")
+	assertionConsumerService.CreateAttr("Binding", httpPostBinding)
+	assertionConsumerService.CreateAttr("Location", p.acsURL)
+	assertionConsumerService.CreateAttr("index", "0")
+	assertionConsumerService.CreateAttr("isDefault", "true")
+
+	doc.Indent(2)
+	return doc.WriteToBytes()
+}
+
+// AuthnRequestURL returns the URL redirecting the user to the identity provider with the signed
+// authentication request in the HTTP-Redirect binding. The request is recorded in the cache, and
+// only the response to it with the same relay state is accepted.
+//
+// Docs: https://docs.oasis-open.org/security/saml/v2.0/saml-bindings-2.0-os.pdf, section 3.4.4.1.
+func (p *IdentityProvider) AuthnRequestURL(relayState string) (string, error) {
+	id, err := newID()
+	if err != nil {
+		return "", err
+	}
+	doc := etree.NewDocument()
+	authnRequest := doc.CreateElement("samlp:AuthnRequest")
+	authnRequest.CreateAttr("xmlns:samlp", protocolNamespace)
+	authnRequest.CreateAttr("xmlns:saml", assertionNamespace)
+	authnRequest.CreateAttr("ID", id)
+	authnRequest.CreateAttr("Version", "2.0")
+	authnRequest.CreateAttr("IssueInstant", p.now().UTC().Format(time.RFC3339))
+	authnRequest.CreateAttr("Destination", p.config.SsoUrl)
+	authnRequest.CreateAttr("AssertionConsumerServiceURL", p.acsURL)
+	authnRequest.CreateAttr("ProtocolBinding", httpPostBinding)
+	authnRequest.CreateElement("saml:Issuer").SetText(p.entityID)
+	nameIDPolicy := authnRequest.CreateElement("samlp:NameIDPolicy")
+	nameIDPolicy.CreateAttr("Format", nameIDFormatUnspecified)
+	nameIDPolicy.CreateAttr("AllowCreate", "true")
+	requestBytes, err := doc.WriteToBytes()
+	if err != nil {
+		return "", errors.Wrap(err, "failed to write authentication request")
+	}
+
+	var compressed bytes.Buffer
+	writer, err := flate.NewWriter(&compressed, flate.DefaultCompression)
+	if err != nil {
+		return "", err
+	}
+	if _, err := writer.Write(requestBytes); err != nil {
+		return "", errors.Wrap(err, "failed to compress authentication request")
+	}
+	if err := writer.Close(); err != nil {
+		return "", errors.Wrap(err, "failed to compress authentication request")
+	}
+
+	signingContext, err := dsig.NewSigningContext(p.spPrivateKey, [][]byte{p.spCertificate.Raw})
+	if err != nil {
+		return "", err
+	}
+	// The signature covers the query string in this exact order.
+	query := "SAMLRequest=" + url.QueryEscape(base64.StdEncoding.EncodeToString(compressed.Bytes()))
+	if relayState != "" {
+		query += "&RelayState=" + url.QueryEscape(relayState)
+	}
+	query += "&SigAlg=" + url.QueryEscape(signingContext.GetSignatureMethodIdentifier())
+	signature, err := signingContext.SignString(query)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to sign authentication request")
+	}
+	query += "&Signature=" + url.QueryEscape(base64.StdEncoding.EncodeToString(signature))
+	p.cache.addRequest(id, p.identityProviderID, relayState)
+
+	separator := "?"
+	if strings.Contains(p.config.SsoUrl, "?") {
+		separator = "&"
+	}
+	return p.config.SsoUrl + separator + query, nil
+}
+
+// UserInfo validates the base64 encoded SAML response posted by the identity provider and returns
+// the user info from the assertion. Either the response or the assertion must be signed by the
+// identity provider certificate. Only the signed content is read. The response must be in response
+// to an outstanding authentication request with the same relay state, and each assertion is accepted once.
+func (p *IdentityProvider) UserInfo(samlResponse, relayState string) (*storepb.IdentityProviderUserInfo, error) {
+	raw, err := base64.StdEncoding.DecodeString(samlResponse)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to decode SAML response")
+	}
+	doc := etree.NewDocument()
+	if err := doc.ReadFromBytes(raw); err != nil {
+		return nil, errors.Wrap(err, "failed to parse SAML response")
+	}
+	response := doc.Root()
+	if response == nil || response.Tag != "Response" || response.NamespaceURI() != protocolNamespace {
+		return nil, errors.New("the SAML response is not a Response element")
+	}
+
+	now := p.now()
+	validationContext := dsig.NewDefaultValidationContext(&dsig.MemoryX509CertificateStore{
+		Roots: p.certificates,
+	})
+	validationContext.Clock = dsig.NewFakeClockAt(now)
+
+	signed := false
+	if hasSignature(response) {
+		response, err = validationContext.Validate(response)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to validate the signature of the SAML response")
+		}
+		signed = true
+	}
+	if destination := response.SelectAttrValue("Destination", ""); destination != "" && destination != p.acsURL {
+		return nil, errors.Errorf("unexpected destination %q", destination)
+	}
+	statusCode := findChild(findChild(response, protocolNamespace, "Status"), protocolNamespace, "StatusCode")
+	if statusCode == nil || statusCode.SelectAttrValue("Value", "") != statusSuccess {
+		return nil, errors.New("the SAML response status is not success")
+	}
+	if findChild(response, assertionNamespace, "EncryptedAssertion") != nil {
+		return nil, errors.New("the encrypted assertion is not supported")
+	}
+
+	var assertions []*etree.Element
+	responseCtx, err := etreeutils.NSBuildParentContext(response)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to build namespace context")
+	}
+	if err := etreeutils.NSFindChildrenIterateCtx(responseCtx, response, assertionNamespace, "Assertion", func(ctx etreeutils.NSContext, el *etree.Element) error {
+		// Detach the assertion with the namespaces declared by the ancestors so that its signature can be validated alone.
+		detached, err := etreeutils.NSDetatch(ctx, el)
+		if err != nil {
+			return err
+		}
+		assertions = append(assertions, detached)
+		return nil
+	}); err != nil {
+		return nil, errors.Wrap(err, "failed to find assertion")
+	}
+	if len(assertions) != 1 {
+		return nil, errors.Errorf("expect exactly one assertion but found %d", len(assertions))
+	}
+	assertion := assertions[0]
+	if hasSignature(assertion) {
+		assertion, err = validationContext.Validate(assertion)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to validate the signature of the assertion")
+		}
+		signed = true
+	}
+	if !signed {
+		return nil, errors.New("neither the SAML response nor the assertion is signed")
+	}
+
+	subjectConfirmationData, err := p.validateAssertion(assertion, now)
+	if err != nil {
+		return nil, err
+	}
+	userInfo, err := p.getUserInfo(assertion)
+	if err != nil {
+		return nil, err
+	}
+
+	// The InResponseTo of the subject confirmation is read since it's always covered by the signature.
+	inResponseTo := subjectConfirmationData.SelectAttrValue("InResponseTo", "")
+	if inResponseTo == "" {
+		return nil, errors.New("the unsolicited SAML response is not supported")
+	}
+	if v := response.SelectAttrValue("InResponseTo", ""); v != "" && v != inResponseTo {
+		return nil, errors.Errorf("the response InResponseTo %q doesn't match the assertion", v)
+	}
+	assertionID := assertion.SelectAttrValue("ID", "")
+	if assertionID == "" {
+		return nil, errors.New("the assertion ID is missing")
+	}
+	// validateAssertion has validated NotOnOrAfter.
+	notOnOrAfter, _ := time.Parse(time.RFC3339, subjectConfirmationData.SelectAttrValue("NotOnOrAfter", ""))
+	if err := p.cache.consume(p.identityProviderID, inResponseTo, relayState, assertionID, notOnOrAfter.Add(maxClockSkew)); err != nil {
+		return nil, err
+	}
+	return userInfo, nil
+}
+
+// validateAssertion validates the issuer, the audience and the time conditions of the assertion.
+// It returns the bearer subject confirmation data.
+func (p *IdentityProvider) validateAssertion(assertion *etree.Element, now time.Time) (*etree.Element, error) {
+	issuer := findChild(assertion, assertionNamespace, "Issuer")
+	if issuer == nil || strings.TrimSpace(issuer.Text()) != p.config.EntityId {
+		return nil, errors.Errorf("the assertion isn't issued by %q", p.config.EntityId)
+	}
+
+	conditions := findChild(assertion, assertionNamespace, "Conditions")
+	if conditions == nil {
+		return nil, errors.New("the assertion conditions are missing")
+	}
+	if err := validateTimeRange(conditions, now); err != nil {
+		return nil, errors.Wrap(err, "invalid assertion conditions")
+	}
+	audienceRestriction := findChild(conditions, assertionNamespace, "AudienceRestriction")
+	if audienceRestriction == nil {
+		return nil, errors.New("the assertion audience restriction is missing")
+	}
+	audienceMatched := false
+	for _, audience := range audienceRestriction.ChildElements() {
+		if audience.Tag == "Audience" && strings.TrimSpace(audience.Text()) == p.entityID {
+			audienceMatched = true
+			break
+		}
+	}
+	if !audienceMatched {
+		return nil, errors.Errorf("the assertion audience doesn't contain %q", p.entityID)
+	}
+
+	subject := findChild(assertion, assertionNamespace, "Subject")
+	if subject == nil {
+		return nil, errors.New("the assertion subject is missing")
+	}
+	for _, subjectConfirmation := range subject.ChildElements() {
+		if subjectConfirmation.Tag != "SubjectConfirmation" || subjectConfirmation.SelectAttrValue("Method", "") != bearerConfirmationMethod {
+			continue
+		}
+		data := findChild(subjectConfirmation, assertionNamespace, "SubjectConfirmationData")
+		if data == nil || data.SelectAttrValue("NotOnOrAfter", "") == "" {
+			return nil, errors.New("the bearer subject confirmation must have NotOnOrAfter")
+		}
+		if err := validateTimeRange(data, now); err != nil {
+			return nil, errors.Wrap(err, "invalid subject confirmation")
+		}
+		if recipient := data.SelectAttrValue("Recipient", ""); recipient != "" && recipient != p.acsURL {
+			return nil, errors.Errorf("unexpected subject confirmation recipient %q", recipient)
+		}
+		return data, nil
+	}
+	return nil, errors.New("the bearer subject confirmation is missing")
+}
+
+func (p *IdentityProvider) getUserInfo(assertion *etree.Element) (*storepb.IdentityProviderUserInfo, error) {
+	attributes := make(map[string]string)
+	if statement := findChild(assertion, assertionNamespace, "AttributeStatement"); statement != nil {
+		for _, attribute := range statement.ChildElements() {
+			if attribute.Tag != "Attribute" {
+				continue
+			}
+			value := findChild(attribute, assertionNamespace, "AttributeValue")
+			if value == nil {
+				continue
+			}
+			// The attribute can be mapped by either the name or the friendly name.
+			for _, name := range []string{attribute.SelectAttrValue("Name", ""), attribute.SelectAttrValue("FriendlyName", "")} {
+				if _, ok := attributes[name]; name != "" && !ok {
+					attributes[name] = strings.TrimSpace(value.Text())
+				}
+			}
+		}
+	}
+
+	fieldMapping := p.config.FieldMapping
+	userInfo := &storepb.IdentityProviderUserInfo{}
+	if fieldMapping.Identifier != "" {
+		userInfo.Identifier = attributes[fieldMapping.Identifier]
+	} else if nameID := findChild(findChild(assertion, assertionNamespace, "Subject"), assertionNamespace, "NameID"); nameID != nil {
+		userInfo.Identifier = strings.TrimSpace(nameID.Text())
+	}
+	if userInfo.Identifier == "" {
+		return nil, errors.New("the identifier is not found in the assertion")
+	}
+	if fieldMapping.DisplayName != "" {
+		userInfo.DisplayName = attributes[fieldMapping.DisplayName]
+	}
+	if userInfo.DisplayName == "" {
+		userInfo.DisplayName = userInfo.Identifier
+	}
+	if fieldMapping.Email == fieldMapping.Identifier {
+		// The identifier is the email, including the NameID if neither is mapped.
+		userInfo.Email = userInfo.Identifier
+	} else if fieldMapping.Email != "" {
+		userInfo.Email = attributes[fieldMapping.Email]
+	}
+	return userInfo, nil
+}
+
+// validateTimeRange validates the NotBefore and NotOnOrAfter attributes of the element with the clock skew.
+func validateTimeRange(el *etree.Element, now time.Time) error {
+	if v := el.SelectAttrValue("NotBefore", ""); v != "" {
+		notBefore, err := time.Parse(time.RFC3339, v)
+		if err != nil {
+			return errors.Wrapf(err, "invalid NotBefore %q", v)
+		}
+		if now.Add(maxClockSkew).Before(notBefore) {
+			return errors.Errorf("not valid before %s", v)
+		}
+	}
+	if v := el.SelectAttrValue("NotOnOrAfter", ""); v != "" {
+		notOnOrAfter, err := time.Parse(time.RFC3339, v)
+		if err != nil {
+			return errors.Wrapf(err, "invalid NotOnOrAfter %q", v)
+		}
+		if !now.Add(-maxClockSkew).Before(notOnOrAfter) {
+			return errors.Errorf("expired at %s", v)
+		}
+	}
+	return nil
+}
+
+// findChild returns the first child element of the tag in the namespace, or nil if it's not found.
+func findChild(el *etree.Element, namespace, tag string) *etree.Element {
+	if el == nil {
+		return nil
+	}
+	// The namespace prefixes may be declared by the ancestors.
+	ctx, err := etreeutils.NSBuildParentContext(el)
+	if err != nil {
+		return nil
+	}
+	child, err := etreeutils.NSFindOneChildCtx(ctx, el, namespace, tag)
+	if err != nil {
+		return nil
+	}
+	return child
+}
+
+func hasSignature(el *etree.Element) bool {
+	return findChild(el, signatureNamespace, "Signature") != nil
+}
+
+func parseCertificates(data string) ([]*x509.Certificate, error) {
+	var certificates []*x509.Certificate
+	rest := []byte(data)
+	for {
+		var block *pem.Block
+		block, rest = pem.Decode(rest)
+		if block == nil {
+			break
+		}
+		if block.Type != "CERTIFICATE" {
+			continue
+		}
+		certificate, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, err
+		}
+		certificates = append(certificates, certificate)
+	}
+	if len(certificates) == 0 {
+		return nil, errors.New("no PEM encoded certificate found")
+	}
+	return certificates, nil
+}
+
+func parsePrivateKey(data string) (*rsa.PrivateKey, error) {
+	block, _ := pem.Decode([]byte(data))
+	if block == nil {
+		return nil, errors.New("no PEM encoded private key found")
+	}
+	if key, err := x509.ParsePKCS1PrivateKey(block.Bytes); err == nil {
+		return key, nil
+	}
+	key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, err
+	}
+	rsaKey, ok := key.(*rsa.PrivateKey)
+	if !ok {
+		return nil, errors.New("only the RSA private key is supported")
+	}
+	return rsaKey, nil
+}
+
+// newID returns a random ID of the request. The ID must start with a letter as an xsd:ID.
+func newID() (string, error) {
+	s, err := newRandomString()
+	if err != nil {
+		return "", errors.Wrap(err, "failed to generate request ID")
+	}
+	return "id-" + s, nil
+}
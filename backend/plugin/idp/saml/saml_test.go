@@ -0,0 +1,486 @@
+package saml
+
+import (
+	"bytes"
+	"compress/flate"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/pem"
+	"fmt"
+	"io"
+	"math/big"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/beevik/etree"
+	dsig "github.com/russellhaering/goxmldsig"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+const (
+	testExternalURL = "https://bytebase.example.com"
+	testIDPEntityID = "https://idp.example.com/metadata"
+)
+
+var testNow = time.Date(2023, 10, 1, 12, 0, 0, 0, time.UTC)
+
+type testKeyPair struct {
+	key            *rsa.PrivateKey
+	certificate    []byte
+	certificatePEM string
+	privateKeyPEM  string
+}
+
+func newTestKeyPair(t *testing.T) *testKeyPair {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "test"},
+		NotBefore:    testNow.Add(-time.Hour),
+		NotAfter:     testNow.Add(time.Hour),
+	}
+	certificate, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+	return &testKeyPair{
+		key:            key,
+		certificate:    certificate,
+		certificatePEM: string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: certificate})),
+		privateKeyPEM:  string(pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)})),
+	}
+}
+
+func newTestCache() *Cache {
+	cache := NewCache()
+	cache.now = func() time.Time { return testNow }
+	return cache
+}
+
+func newTestIdentityProvider(t *testing.T, idpKeyPair *testKeyPair, fieldMapping *storepb.FieldMapping) (*IdentityProvider, *testKeyPair) {
+	spKeyPair := newTestKeyPair(t)
+	identityProvider, err := NewIdentityProvider(&storepb.SAMLIdentityProviderConfig{
+		SsoUrl:        "https://idp.example.com/sso",
+		EntityId:      testIDPEntityID,
+		Certificate:   idpKeyPair.certificatePEM,
+		SpPrivateKey:  spKeyPair.privateKeyPEM,
+		SpCertificate: spKeyPair.certificatePEM,
+		FieldMapping:  fieldMapping,
+	}, testExternalURL, "okta", newTestCache())
+	require.NoError(t, err)
+	identityProvider.now = func() time.Time { return testNow }
+	return identityProvider, spKeyPair
+}
+
+// testResponse is the options to build the SAML response as an identity provider.
+type testResponse struct {
+	inResponseTo      string
+	audience          string
+	notOnOrAfter      time.Time
+	signResponse      bool
+	signAssertion     bool
+	signer            *testKeyPair
+	email             string
+	extraAssertion    bool
+	tamperAfterSigned bool
+}
+
+func buildResponse(t *testing.T, r testResponse) string {
+	acsURL := GetAssertionConsumerServiceURL(testExternalURL, "okta")
+	doc := etree.NewDocument()
+	response := doc.CreateElement("samlp:Response")
+	response.CreateAttr("xmlns:samlp", protocolNamespace)
+	response.CreateAttr("xmlns:saml", assertionNamespace)
+	response.CreateAttr("ID", "response-id")
+	response.CreateAttr("Version", "2.0")
+	response.CreateAttr("Destination", acsURL)
+	if r.inResponseTo != "" {
+		response.CreateAttr("InResponseTo", r.inResponseTo)
+	}
+	response.CreateElement("saml:Issuer").SetText(testIDPEntityID)
+	response.CreateElement("samlp:Status").CreateElement("samlp:StatusCode").CreateAttr("Value", statusSuccess)
+
+	assertion := etree.NewElement("saml:Assertion")
+	assertion.CreateAttr("xmlns:saml", assertionNamespace)
+	assertion.CreateAttr("ID", "assertion-id")
+	assertion.CreateAttr("Version", "2.0")
+	assertion.CreateElement("saml:Issuer").SetText(testIDPEntityID)
+	subject := assertion.CreateElement("saml:Subject")
+	subject.CreateElement("saml:NameID").SetText("alice@example.com")
+	subjectConfirmation := subject.CreateElement("saml:SubjectConfirmation")
+	subjectConfirmation.CreateAttr("Method", bearerConfirmationMethod)
+	subjectConfirmationData := subjectConfirmation.CreateElement("saml:SubjectConfirmationData")
+	subjectConfirmationData.CreateAttr("NotOnOrAfter", r.notOnOrAfter.Format(time.RFC3339))
+	subjectConfirmationData.CreateAttr("Recipient", acsURL)
+	if r.inResponseTo != "" {
+		subjectConfirmationData.CreateAttr("InResponseTo", r.inResponseTo)
+	}
+	conditions := assertion.CreateElement("saml:Conditions")
+	conditions.CreateAttr("NotBefore", testNow.Add(-time.Minute).Format(time.RFC3339))
+	conditions.CreateAttr("NotOnOrAfter", r.notOnOrAfter.Format(time.RFC3339))
+	conditions.CreateElement("saml:AudienceRestriction").CreateElement("saml:Audience").SetText(r.audience)
+	attributeStatement := assertion.CreateElement("saml:AttributeStatement")
+	for name, value := range map[string]string{
+		"uid":         "alice",
+		"displayName": "Alice",
+		"email":       r.email,
+	} {
+		attribute := attributeStatement.CreateElement("saml:Attribute")
+		attribute.CreateAttr("Name", name)
+		attribute.CreateElement("saml:AttributeValue").SetText(value)
+	}
+
+	signingContext, err := dsig.NewSigningContext(r.signer.key, [][]byte{r.signer.certificate})
+	require.NoError(t, err)
+	signingContext.Canonicalizer = dsig.MakeC14N10ExclusiveCanonicalizerWithPrefixList("")
+	if r.signAssertion {
+		assertion, err = signingContext.SignEnveloped(assertion)
+		require.NoError(t, err)
+	}
+	response.AddChild(assertion)
+	if r.extraAssertion {
+		// The forged assertion is unsigned and placed next to the signed one.
+		forged := assertion.Copy()
+		if signature := forged.SelectElement("Signature"); signature != nil {
+			forged.RemoveChild(signature)
+		}
+		response.AddChild(forged)
+	}
+	if r.signResponse {
+		signed, err := signingContext.SignEnveloped(response)
+		require.NoError(t, err)
+		doc.SetRoot(signed)
+	}
+	if r.tamperAfterSigned {
+		for _, value := range doc.FindElements("//AttributeValue") {
+			if value.Text() == r.email {
+				value.SetText("mallory@example.com")
+			}
+		}
+	}
+
+	b, err := doc.WriteToBytes()
+	require.NoError(t, err)
+	return base64.StdEncoding.EncodeToString(b)
+}
+
+func TestNewIdentityProvider(t *testing.T) {
+	idpKeyPair := newTestKeyPair(t)
+	spKeyPair := newTestKeyPair(t)
+	otherKeyPair := newTestKeyPair(t)
+	tests := []struct {
+		name        string
+		config      *storepb.SAMLIdentityProviderConfig
+		containsErr string
+	}{
+		{
+			name: "no SSO URL",
+			config: &storepb.SAMLIdentityProviderConfig{
+				EntityId:      testIDPEntityID,
+				Certificate:   idpKeyPair.certificatePEM,
+				SpPrivateKey:  spKeyPair.privateKeyPEM,
+				SpCertificate: spKeyPair.certificatePEM,
+				FieldMapping:  &storepb.FieldMapping{},
+			},
+			containsErr: `the field "ssoUrl" is empty but required`,
+		},
+		{
+			name: "invalid certificate",
+			config: &storepb.SAMLIdentityProviderConfig{
+				SsoUrl:        "https://idp.example.com/sso",
+				EntityId:      testIDPEntityID,
+				Certificate:   "certificate",
+				SpPrivateKey:  spKeyPair.privateKeyPEM,
+				SpCertificate: spKeyPair.certificatePEM,
+				FieldMapping:  &storepb.FieldMapping{},
+			},
+			containsErr: "invalid certificate",
+		},
+		{
+			name: "mismatched service provider key pair",
+			config: &storepb.SAMLIdentityProviderConfig{
+				SsoUrl:        "https://idp.example.com/sso",
+				EntityId:      testIDPEntityID,
+				Certificate:   idpKeyPair.certificatePEM,
+				SpPrivateKey:  otherKeyPair.privateKeyPEM,
+				SpCertificate: spKeyPair.certificatePEM,
+				FieldMapping:  &storepb.FieldMapping{},
+			},
+			containsErr: "doesn't match the certificate",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := NewIdentityProvider(test.config, testExternalURL, "okta", NewCache())
+			require.ErrorContains(t, err, test.containsErr)
+		})
+	}
+}
+
+func TestMetadata(t *testing.T) {
+	identityProvider, _ := newTestIdentityProvider(t, newTestKeyPair(t), &storepb.FieldMapping{})
+	metadata, err := identityProvider.Metadata()
+	require.NoError(t, err)
+
+	doc := etree.NewDocument()
+	require.NoError(t, doc.ReadFromBytes(metadata))
+	assert.Equal(t, "https://bytebase.example.com/saml/metadata/okta", doc.Root().SelectAttrValue("entityID", ""))
+	assertionConsumerService := doc.FindElement("//AssertionConsumerService")
+	require.NotNil(t, assertionConsumerService)
+	assert.Equal(t, "https://bytebase.example.com/saml/acs/okta", assertionConsumerService.SelectAttrValue("Location", ""))
+	assert.Equal(t, httpPostBinding, assertionConsumerService.SelectAttrValue("Binding", ""))
+}
+
+func TestAuthnRequestURL(t *testing.T) {
+	identityProvider, spKeyPair := newTestIdentityProvider(t, newTestKeyPair(t), &storepb.FieldMapping{})
+	authnRequestURL, err := identityProvider.AuthnRequestURL("idps/okta")
+	require.NoError(t, err)
+
+	u, err := url.Parse(authnRequestURL)
+	require.NoError(t, err)
+	assert.Equal(t, "https://idp.example.com/sso", u.Scheme+"://"+u.Host+u.Path)
+	query := u.Query()
+	assert.Equal(t, "idps/okta", query.Get("RelayState"))
+	assert.Equal(t, dsig.RSASHA256SignatureMethod, query.Get("SigAlg"))
+
+	// Verify the signature over the raw query without the signature.
+	signedQuery := u.RawQuery[:strings.Index(u.RawQuery, "&Signature=")]
+	signature, err := base64.StdEncoding.DecodeString(query.Get("Signature"))
+	require.NoError(t, err)
+	digest := sha256.Sum256([]byte(signedQuery))
+	require.NoError(t, rsa.VerifyPKCS1v15(&spKeyPair.key.PublicKey, crypto.SHA256, digest[:], signature))
+
+	compressed, err := base64.StdEncoding.DecodeString(query.Get("SAMLRequest"))
+	require.NoError(t, err)
+	request, err := io.ReadAll(flate.NewReader(bytes.NewReader(compressed)))
+	require.NoError(t, err)
+	doc := etree.NewDocument()
+	require.NoError(t, doc.ReadFromBytes(request))
+	authnRequest := doc.Root()
+	assert.Equal(t, "AuthnRequest", authnRequest.Tag)
+	assert.Equal(t, "https://bytebase.example.com/saml/acs/okta", authnRequest.SelectAttrValue("AssertionConsumerServiceURL", ""))
+	assert.Equal(t, "https://bytebase.example.com/saml/metadata/okta", authnRequest.FindElement("./Issuer").Text())
+	// The request is recorded for validating the response.
+	recorded, ok := identityProvider.cache.requests[authnRequest.SelectAttrValue("ID", "")]
+	require.True(t, ok)
+	assert.Equal(t, "okta", recorded.identityProviderID)
+	assert.Equal(t, "idps/okta", recorded.relayState)
+}
+
+func TestUserInfo(t *testing.T) {
+	idpKeyPair := newTestKeyPair(t)
+	otherKeyPair := newTestKeyPair(t)
+	identityProvider, _ := newTestIdentityProvider(t, idpKeyPair, &storepb.FieldMapping{
+		Identifier:  "uid",
+		DisplayName: "displayName",
+		Email:       "email",
+	})
+	valid := testResponse{
+		inResponseTo:  "request-id",
+		audience:      "https://bytebase.example.com/saml/metadata/okta",
+		notOnOrAfter:  testNow.Add(5 * time.Minute),
+		signAssertion: true,
+		signer:        idpKeyPair,
+		email:         "alice@example.com",
+	}
+	want := &storepb.IdentityProviderUserInfo{
+		Identifier:  "alice",
+		DisplayName: "Alice",
+		Email:       "alice@example.com",
+	}
+
+	tests := []struct {
+		name        string
+		modify      func(r *testResponse)
+		containsErr string
+	}{
+		{
+			name:   "signed assertion",
+			modify: func(*testResponse) {},
+		},
+		{
+			name: "signed response",
+			modify: func(r *testResponse) {
+				r.signAssertion = false
+				r.signResponse = true
+			},
+		},
+		{
+			name: "signed response and assertion",
+			modify: func(r *testResponse) {
+				r.signResponse = true
+			},
+		},
+		{
+			name: "unsigned",
+			modify: func(r *testResponse) {
+				r.signAssertion = false
+			},
+			containsErr: "neither the SAML response nor the assertion is signed",
+		},
+		{
+			name: "signed by untrusted certificate",
+			modify: func(r *testResponse) {
+				r.signer = otherKeyPair
+			},
+			containsErr: "failed to validate the signature of the assertion",
+		},
+		{
+			name: "tampered after signed",
+			modify: func(r *testResponse) {
+				r.tamperAfterSigned = true
+			},
+			containsErr: "failed to validate the signature of the assertion",
+		},
+		{
+			name: "wrong audience",
+			modify: func(r *testResponse) {
+				r.audience = "https://other.example.com"
+			},
+			containsErr: "the assertion audience doesn't contain",
+		},
+		{
+			name: "expired",
+			modify: func(r *testResponse) {
+				r.notOnOrAfter = testNow.Add(-5 * time.Minute)
+			},
+			containsErr: "expired at",
+		},
+		{
+			name: "extra unsigned assertion",
+			modify: func(r *testResponse) {
+				r.extraAssertion = true
+			},
+			containsErr: "expect exactly one assertion but found 2",
+		},
+		{
+			name: "unsolicited",
+			modify: func(r *testResponse) {
+				r.inResponseTo = ""
+			},
+			containsErr: "the unsolicited SAML response is not supported",
+		},
+		{
+			name: "unknown request",
+			modify: func(r *testResponse) {
+				r.inResponseTo = "other-request-id"
+			},
+			containsErr: "isn't in response to an outstanding authentication request",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			identityProvider.cache = newTestCache()
+			identityProvider.cache.addRequest("request-id", "okta", "idps/okta")
+			r := valid
+			test.modify(&r)
+			userInfo, err := identityProvider.UserInfo(buildResponse(t, r), "idps/okta")
+			if test.containsErr != "" {
+				require.ErrorContains(t, err, test.containsErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, want, userInfo)
+		})
+	}
+}
+
+func TestUserInfoNameID(t *testing.T) {
+	idpKeyPair := newTestKeyPair(t)
+	identityProvider, _ := newTestIdentityProvider(t, idpKeyPair, &storepb.FieldMapping{})
+	identityProvider.cache.addRequest("request-id", "okta", "")
+	userInfo, err := identityProvider.UserInfo(buildResponse(t, testResponse{
+		inResponseTo:  "request-id",
+		audience:      "https://bytebase.example.com/saml/metadata/okta",
+		notOnOrAfter:  testNow.Add(5 * time.Minute),
+		signAssertion: true,
+		signer:        idpKeyPair,
+		email:         "alice@example.com",
+	}), "")
+	require.NoError(t, err)
+	assert.Equal(t, &storepb.IdentityProviderUserInfo{
+		Identifier:  "alice@example.com",
+		DisplayName: "alice@example.com",
+		Email:       "alice@example.com",
+	}, userInfo)
+}
+
+func TestUserInfoReplay(t *testing.T) {
+	idpKeyPair := newTestKeyPair(t)
+	identityProvider, _ := newTestIdentityProvider(t, idpKeyPair, &storepb.FieldMapping{})
+	response := buildResponse(t, testResponse{
+		inResponseTo:  "request-id",
+		audience:      "https://bytebase.example.com/saml/metadata/okta",
+		notOnOrAfter:  testNow.Add(5 * time.Minute),
+		signAssertion: true,
+		signer:        idpKeyPair,
+		email:         "alice@example.com",
+	})
+
+	// The relay state must match the authentication request.
+	identityProvider.cache.addRequest("request-id", "okta", "idps/okta")
+	_, err := identityProvider.UserInfo(response, "idps/other")
+	require.ErrorContains(t, err, "the relay state doesn't match the authentication request")
+
+	_, err = identityProvider.UserInfo(response, "idps/okta")
+	require.NoError(t, err)
+	// The assertion can't be used again even if there is another outstanding request with the same ID.
+	identityProvider.cache.addRequest("request-id", "okta", "idps/okta")
+	_, err = identityProvider.UserInfo(response, "idps/okta")
+	require.ErrorContains(t, err, `the assertion "assertion-id" has been used`)
+}
+
+func TestExchangeCode(t *testing.T) {
+	now := testNow
+	cache := NewCache()
+	cache.now = func() time.Time { return now }
+	userInfo := &storepb.IdentityProviderUserInfo{Identifier: "alice"}
+
+	code, err := cache.CreateCode("okta", userInfo)
+	require.NoError(t, err)
+	_, err = cache.ExchangeCode("other", code)
+	require.ErrorContains(t, err, "the code isn't issued for the identity provider")
+	// The code is consumed on the failed exchange.
+	_, err = cache.ExchangeCode("okta", code)
+	require.ErrorContains(t, err, "the code is invalid or used")
+
+	code, err = cache.CreateCode("okta", userInfo)
+	require.NoError(t, err)
+	got, err := cache.ExchangeCode("okta", code)
+	require.NoError(t, err)
+	assert.Equal(t, userInfo, got)
+	_, err = cache.ExchangeCode("okta", code)
+	require.ErrorContains(t, err, "the code is invalid or used")
+
+	code, err = cache.CreateCode("okta", userInfo)
+	require.NoError(t, err)
+	now = now.Add(codeTTL)
+	_, err = cache.ExchangeCode("okta", code)
+	require.ErrorContains(t, err, "the code is expired")
+}
+
+func TestAddRequestEvictsOldest(t *testing.T) {
+	now := testNow
+	cache := NewCache()
+	cache.now = func() time.Time { return now }
+
+	// The sign-in isn't blocked by the outstanding requests, the oldest one is evicted instead.
+	for i := 0; i < maxCacheEntries; i++ {
+		cache.addRequest(fmt.Sprintf("request-%d", i), "okta", "")
+		now = now.Add(time.Millisecond)
+	}
+	cache.addRequest("request-new", "okta", "")
+	require.Len(t, cache.requests, maxCacheEntries)
+	require.NotContains(t, cache.requests, "request-0")
+	require.Contains(t, cache.requests, "request-1")
+	require.Contains(t, cache.requests, "request-new")
+}
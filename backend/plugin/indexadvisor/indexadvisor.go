@@ -0,0 +1,442 @@
+// Package indexadvisor is a deterministic index advisor.
+// It extracts the predicate, join and ORDER BY columns of queries and proposes composite indexes
+// based on the synced table and index metadata, without any external service.
+package indexadvisor
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	parser "github.com/bytebase/bytebase/backend/plugin/parser/sql"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+const (
+	// maxIndexColumns is the maximum column count of a proposed composite index.
+	maxIndexColumns = 4
+	// maxIndexNameLength is the shorter one of the identifier length limits of MySQL and PostgreSQL.
+	maxIndexNameLength = 63
+)
+
+// SlowQuery is a slow query fingerprint with its aggregated statistics.
+type SlowQuery struct {
+	Fingerprint string
+	// Samples are the sample statements of the fingerprint.
+	// They're preferred over the fingerprint because the fingerprint may not be parsable.
+	Samples           []string
+	Count             int64
+	TotalQueryTime    time.Duration
+	TotalRowsExamined int64
+	TotalRowsSent     int64
+}
+
+// Recommendation is a proposed composite index.
+type Recommendation struct {
+	Schema  string
+	Table   string
+	Columns []string
+	// CurrentIndex is the existing index serving the longest prefix of the columns, or empty if there's none.
+	CurrentIndex         string
+	CreateIndexStatement string
+	// Fingerprints are the slow query fingerprints benefiting from the index.
+	Fingerprints []string
+	QueryCount   int64
+	// TotalQueryTime is the total query time of the slow queries benefiting from the index.
+	TotalQueryTime time.Duration
+	// ReducibleRowsExamined is the number of rows examined but not returned by the slow queries benefiting from the index.
+	ReducibleRowsExamined int64
+}
+
+// FullScan is a large table a query has to scan fully because no index matches its predicates.
+type FullScan struct {
+	Schema   string
+	Table    string
+	RowCount int64
+	// Columns are the filtered columns of the table, empty if the query doesn't filter the table at all.
+	Columns []string
+}
+
+// IsEngineSupported returns true if the index advisor supports the engine.
+func IsEngineSupported(engine parser.EngineType) bool {
+	switch engine {
+	case parser.MySQL, parser.TiDB, parser.MariaDB, parser.OceanBase, parser.Postgres:
+		return true
+	default:
+		return false
+	}
+}
+
+// Advise proposes composite indexes for the slow queries, ordered by the total query time they may save.
+func Advise(engine parser.EngineType, metadata *storepb.DatabaseMetadata, queries []*SlowQuery) ([]*Recommendation, error) {
+	if !IsEngineSupported(engine) {
+		return nil, errors.Errorf("index advisor doesn't support engine %s", engine)
+	}
+	recommendationMap := make(map[string]*Recommendation)
+	for _, query := range queries {
+		statements := query.Samples
+		if len(statements) == 0 {
+			statements = []string{query.Fingerprint}
+		}
+		var accesses []*tableAccess
+		for _, statement := range statements {
+			list, err := extractTableAccessList(engine, metadata, statement)
+			if err != nil {
+				// The sample may be truncated or use a syntax the parser doesn't support, try the next one.
+				continue
+			}
+			accesses = list
+			break
+		}
+
+		for _, access := range accesses {
+			recommendation := newRecommendation(engine, metadata, access)
+			if recommendation == nil {
+				continue
+			}
+			key := recommendation.key()
+			if existing, ok := recommendationMap[key]; ok {
+				recommendation = existing
+			} else {
+				recommendationMap[key] = recommendation
+			}
+			recommendation.Fingerprints = appendUnique(recommendation.Fingerprints, query.Fingerprint)
+			recommendation.QueryCount += query.Count
+			recommendation.TotalQueryTime += query.TotalQueryTime
+			if reducible := query.TotalRowsExamined - query.TotalRowsSent; reducible > 0 {
+				recommendation.ReducibleRowsExamined += reducible
+			}
+		}
+	}
+
+	var recommendations []*Recommendation
+	for _, recommendation := range recommendationMap {
+		recommendations = append(recommendations, recommendation)
+	}
+	return mergeRecommendations(recommendations), nil
+}
+
+// AdviseStatement proposes composite indexes for a single statement.
+func AdviseStatement(engine parser.EngineType, metadata *storepb.DatabaseMetadata, statement string) ([]*Recommendation, error) {
+	if !IsEngineSupported(engine) {
+		return nil, errors.Errorf("index advisor doesn't support engine %s", engine)
+	}
+	accesses, err := extractTableAccessList(engine, metadata, statement)
+	if err != nil {
+		return nil, err
+	}
+	var recommendations []*Recommendation
+	for _, access := range accesses {
+		if recommendation := newRecommendation(engine, metadata, access); recommendation != nil {
+			recommendations = append(recommendations, recommendation)
+		}
+	}
+	return mergeRecommendations(recommendations), nil
+}
+
+// CheckFullScan finds the tables with at least minRowCount rows which the statement doesn't filter, or filters without a usable index.
+func CheckFullScan(engine parser.EngineType, metadata *storepb.DatabaseMetadata, statement string, minRowCount int64) ([]*FullScan, error) {
+	if !IsEngineSupported(engine) {
+		return nil, errors.Errorf("index advisor doesn't support engine %s", engine)
+	}
+	accesses, err := extractTableAccessList(engine, metadata, statement)
+	if err != nil {
+		return nil, err
+	}
+	var result []*FullScan
+	for _, access := range accesses {
+		table := findTable(metadata, access.schema, access.table)
+		if table == nil || table.RowCount < minRowCount {
+			continue
+		}
+		var filtered []string
+		filtered = append(filtered, access.equality...)
+		filtered = append(filtered, access.ranges...)
+		if len(filtered) == 0 {
+			// The conditions that aren't indexable, e.g., OR, may still be served by the indexes, so only the unfiltered tables are reported.
+			if access.unfiltered {
+				result = append(result, &FullScan{
+					Schema:   access.schema,
+					Table:    access.table,
+					RowCount: table.RowCount,
+				})
+			}
+			continue
+		}
+		usable := false
+		for _, index := range table.Indexes {
+			if usablePrefixLength(index, access.equality, access.ranges, nil) > 0 {
+				usable = true
+				break
+			}
+		}
+		if !usable {
+			result = append(result, &FullScan{
+				Schema:   access.schema,
+				Table:    access.table,
+				RowCount: table.RowCount,
+				Columns:  filtered,
+			})
+		}
+	}
+	return result, nil
+}
+
+// tableAccess is how a query accesses a table.
+type tableAccess struct {
+	schema string
+	table  string
+	// equality are the columns compared with constants by equality or IN, and the join columns.
+	equality []string
+	// ranges are the columns compared with constants by range predicates.
+	ranges []string
+	// orderBy are the columns in the ORDER BY clause in order.
+	orderBy []string
+	// unfiltered is true if the query block has no condition at all, so the table is read fully.
+	unfiltered bool
+}
+
+func (a *tableAccess) addEquality(column string) {
+	a.equality = appendUnique(a.equality, column)
+}
+
+func (a *tableAccess) addRange(column string) {
+	a.ranges = appendUnique(a.ranges, column)
+}
+
+func (a *tableAccess) addOrderBy(column string) {
+	a.orderBy = appendUnique(a.orderBy, column)
+}
+
+// candidateColumns returns the composite index columns following the equality, sort, range rule.
+// The equality columns go first, then the ORDER BY columns to avoid sorting, and the first range column last
+// because an index can't be used for the columns after a range column.
+func (a *tableAccess) candidateColumns() []string {
+	var columns []string
+	for _, column := range a.equality {
+		columns = appendUnique(columns, column)
+	}
+	for _, column := range a.orderBy {
+		columns = appendUnique(columns, column)
+	}
+	for _, column := range a.ranges {
+		if !contains(columns, column) {
+			columns = append(columns, column)
+			break
+		}
+	}
+	if len(columns) > maxIndexColumns {
+		columns = columns[:maxIndexColumns]
+	}
+	return columns
+}
+
+func (r *Recommendation) key() string {
+	return fmt.Sprintf("%s.%s(%s)", r.Schema, r.Table, strings.Join(r.Columns, ","))
+}
+
+func newRecommendation(engine parser.EngineType, metadata *storepb.DatabaseMetadata, access *tableAccess) *Recommendation {
+	table := findTable(metadata, access.schema, access.table)
+	if table == nil {
+		return nil
+	}
+	columns := access.candidateColumns()
+	if len(columns) == 0 {
+		return nil
+	}
+
+	var currentIndex string
+	bestPrefix := 0
+	for _, index := range table.Indexes {
+		prefix := usablePrefixLength(index, access.equality, access.ranges, access.orderBy)
+		if prefix >= len(columns) {
+			// An existing index already serves the query.
+			return nil
+		}
+		if index.Unique && isUniqueLookup(index, access.equality) {
+			// At most one row matches, so there's nothing to improve.
+			return nil
+		}
+		if prefix > bestPrefix {
+			bestPrefix = prefix
+			currentIndex = index.Name
+		}
+	}
+
+	return &Recommendation{
+		Schema:               access.schema,
+		Table:                access.table,
+		Columns:              columns,
+		CurrentIndex:         currentIndex,
+		CreateIndexStatement: createIndexStatement(engine, access.schema, access.table, columns),
+	}
+}
+
+// usablePrefixLength returns the number of leading index columns usable by the query.
+// The equality columns match in any order, followed by the ORDER BY columns in order or a range column.
+func usablePrefixLength(index *storepb.IndexMetadata, equality, ranges, orderBy []string) int {
+	expressions := make([]string, 0, len(index.Expressions))
+	for _, expression := range index.Expressions {
+		expressions = append(expressions, normalizeIdentifier(expression))
+	}
+	i := 0
+	for i < len(expressions) && contains(equality, expressions[i]) {
+		i++
+	}
+	j := 0
+	for i < len(expressions) && j < len(orderBy) && expressions[i] == orderBy[j] {
+		i++
+		j++
+	}
+	if i < len(expressions) && contains(ranges, expressions[i]) {
+		i++
+	}
+	return i
+}
+
+// isUniqueLookup returns true if all the index columns are equality columns.
+func isUniqueLookup(index *storepb.IndexMetadata, equality []string) bool {
+	if len(index.Expressions) == 0 {
+		return false
+	}
+	for _, expression := range index.Expressions {
+		if !contains(equality, normalizeIdentifier(expression)) {
+			return false
+		}
+	}
+	return true
+}
+
+// mergeRecommendations merges the recommendations whose columns are a prefix of another recommendation on the same table,
+// and orders the result by the total query time descending.
+func mergeRecommendations(recommendations []*Recommendation) []*Recommendation {
+	sort.SliceStable(recommendations, func(i, j int) bool {
+		if len(recommendations[i].Columns) != len(recommendations[j].Columns) {
+			return len(recommendations[i].Columns) > len(recommendations[j].Columns)
+		}
+		return recommendations[i].key() < recommendations[j].key()
+	})
+	var result []*Recommendation
+	for _, recommendation := range recommendations {
+		merged := false
+		for _, target := range result {
+			if target.Schema != recommendation.Schema || target.Table != recommendation.Table || !isPrefix(recommendation.Columns, target.Columns) {
+				continue
+			}
+			for _, fingerprint := range recommendation.Fingerprints {
+				target.Fingerprints = appendUnique(target.Fingerprints, fingerprint)
+			}
+			target.QueryCount += recommendation.QueryCount
+			target.TotalQueryTime += recommendation.TotalQueryTime
+			target.ReducibleRowsExamined += recommendation.ReducibleRowsExamined
+			merged = true
+			break
+		}
+		if !merged {
+			result = append(result, recommendation)
+		}
+	}
+	sort.SliceStable(result, func(i, j int) bool {
+		if result[i].TotalQueryTime != result[j].TotalQueryTime {
+			return result[i].TotalQueryTime > result[j].TotalQueryTime
+		}
+		return result[i].key() < result[j].key()
+	})
+	return result
+}
+
+func createIndexStatement(engine parser.EngineType, schema, table string, columns []string) string {
+	name := fmt.Sprintf("idx_%s_%s", table, strings.Join(columns, "_"))
+	if len(name) > maxIndexNameLength {
+		name = name[:maxIndexNameLength]
+	}
+	var quotedColumns []string
+	if engine == parser.Postgres {
+		for _, column := range columns {
+			quotedColumns = append(quotedColumns, fmt.Sprintf("%q", column))
+		}
+		return fmt.Sprintf("CREATE INDEX CONCURRENTLY %q ON %q.%q (%s);", name, schema, table, strings.Join(quotedColumns, ", "))
+	}
+	for _, column := range columns {
+		quotedColumns = append(quotedColumns, fmt.Sprintf("`%s`", column))
+	}
+	return fmt.Sprintf("CREATE INDEX `%s` ON `%s` (%s);", name, table, strings.Join(quotedColumns, ", "))
+}
+
+func findTable(metadata *storepb.DatabaseMetadata, schemaName, tableName string) *storepb.TableMetadata {
+	if metadata == nil {
+		return nil
+	}
+	for _, schema := range metadata.Schemas {
+		if schema.Name != schemaName {
+			continue
+		}
+		for _, table := range schema.Tables {
+			if table.Name == tableName {
+				return table
+			}
+		}
+	}
+	return nil
+}
+
+// hasLikePrefix returns true if the LIKE pattern starts with a constant prefix.
+func hasLikePrefix(pattern string) bool {
+	return pattern != "" && pattern[0] != '%' && pattern[0] != '_'
+}
+
+func firstNonEmpty(list ...string) string {
+	for _, s := range list {
+		if s != "" {
+			return s
+		}
+	}
+	return ""
+}
+
+func lower(s string) string {
+	return strings.ToLower(s)
+}
+
+// normalizeIdentifier strips the quotes of the index expression so it's comparable with the column name.
+func normalizeIdentifier(identifier string) string {
+	identifier = strings.TrimSpace(identifier)
+	if len(identifier) >= 2 {
+		first, last := identifier[0], identifier[len(identifier)-1]
+		if (first == '"' && last == '"') || (first == '`' && last == '`') {
+			return identifier[1 : len(identifier)-1]
+		}
+	}
+	return identifier
+}
+
+func isPrefix(prefix, list []string) bool {
+	if len(prefix) > len(list) {
+		return false
+	}
+	for i := range prefix {
+		if prefix[i] != list[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func contains(list []string, s string) bool {
+	for _, item := range list {
+		if item == s {
+			return true
+		}
+	}
+	return false
+}
+
+func appendUnique(list []string, s string) []string {
+	if contains(list, s) {
+		return list
+	}
+	return append(list, s)
+}
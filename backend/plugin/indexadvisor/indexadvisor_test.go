@@ -0,0 +1,260 @@
+package indexadvisor
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	// Register pingcap parser driver.
+	_ "github.com/pingcap/tidb/types/parser_driver"
+
+	parser "github.com/bytebase/bytebase/backend/plugin/parser/sql"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+func newTestMetadata(schemaName string) *storepb.DatabaseMetadata {
+	column := func(names ...string) []*storepb.ColumnMetadata {
+		var columns []*storepb.ColumnMetadata
+		for _, name := range names {
+			columns = append(columns, &storepb.ColumnMetadata{Name: name})
+		}
+		return columns
+	}
+	return &storepb.DatabaseMetadata{
+		Name: "db",
+		Schemas: []*storepb.SchemaMetadata{
+			{
+				Name: schemaName,
+				Tables: []*storepb.TableMetadata{
+					{
+						Name:     "orders",
+						Columns:  column("id", "customer_id", "status", "created_at", "amount"),
+						RowCount: 1000000,
+						Indexes: []*storepb.IndexMetadata{
+							{Name: "PRIMARY", Expressions: []string{"id"}, Primary: true, Unique: true},
+						},
+					},
+					{
+						Name:     "customers",
+						Columns:  column("id", "email", "region"),
+						RowCount: 500000,
+						Indexes: []*storepb.IndexMetadata{
+							{Name: "PRIMARY", Expressions: []string{"id"}, Primary: true, Unique: true},
+							{Name: "idx_customers_email", Expressions: []string{"email"}},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestAdviseStatement(t *testing.T) {
+	tests := []struct {
+		engine    parser.EngineType
+		schema    string
+		statement string
+		want      []*Recommendation
+	}{
+		{
+			engine:    parser.MySQL,
+			statement: "SELECT * FROM orders WHERE customer_id = 1 AND created_at > '2023-01-01' ORDER BY status",
+			want: []*Recommendation{
+				{
+					Table:                "orders",
+					Columns:              []string{"customer_id", "status", "created_at"},
+					CreateIndexStatement: "CREATE INDEX `idx_orders_customer_id_status_created_at` ON `orders` (`customer_id`, `status`, `created_at`);",
+				},
+			},
+		},
+		{
+			engine:    parser.MySQL,
+			statement: "SELECT * FROM customers c JOIN orders o ON c.id = o.customer_id WHERE c.email = 'a@b.c' AND o.status IN ('paid', 'shipped')",
+			want: []*Recommendation{
+				{
+					Table:                "orders",
+					Columns:              []string{"customer_id", "status"},
+					CreateIndexStatement: "CREATE INDEX `idx_orders_customer_id_status` ON `orders` (`customer_id`, `status`);",
+				},
+			},
+		},
+		{
+			// The existing index serves the query.
+			engine:    parser.MySQL,
+			statement: "SELECT * FROM customers WHERE email = 'a@b.c'",
+			want:      nil,
+		},
+		{
+			// The leading wildcard LIKE can't use the index.
+			engine:    parser.MySQL,
+			statement: "SELECT * FROM customers WHERE region = 'eu' AND email LIKE '%@b.c'",
+			want: []*Recommendation{
+				{
+					Table:                "customers",
+					Columns:              []string{"region"},
+					CreateIndexStatement: "CREATE INDEX `idx_customers_region` ON `customers` (`region`);",
+				},
+			},
+		},
+		{
+			engine:    parser.Postgres,
+			schema:    "public",
+			statement: "SELECT * FROM orders o WHERE o.customer_id = $1 AND o.amount BETWEEN 1 AND 10 AND o.status IN (SELECT region FROM customers WHERE region = 'eu')",
+			want: []*Recommendation{
+				{
+					Schema:               "public",
+					Table:                "customers",
+					Columns:              []string{"region"},
+					CreateIndexStatement: `CREATE INDEX CONCURRENTLY "idx_customers_region" ON "public"."customers" ("region");`,
+				},
+				{
+					Schema:               "public",
+					Table:                "orders",
+					Columns:              []string{"customer_id", "status", "amount"},
+					CreateIndexStatement: `CREATE INDEX CONCURRENTLY "idx_orders_customer_id_status_amount" ON "public"."orders" ("customer_id", "status", "amount");`,
+				},
+			},
+		},
+		{
+			engine:    parser.Postgres,
+			schema:    "public",
+			statement: "UPDATE orders SET amount = 0 WHERE status = 'cancelled' AND created_at < now()",
+			want: []*Recommendation{
+				{
+					Schema:               "public",
+					Table:                "orders",
+					Columns:              []string{"status", "created_at"},
+					CreateIndexStatement: `CREATE INDEX CONCURRENTLY "idx_orders_status_created_at" ON "public"."orders" ("status", "created_at");`,
+				},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		got, err := AdviseStatement(test.engine, newTestMetadata(test.schema), test.statement)
+		require.NoError(t, err, test.statement)
+		require.Equal(t, test.want, got, test.statement)
+	}
+}
+
+func TestAdvise(t *testing.T) {
+	queries := []*SlowQuery{
+		{
+			Fingerprint:       "select * from orders where customer_id = ?",
+			Samples:           []string{"SELECT * FROM orders WHERE customer_id = 1"},
+			Count:             10,
+			TotalQueryTime:    10 * time.Second,
+			TotalRowsExamined: 10000,
+			TotalRowsSent:     100,
+		},
+		{
+			Fingerprint:       "select * from orders where customer_id = ? and status = ?",
+			Samples:           []string{"SELECT * FROM orders WHERE customer_id = 1 AND status = 'paid'"},
+			Count:             5,
+			TotalQueryTime:    20 * time.Second,
+			TotalRowsExamined: 5000,
+			TotalRowsSent:     50,
+		},
+		{
+			Fingerprint:       "select * from customers where region = ?",
+			Samples:           []string{"SELECT * FROM customers WHERE region = 'eu'"},
+			Count:             1,
+			TotalQueryTime:    time.Second,
+			TotalRowsExamined: 500000,
+			TotalRowsSent:     1000,
+		},
+		{
+			// The unparsable fingerprint is ignored.
+			Fingerprint: "select * from orders where",
+			Count:       100,
+		},
+	}
+	got, err := Advise(parser.MySQL, newTestMetadata(""), queries)
+	require.NoError(t, err)
+	require.Equal(t, []*Recommendation{
+		{
+			Table:                 "orders",
+			Columns:               []string{"customer_id", "status"},
+			CurrentIndex:          "",
+			CreateIndexStatement:  "CREATE INDEX `idx_orders_customer_id_status` ON `orders` (`customer_id`, `status`);",
+			Fingerprints:          []string{"select * from orders where customer_id = ? and status = ?", "select * from orders where customer_id = ?"},
+			QueryCount:            15,
+			TotalQueryTime:        30 * time.Second,
+			ReducibleRowsExamined: 14850,
+		},
+		{
+			Table:                 "customers",
+			Columns:               []string{"region"},
+			CreateIndexStatement:  "CREATE INDEX `idx_customers_region` ON `customers` (`region`);",
+			Fingerprints:          []string{"select * from customers where region = ?"},
+			QueryCount:            1,
+			TotalQueryTime:        time.Second,
+			ReducibleRowsExamined: 499000,
+		},
+	}, got)
+}
+
+func TestCheckFullScan(t *testing.T) {
+	tests := []struct {
+		engine    parser.EngineType
+		schema    string
+		statement string
+		want      []*FullScan
+	}{
+		{
+			engine:    parser.MySQL,
+			statement: "SELECT * FROM orders WHERE status = 'paid'",
+			want: []*FullScan{
+				{Table: "orders", RowCount: 1000000, Columns: []string{"status"}},
+			},
+		},
+		{
+			engine:    parser.MySQL,
+			statement: "SELECT * FROM orders WHERE id = 1",
+			want:      nil,
+		},
+		{
+			// No filter, the query reads the whole table.
+			engine:    parser.MySQL,
+			statement: "SELECT * FROM orders",
+			want: []*FullScan{
+				{Table: "orders", RowCount: 1000000},
+			},
+		},
+		{
+			engine:    parser.Postgres,
+			schema:    "public",
+			statement: "SELECT count(*) FROM customers",
+			want: []*FullScan{
+				{Schema: "public", Table: "customers", RowCount: 500000},
+			},
+		},
+		{
+			engine:    parser.Postgres,
+			schema:    "public",
+			statement: "SELECT * FROM customers WHERE email = 'a@b.c' OR region = 'eu'",
+			want:      nil,
+		},
+		{
+			engine:    parser.Postgres,
+			schema:    "public",
+			statement: "SELECT * FROM customers WHERE region = 'eu' AND email LIKE 'abc%'",
+			want:      nil,
+		},
+		{
+			engine:    parser.Postgres,
+			schema:    "public",
+			statement: "SELECT * FROM customers WHERE region = 'eu'",
+			want: []*FullScan{
+				{Schema: "public", Table: "customers", RowCount: 500000, Columns: []string{"region"}},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		got, err := CheckFullScan(test.engine, newTestMetadata(test.schema), test.statement, 100000)
+		require.NoError(t, err, test.statement)
+		require.Equal(t, test.want, got, test.statement)
+	}
+}
@@ -0,0 +1,179 @@
+package indexadvisor
+
+import (
+	tidbparser "github.com/pingcap/tidb/parser"
+	tidbast "github.com/pingcap/tidb/parser/ast"
+	"github.com/pingcap/tidb/parser/opcode"
+
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+func extractMySQLTableAccessList(metadata *storepb.DatabaseMetadata, statement string) ([]*tableAccess, error) {
+	p := tidbparser.New()
+	p.EnableWindowFunc(true)
+	nodes, _, err := p.Parse(statement, "", "")
+	if err != nil {
+		return nil, err
+	}
+	visitor := &mysqlQueryVisitor{metadata: metadata}
+	for _, node := range nodes {
+		node.Accept(visitor)
+	}
+	return visitor.result, nil
+}
+
+// mysqlQueryVisitor visits every query block, including the subqueries, and extracts its table accesses.
+type mysqlQueryVisitor struct {
+	metadata *storepb.DatabaseMetadata
+	result   []*tableAccess
+}
+
+// Enter implements the ast.Visitor interface.
+func (v *mysqlQueryVisitor) Enter(in tidbast.Node) (tidbast.Node, bool) {
+	switch node := in.(type) {
+	case *tidbast.SelectStmt:
+		v.extractQueryBlock(node.From, node.Where, node.OrderBy)
+	case *tidbast.UpdateStmt:
+		v.extractQueryBlock(node.TableRefs, node.Where, node.Order)
+	case *tidbast.DeleteStmt:
+		v.extractQueryBlock(node.TableRefs, node.Where, node.Order)
+	}
+	return in, false
+}
+
+// Leave implements the ast.Visitor interface.
+func (*mysqlQueryVisitor) Leave(in tidbast.Node) (tidbast.Node, bool) {
+	return in, true
+}
+
+func (v *mysqlQueryVisitor) extractQueryBlock(from *tidbast.TableRefsClause, where tidbast.ExprNode, orderBy *tidbast.OrderByClause) {
+	if from == nil || from.TableRefs == nil {
+		return
+	}
+	s := newScope(v.metadata)
+	var conditions []tidbast.ExprNode
+	v.addTableRefs(s, from.TableRefs, &conditions)
+	if where != nil {
+		conditions = append(conditions, where)
+	}
+	for _, condition := range conditions {
+		s.filtered = true
+		addMySQLCondition(s, condition)
+	}
+	if orderBy != nil {
+		for _, item := range orderBy.Items {
+			if column, ok := item.Expr.(*tidbast.ColumnNameExpr); ok {
+				s.addOrderBy(column.Name.Table.O, column.Name.Name.O)
+			}
+		}
+	}
+	v.result = append(v.result, s.result()...)
+}
+
+func (v *mysqlQueryVisitor) addTableRefs(s *scope, node tidbast.ResultSetNode, conditions *[]tidbast.ExprNode) {
+	switch node := node.(type) {
+	case *tidbast.Join:
+		if node.Left != nil {
+			v.addTableRefs(s, node.Left, conditions)
+		}
+		if node.Right != nil {
+			v.addTableRefs(s, node.Right, conditions)
+		}
+		if node.On != nil {
+			*conditions = append(*conditions, node.On.Expr)
+		}
+	case *tidbast.TableSource:
+		switch source := node.Source.(type) {
+		case *tidbast.TableName:
+			if source.Schema.O != "" && v.metadata != nil && source.Schema.L != lower(v.metadata.Name) {
+				// The table of other databases.
+				s.addDerivedTable(firstNonEmpty(node.AsName.O, source.Name.O))
+				return
+			}
+			s.addTable("", source.Name.O, node.AsName.O)
+		default:
+			s.addDerivedTable(node.AsName.O)
+		}
+	}
+}
+
+func addMySQLCondition(s *scope, expr tidbast.ExprNode) {
+	switch expr := expr.(type) {
+	case *tidbast.ParenthesesExpr:
+		addMySQLCondition(s, expr.Expr)
+	case *tidbast.BinaryOperationExpr:
+		switch expr.Op {
+		case opcode.LogicAnd:
+			addMySQLCondition(s, expr.L)
+			addMySQLCondition(s, expr.R)
+		case opcode.EQ, opcode.NullEQ:
+			left, leftIsColumn := expr.L.(*tidbast.ColumnNameExpr)
+			right, rightIsColumn := expr.R.(*tidbast.ColumnNameExpr)
+			switch {
+			case leftIsColumn && rightIsColumn:
+				s.addJoin(left.Name.Table.O, left.Name.Name.O, right.Name.Table.O, right.Name.Name.O)
+			case leftIsColumn && !mysqlHasColumn(expr.R):
+				s.addEquality(left.Name.Table.O, left.Name.Name.O)
+			case rightIsColumn && !mysqlHasColumn(expr.L):
+				s.addEquality(right.Name.Table.O, right.Name.Name.O)
+			}
+		case opcode.LT, opcode.LE, opcode.GT, opcode.GE:
+			if column, ok := expr.L.(*tidbast.ColumnNameExpr); ok && !mysqlHasColumn(expr.R) {
+				s.addRange(column.Name.Table.O, column.Name.Name.O)
+			} else if column, ok := expr.R.(*tidbast.ColumnNameExpr); ok && !mysqlHasColumn(expr.L) {
+				s.addRange(column.Name.Table.O, column.Name.Name.O)
+			}
+		}
+	case *tidbast.PatternInExpr:
+		if column, ok := expr.Expr.(*tidbast.ColumnNameExpr); ok && !expr.Not {
+			s.addEquality(column.Name.Table.O, column.Name.Name.O)
+		}
+	case *tidbast.BetweenExpr:
+		if column, ok := expr.Expr.(*tidbast.ColumnNameExpr); ok && !expr.Not {
+			s.addRange(column.Name.Table.O, column.Name.Name.O)
+		}
+	case *tidbast.IsNullExpr:
+		if column, ok := expr.Expr.(*tidbast.ColumnNameExpr); ok && !expr.Not {
+			s.addEquality(column.Name.Table.O, column.Name.Name.O)
+		}
+	case *tidbast.PatternLikeExpr:
+		column, ok := expr.Expr.(*tidbast.ColumnNameExpr)
+		if !ok || expr.Not {
+			return
+		}
+		// Only the LIKE with a constant prefix can use the index.
+		if pattern, ok := expr.Pattern.(tidbast.ValueExpr); ok && hasLikePrefix(pattern.GetString()) {
+			s.addRange(column.Name.Table.O, column.Name.Name.O)
+		}
+	}
+}
+
+// mysqlHasColumn returns true if the expression refers to any column, so it's not a constant.
+func mysqlHasColumn(expr tidbast.ExprNode) bool {
+	finder := &mysqlColumnFinder{}
+	expr.Accept(finder)
+	return finder.found
+}
+
+type mysqlColumnFinder struct {
+	found bool
+}
+
+// Enter implements the ast.Visitor interface.
+func (f *mysqlColumnFinder) Enter(in tidbast.Node) (tidbast.Node, bool) {
+	switch in.(type) {
+	case *tidbast.ColumnNameExpr:
+		f.found = true
+		return in, true
+	case *tidbast.SubqueryExpr:
+		// The subquery may be correlated, treat it as non-constant.
+		f.found = true
+		return in, true
+	}
+	return in, f.found
+}
+
+// Leave implements the ast.Visitor interface.
+func (*mysqlColumnFinder) Leave(in tidbast.Node) (tidbast.Node, bool) {
+	return in, true
+}
@@ -0,0 +1,307 @@
+package indexadvisor
+
+import (
+	pgquery "github.com/pganalyze/pg_query_go/v2"
+
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+const pgDefaultSchema = "public"
+
+func extractPostgresTableAccessList(metadata *storepb.DatabaseMetadata, statement string) ([]*tableAccess, error) {
+	tree, err := pgquery.Parse(statement)
+	if err != nil {
+		return nil, err
+	}
+	extractor := &pgQueryExtractor{metadata: metadata}
+	for _, stmt := range tree.Stmts {
+		extractor.extractNode(stmt.Stmt)
+	}
+	return extractor.result, nil
+}
+
+// pgQueryExtractor extracts the table accesses of every query block, including the subqueries.
+type pgQueryExtractor struct {
+	metadata *storepb.DatabaseMetadata
+	result   []*tableAccess
+}
+
+func (e *pgQueryExtractor) extractNode(node *pgquery.Node) {
+	if node == nil {
+		return
+	}
+	switch n := node.Node.(type) {
+	case *pgquery.Node_SelectStmt:
+		e.extractSelect(n.SelectStmt)
+	case *pgquery.Node_UpdateStmt:
+		s := newScope(e.metadata)
+		e.addRangeVar(s, n.UpdateStmt.Relation)
+		for _, item := range n.UpdateStmt.FromClause {
+			e.addFromItem(s, item, nil)
+		}
+		e.addCondition(s, n.UpdateStmt.WhereClause)
+		e.result = append(e.result, s.result()...)
+	case *pgquery.Node_DeleteStmt:
+		s := newScope(e.metadata)
+		e.addRangeVar(s, n.DeleteStmt.Relation)
+		for _, item := range n.DeleteStmt.UsingClause {
+			e.addFromItem(s, item, nil)
+		}
+		e.addCondition(s, n.DeleteStmt.WhereClause)
+		e.result = append(e.result, s.result()...)
+	case *pgquery.Node_ExplainStmt:
+		e.extractNode(n.ExplainStmt.Query)
+	}
+}
+
+func (e *pgQueryExtractor) extractSelect(stmt *pgquery.SelectStmt) {
+	if stmt == nil {
+		return
+	}
+	if stmt.WithClause != nil {
+		for _, cte := range stmt.WithClause.Ctes {
+			if c, ok := cte.Node.(*pgquery.Node_CommonTableExpr); ok {
+				e.extractNode(c.CommonTableExpr.Ctequery)
+			}
+		}
+	}
+	if stmt.Op != pgquery.SetOperation_SETOP_NONE && stmt.Op != pgquery.SetOperation_SET_OPERATION_UNDEFINED {
+		e.extractSelect(stmt.Larg)
+		e.extractSelect(stmt.Rarg)
+		return
+	}
+
+	s := newScope(e.metadata)
+	var conditions []*pgquery.Node
+	for _, item := range stmt.FromClause {
+		e.addFromItem(s, item, &conditions)
+	}
+	if stmt.WithClause != nil {
+		for _, cte := range stmt.WithClause.Ctes {
+			if c, ok := cte.Node.(*pgquery.Node_CommonTableExpr); ok {
+				// The CTE name shadows the table with the same name.
+				s.aliases[lower(c.CommonTableExpr.Ctename)] = nil
+			}
+		}
+	}
+	conditions = append(conditions, stmt.WhereClause)
+	for _, condition := range conditions {
+		e.addCondition(s, condition)
+	}
+	for _, item := range stmt.SortClause {
+		sortBy, ok := item.Node.(*pgquery.Node_SortBy)
+		if !ok {
+			continue
+		}
+		if qualifier, column, ok := pgColumnRef(sortBy.SortBy.Node); ok {
+			s.addOrderBy(qualifier, column)
+		}
+	}
+	e.result = append(e.result, s.result()...)
+}
+
+func (e *pgQueryExtractor) addRangeVar(s *scope, rangeVar *pgquery.RangeVar) {
+	if rangeVar == nil {
+		return
+	}
+	schemaName := rangeVar.Schemaname
+	if schemaName == "" {
+		schemaName = pgDefaultSchema
+	}
+	var alias string
+	if rangeVar.Alias != nil {
+		alias = rangeVar.Alias.Aliasname
+	}
+	if _, shadowed := s.aliases[lower(rangeVar.Relname)]; shadowed && rangeVar.Schemaname == "" {
+		return
+	}
+	s.addTable(schemaName, rangeVar.Relname, alias)
+}
+
+func (e *pgQueryExtractor) addFromItem(s *scope, node *pgquery.Node, conditions *[]*pgquery.Node) {
+	if node == nil {
+		return
+	}
+	switch n := node.Node.(type) {
+	case *pgquery.Node_RangeVar:
+		e.addRangeVar(s, n.RangeVar)
+	case *pgquery.Node_JoinExpr:
+		e.addFromItem(s, n.JoinExpr.Larg, conditions)
+		e.addFromItem(s, n.JoinExpr.Rarg, conditions)
+		if conditions != nil && n.JoinExpr.Quals != nil {
+			*conditions = append(*conditions, n.JoinExpr.Quals)
+		}
+	case *pgquery.Node_RangeSubselect:
+		e.extractNode(n.RangeSubselect.Subquery)
+		if n.RangeSubselect.Alias != nil {
+			s.addDerivedTable(n.RangeSubselect.Alias.Aliasname)
+		}
+	case *pgquery.Node_RangeFunction:
+		if n.RangeFunction.Alias != nil {
+			s.addDerivedTable(n.RangeFunction.Alias.Aliasname)
+		}
+	}
+}
+
+func (e *pgQueryExtractor) addCondition(s *scope, node *pgquery.Node) {
+	if node == nil {
+		return
+	}
+	s.filtered = true
+	switch n := node.Node.(type) {
+	case *pgquery.Node_BoolExpr:
+		if n.BoolExpr.Boolop == pgquery.BoolExprType_AND_EXPR {
+			for _, arg := range n.BoolExpr.Args {
+				e.addCondition(s, arg)
+			}
+			return
+		}
+		e.extractSubLinks(n.BoolExpr.Args...)
+	case *pgquery.Node_AExpr:
+		e.addAExpr(s, n.AExpr)
+	case *pgquery.Node_NullTest:
+		if n.NullTest.Nulltesttype == pgquery.NullTestType_IS_NULL {
+			if qualifier, column, ok := pgColumnRef(n.NullTest.Arg); ok {
+				s.addEquality(qualifier, column)
+			}
+		}
+	case *pgquery.Node_SubLink:
+		if qualifier, column, ok := pgColumnRef(n.SubLink.Testexpr); ok && n.SubLink.SubLinkType == pgquery.SubLinkType_ANY_SUBLINK {
+			// column IN (SELECT ...)
+			s.addEquality(qualifier, column)
+		}
+		e.extractNode(n.SubLink.Subselect)
+	}
+}
+
+func (e *pgQueryExtractor) addAExpr(s *scope, expr *pgquery.A_Expr) {
+	defer e.extractSubLinks(expr.Lexpr, expr.Rexpr)
+
+	leftQualifier, leftColumn, leftIsColumn := pgColumnRef(expr.Lexpr)
+	rightQualifier, rightColumn, rightIsColumn := pgColumnRef(expr.Rexpr)
+	switch expr.Kind {
+	case pgquery.A_Expr_Kind_AEXPR_OP:
+		switch pgOperatorName(expr) {
+		case "=":
+			switch {
+			case leftIsColumn && rightIsColumn:
+				s.addJoin(leftQualifier, leftColumn, rightQualifier, rightColumn)
+			case leftIsColumn && pgIsConstant(expr.Rexpr):
+				s.addEquality(leftQualifier, leftColumn)
+			case rightIsColumn && pgIsConstant(expr.Lexpr):
+				s.addEquality(rightQualifier, rightColumn)
+			}
+		case "<", "<=", ">", ">=":
+			if leftIsColumn && pgIsConstant(expr.Rexpr) {
+				s.addRange(leftQualifier, leftColumn)
+			} else if rightIsColumn && pgIsConstant(expr.Lexpr) {
+				s.addRange(rightQualifier, rightColumn)
+			}
+		}
+	case pgquery.A_Expr_Kind_AEXPR_IN:
+		if leftIsColumn && pgOperatorName(expr) == "=" {
+			s.addEquality(leftQualifier, leftColumn)
+		}
+	case pgquery.A_Expr_Kind_AEXPR_BETWEEN, pgquery.A_Expr_Kind_AEXPR_BETWEEN_SYM:
+		if leftIsColumn {
+			s.addRange(leftQualifier, leftColumn)
+		}
+	case pgquery.A_Expr_Kind_AEXPR_LIKE:
+		if !leftIsColumn || pgOperatorName(expr) != "~~" {
+			return
+		}
+		// Only the LIKE with a constant prefix can use the index.
+		if pattern, ok := pgStringConstant(expr.Rexpr); ok && hasLikePrefix(pattern) {
+			s.addRange(leftQualifier, leftColumn)
+		}
+	}
+}
+
+// extractSubLinks extracts the subqueries in the expressions as separate query blocks.
+func (e *pgQueryExtractor) extractSubLinks(nodes ...*pgquery.Node) {
+	for _, node := range nodes {
+		if node == nil {
+			continue
+		}
+		if n, ok := node.Node.(*pgquery.Node_SubLink); ok {
+			e.extractNode(n.SubLink.Subselect)
+		}
+	}
+}
+
+func pgOperatorName(expr *pgquery.A_Expr) string {
+	if len(expr.Name) == 0 {
+		return ""
+	}
+	if s, ok := expr.Name[len(expr.Name)-1].Node.(*pgquery.Node_String_); ok {
+		return s.String_.Str
+	}
+	return ""
+}
+
+// pgColumnRef returns the qualifier and the column name of the column reference.
+func pgColumnRef(node *pgquery.Node) (string, string, bool) {
+	if node == nil {
+		return "", "", false
+	}
+	// The casted column isn't a column reference, because the index can't be used unless there's an expression index.
+	ref, ok := node.Node.(*pgquery.Node_ColumnRef)
+	if !ok {
+		return "", "", false
+	}
+	var fields []string
+	for _, field := range ref.ColumnRef.Fields {
+		s, ok := field.Node.(*pgquery.Node_String_)
+		if !ok {
+			return "", "", false
+		}
+		fields = append(fields, s.String_.Str)
+	}
+	switch len(fields) {
+	case 1:
+		return "", fields[0], true
+	case 2:
+		return fields[0], fields[1], true
+	case 3:
+		// schema.table.column
+		return fields[1], fields[2], true
+	default:
+		return "", "", false
+	}
+}
+
+func pgIsConstant(node *pgquery.Node) bool {
+	if node == nil {
+		return false
+	}
+	switch n := node.Node.(type) {
+	case *pgquery.Node_AConst, *pgquery.Node_ParamRef:
+		return true
+	case *pgquery.Node_TypeCast:
+		return pgIsConstant(n.TypeCast.Arg)
+	case *pgquery.Node_FuncCall:
+		// Functions of constants such as now() are constant during the query.
+		for _, arg := range n.FuncCall.Args {
+			if !pgIsConstant(arg) {
+				return false
+			}
+		}
+		return true
+	}
+	return false
+}
+
+func pgStringConstant(node *pgquery.Node) (string, bool) {
+	if node == nil {
+		return "", false
+	}
+	switch n := node.Node.(type) {
+	case *pgquery.Node_AConst:
+		if s, ok := n.AConst.Val.Node.(*pgquery.Node_String_); ok {
+			return s.String_.Str, true
+		}
+	case *pgquery.Node_TypeCast:
+		return pgStringConstant(n.TypeCast.Arg)
+	}
+	return "", false
+}
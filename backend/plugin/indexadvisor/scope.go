@@ -0,0 +1,161 @@
+package indexadvisor
+
+import (
+	"strings"
+
+	"github.com/pkg/errors"
+
+	parser "github.com/bytebase/bytebase/backend/plugin/parser/sql"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+// scope is the tables of a query block, which resolves the column references to the table accesses.
+type scope struct {
+	metadata *storepb.DatabaseMetadata
+	accesses []*tableAccess
+	// aliases maps the lower-cased alias or table name to the table access.
+	// The value is nil if the name refers to an unknown table or a derived table.
+	aliases map[string]*tableAccess
+	tables  map[*tableAccess]*storepb.TableMetadata
+	// filtered is true if the query block has any WHERE or join condition, even one that isn't indexable.
+	filtered bool
+}
+
+func newScope(metadata *storepb.DatabaseMetadata) *scope {
+	return &scope{
+		metadata: metadata,
+		aliases:  make(map[string]*tableAccess),
+		tables:   make(map[*tableAccess]*storepb.TableMetadata),
+	}
+}
+
+// addTable adds a table to the scope. The unknown tables are recorded, so the columns referring to them aren't misresolved.
+func (s *scope) addTable(schemaName, tableName, alias string) {
+	name := alias
+	if name == "" {
+		name = tableName
+	}
+	access, table := s.newTableAccess(schemaName, tableName)
+	if access != nil {
+		s.accesses = append(s.accesses, access)
+		s.tables[access] = table
+	}
+	s.aliases[strings.ToLower(name)] = access
+}
+
+func (s *scope) addDerivedTable(alias string) {
+	if alias != "" {
+		s.aliases[strings.ToLower(alias)] = nil
+	}
+}
+
+func (s *scope) newTableAccess(schemaName, tableName string) (*tableAccess, *storepb.TableMetadata) {
+	if s.metadata == nil {
+		return nil, nil
+	}
+	for _, schema := range s.metadata.Schemas {
+		if !strings.EqualFold(schema.Name, schemaName) {
+			continue
+		}
+		for _, table := range schema.Tables {
+			if strings.EqualFold(table.Name, tableName) {
+				return &tableAccess{schema: schema.Name, table: table.Name}, table
+			}
+		}
+	}
+	return nil, nil
+}
+
+// resolve returns the table access and the column name in the metadata of the column reference.
+func (s *scope) resolve(qualifier, column string) (*tableAccess, string) {
+	if qualifier != "" {
+		access, ok := s.aliases[strings.ToLower(qualifier)]
+		if !ok || access == nil {
+			return nil, ""
+		}
+		name, ok := lookupColumn(s.tables[access], column)
+		if !ok {
+			return nil, ""
+		}
+		return access, name
+	}
+
+	var result *tableAccess
+	var resultName string
+	for _, access := range s.accesses {
+		name, ok := lookupColumn(s.tables[access], column)
+		if !ok {
+			continue
+		}
+		if result != nil {
+			// Ambiguous column reference.
+			return nil, ""
+		}
+		result, resultName = access, name
+	}
+	return result, resultName
+}
+
+func (s *scope) addEquality(qualifier, column string) {
+	if access, name := s.resolve(qualifier, column); access != nil {
+		access.addEquality(name)
+	}
+}
+
+func (s *scope) addRange(qualifier, column string) {
+	if access, name := s.resolve(qualifier, column); access != nil {
+		access.addRange(name)
+	}
+}
+
+func (s *scope) addOrderBy(qualifier, column string) {
+	if access, name := s.resolve(qualifier, column); access != nil {
+		access.addOrderBy(name)
+	}
+}
+
+// addJoin adds the join columns as the equality columns of both sides, because either side may be probed by the index.
+func (s *scope) addJoin(leftQualifier, leftColumn, rightQualifier, rightColumn string) {
+	left, leftName := s.resolve(leftQualifier, leftColumn)
+	right, rightName := s.resolve(rightQualifier, rightColumn)
+	if left == right {
+		return
+	}
+	if left != nil {
+		left.addEquality(leftName)
+	}
+	if right != nil {
+		right.addEquality(rightName)
+	}
+}
+
+// result returns the table accesses, including the ones without any indexable column.
+func (s *scope) result() []*tableAccess {
+	for _, access := range s.accesses {
+		access.unfiltered = !s.filtered
+	}
+	return s.accesses
+}
+
+func lookupColumn(table *storepb.TableMetadata, column string) (string, bool) {
+	if table == nil {
+		return "", false
+	}
+	for _, c := range table.Columns {
+		if strings.EqualFold(c.Name, column) {
+			return c.Name, true
+		}
+	}
+	return "", false
+}
+
+func extractTableAccessList(engine parser.EngineType, metadata *storepb.DatabaseMetadata, statement string) ([]*tableAccess, error) {
+	switch engine {
+	case parser.MySQL, parser.TiDB, parser.MariaDB, parser.OceanBase:
+		return extractMySQLTableAccessList(metadata, statement)
+	case parser.Postgres:
+		return extractPostgresTableAccessList(metadata, statement)
+	default:
+		return nil, errors.Errorf("index advisor doesn't support engine %s", engine)
+	}
+}
@@ -12,6 +12,10 @@ import (
 // Configuration is the root element of mybatis configuration xml file.
 type Configuration struct {
 	Environments []Environment
+	// TypeAliases is the map of the type alias, key is the alias, value is the type.
+	TypeAliases map[string]string
+	// TypeAliasPackages is the packages whose types are aliased by the simple type name.
+	TypeAliasPackages []string
 }
 
 // Environment is the element of environments in mybatis configuration xml file.
@@ -20,10 +24,37 @@ type Environment struct {
 	JDBCConnString string
 }
 
+// ResolveTypeAliases returns the type aliases for the given types, key is the alias, value is the type.
+// Besides the aliases declared by <typeAlias>, the types in the packages declared by <package> are aliased
+// by the simple type name.
+func (c *Configuration) ResolveTypeAliases(types []string) map[string]string {
+	result := make(map[string]string)
+	for _, typ := range types {
+		idx := strings.LastIndex(typ, ".")
+		if idx < 0 {
+			continue
+		}
+		for _, pkg := range c.TypeAliasPackages {
+			if typ[:idx] == pkg {
+				result[typ[idx+1:]] = typ
+			}
+		}
+	}
+	// The declared aliases take precedence.
+	for alias, typ := range c.TypeAliases {
+		result[alias] = typ
+	}
+	return result
+}
+
 // ParseConfiguration parses the mybatis configuration xml file likes below:
 //
 // <configuration>
 //
+//	 <typeAliases>
+//	   <typeAlias alias="Author" type="domain.blog.Author"/>
+//	   <package name="domain.blog"/>
+//	 </typeAliases>
 //	 <environments default="development">
 //	   <environment id="development">
 //	     ...
@@ -44,25 +75,35 @@ func ParseConfiguration(configurationXML string) (*Configuration, error) {
 			} `xml:"dataSource>property"`
 		} `xml:"environment"`
 	}
+	type TypeAliases struct {
+		TypeAlias []struct {
+			Alias string `xml:"alias,attr"`
+			Type  string `xml:"type,attr"`
+		} `xml:"typeAlias"`
+		Package []struct {
+			Name string `xml:"name,attr"`
+		} `xml:"package"`
+	}
 
+	var conf Configuration
 	reader := strings.NewReader(configurationXML)
 	d := xml.NewDecoder(reader)
 	for {
 		token, err := d.Token()
 		if err != nil {
 			if err == io.EOF {
-				return nil, nil
+				return &conf, nil
 			}
 			return nil, errors.Wrapf(err, "failed to read token")
 		}
 		switch t := token.(type) {
 		case xml.StartElement:
-			if t.Name.Local == "environments" {
+			switch t.Name.Local {
+			case "environments":
 				var environments Environments
 				if err := d.DecodeElement(&environments, &t); err != nil {
 					return nil, errors.Wrapf(err, "failed to decode environments")
 				}
-				var conf Configuration
 				for _, environment := range environments.Environment {
 					for _, property := range environment.Properties {
 						if property.Name == "url" {
@@ -73,7 +114,30 @@ func ParseConfiguration(configurationXML string) (*Configuration, error) {
 						}
 					}
 				}
-				return &conf, nil
+			case "typeAliases":
+				var typeAliases TypeAliases
+				if err := d.DecodeElement(&typeAliases, &t); err != nil {
+					return nil, errors.Wrapf(err, "failed to decode type aliases")
+				}
+				for _, typeAlias := range typeAliases.TypeAlias {
+					if typeAlias.Type == "" {
+						continue
+					}
+					alias := typeAlias.Alias
+					if alias == "" {
+						// The type is aliased by the simple type name if the alias is omitted.
+						alias = typeAlias.Type[strings.LastIndex(typeAlias.Type, ".")+1:]
+					}
+					if conf.TypeAliases == nil {
+						conf.TypeAliases = make(map[string]string)
+					}
+					conf.TypeAliases[alias] = typeAlias.Type
+				}
+				for _, pkg := range typeAliases.Package {
+					if pkg.Name != "" {
+						conf.TypeAliasPackages = append(conf.TypeAliasPackages, pkg.Name)
+					}
+				}
 			}
 		default:
 		}
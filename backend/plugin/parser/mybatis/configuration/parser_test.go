@@ -63,3 +63,60 @@ func TestExtractEnvironmentIDs(t *testing.T) {
 		require.Equal(t, tc.want, got)
 	}
 }
+
+func TestParseTypeAliases(t *testing.T) {
+	testCases := []struct {
+		configuration string
+		want          *Configuration
+		types         []string
+		wantAliases   map[string]string
+	}{
+		{
+			configuration: `
+<?xml version="1.0" encoding="UTF-8" ?>
+<!DOCTYPE configuration
+	PUBLIC "-//mybatis.org//DTD Config 3.0//EN"
+	"https://mybatis.org/dtd/mybatis-3-config.dtd">
+<configuration>
+	<typeAliases>
+		<typeAlias alias="Author" type="domain.blog.Author"/>
+		<typeAlias type="domain.blog.mapper.CommentMapper"/>
+		<package name="domain.blog.mapper"/>
+	</typeAliases>
+	<environments default="prod">
+	<environment id="prod">
+		<dataSource type="POOLED">
+			<property name="url" value="jdbc:mysql://localhost:3306/test"/>
+		</dataSource>
+	</environment>
+	</environments>
+</configuration>
+`,
+			want: &Configuration{
+				Environments: []Environment{
+					{
+						ID:             "prod",
+						JDBCConnString: "jdbc:mysql://localhost:3306/test",
+					},
+				},
+				TypeAliases: map[string]string{
+					"Author":        "domain.blog.Author",
+					"CommentMapper": "domain.blog.mapper.CommentMapper",
+				},
+				TypeAliasPackages: []string{"domain.blog.mapper"},
+			},
+			types: []string{"domain.blog.mapper.BlogMapper", "domain.blog.mapper.sub.PostMapper", "BlogMapper"},
+			wantAliases: map[string]string{
+				"Author":        "domain.blog.Author",
+				"CommentMapper": "domain.blog.mapper.CommentMapper",
+				"BlogMapper":    "domain.blog.mapper.BlogMapper",
+			},
+		},
+	}
+	for _, tc := range testCases {
+		got, err := ParseConfiguration(tc.configuration)
+		require.NoError(t, err)
+		require.Equal(t, tc.want, got)
+		require.Equal(t, tc.wantAliases, got.ResolveTypeAliases(tc.types))
+	}
+}
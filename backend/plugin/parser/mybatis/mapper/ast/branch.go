@@ -0,0 +1,51 @@
+// Package ast defines the abstract syntax tree of mybatis mapper xml.
+package ast
+
+// branchEnumerator enumerates the branch combinations of the dynamic SQL elements in depth-first order.
+// The query is restored once for each combination, and the dynamic SQL elements call choose in the
+// restoring order, so the branches of the elements dropped by the outer elements are never enumerated.
+type branchEnumerator struct {
+	// choices is the chosen option of the branches in the restoring order.
+	choices []int
+	// options is the option count of the branches in the restoring order.
+	options []int
+	// pos is the position of the next branch in the current restoring.
+	pos int
+	// preferLast chooses the last option of every branch, which drops all the optional elements.
+	preferLast bool
+}
+
+// choose returns the chosen option of the next branch which has the given number of options.
+func (e *branchEnumerator) choose(options int) int {
+	if e.preferLast {
+		return options - 1
+	}
+	if e.pos < len(e.choices) {
+		choice := e.choices[e.pos]
+		e.options[e.pos] = options
+		e.pos++
+		return choice
+	}
+	e.choices = append(e.choices, 0)
+	e.options = append(e.options, options)
+	e.pos++
+	return 0
+}
+
+// next moves to the next branch combination, returns false if all the combinations are enumerated.
+func (e *branchEnumerator) next() bool {
+	if e.preferLast {
+		return false
+	}
+	// The branches after pos are not reached in the last restoring.
+	e.choices, e.options = e.choices[:e.pos], e.options[:e.pos]
+	e.pos = 0
+	for i := len(e.choices) - 1; i >= 0; i-- {
+		if e.choices[i]+1 < e.options[i] {
+			e.choices[i]++
+			e.choices, e.options = e.choices[:i+1], e.options[:i+1]
+			return true
+		}
+	}
+	return false
+}
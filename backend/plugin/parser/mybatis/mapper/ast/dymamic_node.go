@@ -46,6 +46,10 @@ func NewIfNode(startElement *xml.StartElement) *IfNode {
 
 // RestoreSQL implements Node interface, the if condition will be ignored.
 func (n *IfNode) RestoreSQL(ctx *RestoreContext, w io.Writer) error {
+	if ctx.branches != nil && ctx.branches.choose(2) == 1 {
+		// Drop the optional element.
+		return nil
+	}
 	if len(n.Children) > 0 {
 		if _, err := w.Write([]byte(" ")); err != nil {
 			return err
@@ -89,6 +93,21 @@ func NewChooseNode(_ *xml.StartElement) *ChooseNode {
 
 // RestoreSQL implements Node interface.
 func (n *ChooseNode) RestoreSQL(ctx *RestoreContext, w io.Writer) error {
+	if ctx.branches != nil {
+		options := len(n.Children)
+		if !n.hasOtherwise() {
+			// Nothing is chosen if all the when conditions are false.
+			options++
+		}
+		choice := ctx.branches.choose(options)
+		if choice >= len(n.Children) {
+			return nil
+		}
+		if _, err := w.Write([]byte(" ")); err != nil {
+			return err
+		}
+		return n.Children[choice].RestoreSQL(ctx, w)
+	}
 	if len(n.Children) > 0 {
 		if _, err := w.Write([]byte(" ")); err != nil {
 			return err
@@ -102,6 +121,15 @@ func (n *ChooseNode) RestoreSQL(ctx *RestoreContext, w io.Writer) error {
 	return nil
 }
 
+func (n *ChooseNode) hasOtherwise() bool {
+	for _, child := range n.Children {
+		if _, ok := child.(*OtherwiseNode); ok {
+			return true
+		}
+	}
+	return false
+}
+
 func (*ChooseNode) isChildAcceptable(child Node) bool {
 	switch child.(type) {
 	case *WhenNode, *OtherwiseNode:
@@ -583,7 +611,7 @@ func (n *IncludeNode) RestoreSQL(ctx *RestoreContext, w io.Writer) error {
 		return ctx.Variable[name]
 	})
 
-	sqlNode, ok := ctx.SQLMap[refID]
+	sqlNode, ok := ctx.lookupSQL(refID)
 	if !ok {
 		return errors.Errorf("refID %s not found", n.RefID)
 	}
@@ -600,6 +628,12 @@ func (n *IncludeNode) RestoreSQL(ctx *RestoreContext, w io.Writer) error {
 	if err != nil {
 		return err
 	}
+
+	// Unset all the properties.
+	for _, propertyNode := range n.PropertyChildren {
+		delete(ctx.Variable, propertyNode.Name)
+	}
+
 	trimmed := strings.TrimSpace(sqlString)
 	if len(trimmed) == 0 {
 		return nil
@@ -610,12 +644,6 @@ func (n *IncludeNode) RestoreSQL(ctx *RestoreContext, w io.Writer) error {
 	if _, err := w.Write([]byte(trimmed)); err != nil {
 		return err
 	}
-
-	// Unset all the properties.
-	for _, propertyNode := range n.PropertyChildren {
-		delete(ctx.Variable, propertyNode.Name)
-	}
-
 	return nil
 }
 
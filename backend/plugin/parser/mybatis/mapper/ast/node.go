@@ -4,6 +4,7 @@ package ast
 import (
 	"io"
 	"sort"
+	"strings"
 )
 
 // Node is the interface implemented by all AST node types.
@@ -28,6 +29,44 @@ type RestoreContext struct {
 	SQLLastLineToOriginalLineMapping map[int]int
 	// CurrentLastLine is used for internal calculation.
 	CurrentLastLine int
+	// TypeAliases is the map of type alias in mybatis configuration, key is the lower case alias, value is the type.
+	// It will be used to resolve the namespace of the fully qualified refid of the <include> element.
+	TypeAliases map[string]string
+
+	// branches decides the branch of the dynamic SQL elements, all the branches are restored if it is nil.
+	branches *branchEnumerator
+}
+
+// lookupSQL returns the <sql> element referred by the refID, which may be qualified by the namespace or the type alias of the namespace.
+func (ctx *RestoreContext) lookupSQL(refID string) (*SQLNode, bool) {
+	if sqlNode, ok := ctx.SQLMap[refID]; ok {
+		return sqlNode, true
+	}
+	idx := strings.LastIndex(refID, ".")
+	if idx < 0 {
+		return nil, false
+	}
+	namespace, ok := ctx.TypeAliases[strings.ToLower(refID[:idx])]
+	if !ok {
+		return nil, false
+	}
+	sqlNode, ok := ctx.SQLMap[namespace+refID[idx:]]
+	return sqlNode, ok
+}
+
+// lineMapping returns the sorted(SQL last line ascending) line mapping of the restored SQL statements.
+func (ctx *RestoreContext) lineMapping() []*MybatisSQLLineMapping {
+	var lineMapping []*MybatisSQLLineMapping
+	for sqlLastLine, originalEleLine := range ctx.SQLLastLineToOriginalLineMapping {
+		lineMapping = append(lineMapping, &MybatisSQLLineMapping{
+			SQLLastLine:     sqlLastLine,
+			OriginalEleLine: originalEleLine,
+		})
+	}
+	sort.Slice(lineMapping, func(i, j int) bool {
+		return lineMapping[i].SQLLastLine < lineMapping[j].SQLLastLine
+	})
+	return lineMapping
 }
 
 var (
@@ -63,18 +102,35 @@ func (n *RootNode) RestoreSQLWithLineMapping(ctx *RestoreContext, w io.Writer) (
 			return nil, err
 		}
 	}
-	// building line mapping
-	var lineMapping []*MybatisSQLLineMapping
-	for sqlLastLine, originalEleLine := range ctx.SQLLastLineToOriginalLineMapping {
-		lineMapping = append(lineMapping, &MybatisSQLLineMapping{
-			SQLLastLine:     sqlLastLine,
-			OriginalEleLine: originalEleLine,
-		})
+	return ctx.lineMapping(), nil
+}
+
+// RestoreSQLVariantsWithLineMapping restores every query to the SQL statements of its dynamic SQL branch combinations
+// and returns the sorted(SQL last line ascending) line mapping of the SQL statements in Mybatis mapper xml.
+// Each <if> element is either kept or dropped, and each <choose> element takes one of its <when> and <otherwise>
+// elements, or nothing if there is no <otherwise> element. The <foreach> element is always restored with items.
+// At most maxVariants distinct statements are restored for each query, the variant dropping all the optional
+// elements is always restored if the combinations exceed the limit.
+func (n *RootNode) RestoreSQLVariantsWithLineMapping(ctx *RestoreContext, w io.Writer, maxVariants int) ([]*MybatisSQLLineMapping, error) {
+	for _, query := range collectQueryNodes(n.Children) {
+		if err := query.restoreVariants(ctx, w, maxVariants); err != nil {
+			return nil, err
+		}
 	}
-	sort.Slice(lineMapping, func(i, j int) bool {
-		return lineMapping[i].SQLLastLine < lineMapping[j].SQLLastLine
-	})
-	return lineMapping, nil
+	return ctx.lineMapping(), nil
+}
+
+func collectQueryNodes(nodes []Node) []*QueryNode {
+	var result []*QueryNode
+	for _, node := range nodes {
+		switch node := node.(type) {
+		case *MapperNode:
+			result = append(result, collectQueryNodes(node.Children)...)
+		case *QueryNode:
+			result = append(result, node)
+		}
+	}
+	return result
 }
 
 // AddChild adds a child to the root node.
@@ -11,6 +11,10 @@ var (
 	_ Node = (*QueryNode)(nil)
 )
 
+// maxRestoresPerVariant limits the branch combinations restored for each variant, because the combinations may restore
+// the same statement, e.g., the <if> elements with empty bodies, and the mapper author controls the combination count.
+const maxRestoresPerVariant = 4
+
 // QueryNodeType is the type of the query node.
 type QueryNodeType uint
 
@@ -39,28 +43,85 @@ type QueryNode struct {
 
 // RestoreSQL implements Node interface.
 func (n *QueryNode) RestoreSQL(ctx *RestoreContext, w io.Writer) error {
+	startLine := ctx.CurrentLastLine
+	stmt, err := n.restoreStatement(ctx)
+	if err != nil {
+		return err
+	}
+	return n.writeStatement(ctx, w, startLine, stmt)
+}
+
+// restoreVariants restores the query to at most maxVariants distinct statements of its dynamic SQL branch combinations.
+// At most maxVariants*maxRestoresPerVariant combinations are restored.
+func (n *QueryNode) restoreVariants(ctx *RestoreContext, w io.Writer, maxVariants int) error {
+	defer func() {
+		ctx.branches = nil
+	}()
+	seen := make(map[string]bool)
+	restore := func(branches *branchEnumerator) error {
+		ctx.branches = branches
+		startLine := ctx.CurrentLastLine
+		stmt, err := n.restoreStatement(ctx)
+		if err != nil {
+			return err
+		}
+		if seen[stmt] {
+			ctx.CurrentLastLine = startLine
+			return nil
+		}
+		seen[stmt] = true
+		return n.writeStatement(ctx, w, startLine, stmt)
+	}
+
+	branches := &branchEnumerator{}
+	for restores := 1; ; restores++ {
+		if err := restore(branches); err != nil {
+			return err
+		}
+		if !branches.next() {
+			return nil
+		}
+		// Leave a place for the variant dropping all the optional elements.
+		if len(seen) >= maxVariants-1 || restores >= maxVariants*maxRestoresPerVariant {
+			break
+		}
+	}
+	// There are too many combinations, make sure the variant dropping all the optional elements is restored.
+	return restore(&branchEnumerator{preferLast: true})
+}
+
+// restoreStatement restores the query to the SQL statement ends with semicolon, returns empty string if the query is empty.
+func (n *QueryNode) restoreStatement(ctx *RestoreContext) (string, error) {
 	var sb strings.Builder
 	for _, node := range n.Children {
 		if err := node.RestoreSQL(ctx, &sb); err != nil {
-			return err
+			return "", err
 		}
 	}
-	stmt := sb.String()
-	trimmed := strings.TrimSpace(stmt)
+	trimmed := strings.TrimSpace(sb.String())
 	if len(trimmed) == 0 {
+		return "", nil
+	}
+	if !strings.HasSuffix(trimmed, ";") {
+		trimmed += ";"
+	}
+	return trimmed, nil
+}
+
+// writeStatement writes the statement in a new line and records the line mapping.
+func (n *QueryNode) writeStatement(ctx *RestoreContext, w io.Writer, startLine int, stmt string) error {
+	if len(stmt) == 0 {
+		ctx.CurrentLastLine = startLine
 		return nil
 	}
-	if _, err := w.Write([]byte(trimmed)); err != nil {
+	if _, err := w.Write([]byte(stmt)); err != nil {
 		return err
 	}
-	if !strings.HasSuffix(trimmed, ";") {
-		if _, err := w.Write([]byte(";")); err != nil {
-			return err
-		}
-	}
 	if _, err := w.Write([]byte("\n")); err != nil {
 		return err
 	}
+	// Count the lines of the written statement, because the children may write the text more than once, such as <foreach>.
+	ctx.CurrentLastLine = startLine + strings.Count(stmt, "\n")
 	ctx.SQLLastLineToOriginalLineMapping[ctx.CurrentLastLine] = n.Line
 	ctx.CurrentLastLine++
 	return nil
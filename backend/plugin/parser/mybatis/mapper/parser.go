@@ -18,6 +18,11 @@ type Parser struct {
 	cursor      uint
 	currentLine int
 	sqlMap      map[string]*ast.SQLNode
+	// namespace is the namespace of the current mapper.
+	namespace string
+	// sqlFragments is the <sql> elements keyed by the fully qualified id likes "namespace.id".
+	sqlFragments map[string]*ast.SQLNode
+	typeAliases  map[string]string
 }
 
 // NewParser creates a new mybatis mapper xml parser.
@@ -25,11 +30,39 @@ func NewParser(stmt string) *Parser {
 	reader := strings.NewReader(stmt)
 	d := xml.NewDecoder(reader)
 	return &Parser{
-		d:           d,
-		cursor:      0,
-		buf:         nil,
-		sqlMap:      make(map[string]*ast.SQLNode),
-		currentLine: 1,
+		d:            d,
+		cursor:       0,
+		buf:          nil,
+		sqlMap:       make(map[string]*ast.SQLNode),
+		sqlFragments: make(map[string]*ast.SQLNode),
+		typeAliases:  make(map[string]string),
+		currentLine:  1,
+	}
+}
+
+// GetSQLFragments returns the <sql> elements in the mapper keyed by the fully qualified id likes "namespace.id",
+// which can be referenced by the <include> elements in other mappers. It should be called after Parse.
+func (p *Parser) GetSQLFragments() map[string]*ast.SQLNode {
+	return p.sqlFragments
+}
+
+// AddSQLFragments adds the <sql> elements in other mappers keyed by the fully qualified id likes "namespace.id",
+// the <sql> elements in the mapper itself take precedence. It should be called after Parse.
+func (p *Parser) AddSQLFragments(fragments map[string]*ast.SQLNode) {
+	for id, fragment := range fragments {
+		if _, ok := p.sqlMap[id]; ok {
+			continue
+		}
+		p.sqlMap[id] = fragment
+	}
+}
+
+// SetTypeAliases sets the type aliases in mybatis configuration, key is the alias, value is the type.
+// The namespace in the fully qualified refid of the <include> element can be the type alias.
+func (p *Parser) SetTypeAliases(typeAliases map[string]string) {
+	for alias, typ := range typeAliases {
+		// The type alias is case-insensitive in mybatis.
+		p.typeAliases[strings.ToLower(alias)] = typ
 	}
 }
 
@@ -40,6 +73,7 @@ func (p *Parser) GetRestoreContext() *ast.RestoreContext {
 		Variable:                         make(map[string]string),
 		SQLLastLineToOriginalLineMapping: make(map[int]int),
 		CurrentLastLine:                  1,
+		TypeAliases:                      p.typeAliases,
 	}
 }
 
@@ -66,8 +100,17 @@ func (p *Parser) Parse() (*ast.RootNode, error) {
 		switch ele := token.(type) {
 		case xml.StartElement:
 			newNode := p.newNodeByStartElement(&ele)
-			if ele.Name.Local == "sql" {
-				p.sqlMap[newNode.(*ast.SQLNode).ID] = newNode.(*ast.SQLNode)
+			switch ele.Name.Local {
+			case "mapper":
+				p.namespace = newNode.(*ast.MapperNode).Namespace
+			case "sql":
+				sqlNode := newNode.(*ast.SQLNode)
+				p.sqlMap[sqlNode.ID] = sqlNode
+				if p.namespace != "" {
+					// The <sql> element can also be referenced by the fully qualified id in the same mapper.
+					p.sqlMap[p.namespace+"."+sqlNode.ID] = sqlNode
+					p.sqlFragments[p.namespace+"."+sqlNode.ID] = sqlNode
+				}
 			}
 			startElementStack = append(startElementStack, &ele)
 			nodeStack = append(nodeStack, newNode)
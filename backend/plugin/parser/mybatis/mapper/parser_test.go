@@ -124,3 +124,138 @@ func TestRestoreWithLineMapping(t *testing.T) {
 		require.Equal(t, tc.lineMapping, lineMapping)
 	}
 }
+
+func TestRestoreVariantsWithLineMapping(t *testing.T) {
+	testCases := []struct {
+		xml          string
+		fragmentsXML string
+		typeAliases  map[string]string
+		maxVariants  int
+		sql          string
+		lineMapping  []*ast.MybatisSQLLineMapping
+	}{
+		{
+			xml: `<mapper namespace="com.bytebase.test">
+	<delete id="delete">
+		DELETE FROM t
+		<where>
+			<if test="id != null">
+				AND id = #{id}
+			</if>
+			<choose>
+				<when test="name != null">AND name = #{name}</when>
+				<when test="email != null">AND email = #{email}</when>
+			</choose>
+		</where>
+	</delete>
+	<select id="select">
+		SELECT * FROM t WHERE id IN
+		<foreach collection="ids" item="id" open="(" close=")" separator=",">
+			#{id}
+		</foreach>
+	</select>
+</mapper>`,
+			maxVariants: 64,
+			sql: `DELETE FROM t WHERE id = ?    AND name = ?;
+DELETE FROM t WHERE id = ?    AND email = ?;
+DELETE FROM t WHERE id = ?;
+DELETE FROM t WHERE name = ?;
+DELETE FROM t WHERE email = ?;
+DELETE FROM t;
+SELECT * FROM t WHERE id IN (? , ?);
+`,
+			lineMapping: []*ast.MybatisSQLLineMapping{
+				{SQLLastLine: 1, OriginalEleLine: 2},
+				{SQLLastLine: 2, OriginalEleLine: 2},
+				{SQLLastLine: 3, OriginalEleLine: 2},
+				{SQLLastLine: 4, OriginalEleLine: 2},
+				{SQLLastLine: 5, OriginalEleLine: 2},
+				{SQLLastLine: 6, OriginalEleLine: 2},
+				{SQLLastLine: 7, OriginalEleLine: 14},
+			},
+		},
+		{
+			// The variant dropping all the optional elements is restored if the combinations exceed the limit.
+			xml: `<mapper namespace="com.bytebase.test">
+	<update id="update">
+		UPDATE t
+		<set>
+			<if test="a != null">a = 1,</if>
+			<if test="b != null">b = 1,</if>
+		</set>
+		<where>
+			<if test="id != null">id = #{id}</if>
+		</where>
+	</update>
+</mapper>`,
+			maxVariants: 3,
+			sql: `UPDATE t SET a = 1,  b = 1 WHERE id = ?;
+UPDATE t SET a = 1,  b = 1;
+UPDATE t;
+`,
+			lineMapping: []*ast.MybatisSQLLineMapping{
+				{SQLLastLine: 1, OriginalEleLine: 2},
+				{SQLLastLine: 2, OriginalEleLine: 2},
+				{SQLLastLine: 3, OriginalEleLine: 2},
+			},
+		},
+		{
+			// The <sql> elements in other mappers are referenced by the fully qualified id or the type alias of the namespace.
+			xml: `<mapper namespace="com.bytebase.test">
+	<select id="select">
+		SELECT
+		<include refid="com.bytebase.common.columns"/>
+		FROM t
+		<include refid="Common.filter"/>
+	</select>
+</mapper>`,
+			fragmentsXML: `<mapper namespace="com.bytebase.common">
+	<sql id="columns">id, name</sql>
+	<sql id="filter"><if test="id != null">WHERE id = #{id}</if></sql>
+</mapper>`,
+			typeAliases: map[string]string{"common": "com.bytebase.common"},
+			maxVariants: 64,
+			sql: `SELECT id, name FROM t WHERE id = ?;
+SELECT id, name FROM t;
+`,
+			lineMapping: []*ast.MybatisSQLLineMapping{
+				{SQLLastLine: 1, OriginalEleLine: 2},
+				{SQLLastLine: 2, OriginalEleLine: 2},
+			},
+		},
+	}
+	for _, tc := range testCases {
+		parser := NewParser(tc.xml)
+		node, err := parser.Parse()
+		require.NoError(t, err)
+		if tc.fragmentsXML != "" {
+			fragmentsParser := NewParser(tc.fragmentsXML)
+			_, err := fragmentsParser.Parse()
+			require.NoError(t, err)
+			parser.AddSQLFragments(fragmentsParser.GetSQLFragments())
+		}
+		parser.SetTypeAliases(tc.typeAliases)
+		var sb strings.Builder
+		lineMapping, err := node.RestoreSQLVariantsWithLineMapping(parser.GetRestoreContext(), &sb, tc.maxVariants)
+		require.NoError(t, err)
+		require.Equal(t, tc.sql, sb.String())
+		require.Equal(t, tc.lineMapping, lineMapping)
+	}
+}
+
+func TestRestoreVariantsWithIdenticalBranches(t *testing.T) {
+	// The <if> elements with empty bodies restore the same statement in every combination.
+	xml := `<mapper namespace="com.bytebase.test">
+	<select id="select">
+		SELECT * FROM t
+		` + strings.Repeat(`<if test="a != null"></if>`, 40) + `
+	</select>
+</mapper>`
+	parser := NewParser(xml)
+	node, err := parser.Parse()
+	require.NoError(t, err)
+	var sb strings.Builder
+	_, err = node.RestoreSQLVariantsWithLineMapping(parser.GetRestoreContext(), &sb, 64)
+	require.NoError(t, err)
+	require.Equal(t, "SELECT * FROM t;\n", sb.String())
+}
@@ -0,0 +1,182 @@
+package parser
+
+import (
+	"encoding/json"
+	"sort"
+	"strings"
+
+	pgquery "github.com/pganalyze/pg_query_go/v2"
+	"github.com/pkg/errors"
+)
+
+// ExtractPostgresRoutineStatements extracts the SQL statements in the bodies of the functions and procedures
+// created by the statement. Only the bodies in LANGUAGE plpgsql and sql are supported.
+// The LastLine of the returned statements is the line in the given statement, starting from 1.
+func ExtractPostgresRoutineStatements(statement string) ([]SingleSQL, error) {
+	res, err := pgquery.Parse(statement)
+	if err != nil {
+		return nil, err
+	}
+
+	var result []SingleSQL
+	for _, stmt := range res.Stmts {
+		createFunction, ok := stmt.Stmt.Node.(*pgquery.Node_CreateFunctionStmt)
+		if !ok {
+			continue
+		}
+		text := statement[stmt.StmtLocation:]
+		if stmt.StmtLen > 0 {
+			text = text[:stmt.StmtLen]
+		}
+		language, body, bodyLocation := extractPostgresRoutineBody(createFunction.CreateFunctionStmt)
+		if body == "" {
+			continue
+		}
+		// The position of the body relative to the statement, and its first line in the whole statement.
+		bodyPosition := int(bodyLocation) - int(stmt.StmtLocation)
+		if bodyPosition < 0 || bodyPosition > len(text) {
+			bodyPosition = 0
+		}
+		if idx := strings.Index(text[bodyPosition:], body); idx >= 0 {
+			bodyPosition += idx
+		}
+		bodyFirstLine := strings.Count(statement[:int(stmt.StmtLocation)+bodyPosition], "\n") + 1
+
+		switch strings.ToLower(language) {
+		case "plpgsql":
+			list, err := extractPLpgSQLStatements(text)
+			if err != nil {
+				return nil, err
+			}
+			for _, sql := range list {
+				sql.LastLine += bodyFirstLine - 1
+				result = append(result, sql)
+			}
+		case "sql":
+			list, err := SplitMultiSQL(Postgres, body)
+			if err != nil {
+				return nil, err
+			}
+			for _, sql := range list {
+				if sql.Empty {
+					continue
+				}
+				result = append(result, SingleSQL{
+					Text:     strings.TrimSpace(sql.Text),
+					LastLine: sql.LastLine + bodyFirstLine - 1,
+				})
+			}
+		}
+	}
+	return result, nil
+}
+
+// extractPostgresRoutineBody returns the language, body and location of the body definition of the function.
+func extractPostgresRoutineBody(stmt *pgquery.CreateFunctionStmt) (string, string, int32) {
+	// The default language is sql.
+	language := "sql"
+	var body string
+	var location int32
+	for _, option := range stmt.Options {
+		defElem, ok := option.Node.(*pgquery.Node_DefElem)
+		if !ok || defElem.DefElem.Arg == nil {
+			continue
+		}
+		switch defElem.DefElem.Defname {
+		case "language":
+			if s, ok := defElem.DefElem.Arg.Node.(*pgquery.Node_String_); ok {
+				language = s.String_.Str
+			}
+		case "as":
+			list, ok := defElem.DefElem.Arg.Node.(*pgquery.Node_List)
+			if !ok || len(list.List.Items) != 1 {
+				// The C functions have the object file and link symbol.
+				continue
+			}
+			if s, ok := list.List.Items[0].Node.(*pgquery.Node_String_); ok {
+				body = s.String_.Str
+				location = defElem.DefElem.Location
+			}
+		}
+	}
+	return language, body, location
+}
+
+// extractPLpgSQLStatements extracts the SQL statements in the PL/pgSQL function.
+// The LastLine of the returned statements is the line in the function body, starting from 1.
+func extractPLpgSQLStatements(statement string) ([]SingleSQL, error) {
+	jsonText, err := pgquery.ParsePlPgSqlToJSON(statement)
+	if err != nil {
+		return nil, err
+	}
+	var functions []any
+	if err := json.Unmarshal([]byte(jsonText), &functions); err != nil {
+		return nil, errors.Wrapf(err, "failed to unmarshal PL/pgSQL parse result")
+	}
+	var result []SingleSQL
+	for _, function := range functions {
+		result = append(result, walkPLpgSQL(function)...)
+	}
+	// The JSON objects are unordered, so sort the statements by the line.
+	sort.SliceStable(result, func(i, j int) bool {
+		return result[i].LastLine < result[j].LastLine
+	})
+	return result, nil
+}
+
+// walkPLpgSQL walks the PL/pgSQL parse result and collects the static SQL statements.
+// The expressions such as the IF conditions are also SELECT statements in PL/pgSQL, so only the statements below are collected:
+//   - PLpgSQL_stmt_execsql: the SQL statement.
+//   - PLpgSQL_stmt_perform: PERFORM query, whose query is rewritten to SELECT query.
+//   - PLpgSQL_stmt_fors: FOR target IN query LOOP.
+//   - PLpgSQL_stmt_return_query: RETURN QUERY query.
+func walkPLpgSQL(node any) []SingleSQL {
+	var result []SingleSQL
+	switch node := node.(type) {
+	case []any:
+		for _, item := range node {
+			result = append(result, walkPLpgSQL(item)...)
+		}
+	case map[string]any:
+		for key, value := range node {
+			stmt, ok := value.(map[string]any)
+			if ok {
+				var query string
+				switch key {
+				case "PLpgSQL_stmt_execsql":
+					query = plpgsqlExprQuery(stmt["sqlstmt"])
+				case "PLpgSQL_stmt_perform":
+					query = plpgsqlExprQuery(stmt["expr"])
+				case "PLpgSQL_stmt_fors", "PLpgSQL_stmt_return_query":
+					query = plpgsqlExprQuery(stmt["query"])
+				}
+				if query != "" {
+					line := 1
+					if lineno, ok := stmt["lineno"].(float64); ok {
+						line = int(lineno)
+					}
+					query = strings.TrimSpace(query)
+					result = append(result, SingleSQL{
+						Text:     query,
+						LastLine: line + strings.Count(query, "\n"),
+					})
+				}
+			}
+			result = append(result, walkPLpgSQL(value)...)
+		}
+	}
+	return result
+}
+
+func plpgsqlExprQuery(expr any) string {
+	m, ok := expr.(map[string]any)
+	if !ok {
+		return ""
+	}
+	e, ok := m["PLpgSQL_expr"].(map[string]any)
+	if !ok {
+		return ""
+	}
+	query, _ := e["query"].(string)
+	return query
+}
@@ -0,0 +1,74 @@
+package parser
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestExtractPostgresRoutineStatements(t *testing.T) {
+	tests := []struct {
+		statement string
+		want      []SingleSQL
+	}{
+		{
+			statement: "CREATE TABLE t(a int);",
+			want:      nil,
+		},
+		{
+			statement: `CREATE OR REPLACE FUNCTION f(x int) RETURNS int AS $$
+DECLARE
+  v int;
+  r record;
+BEGIN
+  DELETE FROM t;
+  IF x > 0 THEN
+    SELECT * INTO v FROM t WHERE a = x;
+  ELSE
+    UPDATE t
+    SET a = 1;
+  END IF;
+  FOR r IN SELECT * FROM t LOOP
+    INSERT INTO t VALUES (1);
+  END LOOP;
+  PERFORM * FROM t;
+  EXECUTE 'DELETE FROM t';
+  RETURN 1;
+END;
+$$ LANGUAGE plpgsql;`,
+			want: []SingleSQL{
+				{Text: "DELETE FROM t", LastLine: 6},
+				{Text: "SELECT *        FROM t WHERE a = x", LastLine: 8},
+				{Text: "UPDATE t\n    SET a = 1", LastLine: 11},
+				{Text: "SELECT * FROM t", LastLine: 13},
+				{Text: "INSERT INTO t VALUES (1)", LastLine: 14},
+				{Text: "SELECT * FROM t", LastLine: 16},
+			},
+		},
+		{
+			statement: `CREATE PROCEDURE p()
+LANGUAGE plpgsql
+AS
+$$ BEGIN DELETE FROM t; END $$;`,
+			want: []SingleSQL{
+				{Text: "DELETE FROM t", LastLine: 4},
+			},
+		},
+		{
+			statement: `CREATE FUNCTION g() RETURNS int LANGUAGE sql AS '
+  DELETE FROM t;
+  SELECT 1;
+';`,
+			want: []SingleSQL{
+				{Text: "DELETE FROM t;", LastLine: 2},
+				{Text: "SELECT 1;", LastLine: 3},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		got, err := ExtractPostgresRoutineStatements(test.statement)
+		require.NoError(t, err, test.statement)
+		require.Equal(t, test.want, got, test.statement)
+	}
+}
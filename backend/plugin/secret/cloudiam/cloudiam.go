@@ -0,0 +1,81 @@
+// Package cloudiam generates the short-lived database authentication tokens of the cloud IAM identities.
+package cloudiam
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	v4 "github.com/aws/aws-sdk-go-v2/aws/signer/v4"
+	awsconfig "github.com/aws/aws-sdk-go-v2/config"
+	"github.com/pkg/errors"
+	"golang.org/x/oauth2/google"
+)
+
+const (
+	// rdsTokenExpiration is the lifetime of the RDS IAM authentication token, which is at most 15 minutes.
+	// The token is only used to establish the connection, and the established connection is not affected by the expiration.
+	rdsTokenExpiration = 15 * time.Minute
+	// emptyPayloadHash is the SHA-256 hash of the empty payload for signing the GET request.
+	emptyPayloadHash = "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855"
+	// cloudSQLLoginScope is the OAuth2 scope for the Cloud SQL IAM database authentication.
+	cloudSQLLoginScope = "https://www.googleapis.com/auth/sqlservice.login"
+)
+
+// GetAWSRDSAuthToken returns the RDS IAM authentication token of the database user signed by the default AWS credentials,
+// e.g. the environment variables, the shared config or the IAM role of the EC2 instance or EKS pod.
+//
+// Docs: https://docs.aws.amazon.com/AmazonRDS/latest/UserGuide/UsingWithRDS.IAMDBAuth.html
+func GetAWSRDSAuthToken(ctx context.Context, host, port, region, username string) (string, error) {
+	cfg, err := awsconfig.LoadDefaultConfig(ctx, awsconfig.WithRegion(region))
+	if err != nil {
+		return "", errors.Wrap(err, "failed to load AWS config")
+	}
+	return buildAWSRDSAuthToken(ctx, net.JoinHostPort(host, port), region, username, cfg.Credentials, time.Now())
+}
+
+// buildAWSRDSAuthToken presigns the RDS connect action, which is the same as the BuildAuthToken of the AWS SDK RDS auth feature.
+func buildAWSRDSAuthToken(ctx context.Context, endpoint, region, username string, credentials aws.CredentialsProvider, signingTime time.Time) (string, error) {
+	if credentials == nil {
+		return "", errors.New("AWS credentials not found")
+	}
+	creds, err := credentials.Retrieve(ctx)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to retrieve AWS credentials")
+	}
+
+	values := url.Values{}
+	values.Set("Action", "connect")
+	values.Set("DBUser", username)
+	values.Set("X-Amz-Expires", fmt.Sprintf("%d", int(rdsTokenExpiration.Seconds())))
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, fmt.Sprintf("https://%s/?%s", endpoint, values.Encode()), nil)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to construct RDS connect request")
+	}
+	signedURL, _, err := v4.NewSigner().PresignHTTP(ctx, creds, req, emptyPayloadHash, "rds-db", region, signingTime)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to sign RDS connect request")
+	}
+	return strings.TrimPrefix(signedURL, "https://"), nil
+}
+
+// GetGCPCloudSQLAccessToken returns the OAuth2 access token of the application default credentials, which is used as
+// the password of the Cloud SQL IAM database user.
+//
+// Docs: https://cloud.google.com/sql/docs/postgres/iam-logins
+func GetGCPCloudSQLAccessToken(ctx context.Context) (string, error) {
+	tokenSource, err := google.DefaultTokenSource(ctx, cloudSQLLoginScope)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to find GCP application default credentials")
+	}
+	token, err := tokenSource.Token()
+	if err != nil {
+		return "", errors.Wrap(err, "failed to get GCP access token")
+	}
+	return token.AccessToken, nil
+}
@@ -0,0 +1,36 @@
+package cloudiam
+
+import (
+	"context"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	awscredentials "github.com/aws/aws-sdk-go-v2/credentials"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuildAWSRDSAuthToken(t *testing.T) {
+	credentials := awscredentials.NewStaticCredentialsProvider("AKIDEXAMPLE", "secret", "")
+	signingTime := time.Date(2023, 10, 1, 0, 0, 0, 0, time.UTC)
+
+	token, err := buildAWSRDSAuthToken(context.Background(), "mydb.123456789012.us-east-1.rds.amazonaws.com:5432", "us-east-1", "bytebase", credentials, signingTime)
+	require.NoError(t, err)
+
+	endpoint, query, ok := strings.Cut(token, "/?")
+	require.True(t, ok)
+	assert.Equal(t, "mydb.123456789012.us-east-1.rds.amazonaws.com:5432", endpoint)
+	values, err := url.ParseQuery(query)
+	require.NoError(t, err)
+	assert.Equal(t, "connect", values.Get("Action"))
+	assert.Equal(t, "bytebase", values.Get("DBUser"))
+	assert.Equal(t, "900", values.Get("X-Amz-Expires"))
+	assert.Equal(t, "20231001T000000Z", values.Get("X-Amz-Date"))
+	assert.Equal(t, "AKIDEXAMPLE/20231001/us-east-1/rds-db/aws4_request", values.Get("X-Amz-Credential"))
+	assert.NotEmpty(t, values.Get("X-Amz-Signature"))
+
+	_, err = buildAWSRDSAuthToken(context.Background(), "localhost:5432", "us-east-1", "bytebase", nil, signingTime)
+	require.Error(t, err)
+}
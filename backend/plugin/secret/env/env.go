@@ -0,0 +1,36 @@
+// Package env is the secret provider for the secrets in the environment variables.
+package env
+
+import (
+	"context"
+	"os"
+
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/backend/plugin/secret"
+)
+
+func init() {
+	secret.Register(secret.Env, newProvider)
+}
+
+var _ secret.Provider = (*Provider)(nil)
+
+// Provider is the environment variable secret provider.
+type Provider struct{}
+
+func newProvider(secret.ProviderConfig) secret.Provider {
+	return &Provider{}
+}
+
+// GetSecret returns the value of the environment variable named by the reference path, e.g. env://MYSQL_PASSWORD.
+func (*Provider) GetSecret(_ context.Context, reference *secret.Reference) (string, error) {
+	if reference.Key != "" {
+		return "", errors.Errorf("key is not supported for the environment variable secret")
+	}
+	value, ok := os.LookupEnv(reference.Path)
+	if !ok {
+		return "", errors.Errorf("environment variable %q is not set", reference.Path)
+	}
+	return value, nil
+}
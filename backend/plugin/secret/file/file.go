@@ -0,0 +1,49 @@
+// Package file is the secret provider for the secrets in the local files, e.g. the mounted Kubernetes secrets.
+package file
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/backend/plugin/secret"
+)
+
+func init() {
+	secret.Register(secret.File, newProvider)
+}
+
+var _ secret.Provider = (*Provider)(nil)
+
+// Provider is the file secret provider.
+type Provider struct{}
+
+func newProvider(secret.ProviderConfig) secret.Provider {
+	return &Provider{}
+}
+
+// GetSecret returns the content of the file in the reference path, e.g. file:///run/secrets/mysql-password.
+// The trailing newline is trimmed. If the key is specified, the file is parsed as a JSON object and the value
+// of the key is returned, e.g. file:///run/secrets/mysql.json#password.
+func (*Provider) GetSecret(_ context.Context, reference *secret.Reference) (string, error) {
+	content, err := os.ReadFile(reference.Path)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to read secret file %q", reference.Path)
+	}
+	if reference.Key == "" {
+		return strings.TrimRight(string(content), "\r\n"), nil
+	}
+
+	var data map[string]any
+	if err := json.Unmarshal(content, &data); err != nil {
+		return "", errors.Wrapf(err, "failed to parse secret file %q as JSON", reference.Path)
+	}
+	value, ok := data[reference.Key].(string)
+	if !ok {
+		return "", errors.Errorf("string key %q not found in secret file %q", reference.Key, reference.Path)
+	}
+	return value, nil
+}
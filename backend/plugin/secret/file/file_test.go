@@ -0,0 +1,35 @@
+package file
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bytebase/bytebase/backend/plugin/secret"
+)
+
+func TestProvider_GetSecret(t *testing.T) {
+	dir := t.TempDir()
+	passwordFile := filepath.Join(dir, "mysql-password")
+	require.NoError(t, os.WriteFile(passwordFile, []byte("hello\n"), 0600))
+	jsonFile := filepath.Join(dir, "mysql.json")
+	require.NoError(t, os.WriteFile(jsonFile, []byte(`{"password": "world"}`), 0600))
+
+	p := newProvider(secret.ProviderConfig{})
+	ctx := context.Background()
+
+	value, err := p.GetSecret(ctx, &secret.Reference{Scheme: secret.File, Path: passwordFile})
+	require.NoError(t, err)
+	assert.Equal(t, "hello", value)
+
+	value, err = p.GetSecret(ctx, &secret.Reference{Scheme: secret.File, Path: jsonFile, Key: "password"})
+	require.NoError(t, err)
+	assert.Equal(t, "world", value)
+
+	_, err = p.GetSecret(ctx, &secret.Reference{Scheme: secret.File, Path: filepath.Join(dir, "missing")})
+	require.Error(t, err)
+}
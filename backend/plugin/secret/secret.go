@@ -0,0 +1,236 @@
+// Package secret is the plugin for resolving the credentials from the external secret managers.
+package secret
+
+import (
+	"context"
+	"net/http"
+	"path"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// Scheme is the scheme of the secret reference, which identifies the secret provider.
+type Scheme string
+
+const (
+	// Vault is the scheme for the HashiCorp Vault KV secrets engine.
+	Vault Scheme = "vault"
+	// File is the scheme for the secrets in the local files, e.g. the mounted Kubernetes secrets.
+	File Scheme = "file"
+	// Env is the scheme for the secrets in the environment variables.
+	Env Scheme = "env"
+)
+
+// Reference is the reference to a secret in the external secret manager.
+// The format is <scheme>://<path>[#<key>], for example:
+//   - vault://secret/data/mysql#password reads the "password" key of the Vault secret at "secret/data/mysql".
+//   - file:///run/secrets/mysql-password reads the whole file.
+//   - env://MYSQL_PASSWORD reads the environment variable.
+type Reference struct {
+	Scheme Scheme
+	Path   string
+	// Key is the key in the secret if the secret is a key-value map.
+	Key string
+}
+
+// ParseReference parses the secret reference.
+func ParseReference(reference string) (*Reference, error) {
+	scheme, rest, ok := strings.Cut(reference, "://")
+	if !ok || scheme == "" {
+		return nil, errors.Errorf("invalid secret reference %q, expecting <scheme>://<path>[#<key>]", reference)
+	}
+	path, key, _ := strings.Cut(rest, "#")
+	if path == "" {
+		return nil, errors.Errorf("invalid secret reference %q, the path is empty", reference)
+	}
+	return &Reference{
+		Scheme: Scheme(scheme),
+		Path:   path,
+		Key:    key,
+	}, nil
+}
+
+// Allowlist restricts the secrets that the references can read, so that the users managing the data sources
+// can't read arbitrary files, environment variables or Vault secrets with the server's identity.
+// A scheme is disabled if its allowlist is empty.
+type Allowlist struct {
+	// FileDir is the directory of the files that the file references can read, e.g. /run/secrets.
+	FileDir string
+	// EnvPrefix is the name prefix of the environment variables that the env references can read, e.g. BB_SECRET_.
+	EnvPrefix string
+	// VaultPathPrefix is the path prefix of the Vault secrets that the Vault references can read, e.g. secret/data/bytebase.
+	// It also restricts the Vault database secrets engine credential paths.
+	VaultPathPrefix string
+}
+
+// Check returns an error if the reference is not allowed.
+func (a *Allowlist) Check(reference *Reference) error {
+	switch reference.Scheme {
+	case File:
+		if a.FileDir == "" {
+			return errors.Errorf("file secret references are disabled, set --secret-file-dir to allow them")
+		}
+		if !isFileInDir(reference.Path, a.FileDir) {
+			return errors.Errorf("secret file %q is not in the allowed directory %q", reference.Path, a.FileDir)
+		}
+	case Env:
+		if a.EnvPrefix == "" {
+			return errors.Errorf("environment variable secret references are disabled, set --secret-env-prefix to allow them")
+		}
+		if !strings.HasPrefix(reference.Path, a.EnvPrefix) || reference.Path == a.EnvPrefix {
+			return errors.Errorf("environment variable %q doesn't have the allowed prefix %q", reference.Path, a.EnvPrefix)
+		}
+	case Vault:
+		return a.CheckVaultPath(reference.Path)
+	}
+	return nil
+}
+
+// CheckVaultPath returns an error if the Vault path is not allowed.
+func (a *Allowlist) CheckVaultPath(vaultPath string) error {
+	if a.VaultPathPrefix == "" {
+		return errors.Errorf("vault secret references are disabled, set --secret-vault-path-prefix to allow them")
+	}
+	prefix := strings.Trim(path.Clean("/"+a.VaultPathPrefix), "/")
+	cleaned := strings.Trim(path.Clean("/"+vaultPath), "/")
+	if strings.Trim(vaultPath, "/") != cleaned || (cleaned != prefix && !strings.HasPrefix(cleaned, prefix+"/")) {
+		return errors.Errorf("Vault path %q doesn't have the allowed prefix %q", vaultPath, a.VaultPathPrefix)
+	}
+	return nil
+}
+
+// isFileInDir returns true if the file is in the directory, following the symbolic links if they exist.
+func isFileInDir(file, dir string) bool {
+	if !filepath.IsAbs(file) || !isPathInDir(filepath.Clean(file), filepath.Clean(dir)) {
+		return false
+	}
+	resolvedFile, err := filepath.EvalSymlinks(file)
+	if err != nil {
+		// The file doesn't exist yet, e.g. the secret is not mounted.
+		return true
+	}
+	resolvedDir, err := filepath.EvalSymlinks(dir)
+	if err != nil {
+		return false
+	}
+	return isPathInDir(resolvedFile, resolvedDir)
+}
+
+func isPathInDir(file, dir string) bool {
+	rel, err := filepath.Rel(dir, file)
+	if err != nil {
+		return false
+	}
+	return rel != "." && rel != ".." && !strings.HasPrefix(rel, "../")
+}
+
+// Provider is the interface for the secret provider.
+type Provider interface {
+	// GetSecret returns the secret value of the reference.
+	GetSecret(ctx context.Context, reference *Reference) (string, error)
+}
+
+// ProviderConfig is the provider configuration.
+type ProviderConfig struct {
+	Client *http.Client
+}
+
+type providerFunc func(ProviderConfig) Provider
+
+var (
+	providerMu sync.RWMutex
+	providers  = make(map[Scheme]providerFunc)
+)
+
+// Register makes a secret provider available by the provided scheme.
+// If Register is called twice with the same scheme or if provider is nil,
+// it panics.
+func Register(scheme Scheme, f providerFunc) {
+	providerMu.Lock()
+	defer providerMu.Unlock()
+	if f == nil {
+		panic("secret: Register provider is nil")
+	}
+	if _, dup := providers[scheme]; dup {
+		panic("secret: Register called twice for provider " + scheme)
+	}
+	providers[scheme] = f
+}
+
+// Get returns a secret provider specified by its scheme.
+func Get(scheme Scheme, providerConfig ProviderConfig) (Provider, error) {
+	providerMu.RLock()
+	f, ok := providers[scheme]
+	providerMu.RUnlock()
+	if !ok {
+		return nil, errors.Errorf("unsupported secret provider %q", scheme)
+	}
+	return f(providerConfig), nil
+}
+
+// Resolver resolves the secret references with the registered providers.
+// The resolved secrets are cached in memory for the TTL so that we don't hit the secret manager
+// on every connection. They are never persisted.
+type Resolver struct {
+	ttl       time.Duration
+	allowlist *Allowlist
+	now       func() time.Time
+
+	mu    sync.Mutex
+	cache map[string]cachedSecret
+}
+
+type cachedSecret struct {
+	value    string
+	expireAt time.Time
+}
+
+// NewResolver creates a new resolver caching the secrets for the TTL.
+// Only the references in the allowlist are resolved.
+func NewResolver(ttl time.Duration, allowlist *Allowlist) *Resolver {
+	return &Resolver{
+		ttl:       ttl,
+		allowlist: allowlist,
+		now:       time.Now,
+		cache:     make(map[string]cachedSecret),
+	}
+}
+
+// Resolve returns the secret value of the reference.
+func (r *Resolver) Resolve(ctx context.Context, reference string) (string, error) {
+	r.mu.Lock()
+	cached, ok := r.cache[reference]
+	r.mu.Unlock()
+	if ok && r.now().Before(cached.expireAt) {
+		return cached.value, nil
+	}
+
+	ref, err := ParseReference(reference)
+	if err != nil {
+		return "", err
+	}
+	// The references are validated on saving, check them again in case the allowlist is changed after that.
+	if err := r.allowlist.Check(ref); err != nil {
+		return "", err
+	}
+	provider, err := Get(ref.Scheme, ProviderConfig{})
+	if err != nil {
+		return "", err
+	}
+	value, err := provider.GetSecret(ctx, ref)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to get secret %q", reference)
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.cache[reference] = cachedSecret{
+		value:    value,
+		expireAt: r.now().Add(r.ttl),
+	}
+	return value, nil
+}
@@ -0,0 +1,136 @@
+package secret
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseReference(t *testing.T) {
+	tests := []struct {
+		reference string
+		want      *Reference
+		wantErr   bool
+	}{
+		{
+			reference: "vault://secret/data/mysql#password",
+			want:      &Reference{Scheme: Vault, Path: "secret/data/mysql", Key: "password"},
+		},
+		{
+			reference: "file:///run/secrets/mysql-password",
+			want:      &Reference{Scheme: File, Path: "/run/secrets/mysql-password"},
+		},
+		{
+			reference: "env://MYSQL_PASSWORD",
+			want:      &Reference{Scheme: Env, Path: "MYSQL_PASSWORD"},
+		},
+		{
+			reference: "MYSQL_PASSWORD",
+			wantErr:   true,
+		},
+		{
+			reference: "vault://#password",
+			wantErr:   true,
+		},
+	}
+
+	for _, test := range tests {
+		got, err := ParseReference(test.reference)
+		if test.wantErr {
+			require.Error(t, err, test.reference)
+			continue
+		}
+		require.NoError(t, err, test.reference)
+		assert.Equal(t, test.want, got, test.reference)
+	}
+}
+
+type countingProvider struct {
+	count int
+}
+
+func (p *countingProvider) GetSecret(_ context.Context, reference *Reference) (string, error) {
+	p.count++
+	return reference.Path, nil
+}
+
+func TestResolver(t *testing.T) {
+	provider := &countingProvider{}
+	Register("test", func(ProviderConfig) Provider {
+		return provider
+	})
+
+	now := time.Now()
+	resolver := NewResolver(time.Minute, &Allowlist{})
+	resolver.now = func() time.Time {
+		return now
+	}
+	ctx := context.Background()
+
+	value, err := resolver.Resolve(ctx, "test://hello")
+	require.NoError(t, err)
+	assert.Equal(t, "hello", value)
+	assert.Equal(t, 1, provider.count)
+
+	// The secret is cached within the TTL.
+	now = now.Add(30 * time.Second)
+	value, err = resolver.Resolve(ctx, "test://hello")
+	require.NoError(t, err)
+	assert.Equal(t, "hello", value)
+	assert.Equal(t, 1, provider.count)
+
+	// The secret is resolved again after the TTL.
+	now = now.Add(time.Minute)
+	_, err = resolver.Resolve(ctx, "test://hello")
+	require.NoError(t, err)
+	assert.Equal(t, 2, provider.count)
+
+	_, err = resolver.Resolve(ctx, "unknown://hello")
+	require.EqualError(t, err, `unsupported secret provider "unknown"`)
+}
+
+func TestAllowlist(t *testing.T) {
+	dir := t.TempDir()
+	allowlist := &Allowlist{
+		FileDir:         dir,
+		EnvPrefix:       "BB_SECRET_",
+		VaultPathPrefix: "secret/data/bytebase",
+	}
+	tests := []struct {
+		reference string
+		allowed   bool
+	}{
+		{reference: "file://" + dir + "/mysql-password", allowed: true},
+		{reference: "file://" + dir + "/../etc/passwd", allowed: false},
+		{reference: "file:///etc/passwd", allowed: false},
+		{reference: "file://" + dir, allowed: false},
+		{reference: "env://BB_SECRET_MYSQL_PASSWORD", allowed: true},
+		{reference: "env://PG_URL", allowed: false},
+		{reference: "env://BB_SECRET_", allowed: false},
+		{reference: "vault://secret/data/bytebase/mysql#password", allowed: true},
+		{reference: "vault://secret/data/bytebase#password", allowed: true},
+		{reference: "vault://secret/data/bytebase-admin#password", allowed: false},
+		{reference: "vault://secret/data/bytebase/../admin#password", allowed: false},
+		{reference: "vault://secret/data/admin#password", allowed: false},
+	}
+	for _, test := range tests {
+		ref, err := ParseReference(test.reference)
+		require.NoError(t, err)
+		err = allowlist.Check(ref)
+		if test.allowed {
+			assert.NoError(t, err, test.reference)
+		} else {
+			assert.Error(t, err, test.reference)
+		}
+	}
+
+	// The schemes are disabled without the allowlist.
+	for _, reference := range []string{"file:///run/secrets/mysql-password", "env://BB_SECRET_MYSQL_PASSWORD", "vault://secret/data/bytebase/mysql#password"} {
+		ref, err := ParseReference(reference)
+		require.NoError(t, err)
+		assert.Error(t, (&Allowlist{}).Check(ref), reference)
+	}
+}
@@ -0,0 +1,214 @@
+// Package vault is the secret provider for the HashiCorp Vault KV and database secrets engines.
+package vault
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/backend/plugin/secret"
+)
+
+const (
+	// addressEnv is the environment variable for the Vault server address, e.g. https://vault.example.com:8200.
+	// It's the same as the one used by the Vault CLI.
+	addressEnv = "VAULT_ADDR"
+	// tokenEnv is the environment variable for the Vault token.
+	tokenEnv = "VAULT_TOKEN"
+	// namespaceEnv is the environment variable for the Vault Enterprise namespace.
+	namespaceEnv = "VAULT_NAMESPACE"
+)
+
+func init() {
+	secret.Register(secret.Vault, newProvider)
+}
+
+var _ secret.Provider = (*Provider)(nil)
+
+// Provider is the Vault KV secret provider.
+type Provider struct {
+	client *http.Client
+}
+
+func newProvider(config secret.ProviderConfig) secret.Provider {
+	return NewProvider(config)
+}
+
+// NewProvider creates a new Vault provider.
+func NewProvider(config secret.ProviderConfig) *Provider {
+	if config.Client == nil {
+		config.Client = &http.Client{}
+	}
+	return &Provider{
+		client: config.Client,
+	}
+}
+
+// secretResponse is the response of reading a secret.
+// For KV version 1, the key-value pairs are in data. For KV version 2, they are in data.data.
+//
+// Docs: https://developer.hashicorp.com/vault/api-docs/secret/kv/kv-v2#read-secret-version
+type secretResponse struct {
+	Data map[string]any `json:"data"`
+}
+
+// GetSecret returns the value of the key in the Vault secret, e.g. vault://secret/data/mysql#password.
+// The path is the API path of the secret, which contains "data/" for the KV version 2 secrets engine.
+func (p *Provider) GetSecret(ctx context.Context, reference *secret.Reference) (string, error) {
+	if reference.Key == "" {
+		return "", errors.Errorf("key is required for the Vault secret, e.g. vault://secret/data/mysql#password")
+	}
+	body, err := p.do(ctx, http.MethodGet, reference.Path, nil)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to read Vault secret %q", reference.Path)
+	}
+
+	var r secretResponse
+	if err := json.Unmarshal(body, &r); err != nil {
+		return "", errors.Wrapf(err, "failed to unmarshal Vault secret %q", reference.Path)
+	}
+	data := r.Data
+	// The KV version 2 secrets engine nests the key-value pairs with the metadata.
+	if nested, ok := data["data"].(map[string]any); ok {
+		if _, ok := data["metadata"]; ok {
+			data = nested
+		}
+	}
+	value, ok := data[reference.Key].(string)
+	if !ok {
+		return "", errors.Errorf("string key %q not found in Vault secret %q", reference.Key, reference.Path)
+	}
+	return value, nil
+}
+
+// DatabaseCredential is the dynamic database credential leased from the Vault database secrets engine.
+type DatabaseCredential struct {
+	Username string
+	Password string
+	// LeaseID is used to renew and revoke the lease.
+	LeaseID string
+	// LeaseDuration is the time to live of the credential.
+	LeaseDuration time.Duration
+	Renewable     bool
+}
+
+// leaseResponse is the response of the leased secret.
+//
+// Docs: https://developer.hashicorp.com/vault/api-docs/secret/databases#generate-credentials
+type leaseResponse struct {
+	LeaseID       string `json:"lease_id"`
+	LeaseDuration int64  `json:"lease_duration"`
+	Renewable     bool   `json:"renewable"`
+	Data          struct {
+		Username string `json:"username"`
+		Password string `json:"password"`
+	} `json:"data"`
+}
+
+// GetDatabaseCredential generates a dynamic database credential of the role, e.g. database/creds/readonly.
+func (p *Provider) GetDatabaseCredential(ctx context.Context, path string) (*DatabaseCredential, error) {
+	body, err := p.do(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to generate Vault database credential %q", path)
+	}
+	var r leaseResponse
+	if err := json.Unmarshal(body, &r); err != nil {
+		return nil, errors.Wrapf(err, "failed to unmarshal Vault database credential %q", path)
+	}
+	if r.Data.Username == "" {
+		return nil, errors.Errorf("username not found in Vault database credential %q", path)
+	}
+	return &DatabaseCredential{
+		Username:      r.Data.Username,
+		Password:      r.Data.Password,
+		LeaseID:       r.LeaseID,
+		LeaseDuration: time.Duration(r.LeaseDuration) * time.Second,
+		Renewable:     r.Renewable,
+	}, nil
+}
+
+// RenewLease renews the lease by the increment and returns the new lease duration.
+// Vault may grant a shorter duration than the increment, e.g. when the max TTL is reached.
+//
+// Docs: https://developer.hashicorp.com/vault/api-docs/system/leases#renew-lease
+func (p *Provider) RenewLease(ctx context.Context, leaseID string, increment time.Duration) (time.Duration, error) {
+	payload, err := json.Marshal(map[string]any{
+		"lease_id":  leaseID,
+		"increment": int64(increment.Seconds()),
+	})
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to marshal lease renewal")
+	}
+	body, err := p.do(ctx, http.MethodPut, "sys/leases/renew", payload)
+	if err != nil {
+		return 0, errors.Wrapf(err, "failed to renew Vault lease %q", leaseID)
+	}
+	var r leaseResponse
+	if err := json.Unmarshal(body, &r); err != nil {
+		return 0, errors.Wrapf(err, "failed to unmarshal Vault lease %q", leaseID)
+	}
+	return time.Duration(r.LeaseDuration) * time.Second, nil
+}
+
+// RevokeLease revokes the lease so that Vault drops the database credential immediately.
+//
+// Docs: https://developer.hashicorp.com/vault/api-docs/system/leases#revoke-lease
+func (p *Provider) RevokeLease(ctx context.Context, leaseID string) error {
+	payload, err := json.Marshal(map[string]any{
+		"lease_id": leaseID,
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal lease revocation")
+	}
+	if _, err := p.do(ctx, http.MethodPut, "sys/leases/revoke", payload); err != nil {
+		return errors.Wrapf(err, "failed to revoke Vault lease %q", leaseID)
+	}
+	return nil
+}
+
+// do sends the request to the Vault API path and returns the response body.
+func (p *Provider) do(ctx context.Context, method, path string, payload []byte) ([]byte, error) {
+	address := os.Getenv(addressEnv)
+	if address == "" {
+		return nil, errors.Errorf("environment variable %s is not set", addressEnv)
+	}
+
+	url := fmt.Sprintf("%s/v1/%s", strings.TrimSuffix(address, "/"), strings.TrimPrefix(path, "/"))
+	var reader io.Reader
+	if payload != nil {
+		reader = bytes.NewReader(payload)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, url, reader)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to construct %s %s", method, url)
+	}
+	req.Header.Set("X-Vault-Token", os.Getenv(tokenEnv))
+	if namespace := os.Getenv(namespaceEnv); namespace != "" {
+		req.Header.Set("X-Vault-Namespace", namespace)
+	}
+	if payload != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to %s %s", method, url)
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to read response body from %s", url)
+	}
+	// Vault responds 204 No Content for the requests without the response data, e.g. the lease revocation.
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNoContent {
+		return nil, errors.Errorf("status code: %d, body: %s", resp.StatusCode, body)
+	}
+	return body, nil
+}
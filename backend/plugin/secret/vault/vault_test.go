@@ -0,0 +1,98 @@
+package vault
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bytebase/bytebase/backend/plugin/secret"
+)
+
+func TestProvider_GetSecret(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "s.token", r.Header.Get("X-Vault-Token"))
+		switch r.URL.Path {
+		case "/v1/secret/data/mysql":
+			_, _ = w.Write([]byte(`{"data": {"data": {"password": "kv2-password"}, "metadata": {"version": 1}}}`))
+		case "/v1/kv/mysql":
+			_, _ = w.Write([]byte(`{"data": {"password": "kv1-password"}}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"errors": []}`))
+		}
+	}))
+	defer server.Close()
+	t.Setenv(addressEnv, server.URL)
+	t.Setenv(tokenEnv, "s.token")
+
+	p := newProvider(secret.ProviderConfig{})
+	ctx := context.Background()
+
+	value, err := p.GetSecret(ctx, &secret.Reference{Scheme: secret.Vault, Path: "secret/data/mysql", Key: "password"})
+	require.NoError(t, err)
+	assert.Equal(t, "kv2-password", value)
+
+	value, err = p.GetSecret(ctx, &secret.Reference{Scheme: secret.Vault, Path: "kv/mysql", Key: "password"})
+	require.NoError(t, err)
+	assert.Equal(t, "kv1-password", value)
+
+	_, err = p.GetSecret(ctx, &secret.Reference{Scheme: secret.Vault, Path: "kv/mysql", Key: "username"})
+	require.EqualError(t, err, `string key "username" not found in Vault secret "kv/mysql"`)
+
+	_, err = p.GetSecret(ctx, &secret.Reference{Scheme: secret.Vault, Path: "kv/postgres", Key: "password"})
+	require.Error(t, err)
+}
+
+func TestProvider_DatabaseCredential(t *testing.T) {
+	var revoked []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/v1/database/creds/readonly":
+			_, _ = w.Write([]byte(`{"lease_id": "database/creds/readonly/abc", "lease_duration": 3600, "renewable": true, "data": {"username": "v-readonly-abc", "password": "secret"}}`))
+		case r.Method == http.MethodPut && r.URL.Path == "/v1/sys/leases/renew":
+			var body map[string]any
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+			assert.Equal(t, "database/creds/readonly/abc", body["lease_id"])
+			assert.Equal(t, float64(3600), body["increment"])
+			_, _ = w.Write([]byte(`{"lease_id": "database/creds/readonly/abc", "lease_duration": 1800, "renewable": true}`))
+		case r.Method == http.MethodPut && r.URL.Path == "/v1/sys/leases/revoke":
+			var body map[string]any
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+			revoked = append(revoked, body["lease_id"].(string))
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+	t.Setenv(addressEnv, server.URL)
+
+	p := NewProvider(secret.ProviderConfig{})
+	ctx := context.Background()
+
+	credential, err := p.GetDatabaseCredential(ctx, "database/creds/readonly")
+	require.NoError(t, err)
+	assert.Equal(t, &DatabaseCredential{
+		Username:      "v-readonly-abc",
+		Password:      "secret",
+		LeaseID:       "database/creds/readonly/abc",
+		LeaseDuration: time.Hour,
+		Renewable:     true,
+	}, credential)
+
+	duration, err := p.RenewLease(ctx, credential.LeaseID, credential.LeaseDuration)
+	require.NoError(t, err)
+	assert.Equal(t, 30*time.Minute, duration)
+
+	require.NoError(t, p.RevokeLease(ctx, credential.LeaseID))
+	assert.Equal(t, []string{"database/creds/readonly/abc"}, revoked)
+
+	_, err = p.GetDatabaseCredential(ctx, "database/creds/unknown")
+	require.Error(t, err)
+}
@@ -0,0 +1,1406 @@
+// Package azure is the plugin for Azure DevOps Repos.
+package azure
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"path"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/backend/common"
+	"github.com/bytebase/bytebase/backend/plugin/vcs"
+	"github.com/bytebase/bytebase/backend/plugin/vcs/internal/oauth"
+)
+
+const (
+	// apiVersion is the Azure DevOps REST API version.
+	apiVersion = "7.0"
+	// apiPageSize is the default page size when making API requests.
+	apiPageSize = 100
+	// emptyObjectID is the object ID used to create or delete a ref.
+	emptyObjectID = "0000000000000000000000000000000000000000"
+	// azureDevOpsScope is the Microsoft Entra ID scope of Azure DevOps, the GUID is the
+	// well-known application ID of Azure DevOps.
+	azureDevOpsScope = "499b84ac-1321-427f-aa17-267ca6975798/.default offline_access"
+)
+
+var (
+	// microsoftEntraTokenURL is the Microsoft Entra ID token endpoint, Azure DevOps
+	// OAuth apps are registered in Microsoft Entra ID.
+	microsoftEntraTokenURL = "https://login.microsoftonline.com/organizations/oauth2/v2.0/token"
+
+	commitIDRegex = regexp.MustCompile(`^[0-9a-f]{40}$`)
+)
+
+func init() {
+	vcs.Register(vcs.AzureDevOps, newProvider)
+}
+
+var _ vcs.Provider = (*Provider)(nil)
+
+// Provider is an Azure DevOps VCS provider.
+//
+// The instance URL is the organization URL, e.g. https://dev.azure.com/{organization}, and the
+// repository ID is "{projectID}/{repositoryID}" because most of the Git APIs are project scoped.
+type Provider struct {
+	client *http.Client
+}
+
+func newProvider(config vcs.ProviderConfig) vcs.Provider {
+	if config.Client == nil {
+		config.Client = &http.Client{}
+	}
+	return &Provider{
+		client: config.Client,
+	}
+}
+
+// APIURL returns the API URL path of Azure DevOps, which is the organization URL.
+func (*Provider) APIURL(instanceURL string) string {
+	return instanceURL
+}
+
+// ConnectionData represents an Azure DevOps API response for the connection data.
+type ConnectionData struct {
+	AuthenticatedUser struct {
+		ID                  string `json:"id"`
+		ProviderDisplayName string `json:"providerDisplayName"`
+		Properties          struct {
+			Account struct {
+				Value string `json:"$value"`
+			} `json:"Account"`
+		} `json:"properties"`
+	} `json:"authenticatedUser"`
+}
+
+// Project represents an Azure DevOps API response for a project.
+type Project struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+// Repository represents an Azure DevOps API response for a repository.
+type Repository struct {
+	ID        string  `json:"id"`
+	Name      string  `json:"name"`
+	WebURL    string  `json:"webUrl"`
+	RemoteURL string  `json:"remoteUrl"`
+	Project   Project `json:"project"`
+}
+
+// GitUserDate represents an Azure DevOps API message for a commit author or committer.
+type GitUserDate struct {
+	Name  string    `json:"name"`
+	Email string    `json:"email"`
+	Date  time.Time `json:"date,omitempty"`
+}
+
+// Commit represents an Azure DevOps API response for a commit.
+type Commit struct {
+	CommitID  string      `json:"commitId"`
+	Comment   string      `json:"comment"`
+	Author    GitUserDate `json:"author"`
+	RemoteURL string      `json:"remoteUrl"`
+}
+
+// Item represents an Azure DevOps API response for a repository item.
+type Item struct {
+	ObjectID      string `json:"objectId"`
+	CommitID      string `json:"commitId"`
+	Path          string `json:"path"`
+	GitObjectType string `json:"gitObjectType"`
+	IsFolder      bool   `json:"isFolder"`
+	Content       string `json:"content"`
+}
+
+// Change represents an Azure DevOps API response for an item change.
+type Change struct {
+	Item Item `json:"item"`
+	// ChangeType is a comma-separated list, e.g. "add", "edit", "delete", "edit, rename".
+	ChangeType string `json:"changeType"`
+}
+
+// CommitDiffs represents an Azure DevOps API response for the diffs between two commits.
+type CommitDiffs struct {
+	AllChangesIncluded bool     `json:"allChangesIncluded"`
+	Changes            []Change `json:"changes"`
+}
+
+// Ref represents an Azure DevOps API response for a Git ref.
+type Ref struct {
+	Name     string `json:"name"`
+	ObjectID string `json:"objectId"`
+}
+
+// RefUpdate represents an Azure DevOps API message for updating a Git ref.
+type RefUpdate struct {
+	Name        string `json:"name"`
+	OldObjectID string `json:"oldObjectId"`
+	NewObjectID string `json:"newObjectId,omitempty"`
+}
+
+// RefUpdateResult represents an Azure DevOps API response for updating a Git ref.
+type RefUpdateResult struct {
+	Name          string `json:"name"`
+	Success       bool   `json:"success"`
+	CustomMessage string `json:"customMessage"`
+	UpdateStatus  string `json:"updateStatus"`
+}
+
+// ItemContent represents an Azure DevOps API message for the content of a changed item.
+type ItemContent struct {
+	Content     string `json:"content"`
+	ContentType string `json:"contentType"`
+}
+
+// PushChange represents an Azure DevOps API message for a change in a push.
+type PushChange struct {
+	ChangeType string      `json:"changeType"`
+	Item       Item        `json:"item"`
+	NewContent ItemContent `json:"newContent"`
+}
+
+// PushCommit represents an Azure DevOps API message for a commit in a push.
+type PushCommit struct {
+	Comment string       `json:"comment"`
+	Author  *GitUserDate `json:"author,omitempty"`
+	Changes []PushChange `json:"changes"`
+}
+
+// Push represents an Azure DevOps API message to push commits.
+type Push struct {
+	RefUpdates []RefUpdate  `json:"refUpdates"`
+	Commits    []PushCommit `json:"commits"`
+}
+
+// PullRequest represents an Azure DevOps API response for a pull request.
+type PullRequest struct {
+	PullRequestID int    `json:"pullRequestId"`
+	Title         string `json:"title"`
+	Description   string `json:"description"`
+	// Status is the pull request status, available values are "active", "abandoned" and "completed".
+	Status        string     `json:"status"`
+	SourceRefName string     `json:"sourceRefName"`
+	TargetRefName string     `json:"targetRefName"`
+	Repository    Repository `json:"repository"`
+	CreatedBy     struct {
+		DisplayName string `json:"displayName"`
+		UniqueName  string `json:"uniqueName"`
+	} `json:"createdBy"`
+	LastMergeSourceCommit struct {
+		CommitID string `json:"commitId"`
+	} `json:"lastMergeSourceCommit"`
+}
+
+// PullRequestCreate represents an Azure DevOps API request for creating a pull request.
+type PullRequestCreate struct {
+	SourceRefName     string `json:"sourceRefName"`
+	TargetRefName     string `json:"targetRefName"`
+	Title             string `json:"title"`
+	Description       string `json:"description"`
+	CompletionOptions struct {
+		DeleteSourceBranch bool `json:"deleteSourceBranch"`
+	} `json:"completionOptions"`
+}
+
+// PullRequestIteration represents an Azure DevOps API response for a pull request iteration.
+type PullRequestIteration struct {
+	ID int `json:"id"`
+}
+
+// PullRequestIterationChanges represents an Azure DevOps API response for the changes of a pull request iteration.
+type PullRequestIterationChanges struct {
+	ChangeEntries []Change `json:"changeEntries"`
+	NextSkip      int      `json:"nextSkip"`
+	NextTop       int      `json:"nextTop"`
+}
+
+// ThreadStatus is the status of a pull request comment thread.
+type ThreadStatus string
+
+const (
+	// ThreadStatusActive is the status of an active thread.
+	ThreadStatusActive ThreadStatus = "active"
+	// ThreadStatusClosed is the status of a closed thread.
+	ThreadStatusClosed ThreadStatus = "closed"
+)
+
+// ThreadComment represents an Azure DevOps API message for a pull request comment.
+type ThreadComment struct {
+	ParentCommentID int    `json:"parentCommentId"`
+	Content         string `json:"content"`
+	CommentType     string `json:"commentType"`
+}
+
+// ThreadCreate represents an Azure DevOps API request for creating a pull request comment thread.
+type ThreadCreate struct {
+	Comments []ThreadComment `json:"comments"`
+	Status   ThreadStatus    `json:"status"`
+}
+
+// CommitStatusContext represents an Azure DevOps API message for the context of a commit status.
+type CommitStatusContext struct {
+	Name  string `json:"name"`
+	Genre string `json:"genre"`
+}
+
+// CommitStatusCreate represents an Azure DevOps API request for creating a commit status.
+type CommitStatusCreate struct {
+	// State is the commit status state, available values are "pending", "succeeded", "failed" and "error".
+	State       string              `json:"state"`
+	Description string              `json:"description"`
+	TargetURL   string              `json:"targetUrl"`
+	Context     CommitStatusContext `json:"context"`
+}
+
+// WebhookEventType is the Azure DevOps service hook event type.
+type WebhookEventType string
+
+const (
+	// WebhookPush is the event type for code pushed.
+	WebhookPush WebhookEventType = "git.push"
+	// WebhookPullRequestCreated is the event type for pull request created.
+	WebhookPullRequestCreated WebhookEventType = "git.pullrequest.created"
+	// WebhookPullRequestUpdated is the event type for pull request updated.
+	WebhookPullRequestUpdated WebhookEventType = "git.pullrequest.updated"
+)
+
+// WebhookCreateOrUpdate represents a Bytebase request for creating or updating the service hooks of a repository.
+//
+// Azure DevOps service hook subscription only subscribes one event type, so the provider creates one
+// subscription per event type and joins the subscription IDs with comma as the webhook ID.
+type WebhookCreateOrUpdate struct {
+	// URL is the URL to which the payloads will be delivered.
+	URL string `json:"url"`
+	// Username and Password are sent with basic authentication, because Azure DevOps does not sign the payload.
+	Username   string             `json:"username"`
+	Password   string             `json:"password"`
+	EventTypes []WebhookEventType `json:"eventTypes"`
+}
+
+// Subscription represents an Azure DevOps API message for a service hook subscription.
+type Subscription struct {
+	ID               string            `json:"id,omitempty"`
+	PublisherID      string            `json:"publisherId"`
+	EventType        WebhookEventType  `json:"eventType"`
+	ResourceVersion  string            `json:"resourceVersion"`
+	ConsumerID       string            `json:"consumerId"`
+	ConsumerActionID string            `json:"consumerActionId"`
+	PublisherInputs  map[string]string `json:"publisherInputs"`
+	ConsumerInputs   map[string]string `json:"consumerInputs"`
+}
+
+// WebhookEvent is the API message for the service hook event envelope.
+type WebhookEvent struct {
+	EventType WebhookEventType `json:"eventType"`
+	Resource  json.RawMessage  `json:"resource"`
+}
+
+// WebhookCommit is the API message for the commit in the push event.
+type WebhookCommit struct {
+	CommitID string      `json:"commitId"`
+	Author   GitUserDate `json:"author"`
+	Comment  string      `json:"comment"`
+	URL      string      `json:"url"`
+}
+
+// WebhookPushResource is the API message for the push event resource.
+type WebhookPushResource struct {
+	Commits    []WebhookCommit `json:"commits"`
+	RefUpdates []RefUpdate     `json:"refUpdates"`
+	Repository Repository      `json:"repository"`
+	PushedBy   struct {
+		DisplayName string `json:"displayName"`
+		UniqueName  string `json:"uniqueName"`
+	} `json:"pushedBy"`
+}
+
+// oauthResponse is a Microsoft Entra ID OAuth response.
+type oauthResponse struct {
+	AccessToken      string `json:"access_token"`
+	RefreshToken     string `json:"refresh_token"`
+	ExpiresIn        int64  `json:"expires_in"`
+	Error            string `json:"error,omitempty"`
+	ErrorDescription string `json:"error_description,omitempty"`
+}
+
+// toVCSOAuthToken converts the response to *vcs.OAuthToken.
+func (o oauthResponse) toVCSOAuthToken() *vcs.OAuthToken {
+	oauthToken := &vcs.OAuthToken{
+		AccessToken:  o.AccessToken,
+		RefreshToken: o.RefreshToken,
+		ExpiresIn:    o.ExpiresIn,
+		CreatedAt:    time.Now().Unix(),
+	}
+	if oauthToken.ExpiresIn != 0 {
+		oauthToken.ExpiresTs = oauthToken.CreatedAt + oauthToken.ExpiresIn
+	}
+	return oauthToken
+}
+
+// ExchangeOAuthToken exchanges OAuth content with the provided authorization code.
+//
+// Docs: https://learn.microsoft.com/en-us/entra/identity-platform/v2-oauth2-auth-code-flow
+func (p *Provider) ExchangeOAuthToken(ctx context.Context, _ string, oauthExchange *common.OAuthExchange) (*vcs.OAuthToken, error) {
+	params := &url.Values{}
+	params.Set("client_id", oauthExchange.ClientID)
+	params.Set("client_secret", oauthExchange.ClientSecret)
+	params.Set("code", oauthExchange.Code)
+	params.Set("redirect_uri", oauthExchange.RedirectURL)
+	params.Set("grant_type", "authorization_code")
+	params.Set("scope", azureDevOpsScope)
+	return requestOAuthToken(ctx, p.client, params)
+}
+
+func requestOAuthToken(ctx context.Context, client *http.Client, params *url.Values) (*vcs.OAuthToken, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, microsoftEntraTokenURL, strings.NewReader(params.Encode()))
+	if err != nil {
+		return nil, errors.Wrapf(err, "construct POST %s", microsoftEntraTokenURL)
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to request OAuth token")
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to read OAuth response body, code %v", resp.StatusCode)
+	}
+
+	oauthResp := new(oauthResponse)
+	if err := json.Unmarshal(body, oauthResp); err != nil {
+		return nil, errors.Wrapf(err, "failed to unmarshal OAuth response body, code %v", resp.StatusCode)
+	}
+	if oauthResp.Error != "" {
+		return nil, errors.Errorf("failed to request OAuth token, error: %v, error_description: %v", oauthResp.Error, oauthResp.ErrorDescription)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, errors.Errorf("failed to request OAuth token, status code: %d, body: %s", resp.StatusCode, body)
+	}
+	return oauthResp.toVCSOAuthToken(), nil
+}
+
+// TryLogin tries to fetch the user info from the current OAuth context.
+//
+// Docs: https://learn.microsoft.com/en-us/rest/api/azure/devops/#connection-data
+func (p *Provider) TryLogin(ctx context.Context, oauthCtx common.OauthContext, instanceURL string) (*vcs.UserInfo, error) {
+	url := fmt.Sprintf("%s/_apis/connectionData", p.APIURL(instanceURL))
+	code, body, err := p.request(ctx, http.MethodGet, url, oauthCtx, nil)
+	if err != nil {
+		return nil, err
+	}
+	if code == http.StatusNotFound {
+		return nil, common.Errorf(common.NotFound, "failed to read user info from URL %s", url)
+	} else if code >= 300 {
+		return nil, errors.Errorf("failed to read user info from URL %s, status code: %d, body: %s", url, code, body)
+	}
+
+	var data ConnectionData
+	if err := json.Unmarshal([]byte(body), &data); err != nil {
+		return nil, errors.Wrap(err, "unmarshal")
+	}
+	return &vcs.UserInfo{
+		PublicEmail: data.AuthenticatedUser.Properties.Account.Value,
+		Name:        data.AuthenticatedUser.ProviderDisplayName,
+		State:       vcs.StateActive,
+	}, nil
+}
+
+// FetchCommitByID fetches the commit data by its ID from the repository.
+//
+// Docs: https://learn.microsoft.com/en-us/rest/api/azure/devops/git/commits/get
+func (p *Provider) FetchCommitByID(ctx context.Context, oauthCtx common.OauthContext, instanceURL, repositoryID, commitID string) (*vcs.Commit, error) {
+	url := p.repositoryURL(instanceURL, repositoryID, fmt.Sprintf("commits/%s", commitID), nil)
+	code, body, err := p.request(ctx, http.MethodGet, url, oauthCtx, nil)
+	if err != nil {
+		return nil, err
+	}
+	if code == http.StatusNotFound {
+		return nil, common.Errorf(common.NotFound, "failed to fetch commit data from URL %s", url)
+	} else if code >= 300 {
+		return nil, errors.Errorf("failed to fetch commit data from URL %s, status code: %d, body: %s", url, code, body)
+	}
+
+	commit := &Commit{}
+	if err := json.Unmarshal([]byte(body), commit); err != nil {
+		return nil, errors.Wrap(err, "unmarshal body")
+	}
+	// Per Git convention, the message title and body are separated by two new line characters.
+	messages := strings.SplitN(commit.Comment, "\n\n", 2)
+	return &vcs.Commit{
+		ID:          commit.CommitID,
+		Title:       strings.TrimSpace(messages[0]),
+		Message:     commit.Comment,
+		CreatedTs:   commit.Author.Date.Unix(),
+		URL:         commit.RemoteURL,
+		AuthorName:  commit.Author.Name,
+		AuthorEmail: commit.Author.Email,
+	}, nil
+}
+
+// GetDiffFileList gets the diff files list between two commits.
+//
+// Docs: https://learn.microsoft.com/en-us/rest/api/azure/devops/git/diffs/get
+func (p *Provider) GetDiffFileList(ctx context.Context, oauthCtx common.OauthContext, instanceURL, repositoryID, beforeCommit, afterCommit string) ([]vcs.FileDiff, error) {
+	var ret []vcs.FileDiff
+	for skip := 0; ; skip += apiPageSize {
+		url := p.repositoryURL(instanceURL, repositoryID, "diffs/commits", url.Values{
+			"baseVersion":       {beforeCommit},
+			"baseVersionType":   {"commit"},
+			"targetVersion":     {afterCommit},
+			"targetVersionType": {"commit"},
+			"$top":              {strconv.Itoa(apiPageSize)},
+			"$skip":             {strconv.Itoa(skip)},
+		})
+		code, body, err := p.request(ctx, http.MethodGet, url, oauthCtx, nil)
+		if err != nil {
+			return nil, err
+		}
+		if code == http.StatusNotFound {
+			return nil, common.Errorf(common.NotFound, "failed to get file diff list from URL %s", url)
+		} else if code >= 300 {
+			return nil, errors.Errorf("failed to get file diff list from URL %s, status code: %d, body: %s", url, code, body)
+		}
+
+		diffs := &CommitDiffs{}
+		if err := json.Unmarshal([]byte(body), diffs); err != nil {
+			return nil, errors.Wrapf(err, "failed to unmarshal file diff data from Azure DevOps instance %s", instanceURL)
+		}
+		ret = append(ret, convertChangesToFileDiffs(diffs.Changes)...)
+		if diffs.AllChangesIncluded || len(diffs.Changes) < apiPageSize {
+			break
+		}
+	}
+	return ret, nil
+}
+
+// ListCommitChanges lists the changed files of the given commit.
+//
+// The push event of Azure DevOps doesn't contain the changed files, so the caller uses this method
+// to find the changes of each pushed commit.
+//
+// Docs: https://learn.microsoft.com/en-us/rest/api/azure/devops/git/commits/get-changes
+func (p *Provider) ListCommitChanges(ctx context.Context, oauthCtx common.OauthContext, instanceURL, repositoryID, commitID string) ([]vcs.FileDiff, error) {
+	var ret []vcs.FileDiff
+	for skip := 0; ; skip += apiPageSize {
+		url := p.repositoryURL(instanceURL, repositoryID, fmt.Sprintf("commits/%s/changes", commitID), url.Values{
+			"top":  {strconv.Itoa(apiPageSize)},
+			"skip": {strconv.Itoa(skip)},
+		})
+		code, body, err := p.request(ctx, http.MethodGet, url, oauthCtx, nil)
+		if err != nil {
+			return nil, err
+		}
+		if code == http.StatusNotFound {
+			return nil, common.Errorf(common.NotFound, "failed to list commit changes from URL %s", url)
+		} else if code >= 300 {
+			return nil, errors.Errorf("failed to list commit changes from URL %s, status code: %d, body: %s", url, code, body)
+		}
+
+		var changes struct {
+			Changes []Change `json:"changes"`
+		}
+		if err := json.Unmarshal([]byte(body), &changes); err != nil {
+			return nil, errors.Wrap(err, "unmarshal body")
+		}
+		ret = append(ret, convertChangesToFileDiffs(changes.Changes)...)
+		if len(changes.Changes) < apiPageSize {
+			break
+		}
+	}
+	return ret, nil
+}
+
+func convertChangesToFileDiffs(changes []Change) []vcs.FileDiff {
+	var ret []vcs.FileDiff
+	for _, change := range changes {
+		if change.Item.IsFolder || change.Item.GitObjectType == "tree" {
+			continue
+		}
+		diffType := vcs.FileDiffTypeUnknown
+		switch {
+		case hasChangeType(change.ChangeType, "delete"):
+			diffType = vcs.FileDiffTypeRemoved
+		case hasChangeType(change.ChangeType, "add"):
+			diffType = vcs.FileDiffTypeAdded
+		case hasChangeType(change.ChangeType, "edit"), hasChangeType(change.ChangeType, "rename"):
+			diffType = vcs.FileDiffTypeModified
+		}
+		ret = append(ret, vcs.FileDiff{
+			Path: strings.TrimPrefix(change.Item.Path, "/"),
+			Type: diffType,
+		})
+	}
+	return ret
+}
+
+// hasChangeType returns true if the comma-separated change type list contains the given type.
+func hasChangeType(changeType, want string) bool {
+	for _, t := range strings.Split(changeType, ",") {
+		if strings.TrimSpace(t) == want {
+			return true
+		}
+	}
+	return false
+}
+
+// FetchAllRepositoryList fetches all repositories in the organization that the
+// authenticated user has access to. The repository ID is "{projectID}/{repositoryID}".
+//
+// Docs: https://learn.microsoft.com/en-us/rest/api/azure/devops/git/repositories/list
+func (p *Provider) FetchAllRepositoryList(ctx context.Context, oauthCtx common.OauthContext, instanceURL string) ([]*vcs.Repository, error) {
+	url := fmt.Sprintf("%s/_apis/git/repositories?api-version=%s", p.APIURL(instanceURL), apiVersion)
+	code, body, err := p.request(ctx, http.MethodGet, url, oauthCtx, nil)
+	if err != nil {
+		return nil, err
+	}
+	if code == http.StatusNotFound {
+		return nil, common.Errorf(common.NotFound, "failed to fetch repository list from URL %s", url)
+	} else if code >= 300 {
+		return nil, errors.Errorf("failed to fetch repository list from URL %s, status code: %d, body: %s", url, code, body)
+	}
+
+	var repos struct {
+		Value []Repository `json:"value"`
+	}
+	if err := json.Unmarshal([]byte(body), &repos); err != nil {
+		return nil, errors.Wrap(err, "unmarshal")
+	}
+
+	var allRepos []*vcs.Repository
+	for _, r := range repos.Value {
+		allRepos = append(allRepos,
+			&vcs.Repository{
+				ID:       fmt.Sprintf("%s/%s", r.Project.ID, r.ID),
+				Name:     r.Name,
+				FullPath: fmt.Sprintf("%s/%s", r.Project.Name, r.Name),
+				WebURL:   r.WebURL,
+			},
+		)
+	}
+	return allRepos, nil
+}
+
+// FetchRepositoryFileList fetches the all files from the given repository tree
+// recursively.
+//
+// Docs: https://learn.microsoft.com/en-us/rest/api/azure/devops/git/items/list
+func (p *Provider) FetchRepositoryFileList(ctx context.Context, oauthCtx common.OauthContext, instanceURL, repositoryID, ref, filePath string) ([]*vcs.RepositoryTreeNode, error) {
+	query := versionDescriptor(ref)
+	query.Set("scopePath", "/"+strings.Trim(filePath, "/"))
+	query.Set("recursionLevel", "full")
+	url := p.repositoryURL(instanceURL, repositoryID, "items", query)
+	code, body, err := p.request(ctx, http.MethodGet, url, oauthCtx, nil)
+	if err != nil {
+		return nil, err
+	}
+	if code == http.StatusNotFound {
+		return nil, common.Errorf(common.NotFound, "failed to fetch repository file list from URL %s", url)
+	} else if code >= 300 {
+		return nil, errors.Errorf("failed to fetch repository file list from URL %s, status code: %d, body: %s", url, code, body)
+	}
+
+	var items struct {
+		Value []Item `json:"value"`
+	}
+	if err := json.Unmarshal([]byte(body), &items); err != nil {
+		return nil, errors.Wrap(err, "unmarshal body")
+	}
+
+	var allTreeNodes []*vcs.RepositoryTreeNode
+	for _, item := range items.Value {
+		if item.IsFolder || item.GitObjectType != "blob" {
+			continue
+		}
+		allTreeNodes = append(allTreeNodes,
+			&vcs.RepositoryTreeNode{
+				Path: strings.TrimPrefix(item.Path, "/"),
+				Type: item.GitObjectType,
+			},
+		)
+	}
+	return allTreeNodes, nil
+}
+
+// CreateFile creates a file at given path in the repository.
+//
+// Docs: https://learn.microsoft.com/en-us/rest/api/azure/devops/git/pushes/create
+func (p *Provider) CreateFile(ctx context.Context, oauthCtx common.OauthContext, instanceURL, repositoryID, filePath string, fileCommitCreate vcs.FileCommitCreate) error {
+	return p.pushFile(ctx, oauthCtx, instanceURL, repositoryID, filePath, fileCommitCreate, "add")
+}
+
+// OverwriteFile overwrites an existing file at given path in the repository.
+//
+// Docs: https://learn.microsoft.com/en-us/rest/api/azure/devops/git/pushes/create
+func (p *Provider) OverwriteFile(ctx context.Context, oauthCtx common.OauthContext, instanceURL, repositoryID, filePath string, fileCommitCreate vcs.FileCommitCreate) error {
+	return p.pushFile(ctx, oauthCtx, instanceURL, repositoryID, filePath, fileCommitCreate, "edit")
+}
+
+// pushFile pushes a commit with a single file change to the branch. Azure DevOps requires the
+// current head of the branch to update the ref, which also detects the conflicting writes.
+func (p *Provider) pushFile(ctx context.Context, oauthCtx common.OauthContext, instanceURL, repositoryID, filePath string, fileCommitCreate vcs.FileCommitCreate, changeType string) error {
+	branch, err := p.GetBranch(ctx, oauthCtx, instanceURL, repositoryID, fileCommitCreate.Branch)
+	if err != nil {
+		return errors.Wrapf(err, "failed to get branch %q", fileCommitCreate.Branch)
+	}
+
+	commit := PushCommit{
+		Comment: fileCommitCreate.CommitMessage,
+		Changes: []PushChange{
+			{
+				ChangeType: changeType,
+				Item:       Item{Path: "/" + strings.TrimPrefix(filePath, "/")},
+				NewContent: ItemContent{
+					Content:     fileCommitCreate.Content,
+					ContentType: "rawtext",
+				},
+			},
+		},
+	}
+	if fileCommitCreate.AuthorName != "" && fileCommitCreate.AuthorEmail != "" {
+		commit.Author = &GitUserDate{
+			Name:  fileCommitCreate.AuthorName,
+			Email: fileCommitCreate.AuthorEmail,
+			Date:  time.Now().UTC(),
+		}
+	}
+	body, err := json.Marshal(Push{
+		RefUpdates: []RefUpdate{
+			{
+				Name:        "refs/heads/" + fileCommitCreate.Branch,
+				OldObjectID: branch.LastCommitID,
+			},
+		},
+		Commits: []PushCommit{commit},
+	})
+	if err != nil {
+		return errors.Wrap(err, "marshal push")
+	}
+
+	url := p.repositoryURL(instanceURL, repositoryID, "pushes", nil)
+	code, resp, err := p.request(ctx, http.MethodPost, url, oauthCtx, body)
+	if err != nil {
+		return err
+	}
+	if code == http.StatusNotFound {
+		return common.Errorf(common.NotFound, "failed to create/update file through URL %s", url)
+	} else if code >= 300 {
+		return errors.Errorf("failed to create/update file through URL %s, status code: %d, body: %s", url, code, resp)
+	}
+	return nil
+}
+
+// ReadFileMeta reads the metadata of the given file in the repository.
+//
+// Docs: https://learn.microsoft.com/en-us/rest/api/azure/devops/git/items/get
+func (p *Provider) ReadFileMeta(ctx context.Context, oauthCtx common.OauthContext, instanceURL, repositoryID, filePath, ref string) (*vcs.FileMeta, error) {
+	item, err := p.readFile(ctx, oauthCtx, instanceURL, repositoryID, filePath, ref)
+	if err != nil {
+		return nil, err
+	}
+	return &vcs.FileMeta{
+		Name:         path.Base(item.Path),
+		Path:         strings.TrimPrefix(item.Path, "/"),
+		Size:         int64(len(item.Content)),
+		SHA:          item.ObjectID,
+		LastCommitID: item.CommitID,
+	}, nil
+}
+
+// ReadFileContent reads the content of the given file in the repository.
+//
+// Docs: https://learn.microsoft.com/en-us/rest/api/azure/devops/git/items/get
+func (p *Provider) ReadFileContent(ctx context.Context, oauthCtx common.OauthContext, instanceURL, repositoryID, filePath, ref string) (string, error) {
+	item, err := p.readFile(ctx, oauthCtx, instanceURL, repositoryID, filePath, ref)
+	if err != nil {
+		return "", err
+	}
+	return item.Content, nil
+}
+
+func (p *Provider) readFile(ctx context.Context, oauthCtx common.OauthContext, instanceURL, repositoryID, filePath, ref string) (*Item, error) {
+	query := versionDescriptor(ref)
+	query.Set("path", "/"+strings.TrimPrefix(filePath, "/"))
+	query.Set("includeContent", "true")
+	query.Set("$format", "json")
+	url := p.repositoryURL(instanceURL, repositoryID, "items", query)
+	code, body, err := p.request(ctx, http.MethodGet, url, oauthCtx, nil)
+	if err != nil {
+		return nil, err
+	}
+	if code == http.StatusNotFound {
+		return nil, common.Errorf(common.NotFound, "failed to read file from URL %s", url)
+	} else if code >= 300 {
+		return nil, errors.Errorf("failed to read file from URL %s, status code: %d, body: %s", url, code, body)
+	}
+
+	item := &Item{}
+	if err := json.Unmarshal([]byte(body), item); err != nil {
+		return nil, errors.Wrap(err, "unmarshal body")
+	}
+	if item.IsFolder {
+		return nil, errors.Errorf("%q is a directory not a file", filePath)
+	}
+	return item, nil
+}
+
+// GetBranch gets the given branch in the repository.
+//
+// Docs: https://learn.microsoft.com/en-us/rest/api/azure/devops/git/refs/list
+func (p *Provider) GetBranch(ctx context.Context, oauthCtx common.OauthContext, instanceURL, repositoryID, branchName string) (*vcs.BranchInfo, error) {
+	url := p.repositoryURL(instanceURL, repositoryID, "refs", url.Values{"filter": {"heads/" + branchName}})
+	code, body, err := p.request(ctx, http.MethodGet, url, oauthCtx, nil)
+	if err != nil {
+		return nil, err
+	}
+	if code == http.StatusNotFound {
+		return nil, common.Errorf(common.NotFound, "failed to get branch from URL %s", url)
+	} else if code >= 300 {
+		return nil, errors.Errorf("failed to get branch from URL %s, status code: %d, body: %s", url, code, body)
+	}
+
+	var refs struct {
+		Value []Ref `json:"value"`
+	}
+	if err := json.Unmarshal([]byte(body), &refs); err != nil {
+		return nil, err
+	}
+	// The filter matches the prefix of ref names, so we need to find the exact one.
+	for _, ref := range refs.Value {
+		if ref.Name == "refs/heads/"+branchName {
+			return &vcs.BranchInfo{
+				Name:         branchName,
+				LastCommitID: ref.ObjectID,
+			}, nil
+		}
+	}
+	return nil, common.Errorf(common.NotFound, "branch %q not found", branchName)
+}
+
+// CreateBranch creates the branch in the repository.
+//
+// Docs: https://learn.microsoft.com/en-us/rest/api/azure/devops/git/refs/update-refs
+func (p *Provider) CreateBranch(ctx context.Context, oauthCtx common.OauthContext, instanceURL, repositoryID string, branch *vcs.BranchInfo) error {
+	body, err := json.Marshal([]RefUpdate{
+		{
+			Name:        "refs/heads/" + branch.Name,
+			OldObjectID: emptyObjectID,
+			NewObjectID: branch.LastCommitID,
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "marshal branch create")
+	}
+
+	url := p.repositoryURL(instanceURL, repositoryID, "refs", nil)
+	code, resp, err := p.request(ctx, http.MethodPost, url, oauthCtx, body)
+	if err != nil {
+		return err
+	}
+	if code == http.StatusNotFound {
+		return common.Errorf(common.NotFound, "failed to create branch from URL %s", url)
+	} else if code >= 300 {
+		return errors.Errorf("failed to create branch from URL %s, status code: %d, body: %s", url, code, resp)
+	}
+
+	var results struct {
+		Value []RefUpdateResult `json:"value"`
+	}
+	if err := json.Unmarshal([]byte(resp), &results); err != nil {
+		return errors.Wrap(err, "unmarshal body")
+	}
+	for _, result := range results.Value {
+		if !result.Success {
+			return errors.Errorf("failed to create branch %q, status: %s, message: %s", branch.Name, result.UpdateStatus, result.CustomMessage)
+		}
+	}
+	return nil
+}
+
+// ListPullRequestFile lists the changed files in the latest iteration of the pull request.
+//
+// Docs: https://learn.microsoft.com/en-us/rest/api/azure/devops/git/pull-request-iteration-changes/get
+func (p *Provider) ListPullRequestFile(ctx context.Context, oauthCtx common.OauthContext, instanceURL, repositoryID, pullRequestID string) ([]*vcs.PullRequestFile, error) {
+	pullRequest, err := p.getPullRequest(ctx, oauthCtx, instanceURL, repositoryID, pullRequestID)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get pull request")
+	}
+	iterationID, err := p.getLatestPullRequestIteration(ctx, oauthCtx, instanceURL, repositoryID, pullRequestID)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get pull request iteration")
+	}
+
+	var res []*vcs.PullRequestFile
+	skip := 0
+	for {
+		url := p.repositoryURL(instanceURL, repositoryID, fmt.Sprintf("pullRequests/%s/iterations/%d/changes", pullRequestID, iterationID), url.Values{
+			"$top":  {strconv.Itoa(apiPageSize)},
+			"$skip": {strconv.Itoa(skip)},
+		})
+		code, body, err := p.request(ctx, http.MethodGet, url, oauthCtx, nil)
+		if err != nil {
+			return nil, err
+		}
+		if code == http.StatusNotFound {
+			return nil, common.Errorf(common.NotFound, "failed to list pull request file from URL %s", url)
+		} else if code >= 300 {
+			return nil, errors.Errorf("failed to list pull request file from URL %s, status code: %d, body: %s", url, code, body)
+		}
+
+		changes := &PullRequestIterationChanges{}
+		if err := json.Unmarshal([]byte(body), changes); err != nil {
+			return nil, errors.Wrap(err, "unmarshal body")
+		}
+		for _, diff := range convertChangesToFileDiffs(changes.ChangeEntries) {
+			res = append(res, &vcs.PullRequestFile{
+				Path:         diff.Path,
+				LastCommitID: pullRequest.LastMergeSourceCommit.CommitID,
+				IsDeleted:    diff.Type == vcs.FileDiffTypeRemoved,
+			})
+		}
+		if changes.NextSkip <= 0 {
+			break
+		}
+		skip = changes.NextSkip
+	}
+	return res, nil
+}
+
+func (p *Provider) getPullRequest(ctx context.Context, oauthCtx common.OauthContext, instanceURL, repositoryID, pullRequestID string) (*PullRequest, error) {
+	url := p.repositoryURL(instanceURL, repositoryID, fmt.Sprintf("pullRequests/%s", pullRequestID), nil)
+	code, body, err := p.request(ctx, http.MethodGet, url, oauthCtx, nil)
+	if err != nil {
+		return nil, err
+	}
+	if code == http.StatusNotFound {
+		return nil, common.Errorf(common.NotFound, "failed to get pull request from URL %s", url)
+	} else if code >= 300 {
+		return nil, errors.Errorf("failed to get pull request from URL %s, status code: %d, body: %s", url, code, body)
+	}
+
+	pullRequest := &PullRequest{}
+	if err := json.Unmarshal([]byte(body), pullRequest); err != nil {
+		return nil, errors.Wrap(err, "unmarshal body")
+	}
+	return pullRequest, nil
+}
+
+func (p *Provider) getLatestPullRequestIteration(ctx context.Context, oauthCtx common.OauthContext, instanceURL, repositoryID, pullRequestID string) (int, error) {
+	url := p.repositoryURL(instanceURL, repositoryID, fmt.Sprintf("pullRequests/%s/iterations", pullRequestID), nil)
+	code, body, err := p.request(ctx, http.MethodGet, url, oauthCtx, nil)
+	if err != nil {
+		return 0, err
+	}
+	if code == http.StatusNotFound {
+		return 0, common.Errorf(common.NotFound, "failed to list pull request iterations from URL %s", url)
+	} else if code >= 300 {
+		return 0, errors.Errorf("failed to list pull request iterations from URL %s, status code: %d, body: %s", url, code, body)
+	}
+
+	var iterations struct {
+		Value []PullRequestIteration `json:"value"`
+	}
+	if err := json.Unmarshal([]byte(body), &iterations); err != nil {
+		return 0, errors.Wrap(err, "unmarshal body")
+	}
+	latest := 0
+	for _, iteration := range iterations.Value {
+		if iteration.ID > latest {
+			latest = iteration.ID
+		}
+	}
+	if latest == 0 {
+		return 0, errors.Errorf("pull request %s has no iteration", pullRequestID)
+	}
+	return latest, nil
+}
+
+// CreatePullRequest creates the pull request in the repository.
+//
+// Docs: https://learn.microsoft.com/en-us/rest/api/azure/devops/git/pull-requests/create
+func (p *Provider) CreatePullRequest(ctx context.Context, oauthCtx common.OauthContext, instanceURL, repositoryID string, pullRequestCreate *vcs.PullRequestCreate) (*vcs.PullRequest, error) {
+	create := PullRequestCreate{
+		SourceRefName: "refs/heads/" + pullRequestCreate.Head,
+		TargetRefName: "refs/heads/" + pullRequestCreate.Base,
+		Title:         pullRequestCreate.Title,
+		Description:   pullRequestCreate.Body,
+	}
+	create.CompletionOptions.DeleteSourceBranch = pullRequestCreate.RemoveHeadAfterMerged
+	body, err := json.Marshal(create)
+	if err != nil {
+		return nil, errors.Wrap(err, "marshal pull request create")
+	}
+
+	url := p.repositoryURL(instanceURL, repositoryID, "pullrequests", nil)
+	code, resp, err := p.request(ctx, http.MethodPost, url, oauthCtx, body)
+	if err != nil {
+		return nil, err
+	}
+	if code == http.StatusNotFound {
+		return nil, common.Errorf(common.NotFound, "failed to create pull request from URL %s", url)
+	} else if code >= 300 {
+		return nil, errors.Errorf("failed to create pull request from URL %s, status code: %d, body: %s", url, code, resp)
+	}
+
+	var res PullRequest
+	if err := json.Unmarshal([]byte(resp), &res); err != nil {
+		return nil, err
+	}
+	return &vcs.PullRequest{
+		URL: fmt.Sprintf("%s/pullrequest/%d", res.Repository.WebURL, res.PullRequestID),
+	}, nil
+}
+
+// GetPullRequest gets the pull request in the repository.
+func (p *Provider) GetPullRequest(ctx context.Context, oauthCtx common.OauthContext, instanceURL, repositoryID, pullRequestID string) (*vcs.PullRequest, error) {
+	pullRequest, err := p.getPullRequest(ctx, oauthCtx, instanceURL, repositoryID, pullRequestID)
+	if err != nil {
+		return nil, err
+	}
+	return convertToPullRequest(pullRequest), nil
+}
+
+// ListOpenPullRequests lists the first page of the active pull requests to the base branch in the repository.
+//
+// Docs: https://learn.microsoft.com/en-us/rest/api/azure/devops/git/pull-requests/get-pull-requests
+func (p *Provider) ListOpenPullRequests(ctx context.Context, oauthCtx common.OauthContext, instanceURL, repositoryID, baseBranch string) ([]*vcs.PullRequest, error) {
+	url := p.repositoryURL(instanceURL, repositoryID, "pullrequests", url.Values{
+		"searchCriteria.status":        {"active"},
+		"searchCriteria.targetRefName": {"refs/heads/" + baseBranch},
+		"$top":                         {strconv.Itoa(apiPageSize)},
+	})
+	code, body, err := p.request(ctx, http.MethodGet, url, oauthCtx, nil)
+	if err != nil {
+		return nil, err
+	}
+	if code == http.StatusNotFound {
+		return nil, common.Errorf(common.NotFound, "failed to list pull requests from URL %s", url)
+	} else if code >= 300 {
+		return nil, errors.Errorf("failed to list pull requests from URL %s, status code: %d, body: %s", url, code, body)
+	}
+
+	var list struct {
+		Value []*PullRequest `json:"value"`
+	}
+	if err := json.Unmarshal([]byte(body), &list); err != nil {
+		return nil, errors.Wrap(err, "unmarshal body")
+	}
+	var res []*vcs.PullRequest
+	for _, pullRequest := range list.Value {
+		res = append(res, convertToPullRequest(pullRequest))
+	}
+	return res, nil
+}
+
+func convertToPullRequest(pullRequest *PullRequest) *vcs.PullRequest {
+	state := vcs.PullRequestStateOpen
+	switch pullRequest.Status {
+	case "completed":
+		state = vcs.PullRequestStateMerged
+	case "abandoned":
+		state = vcs.PullRequestStateClosed
+	}
+	return &vcs.PullRequest{
+		URL:          fmt.Sprintf("%s/pullrequest/%d", pullRequest.Repository.WebURL, pullRequest.PullRequestID),
+		State:        state,
+		HeadCommitID: pullRequest.LastMergeSourceCommit.CommitID,
+		HeadBranch:   strings.TrimPrefix(pullRequest.SourceRefName, "refs/heads/"),
+		BaseBranch:   strings.TrimPrefix(pullRequest.TargetRefName, "refs/heads/"),
+	}
+}
+
+// CreatePullRequestThread creates a comment thread in the pull request.
+//
+// Docs: https://learn.microsoft.com/en-us/rest/api/azure/devops/git/pull-request-threads/create
+func (p *Provider) CreatePullRequestThread(ctx context.Context, oauthCtx common.OauthContext, instanceURL, repositoryID, pullRequestID, content string, status ThreadStatus) error {
+	body, err := json.Marshal(ThreadCreate{
+		Comments: []ThreadComment{
+			{
+				ParentCommentID: 0,
+				Content:         content,
+				CommentType:     "text",
+			},
+		},
+		Status: status,
+	})
+	if err != nil {
+		return errors.Wrap(err, "marshal thread create")
+	}
+
+	url := p.repositoryURL(instanceURL, repositoryID, fmt.Sprintf("pullRequests/%s/threads", pullRequestID), nil)
+	code, resp, err := p.request(ctx, http.MethodPost, url, oauthCtx, body)
+	if err != nil {
+		return err
+	}
+	if code == http.StatusNotFound {
+		return common.Errorf(common.NotFound, "failed to create pull request thread from URL %s", url)
+	} else if code >= 300 {
+		return errors.Errorf("failed to create pull request thread from URL %s, status code: %d, body: %s", url, code, resp)
+	}
+	return nil
+}
+
+// CreatePullRequestComment creates an active comment thread in the pull request.
+func (p *Provider) CreatePullRequestComment(ctx context.Context, oauthCtx common.OauthContext, instanceURL, repositoryID, pullRequestID, comment string) error {
+	return p.CreatePullRequestThread(ctx, oauthCtx, instanceURL, repositoryID, pullRequestID, comment, ThreadStatusActive)
+}
+
+// CreateCommitStatus creates the status of the commit. The context name of the status is split
+// into the genre and the name by the last slash, e.g. "bytebase/review".
+//
+// Docs: https://learn.microsoft.com/en-us/rest/api/azure/devops/git/statuses/create
+func (p *Provider) CreateCommitStatus(ctx context.Context, oauthCtx common.OauthContext, instanceURL, repositoryID, commitID string, commitStatus *vcs.CommitStatus) error {
+	statusContext := CommitStatusContext{Name: commitStatus.Context}
+	if i := strings.LastIndex(commitStatus.Context, "/"); i >= 0 {
+		statusContext = CommitStatusContext{
+			Name:  commitStatus.Context[i+1:],
+			Genre: commitStatus.Context[:i],
+		}
+	}
+	body, err := json.Marshal(CommitStatusCreate{
+		State:       convertToCommitStatusState(commitStatus.State),
+		Description: commitStatus.Description,
+		TargetURL:   commitStatus.TargetURL,
+		Context:     statusContext,
+	})
+	if err != nil {
+		return errors.Wrap(err, "marshal commit status create")
+	}
+
+	url := p.repositoryURL(instanceURL, repositoryID, fmt.Sprintf("commits/%s/statuses", commitID), nil)
+	code, resp, err := p.request(ctx, http.MethodPost, url, oauthCtx, body)
+	if err != nil {
+		return err
+	}
+	if code == http.StatusNotFound {
+		return common.Errorf(common.NotFound, "failed to create commit status from URL %s", url)
+	} else if code >= 300 {
+		return errors.Errorf("failed to create commit status from URL %s, status code: %d, body: %s", url, code, resp)
+	}
+	return nil
+}
+
+func convertToCommitStatusState(state vcs.CommitStatusState) string {
+	switch state {
+	case vcs.CommitStatusSuccess:
+		return "succeeded"
+	case vcs.CommitStatusFailure:
+		return "failed"
+	case vcs.CommitStatusError:
+		return "error"
+	default:
+		return "pending"
+	}
+}
+
+// UpsertEnvironmentVariable is not supported, Bytebase reviews the pull request through the
+// service hooks and posts the result as the pull request comment instead of running the pipeline.
+func (*Provider) UpsertEnvironmentVariable(context.Context, common.OauthContext, string, string, string, string) error {
+	return errors.New("not supported")
+}
+
+// CreateWebhook creates the service hook subscriptions of the repository with given payload.
+// The payload is the JSON of WebhookCreateOrUpdate.
+//
+// Docs: https://learn.microsoft.com/en-us/rest/api/azure/devops/hooks/subscriptions/create
+func (p *Provider) CreateWebhook(ctx context.Context, oauthCtx common.OauthContext, instanceURL, repositoryID string, payload []byte) (string, error) {
+	subscriptions, err := buildSubscriptions(repositoryID, payload)
+	if err != nil {
+		return "", err
+	}
+
+	var ids []string
+	url := fmt.Sprintf("%s/_apis/hooks/subscriptions?api-version=%s", p.APIURL(instanceURL), apiVersion)
+	for _, subscription := range subscriptions {
+		body, err := json.Marshal(subscription)
+		if err != nil {
+			return "", errors.Wrap(err, "marshal subscription")
+		}
+		code, resp, err := p.request(ctx, http.MethodPost, url, oauthCtx, body)
+		if err != nil {
+			return "", err
+		}
+		if code == http.StatusNotFound {
+			return "", common.Errorf(common.NotFound, "failed to create webhook through URL %s", url)
+		} else if code >= 300 {
+			return "", errors.Errorf("failed to create webhook through URL %s, status code: %d, body: %s", url, code, resp)
+		}
+
+		var created Subscription
+		if err := json.Unmarshal([]byte(resp), &created); err != nil {
+			return "", errors.Wrap(err, "unmarshal body")
+		}
+		ids = append(ids, created.ID)
+	}
+	return strings.Join(ids, ","), nil
+}
+
+// PatchWebhook replaces the service hook subscriptions of the repository with given payload.
+// The event types in the payload must be in the same order as the ones used to create the webhook.
+//
+// Docs: https://learn.microsoft.com/en-us/rest/api/azure/devops/hooks/subscriptions/replace-subscription
+func (p *Provider) PatchWebhook(ctx context.Context, oauthCtx common.OauthContext, instanceURL, repositoryID, webhookID string, payload []byte) error {
+	subscriptions, err := buildSubscriptions(repositoryID, payload)
+	if err != nil {
+		return err
+	}
+	ids := strings.Split(webhookID, ",")
+	if len(ids) != len(subscriptions) {
+		return errors.Errorf("expect %d event types for webhook %q, got %d", len(ids), webhookID, len(subscriptions))
+	}
+
+	for i, subscription := range subscriptions {
+		subscription.ID = ids[i]
+		body, err := json.Marshal(subscription)
+		if err != nil {
+			return errors.Wrap(err, "marshal subscription")
+		}
+		url := fmt.Sprintf("%s/_apis/hooks/subscriptions/%s?api-version=%s", p.APIURL(instanceURL), ids[i], apiVersion)
+		code, resp, err := p.request(ctx, http.MethodPut, url, oauthCtx, body)
+		if err != nil {
+			return err
+		}
+		if code == http.StatusNotFound {
+			return common.Errorf(common.NotFound, "failed to patch webhook through URL %s", url)
+		} else if code >= 300 {
+			return errors.Errorf("failed to patch webhook through URL %s, status code: %d, body: %s", url, code, resp)
+		}
+	}
+	return nil
+}
+
+// DeleteWebhook deletes the service hook subscriptions of the repository.
+//
+// Docs: https://learn.microsoft.com/en-us/rest/api/azure/devops/hooks/subscriptions/delete
+func (p *Provider) DeleteWebhook(ctx context.Context, oauthCtx common.OauthContext, instanceURL, _, webhookID string) error {
+	for _, id := range strings.Split(webhookID, ",") {
+		url := fmt.Sprintf("%s/_apis/hooks/subscriptions/%s?api-version=%s", p.APIURL(instanceURL), id, apiVersion)
+		code, body, err := p.request(ctx, http.MethodDelete, url, oauthCtx, nil)
+		if err != nil {
+			return err
+		}
+		if code == http.StatusNotFound {
+			continue // It is OK if the webhook has already gone
+		} else if code >= 300 {
+			return errors.Errorf("failed to delete webhook through URL %s, status code: %d, body: %s", url, code, body)
+		}
+	}
+	return nil
+}
+
+func buildSubscriptions(repositoryID string, payload []byte) ([]*Subscription, error) {
+	var webhook WebhookCreateOrUpdate
+	if err := json.Unmarshal(payload, &webhook); err != nil {
+		return nil, errors.Wrap(err, "unmarshal webhook payload")
+	}
+	projectID, repoID, err := splitRepositoryID(repositoryID)
+	if err != nil {
+		return nil, err
+	}
+
+	var subscriptions []*Subscription
+	for _, eventType := range webhook.EventTypes {
+		subscriptions = append(subscriptions, &Subscription{
+			PublisherID:      "tfs",
+			EventType:        eventType,
+			ResourceVersion:  "1.0",
+			ConsumerID:       "webHooks",
+			ConsumerActionID: "httpRequest",
+			PublisherInputs: map[string]string{
+				"projectId":  projectID,
+				"repository": repoID,
+			},
+			ConsumerInputs: map[string]string{
+				"url":                    webhook.URL,
+				"basicAuthUsername":      webhook.Username,
+				"basicAuthPassword":      webhook.Password,
+				"resourceDetailsToSend":  "all",
+				"messagesToSend":         "none",
+				"detailedMessagesToSend": "none",
+			},
+		})
+	}
+	return subscriptions, nil
+}
+
+// splitRepositoryID splits the repository ID "{projectID}/{repositoryID}".
+func splitRepositoryID(repositoryID string) (string, string, error) {
+	projectID, repoID, ok := strings.Cut(repositoryID, "/")
+	if !ok || projectID == "" || repoID == "" {
+		return "", "", errors.Errorf("invalid Azure DevOps repository ID %q, expect {projectID}/{repositoryID}", repositoryID)
+	}
+	return projectID, repoID, nil
+}
+
+// repositoryURL returns the URL of the Git API of the repository with the given sub-path and query.
+func (p *Provider) repositoryURL(instanceURL, repositoryID, subPath string, query url.Values) string {
+	if query == nil {
+		query = url.Values{}
+	}
+	query.Set("api-version", apiVersion)
+	// The repository ID is "{projectID}/{repositoryID}", and the IDs are GUIDs which don't need escaping.
+	projectID, repoID, _ := strings.Cut(repositoryID, "/")
+	return fmt.Sprintf("%s/%s/_apis/git/repositories/%s/%s?%s", p.APIURL(instanceURL), projectID, repoID, subPath, query.Encode())
+}
+
+// versionDescriptor returns the query of the version descriptor for the given ref, which could be
+// a branch name or a commit ID.
+func versionDescriptor(ref string) url.Values {
+	versionType := "branch"
+	if commitIDRegex.MatchString(ref) {
+		versionType = "commit"
+	}
+	return url.Values{
+		"versionDescriptor.version":     {ref},
+		"versionDescriptor.versionType": {versionType},
+	}
+}
+
+// request makes the HTTP request with the OAuth context. Azure DevOps accepts both the OAuth access
+// token and the personal access token, the latter is sent with basic authentication.
+func (p *Provider) request(ctx context.Context, method, url string, oauthCtx common.OauthContext, body []byte) (int, string, error) {
+	header := authorizationHeader(oauthCtx.AccessToken)
+	refresher := tokenRefresher(
+		oauthContext{
+			ClientID:     oauthCtx.ClientID,
+			ClientSecret: oauthCtx.ClientSecret,
+			RefreshToken: oauthCtx.RefreshToken,
+		},
+		oauthCtx.Refresher,
+	)
+
+	var code int
+	var resp string
+	var err error
+	switch method {
+	case http.MethodGet:
+		code, _, resp, err = oauth.GetWithHeader(ctx, p.client, url, &oauthCtx.AccessToken, refresher, header)
+	case http.MethodPost:
+		code, _, resp, err = oauth.PostWithHeader(ctx, p.client, url, &oauthCtx.AccessToken, bytes.NewReader(body), refresher, header)
+	case http.MethodPut:
+		code, _, resp, err = oauth.PutWithHeader(ctx, p.client, url, &oauthCtx.AccessToken, bytes.NewReader(body), refresher, header)
+	case http.MethodPatch:
+		code, _, resp, err = oauth.PatchWithHeader(ctx, p.client, url, &oauthCtx.AccessToken, bytes.NewReader(body), refresher, header)
+	case http.MethodDelete:
+		code, _, resp, err = oauth.DeleteWithHeader(ctx, p.client, url, &oauthCtx.AccessToken, refresher, header)
+	default:
+		return 0, "", errors.Errorf("unsupported method %s", method)
+	}
+	if err != nil {
+		return 0, "", errors.Wrapf(err, "%s %s", method, url)
+	}
+	return code, resp, nil
+}
+
+// authorizationHeader returns the basic authentication header for the personal access token. The
+// OAuth access tokens issued by Microsoft Entra ID are JWTs, while personal access tokens never
+// contain dots, so they are sent as bearer tokens by default.
+func authorizationHeader(token string) map[string]string {
+	if token == "" || strings.Contains(token, ".") {
+		return nil
+	}
+	return map[string]string{
+		"Authorization": "Basic " + base64.StdEncoding.EncodeToString([]byte(":"+token)),
+	}
+}
+
+// oauthContext is the request context for refreshing oauth token.
+type oauthContext struct {
+	ClientID     string
+	ClientSecret string
+	RefreshToken string
+}
+
+func tokenRefresher(oauthCtx oauthContext, refresher common.TokenRefresher) oauth.TokenRefresher {
+	return func(ctx context.Context, client *http.Client, oldToken *string) error {
+		if oauthCtx.RefreshToken == "" {
+			return errors.New("the access token has expired and cannot be refreshed, personal access token needs to be renewed manually")
+		}
+		params := &url.Values{}
+		params.Set("client_id", oauthCtx.ClientID)
+		params.Set("client_secret", oauthCtx.ClientSecret)
+		params.Set("refresh_token", oauthCtx.RefreshToken)
+		params.Set("grant_type", "refresh_token")
+		params.Set("scope", azureDevOpsScope)
+		token, err := requestOAuthToken(ctx, client, params)
+		if err != nil {
+			return err
+		}
+
+		// Update the old token to new value for retries.
+		*oldToken = token.AccessToken
+
+		return refresher(token.AccessToken, token.RefreshToken, token.ExpiresTs)
+	}
+}
+
+// RepositoryID returns the repository ID of Bytebase for the repository in the event.
+func (r Repository) RepositoryID() string {
+	return fmt.Sprintf("%s/%s", r.Project.ID, r.ID)
+}
+
+// ToVCS returns the push event of the given ref update in VCS format. The commits are converted
+// by the caller because the event doesn't contain the changed files of the commits.
+func (p WebhookPushResource) ToVCS(refUpdate RefUpdate, commitList []vcs.Commit) vcs.PushEvent {
+	return vcs.PushEvent{
+		VCSType:            vcs.AzureDevOps,
+		Ref:                refUpdate.Name,
+		Before:             refUpdate.OldObjectID,
+		After:              refUpdate.NewObjectID,
+		RepositoryID:       p.Repository.RepositoryID(),
+		RepositoryURL:      p.Repository.RemoteURL,
+		RepositoryFullPath: fmt.Sprintf("%s/%s", p.Repository.Project.Name, p.Repository.Name),
+		AuthorName:         p.PushedBy.DisplayName,
+		CommitList:         commitList,
+	}
+}
+
+// ToVCS converts the pull request of the given service hook event type to the VCS pull request event.
+// Azure DevOps reports the completion and the abandonment of the pull request as updates, so the
+// action is derived from the pull request status. It returns false if the event is not interesting
+// to the pull request flow.
+func (p PullRequest) ToVCS(eventType WebhookEventType) (vcs.PullRequestEvent, bool) {
+	var action vcs.PullRequestEventAction
+	switch {
+	case p.Status == "active" && eventType == WebhookPullRequestCreated:
+		action = vcs.PullRequestEventOpened
+	case p.Status == "active" && eventType == WebhookPullRequestUpdated:
+		action = vcs.PullRequestEventUpdated
+	case p.Status == "completed":
+		action = vcs.PullRequestEventMerged
+	case p.Status == "abandoned":
+		action = vcs.PullRequestEventClosed
+	default:
+		return vcs.PullRequestEvent{}, false
+	}
+	return vcs.PullRequestEvent{
+		VCSType:            vcs.AzureDevOps,
+		Action:             action,
+		RepositoryID:       p.Repository.RepositoryID(),
+		RepositoryURL:      p.Repository.RemoteURL,
+		RepositoryFullPath: fmt.Sprintf("%s/%s", p.Repository.Project.Name, p.Repository.Name),
+		PullRequestID:      strconv.Itoa(p.PullRequestID),
+		URL:                fmt.Sprintf("%s/pullrequest/%d", p.Repository.WebURL, p.PullRequestID),
+		Title:              p.Title,
+		Description:        p.Description,
+		AuthorName:         p.CreatedBy.DisplayName,
+		AuthorEmail:        p.CreatedBy.UniqueName,
+		HeadCommitID:       p.LastMergeSourceCommit.CommitID,
+		BaseBranch:         strings.TrimPrefix(p.TargetRefName, "refs/heads/"),
+	}, true
+}
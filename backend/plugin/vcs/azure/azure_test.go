@@ -0,0 +1,463 @@
+package azure
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bytebase/bytebase/backend/common"
+	"github.com/bytebase/bytebase/backend/plugin/vcs"
+)
+
+const (
+	testRepositoryID = "project-id/repo-id"
+	testRepoPath     = "/project-id/_apis/git/repositories/repo-id/"
+	// testPAT is a personal access token, which doesn't contain dots.
+	testPAT = "personalaccesstoken"
+)
+
+// newFakeAzureDevOps starts a fake Azure DevOps server with given handlers on the API paths.
+func newFakeAzureDevOps(t *testing.T, handlers map[string]http.HandlerFunc) (*Provider, string) {
+	mux := http.NewServeMux()
+	for pattern, handler := range handlers {
+		handler := handler
+		mux.HandleFunc(pattern, func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path != "/_apis/connectionData" {
+				assert.Equal(t, apiVersion, r.URL.Query().Get("api-version"))
+			}
+			handler(w, r)
+		})
+	}
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+	return newProvider(vcs.ProviderConfig{Client: server.Client()}).(*Provider), server.URL
+}
+
+func TestProvider_Authorization(t *testing.T) {
+	var authorization []string
+	p, instanceURL := newFakeAzureDevOps(t, map[string]http.HandlerFunc{
+		"/_apis/connectionData": func(w http.ResponseWriter, r *http.Request) {
+			authorization = append(authorization, r.Header.Get("Authorization"))
+			_, _ = io.WriteString(w, `{"authenticatedUser": {"id": "1", "providerDisplayName": "Octocat", "properties": {"Account": {"$type": "System.String", "$value": "octocat@contoso.com"}}}}`)
+		},
+	})
+	ctx := context.Background()
+
+	got, err := p.TryLogin(ctx, common.OauthContext{AccessToken: testPAT}, instanceURL)
+	require.NoError(t, err)
+	assert.Equal(t, &vcs.UserInfo{PublicEmail: "octocat@contoso.com", Name: "Octocat", State: vcs.StateActive}, got)
+
+	_, err = p.TryLogin(ctx, common.OauthContext{AccessToken: "header.payload.signature"}, instanceURL)
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{
+		"Basic " + base64.StdEncoding.EncodeToString([]byte(":"+testPAT)),
+		"Bearer header.payload.signature",
+	}, authorization)
+}
+
+func TestProvider_ExchangeOAuthToken(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, r.ParseForm())
+		assert.Equal(t, "authorization_code", r.PostForm.Get("grant_type"))
+		assert.Equal(t, "test_code", r.PostForm.Get("code"))
+		assert.Equal(t, azureDevOpsScope, r.PostForm.Get("scope"))
+		_, _ = io.WriteString(w, `{"access_token":"access","token_type":"Bearer","expires_in":3599,"refresh_token":"refresh"}`)
+	}))
+	t.Cleanup(server.Close)
+	originalTokenURL := microsoftEntraTokenURL
+	microsoftEntraTokenURL = server.URL
+	t.Cleanup(func() { microsoftEntraTokenURL = originalTokenURL })
+
+	p := newProvider(vcs.ProviderConfig{Client: server.Client()}).(*Provider)
+	got, err := p.ExchangeOAuthToken(context.Background(), "https://dev.azure.com/contoso", &common.OAuthExchange{
+		ClientID:     "test_client_id",
+		ClientSecret: "test_client_secret",
+		Code:         "test_code",
+		RedirectURL:  "http://localhost:3000",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "access", got.AccessToken)
+	assert.Equal(t, "refresh", got.RefreshToken)
+	assert.Equal(t, got.CreatedAt+3599, got.ExpiresTs)
+}
+
+func TestProvider_FetchCommitByID(t *testing.T) {
+	p, instanceURL := newFakeAzureDevOps(t, map[string]http.HandlerFunc{
+		testRepoPath + "commits/abc": func(w http.ResponseWriter, _ *http.Request) {
+			_, _ = io.WriteString(w, `
+{
+  "commitId": "abc",
+  "comment": "add readme\n\nmore details",
+  "author": {"name": "Octocat", "email": "octocat@contoso.com", "date": "2014-11-07T22:01:45Z"},
+  "remoteUrl": "https://dev.azure.com/contoso/project/_git/repo/commit/abc"
+}`)
+		},
+	})
+
+	got, err := p.FetchCommitByID(context.Background(), common.OauthContext{AccessToken: testPAT}, instanceURL, testRepositoryID, "abc")
+	require.NoError(t, err)
+	want := &vcs.Commit{
+		ID:          "abc",
+		Title:       "add readme",
+		Message:     "add readme\n\nmore details",
+		CreatedTs:   1415397705,
+		URL:         "https://dev.azure.com/contoso/project/_git/repo/commit/abc",
+		AuthorName:  "Octocat",
+		AuthorEmail: "octocat@contoso.com",
+	}
+	assert.Equal(t, want, got)
+}
+
+func TestProvider_GetDiffFileList(t *testing.T) {
+	p, instanceURL := newFakeAzureDevOps(t, map[string]http.HandlerFunc{
+		testRepoPath + "diffs/commits": func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "a", r.URL.Query().Get("baseVersion"))
+			assert.Equal(t, "b", r.URL.Query().Get("targetVersion"))
+			_, _ = io.WriteString(w, `
+{
+  "allChangesIncluded": true,
+  "changes": [
+    {"item": {"path": "/db", "isFolder": true, "gitObjectType": "tree"}, "changeType": "add"},
+    {"item": {"path": "/db/1.sql", "gitObjectType": "blob"}, "changeType": "add"},
+    {"item": {"path": "/db/2.sql", "gitObjectType": "blob"}, "changeType": "edit, rename"},
+    {"item": {"path": "/db/3.sql", "gitObjectType": "blob"}, "changeType": "delete"}
+  ]
+}`)
+		},
+	})
+
+	got, err := p.GetDiffFileList(context.Background(), common.OauthContext{AccessToken: testPAT}, instanceURL, testRepositoryID, "a", "b")
+	require.NoError(t, err)
+	want := []vcs.FileDiff{
+		{Path: "db/1.sql", Type: vcs.FileDiffTypeAdded},
+		{Path: "db/2.sql", Type: vcs.FileDiffTypeModified},
+		{Path: "db/3.sql", Type: vcs.FileDiffTypeRemoved},
+	}
+	assert.Equal(t, want, got)
+}
+
+func TestProvider_FetchAllRepositoryList(t *testing.T) {
+	p, instanceURL := newFakeAzureDevOps(t, map[string]http.HandlerFunc{
+		"/_apis/git/repositories": func(w http.ResponseWriter, _ *http.Request) {
+			_, _ = io.WriteString(w, `{"count": 1, "value": [{"id": "repo-id", "name": "repo", "webUrl": "https://dev.azure.com/contoso/project/_git/repo", "project": {"id": "project-id", "name": "project"}}]}`)
+		},
+	})
+
+	got, err := p.FetchAllRepositoryList(context.Background(), common.OauthContext{AccessToken: testPAT}, instanceURL)
+	require.NoError(t, err)
+	want := []*vcs.Repository{
+		{ID: testRepositoryID, Name: "repo", FullPath: "project/repo", WebURL: "https://dev.azure.com/contoso/project/_git/repo"},
+	}
+	assert.Equal(t, want, got)
+}
+
+func TestProvider_ReadFile(t *testing.T) {
+	p, instanceURL := newFakeAzureDevOps(t, map[string]http.HandlerFunc{
+		testRepoPath + "items": func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Query().Get("recursionLevel") == "full" {
+				assert.Equal(t, "/db", r.URL.Query().Get("scopePath"))
+				assert.Equal(t, "branch", r.URL.Query().Get("versionDescriptor.versionType"))
+				_, _ = io.WriteString(w, `{"value": [{"path": "/db", "isFolder": true, "gitObjectType": "tree"}, {"path": "/db/1.sql", "gitObjectType": "blob"}]}`)
+				return
+			}
+			assert.Equal(t, "/db/1.sql", r.URL.Query().Get("path"))
+			assert.Equal(t, "commit", r.URL.Query().Get("versionDescriptor.versionType"))
+			_, _ = io.WriteString(w, `{"objectId": "sha1", "commitId": "c1", "path": "/db/1.sql", "gitObjectType": "blob", "content": "SELECT 1"}`)
+		},
+	})
+	ctx := context.Background()
+	commitID := strings.Repeat("a", 40)
+
+	files, err := p.FetchRepositoryFileList(ctx, common.OauthContext{AccessToken: testPAT}, instanceURL, testRepositoryID, "main", "db")
+	require.NoError(t, err)
+	assert.Equal(t, []*vcs.RepositoryTreeNode{{Path: "db/1.sql", Type: "blob"}}, files)
+
+	meta, err := p.ReadFileMeta(ctx, common.OauthContext{AccessToken: testPAT}, instanceURL, testRepositoryID, "db/1.sql", commitID)
+	require.NoError(t, err)
+	assert.Equal(t, &vcs.FileMeta{Name: "1.sql", Path: "db/1.sql", Size: 8, SHA: "sha1", LastCommitID: "c1"}, meta)
+
+	content, err := p.ReadFileContent(ctx, common.OauthContext{AccessToken: testPAT}, instanceURL, testRepositoryID, "db/1.sql", commitID)
+	require.NoError(t, err)
+	assert.Equal(t, "SELECT 1", content)
+}
+
+func TestProvider_BranchAndPush(t *testing.T) {
+	var refUpdates []RefUpdate
+	var push Push
+	p, instanceURL := newFakeAzureDevOps(t, map[string]http.HandlerFunc{
+		testRepoPath + "refs": func(w http.ResponseWriter, r *http.Request) {
+			if r.Method == http.MethodGet {
+				assert.True(t, strings.HasPrefix(r.URL.Query().Get("filter"), "heads/main"))
+				_, _ = io.WriteString(w, `{"count": 2, "value": [{"name": "refs/heads/main-old", "objectId": "c0"}, {"name": "refs/heads/main", "objectId": "c1"}]}`)
+				return
+			}
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&refUpdates))
+			_, _ = io.WriteString(w, `{"count": 1, "value": [{"name": "refs/heads/feature", "success": true, "updateStatus": "succeeded"}]}`)
+		},
+		testRepoPath + "pushes": func(w http.ResponseWriter, r *http.Request) {
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&push))
+			w.WriteHeader(http.StatusCreated)
+			_, _ = io.WriteString(w, `{"pushId": 1}`)
+		},
+	})
+	ctx := context.Background()
+
+	branch, err := p.GetBranch(ctx, common.OauthContext{AccessToken: testPAT}, instanceURL, testRepositoryID, "main")
+	require.NoError(t, err)
+	assert.Equal(t, &vcs.BranchInfo{Name: "main", LastCommitID: "c1"}, branch)
+
+	_, err = p.GetBranch(ctx, common.OauthContext{AccessToken: testPAT}, instanceURL, testRepositoryID, "main-o")
+	assert.Equal(t, common.NotFound, common.ErrorCode(err))
+
+	err = p.CreateBranch(ctx, common.OauthContext{AccessToken: testPAT}, instanceURL, testRepositoryID, &vcs.BranchInfo{Name: "feature", LastCommitID: "c1"})
+	require.NoError(t, err)
+	assert.Equal(t, []RefUpdate{{Name: "refs/heads/feature", OldObjectID: emptyObjectID, NewObjectID: "c1"}}, refUpdates)
+
+	err = p.OverwriteFile(ctx, common.OauthContext{AccessToken: testPAT}, instanceURL, testRepositoryID, "db/1.sql", vcs.FileCommitCreate{
+		Branch:        "main",
+		Content:       "SELECT 2",
+		CommitMessage: "update",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []RefUpdate{{Name: "refs/heads/main", OldObjectID: "c1"}}, push.RefUpdates)
+	require.Len(t, push.Commits, 1)
+	assert.Equal(t, "update", push.Commits[0].Comment)
+	assert.Nil(t, push.Commits[0].Author)
+	assert.Equal(t, []PushChange{
+		{
+			ChangeType: "edit",
+			Item:       Item{Path: "/db/1.sql"},
+			NewContent: ItemContent{Content: "SELECT 2", ContentType: "rawtext"},
+		},
+	}, push.Commits[0].Changes)
+}
+
+func TestProvider_PullRequest(t *testing.T) {
+	var pullRequestCreate PullRequestCreate
+	var thread ThreadCreate
+	p, instanceURL := newFakeAzureDevOps(t, map[string]http.HandlerFunc{
+		testRepoPath + "pullRequests/3": func(w http.ResponseWriter, _ *http.Request) {
+			_, _ = io.WriteString(w, `{"pullRequestId": 3, "status": "active", "lastMergeSourceCommit": {"commitId": "c2"}}`)
+		},
+		testRepoPath + "pullRequests/3/iterations": func(w http.ResponseWriter, _ *http.Request) {
+			_, _ = io.WriteString(w, `{"count": 2, "value": [{"id": 1}, {"id": 2}]}`)
+		},
+		testRepoPath + "pullRequests/3/iterations/2/changes": func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Query().Get("$skip") == "0" {
+				_, _ = io.WriteString(w, `{"changeEntries": [{"item": {"path": "/db/1.sql"}, "changeType": "add"}], "nextSkip": 1, "nextTop": 100}`)
+				return
+			}
+			_, _ = io.WriteString(w, `{"changeEntries": [{"item": {"path": "/db/2.sql"}, "changeType": "delete"}]}`)
+		},
+		testRepoPath + "pullRequests/3/threads": func(w http.ResponseWriter, r *http.Request) {
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&thread))
+			_, _ = io.WriteString(w, `{"id": 1}`)
+		},
+		testRepoPath + "pullrequests": func(w http.ResponseWriter, r *http.Request) {
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&pullRequestCreate))
+			w.WriteHeader(http.StatusCreated)
+			_, _ = io.WriteString(w, `{"pullRequestId": 4, "repository": {"webUrl": "https://dev.azure.com/contoso/project/_git/repo"}}`)
+		},
+	})
+	ctx := context.Background()
+
+	files, err := p.ListPullRequestFile(ctx, common.OauthContext{AccessToken: testPAT}, instanceURL, testRepositoryID, "3")
+	require.NoError(t, err)
+	want := []*vcs.PullRequestFile{
+		{Path: "db/1.sql", LastCommitID: "c2"},
+		{Path: "db/2.sql", LastCommitID: "c2", IsDeleted: true},
+	}
+	assert.Equal(t, want, files)
+
+	pr, err := p.CreatePullRequest(ctx, common.OauthContext{AccessToken: testPAT}, instanceURL, testRepositoryID, &vcs.PullRequestCreate{
+		Title:                 "title",
+		Body:                  "body",
+		Head:                  "feature",
+		Base:                  "main",
+		RemoveHeadAfterMerged: true,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "https://dev.azure.com/contoso/project/_git/repo/pullrequest/4", pr.URL)
+	assert.Equal(t, "refs/heads/feature", pullRequestCreate.SourceRefName)
+	assert.Equal(t, "refs/heads/main", pullRequestCreate.TargetRefName)
+	assert.True(t, pullRequestCreate.CompletionOptions.DeleteSourceBranch)
+
+	err = p.CreatePullRequestThread(ctx, common.OauthContext{AccessToken: testPAT}, instanceURL, testRepositoryID, "3", "content", ThreadStatusActive)
+	require.NoError(t, err)
+	assert.Equal(t, ThreadCreate{
+		Comments: []ThreadComment{{Content: "content", CommentType: "text"}},
+		Status:   ThreadStatusActive,
+	}, thread)
+}
+
+func TestProvider_Webhook(t *testing.T) {
+	var created []Subscription
+	var replaced []string
+	var deleted []string
+	p, instanceURL := newFakeAzureDevOps(t, map[string]http.HandlerFunc{
+		"/_apis/hooks/subscriptions": func(w http.ResponseWriter, r *http.Request) {
+			var subscription Subscription
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&subscription))
+			subscription.ID = string(subscription.EventType)
+			created = append(created, subscription)
+			_ = json.NewEncoder(w).Encode(subscription)
+		},
+		"/_apis/hooks/subscriptions/": func(w http.ResponseWriter, r *http.Request) {
+			id := strings.TrimPrefix(r.URL.Path, "/_apis/hooks/subscriptions/")
+			switch r.Method {
+			case http.MethodPut:
+				replaced = append(replaced, id)
+			case http.MethodDelete:
+				if id == string(WebhookPullRequestUpdated) {
+					w.WriteHeader(http.StatusNotFound)
+					return
+				}
+				deleted = append(deleted, id)
+				w.WriteHeader(http.StatusNoContent)
+			}
+		},
+	})
+	ctx := context.Background()
+
+	payload, err := json.Marshal(WebhookCreateOrUpdate{
+		URL:        "http://bytebase/hook/azure/1",
+		Username:   "bytebase",
+		Password:   "secret",
+		EventTypes: []WebhookEventType{WebhookPush, WebhookPullRequestCreated, WebhookPullRequestUpdated},
+	})
+	require.NoError(t, err)
+	id, err := p.CreateWebhook(ctx, common.OauthContext{AccessToken: testPAT}, instanceURL, testRepositoryID, payload)
+	require.NoError(t, err)
+	assert.Equal(t, "git.push,git.pullrequest.created,git.pullrequest.updated", id)
+	require.Len(t, created, 3)
+	assert.Equal(t, map[string]string{"projectId": "project-id", "repository": "repo-id"}, created[0].PublisherInputs)
+	assert.Equal(t, "secret", created[0].ConsumerInputs["basicAuthPassword"])
+
+	require.NoError(t, p.PatchWebhook(ctx, common.OauthContext{AccessToken: testPAT}, instanceURL, testRepositoryID, id, payload))
+	assert.Equal(t, []string{"git.push", "git.pullrequest.created", "git.pullrequest.updated"}, replaced)
+
+	// Deleting a subscription that has gone is fine.
+	require.NoError(t, p.DeleteWebhook(ctx, common.OauthContext{AccessToken: testPAT}, instanceURL, testRepositoryID, id))
+	assert.Equal(t, []string{"git.push", "git.pullrequest.created"}, deleted)
+
+	_, err = p.CreateWebhook(ctx, common.OauthContext{AccessToken: testPAT}, instanceURL, "repo-id", payload)
+	assert.Error(t, err)
+}
+
+func TestWebhookPushResource_ToVCS(t *testing.T) {
+	body := `
+{
+  "eventType": "git.push",
+  "resource": {
+    "commits": [{"commitId": "b", "author": {"name": "Octocat", "email": "octocat@contoso.com", "date": "2014-11-07T22:01:45Z"}, "comment": "add schema", "url": "https://dev.azure.com/contoso/_apis/git/repositories/repo-id/commits/b"}],
+    "refUpdates": [{"name": "refs/heads/main", "oldObjectId": "a", "newObjectId": "b"}],
+    "repository": {"id": "repo-id", "name": "repo", "remoteUrl": "https://dev.azure.com/contoso/project/_git/repo", "project": {"id": "project-id", "name": "project"}},
+    "pushedBy": {"displayName": "Octocat", "uniqueName": "octocat@contoso.com"}
+  }
+}`
+	var event WebhookEvent
+	require.NoError(t, json.Unmarshal([]byte(body), &event))
+	assert.Equal(t, WebhookPush, event.EventType)
+	var resource WebhookPushResource
+	require.NoError(t, json.Unmarshal(event.Resource, &resource))
+
+	commitList := []vcs.Commit{{ID: "b"}}
+	want := vcs.PushEvent{
+		VCSType:            vcs.AzureDevOps,
+		Ref:                "refs/heads/main",
+		Before:             "a",
+		After:              "b",
+		RepositoryID:       testRepositoryID,
+		RepositoryURL:      "https://dev.azure.com/contoso/project/_git/repo",
+		RepositoryFullPath: "project/repo",
+		AuthorName:         "Octocat",
+		CommitList:         commitList,
+	}
+	assert.Equal(t, want, resource.ToVCS(resource.RefUpdates[0], commitList))
+}
+
+func TestProvider_CreateCommitStatus(t *testing.T) {
+	var status CommitStatusCreate
+	p, instanceURL := newFakeAzureDevOps(t, map[string]http.HandlerFunc{
+		testRepoPath + "commits/c2/statuses": func(w http.ResponseWriter, r *http.Request) {
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&status))
+			w.WriteHeader(http.StatusCreated)
+			_, _ = io.WriteString(w, `{"id": 1}`)
+		},
+	})
+
+	err := p.CreateCommitStatus(context.Background(), common.OauthContext{AccessToken: testPAT}, instanceURL, testRepositoryID, "c2", &vcs.CommitStatus{
+		State:       vcs.CommitStatusFailure,
+		Context:     "bytebase/review",
+		Description: "SQL review failed",
+		TargetURL:   "https://bytebase.example.com/issue/1",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, CommitStatusCreate{
+		State:       "failed",
+		Description: "SQL review failed",
+		TargetURL:   "https://bytebase.example.com/issue/1",
+		Context:     CommitStatusContext{Name: "review", Genre: "bytebase"},
+	}, status)
+}
+
+func TestPullRequest_ToVCS(t *testing.T) {
+	resource := `
+{
+  "pullRequestId": 3,
+  "title": "Add the email column",
+  "description": "details",
+  "status": "active",
+  "sourceRefName": "refs/heads/feature",
+  "targetRefName": "refs/heads/main",
+  "createdBy": {"displayName": "Contoso", "uniqueName": "contoso@example.com"},
+  "lastMergeSourceCommit": {"commitId": "c2"},
+  "repository": {
+    "id": "repo-id",
+    "name": "repo",
+    "webUrl": "https://dev.azure.com/contoso/project/_git/repo",
+    "remoteUrl": "https://contoso@dev.azure.com/contoso/project/_git/repo",
+    "project": {"id": "project-id", "name": "project"}
+  }
+}`
+	var pullRequest PullRequest
+	require.NoError(t, json.Unmarshal([]byte(resource), &pullRequest))
+	got, ok := pullRequest.ToVCS(WebhookPullRequestCreated)
+	require.True(t, ok)
+	want := vcs.PullRequestEvent{
+		VCSType:            vcs.AzureDevOps,
+		Action:             vcs.PullRequestEventOpened,
+		RepositoryID:       testRepositoryID,
+		RepositoryURL:      "https://contoso@dev.azure.com/contoso/project/_git/repo",
+		RepositoryFullPath: "project/repo",
+		PullRequestID:      "3",
+		URL:                "https://dev.azure.com/contoso/project/_git/repo/pullrequest/3",
+		Title:              "Add the email column",
+		Description:        "details",
+		AuthorName:         "Contoso",
+		AuthorEmail:        "contoso@example.com",
+		HeadCommitID:       "c2",
+		BaseBranch:         "main",
+	}
+	assert.Equal(t, want, got)
+
+	for status, action := range map[string]vcs.PullRequestEventAction{
+		"active":    vcs.PullRequestEventUpdated,
+		"completed": vcs.PullRequestEventMerged,
+		"abandoned": vcs.PullRequestEventClosed,
+	} {
+		pullRequest.Status = status
+		got, ok := pullRequest.ToVCS(WebhookPullRequestUpdated)
+		require.True(t, ok)
+		assert.Equal(t, action, got.Action, status)
+	}
+}
@@ -855,6 +855,242 @@ func (p *Provider) CreatePullRequest(ctx context.Context, oauthCtx common.OauthC
 	}, nil
 }
 
+// GetPullRequest gets the pull request in the repository.
+//
+// Docs: https://developer.atlassian.com/cloud/bitbucket/rest/api-group-pullrequests/#api-repositories-workspace-repo-slug-pullrequests-pull-request-id-get
+func (p *Provider) GetPullRequest(ctx context.Context, oauthCtx common.OauthContext, instanceURL, repositoryID, pullRequestID string) (*vcs.PullRequest, error) {
+	url := fmt.Sprintf("%s/repositories/%s/pullrequests/%s", p.APIURL(instanceURL), repositoryID, pullRequestID)
+	code, _, body, err := oauth.Get(
+		ctx,
+		p.client,
+		url,
+		&oauthCtx.AccessToken,
+		tokenRefresher(
+			instanceURL,
+			oauthContext{
+				ClientID:     oauthCtx.ClientID,
+				ClientSecret: oauthCtx.ClientSecret,
+				RefreshToken: oauthCtx.RefreshToken,
+			},
+			oauthCtx.Refresher,
+		),
+	)
+	if err != nil {
+		return nil, errors.Wrapf(err, "GET %s", url)
+	}
+
+	if code == http.StatusNotFound {
+		return nil, common.Errorf(common.NotFound, "failed to get pull request from URL %s", url)
+	} else if code >= 300 {
+		return nil, errors.Errorf("failed to get pull request from URL %s, status code: %d, body: %s",
+			url,
+			code,
+			body,
+		)
+	}
+
+	// The API shares the same pull request schema as the webhook payload.
+	var res WebhookPullRequest
+	if err := json.Unmarshal([]byte(body), &res); err != nil {
+		return nil, err
+	}
+	return convertToPullRequest(&res), nil
+}
+
+// ListOpenPullRequests lists the first page of the open pull requests to the base branch in the repository.
+//
+// Docs: https://developer.atlassian.com/cloud/bitbucket/rest/api-group-pullrequests/#api-repositories-workspace-repo-slug-pullrequests-get
+func (p *Provider) ListOpenPullRequests(ctx context.Context, oauthCtx common.OauthContext, instanceURL, repositoryID, baseBranch string) ([]*vcs.PullRequest, error) {
+	query := url.Values{
+		"state":   {"OPEN"},
+		"q":       {fmt.Sprintf("destination.branch.name = %q", baseBranch)},
+		"pagelen": {strconv.Itoa(apiPageSize)},
+	}
+	url := fmt.Sprintf("%s/repositories/%s/pullrequests?%s", p.APIURL(instanceURL), repositoryID, query.Encode())
+	code, _, body, err := oauth.Get(
+		ctx,
+		p.client,
+		url,
+		&oauthCtx.AccessToken,
+		tokenRefresher(
+			instanceURL,
+			oauthContext{
+				ClientID:     oauthCtx.ClientID,
+				ClientSecret: oauthCtx.ClientSecret,
+				RefreshToken: oauthCtx.RefreshToken,
+			},
+			oauthCtx.Refresher,
+		),
+	)
+	if err != nil {
+		return nil, errors.Wrapf(err, "GET %s", url)
+	}
+
+	if code == http.StatusNotFound {
+		return nil, common.Errorf(common.NotFound, "failed to list pull requests from URL %s", url)
+	} else if code >= 300 {
+		return nil, errors.Errorf("failed to list pull requests from URL %s, status code: %d, body: %s",
+			url,
+			code,
+			body,
+		)
+	}
+
+	var list struct {
+		Values []*WebhookPullRequest `json:"values"`
+	}
+	if err := json.Unmarshal([]byte(body), &list); err != nil {
+		return nil, err
+	}
+	var res []*vcs.PullRequest
+	for _, pullRequest := range list.Values {
+		res = append(res, convertToPullRequest(pullRequest))
+	}
+	return res, nil
+}
+
+func convertToPullRequest(pullRequest *WebhookPullRequest) *vcs.PullRequest {
+	state := vcs.PullRequestStateClosed
+	switch pullRequest.State {
+	case "OPEN":
+		state = vcs.PullRequestStateOpen
+	case "MERGED":
+		state = vcs.PullRequestStateMerged
+	}
+	return &vcs.PullRequest{
+		URL:          pullRequest.Links.HTML.Href,
+		State:        state,
+		HeadCommitID: pullRequest.Source.Commit.Hash,
+		HeadBranch:   pullRequest.Source.Branch.Name,
+		BaseBranch:   pullRequest.Destination.Branch.Name,
+	}
+}
+
+type pullRequestCommentContent struct {
+	Raw string `json:"raw"`
+}
+
+type pullRequestComment struct {
+	Content pullRequestCommentContent `json:"content"`
+}
+
+// CreatePullRequestComment creates a comment in the pull request.
+//
+// Docs: https://developer.atlassian.com/cloud/bitbucket/rest/api-group-pullrequests/#api-repositories-workspace-repo-slug-pullrequests-pull-request-id-comments-post
+func (p *Provider) CreatePullRequestComment(ctx context.Context, oauthCtx common.OauthContext, instanceURL, repositoryID, pullRequestID, comment string) error {
+	payload, err := json.Marshal(
+		pullRequestComment{
+			Content: pullRequestCommentContent{Raw: comment},
+		},
+	)
+	if err != nil {
+		return errors.Wrap(err, "marshal pull request comment")
+	}
+
+	url := fmt.Sprintf("%s/repositories/%s/pullrequests/%s/comments", p.APIURL(instanceURL), repositoryID, pullRequestID)
+	code, _, body, err := oauth.Post(
+		ctx,
+		p.client,
+		url,
+		&oauthCtx.AccessToken,
+		bytes.NewReader(payload),
+		tokenRefresher(
+			instanceURL,
+			oauthContext{
+				ClientID:     oauthCtx.ClientID,
+				ClientSecret: oauthCtx.ClientSecret,
+				RefreshToken: oauthCtx.RefreshToken,
+			},
+			oauthCtx.Refresher,
+		),
+	)
+	if err != nil {
+		return errors.Wrapf(err, "POST %s", url)
+	}
+
+	if code == http.StatusNotFound {
+		return common.Errorf(common.NotFound, "failed to create pull request comment from URL %s", url)
+	} else if code >= 300 {
+		return errors.Errorf("failed to create pull request comment from URL %s, status code: %d, body: %s",
+			url,
+			code,
+			body,
+		)
+	}
+	return nil
+}
+
+// CommitStatusCreate is the API message for creating a commit build status.
+type CommitStatusCreate struct {
+	// Key identifies the build status, the status with the same key is updated.
+	Key         string `json:"key"`
+	State       string `json:"state"`
+	Name        string `json:"name"`
+	URL         string `json:"url"`
+	Description string `json:"description,omitempty"`
+}
+
+// CreateCommitStatus creates the commit build status.
+//
+// Docs: https://developer.atlassian.com/cloud/bitbucket/rest/api-group-commit-statuses/#api-repositories-workspace-repo-slug-commit-commit-statuses-build-post
+func (p *Provider) CreateCommitStatus(ctx context.Context, oauthCtx common.OauthContext, instanceURL, repositoryID, commitID string, commitStatus *vcs.CommitStatus) error {
+	payload, err := json.Marshal(
+		CommitStatusCreate{
+			Key:         commitStatus.Context,
+			State:       convertToCommitStatusState(commitStatus.State),
+			Name:        commitStatus.Context,
+			URL:         commitStatus.TargetURL,
+			Description: commitStatus.Description,
+		},
+	)
+	if err != nil {
+		return errors.Wrap(err, "marshal commit status create")
+	}
+
+	url := fmt.Sprintf("%s/repositories/%s/commit/%s/statuses/build", p.APIURL(instanceURL), repositoryID, commitID)
+	code, _, body, err := oauth.Post(
+		ctx,
+		p.client,
+		url,
+		&oauthCtx.AccessToken,
+		bytes.NewReader(payload),
+		tokenRefresher(
+			instanceURL,
+			oauthContext{
+				ClientID:     oauthCtx.ClientID,
+				ClientSecret: oauthCtx.ClientSecret,
+				RefreshToken: oauthCtx.RefreshToken,
+			},
+			oauthCtx.Refresher,
+		),
+	)
+	if err != nil {
+		return errors.Wrapf(err, "POST %s", url)
+	}
+
+	if code == http.StatusNotFound {
+		return common.Errorf(common.NotFound, "failed to create commit status from URL %s", url)
+	} else if code >= 300 {
+		return errors.Errorf("failed to create commit status from URL %s, status code: %d, body: %s",
+			url,
+			code,
+			body,
+		)
+	}
+	return nil
+}
+
+func convertToCommitStatusState(state vcs.CommitStatusState) string {
+	switch state {
+	case vcs.CommitStatusSuccess:
+		return "SUCCESSFUL"
+	case vcs.CommitStatusFailure, vcs.CommitStatusError:
+		return "FAILED"
+	default:
+		return "INPROGRESS"
+	}
+}
+
 // UpsertEnvironmentVariable creates or updates the environment variable in the repository.
 //
 // WARNING: This is not supported in Bitbucket Cloud.
@@ -908,6 +1144,34 @@ type WebhookPushEvent struct {
 	Actor      User        `json:"actor"`
 }
 
+// WebhookPullRequestBranch is the API message for the branch of the pull request in the webhook event.
+type WebhookPullRequestBranch struct {
+	Branch struct {
+		Name string `json:"name"`
+	} `json:"branch"`
+	// Commit is the latest commit of the branch, the hash is abbreviated.
+	Commit Target `json:"commit"`
+}
+
+// WebhookPullRequest is the API message for the pull request in the webhook event.
+type WebhookPullRequest struct {
+	ID          int    `json:"id"`
+	Title       string `json:"title"`
+	Description string `json:"description"`
+	// State is one of OPEN, MERGED, DECLINED and SUPERSEDED.
+	State       string                   `json:"state"`
+	Author      User                     `json:"author"`
+	Source      WebhookPullRequestBranch `json:"source"`
+	Destination WebhookPullRequestBranch `json:"destination"`
+	Links       Links                    `json:"links"`
+}
+
+// WebhookPullRequestEvent is the API message for webhook pull request event.
+type WebhookPullRequestEvent struct {
+	PullRequest WebhookPullRequest `json:"pullrequest"`
+	Repository  Repository         `json:"repository"`
+}
+
 // WebhookCreateOrUpdate represents a Bitbucket API request for creating or
 // updating a webhook.
 type WebhookCreateOrUpdate struct {
@@ -1099,3 +1363,35 @@ func tokenRefresher(instanceURL string, oauthCtx oauthContext, refresher common.
 		return refresher(r.AccessToken, r.RefreshToken, expireAt)
 	}
 }
+
+// ToVCS returns the pull request event in VCS format with the given event key. It returns
+// false if the event is not related to the pull request flow.
+func (p WebhookPullRequestEvent) ToVCS(eventKey string) (vcs.PullRequestEvent, bool) {
+	var action vcs.PullRequestEventAction
+	switch eventKey {
+	case "pullrequest:created":
+		action = vcs.PullRequestEventOpened
+	case "pullrequest:updated":
+		action = vcs.PullRequestEventUpdated
+	case "pullrequest:fulfilled":
+		action = vcs.PullRequestEventMerged
+	case "pullrequest:rejected":
+		action = vcs.PullRequestEventClosed
+	default:
+		return vcs.PullRequestEvent{}, false
+	}
+	return vcs.PullRequestEvent{
+		VCSType:            vcs.Bitbucket,
+		Action:             action,
+		RepositoryID:       p.Repository.FullName,
+		RepositoryURL:      p.Repository.Links.HTML.Href,
+		RepositoryFullPath: p.Repository.FullName,
+		PullRequestID:      strconv.Itoa(p.PullRequest.ID),
+		URL:                p.PullRequest.Links.HTML.Href,
+		Title:              p.PullRequest.Title,
+		Description:        p.PullRequest.Description,
+		AuthorName:         p.PullRequest.Author.DisplayName,
+		HeadCommitID:       p.PullRequest.Source.Commit.Hash,
+		BaseBranch:         p.PullRequest.Destination.Branch.Name,
+	}, true
+}
@@ -2,6 +2,7 @@ package bitbucket
 
 import (
 	"context"
+	"encoding/json"
 	"io"
 	"net/http"
 	"strings"
@@ -924,3 +925,44 @@ func newMockProvider(mockRoundTrip func(r *http.Request) (*http.Response, error)
 		},
 	)
 }
+
+func TestWebhookPullRequestEvent_ToVCS(t *testing.T) {
+	body := `
+{
+  "pullrequest": {
+    "id": 7,
+    "title": "Add the email column",
+    "description": "details",
+    "author": {"display_name": "Octocat"},
+    "links": {"html": {"href": "https://bitbucket.org/octocat/hello/pull-requests/7"}},
+    "source": {"branch": {"name": "feature"}, "commit": {"hash": "4a6e9d1"}},
+    "destination": {"branch": {"name": "main"}, "commit": {"hash": "2d8f6c0"}}
+  },
+  "repository": {
+    "full_name": "octocat/hello",
+    "links": {"html": {"href": "https://bitbucket.org/octocat/hello"}}
+  }
+}`
+	var event WebhookPullRequestEvent
+	require.NoError(t, json.Unmarshal([]byte(body), &event))
+	got, ok := event.ToVCS("pullrequest:fulfilled")
+	require.True(t, ok)
+	want := vcs.PullRequestEvent{
+		VCSType:            vcs.Bitbucket,
+		Action:             vcs.PullRequestEventMerged,
+		RepositoryID:       "octocat/hello",
+		RepositoryURL:      "https://bitbucket.org/octocat/hello",
+		RepositoryFullPath: "octocat/hello",
+		PullRequestID:      "7",
+		URL:                "https://bitbucket.org/octocat/hello/pull-requests/7",
+		Title:              "Add the email column",
+		Description:        "details",
+		AuthorName:         "Octocat",
+		HeadCommitID:       "4a6e9d1",
+		BaseBranch:         "main",
+	}
+	assert.Equal(t, want, got)
+
+	_, ok = event.ToVCS("pullrequest:approved")
+	assert.False(t, ok)
+}
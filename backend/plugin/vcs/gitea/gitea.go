@@ -0,0 +1,1576 @@
+// Package gitea is the plugin for Gitea and Forgejo.
+package gitea
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/backend/common"
+	"github.com/bytebase/bytebase/backend/plugin/vcs"
+	"github.com/bytebase/bytebase/backend/plugin/vcs/internal/oauth"
+)
+
+const (
+	// apiPageSize is the default page size when making API requests.
+	apiPageSize = 50
+)
+
+func init() {
+	vcs.Register(vcs.Gitea, newProvider)
+}
+
+var _ vcs.Provider = (*Provider)(nil)
+
+// Provider is a Gitea VCS provider, which also works for Forgejo because Forgejo keeps the Gitea API.
+type Provider struct {
+	client *http.Client
+}
+
+func newProvider(config vcs.ProviderConfig) vcs.Provider {
+	if config.Client == nil {
+		config.Client = &http.Client{}
+	}
+	return &Provider{
+		client: config.Client,
+	}
+}
+
+// APIURL returns the API URL path of Gitea.
+func (*Provider) APIURL(instanceURL string) string {
+	return fmt.Sprintf("%s/api/v1", instanceURL)
+}
+
+// User represents a Gitea API response for a user.
+type User struct {
+	Login    string `json:"login"`
+	FullName string `json:"full_name"`
+	Email    string `json:"email"`
+}
+
+// Repository represents a Gitea API response for a repository.
+type Repository struct {
+	ID          int64  `json:"id"`
+	Name        string `json:"name"`
+	FullName    string `json:"full_name"`
+	HTMLURL     string `json:"html_url"`
+	Permissions struct {
+		Admin bool `json:"admin"`
+	} `json:"permissions"`
+}
+
+// RepositoryTree represents a Gitea API response for a repository tree.
+type RepositoryTree struct {
+	Tree       []RepositoryTreeNode `json:"tree"`
+	Truncated  bool                 `json:"truncated"`
+	Page       int                  `json:"page"`
+	TotalCount int                  `json:"total_count"`
+}
+
+// RepositoryTreeNode represents a Gitea API response for a repository tree node.
+type RepositoryTreeNode struct {
+	Path string `json:"path"`
+	Type string `json:"type"`
+}
+
+// File represents a Gitea API response for a repository file.
+type File struct {
+	Type          string `json:"type"`
+	Encoding      string `json:"encoding"`
+	Size          int64  `json:"size"`
+	Name          string `json:"name"`
+	Path          string `json:"path"`
+	Content       string `json:"content"`
+	SHA           string `json:"sha"`
+	LastCommitSHA string `json:"last_commit_sha"`
+}
+
+// CommitUser represents a Gitea API message for a commit author or committer.
+type CommitUser struct {
+	Name  string `json:"name"`
+	Email string `json:"email"`
+	// Date is empty when committing a file.
+	Date string `json:"date,omitempty"`
+}
+
+// CommitAffectedFile represents a Gitea API response for a file changed by a commit.
+type CommitAffectedFile struct {
+	Filename string `json:"filename"`
+	// Available values: "added", "modified", "removed".
+	Status string `json:"status"`
+}
+
+// Commit represents a Gitea API response for a commit.
+type Commit struct {
+	SHA     string `json:"sha"`
+	HTMLURL string `json:"html_url"`
+	Commit  struct {
+		Message string     `json:"message"`
+		Author  CommitUser `json:"author"`
+	} `json:"commit"`
+	Files []CommitAffectedFile `json:"files"`
+}
+
+// CommitsCompare represents a Gitea API response for comparing two commits.
+type CommitsCompare struct {
+	TotalCommits int      `json:"total_commits"`
+	Commits      []Commit `json:"commits"`
+}
+
+// FileCommit represents a Gitea API request for committing a file.
+type FileCommit struct {
+	Message string      `json:"message"`
+	Content string      `json:"content"`
+	SHA     string      `json:"sha,omitempty"`
+	Branch  string      `json:"branch,omitempty"`
+	Author  *CommitUser `json:"author,omitempty"`
+}
+
+// Branch is the API message for Gitea branch.
+type Branch struct {
+	Name   string `json:"name"`
+	Commit struct {
+		ID string `json:"id"`
+	} `json:"commit"`
+}
+
+// BranchCreate is the API message to create the branch.
+type BranchCreate struct {
+	NewBranchName string `json:"new_branch_name"`
+	// OldRefName is the branch, tag or commit to create the branch from.
+	OldRefName string `json:"old_ref_name"`
+}
+
+// PullRequest is the API message for Gitea pull request.
+type PullRequest struct {
+	Number  int    `json:"number"`
+	HTMLURL string `json:"html_url"`
+	// State is either "open" or "closed", a merged pull request is also "closed".
+	State  string `json:"state"`
+	Merged bool   `json:"merged"`
+	Head   struct {
+		Ref string `json:"ref"`
+		SHA string `json:"sha"`
+	} `json:"head"`
+	Base struct {
+		Ref string `json:"ref"`
+	} `json:"base"`
+}
+
+// PullRequestFile is the API message for files in Gitea pull request.
+type PullRequestFile struct {
+	Filename string `json:"filename"`
+	// The file status in Gitea PR.
+	// Available values: "added", "deleted", "modified", "renamed", "copied", "changed", "unchanged".
+	Status string `json:"status"`
+}
+
+// RepositorySecretUpdate is the API message to create or update the repository action secret.
+type RepositorySecretUpdate struct {
+	Data string `json:"data"`
+}
+
+// WebhookType is the Gitea webhook type, which is sent in the X-Gitea-Event header.
+type WebhookType string
+
+const (
+	// WebhookPush is the webhook type for push.
+	WebhookPush WebhookType = "push"
+	// WebhookPullRequest is the webhook type for pull request.
+	WebhookPullRequest WebhookType = "pull_request"
+)
+
+// WebhookInfo represents a Gitea API response for the webhook information.
+type WebhookInfo struct {
+	ID int `json:"id"`
+}
+
+// WebhookConfig represents the Gitea API message for webhook configuration.
+type WebhookConfig struct {
+	// URL is the URL to which the payloads will be delivered.
+	URL string `json:"url"`
+	// ContentType is the media type used to serialize the payloads, "json" or "form".
+	ContentType string `json:"content_type"`
+	// Secret is the secret will be used as the key to generate the HMAC hex digest
+	// value in the X-Gitea-Signature header.
+	Secret string `json:"secret"`
+}
+
+// WebhookCreateOrUpdate represents a Gitea API request for creating or updating a webhook.
+type WebhookCreateOrUpdate struct {
+	// Type is the webhook type, it's always "gitea" for the webhook delivering the Gitea payloads.
+	// It's omitted when updating the webhook.
+	Type   string        `json:"type,omitempty"`
+	Config WebhookConfig `json:"config"`
+	// Events determines what events the hook is triggered for.
+	Events []string `json:"events"`
+	Active bool     `json:"active"`
+}
+
+// WebhookRepository is the API message for webhook repository.
+type WebhookRepository struct {
+	ID       int    `json:"id"`
+	FullName string `json:"full_name"`
+	HTMLURL  string `json:"html_url"`
+}
+
+// WebhookCommitAuthor is the API message for webhook commit author.
+type WebhookCommitAuthor struct {
+	Name     string `json:"name"`
+	Email    string `json:"email"`
+	Username string `json:"username"`
+}
+
+// WebhookSender is the API message for webhook sender.
+type WebhookSender struct {
+	Login string `json:"login"`
+}
+
+// WebhookCommit is the API message for webhook commit.
+type WebhookCommit struct {
+	ID        string              `json:"id"`
+	Message   string              `json:"message"`
+	Timestamp time.Time           `json:"timestamp"`
+	URL       string              `json:"url"`
+	Author    WebhookCommitAuthor `json:"author"`
+	Added     []string            `json:"added"`
+	Modified  []string            `json:"modified"`
+	Removed   []string            `json:"removed"`
+}
+
+// WebhookPushEvent is the API message for webhook push event.
+type WebhookPushEvent struct {
+	Ref        string            `json:"ref"`
+	Before     string            `json:"before"`
+	After      string            `json:"after"`
+	Repository WebhookRepository `json:"repository"`
+	Sender     WebhookSender     `json:"sender"`
+	Commits    []WebhookCommit   `json:"commits"`
+}
+
+// WebhookPullRequestUser is the API message for webhook pull request user.
+type WebhookPullRequestUser struct {
+	Login string `json:"login"`
+	Email string `json:"email"`
+}
+
+// WebhookPullRequestBranch is the API message for webhook pull request branch.
+type WebhookPullRequestBranch struct {
+	Ref string `json:"ref"`
+	SHA string `json:"sha"`
+}
+
+// WebhookPullRequestInfo is the API message for the pull request in the webhook pull request event.
+type WebhookPullRequestInfo struct {
+	Number  int                      `json:"number"`
+	HTMLURL string                   `json:"html_url"`
+	Title   string                   `json:"title"`
+	Body    string                   `json:"body"`
+	Merged  bool                     `json:"merged"`
+	User    WebhookPullRequestUser   `json:"user"`
+	Head    WebhookPullRequestBranch `json:"head"`
+	Base    WebhookPullRequestBranch `json:"base"`
+}
+
+// WebhookPullRequestEvent is the API message for webhook pull request event.
+type WebhookPullRequestEvent struct {
+	// Action is the pull request action, e.g. "opened", "reopened", "synchronized" and "closed".
+	Action      string                 `json:"action"`
+	Number      int                    `json:"number"`
+	PullRequest WebhookPullRequestInfo `json:"pull_request"`
+	Repository  WebhookRepository      `json:"repository"`
+}
+
+// PullRequestComment is the API message to create a comment on the pull request.
+type PullRequestComment struct {
+	Body string `json:"body"`
+}
+
+// CommitStatusCreate is the API message to create a commit status.
+type CommitStatusCreate struct {
+	// State is the commit status state, available values are "pending", "success", "failure" and "error".
+	State       string `json:"state"`
+	Context     string `json:"context"`
+	Description string `json:"description"`
+	TargetURL   string `json:"target_url"`
+}
+
+// oauthResponse is a Gitea OAuth response.
+type oauthResponse struct {
+	AccessToken      string `json:"access_token"`
+	RefreshToken     string `json:"refresh_token"`
+	ExpiresIn        int64  `json:"expires_in"`
+	Error            string `json:"error,omitempty"`
+	ErrorDescription string `json:"error_description,omitempty"`
+}
+
+// toVCSOAuthToken converts the response to *vcs.OAuthToken.
+func (o oauthResponse) toVCSOAuthToken() *vcs.OAuthToken {
+	oauthToken := &vcs.OAuthToken{
+		AccessToken:  o.AccessToken,
+		RefreshToken: o.RefreshToken,
+		ExpiresIn:    o.ExpiresIn,
+		CreatedAt:    time.Now().Unix(),
+	}
+	if oauthToken.ExpiresIn != 0 {
+		oauthToken.ExpiresTs = oauthToken.CreatedAt + oauthToken.ExpiresIn
+	}
+	return oauthToken
+}
+
+// ExchangeOAuthToken exchanges OAuth content with the provided authorization code.
+//
+// Docs: https://docs.gitea.com/development/oauth2-provider
+func (p *Provider) ExchangeOAuthToken(ctx context.Context, instanceURL string, oauthExchange *common.OAuthExchange) (*vcs.OAuthToken, error) {
+	params := &url.Values{}
+	params.Set("client_id", oauthExchange.ClientID)
+	params.Set("client_secret", oauthExchange.ClientSecret)
+	params.Set("code", oauthExchange.Code)
+	params.Set("redirect_uri", oauthExchange.RedirectURL)
+	params.Set("grant_type", "authorization_code")
+	url := fmt.Sprintf("%s/login/oauth/access_token", instanceURL)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, strings.NewReader(params.Encode()))
+	if err != nil {
+		return nil, errors.Wrapf(err, "construct POST %s", url)
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to exchange OAuth token")
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to read OAuth response body, code %v", resp.StatusCode)
+	}
+
+	oauthResp := new(oauthResponse)
+	if err := json.Unmarshal(body, oauthResp); err != nil {
+		return nil, errors.Wrapf(err, "failed to unmarshal OAuth response body, code %v", resp.StatusCode)
+	}
+	if oauthResp.Error != "" {
+		return nil, errors.Errorf("failed to exchange OAuth token, error: %v, error_description: %v", oauthResp.Error, oauthResp.ErrorDescription)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, errors.Errorf("failed to exchange OAuth token, status code: %d, body: %s", resp.StatusCode, body)
+	}
+	return oauthResp.toVCSOAuthToken(), nil
+}
+
+// TryLogin tries to fetch the user info from the current OAuth context.
+//
+// Docs: https://gitea.com/api/swagger#/user/userGetCurrent
+func (p *Provider) TryLogin(ctx context.Context, oauthCtx common.OauthContext, instanceURL string) (*vcs.UserInfo, error) {
+	url := fmt.Sprintf("%s/user", p.APIURL(instanceURL))
+	code, _, body, err := oauth.Get(
+		ctx,
+		p.client,
+		url,
+		&oauthCtx.AccessToken,
+		tokenRefresher(
+			instanceURL,
+			oauthContext{
+				ClientID:     oauthCtx.ClientID,
+				ClientSecret: oauthCtx.ClientSecret,
+				RefreshToken: oauthCtx.RefreshToken,
+			},
+			oauthCtx.Refresher,
+		),
+	)
+	if err != nil {
+		return nil, errors.Wrapf(err, "GET %s", url)
+	}
+
+	if code == http.StatusNotFound {
+		return nil, common.Errorf(common.NotFound, "failed to read user info from URL %s", url)
+	} else if code >= 300 {
+		return nil, errors.Errorf("failed to read user info from URL %s, status code: %d, body: %s", url, code, body)
+	}
+
+	var user User
+	if err := json.Unmarshal([]byte(body), &user); err != nil {
+		return nil, errors.Wrap(err, "unmarshal")
+	}
+	name := user.FullName
+	if name == "" {
+		name = user.Login
+	}
+	return &vcs.UserInfo{
+		PublicEmail: user.Email,
+		Name:        name,
+		State:       vcs.StateActive,
+	}, nil
+}
+
+// FetchCommitByID fetches the commit data by its ID from the repository.
+//
+// Docs: https://gitea.com/api/swagger#/repository/repoGetSingleCommit
+func (p *Provider) FetchCommitByID(ctx context.Context, oauthCtx common.OauthContext, instanceURL, repositoryID, commitID string) (*vcs.Commit, error) {
+	commit, err := p.getCommit(ctx, oauthCtx, instanceURL, repositoryID, commitID)
+	if err != nil {
+		return nil, err
+	}
+
+	createdTs := int64(0)
+	if t, err := time.Parse(time.RFC3339, commit.Commit.Author.Date); err == nil {
+		createdTs = t.Unix()
+	}
+	// Per Git convention, the message title and body are separated by two new line characters.
+	messages := strings.SplitN(commit.Commit.Message, "\n\n", 2)
+	return &vcs.Commit{
+		ID:          commit.SHA,
+		Title:       strings.TrimSpace(messages[0]),
+		Message:     commit.Commit.Message,
+		CreatedTs:   createdTs,
+		URL:         commit.HTMLURL,
+		AuthorName:  commit.Commit.Author.Name,
+		AuthorEmail: commit.Commit.Author.Email,
+	}, nil
+}
+
+func (p *Provider) getCommit(ctx context.Context, oauthCtx common.OauthContext, instanceURL, repositoryID, commitID string) (*Commit, error) {
+	url := fmt.Sprintf("%s/repos/%s/git/commits/%s", p.APIURL(instanceURL), repositoryID, commitID)
+	code, _, body, err := oauth.Get(
+		ctx,
+		p.client,
+		url,
+		&oauthCtx.AccessToken,
+		tokenRefresher(
+			instanceURL,
+			oauthContext{
+				ClientID:     oauthCtx.ClientID,
+				ClientSecret: oauthCtx.ClientSecret,
+				RefreshToken: oauthCtx.RefreshToken,
+			},
+			oauthCtx.Refresher,
+		),
+	)
+	if err != nil {
+		return nil, errors.Wrapf(err, "GET %s", url)
+	}
+
+	if code == http.StatusNotFound {
+		return nil, common.Errorf(common.NotFound, "failed to fetch commit data from URL %s", url)
+	} else if code >= 300 {
+		return nil, errors.Errorf("failed to fetch commit data from URL %s, status code: %d, body: %s", url, code, body)
+	}
+
+	commit := &Commit{}
+	if err := json.Unmarshal([]byte(body), commit); err != nil {
+		return nil, errors.Wrap(err, "unmarshal body")
+	}
+	return commit, nil
+}
+
+// GetDiffFileList gets the diff files list between two commits.
+//
+// Gitea returns the changed files of every commit instead of the whole comparison, so we merge
+// the changes of the commits in order.
+//
+// Docs: https://gitea.com/api/swagger#/repository/repoCompareDiff
+func (p *Provider) GetDiffFileList(ctx context.Context, oauthCtx common.OauthContext, instanceURL, repositoryID, beforeCommit, afterCommit string) ([]vcs.FileDiff, error) {
+	url := fmt.Sprintf("%s/repos/%s/compare/%s...%s", p.APIURL(instanceURL), repositoryID, beforeCommit, afterCommit)
+	code, _, body, err := oauth.Get(
+		ctx,
+		p.client,
+		url,
+		&oauthCtx.AccessToken,
+		tokenRefresher(
+			instanceURL,
+			oauthContext{
+				ClientID:     oauthCtx.ClientID,
+				ClientSecret: oauthCtx.ClientSecret,
+				RefreshToken: oauthCtx.RefreshToken,
+			},
+			oauthCtx.Refresher,
+		),
+	)
+	if err != nil {
+		return nil, errors.Wrapf(err, "GET %s", url)
+	}
+
+	if code == http.StatusNotFound {
+		return nil, common.Errorf(common.NotFound, "failed to get file diff list from URL %s", url)
+	} else if code >= 300 {
+		return nil, errors.Errorf("failed to get file diff list from URL %s, status code: %d, body: %s",
+			url,
+			code,
+			body,
+		)
+	}
+
+	compare := &CommitsCompare{}
+	if err := json.Unmarshal([]byte(body), compare); err != nil {
+		return nil, errors.Wrapf(err, "failed to unmarshal file diff data from Gitea instance %s", instanceURL)
+	}
+
+	var ret []vcs.FileDiff
+	// index is the position of the file in ret.
+	index := make(map[string]int)
+	for _, commit := range compare.Commits {
+		for _, file := range commit.Files {
+			diffType := vcs.FileDiffTypeUnknown
+			switch file.Status {
+			case "added":
+				diffType = vcs.FileDiffTypeAdded
+			case "modified":
+				diffType = vcs.FileDiffTypeModified
+			case "removed":
+				diffType = vcs.FileDiffTypeRemoved
+			}
+			i, ok := index[file.Filename]
+			if !ok {
+				index[file.Filename] = len(ret)
+				ret = append(ret, vcs.FileDiff{Path: file.Filename, Type: diffType})
+				continue
+			}
+			switch {
+			case ret[i].Type == vcs.FileDiffTypeAdded && diffType == vcs.FileDiffTypeModified:
+				// The file added in the comparison is still added.
+			case ret[i].Type == vcs.FileDiffTypeRemoved && diffType == vcs.FileDiffTypeAdded:
+				// The file removed and added again is modified.
+				ret[i].Type = vcs.FileDiffTypeModified
+			default:
+				ret[i].Type = diffType
+			}
+		}
+	}
+	return ret, nil
+}
+
+// FetchAllRepositoryList fetches all repositories where the authenticated user
+// has admin permissions, which is required to create webhook in the repository.
+// The repository ID is the full name likes "owner/repo", which is used to access
+// the repository in Gitea API.
+//
+// Docs: https://gitea.com/api/swagger#/user/userCurrentListRepos
+func (p *Provider) FetchAllRepositoryList(ctx context.Context, oauthCtx common.OauthContext, instanceURL string) ([]*vcs.Repository, error) {
+	var giteaRepos []Repository
+	page := 1
+	for {
+		repos, hasNextPage, err := p.fetchPaginatedRepositoryList(ctx, oauthCtx, instanceURL, page)
+		if err != nil {
+			return nil, errors.Wrap(err, "fetch paginated list")
+		}
+		giteaRepos = append(giteaRepos, repos...)
+
+		if !hasNextPage {
+			break
+		}
+		page++
+	}
+
+	var allRepos []*vcs.Repository
+	for _, r := range giteaRepos {
+		if !r.Permissions.Admin {
+			continue
+		}
+		allRepos = append(allRepos,
+			&vcs.Repository{
+				ID:       r.FullName,
+				Name:     r.Name,
+				FullPath: r.FullName,
+				WebURL:   r.HTMLURL,
+			},
+		)
+	}
+	return allRepos, nil
+}
+
+// fetchPaginatedRepositoryList fetches repositories where the authenticated
+// user has access to in given page. It returns the paginated results along
+// with a boolean indicating whether the next page exists.
+func (p *Provider) fetchPaginatedRepositoryList(ctx context.Context, oauthCtx common.OauthContext, instanceURL string, page int) (repos []Repository, hasNextPage bool, err error) {
+	url := fmt.Sprintf("%s/user/repos?page=%d&limit=%d", p.APIURL(instanceURL), page, apiPageSize)
+	code, _, body, err := oauth.Get(
+		ctx,
+		p.client,
+		url,
+		&oauthCtx.AccessToken,
+		tokenRefresher(
+			instanceURL,
+			oauthContext{
+				ClientID:     oauthCtx.ClientID,
+				ClientSecret: oauthCtx.ClientSecret,
+				RefreshToken: oauthCtx.RefreshToken,
+			},
+			oauthCtx.Refresher,
+		),
+	)
+	if err != nil {
+		return nil, false, errors.Wrapf(err, "GET %s", url)
+	}
+
+	if code == http.StatusNotFound {
+		return nil, false, common.Errorf(common.NotFound, "failed to fetch repository list from URL %s", url)
+	} else if code >= 300 {
+		return nil, false,
+			errors.Errorf("failed to fetch repository list from URL %s, status code: %d, body: %s",
+				url,
+				code,
+				body,
+			)
+	}
+
+	if err := json.Unmarshal([]byte(body), &repos); err != nil {
+		return nil, false, errors.Wrap(err, "unmarshal")
+	}
+	return repos, len(repos) >= apiPageSize, nil
+}
+
+// FetchRepositoryFileList fetches the all files from the given repository tree
+// recursively.
+//
+// Docs: https://gitea.com/api/swagger#/repository/GetTree
+func (p *Provider) FetchRepositoryFileList(ctx context.Context, oauthCtx common.OauthContext, instanceURL, repositoryID, ref, filePath string) ([]*vcs.RepositoryTreeNode, error) {
+	if filePath != "" && !strings.HasSuffix(filePath, "/") {
+		filePath += "/"
+	}
+
+	var allTreeNodes []*vcs.RepositoryTreeNode
+	page := 1
+	for {
+		repoTree, err := p.fetchPaginatedRepositoryTree(ctx, oauthCtx, instanceURL, repositoryID, ref, page)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range repoTree.Tree {
+			// Gitea does not support filtering by path prefix, thus simulating the
+			// behavior here.
+			if n.Type == "blob" && strings.HasPrefix(n.Path, filePath) {
+				allTreeNodes = append(allTreeNodes,
+					&vcs.RepositoryTreeNode{
+						Path: n.Path,
+						Type: n.Type,
+					},
+				)
+			}
+		}
+		// The tree is truncated if there are more pages.
+		if !repoTree.Truncated || len(repoTree.Tree) == 0 {
+			break
+		}
+		page++
+	}
+	return allTreeNodes, nil
+}
+
+func (p *Provider) fetchPaginatedRepositoryTree(ctx context.Context, oauthCtx common.OauthContext, instanceURL, repositoryID, ref string, page int) (*RepositoryTree, error) {
+	url := fmt.Sprintf("%s/repos/%s/git/trees/%s?recursive=true&page=%d&per_page=%d", p.APIURL(instanceURL), repositoryID, url.PathEscape(ref), page, apiPageSize)
+	code, _, body, err := oauth.Get(
+		ctx,
+		p.client,
+		url,
+		&oauthCtx.AccessToken,
+		tokenRefresher(
+			instanceURL,
+			oauthContext{
+				ClientID:     oauthCtx.ClientID,
+				ClientSecret: oauthCtx.ClientSecret,
+				RefreshToken: oauthCtx.RefreshToken,
+			},
+			oauthCtx.Refresher,
+		),
+	)
+	if err != nil {
+		return nil, errors.Wrapf(err, "GET %s", url)
+	}
+
+	if code == http.StatusNotFound {
+		return nil, common.Errorf(common.NotFound, "failed to fetch repository file list from URL %s", url)
+	} else if code >= 300 {
+		return nil,
+			errors.Errorf("failed to fetch repository file list from URL %s, status code: %d, body: %s",
+				url,
+				code,
+				body,
+			)
+	}
+
+	repoTree := &RepositoryTree{}
+	if err := json.Unmarshal([]byte(body), repoTree); err != nil {
+		return nil, errors.Wrap(err, "unmarshal body")
+	}
+	return repoTree, nil
+}
+
+// CreateFile creates a file at given path in the repository.
+//
+// Docs: https://gitea.com/api/swagger#/repository/repoCreateFile
+func (p *Provider) CreateFile(ctx context.Context, oauthCtx common.OauthContext, instanceURL, repositoryID, filePath string, fileCommitCreate vcs.FileCommitCreate) error {
+	return p.writeFile(ctx, oauthCtx, instanceURL, repositoryID, filePath, fileCommitCreate, http.MethodPost)
+}
+
+// OverwriteFile overwrites an existing file at given path in the repository.
+//
+// Docs: https://gitea.com/api/swagger#/repository/repoUpdateFile
+func (p *Provider) OverwriteFile(ctx context.Context, oauthCtx common.OauthContext, instanceURL, repositoryID, filePath string, fileCommitCreate vcs.FileCommitCreate) error {
+	return p.writeFile(ctx, oauthCtx, instanceURL, repositoryID, filePath, fileCommitCreate, http.MethodPut)
+}
+
+// writeFile creates the file with POST, or updates the file with PUT.
+func (p *Provider) writeFile(ctx context.Context, oauthCtx common.OauthContext, instanceURL, repositoryID, filePath string, fileCommitCreate vcs.FileCommitCreate, method string) error {
+	fileCommit := FileCommit{
+		Message: fileCommitCreate.CommitMessage,
+		Content: base64.StdEncoding.EncodeToString([]byte(fileCommitCreate.Content)),
+		Branch:  fileCommitCreate.Branch,
+		SHA:     fileCommitCreate.SHA,
+	}
+	if fileCommitCreate.AuthorName != "" && fileCommitCreate.AuthorEmail != "" {
+		fileCommit.Author = &CommitUser{
+			Name:  fileCommitCreate.AuthorName,
+			Email: fileCommitCreate.AuthorEmail,
+		}
+	}
+	body, err := json.Marshal(fileCommit)
+	if err != nil {
+		return errors.Wrap(err, "marshal file commit")
+	}
+
+	url := fmt.Sprintf("%s/repos/%s/contents/%s", p.APIURL(instanceURL), repositoryID, escapeFilePath(filePath))
+	request := oauth.Post
+	if method == http.MethodPut {
+		request = oauth.Put
+	}
+	code, _, resp, err := request(
+		ctx,
+		p.client,
+		url,
+		&oauthCtx.AccessToken,
+		bytes.NewReader(body),
+		tokenRefresher(
+			instanceURL,
+			oauthContext{
+				ClientID:     oauthCtx.ClientID,
+				ClientSecret: oauthCtx.ClientSecret,
+				RefreshToken: oauthCtx.RefreshToken,
+			},
+			oauthCtx.Refresher,
+		),
+	)
+	if err != nil {
+		return errors.Wrapf(err, "%s %s", method, url)
+	}
+
+	if code == http.StatusNotFound {
+		return common.Errorf(common.NotFound, "failed to create/update file through URL %s", url)
+	} else if code >= 300 {
+		return errors.Errorf("failed to create/update file through URL %s, status code: %d, body: %s",
+			url,
+			code,
+			resp,
+		)
+	}
+	return nil
+}
+
+// ReadFileMeta reads the metadata of the given file in the repository.
+//
+// Docs: https://gitea.com/api/swagger#/repository/repoGetContents
+func (p *Provider) ReadFileMeta(ctx context.Context, oauthCtx common.OauthContext, instanceURL, repositoryID, filePath, ref string) (*vcs.FileMeta, error) {
+	file, err := p.readFile(ctx, oauthCtx, instanceURL, repositoryID, filePath, ref)
+	if err != nil {
+		return nil, err
+	}
+	return &vcs.FileMeta{
+		Name:         file.Name,
+		Path:         file.Path,
+		Size:         file.Size,
+		SHA:          file.SHA,
+		LastCommitID: file.LastCommitSHA,
+	}, nil
+}
+
+// ReadFileContent reads the content of the given file in the repository.
+//
+// Docs: https://gitea.com/api/swagger#/repository/repoGetContents
+func (p *Provider) ReadFileContent(ctx context.Context, oauthCtx common.OauthContext, instanceURL, repositoryID, filePath, ref string) (string, error) {
+	file, err := p.readFile(ctx, oauthCtx, instanceURL, repositoryID, filePath, ref)
+	if err != nil {
+		return "", err
+	}
+	if file.Encoding != "base64" {
+		return file.Content, nil
+	}
+	content, err := base64.StdEncoding.DecodeString(file.Content)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to decode the content of file %q", filePath)
+	}
+	return string(content), nil
+}
+
+func (p *Provider) readFile(ctx context.Context, oauthCtx common.OauthContext, instanceURL, repositoryID, filePath, ref string) (*File, error) {
+	url := fmt.Sprintf("%s/repos/%s/contents/%s?ref=%s", p.APIURL(instanceURL), repositoryID, escapeFilePath(filePath), url.QueryEscape(ref))
+	code, _, body, err := oauth.Get(
+		ctx,
+		p.client,
+		url,
+		&oauthCtx.AccessToken,
+		tokenRefresher(
+			instanceURL,
+			oauthContext{
+				ClientID:     oauthCtx.ClientID,
+				ClientSecret: oauthCtx.ClientSecret,
+				RefreshToken: oauthCtx.RefreshToken,
+			},
+			oauthCtx.Refresher,
+		),
+	)
+	if err != nil {
+		return nil, errors.Wrapf(err, "GET %s", url)
+	}
+
+	if code == http.StatusNotFound {
+		return nil, common.Errorf(common.NotFound, "failed to read file from URL %s", url)
+	} else if code >= 300 {
+		return nil,
+			errors.Errorf("failed to read file from URL %s, status code: %d, body: %s",
+				url,
+				code,
+				body,
+			)
+	}
+
+	// This API endpoint returns a JSON array if the path is a directory, and we do
+	// not want that.
+	if body != "" && body[0] == '[' {
+		return nil, errors.Errorf("%q is a directory not a file", filePath)
+	}
+
+	file := &File{}
+	if err := json.Unmarshal([]byte(body), file); err != nil {
+		return nil, errors.Wrap(err, "unmarshal body")
+	}
+	return file, nil
+}
+
+// GetBranch gets the given branch in the repository.
+//
+// Docs: https://gitea.com/api/swagger#/repository/repoGetBranch
+func (p *Provider) GetBranch(ctx context.Context, oauthCtx common.OauthContext, instanceURL, repositoryID, branchName string) (*vcs.BranchInfo, error) {
+	url := fmt.Sprintf("%s/repos/%s/branches/%s", p.APIURL(instanceURL), repositoryID, escapeFilePath(branchName))
+	code, _, body, err := oauth.Get(
+		ctx,
+		p.client,
+		url,
+		&oauthCtx.AccessToken,
+		tokenRefresher(
+			instanceURL,
+			oauthContext{
+				ClientID:     oauthCtx.ClientID,
+				ClientSecret: oauthCtx.ClientSecret,
+				RefreshToken: oauthCtx.RefreshToken,
+			},
+			oauthCtx.Refresher,
+		),
+	)
+	if err != nil {
+		return nil, errors.Wrapf(err, "GET %s", url)
+	}
+
+	if code == http.StatusNotFound {
+		return nil, common.Errorf(common.NotFound, "failed to get branch from URL %s", url)
+	} else if code >= 300 {
+		return nil, errors.Errorf("failed to get branch from URL %s, status code: %d, body: %s",
+			url,
+			code,
+			body,
+		)
+	}
+
+	res := new(Branch)
+	if err := json.Unmarshal([]byte(body), res); err != nil {
+		return nil, err
+	}
+	return &vcs.BranchInfo{
+		Name:         res.Name,
+		LastCommitID: res.Commit.ID,
+	}, nil
+}
+
+// CreateBranch creates the branch in the repository.
+//
+// Docs: https://gitea.com/api/swagger#/repository/repoCreateBranch
+func (p *Provider) CreateBranch(ctx context.Context, oauthCtx common.OauthContext, instanceURL, repositoryID string, branch *vcs.BranchInfo) error {
+	body, err := json.Marshal(
+		BranchCreate{
+			NewBranchName: branch.Name,
+			OldRefName:    branch.LastCommitID,
+		},
+	)
+	if err != nil {
+		return errors.Wrap(err, "marshal branch create")
+	}
+
+	url := fmt.Sprintf("%s/repos/%s/branches", p.APIURL(instanceURL), repositoryID)
+	code, _, resp, err := oauth.Post(
+		ctx,
+		p.client,
+		url,
+		&oauthCtx.AccessToken,
+		bytes.NewReader(body),
+		tokenRefresher(
+			instanceURL,
+			oauthContext{
+				ClientID:     oauthCtx.ClientID,
+				ClientSecret: oauthCtx.ClientSecret,
+				RefreshToken: oauthCtx.RefreshToken,
+			},
+			oauthCtx.Refresher,
+		),
+	)
+	if err != nil {
+		return errors.Wrapf(err, "POST %s", url)
+	}
+
+	if code == http.StatusNotFound {
+		return common.Errorf(common.NotFound, "failed to create branch from URL %s", url)
+	} else if code >= 300 {
+		return errors.Errorf("failed to create branch from URL %s, status code: %d, body: %s",
+			url,
+			code,
+			resp,
+		)
+	}
+	return nil
+}
+
+// ListPullRequestFile lists the changed files in the pull request.
+//
+// Docs: https://gitea.com/api/swagger#/repository/repoGetPullRequestFiles
+func (p *Provider) ListPullRequestFile(ctx context.Context, oauthCtx common.OauthContext, instanceURL, repositoryID, pullRequestID string) ([]*vcs.PullRequestFile, error) {
+	pullRequest, err := p.getPullRequest(ctx, oauthCtx, instanceURL, repositoryID, pullRequestID)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get pull request")
+	}
+
+	var res []*vcs.PullRequestFile
+	page := 1
+	for {
+		fileList, err := p.listPaginatedPullRequestFile(ctx, oauthCtx, instanceURL, repositoryID, pullRequestID, page)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to list pull request file")
+		}
+		for _, file := range fileList {
+			res = append(res, &vcs.PullRequestFile{
+				Path:         file.Filename,
+				LastCommitID: pullRequest.Head.SHA,
+				IsDeleted:    file.Status == "deleted" || file.Status == "removed",
+			})
+		}
+		if len(fileList) < apiPageSize {
+			break
+		}
+		page++
+	}
+	return res, nil
+}
+
+func (p *Provider) getPullRequest(ctx context.Context, oauthCtx common.OauthContext, instanceURL, repositoryID, pullRequestID string) (*PullRequest, error) {
+	url := fmt.Sprintf("%s/repos/%s/pulls/%s", p.APIURL(instanceURL), repositoryID, pullRequestID)
+	code, _, body, err := oauth.Get(
+		ctx,
+		p.client,
+		url,
+		&oauthCtx.AccessToken,
+		tokenRefresher(
+			instanceURL,
+			oauthContext{
+				ClientID:     oauthCtx.ClientID,
+				ClientSecret: oauthCtx.ClientSecret,
+				RefreshToken: oauthCtx.RefreshToken,
+			},
+			oauthCtx.Refresher,
+		),
+	)
+	if err != nil {
+		return nil, errors.Wrapf(err, "GET %s", url)
+	}
+	if code == http.StatusNotFound {
+		return nil, common.Errorf(common.NotFound, "failed to get pull request from URL %s", url)
+	} else if code >= 300 {
+		return nil, errors.Errorf("failed to get pull request from URL %s, status code: %d, body: %s",
+			url,
+			code,
+			body,
+		)
+	}
+
+	pullRequest := &PullRequest{}
+	if err := json.Unmarshal([]byte(body), pullRequest); err != nil {
+		return nil, errors.Wrap(err, "unmarshal body")
+	}
+	return pullRequest, nil
+}
+
+// listPaginatedPullRequestFile lists the changed files in the pull request with pagination.
+func (p *Provider) listPaginatedPullRequestFile(ctx context.Context, oauthCtx common.OauthContext, instanceURL, repositoryID, pullRequestID string, page int) ([]PullRequestFile, error) {
+	url := fmt.Sprintf("%s/repos/%s/pulls/%s/files?page=%d&limit=%d", p.APIURL(instanceURL), repositoryID, pullRequestID, page, apiPageSize)
+	code, _, body, err := oauth.Get(
+		ctx,
+		p.client,
+		url,
+		&oauthCtx.AccessToken,
+		tokenRefresher(
+			instanceURL,
+			oauthContext{
+				ClientID:     oauthCtx.ClientID,
+				ClientSecret: oauthCtx.ClientSecret,
+				RefreshToken: oauthCtx.RefreshToken,
+			},
+			oauthCtx.Refresher,
+		),
+	)
+	if err != nil {
+		return nil, errors.Wrapf(err, "GET %s", url)
+	}
+	if code == http.StatusNotFound {
+		return nil, common.Errorf(common.NotFound, "failed to list pull request file from URL %s", url)
+	} else if code >= 300 {
+		return nil, errors.Errorf("failed to list pull request file from URL %s, status code: %d, body: %s",
+			url,
+			code,
+			body,
+		)
+	}
+
+	var prFiles []PullRequestFile
+	if err := json.Unmarshal([]byte(body), &prFiles); err != nil {
+		return nil, errors.Wrap(err, "unmarshal body")
+	}
+	return prFiles, nil
+}
+
+// CreatePullRequest creates the pull request in the repository.
+//
+// Docs: https://gitea.com/api/swagger#/repository/repoCreatePullRequest
+func (p *Provider) CreatePullRequest(ctx context.Context, oauthCtx common.OauthContext, instanceURL, repositoryID string, pullRequestCreate *vcs.PullRequestCreate) (*vcs.PullRequest, error) {
+	body, err := json.Marshal(pullRequestCreate)
+	if err != nil {
+		return nil, errors.Wrap(err, "marshal pull request create")
+	}
+
+	url := fmt.Sprintf("%s/repos/%s/pulls", p.APIURL(instanceURL), repositoryID)
+	code, _, resp, err := oauth.Post(
+		ctx,
+		p.client,
+		url,
+		&oauthCtx.AccessToken,
+		bytes.NewReader(body),
+		tokenRefresher(
+			instanceURL,
+			oauthContext{
+				ClientID:     oauthCtx.ClientID,
+				ClientSecret: oauthCtx.ClientSecret,
+				RefreshToken: oauthCtx.RefreshToken,
+			},
+			oauthCtx.Refresher,
+		),
+	)
+	if err != nil {
+		return nil, errors.Wrapf(err, "POST %s", url)
+	}
+
+	if code == http.StatusNotFound {
+		return nil, common.Errorf(common.NotFound, "failed to create pull request from URL %s", url)
+	} else if code >= 300 {
+		return nil, errors.Errorf("failed to create pull request from URL %s, status code: %d, body: %s",
+			url,
+			code,
+			resp,
+		)
+	}
+
+	var res PullRequest
+	if err := json.Unmarshal([]byte(resp), &res); err != nil {
+		return nil, err
+	}
+	return &vcs.PullRequest{
+		URL: res.HTMLURL,
+	}, nil
+}
+
+// GetPullRequest gets the pull request in the repository.
+//
+// Docs: https://gitea.com/api/swagger#/repository/repoGetPullRequest
+func (p *Provider) GetPullRequest(ctx context.Context, oauthCtx common.OauthContext, instanceURL, repositoryID, pullRequestID string) (*vcs.PullRequest, error) {
+	url := fmt.Sprintf("%s/repos/%s/pulls/%s", p.APIURL(instanceURL), repositoryID, pullRequestID)
+	code, _, body, err := oauth.Get(
+		ctx,
+		p.client,
+		url,
+		&oauthCtx.AccessToken,
+		tokenRefresher(
+			instanceURL,
+			oauthContext{
+				ClientID:     oauthCtx.ClientID,
+				ClientSecret: oauthCtx.ClientSecret,
+				RefreshToken: oauthCtx.RefreshToken,
+			},
+			oauthCtx.Refresher,
+		),
+	)
+	if err != nil {
+		return nil, errors.Wrapf(err, "GET %s", url)
+	}
+
+	if code == http.StatusNotFound {
+		return nil, common.Errorf(common.NotFound, "failed to get pull request from URL %s", url)
+	} else if code >= 300 {
+		return nil, errors.Errorf("failed to get pull request from URL %s, status code: %d, body: %s",
+			url,
+			code,
+			body,
+		)
+	}
+
+	var res PullRequest
+	if err := json.Unmarshal([]byte(body), &res); err != nil {
+		return nil, err
+	}
+	return convertToPullRequest(&res), nil
+}
+
+// ListOpenPullRequests lists the first page of the open pull requests to the base branch in the repository.
+// The API doesn't filter the pull requests by the base branch, so they're filtered here.
+//
+// Docs: https://gitea.com/api/swagger#/repository/repoListPullRequests
+func (p *Provider) ListOpenPullRequests(ctx context.Context, oauthCtx common.OauthContext, instanceURL, repositoryID, baseBranch string) ([]*vcs.PullRequest, error) {
+	url := fmt.Sprintf("%s/repos/%s/pulls?state=open&limit=%d", p.APIURL(instanceURL), repositoryID, apiPageSize)
+	code, _, body, err := oauth.Get(
+		ctx,
+		p.client,
+		url,
+		&oauthCtx.AccessToken,
+		tokenRefresher(
+			instanceURL,
+			oauthContext{
+				ClientID:     oauthCtx.ClientID,
+				ClientSecret: oauthCtx.ClientSecret,
+				RefreshToken: oauthCtx.RefreshToken,
+			},
+			oauthCtx.Refresher,
+		),
+	)
+	if err != nil {
+		return nil, errors.Wrapf(err, "GET %s", url)
+	}
+
+	if code == http.StatusNotFound {
+		return nil, common.Errorf(common.NotFound, "failed to list pull requests from URL %s", url)
+	} else if code >= 300 {
+		return nil, errors.Errorf("failed to list pull requests from URL %s, status code: %d, body: %s",
+			url,
+			code,
+			body,
+		)
+	}
+
+	var list []*PullRequest
+	if err := json.Unmarshal([]byte(body), &list); err != nil {
+		return nil, err
+	}
+	var res []*vcs.PullRequest
+	for _, pullRequest := range list {
+		if pullRequest.Base.Ref == baseBranch {
+			res = append(res, convertToPullRequest(pullRequest))
+		}
+	}
+	return res, nil
+}
+
+func convertToPullRequest(pullRequest *PullRequest) *vcs.PullRequest {
+	state := vcs.PullRequestStateOpen
+	if pullRequest.Merged {
+		state = vcs.PullRequestStateMerged
+	} else if pullRequest.State != "open" {
+		state = vcs.PullRequestStateClosed
+	}
+	return &vcs.PullRequest{
+		URL:          pullRequest.HTMLURL,
+		State:        state,
+		HeadCommitID: pullRequest.Head.SHA,
+		HeadBranch:   pullRequest.Head.Ref,
+		BaseBranch:   pullRequest.Base.Ref,
+	}
+}
+
+// CreatePullRequestComment creates a comment on the pull request.
+//
+// Docs: https://gitea.com/api/swagger#/issue/issueCreateComment
+func (p *Provider) CreatePullRequestComment(ctx context.Context, oauthCtx common.OauthContext, instanceURL, repositoryID, pullRequestID, comment string) error {
+	body, err := json.Marshal(PullRequestComment{Body: comment})
+	if err != nil {
+		return errors.Wrap(err, "marshal pull request comment")
+	}
+
+	url := fmt.Sprintf("%s/repos/%s/issues/%s/comments", p.APIURL(instanceURL), repositoryID, pullRequestID)
+	return p.post(ctx, oauthCtx, instanceURL, url, body, "create pull request comment")
+}
+
+// CreateCommitStatus creates the status of the commit.
+//
+// Docs: https://gitea.com/api/swagger#/repository/repoCreateStatus
+func (p *Provider) CreateCommitStatus(ctx context.Context, oauthCtx common.OauthContext, instanceURL, repositoryID, commitID string, commitStatus *vcs.CommitStatus) error {
+	body, err := json.Marshal(
+		CommitStatusCreate{
+			State:       convertToCommitStatusState(commitStatus.State),
+			Context:     commitStatus.Context,
+			Description: commitStatus.Description,
+			TargetURL:   commitStatus.TargetURL,
+		},
+	)
+	if err != nil {
+		return errors.Wrap(err, "marshal commit status create")
+	}
+
+	url := fmt.Sprintf("%s/repos/%s/statuses/%s", p.APIURL(instanceURL), repositoryID, commitID)
+	return p.post(ctx, oauthCtx, instanceURL, url, body, "create commit status")
+}
+
+func convertToCommitStatusState(state vcs.CommitStatusState) string {
+	switch state {
+	case vcs.CommitStatusSuccess:
+		return "success"
+	case vcs.CommitStatusFailure:
+		return "failure"
+	case vcs.CommitStatusError:
+		return "error"
+	default:
+		return "pending"
+	}
+}
+
+func (p *Provider) post(ctx context.Context, oauthCtx common.OauthContext, instanceURL, url string, body []byte, action string) error {
+	code, _, resp, err := oauth.Post(
+		ctx,
+		p.client,
+		url,
+		&oauthCtx.AccessToken,
+		bytes.NewReader(body),
+		tokenRefresher(
+			instanceURL,
+			oauthContext{
+				ClientID:     oauthCtx.ClientID,
+				ClientSecret: oauthCtx.ClientSecret,
+				RefreshToken: oauthCtx.RefreshToken,
+			},
+			oauthCtx.Refresher,
+		),
+	)
+	if err != nil {
+		return errors.Wrapf(err, "POST %s", url)
+	}
+
+	if code == http.StatusNotFound {
+		return common.Errorf(common.NotFound, "failed to %s from URL %s", action, url)
+	} else if code >= 300 {
+		return errors.Errorf("failed to %s from URL %s, status code: %d, body: %s",
+			action,
+			url,
+			code,
+			resp,
+		)
+	}
+	return nil
+}
+
+// UpsertEnvironmentVariable creates or updates the Gitea Actions secret in the repository.
+//
+// Docs: https://gitea.com/api/swagger#/repository/updateRepoSecret
+func (p *Provider) UpsertEnvironmentVariable(ctx context.Context, oauthCtx common.OauthContext, instanceURL, repositoryID, key, value string) error {
+	body, err := json.Marshal(
+		RepositorySecretUpdate{
+			Data: value,
+		},
+	)
+	if err != nil {
+		return errors.Wrap(err, "marshal environment variable")
+	}
+
+	url := fmt.Sprintf("%s/repos/%s/actions/secrets/%s", p.APIURL(instanceURL), repositoryID, key)
+	code, _, resp, err := oauth.Put(
+		ctx,
+		p.client,
+		url,
+		&oauthCtx.AccessToken,
+		bytes.NewReader(body),
+		tokenRefresher(
+			instanceURL,
+			oauthContext{
+				ClientID:     oauthCtx.ClientID,
+				ClientSecret: oauthCtx.ClientSecret,
+				RefreshToken: oauthCtx.RefreshToken,
+			},
+			oauthCtx.Refresher,
+		),
+	)
+	if err != nil {
+		return errors.Wrapf(err, "PUT %s", url)
+	}
+
+	if code == http.StatusNotFound {
+		return common.Errorf(common.NotFound, "failed to upsert environment variable from URL %s", url)
+	} else if code >= 300 {
+		return errors.Errorf("failed to upsert environment variable from URL %s, status code: %d, body: %s",
+			url,
+			code,
+			resp,
+		)
+	}
+	return nil
+}
+
+// CreateWebhook creates a webhook in the repository with given payload.
+//
+// Docs: https://gitea.com/api/swagger#/repository/repoCreateHook
+func (p *Provider) CreateWebhook(ctx context.Context, oauthCtx common.OauthContext, instanceURL, repositoryID string, payload []byte) (string, error) {
+	url := fmt.Sprintf("%s/repos/%s/hooks", p.APIURL(instanceURL), repositoryID)
+	code, _, body, err := oauth.Post(
+		ctx,
+		p.client,
+		url,
+		&oauthCtx.AccessToken,
+		bytes.NewReader(payload),
+		tokenRefresher(
+			instanceURL,
+			oauthContext{
+				ClientID:     oauthCtx.ClientID,
+				ClientSecret: oauthCtx.ClientSecret,
+				RefreshToken: oauthCtx.RefreshToken,
+			},
+			oauthCtx.Refresher,
+		),
+	)
+	if err != nil {
+		return "", errors.Wrapf(err, "POST %s", url)
+	}
+
+	if code == http.StatusNotFound {
+		return "", common.Errorf(common.NotFound, "failed to create webhook through URL %s", url)
+	} else if code >= 300 {
+		return "", errors.Errorf("failed to create webhook through URL %s, status code: %d, body: %s",
+			url,
+			code,
+			body,
+		)
+	}
+
+	var webhookInfo WebhookInfo
+	if err := json.Unmarshal([]byte(body), &webhookInfo); err != nil {
+		return "", errors.Wrap(err, "unmarshal body")
+	}
+	return strconv.Itoa(webhookInfo.ID), nil
+}
+
+// PatchWebhook patches the webhook in the repository with given payload.
+//
+// Docs: https://gitea.com/api/swagger#/repository/repoEditHook
+func (p *Provider) PatchWebhook(ctx context.Context, oauthCtx common.OauthContext, instanceURL, repositoryID, webhookID string, payload []byte) error {
+	url := fmt.Sprintf("%s/repos/%s/hooks/%s", p.APIURL(instanceURL), repositoryID, webhookID)
+	code, _, body, err := oauth.Patch(
+		ctx,
+		p.client,
+		url,
+		&oauthCtx.AccessToken,
+		bytes.NewReader(payload),
+		tokenRefresher(
+			instanceURL,
+			oauthContext{
+				ClientID:     oauthCtx.ClientID,
+				ClientSecret: oauthCtx.ClientSecret,
+				RefreshToken: oauthCtx.RefreshToken,
+			},
+			oauthCtx.Refresher,
+		),
+	)
+	if err != nil {
+		return errors.Wrapf(err, "PATCH %s", url)
+	}
+
+	if code == http.StatusNotFound {
+		return common.Errorf(common.NotFound, "failed to patch webhook through URL %s", url)
+	} else if code >= 300 {
+		return errors.Errorf("failed to patch webhook through URL %s, status code: %d, body: %s",
+			url,
+			code,
+			body,
+		)
+	}
+	return nil
+}
+
+// DeleteWebhook deletes the webhook from the repository.
+//
+// Docs: https://gitea.com/api/swagger#/repository/repoDeleteHook
+func (p *Provider) DeleteWebhook(ctx context.Context, oauthCtx common.OauthContext, instanceURL, repositoryID, webhookID string) error {
+	url := fmt.Sprintf("%s/repos/%s/hooks/%s", p.APIURL(instanceURL), repositoryID, webhookID)
+	code, _, body, err := oauth.Delete(
+		ctx,
+		p.client,
+		url,
+		&oauthCtx.AccessToken,
+		tokenRefresher(
+			instanceURL,
+			oauthContext{
+				ClientID:     oauthCtx.ClientID,
+				ClientSecret: oauthCtx.ClientSecret,
+				RefreshToken: oauthCtx.RefreshToken,
+			},
+			oauthCtx.Refresher,
+		),
+	)
+	if err != nil {
+		return errors.Wrapf(err, "DELETE %s", url)
+	}
+
+	if code == http.StatusNotFound {
+		return nil // It is OK if the webhook has already gone
+	} else if code >= 300 {
+		return errors.Errorf("failed to delete webhook through URL %s, status code: %d, body: %s",
+			url,
+			code,
+			body,
+		)
+	}
+	return nil
+}
+
+// escapeFilePath escapes every segment of the file path, the "/" is kept because Gitea uses it in the API path.
+func escapeFilePath(filePath string) string {
+	segments := strings.Split(filePath, "/")
+	for i, segment := range segments {
+		segments[i] = url.PathEscape(segment)
+	}
+	return strings.Join(segments, "/")
+}
+
+// oauthContext is the request context for refreshing oauth token.
+type oauthContext struct {
+	ClientID     string
+	ClientSecret string
+	RefreshToken string
+}
+
+func tokenRefresher(instanceURL string, oauthCtx oauthContext, refresher common.TokenRefresher) oauth.TokenRefresher {
+	return func(ctx context.Context, client *http.Client, oldToken *string) error {
+		params := &url.Values{}
+		params.Set("client_id", oauthCtx.ClientID)
+		params.Set("client_secret", oauthCtx.ClientSecret)
+		params.Set("refresh_token", oauthCtx.RefreshToken)
+		params.Set("grant_type", "refresh_token")
+
+		url := fmt.Sprintf("%s/login/oauth/access_token", instanceURL)
+		req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, strings.NewReader(params.Encode()))
+		if err != nil {
+			return errors.Wrapf(err, "construct POST %s", url)
+		}
+
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		req.Header.Set("Accept", "application/json")
+		resp, err := client.Do(req)
+		if err != nil {
+			return errors.Wrapf(err, "POST %s", url)
+		}
+		defer resp.Body.Close()
+
+		body, err := io.ReadAll(resp.Body)
+		if err != nil {
+			return errors.Wrapf(err, "read body of POST %s", url)
+		}
+
+		if resp.StatusCode != http.StatusOK {
+			return errors.Errorf("non-200 POST %s status code %d with body %q", url, resp.StatusCode, body)
+		}
+
+		var r oauthResponse
+		if err := json.Unmarshal(body, &r); err != nil {
+			return errors.Wrapf(err, "unmarshal body from POST %s", url)
+		}
+
+		// Update the old token to new value for retries.
+		*oldToken = r.AccessToken
+
+		return refresher(r.AccessToken, r.RefreshToken, r.toVCSOAuthToken().ExpiresTs)
+	}
+}
+
+// ToVCS returns the push event in VCS format.
+func (p WebhookPushEvent) ToVCS() vcs.PushEvent {
+	var commitList []vcs.Commit
+	for _, commit := range p.Commits {
+		// Per Git convention, the message title and body are separated by two new line characters.
+		messages := strings.SplitN(commit.Message, "\n\n", 2)
+		messageTitle := strings.TrimSpace(messages[0])
+
+		commitList = append(commitList, vcs.Commit{
+			ID:           commit.ID,
+			Title:        messageTitle,
+			Message:      commit.Message,
+			CreatedTs:    commit.Timestamp.Unix(),
+			URL:          commit.URL,
+			AuthorName:   commit.Author.Name,
+			AuthorEmail:  commit.Author.Email,
+			AddedList:    commit.Added,
+			ModifiedList: commit.Modified,
+		})
+	}
+	return vcs.PushEvent{
+		VCSType:            vcs.Gitea,
+		Ref:                p.Ref,
+		Before:             p.Before,
+		After:              p.After,
+		RepositoryID:       p.Repository.FullName,
+		RepositoryURL:      p.Repository.HTMLURL,
+		RepositoryFullPath: p.Repository.FullName,
+		AuthorName:         p.Sender.Login,
+		CommitList:         commitList,
+	}
+}
+
+// ToVCS converts the Gitea pull request event to the VCS pull request event.
+// It returns false if the action is not interesting to the pull request flow.
+func (p WebhookPullRequestEvent) ToVCS() (vcs.PullRequestEvent, bool) {
+	var action vcs.PullRequestEventAction
+	switch p.Action {
+	case "opened", "reopened":
+		action = vcs.PullRequestEventOpened
+	case "synchronized":
+		action = vcs.PullRequestEventUpdated
+	case "closed":
+		action = vcs.PullRequestEventClosed
+		if p.PullRequest.Merged {
+			action = vcs.PullRequestEventMerged
+		}
+	default:
+		return vcs.PullRequestEvent{}, false
+	}
+	return vcs.PullRequestEvent{
+		VCSType:            vcs.Gitea,
+		Action:             action,
+		RepositoryID:       p.Repository.FullName,
+		RepositoryURL:      p.Repository.HTMLURL,
+		RepositoryFullPath: p.Repository.FullName,
+		PullRequestID:      strconv.Itoa(p.PullRequest.Number),
+		URL:                p.PullRequest.HTMLURL,
+		Title:              p.PullRequest.Title,
+		Description:        p.PullRequest.Body,
+		AuthorName:         p.PullRequest.User.Login,
+		AuthorEmail:        p.PullRequest.User.Email,
+		HeadCommitID:       p.PullRequest.Head.SHA,
+		BaseBranch:         p.PullRequest.Base.Ref,
+	}, true
+}
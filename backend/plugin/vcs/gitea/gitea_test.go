@@ -0,0 +1,476 @@
+package gitea
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bytebase/bytebase/backend/common"
+	"github.com/bytebase/bytebase/backend/plugin/vcs"
+)
+
+// newFakeGitea starts a fake Gitea server with given handlers on the API paths.
+func newFakeGitea(t *testing.T, handlers map[string]http.HandlerFunc) (*Provider, string) {
+	mux := http.NewServeMux()
+	for pattern, handler := range handlers {
+		mux.HandleFunc(pattern, handler)
+	}
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+	return newProvider(vcs.ProviderConfig{Client: server.Client()}).(*Provider), server.URL
+}
+
+func TestProvider_ExchangeOAuthToken(t *testing.T) {
+	p, instanceURL := newFakeGitea(t, map[string]http.HandlerFunc{
+		"/login/oauth/access_token": func(w http.ResponseWriter, r *http.Request) {
+			require.NoError(t, r.ParseForm())
+			assert.Equal(t, "authorization_code", r.PostForm.Get("grant_type"))
+			assert.Equal(t, "test_code", r.PostForm.Get("code"))
+			_, _ = io.WriteString(w, `{"access_token":"access","token_type":"bearer","expires_in":3600,"refresh_token":"refresh"}`)
+		},
+	})
+
+	got, err := p.ExchangeOAuthToken(context.Background(), instanceURL, &common.OAuthExchange{
+		ClientID:     "test_client_id",
+		ClientSecret: "test_client_secret",
+		Code:         "test_code",
+		RedirectURL:  "http://localhost:3000",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "access", got.AccessToken)
+	assert.Equal(t, "refresh", got.RefreshToken)
+	assert.Equal(t, got.CreatedAt+3600, got.ExpiresTs)
+}
+
+func TestProvider_TokenRefresh(t *testing.T) {
+	p, instanceURL := newFakeGitea(t, map[string]http.HandlerFunc{
+		"/api/v1/user": func(w http.ResponseWriter, r *http.Request) {
+			if r.Header.Get("Authorization") != "Bearer new" {
+				w.WriteHeader(http.StatusUnauthorized)
+				_, _ = io.WriteString(w, `{"error":"invalid_token","error_description":"Token is expired."}`)
+				return
+			}
+			_, _ = io.WriteString(w, `{"login":"octocat","full_name":"","email":"octocat@gitea.io"}`)
+		},
+		"/login/oauth/access_token": func(w http.ResponseWriter, r *http.Request) {
+			require.NoError(t, r.ParseForm())
+			assert.Equal(t, "refresh_token", r.PostForm.Get("grant_type"))
+			_, _ = io.WriteString(w, `{"access_token":"new","expires_in":3600,"refresh_token":"new_refresh"}`)
+		},
+	})
+
+	var refreshed string
+	got, err := p.TryLogin(context.Background(), common.OauthContext{
+		AccessToken:  "old",
+		RefreshToken: "refresh",
+		Refresher: func(token, _ string, _ int64) error {
+			refreshed = token
+			return nil
+		},
+	}, instanceURL)
+	require.NoError(t, err)
+	assert.Equal(t, "new", refreshed)
+	assert.Equal(t, &vcs.UserInfo{PublicEmail: "octocat@gitea.io", Name: "octocat", State: vcs.StateActive}, got)
+}
+
+func TestProvider_FetchCommitByID(t *testing.T) {
+	p, instanceURL := newFakeGitea(t, map[string]http.HandlerFunc{
+		"/api/v1/repos/octocat/hello/git/commits/abc": func(w http.ResponseWriter, _ *http.Request) {
+			_, _ = io.WriteString(w, `
+{
+  "sha": "abc",
+  "html_url": "https://gitea.io/octocat/hello/commit/abc",
+  "commit": {
+    "message": "add readme\n\nmore details",
+    "author": {"name": "Octocat", "email": "octocat@gitea.io", "date": "2014-11-07T22:01:45Z"}
+  }
+}`)
+		},
+	})
+
+	got, err := p.FetchCommitByID(context.Background(), common.OauthContext{}, instanceURL, "octocat/hello", "abc")
+	require.NoError(t, err)
+	want := &vcs.Commit{
+		ID:          "abc",
+		Title:       "add readme",
+		Message:     "add readme\n\nmore details",
+		CreatedTs:   1415397705,
+		URL:         "https://gitea.io/octocat/hello/commit/abc",
+		AuthorName:  "Octocat",
+		AuthorEmail: "octocat@gitea.io",
+	}
+	assert.Equal(t, want, got)
+
+	_, err = p.FetchCommitByID(context.Background(), common.OauthContext{}, instanceURL, "octocat/hello", "missing")
+	assert.Equal(t, common.NotFound, common.ErrorCode(err))
+}
+
+func TestProvider_GetDiffFileList(t *testing.T) {
+	p, instanceURL := newFakeGitea(t, map[string]http.HandlerFunc{
+		"/api/v1/repos/octocat/hello/compare/a...c": func(w http.ResponseWriter, _ *http.Request) {
+			_, _ = io.WriteString(w, `
+{
+  "total_commits": 2,
+  "commits": [
+    {"sha": "b", "files": [{"filename": "1.sql", "status": "added"}, {"filename": "2.sql", "status": "removed"}]},
+    {"sha": "c", "files": [{"filename": "1.sql", "status": "modified"}, {"filename": "2.sql", "status": "added"}, {"filename": "3.sql", "status": "modified"}]}
+  ]
+}`)
+		},
+	})
+
+	got, err := p.GetDiffFileList(context.Background(), common.OauthContext{}, instanceURL, "octocat/hello", "a", "c")
+	require.NoError(t, err)
+	want := []vcs.FileDiff{
+		{Path: "1.sql", Type: vcs.FileDiffTypeAdded},
+		{Path: "2.sql", Type: vcs.FileDiffTypeModified},
+		{Path: "3.sql", Type: vcs.FileDiffTypeModified},
+	}
+	assert.Equal(t, want, got)
+}
+
+func TestProvider_FetchAllRepositoryList(t *testing.T) {
+	p, instanceURL := newFakeGitea(t, map[string]http.HandlerFunc{
+		"/api/v1/user/repos": func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "1", r.URL.Query().Get("page"))
+			_, _ = io.WriteString(w, `
+[
+  {"id": 1, "name": "hello", "full_name": "octocat/hello", "html_url": "https://gitea.io/octocat/hello", "permissions": {"admin": true}},
+  {"id": 2, "name": "world", "full_name": "octocat/world", "html_url": "https://gitea.io/octocat/world", "permissions": {"admin": false}}
+]`)
+		},
+	})
+
+	got, err := p.FetchAllRepositoryList(context.Background(), common.OauthContext{}, instanceURL)
+	require.NoError(t, err)
+	want := []*vcs.Repository{
+		{ID: "octocat/hello", Name: "hello", FullPath: "octocat/hello", WebURL: "https://gitea.io/octocat/hello"},
+	}
+	assert.Equal(t, want, got)
+}
+
+func TestProvider_FetchRepositoryFileList(t *testing.T) {
+	p, instanceURL := newFakeGitea(t, map[string]http.HandlerFunc{
+		"/api/v1/repos/octocat/hello/git/trees/main": func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "true", r.URL.Query().Get("recursive"))
+			if r.URL.Query().Get("page") == "1" {
+				_, _ = io.WriteString(w, `{"truncated": true, "tree": [{"path": "db", "type": "tree"}, {"path": "db/1.sql", "type": "blob"}]}`)
+				return
+			}
+			_, _ = io.WriteString(w, `{"truncated": false, "tree": [{"path": "README.md", "type": "blob"}, {"path": "db/2.sql", "type": "blob"}]}`)
+		},
+	})
+
+	got, err := p.FetchRepositoryFileList(context.Background(), common.OauthContext{}, instanceURL, "octocat/hello", "main", "db")
+	require.NoError(t, err)
+	want := []*vcs.RepositoryTreeNode{
+		{Path: "db/1.sql", Type: "blob"},
+		{Path: "db/2.sql", Type: "blob"},
+	}
+	assert.Equal(t, want, got)
+}
+
+func TestProvider_ReadAndWriteFile(t *testing.T) {
+	var created, updated FileCommit
+	p, instanceURL := newFakeGitea(t, map[string]http.HandlerFunc{
+		"/api/v1/repos/octocat/hello/contents/db/1.sql": func(w http.ResponseWriter, r *http.Request) {
+			switch r.Method {
+			case http.MethodGet:
+				assert.Equal(t, "main", r.URL.Query().Get("ref"))
+				_, _ = io.WriteString(w, `{"type": "file", "encoding": "base64", "size": 8, "name": "1.sql", "path": "db/1.sql", "sha": "sha1", "last_commit_sha": "c1", "content": "`+base64.StdEncoding.EncodeToString([]byte("SELECT 1"))+`"}`)
+			case http.MethodPost:
+				require.NoError(t, json.NewDecoder(r.Body).Decode(&created))
+				w.WriteHeader(http.StatusCreated)
+			case http.MethodPut:
+				require.NoError(t, json.NewDecoder(r.Body).Decode(&updated))
+			}
+		},
+	})
+	ctx := context.Background()
+
+	meta, err := p.ReadFileMeta(ctx, common.OauthContext{}, instanceURL, "octocat/hello", "db/1.sql", "main")
+	require.NoError(t, err)
+	assert.Equal(t, &vcs.FileMeta{Name: "1.sql", Path: "db/1.sql", Size: 8, SHA: "sha1", LastCommitID: "c1"}, meta)
+
+	content, err := p.ReadFileContent(ctx, common.OauthContext{}, instanceURL, "octocat/hello", "db/1.sql", "main")
+	require.NoError(t, err)
+	assert.Equal(t, "SELECT 1", content)
+
+	err = p.CreateFile(ctx, common.OauthContext{}, instanceURL, "octocat/hello", "db/1.sql", vcs.FileCommitCreate{
+		Branch:        "main",
+		Content:       "SELECT 2",
+		CommitMessage: "create",
+		AuthorName:    vcs.BytebaseAuthorName,
+		AuthorEmail:   vcs.BytebaseAuthorEmail,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, FileCommit{
+		Message: "create",
+		Content: base64.StdEncoding.EncodeToString([]byte("SELECT 2")),
+		Branch:  "main",
+		Author:  &CommitUser{Name: vcs.BytebaseAuthorName, Email: vcs.BytebaseAuthorEmail},
+	}, created)
+
+	err = p.OverwriteFile(ctx, common.OauthContext{}, instanceURL, "octocat/hello", "db/1.sql", vcs.FileCommitCreate{
+		Branch:        "main",
+		Content:       "SELECT 3",
+		CommitMessage: "update",
+		SHA:           "sha1",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "sha1", updated.SHA)
+	assert.Nil(t, updated.Author)
+}
+
+func TestProvider_Branch(t *testing.T) {
+	var branchCreate BranchCreate
+	p, instanceURL := newFakeGitea(t, map[string]http.HandlerFunc{
+		"/api/v1/repos/octocat/hello/branches/main": func(w http.ResponseWriter, _ *http.Request) {
+			_, _ = io.WriteString(w, `{"name": "main", "commit": {"id": "c1"}}`)
+		},
+		"/api/v1/repos/octocat/hello/branches": func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, http.MethodPost, r.Method)
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&branchCreate))
+			w.WriteHeader(http.StatusCreated)
+		},
+	})
+	ctx := context.Background()
+
+	got, err := p.GetBranch(ctx, common.OauthContext{}, instanceURL, "octocat/hello", "main")
+	require.NoError(t, err)
+	assert.Equal(t, &vcs.BranchInfo{Name: "main", LastCommitID: "c1"}, got)
+
+	_, err = p.GetBranch(ctx, common.OauthContext{}, instanceURL, "octocat/hello", "missing")
+	assert.Equal(t, common.NotFound, common.ErrorCode(err))
+
+	err = p.CreateBranch(ctx, common.OauthContext{}, instanceURL, "octocat/hello", &vcs.BranchInfo{Name: "feature", LastCommitID: "c1"})
+	require.NoError(t, err)
+	assert.Equal(t, BranchCreate{NewBranchName: "feature", OldRefName: "c1"}, branchCreate)
+}
+
+func TestProvider_PullRequest(t *testing.T) {
+	var pullRequestCreate map[string]any
+	p, instanceURL := newFakeGitea(t, map[string]http.HandlerFunc{
+		"/api/v1/repos/octocat/hello/pulls/3": func(w http.ResponseWriter, _ *http.Request) {
+			_, _ = io.WriteString(w, `{"number": 3, "head": {"ref": "feature", "sha": "c2"}}`)
+		},
+		"/api/v1/repos/octocat/hello/pulls/3/files": func(w http.ResponseWriter, _ *http.Request) {
+			_, _ = io.WriteString(w, `[{"filename": "db/1.sql", "status": "added"}, {"filename": "db/2.sql", "status": "deleted"}]`)
+		},
+		"/api/v1/repos/octocat/hello/pulls": func(w http.ResponseWriter, r *http.Request) {
+			if r.Method == http.MethodGet {
+				assert.Equal(t, "open", r.URL.Query().Get("state"))
+				_, _ = io.WriteString(w, `[
+					{"number": 5, "state": "open", "html_url": "https://gitea.io/octocat/hello/pulls/5", "head": {"ref": "feature", "sha": "c3"}, "base": {"ref": "main"}},
+					{"number": 6, "state": "open", "html_url": "https://gitea.io/octocat/hello/pulls/6", "head": {"ref": "fix", "sha": "c4"}, "base": {"ref": "release"}}
+				]`)
+				return
+			}
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&pullRequestCreate))
+			w.WriteHeader(http.StatusCreated)
+			_, _ = io.WriteString(w, `{"number": 4, "html_url": "https://gitea.io/octocat/hello/pulls/4"}`)
+		},
+	})
+	ctx := context.Background()
+
+	files, err := p.ListPullRequestFile(ctx, common.OauthContext{}, instanceURL, "octocat/hello", "3")
+	require.NoError(t, err)
+	want := []*vcs.PullRequestFile{
+		{Path: "db/1.sql", LastCommitID: "c2"},
+		{Path: "db/2.sql", LastCommitID: "c2", IsDeleted: true},
+	}
+	assert.Equal(t, want, files)
+
+	pr, err := p.CreatePullRequest(ctx, common.OauthContext{}, instanceURL, "octocat/hello", &vcs.PullRequestCreate{
+		Title: "title",
+		Body:  "body",
+		Head:  "feature",
+		Base:  "main",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "https://gitea.io/octocat/hello/pulls/4", pr.URL)
+	assert.Equal(t, "feature", pullRequestCreate["head"])
+	assert.Equal(t, "main", pullRequestCreate["base"])
+
+	// The pull requests to the other base branches are filtered out.
+	pullRequests, err := p.ListOpenPullRequests(ctx, common.OauthContext{}, instanceURL, "octocat/hello", "main")
+	require.NoError(t, err)
+	assert.Equal(t, []*vcs.PullRequest{
+		{URL: "https://gitea.io/octocat/hello/pulls/5", State: vcs.PullRequestStateOpen, HeadCommitID: "c3", HeadBranch: "feature", BaseBranch: "main"},
+	}, pullRequests)
+}
+
+func TestProvider_Webhook(t *testing.T) {
+	var hookCreate WebhookCreateOrUpdate
+	deleted := false
+	p, instanceURL := newFakeGitea(t, map[string]http.HandlerFunc{
+		"/api/v1/repos/octocat/hello/hooks": func(w http.ResponseWriter, r *http.Request) {
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&hookCreate))
+			w.WriteHeader(http.StatusCreated)
+			_, _ = io.WriteString(w, `{"id": 7}`)
+		},
+		"/api/v1/repos/octocat/hello/hooks/7": func(w http.ResponseWriter, r *http.Request) {
+			switch r.Method {
+			case http.MethodPatch:
+				_, _ = io.WriteString(w, `{"id": 7}`)
+			case http.MethodDelete:
+				deleted = true
+				w.WriteHeader(http.StatusNoContent)
+			}
+		},
+		"/api/v1/repos/octocat/hello/actions/secrets/SQL_REVIEW_API_SECRET": func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, http.MethodPut, r.Method)
+			w.WriteHeader(http.StatusNoContent)
+		},
+	})
+	ctx := context.Background()
+
+	payload, err := json.Marshal(WebhookCreateOrUpdate{
+		Type:   "gitea",
+		Config: WebhookConfig{URL: "http://bytebase/hook/gitea/1", ContentType: "json", Secret: "secret"},
+		Events: []string{string(WebhookPush)},
+		Active: true,
+	})
+	require.NoError(t, err)
+	id, err := p.CreateWebhook(ctx, common.OauthContext{}, instanceURL, "octocat/hello", payload)
+	require.NoError(t, err)
+	assert.Equal(t, "7", id)
+	assert.Equal(t, "secret", hookCreate.Config.Secret)
+
+	require.NoError(t, p.PatchWebhook(ctx, common.OauthContext{}, instanceURL, "octocat/hello", id, payload))
+	require.NoError(t, p.DeleteWebhook(ctx, common.OauthContext{}, instanceURL, "octocat/hello", id))
+	assert.True(t, deleted)
+	// Deleting a webhook that has gone is fine.
+	require.NoError(t, p.DeleteWebhook(ctx, common.OauthContext{}, instanceURL, "octocat/hello", "8"))
+
+	require.NoError(t, p.UpsertEnvironmentVariable(ctx, common.OauthContext{}, instanceURL, "octocat/hello", vcs.SQLReviewAPISecretName, "token"))
+}
+
+func TestWebhookPushEvent_ToVCS(t *testing.T) {
+	body := `
+{
+  "ref": "refs/heads/main",
+  "before": "a",
+  "after": "b",
+  "commits": [
+    {
+      "id": "b",
+      "message": "add schema\n\ndetails",
+      "url": "https://gitea.io/octocat/hello/commit/b",
+      "author": {"name": "Octocat", "email": "octocat@gitea.io", "username": "octocat"},
+      "timestamp": "2014-11-07T22:01:45Z",
+      "added": ["db/1.sql"],
+      "modified": ["db/2.sql"],
+      "removed": []
+    }
+  ],
+  "repository": {"id": 1, "full_name": "octocat/hello", "html_url": "https://gitea.io/octocat/hello"},
+  "sender": {"login": "octocat"}
+}`
+	var event WebhookPushEvent
+	require.NoError(t, json.Unmarshal([]byte(body), &event))
+	want := vcs.PushEvent{
+		VCSType:            vcs.Gitea,
+		Ref:                "refs/heads/main",
+		Before:             "a",
+		After:              "b",
+		RepositoryID:       "octocat/hello",
+		RepositoryURL:      "https://gitea.io/octocat/hello",
+		RepositoryFullPath: "octocat/hello",
+		AuthorName:         "octocat",
+		CommitList: []vcs.Commit{
+			{
+				ID:           "b",
+				Title:        "add schema",
+				Message:      "add schema\n\ndetails",
+				CreatedTs:    1415397705,
+				URL:          "https://gitea.io/octocat/hello/commit/b",
+				AuthorName:   "Octocat",
+				AuthorEmail:  "octocat@gitea.io",
+				AddedList:    []string{"db/1.sql"},
+				ModifiedList: []string{"db/2.sql"},
+			},
+		},
+	}
+	assert.Equal(t, want, event.ToVCS())
+}
+
+func TestProvider_PullRequestCommentAndStatus(t *testing.T) {
+	var comment PullRequestComment
+	var status CommitStatusCreate
+	p, instanceURL := newFakeGitea(t, map[string]http.HandlerFunc{
+		"/api/v1/repos/octocat/hello/issues/3/comments": func(w http.ResponseWriter, r *http.Request) {
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&comment))
+			w.WriteHeader(http.StatusCreated)
+			_, _ = io.WriteString(w, `{"id": 1}`)
+		},
+		"/api/v1/repos/octocat/hello/statuses/c2": func(w http.ResponseWriter, r *http.Request) {
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&status))
+			w.WriteHeader(http.StatusCreated)
+			_, _ = io.WriteString(w, `{"id": 1}`)
+		},
+	})
+	ctx := context.Background()
+
+	err := p.CreatePullRequestComment(ctx, common.OauthContext{}, instanceURL, "octocat/hello", "3", "content")
+	require.NoError(t, err)
+	assert.Equal(t, PullRequestComment{Body: "content"}, comment)
+
+	err = p.CreateCommitStatus(ctx, common.OauthContext{}, instanceURL, "octocat/hello", "c2", &vcs.CommitStatus{
+		State:     vcs.CommitStatusPending,
+		Context:   "bytebase/review",
+		TargetURL: "https://bytebase.example.com/issue/1",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, CommitStatusCreate{
+		State:     "pending",
+		Context:   "bytebase/review",
+		TargetURL: "https://bytebase.example.com/issue/1",
+	}, status)
+}
+
+func TestWebhookPullRequestEvent_ToVCS(t *testing.T) {
+	body := `
+{
+  "action": "synchronized",
+  "number": 3,
+  "pull_request": {
+    "number": 3,
+    "html_url": "https://gitea.io/octocat/hello/pulls/3",
+    "title": "Add the email column",
+    "body": "details",
+    "merged": false,
+    "user": {"login": "octocat", "email": "octocat@gitea.io"},
+    "head": {"ref": "feature", "sha": "c2"},
+    "base": {"ref": "main", "sha": "c1"}
+  },
+  "repository": {"id": 1, "full_name": "octocat/hello", "html_url": "https://gitea.io/octocat/hello"}
+}`
+	var event WebhookPullRequestEvent
+	require.NoError(t, json.Unmarshal([]byte(body), &event))
+	got, ok := event.ToVCS()
+	require.True(t, ok)
+	want := vcs.PullRequestEvent{
+		VCSType:            vcs.Gitea,
+		Action:             vcs.PullRequestEventUpdated,
+		RepositoryID:       "octocat/hello",
+		RepositoryURL:      "https://gitea.io/octocat/hello",
+		RepositoryFullPath: "octocat/hello",
+		PullRequestID:      "3",
+		URL:                "https://gitea.io/octocat/hello/pulls/3",
+		Title:              "Add the email column",
+		Description:        "details",
+		AuthorName:         "octocat",
+		AuthorEmail:        "octocat@gitea.io",
+		HeadCommitID:       "c2",
+		BaseBranch:         "main",
+	}
+	assert.Equal(t, want, got)
+}
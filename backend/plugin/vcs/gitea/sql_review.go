@@ -0,0 +1,23 @@
+package gitea
+
+import (
+	_ "embed"
+	"fmt"
+
+	"github.com/bytebase/bytebase/backend/plugin/vcs"
+)
+
+// sqlReviewAction is the Gitea action for SQL review in VCS workflow.
+//
+//go:embed bytebase-sql-review.yml
+var sqlReviewAction string
+
+const (
+	// SQLReviewActionFilePath is the SQL review action file path.
+	SQLReviewActionFilePath = ".gitea/workflows/bytebase-sql-review.yml"
+)
+
+// SetupSQLReviewCI will setup the SQL review CI content with SQL review endpoint.
+func SetupSQLReviewCI(endpoint string) string {
+	return fmt.Sprintf(sqlReviewAction, endpoint, vcs.SQLReviewAPISecretName)
+}
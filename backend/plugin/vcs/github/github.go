@@ -124,6 +124,8 @@ const (
 	WebhookPush WebhookType = "push"
 	// WebhookPing is the webhook type for ping.
 	WebhookPing WebhookType = "ping"
+	// WebhookPullRequest is the webhook type for pull request.
+	WebhookPullRequest WebhookType = "pull_request"
 )
 
 // WebhookInfo represents a GitHub API response for the webhook information.
@@ -203,6 +205,31 @@ type WebhookPushEvent struct {
 	Commits    []WebhookCommit   `json:"commits"`
 }
 
+// WebhookPullRequestBranch is the API message for the branch of the pull request in the webhook event.
+type WebhookPullRequestBranch struct {
+	Ref string `json:"ref"`
+	SHA string `json:"sha"`
+}
+
+// WebhookPullRequestInfo is the API message for the pull request in the webhook event.
+type WebhookPullRequestInfo struct {
+	Number  int                      `json:"number"`
+	HTMLURL string                   `json:"html_url"`
+	Title   string                   `json:"title"`
+	Body    string                   `json:"body"`
+	Merged  bool                     `json:"merged"`
+	User    WebhookSender            `json:"user"`
+	Head    WebhookPullRequestBranch `json:"head"`
+	Base    WebhookPullRequestBranch `json:"base"`
+}
+
+// WebhookPullRequestEvent is the API message for webhook pull request event.
+type WebhookPullRequestEvent struct {
+	Action      string                 `json:"action"`
+	PullRequest WebhookPullRequestInfo `json:"pull_request"`
+	Repository  WebhookRepository      `json:"repository"`
+}
+
 // fetchUserInfoImpl fetches user information from the given resourceURI, which
 // should be either "user" or "users/{username}".
 func (p *Provider) fetchUserInfoImpl(ctx context.Context, oauthCtx common.OauthContext, instanceURL, resourceURI string) (*vcs.UserInfo, error) {
@@ -1012,6 +1039,119 @@ func (p *Provider) CreateBranch(ctx context.Context, oauthCtx common.OauthContex
 // PullRequest is the API message for GitHub pull request.
 type PullRequest struct {
 	HTMLURL string `json:"html_url"`
+	// State is either "open" or "closed", a merged pull request is also "closed".
+	State  string          `json:"state"`
+	Merged bool            `json:"merged"`
+	Head   PullRequestHead `json:"head"`
+	Base   PullRequestHead `json:"base"`
+}
+
+// PullRequestHead is the API message for the head or base of GitHub pull request.
+type PullRequestHead struct {
+	Ref string `json:"ref"`
+	SHA string `json:"sha"`
+}
+
+// GetPullRequest gets the pull request in the repository.
+//
+// Docs: https://docs.github.com/en/rest/pulls/pulls#get-a-pull-request
+func (p *Provider) GetPullRequest(ctx context.Context, oauthCtx common.OauthContext, instanceURL, repositoryID, pullRequestID string) (*vcs.PullRequest, error) {
+	url := fmt.Sprintf("%s/repos/%s/pulls/%s", p.APIURL(instanceURL), repositoryID, pullRequestID)
+	code, _, body, err := oauth.Get(
+		ctx,
+		p.client,
+		url,
+		&oauthCtx.AccessToken,
+		tokenRefresher(
+			instanceURL,
+			oauthContext{
+				ClientID:     oauthCtx.ClientID,
+				ClientSecret: oauthCtx.ClientSecret,
+				RefreshToken: oauthCtx.RefreshToken,
+			},
+			oauthCtx.Refresher,
+		),
+	)
+	if err != nil {
+		return nil, errors.Wrapf(err, "GET %s", url)
+	}
+
+	if code == http.StatusNotFound {
+		return nil, common.Errorf(common.NotFound, "failed to get pull request from URL %s", url)
+	} else if code >= 300 {
+		return nil, errors.Errorf("failed to get pull request from URL %s, status code: %d, body: %s",
+			url,
+			code,
+			body,
+		)
+	}
+
+	var res PullRequest
+	if err := json.Unmarshal([]byte(body), &res); err != nil {
+		return nil, err
+	}
+	return convertToPullRequest(&res), nil
+}
+
+// ListOpenPullRequests lists the first page of the open pull requests to the base branch in the repository.
+//
+// Docs: https://docs.github.com/en/rest/pulls/pulls#list-pull-requests
+func (p *Provider) ListOpenPullRequests(ctx context.Context, oauthCtx common.OauthContext, instanceURL, repositoryID, baseBranch string) ([]*vcs.PullRequest, error) {
+	url := fmt.Sprintf("%s/repos/%s/pulls?state=open&base=%s&per_page=%d", p.APIURL(instanceURL), repositoryID, url.QueryEscape(baseBranch), apiPageSize)
+	code, _, body, err := oauth.Get(
+		ctx,
+		p.client,
+		url,
+		&oauthCtx.AccessToken,
+		tokenRefresher(
+			instanceURL,
+			oauthContext{
+				ClientID:     oauthCtx.ClientID,
+				ClientSecret: oauthCtx.ClientSecret,
+				RefreshToken: oauthCtx.RefreshToken,
+			},
+			oauthCtx.Refresher,
+		),
+	)
+	if err != nil {
+		return nil, errors.Wrapf(err, "GET %s", url)
+	}
+
+	if code == http.StatusNotFound {
+		return nil, common.Errorf(common.NotFound, "failed to list pull requests from URL %s", url)
+	} else if code >= 300 {
+		return nil, errors.Errorf("failed to list pull requests from URL %s, status code: %d, body: %s",
+			url,
+			code,
+			body,
+		)
+	}
+
+	var list []*PullRequest
+	if err := json.Unmarshal([]byte(body), &list); err != nil {
+		return nil, err
+	}
+	var res []*vcs.PullRequest
+	for _, pullRequest := range list {
+		res = append(res, convertToPullRequest(pullRequest))
+	}
+	return res, nil
+}
+
+func convertToPullRequest(pullRequest *PullRequest) *vcs.PullRequest {
+	state := vcs.PullRequestStateOpen
+	if pullRequest.Merged {
+		state = vcs.PullRequestStateMerged
+	} else if pullRequest.State != "open" {
+		state = vcs.PullRequestStateClosed
+	}
+	return &vcs.PullRequest{
+		URL:          pullRequest.HTMLURL,
+		State:        state,
+		HeadCommitID: pullRequest.Head.SHA,
+		HeadBranch:   pullRequest.Head.Ref,
+		BaseBranch:   pullRequest.Base.Ref,
+	}
 }
 
 // CreatePullRequest creates the pull request in the repository.
@@ -1070,6 +1210,169 @@ type RepositorySecretUpdate struct {
 	KeyID          string `json:"key_id"`
 }
 
+// CreatePullRequestComment creates a comment in the pull request.
+//
+// Docs: https://docs.github.com/en/rest/issues/comments#create-an-issue-comment
+func (p *Provider) CreatePullRequestComment(ctx context.Context, oauthCtx common.OauthContext, instanceURL, repositoryID, pullRequestID, comment string) error {
+	body, err := json.Marshal(map[string]string{"body": comment})
+	if err != nil {
+		return errors.Wrap(err, "marshal pull request comment")
+	}
+
+	// Pull requests are issues in GitHub, they share the comments API.
+	url := fmt.Sprintf("%s/repos/%s/issues/%s/comments", p.APIURL(instanceURL), repositoryID, pullRequestID)
+	code, _, resp, err := oauth.Post(
+		ctx,
+		p.client,
+		url,
+		&oauthCtx.AccessToken,
+		bytes.NewReader(body),
+		tokenRefresher(
+			instanceURL,
+			oauthContext{
+				ClientID:     oauthCtx.ClientID,
+				ClientSecret: oauthCtx.ClientSecret,
+				RefreshToken: oauthCtx.RefreshToken,
+			},
+			oauthCtx.Refresher,
+		),
+	)
+	if err != nil {
+		return errors.Wrapf(err, "POST %s", url)
+	}
+
+	if code == http.StatusNotFound {
+		return common.Errorf(common.NotFound, "failed to create pull request comment from URL %s", url)
+	} else if code >= 300 {
+		return errors.Errorf("failed to create pull request comment from URL %s, status code: %d, body: %s",
+			url,
+			code,
+			resp,
+		)
+	}
+	return nil
+}
+
+// CheckRunCreate is the API message for creating a check run.
+type CheckRunCreate struct {
+	Name       string         `json:"name"`
+	HeadSHA    string         `json:"head_sha"`
+	Status     string         `json:"status"`
+	Conclusion string         `json:"conclusion,omitempty"`
+	DetailsURL string         `json:"details_url,omitempty"`
+	Output     CheckRunOutput `json:"output"`
+}
+
+// CheckRunOutput is the API message for the output of a check run.
+type CheckRunOutput struct {
+	Title   string `json:"title"`
+	Summary string `json:"summary"`
+}
+
+// CommitStatusCreate is the API message for creating a commit status.
+type CommitStatusCreate struct {
+	State       string `json:"state"`
+	TargetURL   string `json:"target_url,omitempty"`
+	Description string `json:"description,omitempty"`
+	Context     string `json:"context"`
+}
+
+// CreateCommitStatus creates the commit status. It reports a check run, and falls back
+// to the commit status if the token is not allowed to write checks, which is the case
+// for the OAuth app tokens.
+//
+// Docs: https://docs.github.com/en/rest/checks/runs#create-a-check-run
+// Docs: https://docs.github.com/en/rest/commits/statuses#create-a-commit-status
+func (p *Provider) CreateCommitStatus(ctx context.Context, oauthCtx common.OauthContext, instanceURL, repositoryID, commitID string, commitStatus *vcs.CommitStatus) error {
+	checkRun := CheckRunCreate{
+		Name:       commitStatus.Context,
+		HeadSHA:    commitID,
+		Status:     "completed",
+		DetailsURL: commitStatus.TargetURL,
+		Output: CheckRunOutput{
+			Title:   commitStatus.Description,
+			Summary: commitStatus.Description,
+		},
+	}
+	switch commitStatus.State {
+	case vcs.CommitStatusSuccess:
+		checkRun.Conclusion = "success"
+	case vcs.CommitStatusFailure:
+		checkRun.Conclusion = "failure"
+	case vcs.CommitStatusError:
+		checkRun.Conclusion = "failure"
+	default:
+		checkRun.Status = "in_progress"
+	}
+	code, err := p.post(ctx, oauthCtx, instanceURL, fmt.Sprintf("%s/repos/%s/check-runs", p.APIURL(instanceURL), repositoryID), checkRun)
+	if err != nil {
+		return errors.Wrap(err, "create check run")
+	}
+	if code != http.StatusForbidden && code != http.StatusNotFound {
+		return nil
+	}
+
+	url := fmt.Sprintf("%s/repos/%s/statuses/%s", p.APIURL(instanceURL), repositoryID, commitID)
+	code, err = p.post(ctx, oauthCtx, instanceURL, url, CommitStatusCreate{
+		State:       convertToCommitStatusState(commitStatus.State),
+		TargetURL:   commitStatus.TargetURL,
+		Description: commitStatus.Description,
+		Context:     commitStatus.Context,
+	})
+	if err != nil {
+		return errors.Wrap(err, "create commit status")
+	}
+	if code == http.StatusNotFound {
+		return common.Errorf(common.NotFound, "failed to create commit status from URL %s", url)
+	}
+	return nil
+}
+
+// post posts the message to the URL. It returns the status code for 403 and 404,
+// and an error for other unsuccessful status codes.
+func (p *Provider) post(ctx context.Context, oauthCtx common.OauthContext, instanceURL, url string, message any) (int, error) {
+	body, err := json.Marshal(message)
+	if err != nil {
+		return 0, errors.Wrap(err, "marshal message")
+	}
+	code, _, resp, err := oauth.Post(
+		ctx,
+		p.client,
+		url,
+		&oauthCtx.AccessToken,
+		bytes.NewReader(body),
+		tokenRefresher(
+			instanceURL,
+			oauthContext{
+				ClientID:     oauthCtx.ClientID,
+				ClientSecret: oauthCtx.ClientSecret,
+				RefreshToken: oauthCtx.RefreshToken,
+			},
+			oauthCtx.Refresher,
+		),
+	)
+	if err != nil {
+		return 0, errors.Wrapf(err, "POST %s", url)
+	}
+	if code >= 300 && code != http.StatusForbidden && code != http.StatusNotFound {
+		return code, errors.Errorf("failed to POST %s, status code: %d, body: %s", url, code, resp)
+	}
+	return code, nil
+}
+
+func convertToCommitStatusState(state vcs.CommitStatusState) string {
+	switch state {
+	case vcs.CommitStatusSuccess:
+		return "success"
+	case vcs.CommitStatusFailure:
+		return "failure"
+	case vcs.CommitStatusError:
+		return "error"
+	default:
+		return "pending"
+	}
+}
+
 // UpsertEnvironmentVariable creates or updates the environment variable in the repository.
 //
 // https://docs.github.com/en/rest/actions/secrets#create-or-update-a-repository-secret
@@ -1444,3 +1747,36 @@ func (p WebhookPushEvent) ToVCS() vcs.PushEvent {
 		CommitList:         commitList,
 	}
 }
+
+// ToVCS returns the pull request event in VCS format. It returns false if the action is not
+// related to the pull request flow, e.g. the pull request is labeled.
+func (p WebhookPullRequestEvent) ToVCS() (vcs.PullRequestEvent, bool) {
+	var action vcs.PullRequestEventAction
+	switch p.Action {
+	case "opened", "reopened":
+		action = vcs.PullRequestEventOpened
+	case "synchronize":
+		action = vcs.PullRequestEventUpdated
+	case "closed":
+		action = vcs.PullRequestEventClosed
+		if p.PullRequest.Merged {
+			action = vcs.PullRequestEventMerged
+		}
+	default:
+		return vcs.PullRequestEvent{}, false
+	}
+	return vcs.PullRequestEvent{
+		VCSType:            vcs.GitHub,
+		Action:             action,
+		RepositoryID:       p.Repository.FullName,
+		RepositoryURL:      p.Repository.HTMLURL,
+		RepositoryFullPath: p.Repository.FullName,
+		PullRequestID:      strconv.Itoa(p.PullRequest.Number),
+		URL:                p.PullRequest.HTMLURL,
+		Title:              p.PullRequest.Title,
+		Description:        p.PullRequest.Body,
+		AuthorName:         p.PullRequest.User.Login,
+		HeadCommitID:       p.PullRequest.Head.SHA,
+		BaseBranch:         p.PullRequest.Base.Ref,
+	}, true
+}
@@ -973,6 +973,41 @@ func TestProvider_UpsertEnvironmentVariable(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestProvider_ListOpenPullRequests(t *testing.T) {
+	p := newMockProvider(func(r *http.Request) (*http.Response, error) {
+		assert.Equal(t, "GET", r.Method)
+		assert.Equal(t, "/repos/octocat/Hello-World/pulls", r.URL.Path)
+		assert.Equal(t, "open", r.URL.Query().Get("state"))
+		assert.Equal(t, "main", r.URL.Query().Get("base"))
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body: io.NopCloser(strings.NewReader(`
+[
+  {
+    "html_url": "https://github.com/octocat/Hello-World/pull/1347",
+    "state": "open",
+    "head": {"ref": "new-topic", "sha": "6dcb09b5b57875f334f61aebed695e2e4193db5e"},
+    "base": {"ref": "main", "sha": "6dcb09b5b57875f334f61aebed695e2e4193db5e"}
+  }
+]
+`)),
+		}, nil
+	})
+
+	got, err := p.ListOpenPullRequests(context.Background(), common.OauthContext{}, githubComURL, "octocat/Hello-World", "main")
+	require.NoError(t, err)
+	want := []*vcs.PullRequest{
+		{
+			URL:          "https://github.com/octocat/Hello-World/pull/1347",
+			State:        vcs.PullRequestStateOpen,
+			HeadCommitID: "6dcb09b5b57875f334f61aebed695e2e4193db5e",
+			HeadBranch:   "new-topic",
+			BaseBranch:   "main",
+		},
+	}
+	assert.Equal(t, want, got)
+}
+
 func TestProvider_ListPullRequestFile(t *testing.T) {
 	p := newMockProvider(func(r *http.Request) (*http.Response, error) {
 		assert.Equal(t, "/repos/octocat/Hello-World/pulls/1/files", r.URL.Path)
@@ -1058,6 +1093,98 @@ func TestProvider_GetDiffFileList(t *testing.T) {
 	assert.Equal(t, want, got)
 }
 
+func TestProvider_CreateCommitStatus(t *testing.T) {
+	var paths []string
+	p := newMockProvider(func(r *http.Request) (*http.Response, error) {
+		assert.Equal(t, "POST", r.Method)
+		paths = append(paths, r.URL.Path)
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"name": "bytebase/review", "head_sha": "6dcb09b5b57875f334f61aebed695e2e4193db5e", "status": "completed", "conclusion": "failure", "details_url": "https://bytebase.example.com/issue/1", "output": {"title": "SQL review failed", "summary": "SQL review failed"}}`, string(body))
+		return &http.Response{
+			StatusCode: http.StatusCreated,
+			Body:       io.NopCloser(strings.NewReader(`{"id": 1}`)),
+		}, nil
+	},
+	)
+
+	ctx := context.Background()
+	commitStatus := &vcs.CommitStatus{
+		State:       vcs.CommitStatusFailure,
+		Context:     "bytebase/review",
+		Description: "SQL review failed",
+		TargetURL:   "https://bytebase.example.com/issue/1",
+	}
+	err := p.CreateCommitStatus(ctx, common.OauthContext{}, githubComURL, "octocat/Hello-World", "6dcb09b5b57875f334f61aebed695e2e4193db5e", commitStatus)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"/repos/octocat/Hello-World/check-runs"}, paths)
+
+	// Fall back to the commit status if the token cannot write checks.
+	paths = nil
+	p = newMockProvider(func(r *http.Request) (*http.Response, error) {
+		assert.Equal(t, "POST", r.Method)
+		paths = append(paths, r.URL.Path)
+		if r.URL.Path == "/repos/octocat/Hello-World/check-runs" {
+			return &http.Response{
+				StatusCode: http.StatusForbidden,
+				Body:       io.NopCloser(strings.NewReader(`{"message": "Resource not accessible by integration"}`)),
+			}, nil
+		}
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"state": "failure", "context": "bytebase/review", "description": "SQL review failed", "target_url": "https://bytebase.example.com/issue/1"}`, string(body))
+		return &http.Response{
+			StatusCode: http.StatusCreated,
+			Body:       io.NopCloser(strings.NewReader(`{"id": 1}`)),
+		}, nil
+	},
+	)
+	err = p.CreateCommitStatus(ctx, common.OauthContext{}, githubComURL, "octocat/Hello-World", "6dcb09b5b57875f334f61aebed695e2e4193db5e", commitStatus)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"/repos/octocat/Hello-World/check-runs", "/repos/octocat/Hello-World/statuses/6dcb09b5b57875f334f61aebed695e2e4193db5e"}, paths)
+}
+
+func TestWebhookPullRequestEvent_ToVCS(t *testing.T) {
+	event := WebhookPullRequestEvent{
+		Action: "closed",
+		PullRequest: WebhookPullRequestInfo{
+			Number:  1347,
+			HTMLURL: "https://github.com/octocat/Hello-World/pull/1347",
+			Title:   "Add the email column",
+			Merged:  true,
+			User:    WebhookSender{Login: "octocat"},
+			Head:    WebhookPullRequestBranch{Ref: "feature", SHA: "6dcb09b5b57875f334f61aebed695e2e4193db5e"},
+			Base:    WebhookPullRequestBranch{Ref: "main"},
+		},
+		Repository: WebhookRepository{FullName: "octocat/Hello-World", HTMLURL: "https://github.com/octocat/Hello-World"},
+	}
+	got, ok := event.ToVCS()
+	require.True(t, ok)
+	want := vcs.PullRequestEvent{
+		VCSType:            vcs.GitHub,
+		Action:             vcs.PullRequestEventMerged,
+		RepositoryID:       "octocat/Hello-World",
+		RepositoryURL:      "https://github.com/octocat/Hello-World",
+		RepositoryFullPath: "octocat/Hello-World",
+		PullRequestID:      "1347",
+		URL:                "https://github.com/octocat/Hello-World/pull/1347",
+		Title:              "Add the email column",
+		AuthorName:         "octocat",
+		HeadCommitID:       "6dcb09b5b57875f334f61aebed695e2e4193db5e",
+		BaseBranch:         "main",
+	}
+	assert.Equal(t, want, got)
+
+	event.PullRequest.Merged = false
+	got, ok = event.ToVCS()
+	require.True(t, ok)
+	assert.Equal(t, vcs.PullRequestEventClosed, got.Action)
+
+	event.Action = "labeled"
+	_, ok = event.ToVCS()
+	assert.False(t, ok)
+}
+
 func newMockProvider(mockRoundTrip func(r *http.Request) (*http.Response, error)) vcs.Provider {
 	return newProvider(
 		vcs.ProviderConfig{
@@ -38,6 +38,8 @@ type WebhookType string
 const (
 	// WebhookPush is the webhook type for push.
 	WebhookPush WebhookType = "push"
+	// WebhookMergeRequest is the webhook type for merge request.
+	WebhookMergeRequest WebhookType = "merge_request"
 )
 
 // WebhookInfo represents a GitLab API response for the webhook information.
@@ -51,12 +53,9 @@ type WebhookCreate struct {
 	SecretToken string `json:"token"`
 	// This is set to true
 	PushEvents bool `json:"push_events"`
-	// For now, there is no native dry run DDL support in mysql/postgres. One may wonder if we could wrap the DDL
-	// in a transaction and just not commit at the end, unfortunately there are side effects which are hard to control.
-	// See https://www.postgresql.org/message-id/CAMsr%2BYGiYQ7PYvYR2Voio37YdCpp79j5S%2BcmgVJMOLM2LnRQcA%40mail.gmail.com
-	// So we can't possibly display useful info when reviewing a MR, thus we don't enable this event.
-	// Saying that, delivering a souding dry run solution would be great and hopefully we can achieve that one day.
-	// MergeRequestsEvents  bool   `json:"merge_requests_events"`
+	// MergeRequestsEvents is used by the pull request flow, which creates draft issues for the merge
+	// requests and rolls them out on merge.
+	MergeRequestsEvents   bool `json:"merge_requests_events"`
 	EnableSSLVerification bool `json:"enable_ssl_verification"`
 }
 
@@ -96,6 +95,40 @@ type WebhookPushEvent struct {
 	CommitList []WebhookCommit `json:"commits"`
 }
 
+// WebhookUser is the API message for webhook user.
+type WebhookUser struct {
+	Name     string `json:"name"`
+	Username string `json:"username"`
+	Email    string `json:"email"`
+}
+
+// WebhookMergeRequestLastCommit is the API message for the last commit of the merge request in the webhook event.
+type WebhookMergeRequestLastCommit struct {
+	ID string `json:"id"`
+}
+
+// WebhookMergeRequestAttributes is the API message for the merge request attributes in the webhook event.
+type WebhookMergeRequestAttributes struct {
+	IID          int    `json:"iid"`
+	Title        string `json:"title"`
+	Description  string `json:"description"`
+	URL          string `json:"url"`
+	SourceBranch string `json:"source_branch"`
+	TargetBranch string `json:"target_branch"`
+	Action       string `json:"action"`
+	// OldRev is only set when new commits are pushed to the merge request.
+	OldRev     string                        `json:"oldrev"`
+	LastCommit WebhookMergeRequestLastCommit `json:"last_commit"`
+}
+
+// WebhookMergeRequestEvent is the API message for webhook merge request event.
+type WebhookMergeRequestEvent struct {
+	ObjectKind       WebhookType                   `json:"object_kind"`
+	User             WebhookUser                   `json:"user"`
+	Project          WebhookProject                `json:"project"`
+	ObjectAttributes WebhookMergeRequestAttributes `json:"object_attributes"`
+}
+
 // Commit is the API message for commit.
 type Commit struct {
 	ID         string `json:"id"`
@@ -844,6 +877,112 @@ func (p *Provider) CreateBranch(ctx context.Context, oauthCtx common.OauthContex
 // MergeRequest is the API message for GitLab merge request.
 type MergeRequest struct {
 	WebURL string `json:"web_url"`
+	// State is one of "opened", "closed", "locked" and "merged".
+	State        string `json:"state"`
+	SHA          string `json:"sha"`
+	SourceBranch string `json:"source_branch"`
+	TargetBranch string `json:"target_branch"`
+}
+
+// GetPullRequest gets the merge request in the repository.
+//
+// Docs: https://docs.gitlab.com/ee/api/merge_requests.html#get-single-mr
+func (p *Provider) GetPullRequest(ctx context.Context, oauthCtx common.OauthContext, instanceURL, repositoryID, pullRequestID string) (*vcs.PullRequest, error) {
+	url := fmt.Sprintf("%s/projects/%s/merge_requests/%s", p.APIURL(instanceURL), repositoryID, pullRequestID)
+	code, _, body, err := oauth.Get(
+		ctx,
+		p.client,
+		url,
+		&oauthCtx.AccessToken,
+		tokenRefresher(
+			instanceURL,
+			oauthContext{
+				ClientID:     oauthCtx.ClientID,
+				ClientSecret: oauthCtx.ClientSecret,
+				RefreshToken: oauthCtx.RefreshToken,
+			},
+			oauthCtx.Refresher,
+		),
+	)
+	if err != nil {
+		return nil, errors.Wrapf(err, "GET %s", url)
+	}
+	if code == http.StatusNotFound {
+		return nil, common.Errorf(common.NotFound, "failed to get merge request from URL %s", url)
+	} else if code >= 300 {
+		return nil, errors.Errorf("failed to get merge request from URL %s, status code: %d, body: %s",
+			url,
+			code,
+			body,
+		)
+	}
+
+	var res MergeRequest
+	if err := json.Unmarshal([]byte(body), &res); err != nil {
+		return nil, err
+	}
+	return convertToPullRequest(&res), nil
+}
+
+// ListOpenPullRequests lists the first page of the open merge requests to the base branch in the repository.
+//
+// Docs: https://docs.gitlab.com/ee/api/merge_requests.html#list-project-merge-requests
+func (p *Provider) ListOpenPullRequests(ctx context.Context, oauthCtx common.OauthContext, instanceURL, repositoryID, baseBranch string) ([]*vcs.PullRequest, error) {
+	url := fmt.Sprintf("%s/projects/%s/merge_requests?state=opened&target_branch=%s&per_page=%d", p.APIURL(instanceURL), repositoryID, url.QueryEscape(baseBranch), apiPageSize)
+	code, _, body, err := oauth.Get(
+		ctx,
+		p.client,
+		url,
+		&oauthCtx.AccessToken,
+		tokenRefresher(
+			instanceURL,
+			oauthContext{
+				ClientID:     oauthCtx.ClientID,
+				ClientSecret: oauthCtx.ClientSecret,
+				RefreshToken: oauthCtx.RefreshToken,
+			},
+			oauthCtx.Refresher,
+		),
+	)
+	if err != nil {
+		return nil, errors.Wrapf(err, "GET %s", url)
+	}
+	if code == http.StatusNotFound {
+		return nil, common.Errorf(common.NotFound, "failed to list merge requests from URL %s", url)
+	} else if code >= 300 {
+		return nil, errors.Errorf("failed to list merge requests from URL %s, status code: %d, body: %s",
+			url,
+			code,
+			body,
+		)
+	}
+
+	var list []*MergeRequest
+	if err := json.Unmarshal([]byte(body), &list); err != nil {
+		return nil, err
+	}
+	var res []*vcs.PullRequest
+	for _, mergeRequest := range list {
+		res = append(res, convertToPullRequest(mergeRequest))
+	}
+	return res, nil
+}
+
+func convertToPullRequest(mergeRequest *MergeRequest) *vcs.PullRequest {
+	state := vcs.PullRequestStateOpen
+	switch mergeRequest.State {
+	case "merged":
+		state = vcs.PullRequestStateMerged
+	case "closed":
+		state = vcs.PullRequestStateClosed
+	}
+	return &vcs.PullRequest{
+		URL:          mergeRequest.WebURL,
+		State:        state,
+		HeadCommitID: mergeRequest.SHA,
+		HeadBranch:   mergeRequest.SourceBranch,
+		BaseBranch:   mergeRequest.TargetBranch,
+	}
 }
 
 // CreatePullRequest creates the pull request in the repository.
@@ -904,6 +1043,116 @@ func (p *Provider) CreatePullRequest(ctx context.Context, oauthCtx common.OauthC
 	}, nil
 }
 
+// CreatePullRequestComment creates a comment in the merge request.
+//
+// Docs: https://docs.gitlab.com/ee/api/notes.html#create-new-merge-request-note
+func (p *Provider) CreatePullRequestComment(ctx context.Context, oauthCtx common.OauthContext, instanceURL, repositoryID, pullRequestID, comment string) error {
+	body, err := json.Marshal(map[string]string{"body": comment})
+	if err != nil {
+		return errors.Wrap(err, "marshal merge request note")
+	}
+
+	url := fmt.Sprintf("%s/projects/%s/merge_requests/%s/notes", p.APIURL(instanceURL), repositoryID, pullRequestID)
+	code, _, resp, err := oauth.Post(
+		ctx,
+		p.client,
+		url,
+		&oauthCtx.AccessToken,
+		bytes.NewReader(body),
+		tokenRefresher(
+			instanceURL,
+			oauthContext{
+				ClientID:     oauthCtx.ClientID,
+				ClientSecret: oauthCtx.ClientSecret,
+				RefreshToken: oauthCtx.RefreshToken,
+			},
+			oauthCtx.Refresher,
+		),
+	)
+	if err != nil {
+		return errors.Wrapf(err, "POST %s", url)
+	}
+
+	if code == http.StatusNotFound {
+		return common.Errorf(common.NotFound, "failed to create merge request note from URL %s", url)
+	} else if code >= 300 {
+		return errors.Errorf("failed to create merge request note from URL %s, status code: %d, body: %s",
+			url,
+			code,
+			resp,
+		)
+	}
+	return nil
+}
+
+// CommitStatusCreate is the API message for creating a commit status.
+type CommitStatusCreate struct {
+	State       string `json:"state"`
+	Name        string `json:"name"`
+	TargetURL   string `json:"target_url,omitempty"`
+	Description string `json:"description,omitempty"`
+}
+
+// CreateCommitStatus creates the commit status.
+//
+// Docs: https://docs.gitlab.com/ee/api/commits.html#set-the-pipeline-status-of-a-commit
+func (p *Provider) CreateCommitStatus(ctx context.Context, oauthCtx common.OauthContext, instanceURL, repositoryID, commitID string, commitStatus *vcs.CommitStatus) error {
+	body, err := json.Marshal(
+		CommitStatusCreate{
+			State:       convertToCommitStatusState(commitStatus.State),
+			Name:        commitStatus.Context,
+			TargetURL:   commitStatus.TargetURL,
+			Description: commitStatus.Description,
+		},
+	)
+	if err != nil {
+		return errors.Wrap(err, "marshal commit status create")
+	}
+
+	url := fmt.Sprintf("%s/projects/%s/statuses/%s", p.APIURL(instanceURL), repositoryID, commitID)
+	code, _, resp, err := oauth.Post(
+		ctx,
+		p.client,
+		url,
+		&oauthCtx.AccessToken,
+		bytes.NewReader(body),
+		tokenRefresher(
+			instanceURL,
+			oauthContext{
+				ClientID:     oauthCtx.ClientID,
+				ClientSecret: oauthCtx.ClientSecret,
+				RefreshToken: oauthCtx.RefreshToken,
+			},
+			oauthCtx.Refresher,
+		),
+	)
+	if err != nil {
+		return errors.Wrapf(err, "POST %s", url)
+	}
+
+	if code == http.StatusNotFound {
+		return common.Errorf(common.NotFound, "failed to create commit status from URL %s", url)
+	} else if code >= 300 {
+		return errors.Errorf("failed to create commit status from URL %s, status code: %d, body: %s",
+			url,
+			code,
+			resp,
+		)
+	}
+	return nil
+}
+
+func convertToCommitStatusState(state vcs.CommitStatusState) string {
+	switch state {
+	case vcs.CommitStatusSuccess:
+		return "success"
+	case vcs.CommitStatusFailure, vcs.CommitStatusError:
+		return "failed"
+	default:
+		return "running"
+	}
+}
+
 // EnvironmentVariable is the API message for environment variable in GitLab project.
 type EnvironmentVariable struct {
 	Key   string `json:"key"`
@@ -1321,3 +1570,39 @@ func (p WebhookPushEvent) ToVCS() (vcs.PushEvent, error) {
 		CommitList:         commitList,
 	}, nil
 }
+
+// ToVCS returns the merge request event in VCS format. It returns false if the action is not
+// related to the pull request flow, e.g. the merge request title is updated.
+func (p WebhookMergeRequestEvent) ToVCS() (vcs.PullRequestEvent, bool) {
+	var action vcs.PullRequestEventAction
+	switch p.ObjectAttributes.Action {
+	case "open", "reopen":
+		action = vcs.PullRequestEventOpened
+	case "update":
+		if p.ObjectAttributes.OldRev == "" {
+			return vcs.PullRequestEvent{}, false
+		}
+		action = vcs.PullRequestEventUpdated
+	case "merge":
+		action = vcs.PullRequestEventMerged
+	case "close":
+		action = vcs.PullRequestEventClosed
+	default:
+		return vcs.PullRequestEvent{}, false
+	}
+	return vcs.PullRequestEvent{
+		VCSType:            vcs.GitLab,
+		Action:             action,
+		RepositoryID:       fmt.Sprintf("%v", p.Project.ID),
+		RepositoryURL:      p.Project.WebURL,
+		RepositoryFullPath: p.Project.FullPath,
+		PullRequestID:      strconv.Itoa(p.ObjectAttributes.IID),
+		URL:                p.ObjectAttributes.URL,
+		Title:              p.ObjectAttributes.Title,
+		Description:        p.ObjectAttributes.Description,
+		AuthorName:         p.User.Name,
+		AuthorEmail:        p.User.Email,
+		HeadCommitID:       p.ObjectAttributes.LastCommit.ID,
+		BaseBranch:         p.ObjectAttributes.TargetBranch,
+	}, true
+}
@@ -853,6 +853,70 @@ func TestProvider_GetDiffFileList(t *testing.T) {
 	assert.Equal(t, want, got)
 }
 
+func TestProvider_CreateCommitStatus(t *testing.T) {
+	p := newMockProvider(func(r *http.Request) (*http.Response, error) {
+		assert.Equal(t, "POST", r.Method)
+		assert.Equal(t, "/api/v4/projects/1/statuses/7b5c3cc8be40ee161ae89a06bba6229da1032a0c", r.URL.Path)
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"state": "success", "name": "bytebase/review", "description": "Drafted 1 issue(s)", "target_url": "https://bytebase.example.com/issue/1"}`, string(body))
+		return &http.Response{
+			StatusCode: http.StatusCreated,
+			Body:       io.NopCloser(strings.NewReader(`{"id": 1}`)),
+		}, nil
+	},
+	)
+
+	ctx := context.Background()
+	err := p.CreateCommitStatus(ctx, common.OauthContext{}, "", "1", "7b5c3cc8be40ee161ae89a06bba6229da1032a0c", &vcs.CommitStatus{
+		State:       vcs.CommitStatusSuccess,
+		Context:     "bytebase/review",
+		Description: "Drafted 1 issue(s)",
+		TargetURL:   "https://bytebase.example.com/issue/1",
+	})
+	require.NoError(t, err)
+}
+
+func TestWebhookMergeRequestEvent_ToVCS(t *testing.T) {
+	event := WebhookMergeRequestEvent{
+		ObjectKind: WebhookMergeRequest,
+		User:       WebhookUser{Name: "Administrator", Username: "root", Email: "admin@example.com"},
+		Project:    WebhookProject{ID: 1, WebURL: "https://gitlab.example.com/gitlabhq/gitlab-test", FullPath: "gitlabhq/gitlab-test"},
+		ObjectAttributes: WebhookMergeRequestAttributes{
+			IID:          1,
+			Title:        "MS-Viewport",
+			URL:          "https://gitlab.example.com/gitlabhq/gitlab-test/-/merge_requests/1",
+			SourceBranch: "ms-viewport",
+			TargetBranch: "main",
+			Action:       "update",
+			OldRev:       "da1560886d4f094c3e6c9ef40349f7d38b5d27d7",
+			LastCommit:   WebhookMergeRequestLastCommit{ID: "7b5c3cc8be40ee161ae89a06bba6229da1032a0c"},
+		},
+	}
+	got, ok := event.ToVCS()
+	require.True(t, ok)
+	want := vcs.PullRequestEvent{
+		VCSType:            vcs.GitLab,
+		Action:             vcs.PullRequestEventUpdated,
+		RepositoryID:       "1",
+		RepositoryURL:      "https://gitlab.example.com/gitlabhq/gitlab-test",
+		RepositoryFullPath: "gitlabhq/gitlab-test",
+		PullRequestID:      "1",
+		URL:                "https://gitlab.example.com/gitlabhq/gitlab-test/-/merge_requests/1",
+		Title:              "MS-Viewport",
+		AuthorName:         "Administrator",
+		AuthorEmail:        "admin@example.com",
+		HeadCommitID:       "7b5c3cc8be40ee161ae89a06bba6229da1032a0c",
+		BaseBranch:         "main",
+	}
+	assert.Equal(t, want, got)
+
+	// The update without new commits, e.g. the title change, is ignored.
+	event.ObjectAttributes.OldRev = ""
+	_, ok = event.ToVCS()
+	assert.False(t, ok)
+}
+
 func newMockProvider(mockRoundTrip func(r *http.Request) (*http.Response, error)) vcs.Provider {
 	return newProvider(
 		vcs.ProviderConfig{
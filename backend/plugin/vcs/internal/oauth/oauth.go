@@ -86,18 +86,39 @@ func Put(ctx context.Context, client *http.Client, url string, token *string, bo
 	return retry(ctx, client, token, tokenRefresher, requester(ctx, client, http.MethodPut, url, token, body))
 }
 
+// PutWithHeader makes a HTTP PUT request to the given URL using the token and
+// additional header. It refreshes token and retries the request in the case of
+// the token has expired.
+func PutWithHeader(ctx context.Context, client *http.Client, url string, token *string, body io.Reader, tokenRefresher TokenRefresher, header map[string]string) (code int, _ http.Header, respBody string, err error) {
+	return retry(ctx, client, token, tokenRefresher, requesterWithHeader(ctx, client, http.MethodPut, url, token, body, header))
+}
+
 // Patch makes a HTTP PATCH request to the given URL using the token. It
 // refreshes token and retries the request in the case of the token has expired.
 func Patch(ctx context.Context, client *http.Client, url string, token *string, body io.Reader, tokenRefresher TokenRefresher) (code int, header http.Header, respBody string, err error) {
 	return retry(ctx, client, token, tokenRefresher, requester(ctx, client, http.MethodPatch, url, token, body))
 }
 
+// PatchWithHeader makes a HTTP PATCH request to the given URL using the token
+// and additional header. It refreshes token and retries the request in the case
+// of the token has expired.
+func PatchWithHeader(ctx context.Context, client *http.Client, url string, token *string, body io.Reader, tokenRefresher TokenRefresher, header map[string]string) (code int, _ http.Header, respBody string, err error) {
+	return retry(ctx, client, token, tokenRefresher, requesterWithHeader(ctx, client, http.MethodPatch, url, token, body, header))
+}
+
 // Delete makes a HTTP DELETE request to the given URL using the token. It refreshes
 // token and retries the request in the case of the token has expired.
 func Delete(ctx context.Context, client *http.Client, url string, token *string, tokenRefresher TokenRefresher) (code int, header http.Header, respBody string, err error) {
 	return retry(ctx, client, token, tokenRefresher, requester(ctx, client, http.MethodDelete, url, token, nil))
 }
 
+// DeleteWithHeader makes a HTTP DELETE request to the given URL using the token
+// and additional header. It refreshes token and retries the request in the case
+// of the token has expired.
+func DeleteWithHeader(ctx context.Context, client *http.Client, url string, token *string, tokenRefresher TokenRefresher, header map[string]string) (code int, _ http.Header, respBody string, err error) {
+	return retry(ctx, client, token, tokenRefresher, requesterWithHeader(ctx, client, http.MethodDelete, url, token, nil, header))
+}
+
 const maxRetries = 3
 
 func retry(ctx context.Context, client *http.Client, token *string, tokenRefresher TokenRefresher, f func() (*http.Response, error)) (code int, header http.Header, respBody string, err error) {
@@ -24,6 +24,10 @@ const (
 	GitHub Type = "GITHUB"
 	// Bitbucket is the VCS type for Bitbucket Cloud (bitbucket.org).
 	Bitbucket Type = "BITBUCKET"
+	// AzureDevOps is the VCS type for Azure DevOps Repos (dev.azure.com).
+	AzureDevOps Type = "AZURE_DEVOPS"
+	// Gitea is the VCS type for Gitea and Forgejo (self-hosted).
+	Gitea Type = "GITEA"
 
 	// SQLReviewAPISecretName is the api secret name used in GitHub action or GitLab CI workflow.
 	SQLReviewAPISecretName = "SQL_REVIEW_API_SECRET"
@@ -137,6 +141,64 @@ type PushEvent struct {
 	FileCommit FileCommit `json:"fileCommit"`
 }
 
+// PullRequestEventAction is the action of a VCS pull request event.
+type PullRequestEventAction string
+
+const (
+	// PullRequestEventOpened means the pull request is opened or reopened.
+	PullRequestEventOpened PullRequestEventAction = "OPENED"
+	// PullRequestEventUpdated means new commits are pushed to the pull request.
+	PullRequestEventUpdated PullRequestEventAction = "UPDATED"
+	// PullRequestEventMerged means the pull request is merged.
+	PullRequestEventMerged PullRequestEventAction = "MERGED"
+	// PullRequestEventClosed means the pull request is closed without being merged.
+	PullRequestEventClosed PullRequestEventAction = "CLOSED"
+)
+
+// PullRequestEvent is the API message for a VCS pull request event.
+type PullRequestEvent struct {
+	VCSType            Type
+	Action             PullRequestEventAction
+	RepositoryID       string
+	RepositoryURL      string
+	RepositoryFullPath string
+	// PullRequestID is the pull request number for GitHub, Gitea and Bitbucket, the merge request IID
+	// for GitLab, and the pull request ID for Azure DevOps.
+	PullRequestID string
+	URL           string
+	Title         string
+	Description   string
+	AuthorName    string
+	AuthorEmail   string
+	// HeadCommitID is the latest commit of the source branch.
+	HeadCommitID string
+	// BaseBranch is the target branch of the pull request.
+	BaseBranch string
+}
+
+// CommitStatusState is the state of a commit status.
+type CommitStatusState string
+
+const (
+	// CommitStatusPending means the checks are running.
+	CommitStatusPending CommitStatusState = "PENDING"
+	// CommitStatusSuccess means the checks are passed.
+	CommitStatusSuccess CommitStatusState = "SUCCESS"
+	// CommitStatusFailure means the checks are failed.
+	CommitStatusFailure CommitStatusState = "FAILURE"
+	// CommitStatusError means the checks cannot be finished because of errors.
+	CommitStatusError CommitStatusState = "ERROR"
+)
+
+// CommitStatus is the API message for a commit status.
+type CommitStatus struct {
+	State CommitStatusState
+	// Context distinguishes the status from the statuses set by other systems, e.g. "bytebase/review".
+	Context     string
+	Description string
+	TargetURL   string
+}
+
 // State is the state of a VCS user account.
 type State string
 
@@ -187,9 +249,26 @@ type PullRequestCreate struct {
 	RemoveHeadAfterMerged bool `json:"-"`
 }
 
+// PullRequestState is the state of a pull request.
+type PullRequestState string
+
+const (
+	// PullRequestStateOpen means the pull request is open.
+	PullRequestStateOpen PullRequestState = "OPEN"
+	// PullRequestStateMerged means the pull request is merged.
+	PullRequestStateMerged PullRequestState = "MERGED"
+	// PullRequestStateClosed means the pull request is closed without being merged.
+	PullRequestStateClosed PullRequestState = "CLOSED"
+)
+
 // PullRequest is the API message for pull request in repository.
 type PullRequest struct {
 	URL string `json:"url"`
+	// State, HeadCommitID, HeadBranch and BaseBranch are only set by GetPullRequest and ListOpenPullRequests.
+	State        PullRequestState `json:"-"`
+	HeadCommitID string           `json:"-"`
+	HeadBranch   string           `json:"-"`
+	BaseBranch   string           `json:"-"`
 }
 
 // Provider is the interface for VCS provider.
@@ -290,6 +369,36 @@ type Provider interface {
 	ListPullRequestFile(ctx context.Context, oauthCtx common.OauthContext, instanceURL, repositoryID, pullRequestID string) ([]*PullRequestFile, error)
 	// pullRequestCreate: the new pull request info
 	CreatePullRequest(ctx context.Context, oauthCtx common.OauthContext, instanceURL, repositoryID string, pullRequestCreate *PullRequestCreate) (*PullRequest, error)
+	// GetPullRequest gets the pull request in the repository.
+	//
+	// oauthCtx: OAuth context to get the pull request
+	// instanceURL: VCS instance URL
+	// repositoryID: the repository ID from the external VCS system (note this is NOT the ID of Bytebase's own repository resource)
+	// pullRequestID: the pull request id
+	GetPullRequest(ctx context.Context, oauthCtx common.OauthContext, instanceURL, repositoryID, pullRequestID string) (*PullRequest, error)
+	// ListOpenPullRequests lists the first page of the open pull requests to the base branch in the repository.
+	//
+	// oauthCtx: OAuth context to list the pull requests
+	// instanceURL: VCS instance URL
+	// repositoryID: the repository ID from the external VCS system (note this is NOT the ID of Bytebase's own repository resource)
+	// baseBranch: the base branch of the pull requests
+	ListOpenPullRequests(ctx context.Context, oauthCtx common.OauthContext, instanceURL, repositoryID, baseBranch string) ([]*PullRequest, error)
+	// CreatePullRequestComment creates a comment in the pull request.
+	//
+	// oauthCtx: OAuth context to create the comment
+	// instanceURL: VCS instance URL
+	// repositoryID: the repository ID from the external VCS system (note this is NOT the ID of Bytebase's own repository resource)
+	// pullRequestID: the pull request id
+	// comment: the comment content in markdown
+	CreatePullRequestComment(ctx context.Context, oauthCtx common.OauthContext, instanceURL, repositoryID, pullRequestID, comment string) error
+	// CreateCommitStatus creates the commit status, which is shown in the pull request of the commit.
+	//
+	// oauthCtx: OAuth context to create the commit status
+	// instanceURL: VCS instance URL
+	// repositoryID: the repository ID from the external VCS system (note this is NOT the ID of Bytebase's own repository resource)
+	// commitID: the commit id
+	// commitStatus: the commit status
+	CreateCommitStatus(ctx context.Context, oauthCtx common.OauthContext, instanceURL, repositoryID, commitID string, commitStatus *CommitStatus) error
 	// UpsertEnvironmentVariable creates or updates the environment variable in the repository.
 	//
 	// oauthCtx: OAuth context to create the webhook
@@ -0,0 +1,266 @@
+// Package accessgrant is the runner revoking the expired access grants from the project IAM policies.
+package accessgrant
+
+import (
+	"context"
+	"fmt"
+	"html"
+	"sync"
+	"time"
+
+	"github.com/google/cel-go/cel"
+	"github.com/google/cel-go/common/operators"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+	exprpb "google.golang.org/genproto/googleapis/api/expr/v1alpha1"
+
+	"github.com/bytebase/bytebase/backend/common"
+	"github.com/bytebase/bytebase/backend/common/log"
+	"github.com/bytebase/bytebase/backend/component/activity"
+	api "github.com/bytebase/bytebase/backend/legacyapi"
+	"github.com/bytebase/bytebase/backend/runner/mail"
+	"github.com/bytebase/bytebase/backend/store"
+)
+
+const runnerInterval = 1 * time.Minute
+
+// NewRunner creates a new access grant runner.
+func NewRunner(store *store.Store, activityManager *activity.Manager) *Runner {
+	return &Runner{
+		store:           store,
+		activityManager: activityManager,
+	}
+}
+
+// Runner is the runner which sweeps the expired bindings out of the project IAM policies.
+// The expired bindings never pass the condition evaluation, so the runner keeps the policies clean and notifies the users.
+type Runner struct {
+	store           *store.Store
+	activityManager *activity.Manager
+}
+
+// Member is a member of a project IAM policy binding.
+type Member struct {
+	Role api.Role
+	// Expression is the condition expression of the binding.
+	Expression string
+	User       *store.UserMessage
+}
+
+// Run runs the runner.
+func (r *Runner) Run(ctx context.Context, wg *sync.WaitGroup) {
+	ticker := time.NewTicker(runnerInterval)
+	defer ticker.Stop()
+	defer wg.Done()
+	log.Debug(fmt.Sprintf("Access grant runner started and will run every %v", runnerInterval))
+	for {
+		select {
+		case <-ticker.C:
+			r.revokeExpiredGrants(ctx, time.Now())
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+func (r *Runner) revokeExpiredGrants(ctx context.Context, now time.Time) {
+	projects, err := r.store.ListProjectV2(ctx, &store.FindProjectMessage{})
+	if err != nil {
+		log.Error("failed to list projects", zap.Error(err))
+		return
+	}
+	for _, project := range projects {
+		policy, err := r.store.GetProjectPolicy(ctx, &store.GetProjectPolicyMessage{UID: &project.UID})
+		if err != nil {
+			log.Error("failed to get project policy", zap.String("project", project.ResourceID), zap.Error(err))
+			continue
+		}
+		var expired []*Member
+		for _, binding := range policy.Bindings {
+			expression := binding.Condition.GetExpression()
+			expireTime, err := GetExpireTime(expression)
+			if err != nil {
+				log.Warn("failed to get the expire time of the binding", zap.String("project", project.ResourceID), zap.String("condition", expression), zap.Error(err))
+				continue
+			}
+			if expireTime == nil || expireTime.After(now) {
+				continue
+			}
+			for _, user := range binding.Members {
+				expired = append(expired, &Member{Role: binding.Role, Expression: expression, User: user})
+			}
+		}
+		if len(expired) == 0 {
+			continue
+		}
+		if _, err := Revoke(ctx, r.store, r.activityManager, project, expired, api.SystemBotID, "the grant has expired"); err != nil {
+			if common.ErrorCode(err) == common.Conflict {
+				log.Warn("skip revoking the expired grants of the last owner", zap.String("project", project.ResourceID), zap.Error(err))
+				continue
+			}
+			log.Error("failed to revoke expired grants", zap.String("project", project.ResourceID), zap.Error(err))
+		}
+	}
+}
+
+// Revoke removes the members from the bindings of the project IAM policy, and notifies the revoked users.
+// It returns the members which are revoked, the members not found in the policy are ignored.
+// The last owner of the project is never revoked, the error code is Conflict if only the last owner is to be revoked.
+func Revoke(ctx context.Context, s *store.Store, activityManager *activity.Manager, project *store.ProjectMessage, members []*Member, creatorUID int, reason string) ([]*Member, error) {
+	policy, err := s.GetProjectPolicy(ctx, &store.GetProjectPolicyMessage{UID: &project.UID})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get the policy of project %q", project.ResourceID)
+	}
+	remove := getRemovePolicy(policy, members)
+	if len(remove.Bindings) == 0 {
+		return nil, nil
+	}
+	removed, err := s.RemoveProjectIAMPolicyMembers(ctx, remove, project.UID)
+	if common.ErrorCode(err) == common.Conflict {
+		// Revoke the others without the owners.
+		withoutOwner := &store.IAMPolicyMessage{}
+		for _, binding := range remove.Bindings {
+			if binding.Role != api.Owner {
+				withoutOwner.Bindings = append(withoutOwner.Bindings, binding)
+			}
+		}
+		if len(withoutOwner.Bindings) == 0 {
+			return nil, err
+		}
+		log.Warn("skip revoking the last owner of the project", zap.String("project", project.ResourceID), zap.Error(err))
+		removed, err = s.RemoveProjectIAMPolicyMembers(ctx, withoutOwner, project.UID)
+	}
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to remove the members from the policy of project %q", project.ResourceID)
+	}
+
+	var revoked []*Member
+	for _, binding := range removed.Bindings {
+		for _, user := range binding.Members {
+			revoked = append(revoked, &Member{Role: binding.Role, Expression: binding.Condition.GetExpression(), User: user})
+		}
+	}
+	// It's ok to fail to notify the users, the grants have been revoked.
+	for _, member := range revoked {
+		if err := notify(ctx, s, activityManager, project, member, creatorUID, reason); err != nil {
+			log.Warn("failed to notify the revoked user", zap.String("project", project.ResourceID), zap.Int("user", member.User.ID), zap.Error(err))
+		}
+	}
+	return revoked, nil
+}
+
+// getRemovePolicy returns the bindings of the policy with only the members to remove.
+func getRemovePolicy(policy *store.IAMPolicyMessage, members []*Member) *store.IAMPolicyMessage {
+	isRemoved := func(binding *store.PolicyBinding, user *store.UserMessage) bool {
+		for _, member := range members {
+			if member.Role == binding.Role && member.Expression == binding.Condition.GetExpression() && member.User.ID == user.ID {
+				return true
+			}
+		}
+		return false
+	}
+
+	remove := &store.IAMPolicyMessage{}
+	for _, binding := range policy.Bindings {
+		var users []*store.UserMessage
+		for _, user := range binding.Members {
+			if isRemoved(binding, user) {
+				users = append(users, user)
+			}
+		}
+		if len(users) == 0 {
+			continue
+		}
+		newBinding := *binding
+		newBinding.Members = users
+		remove.Bindings = append(remove.Bindings, &newBinding)
+	}
+	return remove
+}
+
+// notify creates the project activity of the revocation, and notifies the user by the inbox and the mail.
+func notify(ctx context.Context, s *store.Store, activityManager *activity.Manager, project *store.ProjectMessage, member *Member, creatorUID int, reason string) error {
+	content := fmt.Sprintf("Revoked %s from %s (%s), because %s.", member.Role, member.User.Name, member.User.Email, reason)
+	revokeActivity, err := activityManager.CreateActivity(ctx, &store.ActivityMessage{
+		CreatorUID:   creatorUID,
+		ContainerUID: project.UID,
+		Type:         api.ActivityProjectMemberDelete,
+		Level:        api.ActivityInfo,
+		Comment:      content,
+	}, &activity.Metadata{})
+	if err != nil {
+		return errors.Wrap(err, "failed to create project activity")
+	}
+	if member.User.ID == api.SystemBotID {
+		return nil
+	}
+	if _, err := s.CreateInbox(ctx, &store.InboxMessage{
+		ReceiverUID: member.User.ID,
+		ActivityUID: revokeActivity.UID,
+	}); err != nil {
+		return errors.Wrap(err, "failed to post activity to user inbox")
+	}
+	subject := fmt.Sprintf("Access revoked - %s", project.Title)
+	body := fmt.Sprintf(`<p>%s</p>`, html.EscapeString(fmt.Sprintf("Your %s access to the project %q has been revoked, because %s.", member.Role, project.Title, reason)))
+	return mail.SendMail(ctx, s, []string{member.User.Email}, subject, body)
+}
+
+// GetExpireTime gets the expire time of the binding condition, which is the earliest
+// `request.time < timestamp("...")` in the conjunctions of the condition.
+// It returns nil if the condition never expires.
+func GetExpireTime(expression string) (*time.Time, error) {
+	if expression == "" {
+		return nil, nil
+	}
+	e, err := cel.NewEnv()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create cel environment")
+	}
+	ast, issues := e.Parse(expression)
+	if issues != nil && issues.Err() != nil {
+		return nil, errors.Wrap(issues.Err(), "failed to parse expression")
+	}
+
+	var expireTime *time.Time
+	var walk func(expr *exprpb.Expr) error
+	walk = func(expr *exprpb.Expr) error {
+		callExpr := expr.GetCallExpr()
+		if callExpr == nil {
+			return nil
+		}
+		switch callExpr.Function {
+		case operators.LogicalAnd:
+			for _, arg := range callExpr.Args {
+				if err := walk(arg); err != nil {
+					return err
+				}
+			}
+		case operators.Less, operators.LessEquals:
+			if len(callExpr.Args) != 2 || !isRequestTime(callExpr.Args[0]) {
+				return nil
+			}
+			timestampExpr := callExpr.Args[1].GetCallExpr()
+			if timestampExpr == nil || timestampExpr.Function != "timestamp" || len(timestampExpr.Args) != 1 {
+				return nil
+			}
+			value := timestampExpr.Args[0].GetConstExpr().GetStringValue()
+			t, err := time.Parse(time.RFC3339, value)
+			if err != nil {
+				return errors.Wrapf(err, "invalid timestamp %q", value)
+			}
+			if expireTime == nil || t.Before(*expireTime) {
+				expireTime = &t
+			}
+		}
+		return nil
+	}
+	if err := walk(ast.Expr()); err != nil {
+		return nil, err
+	}
+	return expireTime, nil
+}
+
+func isRequestTime(expr *exprpb.Expr) bool {
+	selectExpr := expr.GetSelectExpr()
+	return selectExpr != nil && selectExpr.Field == "time" && selectExpr.Operand.GetIdentExpr().GetName() == "request"
+}
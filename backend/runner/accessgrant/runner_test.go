@@ -0,0 +1,90 @@
+package accessgrant
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/type/expr"
+
+	api "github.com/bytebase/bytebase/backend/legacyapi"
+	"github.com/bytebase/bytebase/backend/store"
+)
+
+func TestGetExpireTime(t *testing.T) {
+	tests := []struct {
+		expression string
+		want       string
+		wantErr    bool
+	}{
+		{
+			expression: "",
+			want:       "",
+		},
+		{
+			expression: `request.time < timestamp("2023-07-04T08:09:27.000Z")`,
+			want:       "2023-07-04T08:09:27Z",
+		},
+		{
+			expression: `resource.database in ["instances/prod/databases/db"] && request.row_limit <= 1000 && request.time < timestamp("2023-07-04T08:09:27+08:00")`,
+			want:       "2023-07-04T00:09:27Z",
+		},
+		{
+			expression: `request.time < timestamp("2023-08-01T00:00:00Z") && request.time <= timestamp("2023-07-01T00:00:00Z")`,
+			want:       "2023-07-01T00:00:00Z",
+		},
+		{
+			// The disjunction never expires.
+			expression: `request.time < timestamp("2023-07-01T00:00:00Z") || resource.environment == "test"`,
+			want:       "",
+		},
+		{
+			expression: `resource.environment == "test"`,
+			want:       "",
+		},
+		{
+			expression: `request.time < timestamp("tomorrow")`,
+			wantErr:    true,
+		},
+	}
+
+	for _, test := range tests {
+		got, err := GetExpireTime(test.expression)
+		if test.wantErr {
+			require.Error(t, err, test.expression)
+			continue
+		}
+		require.NoError(t, err, test.expression)
+		if test.want == "" {
+			require.Nil(t, got, test.expression)
+			continue
+		}
+		require.NotNil(t, got, test.expression)
+		require.Equal(t, test.want, got.UTC().Format(time.RFC3339), test.expression)
+	}
+}
+
+func TestGetRemovePolicy(t *testing.T) {
+	alice := &store.UserMessage{ID: 101, Email: "alice@bytebase.com"}
+	bob := &store.UserMessage{ID: 102, Email: "bob@bytebase.com"}
+	expression := `request.time < timestamp("2023-07-01T00:00:00Z")`
+	policy := &store.IAMPolicyMessage{
+		Bindings: []*store.PolicyBinding{
+			{Role: api.Owner, Members: []*store.UserMessage{alice}, Condition: &expr.Expr{}},
+			{Role: api.Developer, Members: []*store.UserMessage{alice, bob}, Condition: &expr.Expr{Expression: expression}},
+		},
+	}
+
+	// Only the members of the matching binding are removed, and the policy is not changed.
+	remove := getRemovePolicy(policy, []*Member{
+		{Role: api.Developer, Expression: expression, User: bob},
+		{Role: api.Developer, Expression: "", User: alice},
+	})
+	require.Len(t, remove.Bindings, 1)
+	require.Equal(t, api.Developer, remove.Bindings[0].Role)
+	require.Equal(t, expression, remove.Bindings[0].Condition.GetExpression())
+	require.Equal(t, []*store.UserMessage{bob}, remove.Bindings[0].Members)
+	require.Len(t, policy.Bindings[1].Members, 2)
+
+	require.Empty(t, getRemovePolicy(policy, nil).Bindings)
+}
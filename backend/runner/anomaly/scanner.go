@@ -192,7 +192,7 @@ func (s *Scanner) checkInstanceAnomaly(ctx context.Context, instance *store.Inst
 }
 
 func (s *Scanner) checkDatabaseAnomaly(ctx context.Context, instance *store.InstanceMessage, database *store.DatabaseMessage) {
-	driver, err := s.dbFactory.GetAdminDatabaseDriver(ctx, instance, database)
+	driver, err := s.dbFactory.GetReplicaPreferredDatabaseDriver(ctx, instance, database)
 
 	// Check connection
 	if err != nil {
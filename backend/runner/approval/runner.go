@@ -5,6 +5,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"math"
 	"reflect"
 	"sort"
 	"strconv"
@@ -24,6 +25,8 @@ import (
 	"github.com/bytebase/bytebase/backend/component/state"
 	enterpriseAPI "github.com/bytebase/bytebase/backend/enterprise/api"
 	api "github.com/bytebase/bytebase/backend/legacyapi"
+	"github.com/bytebase/bytebase/backend/plugin/db"
+	parser "github.com/bytebase/bytebase/backend/plugin/parser/sql"
 	"github.com/bytebase/bytebase/backend/runner/relay"
 	"github.com/bytebase/bytebase/backend/utils"
 
@@ -182,7 +185,7 @@ func (r *Runner) findApprovalTemplateForIssue(ctx context.Context, issue *store.
 			},
 		}
 	} else {
-		riskLevel, done, err := getIssueRiskLevel(ctx, r.store, issue, risks)
+		riskLevel, statementFactors, taskCount, done, err := getIssueRiskLevel(ctx, r.store, issue, risks)
 		if err != nil {
 			err = errors.Wrap(err, "failed to get issue risk level")
 			if updateErr := updateIssuePayload(ctx, r.store, issue, &storepb.IssuePayload{
@@ -199,7 +202,7 @@ func (r *Runner) findApprovalTemplateForIssue(ctx context.Context, issue *store.
 			return false, nil
 		}
 
-		approvalTemplate, err = getApprovalTemplate(approvalSetting, riskLevel, issueTypeToRiskSource[issue.Type])
+		approvalTemplate, err = getApprovalTemplate(approvalSetting, getIssueApprovalFactors(issue, payload, riskLevel, taskCount), statementFactors)
 		if err != nil {
 			err = errors.Wrapf(err, "failed to get approval template, riskLevel: %v", riskLevel)
 			if updateErr := updateIssuePayload(ctx, r.store, issue, &storepb.IssuePayload{
@@ -296,7 +299,7 @@ func (r *Runner) findApprovalTemplateForIssue(ctx context.Context, issue *store.
 	return true, nil
 }
 
-func getApprovalTemplate(approvalSetting *storepb.WorkspaceApprovalSetting, riskLevel int64, riskSource store.RiskSource) (*storepb.ApprovalTemplate, error) {
+func getApprovalTemplate(approvalSetting *storepb.WorkspaceApprovalSetting, issueFactors map[string]any, statementFactors []map[string]any) (*storepb.ApprovalTemplate, error) {
 	e, err := cel.NewEnv(common.ApprovalFactors...)
 	if err != nil {
 		return nil, err
@@ -315,49 +318,96 @@ func getApprovalTemplate(approvalSetting *storepb.WorkspaceApprovalSetting, risk
 			return nil, errors.Wrap(err, "failed to compile expression")
 		}
 
-		res, _, err := prg.Eval(map[string]any{
-			"level":  riskLevel,
-			"source": int64(convertToSource(riskSource)),
-		})
-		if err != nil {
-			return nil, err
-		}
+		// The rule matches if it matches any statement of the issue.
+		for _, factors := range statementFactors {
+			args := map[string]any{}
+			for k, v := range issueFactors {
+				args[k] = v
+			}
+			for k, v := range factors {
+				args[k] = v
+			}
+			res, _, err := prg.Eval(args)
+			if err != nil {
+				return nil, err
+			}
 
-		val, err := res.ConvertToNative(reflect.TypeOf(false))
-		if err != nil {
-			return nil, errors.Wrap(err, "expect bool result")
-		}
-		if boolVal, ok := val.(bool); ok && boolVal {
-			return rule.Template, nil
+			val, err := res.ConvertToNative(reflect.TypeOf(false))
+			if err != nil {
+				return nil, errors.Wrap(err, "expect bool result")
+			}
+			if boolVal, ok := val.(bool); ok && boolVal {
+				return rule.Template, nil
+			}
 		}
 	}
 	return nil, nil
 }
 
-func getIssueRiskLevel(ctx context.Context, s *store.Store, issue *store.IssueMessage, risks []*store.RiskMessage) (int64, bool, error) {
-	tasks, err := s.ListTasks(ctx, &api.TaskFind{
-		PipelineID: issue.PipelineUID,
-		StatusList: &[]api.TaskStatus{api.TaskPendingApproval},
-	})
-	if err != nil {
-		return 0, false, err
+// getIssueApprovalFactors returns the issue-wide approval factors.
+func getIssueApprovalFactors(issue *store.IssueMessage, payload *storepb.IssuePayload, riskLevel int64, taskCount int) map[string]any {
+	labels := payload.Labels
+	if labels == nil {
+		labels = []string{}
+	}
+	return map[string]any{
+		"level":        riskLevel,
+		"source":       int64(convertToSource(issueTypeToRiskSource[issue.Type])),
+		"labels":       labels,
+		"creator_role": string(issue.Creator.Role),
+		"task_count":   int64(taskCount),
+	}
+}
+
+// getIssueRiskLevel returns the risk level of the issue and the factors of every statement in the issue's tasks.
+// The factors contain the project_id only if there is no task.
+func getIssueRiskLevel(ctx context.Context, s *store.Store, issue *store.IssueMessage, risks []*store.RiskMessage) (int64, []map[string]any, int, bool, error) {
+	var tasks []*store.TaskMessage
+	if issue.PipelineUID != nil {
+		var err error
+		tasks, err = s.ListTasks(ctx, &api.TaskFind{
+			PipelineID: issue.PipelineUID,
+			StatusList: &[]api.TaskStatus{api.TaskPendingApproval},
+		})
+		if err != nil {
+			return 0, nil, 0, false, err
+		}
 	}
 
 	var maxRiskLevel int64
+	var statementFactors []map[string]any
 	for _, task := range tasks {
-		riskLevel, done, err := getTaskRiskLevel(ctx, s, issue, task, risks)
+		taskFactors, done, err := getTaskFactors(ctx, s, issue, task)
 		if err != nil {
-			return 0, false, errors.Wrapf(err, "failed to get task risk level for task %v", task.ID)
+			return 0, nil, 0, false, errors.Wrapf(err, "failed to get task factors for task %v", task.ID)
 		}
 		if !done {
-			return 0, false, nil
+			return 0, nil, 0, false, nil
+		}
+		riskLevel, err := getTaskRiskLevel(issue, taskFactors, risks)
+		if err != nil {
+			return 0, nil, 0, false, errors.Wrapf(err, "failed to get task risk level for task %v", task.ID)
 		}
 		if riskLevel > maxRiskLevel {
 			maxRiskLevel = riskLevel
 		}
+		statementFactors = append(statementFactors, taskFactors...)
+	}
+	if len(statementFactors) == 0 {
+		statementFactors = append(statementFactors, map[string]any{
+			"environment_id": "",
+			"project_id":     issue.Project.ResourceID,
+			"database_name":  "",
+			"db_engine":      "",
+			"sql_type":       "UNKNOWN",
+			"affected_rows":  int64(0),
+			"lock_mode":      "NONE",
+			"blocking_rows":  int64(0),
+			"table_names":    []string{},
+		})
 	}
 
-	return maxRiskLevel, true, nil
+	return maxRiskLevel, statementFactors, len(tasks), true, nil
 }
 
 func getReportResult(ctx context.Context, s *store.Store, task *store.TaskMessage, taskCheckType api.TaskCheckType) ([]api.TaskCheckResult, bool, error) {
@@ -388,49 +438,98 @@ func getReportResult(ctx context.Context, s *store.Store, task *store.TaskMessag
 	return payload.ResultList, true, nil
 }
 
-func getTaskRiskLevel(ctx context.Context, s *store.Store, issue *store.IssueMessage, task *store.TaskMessage, risks []*store.RiskMessage) (int64, bool, error) {
-	// Fall through to "DEFAULT" risk level if risks are empty.
-	if len(risks) == 0 {
-		return 0, true, nil
+// getOptionalReportResult is like getReportResult, but it returns a nil result for a missing or unsuccessful report
+// instead of waiting for it, e.g. the tasks created before the report was introduced have no such task check run.
+// It only waits for the report while the latest run is still running.
+func getOptionalReportResult(ctx context.Context, s *store.Store, task *store.TaskMessage, taskCheckType api.TaskCheckType) ([]api.TaskCheckResult, bool, error) {
+	reports, err := s.ListTaskCheckRuns(ctx, &store.TaskCheckRunFind{
+		TaskID: &task.ID,
+		Type:   &taskCheckType,
+	})
+	if err != nil {
+		return nil, false, err
+	}
+	if len(reports) == 0 {
+		return nil, true, nil
+	}
+	lastReport := reports[0]
+	for i, report := range reports {
+		if report.ID > lastReport.ID {
+			lastReport = reports[i]
+		}
+	}
+	switch lastReport.Status {
+	case api.TaskCheckRunRunning:
+		return nil, false, nil
+	case api.TaskCheckRunDone:
+	default:
+		return nil, true, nil
+	}
+
+	payload := &api.TaskCheckRunResultPayload{}
+	if err := json.Unmarshal([]byte(lastReport.Result), payload); err != nil {
+		return nil, false, err
 	}
+	return payload.ResultList, true, nil
+}
 
+// getTaskFactors returns the risk and approval factors of every statement in the task.
+// It returns the factors without the statement ones if the statement reports are not supported.
+func getTaskFactors(ctx context.Context, s *store.Store, issue *store.IssueMessage, task *store.TaskMessage) ([]map[string]any, bool, error) {
 	instance, err := s.GetInstanceV2(ctx, &store.FindInstanceMessage{
 		UID: &task.InstanceID,
 	})
 	if err != nil {
-		return 0, false, err
+		return nil, false, err
 	}
 
 	var affectedRowsReportResult, statementTypeReportResult []api.TaskCheckResult
 	if api.IsTaskCheckReportSupported(instance.Engine) && api.IsTaskCheckReportNeededForTaskType(task.Type) {
 		affectedRowsReportResultInner, done, err := getReportResult(ctx, s, task, api.TaskCheckDatabaseStatementAffectedRowsReport)
 		if err != nil {
-			return 0, false, err
+			return nil, false, err
 		}
 		if !done {
-			return 0, false, nil
+			return nil, false, nil
 		}
 		affectedRowsReportResult = affectedRowsReportResultInner
 
 		statementTypeReportResultInner, done, err := getReportResult(ctx, s, task, api.TaskCheckDatabaseStatementTypeReport)
 		if err != nil {
-			return 0, false, err
+			return nil, false, err
 		}
 		if !done {
-			return 0, false, nil
+			return nil, false, nil
 		}
 		statementTypeReportResult = statementTypeReportResultInner
 	}
 
 	if len(affectedRowsReportResult) != len(statementTypeReportResult) {
-		return 0, false, errors.New("affected rows report result and statement type report result length mismatch")
+		return nil, false, errors.New("affected rows report result and statement type report result length mismatch")
+	}
+
+	var lockReportResult []api.TaskCheckResult
+	lockReportNeeded := false
+	if api.IsStatementLockReportSupported(instance.Engine) && api.IsTaskCheckReportNeededForTaskType(task.Type) {
+		lockReportResultInner, done, err := getOptionalReportResult(ctx, s, task, api.TaskCheckDatabaseStatementLockReport)
+		if err != nil {
+			return nil, false, err
+		}
+		if !done {
+			return nil, false, nil
+		}
+		// The lock impact is unknown if the lock report doesn't align with the other reports, e.g. there's a syntax error.
+		if len(lockReportResultInner) == len(affectedRowsReportResult) {
+			lockReportResult = lockReportResultInner
+		}
+		lockReportNeeded = true
 	}
 
 	var databaseName string
 	if task.Type == api.TaskDatabaseCreate {
 		payload := &api.TaskDatabaseCreatePayload{}
 		if err := json.Unmarshal([]byte(task.Payload), payload); err != nil {
-			return 0, false, err
+			return nil, false, err
 		}
 		databaseName = payload.DatabaseName
 	} else {
@@ -438,14 +537,138 @@ func getTaskRiskLevel(ctx context.Context, s *store.Store, issue *store.IssueMes
 			UID: task.DatabaseID,
 		})
 		if err != nil {
-			return 0, false, err
+			return nil, false, err
 		}
 		databaseName = database.DatabaseName
 	}
 
+	tableNames, err := getTaskTableNames(ctx, s, instance, databaseName, task)
+	if err != nil {
+		return nil, false, err
+	}
+
+	newFactors := func() map[string]any {
+		return map[string]any{
+			"environment_id": instance.EnvironmentID,
+			"project_id":     issue.Project.ResourceID,
+			"database_name":  databaseName,
+			// convert to string type otherwise cel-go will complain that db.Type is not string type.
+			"db_engine":     string(instance.Engine),
+			"sql_type":      "UNKNOWN",
+			"affected_rows": int64(0),
+			"lock_mode":     "NONE",
+			"blocking_rows": int64(0),
+			"table_names":   tableNames,
+		}
+	}
+	if len(affectedRowsReportResult) == 0 {
+		return []map[string]any{newFactors()}, true, nil
+	}
+
+	var statementFactors []map[string]any
+	for i := range affectedRowsReportResult {
+		factors := newFactors()
+		if affectedRowsReportResult[i].Code == common.Ok.Int() {
+			affectedRows, err := strconv.ParseInt(affectedRowsReportResult[i].Content, 10, 64)
+			if err != nil {
+				log.Warn("failed to convert affectedRows to int64, will use 0 as the value of affected_rows", zap.Error(err))
+			} else {
+				factors["affected_rows"] = affectedRows
+			}
+		}
+		if statementTypeReportResult[i].Code == common.Ok.Int() {
+			factors["sql_type"] = statementTypeReportResult[i].Content
+		}
+		if lockReportNeeded {
+			var lockResult *api.TaskCheckResult
+			if len(lockReportResult) > 0 {
+				lockResult = &lockReportResult[i]
+			}
+			setStatementLockFactors(factors, instance.Engine, lockResult)
+		}
+		statementFactors = append(statementFactors, factors)
+	}
+	return statementFactors, true, nil
+}
+
+// setStatementLockFactors sets the lock_mode and blocking_rows factors from the statement lock report result.
+// The result is nil if the lock report is missing or failed.
+// If the lock impact of the statement is unknown, it's assumed to take the most restrictive lock on a table of unknown size,
+// so that the approval rules on the lock level don't silently skip the statement.
+func setStatementLockFactors(factors map[string]any, engine db.Type, result *api.TaskCheckResult) {
+	if result != nil && result.Code == common.Ok.Int() && result.Details != "" {
+		report := &api.StatementLockReport{}
+		err := json.Unmarshal([]byte(result.Details), report)
+		if err == nil {
+			if report.LockMode != "" {
+				factors["lock_mode"] = report.LockMode
+			}
+			factors["blocking_rows"] = report.BlockingRows()
+			return
+		}
+		log.Warn("failed to unmarshal statement lock report, will use the most restrictive lock_mode", zap.Error(err))
+	}
+	factors["lock_mode"] = getMostRestrictiveLockMode(engine)
+	factors["blocking_rows"] = int64(math.MaxInt64)
+}
+
+// getMostRestrictiveLockMode returns the most restrictive lock mode reported by the statement lock report of the engine.
+func getMostRestrictiveLockMode(engine db.Type) string {
+	switch engine {
+	case db.Postgres:
+		return "ACCESS EXCLUSIVE"
+	default:
+		return "EXCLUSIVE"
+	}
+}
+
+// getTaskTableNames returns the names of the tables touched by the task statement.
+// The statement is not parsed if it's too large, and the parse errors are ignored.
+func getTaskTableNames(ctx context.Context, s *store.Store, instance *store.InstanceMessage, databaseName string, task *store.TaskMessage) ([]string, error) {
+	tableNames := []string{}
+	if !api.IsTaskCheckReportNeededForTaskType(task.Type) {
+		return tableNames, nil
+	}
+	sheetID, err := utils.GetTaskSheetID(task.Payload)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get task sheet id")
+	}
+	sheet, err := s.GetSheetV2(ctx, &store.FindSheetMessage{UID: &sheetID}, api.SystemBotID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get sheet %d", sheetID)
+	}
+	if sheet == nil || sheet.Size > common.MaxSheetSizeForTaskCheck {
+		return tableNames, nil
+	}
+	statement, err := s.GetSheetStatementByID(ctx, sheetID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get sheet statement %d", sheetID)
+	}
+	resources, err := parser.ExtractResourceList(parser.EngineType(instance.Engine), databaseName, "", statement)
+	if err != nil {
+		log.Debug("failed to extract the tables of the task statement", zap.Int("taskID", task.ID), zap.Error(err))
+		return tableNames, nil
+	}
+	seen := map[string]bool{}
+	for _, resource := range resources {
+		if resource.Table == "" || seen[resource.Table] {
+			continue
+		}
+		seen[resource.Table] = true
+		tableNames = append(tableNames, resource.Table)
+	}
+	return tableNames, nil
+}
+
+func getTaskRiskLevel(issue *store.IssueMessage, statementFactors []map[string]any, risks []*store.RiskMessage) (int64, error) {
+	// Fall through to "DEFAULT" risk level if risks are empty.
+	if len(risks) == 0 {
+		return 0, nil
+	}
+
 	e, err := cel.NewEnv(common.RiskFactors...)
 	if err != nil {
-		return 0, false, err
+		return 0, err
 	}
 
 	// higher risks go first
@@ -463,74 +686,38 @@ func getTaskRiskLevel(ctx context.Context, s *store.Store, issue *store.IssueMes
 		if risk.Expression == nil || risk.Expression.Expression == "" {
 			continue
 		}
+		if risk.Level <= maxRisk {
+			continue
+		}
 
 		ast, issues := e.Parse(risk.Expression.Expression)
 		if issues != nil && issues.Err() != nil {
-			return 0, false, errors.Errorf("failed to parse expression: %v", issues.Err())
+			return 0, errors.Errorf("failed to parse expression: %v", issues.Err())
 		}
 		prg, err := e.Program(ast)
 		if err != nil {
-			return 0, false, err
-		}
-		args := map[string]any{
-			"environment_id": instance.EnvironmentID,
-			"project_id":     issue.Project.ResourceID,
-			"database_name":  databaseName,
-			// convert to string type otherwise cel-go will complain that db.Type is not string type.
-			"db_engine":     string(instance.Engine),
-			"sql_type":      "UNKNOWN",
-			"affected_rows": 0,
+			return 0, err
 		}
 
 		// eval for each statement
-		if len(affectedRowsReportResult) > 0 {
-			for i := range affectedRowsReportResult {
-				args := args
-				if affectedRowsReportResult[i].Code == common.Ok.Int() {
-					affectedRows, err := strconv.ParseInt(affectedRowsReportResult[i].Content, 10, 64)
-					if err != nil {
-						log.Warn("failed to convert affectedRows to int64, will use 0 as the value of affected_rows", zap.Error(err))
-					} else {
-						args["affected_rows"] = affectedRows
-					}
-				}
-				if statementTypeReportResult[i].Code == common.Ok.Int() {
-					args["sql_type"] = statementTypeReportResult[i].Content
-				}
-
-				res, _, err := prg.Eval(args)
-				if err != nil {
-					return 0, false, err
-				}
-
-				val, err := res.ConvertToNative(reflect.TypeOf(false))
-				if err != nil {
-					return 0, false, errors.Wrap(err, "expect bool result")
-				}
-				if boolVal, ok := val.(bool); ok && boolVal {
-					if risk.Level > maxRisk {
-						maxRisk = risk.Level
-					}
-				}
-			}
-		} else {
-			res, _, err := prg.Eval(args)
+		for _, factors := range statementFactors {
+			res, _, err := prg.Eval(factors)
 			if err != nil {
-				return 0, false, err
+				return 0, err
 			}
 			val, err := res.ConvertToNative(reflect.TypeOf(false))
 			if err != nil {
-				return 0, false, errors.Wrap(err, "expect bool result")
+				return 0, errors.Wrap(err, "expect bool result")
 			}
 			if boolVal, ok := val.(bool); ok && boolVal {
-				return risk.Level, true, nil
+				maxRisk = risk.Level
+				break
 			}
 		}
 	}
 
-	return maxRisk, true, nil
+	return maxRisk, nil
 }
-
 func updateIssuePayload(ctx context.Context, s *store.Store, issue *store.IssueMessage, payload *storepb.IssuePayload) error {
 	originalPayload := &storepb.IssuePayload{}
 	if err := protojson.Unmarshal([]byte(issue.Payload), originalPayload); err != nil {
@@ -540,6 +727,7 @@ func updateIssuePayload(ctx context.Context, s *store.Store, issue *store.IssueM
 	if originalPayload.Grouping != nil {
 		payload.Grouping = originalPayload.Grouping
 	}
+	payload.Labels = originalPayload.Labels
 	payloadBytes, err := protojson.Marshal(payload)
 	if err != nil {
 		return errors.Wrapf(err, "failed to marshal payload")
@@ -0,0 +1,177 @@
+package approval
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/type/expr"
+
+	"github.com/bytebase/bytebase/backend/common"
+	api "github.com/bytebase/bytebase/backend/legacyapi"
+	"github.com/bytebase/bytebase/backend/plugin/db"
+	"github.com/bytebase/bytebase/backend/store"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+func TestGetApprovalTemplate(t *testing.T) {
+	newRule := func(expression, title string) *storepb.WorkspaceApprovalSetting_Rule {
+		return &storepb.WorkspaceApprovalSetting_Rule{
+			Condition: &expr.Expr{Expression: expression},
+			Template:  &storepb.ApprovalTemplate{Title: title},
+		}
+	}
+	approvalSetting := &storepb.WorkspaceApprovalSetting{
+		Rules: []*storepb.WorkspaceApprovalSetting_Rule{
+			newRule(`environment_id == "prod" && sql_type == "DROP_TABLE" && table_names.exists(t, t in ["user", "payment"])`, "security"),
+			newRule(`"hotfix" in labels && creator_role == "DBA"`, "hotfix"),
+			newRule(`task_count > 10 || affected_rows > 1000`, "large"),
+			newRule(`level == 300`, "high"),
+		},
+	}
+	issueFactors := func(level int64, labels []string, creatorRole string, taskCount int64) map[string]any {
+		return map[string]any{
+			"level":        level,
+			"source":       int64(0),
+			"labels":       labels,
+			"creator_role": creatorRole,
+			"task_count":   taskCount,
+		}
+	}
+	statementFactors := func(environmentID, sqlType string, affectedRows int64, tableNames []string) map[string]any {
+		return map[string]any{
+			"environment_id": environmentID,
+			"project_id":     "shop",
+			"db_engine":      "MYSQL",
+			"sql_type":       sqlType,
+			"affected_rows":  affectedRows,
+			"table_names":    tableNames,
+		}
+	}
+
+	tests := []struct {
+		issueFactors     map[string]any
+		statementFactors []map[string]any
+		want             string
+	}{
+		{
+			issueFactors: issueFactors(300, []string{}, "DEVELOPER", 1),
+			statementFactors: []map[string]any{
+				statementFactors("prod", "INSERT", 1, []string{"user"}),
+				statementFactors("prod", "DROP_TABLE", 0, []string{"user"}),
+			},
+			want: "security",
+		},
+		{
+			// The factors of different statements are not mixed.
+			issueFactors: issueFactors(300, []string{}, "DEVELOPER", 1),
+			statementFactors: []map[string]any{
+				statementFactors("test", "DROP_TABLE", 0, []string{"user"}),
+				statementFactors("prod", "INSERT", 1, []string{"user"}),
+			},
+			want: "high",
+		},
+		{
+			issueFactors:     issueFactors(0, []string{"hotfix"}, "DBA", 1),
+			statementFactors: []map[string]any{statementFactors("prod", "UPDATE", 1, []string{})},
+			want:             "hotfix",
+		},
+		{
+			issueFactors:     issueFactors(0, []string{"hotfix"}, "DEVELOPER", 11),
+			statementFactors: []map[string]any{statementFactors("prod", "UPDATE", 1, []string{})},
+			want:             "large",
+		},
+		{
+			issueFactors:     issueFactors(0, []string{}, "DEVELOPER", 1),
+			statementFactors: []map[string]any{statementFactors("prod", "UPDATE", 1, []string{})},
+			want:             "",
+		},
+	}
+
+	a := require.New(t)
+	for _, test := range tests {
+		template, err := getApprovalTemplate(approvalSetting, test.issueFactors, test.statementFactors)
+		a.NoError(err)
+		if test.want == "" {
+			a.Nil(template)
+			continue
+		}
+		a.NotNil(template)
+		a.Equal(test.want, template.Title)
+	}
+}
+
+func TestSetStatementLockFactors(t *testing.T) {
+	issue := &store.IssueMessage{Type: api.IssueDatabaseSchemaUpdate}
+	risks := []*store.RiskMessage{
+		{
+			Source:     store.RiskSourceDatabaseSchemaUpdate,
+			Level:      300,
+			Active:     true,
+			Expression: &expr.Expr{Expression: `lock_mode == "ACCESS EXCLUSIVE" || lock_mode == "EXCLUSIVE"`},
+		},
+	}
+	okResult := func(report string) *api.TaskCheckResult {
+		return &api.TaskCheckResult{Status: api.TaskCheckStatusSuccess, Code: common.Ok.Int(), Details: report}
+	}
+
+	tests := []struct {
+		engine           db.Type
+		result           *api.TaskCheckResult
+		wantLockMode     string
+		wantBlockingRows int64
+		wantRiskLevel    int64
+	}{
+		{
+			engine:           db.Postgres,
+			result:           okResult(`{"lockMode":"SHARE UPDATE EXCLUSIVE","blockWrites":false,"table":"t"}`),
+			wantLockMode:     "SHARE UPDATE EXCLUSIVE",
+			wantBlockingRows: 0,
+		},
+		{
+			// The lock report failed or is missing.
+			engine:           db.Postgres,
+			result:           nil,
+			wantLockMode:     "ACCESS EXCLUSIVE",
+			wantBlockingRows: math.MaxInt64,
+			wantRiskLevel:    300,
+		},
+		{
+			// The statement failed to be analyzed.
+			engine:           db.MySQL,
+			result:           &api.TaskCheckResult{Status: api.TaskCheckStatusError, Code: common.Internal.Int()},
+			wantLockMode:     "EXCLUSIVE",
+			wantBlockingRows: math.MaxInt64,
+			wantRiskLevel:    300,
+		},
+		{
+			// The statement is not supported by the analyzer.
+			engine:           db.MySQL,
+			result:           &api.TaskCheckResult{Status: api.TaskCheckStatusSuccess, Code: common.Ok.Int(), Content: "UNKNOWN"},
+			wantLockMode:     "EXCLUSIVE",
+			wantBlockingRows: math.MaxInt64,
+			wantRiskLevel:    300,
+		},
+	}
+
+	a := require.New(t)
+	for _, test := range tests {
+		factors := map[string]any{
+			"environment_id": "prod",
+			"project_id":     "shop",
+			"db_engine":      string(test.engine),
+			"sql_type":       "ALTER_TABLE",
+			"affected_rows":  int64(0),
+			"lock_mode":      "NONE",
+			"blocking_rows":  int64(0),
+			"table_names":    []string{"t"},
+		}
+		setStatementLockFactors(factors, test.engine, test.result)
+		a.Equal(test.wantLockMode, factors["lock_mode"])
+		a.Equal(test.wantBlockingRows, factors["blocking_rows"])
+
+		riskLevel, err := getTaskRiskLevel(issue, []map[string]any{factors}, risks)
+		a.NoError(err)
+		a.Equal(test.wantRiskLevel, riskLevel)
+	}
+}
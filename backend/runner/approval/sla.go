@@ -0,0 +1,323 @@
+package approval
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"html"
+	"sync"
+	"time"
+
+	"github.com/gosimple/slug"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/bytebase/bytebase/backend/common/log"
+	"github.com/bytebase/bytebase/backend/component/activity"
+	enterpriseAPI "github.com/bytebase/bytebase/backend/enterprise/api"
+	api "github.com/bytebase/bytebase/backend/legacyapi"
+	"github.com/bytebase/bytebase/backend/runner/mail"
+	"github.com/bytebase/bytebase/backend/runner/taskrun"
+	"github.com/bytebase/bytebase/backend/store"
+	"github.com/bytebase/bytebase/backend/utils"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+// SLARunner is the runner enforcing the SLAs of the approval templates on the pending approval steps.
+type SLARunner struct {
+	store           *store.Store
+	activityManager *activity.Manager
+	taskScheduler   *taskrun.Scheduler
+	licenseService  enterpriseAPI.LicenseService
+}
+
+// NewSLARunner creates a new approval SLA runner.
+func NewSLARunner(store *store.Store, activityManager *activity.Manager, taskScheduler *taskrun.Scheduler, licenseService enterpriseAPI.LicenseService) *SLARunner {
+	return &SLARunner{
+		store:           store,
+		activityManager: activityManager,
+		taskScheduler:   taskScheduler,
+		licenseService:  licenseService,
+	}
+}
+
+const approvalSLARunnerInterval = 1 * time.Minute
+
+// Run runs the runner.
+func (r *SLARunner) Run(ctx context.Context, wg *sync.WaitGroup) {
+	ticker := time.NewTicker(approvalSLARunnerInterval)
+	defer ticker.Stop()
+	defer wg.Done()
+	log.Debug(fmt.Sprintf("Approval SLA runner started and will run every %v", approvalSLARunnerInterval))
+	for {
+		select {
+		case <-ticker.C:
+			r.checkApprovalSLAs(ctx)
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+func (r *SLARunner) checkApprovalSLAs(ctx context.Context) {
+	if !r.licenseService.IsFeatureEnabled(api.FeatureCustomApproval) {
+		return
+	}
+	issues, err := r.store.ListIssueV2(ctx, &store.FindIssueMessage{
+		StatusList: []api.IssueStatus{api.IssueOpen},
+	})
+	if err != nil {
+		log.Error("failed to list issues for checking approval SLAs", zap.Error(err))
+		return
+	}
+	for _, issue := range issues {
+		if err := r.checkIssueApprovalSLA(ctx, issue, time.Now()); err != nil {
+			log.Error("failed to check approval SLA", zap.Int("issueID", issue.UID), zap.Error(err))
+		}
+	}
+}
+
+func (r *SLARunner) checkIssueApprovalSLA(ctx context.Context, issue *store.IssueMessage, now time.Time) error {
+	payload := &storepb.IssuePayload{}
+	if err := protojson.Unmarshal([]byte(issue.Payload), payload); err != nil {
+		return errors.Wrap(err, "failed to unmarshal issue payload")
+	}
+	approval := payload.Approval
+	if approval == nil || !approval.ApprovalFindingDone || approval.ApprovalFindingError != "" || len(approval.ApprovalTemplates) != 1 {
+		return nil
+	}
+	template := approval.ApprovalTemplates[0]
+	sla := template.Sla
+	if sla == nil {
+		return nil
+	}
+	if utils.FindRejectedStep(template, approval.Approvers) != nil {
+		return nil
+	}
+	stepIndex := utils.FindNextPendingStepIndex(template, approval.Approvers)
+	if stepIndex < 0 {
+		return nil
+	}
+	step := template.Flow.Steps[stepIndex]
+
+	// The SLA of the step counts from the time when the step is found pending.
+	state := approval.SlaState
+	if state == nil || int(state.StepIndex) != stepIndex || state.PendingTime == nil {
+		return r.updateSLAState(ctx, issue.UID, stepIndex, func(state *storepb.IssuePayloadApproval_SLAState) {
+			state.PendingTime = timestamppb.New(now)
+		})
+	}
+	elapsed := now.Sub(state.PendingTime.AsTime())
+
+	if timeout := sla.ExpirationTimeout.AsDuration(); timeout > 0 && elapsed >= timeout {
+		return r.expire(ctx, issue, stepIndex, sla.ExpirationAction, timeout)
+	}
+
+	if timeout := sla.EscalationTimeout.AsDuration(); timeout > 0 && elapsed >= timeout && !state.Escalated && sla.EscalationNode != nil {
+		if err := r.updateSLAState(ctx, issue.UID, stepIndex, func(state *storepb.IssuePayloadApproval_SLAState) {
+			state.Escalated = true
+		}); err != nil {
+			return err
+		}
+		escalationStep := &storepb.ApprovalStep{
+			Type:  storepb.ApprovalStep_ANY,
+			Nodes: []*storepb.ApprovalNode{sla.EscalationNode},
+		}
+		subject := fmt.Sprintf("Issue approval escalated - %s", issue.Title)
+		content := fmt.Sprintf("The approval of the issue %q has been pending for %v, you can approve or reject it on behalf of the approvers.", issue.Title, timeout)
+		return r.notify(ctx, issue, escalationStep, storepb.ActivityIssueApprovalNotifyPayload_ESCALATION, subject, content)
+	}
+
+	if interval := sla.ReminderInterval.AsDuration(); interval > 0 {
+		last := state.PendingTime.AsTime()
+		if state.LastReminderTime != nil {
+			last = state.LastReminderTime.AsTime()
+		}
+		if now.Sub(last) < interval {
+			return nil
+		}
+		if err := r.updateSLAState(ctx, issue.UID, stepIndex, func(state *storepb.IssuePayloadApproval_SLAState) {
+			state.LastReminderTime = timestamppb.New(now)
+		}); err != nil {
+			return err
+		}
+		reminderStep := step
+		if state.Escalated && sla.EscalationNode != nil {
+			reminderStep = &storepb.ApprovalStep{
+				Type:  step.Type,
+				Nodes: append(append([]*storepb.ApprovalNode{}, step.Nodes...), sla.EscalationNode),
+			}
+		}
+		subject := fmt.Sprintf("Issue approval overdue - %s", issue.Title)
+		content := fmt.Sprintf("The approval of the issue %q has been pending for %v.", issue.Title, elapsed.Truncate(time.Minute))
+		return r.notify(ctx, issue, reminderStep, storepb.ActivityIssueApprovalNotifyPayload_REMINDER, subject, content)
+	}
+	return nil
+}
+
+// updateSLAState updates the SLA state of the pending step on the latest issue payload,
+// so that the approvals made since the issue is listed are kept.
+func (r *SLARunner) updateSLAState(ctx context.Context, issueUID int, stepIndex int, update func(*storepb.IssuePayloadApproval_SLAState)) error {
+	issue, payload, err := r.getPendingIssue(ctx, issueUID, stepIndex)
+	if err != nil || issue == nil {
+		return err
+	}
+	state := payload.Approval.SlaState
+	if state == nil || int(state.StepIndex) != stepIndex {
+		state = &storepb.IssuePayloadApproval_SLAState{StepIndex: int32(stepIndex)}
+		payload.Approval.SlaState = state
+	}
+	update(state)
+	return r.updateIssuePayload(ctx, issue, payload)
+}
+
+// expire rejects the pending step or cancels the issue.
+func (r *SLARunner) expire(ctx context.Context, issue *store.IssueMessage, stepIndex int, action storepb.ApprovalSLA_ExpirationAction, timeout time.Duration) error {
+	comment := fmt.Sprintf("The approval expired after pending for %v.", timeout)
+	switch action {
+	case storepb.ApprovalSLA_REJECT:
+		issue, payload, err := r.getPendingIssue(ctx, issue.UID, stepIndex)
+		if err != nil || issue == nil {
+			return err
+		}
+		payload.Approval.Approvers = append(payload.Approval.Approvers, &storepb.IssuePayloadApproval_Approver{
+			Status:      storepb.IssuePayloadApproval_Approver_REJECTED,
+			PrincipalId: api.SystemBotID,
+		})
+		if err := r.updateIssuePayload(ctx, issue, payload); err != nil {
+			return err
+		}
+		activityPayload, err := protojson.Marshal(&storepb.ActivityIssueCommentCreatePayload{
+			Event: &storepb.ActivityIssueCommentCreatePayload_ApprovalEvent_{
+				ApprovalEvent: &storepb.ActivityIssueCommentCreatePayload_ApprovalEvent{
+					Status: storepb.ActivityIssueCommentCreatePayload_ApprovalEvent_REJECTED,
+				},
+			},
+			IssueName: issue.Title,
+		})
+		if err != nil {
+			return errors.Wrap(err, "failed to marshal activity payload")
+		}
+		if _, err := r.activityManager.CreateActivity(ctx, &store.ActivityMessage{
+			CreatorUID:   api.SystemBotID,
+			ContainerUID: issue.UID,
+			Type:         api.ActivityIssueCommentCreate,
+			Level:        api.ActivityInfo,
+			Comment:      comment,
+			Payload:      string(activityPayload),
+		}, &activity.Metadata{}); err != nil {
+			return errors.Wrap(err, "failed to create activity after rejecting the expired approval")
+		}
+		return nil
+	case storepb.ApprovalSLA_CANCEL:
+		if err := r.taskScheduler.ChangeIssueStatus(ctx, issue, api.IssueCanceled, api.SystemBotID, comment); err != nil {
+			return errors.Wrap(err, "failed to cancel the issue of the expired approval")
+		}
+		return nil
+	default:
+		return nil
+	}
+}
+
+// getPendingIssue gets the latest open issue and its payload if the step is still pending, otherwise it returns nil.
+func (r *SLARunner) getPendingIssue(ctx context.Context, issueUID int, stepIndex int) (*store.IssueMessage, *storepb.IssuePayload, error) {
+	issue, err := r.store.GetIssueV2(ctx, &store.FindIssueMessage{UID: &issueUID})
+	if err != nil {
+		return nil, nil, errors.Wrapf(err, "failed to get issue %d", issueUID)
+	}
+	if issue == nil || issue.Status != api.IssueOpen {
+		return nil, nil, nil
+	}
+	payload := &storepb.IssuePayload{}
+	if err := protojson.Unmarshal([]byte(issue.Payload), payload); err != nil {
+		return nil, nil, errors.Wrap(err, "failed to unmarshal issue payload")
+	}
+	approval := payload.Approval
+	if approval == nil || len(approval.ApprovalTemplates) != 1 {
+		return nil, nil, nil
+	}
+	template := approval.ApprovalTemplates[0]
+	if utils.FindRejectedStep(template, approval.Approvers) != nil || utils.FindNextPendingStepIndex(template, approval.Approvers) != stepIndex {
+		return nil, nil, nil
+	}
+	return issue, payload, nil
+}
+
+func (r *SLARunner) updateIssuePayload(ctx context.Context, issue *store.IssueMessage, payload *storepb.IssuePayload) error {
+	payloadBytes, err := protojson.Marshal(payload)
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal issue payload")
+	}
+	payloadStr := string(payloadBytes)
+	if _, err := r.store.UpdateIssueV2(ctx, issue.UID, &store.UpdateIssueMessage{
+		Payload: &payloadStr,
+	}, api.SystemBotID); err != nil {
+		return errors.Wrap(err, "failed to update issue payload")
+	}
+	return nil
+}
+
+// notify notifies the users of the step nodes by the webhooks, the inbox and the mail.
+func (r *SLARunner) notify(ctx context.Context, issue *store.IssueMessage, step *storepb.ApprovalStep, notifyType storepb.ActivityIssueApprovalNotifyPayload_Type, subject string, content string) error {
+	protoPayload, err := protojson.Marshal(&storepb.ActivityIssueApprovalNotifyPayload{
+		ApprovalStep: step,
+		Type:         notifyType,
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal activity payload")
+	}
+	activityPayload, err := json.Marshal(api.ActivityIssueApprovalNotifyPayload{
+		ProtoPayload: string(protoPayload),
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal activity payload")
+	}
+	// The project webhooks are posted by the activity manager.
+	notifyActivity, err := r.activityManager.CreateActivity(ctx, &store.ActivityMessage{
+		CreatorUID:   api.SystemBotID,
+		ContainerUID: issue.UID,
+		Type:         api.ActivityIssueApprovalNotify,
+		Level:        api.ActivityWarn,
+		Comment:      content,
+		Payload:      string(activityPayload),
+	}, &activity.Metadata{Issue: issue})
+	if err != nil {
+		return errors.Wrap(err, "failed to create approval notify activity")
+	}
+
+	var receivers []string
+	notified := map[int]bool{}
+	for _, node := range step.Nodes {
+		users, err := utils.ListApprovalNodeUsers(ctx, r.store, node, issue.Project.ResourceID)
+		if err != nil {
+			return errors.Wrap(err, "failed to list the users of the approval node")
+		}
+		for _, user := range users {
+			if notified[user.ID] || user.ID == api.SystemBotID {
+				continue
+			}
+			notified[user.ID] = true
+			if _, err := r.store.CreateInbox(ctx, &store.InboxMessage{
+				ReceiverUID: user.ID,
+				ActivityUID: notifyActivity.UID,
+			}); err != nil {
+				return errors.Wrapf(err, "failed to post activity to approver inbox: %d", user.ID)
+			}
+			receivers = append(receivers, user.Email)
+		}
+	}
+
+	setting, err := r.store.GetWorkspaceGeneralSetting(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to get workspace setting")
+	}
+	link := fmt.Sprintf("%s/issue/%s-%d", setting.ExternalUrl, slug.Make(issue.Title), issue.UID)
+	body := fmt.Sprintf(`<p>%s</p><p><a href="%s">View the issue</a></p>`, html.EscapeString(content), html.EscapeString(link))
+	// It's ok to fail to send the mail, the approvers are notified in the inbox.
+	if err := mail.SendMail(ctx, r.store, receivers, subject, body); err != nil {
+		log.Warn("failed to send the approval SLA mail", zap.Int("issueID", issue.UID), zap.Error(err))
+	}
+	return nil
+}
@@ -73,7 +73,7 @@ func (r *Runner) Run(ctx context.Context, wg *sync.WaitGroup) {
 					log.Error("failed to unmarshal IM setting value", zap.String("settingName", string(settingName)), zap.Any("settingValue", setting.Value), zap.Error(err))
 					return
 				}
-				if !value.ExternalApproval.Enabled {
+				if value.IMType != api.IMTypeFeishu || !value.ExternalApproval.Enabled {
 					return
 				}
 
@@ -271,7 +271,7 @@ func (r *Runner) Run(ctx context.Context, wg *sync.WaitGroup) {
 }
 
 func (r *Runner) cancelOldExternalApprovalIfNeeded(ctx context.Context, issue *store.IssueMessage, stage *store.StageMessage, settingValue *api.SettingAppIMValue) (*store.ExternalApprovalMessage, error) {
-	approval, err := r.store.GetExternalApprovalByIssueIDV2(ctx, issue.UID)
+	approval, err := r.store.GetExternalApprovalByIssueIDV2(ctx, issue.UID, api.ExternalApprovalTypeFeishu)
 	if err != nil {
 		return nil, err
 	}
@@ -374,10 +374,10 @@ func (r *Runner) CancelExternalApproval(ctx context.Context, issueID int, reason
 	if err := json.Unmarshal([]byte(setting.Value), &value); err != nil {
 		return errors.Wrapf(err, "failed to unmarshal IM setting, settingName %s", string(settingName))
 	}
-	if !value.ExternalApproval.Enabled {
+	if value.IMType != api.IMTypeFeishu || !value.ExternalApproval.Enabled {
 		return nil
 	}
-	approval, err := r.store.GetExternalApprovalByIssueIDV2(ctx, issueID)
+	approval, err := r.store.GetExternalApprovalByIssueIDV2(ctx, issueID, api.ExternalApprovalTypeFeishu)
 	if err != nil {
 		return err
 	}
@@ -577,7 +577,7 @@ func (r *Runner) createExternalApproval(ctx context.Context, issue *store.IssueM
 
 // scheduleApproval tries to cancel old external apporvals and create new external approvals if needed.
 func (r *Runner) scheduleApproval(ctx context.Context, issue *store.IssueMessage, stages []*store.StageMessage, settingValue *api.SettingAppIMValue) {
-	if !settingValue.ExternalApproval.Enabled {
+	if settingValue.IMType != api.IMTypeFeishu || !settingValue.ExternalApproval.Enabled {
 		return
 	}
 
@@ -639,7 +639,7 @@ func (r *Runner) tryUpdateApprovalDefinition(ctx context.Context) error {
 	if err := json.Unmarshal([]byte(setting.Value), &value); err != nil {
 		return errors.Wrapf(err, "failed to unmarshal setting value %+v", setting.Value)
 	}
-	if !value.ExternalApproval.Enabled {
+	if value.IMType != api.IMTypeFeishu || !value.ExternalApproval.Enabled {
 		return nil
 	}
 	// pass in ApprovalDefinitionID so that this would be a PATCH.
@@ -0,0 +1,186 @@
+// Package auditlogexport is the runner exporting the hash chained audit logs to the SIEM systems.
+package auditlogexport
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/bytebase/bytebase/backend/common/log"
+	enterpriseAPI "github.com/bytebase/bytebase/backend/enterprise/api"
+	api "github.com/bytebase/bytebase/backend/legacyapi"
+	"github.com/bytebase/bytebase/backend/plugin/auditlog"
+	"github.com/bytebase/bytebase/backend/store"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+const (
+	exportInterval = 10 * time.Second
+	// exportBatchSize is the max number of the audit logs exported in one call.
+	exportBatchSize = 500
+)
+
+// NewRunner creates a new audit log export runner.
+// The file destinations can only write to the fileDir, they are disabled if it's empty.
+func NewRunner(store *store.Store, licenseService enterpriseAPI.LicenseService, fileDir string) *Runner {
+	return &Runner{
+		store:          store,
+		licenseService: licenseService,
+		fileDir:        fileDir,
+		exporters:      map[string]*destinationExporter{},
+	}
+}
+
+// Runner is the runner exporting the audit logs to the destinations of the audit log export setting.
+//
+// The delivery is at-least-once. The ID of the last exported audit log is persisted as the cursor of
+// each destination after the export succeeds, so the audit logs might be exported again if the cursor
+// fails to be persisted. The verifier accepts the identical duplicates.
+type Runner struct {
+	store          *store.Store
+	licenseService enterpriseAPI.LicenseService
+	fileDir        string
+	// exporters is the map from the destination name to the exporter.
+	exporters map[string]*destinationExporter
+}
+
+type destinationExporter struct {
+	destination *storepb.AuditLogExportSetting_Destination
+	exporter    auditlog.Exporter
+}
+
+// Run runs the runner.
+func (r *Runner) Run(ctx context.Context, wg *sync.WaitGroup) {
+	ticker := time.NewTicker(exportInterval)
+	defer ticker.Stop()
+	defer wg.Done()
+	defer r.closeExporters(nil)
+	log.Debug(fmt.Sprintf("Audit log export runner started and will run every %v", exportInterval))
+	for {
+		select {
+		case <-ticker.C:
+			if err := r.exportAll(ctx); err != nil {
+				log.Error("failed to export audit logs", zap.Error(err))
+			}
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+func (r *Runner) exportAll(ctx context.Context) error {
+	if !r.licenseService.IsFeatureEnabled(api.FeatureAuditLog) {
+		r.closeExporters(nil)
+		return nil
+	}
+	setting, err := r.store.GetWorkspaceAuditLogExportSetting(ctx)
+	if err != nil {
+		return err
+	}
+	r.closeExporters(setting.Destinations)
+
+	for _, destination := range setting.Destinations {
+		if err := r.export(ctx, destination); err != nil {
+			log.Warn("failed to export audit logs to the destination", zap.String("destination", destination.Name), zap.Error(err))
+		}
+	}
+	return nil
+}
+
+func (r *Runner) export(ctx context.Context, destination *storepb.AuditLogExportSetting_Destination) error {
+	e, ok := r.exporters[destination.Name]
+	if !ok {
+		exporter, err := NewExporter(destination, r.fileDir)
+		if err != nil {
+			return err
+		}
+		e = &destinationExporter{destination: destination, exporter: exporter}
+		r.exporters[destination.Name] = e
+	}
+
+	cursor, err := r.store.GetAuditLogExportCursor(ctx, destination.Name)
+	if err != nil {
+		return errors.Wrap(err, "failed to get the export cursor")
+	}
+	for {
+		limit := exportBatchSize
+		auditLogs, err := r.store.ListAuditLogs(ctx, &store.FindAuditLogMessage{IDAfter: &cursor, Limit: &limit})
+		if err != nil {
+			return errors.Wrap(err, "failed to list audit logs")
+		}
+		if len(auditLogs) == 0 {
+			return nil
+		}
+		var records []*auditlog.Record
+		for _, auditLog := range auditLogs {
+			records = append(records, &auditlog.Record{
+				ID:       auditLog.ID,
+				PrevHash: auditLog.PrevHash,
+				Hash:     auditLog.Hash,
+				Entry:    json.RawMessage(auditLog.Entry),
+			})
+		}
+		if err := e.exporter.Export(ctx, records); err != nil {
+			return err
+		}
+		cursor = auditLogs[len(auditLogs)-1].ID
+		if err := r.store.UpsertAuditLogExportCursor(ctx, destination.Name, cursor); err != nil {
+			return errors.Wrap(err, "failed to update the export cursor")
+		}
+		if len(auditLogs) < exportBatchSize {
+			return nil
+		}
+	}
+}
+
+// closeExporters closes the exporters whose destinations are removed or changed.
+func (r *Runner) closeExporters(destinations []*storepb.AuditLogExportSetting_Destination) {
+	for name, e := range r.exporters {
+		var keep bool
+		for _, destination := range destinations {
+			if destination.Name == name && proto.Equal(destination, e.destination) {
+				keep = true
+				break
+			}
+		}
+		if keep {
+			continue
+		}
+		if err := e.exporter.Close(); err != nil {
+			log.Warn("failed to close the audit log exporter", zap.String("destination", name), zap.Error(err))
+		}
+		delete(r.exporters, name)
+	}
+}
+
+// NewExporter creates the exporter of the destination. The file destination can only write to the fileDir.
+func NewExporter(destination *storepb.AuditLogExportSetting_Destination, fileDir string) (auditlog.Exporter, error) {
+	switch destination.Type {
+	case storepb.AuditLogExportSetting_Destination_SYSLOG:
+		config := destination.Syslog
+		if config == nil {
+			return nil, errors.Errorf("syslog config is required")
+		}
+		return auditlog.NewSyslogExporter(config.Network, config.Address, int(config.Facility), config.AppName)
+	case storepb.AuditLogExportSetting_Destination_HTTP:
+		config := destination.Http
+		if config == nil {
+			return nil, errors.Errorf("HTTP config is required")
+		}
+		return auditlog.NewHTTPExporter(config.Url, config.Headers)
+	case storepb.AuditLogExportSetting_Destination_FILE:
+		config := destination.File
+		if config == nil {
+			return nil, errors.Errorf("file config is required")
+		}
+		return auditlog.NewFileExporter(config.Path, fileDir, config.MaxSize, int(config.MaxBackups))
+	default:
+		return nil, errors.Errorf("unsupported destination type %v", destination.Type)
+	}
+}
@@ -296,7 +296,7 @@ func (r *Runner) downloadBinlogFilesForInstance(ctx context.Context, instance *s
 	}
 	defer driver.Close(ctx)
 
-	mysqlDriver, ok := driver.(*mysql.Driver)
+	mysqlDriver, ok := db.Unwrap(driver).(*mysql.Driver)
 	if !ok {
 		log.Error("Failed to cast driver to mysql.Driver", zap.String("instance", instance.ResourceID))
 		return
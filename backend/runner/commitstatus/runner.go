@@ -0,0 +1,282 @@
+// Package commitstatus is the runner for reporting the issue progress as the VCS commit status.
+package commitstatus
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/gosimple/slug"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	"github.com/bytebase/bytebase/backend/common"
+	"github.com/bytebase/bytebase/backend/common/log"
+	"github.com/bytebase/bytebase/backend/component/state"
+	api "github.com/bytebase/bytebase/backend/legacyapi"
+	"github.com/bytebase/bytebase/backend/plugin/vcs"
+	"github.com/bytebase/bytebase/backend/store"
+	"github.com/bytebase/bytebase/backend/utils"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+const (
+	commitStatusRunnerInterval = 5 * time.Second
+	// commitStatusContext is the context of the commit status, the VCS shows one status per context on a commit.
+	commitStatusContext = "bytebase/rollout"
+	// lastReportedTTL is how long the last reported commit status of an issue is kept.
+	// An evicted issue only gets the same status posted again on its next progress.
+	lastReportedTTL = 24 * time.Hour
+)
+
+// Runner is the runner for reporting the issue progress as the VCS commit status.
+// The issues to report are collected in state.CommitStatusReporting on the issue activities
+// and the task check runs.
+type Runner struct {
+	store    *store.Store
+	stateCfg *state.State
+
+	// lastReported is the last reported commit status of the issues, so that we don't
+	// post the same status again. It's only accessed by the runner goroutine.
+	lastReported map[int]reportedCommitStatus
+}
+
+type reportedCommitStatus struct {
+	commitStatus vcs.CommitStatus
+	reportedTs   time.Time
+}
+
+// NewRunner creates a new runner.
+func NewRunner(store *store.Store, stateCfg *state.State) *Runner {
+	return &Runner{
+		store:        store,
+		stateCfg:     stateCfg,
+		lastReported: make(map[int]reportedCommitStatus),
+	}
+}
+
+// Run runs the runner.
+func (r *Runner) Run(ctx context.Context, wg *sync.WaitGroup) {
+	ticker := time.NewTicker(commitStatusRunnerInterval)
+	defer ticker.Stop()
+	defer wg.Done()
+	log.Debug(fmt.Sprintf("Commit status runner started and will run every %v", commitStatusRunnerInterval))
+	for {
+		select {
+		case <-ticker.C:
+			r.evictLastReported(time.Now())
+			r.stateCfg.CommitStatusReporting.Range(func(key, _ any) bool {
+				// Delete before reporting so that the progress made during the reporting is reported next time.
+				r.stateCfg.CommitStatusReporting.Delete(key)
+				issueUID := key.(int)
+				if err := r.report(ctx, issueUID); err != nil {
+					log.Warn("Failed to report the commit status", zap.Int("issue_id", issueUID), zap.Error(err))
+				}
+				return true
+			})
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// evictLastReported drops the statuses reported before the TTL, so that the issues
+// left open forever or no longer reportable don't grow the map unbounded.
+func (r *Runner) evictLastReported(now time.Time) {
+	for issueUID, reported := range r.lastReported {
+		if now.Sub(reported.reportedTs) > lastReportedTTL {
+			delete(r.lastReported, issueUID)
+		}
+	}
+}
+
+func (r *Runner) report(ctx context.Context, issueUID int) error {
+	issue, err := r.store.GetIssueV2(ctx, &store.FindIssueMessage{UID: &issueUID})
+	if err != nil {
+		return errors.Wrap(err, "failed to get issue")
+	}
+	if issue == nil {
+		delete(r.lastReported, issueUID)
+		return nil
+	}
+	payload := &storepb.IssuePayload{}
+	if err := protojson.Unmarshal([]byte(issue.Payload), payload); err != nil {
+		return errors.Wrap(err, "failed to unmarshal issue payload")
+	}
+	if payload.VcsCommit == nil {
+		return nil
+	}
+
+	repositoryUID := int(payload.VcsCommit.RepositoryId)
+	repo, err := r.store.GetRepositoryV2(ctx, &store.FindRepositoryMessage{UID: &repositoryUID})
+	if err != nil {
+		return errors.Wrap(err, "failed to get repository")
+	}
+	// The repository may have been unlinked from the project.
+	if repo == nil {
+		delete(r.lastReported, issueUID)
+		return nil
+	}
+	externalVCS, err := r.store.GetExternalVersionControlV2(ctx, repo.VCSUID)
+	if err != nil {
+		return errors.Wrap(err, "failed to get VCS")
+	}
+	if externalVCS == nil {
+		return nil
+	}
+
+	approved, err := utils.CheckIssueApproved(issue)
+	if err != nil {
+		return err
+	}
+	var stages []*store.StageMessage
+	var tasks []*store.TaskMessage
+	var taskCheckRuns []*store.TaskCheckRunMessage
+	if issue.PipelineUID != nil {
+		if stages, err = r.store.ListStageV2(ctx, *issue.PipelineUID); err != nil {
+			return errors.Wrap(err, "failed to list stages")
+		}
+		if tasks, err = r.store.ListTasks(ctx, &api.TaskFind{PipelineID: issue.PipelineUID}); err != nil {
+			return errors.Wrap(err, "failed to list tasks")
+		}
+		if taskCheckRuns, err = r.store.ListTaskCheckRuns(ctx, &store.TaskCheckRunFind{PipelineID: issue.PipelineUID}); err != nil {
+			return errors.Wrap(err, "failed to list task check runs")
+		}
+	}
+	setting, err := r.store.GetWorkspaceGeneralSetting(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to get workspace setting")
+	}
+
+	state, description := getCommitStatus(issue.Status, payload, approved, stages, tasks, taskCheckRuns)
+	commitStatus := vcs.CommitStatus{
+		State:       state,
+		Context:     commitStatusContext,
+		Description: description,
+		TargetURL:   fmt.Sprintf("%s/issue/%s-%d", setting.ExternalUrl, slug.Make(issue.Title), issue.UID),
+	}
+	if last, ok := r.lastReported[issue.UID]; ok && last.commitStatus == commitStatus {
+		return nil
+	}
+
+	if err := vcs.Get(externalVCS.Type, vcs.ProviderConfig{}).CreateCommitStatus(
+		ctx,
+		common.OauthContext{
+			ClientID:     externalVCS.ApplicationID,
+			ClientSecret: externalVCS.Secret,
+			AccessToken:  repo.AccessToken,
+			RefreshToken: repo.RefreshToken,
+			Refresher:    utils.RefreshToken(ctx, r.store, repo.WebURL),
+		},
+		externalVCS.InstanceURL,
+		repo.ExternalID,
+		payload.VcsCommit.CommitId,
+		&commitStatus,
+	); err != nil {
+		return err
+	}
+
+	if issue.Status == api.IssueOpen {
+		r.lastReported[issue.UID] = reportedCommitStatus{
+			commitStatus: commitStatus,
+			reportedTs:   time.Now(),
+		}
+	} else {
+		delete(r.lastReported, issue.UID)
+	}
+	return nil
+}
+
+// getCommitStatus returns the commit status state and description of the issue progress.
+func getCommitStatus(issueStatus api.IssueStatus, payload *storepb.IssuePayload, approved bool, stages []*store.StageMessage, tasks []*store.TaskMessage, taskCheckRuns []*store.TaskCheckRunMessage) (vcs.CommitStatusState, string) {
+	switch issueStatus {
+	case api.IssueDone:
+		return vcs.CommitStatusSuccess, "Rolled out"
+	case api.IssueCanceled:
+		return vcs.CommitStatusError, "Issue canceled"
+	}
+
+	for _, task := range tasks {
+		if task.Status == api.TaskFailed {
+			return vcs.CommitStatusFailure, fmt.Sprintf("Task %q failed", task.Name)
+		}
+	}
+	if pullRequest := payload.PullRequest; pullRequest != nil && !pullRequest.Merged {
+		return vcs.CommitStatusPending, "Waiting for the pull request to be merged"
+	}
+
+	running, failed := summarizeTaskCheckRuns(taskCheckRuns)
+	if running {
+		return vcs.CommitStatusPending, "Running task checks"
+	}
+	if failed {
+		return vcs.CommitStatusFailure, "Task checks failed"
+	}
+	if !approved {
+		return vcs.CommitStatusPending, "Waiting for approval"
+	}
+
+	// Stages are rolled out in order, report the first stage that is not done.
+	for _, stage := range stages {
+		stageRunning, stageDone := false, true
+		for _, task := range tasks {
+			if task.StageID != stage.ID {
+				continue
+			}
+			if task.Status == api.TaskRunning {
+				stageRunning = true
+			}
+			if task.Status != api.TaskDone && task.Status != api.TaskCanceled {
+				stageDone = false
+			}
+		}
+		if stageRunning {
+			return vcs.CommitStatusPending, fmt.Sprintf("Rolling out %s", stage.Name)
+		}
+		if !stageDone {
+			return vcs.CommitStatusPending, fmt.Sprintf("Waiting to roll out %s", stage.Name)
+		}
+	}
+	return vcs.CommitStatusPending, "Waiting to resolve the issue"
+}
+
+// summarizeTaskCheckRuns returns whether any of the latest task check runs is running or has failed.
+// A task check run fails if it cannot be finished or it reports any error.
+func summarizeTaskCheckRuns(taskCheckRuns []*store.TaskCheckRunMessage) (bool, bool) {
+	type checkKey struct {
+		taskID    int
+		checkType api.TaskCheckType
+	}
+	latest := make(map[checkKey]*store.TaskCheckRunMessage)
+	for _, run := range taskCheckRuns {
+		key := checkKey{taskID: run.TaskID, checkType: run.Type}
+		if last, ok := latest[key]; !ok || run.ID > last.ID {
+			latest[key] = run
+		}
+	}
+
+	running, failed := false, false
+	for _, run := range latest {
+		switch run.Status {
+		case api.TaskCheckRunRunning:
+			running = true
+		case api.TaskCheckRunFailed:
+			failed = true
+		case api.TaskCheckRunDone:
+			var result api.TaskCheckRunResultPayload
+			if err := json.Unmarshal([]byte(run.Result), &result); err != nil {
+				failed = true
+				continue
+			}
+			for _, checkResult := range result.ResultList {
+				if checkResult.Status == api.TaskCheckStatusError {
+					failed = true
+				}
+			}
+		}
+	}
+	return running, failed
+}
@@ -0,0 +1,150 @@
+package commitstatus
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	api "github.com/bytebase/bytebase/backend/legacyapi"
+	"github.com/bytebase/bytebase/backend/plugin/vcs"
+	"github.com/bytebase/bytebase/backend/store"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+func TestGetCommitStatus(t *testing.T) {
+	stages := []*store.StageMessage{
+		{ID: 1, Name: "Test Stage"},
+		{ID: 2, Name: "Prod Stage"},
+	}
+	checkError := `{"resultList":[{"status":"ERROR","title":"Syntax error"}]}`
+	checkSuccess := `{"resultList":[{"status":"SUCCESS","title":"OK"}]}`
+
+	tests := []struct {
+		name            string
+		issueStatus     api.IssueStatus
+		payload         *storepb.IssuePayload
+		approved        bool
+		tasks           []*store.TaskMessage
+		taskCheckRuns   []*store.TaskCheckRunMessage
+		wantState       vcs.CommitStatusState
+		wantDescription string
+	}{
+		{
+			name:            "done",
+			issueStatus:     api.IssueDone,
+			wantState:       vcs.CommitStatusSuccess,
+			wantDescription: "Rolled out",
+		},
+		{
+			name:            "canceled",
+			issueStatus:     api.IssueCanceled,
+			wantState:       vcs.CommitStatusError,
+			wantDescription: "Issue canceled",
+		},
+		{
+			name:        "task failed",
+			issueStatus: api.IssueOpen,
+			approved:    true,
+			tasks: []*store.TaskMessage{
+				{StageID: 1, Name: "Add column", Status: api.TaskFailed},
+			},
+			wantState:       vcs.CommitStatusFailure,
+			wantDescription: `Task "Add column" failed`,
+		},
+		{
+			name:        "pull request not merged",
+			issueStatus: api.IssueOpen,
+			payload: &storepb.IssuePayload{
+				PullRequest: &storepb.IssuePayloadPullRequest{PullRequestId: "1"},
+			},
+			tasks: []*store.TaskMessage{
+				{StageID: 1, Status: api.TaskPendingApproval},
+			},
+			wantState:       vcs.CommitStatusPending,
+			wantDescription: "Waiting for the pull request to be merged",
+		},
+		{
+			name:        "task check running",
+			issueStatus: api.IssueOpen,
+			tasks: []*store.TaskMessage{
+				{ID: 1, StageID: 1, Status: api.TaskPendingApproval},
+			},
+			taskCheckRuns: []*store.TaskCheckRunMessage{
+				{ID: 1, TaskID: 1, Type: api.TaskCheckDatabaseStatementSyntax, Status: api.TaskCheckRunRunning},
+			},
+			wantState:       vcs.CommitStatusPending,
+			wantDescription: "Running task checks",
+		},
+		{
+			name:        "task check error",
+			issueStatus: api.IssueOpen,
+			tasks: []*store.TaskMessage{
+				{ID: 1, StageID: 1, Status: api.TaskPendingApproval},
+			},
+			taskCheckRuns: []*store.TaskCheckRunMessage{
+				{ID: 1, TaskID: 1, Type: api.TaskCheckDatabaseStatementSyntax, Status: api.TaskCheckRunDone, Result: checkError},
+			},
+			wantState:       vcs.CommitStatusFailure,
+			wantDescription: "Task checks failed",
+		},
+		{
+			name:        "only the latest task check counts",
+			issueStatus: api.IssueOpen,
+			tasks: []*store.TaskMessage{
+				{ID: 1, StageID: 1, Status: api.TaskPendingApproval},
+			},
+			taskCheckRuns: []*store.TaskCheckRunMessage{
+				{ID: 2, TaskID: 1, Type: api.TaskCheckDatabaseStatementSyntax, Status: api.TaskCheckRunDone, Result: checkSuccess},
+				{ID: 1, TaskID: 1, Type: api.TaskCheckDatabaseStatementSyntax, Status: api.TaskCheckRunDone, Result: checkError},
+			},
+			wantState:       vcs.CommitStatusPending,
+			wantDescription: "Waiting for approval",
+		},
+		{
+			name:        "rolling out",
+			issueStatus: api.IssueOpen,
+			approved:    true,
+			tasks: []*store.TaskMessage{
+				{ID: 1, StageID: 1, Status: api.TaskDone},
+				{ID: 2, StageID: 2, Status: api.TaskRunning},
+			},
+			wantState:       vcs.CommitStatusPending,
+			wantDescription: "Rolling out Prod Stage",
+		},
+		{
+			name:        "waiting to roll out",
+			issueStatus: api.IssueOpen,
+			approved:    true,
+			tasks: []*store.TaskMessage{
+				{ID: 1, StageID: 1, Status: api.TaskPending},
+				{ID: 2, StageID: 2, Status: api.TaskPending},
+			},
+			wantState:       vcs.CommitStatusPending,
+			wantDescription: "Waiting to roll out Test Stage",
+		},
+	}
+
+	for _, test := range tests {
+		payload := test.payload
+		if payload == nil {
+			payload = &storepb.IssuePayload{}
+		}
+		state, description := getCommitStatus(test.issueStatus, payload, test.approved, stages, test.tasks, test.taskCheckRuns)
+		assert.Equal(t, test.wantState, state, test.name)
+		assert.Equal(t, test.wantDescription, description, test.name)
+	}
+}
+
+func TestEvictLastReported(t *testing.T) {
+	now := time.Now()
+	r := NewRunner(nil, nil)
+	r.lastReported[1] = reportedCommitStatus{reportedTs: now.Add(-lastReportedTTL - time.Minute)}
+	r.lastReported[2] = reportedCommitStatus{reportedTs: now.Add(-time.Minute)}
+
+	r.evictLastReported(now)
+	_, ok := r.lastReported[1]
+	assert.False(t, ok)
+	_, ok = r.lastReported[2]
+	assert.True(t, ok)
+}
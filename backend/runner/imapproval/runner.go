@@ -0,0 +1,466 @@
+// Package imapproval is the runner posting the interactive approval messages of the pending review steps to Slack and Microsoft Teams.
+package imapproval
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gosimple/slug"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	"github.com/bytebase/bytebase/backend/common/log"
+	api "github.com/bytebase/bytebase/backend/legacyapi"
+	"github.com/bytebase/bytebase/backend/plugin/app/slack"
+	"github.com/bytebase/bytebase/backend/plugin/app/teams"
+	"github.com/bytebase/bytebase/backend/store"
+	"github.com/bytebase/bytebase/backend/utils"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+const runnerInterval = 1 * time.Minute
+
+// NewRunner returns a runner.
+func NewRunner(store *store.Store, slackProvider *slack.Provider, teamsProvider *teams.Provider) *Runner {
+	return &Runner{
+		store:         store,
+		slackProvider: slackProvider,
+		teamsProvider: teamsProvider,
+	}
+}
+
+// Runner is a runner which keeps the interactive approval messages in sync with the reviews.
+// Each message is an external approval of the issue, and there is at most one active message for the pending review step.
+type Runner struct {
+	store         *store.Store
+	slackProvider *slack.Provider
+	teamsProvider *teams.Provider
+
+	// mu serializes the syncing so that the runner and the interaction hooks never post duplicated messages.
+	mu sync.Mutex
+}
+
+// message is the IM-agnostic content of the interactive approval message.
+type message struct {
+	title      string
+	link       string
+	fields     [][2]string
+	status     string
+	pending    bool
+	reviewName string
+	stepIndex  int
+}
+
+// Run runs the runner.
+func (r *Runner) Run(ctx context.Context, wg *sync.WaitGroup) {
+	ticker := time.NewTicker(runnerInterval)
+	defer ticker.Stop()
+	defer wg.Done()
+	log.Debug(fmt.Sprintf("IM approval runner started and will run every %v", runnerInterval))
+	for {
+		select {
+		case <-ticker.C:
+			r.syncAll(ctx)
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+func (r *Runner) syncAll(ctx context.Context) {
+	setting, err := GetSetting(ctx, r.store)
+	if err != nil {
+		log.Error("failed to get IM setting", zap.Error(err))
+		return
+	}
+	if setting == nil {
+		return
+	}
+	approvalType := getExternalApprovalType(setting.IMType)
+
+	// The issues with the pending review steps and the issues with the messages to be closed.
+	issueUIDs := make(map[int]bool)
+	issues, err := r.store.ListIssueV2(ctx, &store.FindIssueMessage{
+		StatusList: []api.IssueStatus{api.IssueOpen},
+	})
+	if err != nil {
+		log.Error("failed to list issues", zap.Error(err))
+		return
+	}
+	for _, issue := range issues {
+		issueUIDs[issue.UID] = true
+	}
+	for _, externalApprovalType := range []api.ExternalApprovalType{api.ExternalApprovalTypeSlack, api.ExternalApprovalTypeTeams} {
+		externalApprovalType := externalApprovalType
+		externalApprovals, err := r.store.ListExternalApprovalV2(ctx, &store.ListExternalApprovalMessage{
+			Type: &externalApprovalType,
+		})
+		if err != nil {
+			log.Error("failed to list external approvals", zap.String("type", string(externalApprovalType)), zap.Error(err))
+			return
+		}
+		for _, externalApproval := range externalApprovals {
+			if externalApproval.Type != approvalType {
+				// The messages of the previous IM cannot be updated anymore.
+				if _, err := r.store.UpdateExternalApprovalV2(ctx, &store.UpdateExternalApprovalMessage{
+					ID:        externalApproval.ID,
+					RowStatus: api.Archived,
+				}); err != nil {
+					log.Error("failed to archive external approval", zap.Int("id", externalApproval.ID), zap.Error(err))
+				}
+				continue
+			}
+			issueUIDs[externalApproval.IssueUID] = true
+		}
+	}
+
+	for issueUID := range issueUIDs {
+		if err := r.SyncIssue(ctx, issueUID); err != nil {
+			if errors.Is(err, context.Canceled) {
+				return
+			}
+			log.Error("failed to sync IM approval messages", zap.Int("issueID", issueUID), zap.Error(err))
+		}
+	}
+}
+
+// SyncIssue posts the interactive approval message of the pending review step of the issue,
+// and closes the messages of the steps which are no longer pending.
+func (r *Runner) SyncIssue(ctx context.Context, issueUID int) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	setting, err := GetSetting(ctx, r.store)
+	if err != nil {
+		return err
+	}
+	if setting == nil {
+		return nil
+	}
+	issue, err := r.store.GetIssueV2(ctx, &store.FindIssueMessage{UID: &issueUID})
+	if err != nil {
+		return errors.Wrapf(err, "failed to get issue %d", issueUID)
+	}
+	if issue == nil {
+		return nil
+	}
+	payload := &storepb.IssuePayload{}
+	if err := protojson.Unmarshal([]byte(issue.Payload), payload); err != nil {
+		return errors.Wrap(err, "failed to unmarshal issue payload")
+	}
+	approval := payload.Approval
+	var template *storepb.ApprovalTemplate
+	if approval != nil && approval.ApprovalFindingDone && approval.ApprovalFindingError == "" && len(approval.ApprovalTemplates) == 1 {
+		template = approval.ApprovalTemplates[0]
+	}
+	pendingStepIndex := -1
+	if issue.Status == api.IssueOpen && template != nil && utils.FindRejectedStep(template, approval.Approvers) == nil {
+		pendingStepIndex = utils.FindNextPendingStepIndex(template, approval.Approvers)
+	}
+
+	workspaceSetting, err := r.store.GetWorkspaceGeneralSetting(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to get workspace setting")
+	}
+	msg := &message{
+		title: issue.Title,
+		link:  fmt.Sprintf("%s/issue/%s-%d", workspaceSetting.ExternalUrl, slug.Make(issue.Title), issue.UID),
+		fields: [][2]string{
+			{"Project", issue.Project.Title},
+			{"Creator", issue.Creator.Name},
+		},
+		reviewName: FormatReviewName(issue.Project.ResourceID, issue.UID),
+	}
+
+	approvalType := getExternalApprovalType(setting.IMType)
+	externalApprovals, err := r.store.ListExternalApprovalV2(ctx, &store.ListExternalApprovalMessage{
+		IssueUID: &issue.UID,
+		Type:     &approvalType,
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to list external approvals")
+	}
+	var current *store.ExternalApprovalMessage
+	var currentPayload *api.ExternalApprovalPayloadIM
+	for _, externalApproval := range externalApprovals {
+		imPayload := &api.ExternalApprovalPayloadIM{}
+		if err := json.Unmarshal([]byte(externalApproval.Payload), imPayload); err != nil {
+			return errors.Wrapf(err, "failed to unmarshal external approval payload %q", externalApproval.Payload)
+		}
+		if current == nil && imPayload.StepIndex == pendingStepIndex {
+			current, currentPayload = externalApproval, imPayload
+			continue
+		}
+		// Close the message of the step which is no longer pending.
+		closed := *msg
+		closed.stepIndex = imPayload.StepIndex
+		closed.status, err = r.getClosedStatus(ctx, issue, approval, template, imPayload.StepIndex, pendingStepIndex)
+		if err != nil {
+			return err
+		}
+		closed.fields = append(closed.fields, [2]string{"Approval step", formatStep(imPayload.StepIndex, template)})
+		if err := r.updateMessage(ctx, setting, imPayload.Channel, imPayload.MessageID, &closed); err != nil {
+			// The message may have been deleted in the IM, so we just archive it.
+			log.Warn("failed to close IM approval message", zap.Int("issueID", issue.UID), zap.Error(err))
+		}
+		if _, err := r.store.UpdateExternalApprovalV2(ctx, &store.UpdateExternalApprovalMessage{
+			ID:        externalApproval.ID,
+			RowStatus: api.Archived,
+		}); err != nil {
+			return errors.Wrap(err, "failed to archive external approval")
+		}
+	}
+
+	if pendingStepIndex < 0 {
+		return nil
+	}
+	for _, node := range template.Flow.Steps[pendingStepIndex].Nodes {
+		// The step of the external approval node is approved by the relay.
+		if node.GetExternalNodeId() != "" {
+			return nil
+		}
+	}
+	msg.stepIndex = pendingStepIndex
+	msg.pending = true
+	msg.status = fmt.Sprintf("Waiting for approval of step %d of %d.", pendingStepIndex+1, len(template.Flow.Steps))
+	msg.fields = append(msg.fields, [2]string{"Approval step", formatStep(pendingStepIndex, template)})
+
+	if current == nil {
+		messageID, err := r.postMessage(ctx, setting, msg)
+		if err != nil {
+			return errors.Wrap(err, "failed to post IM approval message")
+		}
+		imPayload, err := json.Marshal(&api.ExternalApprovalPayloadIM{
+			StepIndex: pendingStepIndex,
+			Channel:   setting.Channel,
+			MessageID: messageID,
+			Status:    msg.status,
+		})
+		if err != nil {
+			return errors.Wrap(err, "failed to marshal external approval payload")
+		}
+		if _, err := r.store.CreateExternalApprovalV2(ctx, &store.ExternalApprovalMessage{
+			IssueUID:     issue.UID,
+			RequesterUID: issue.Creator.ID,
+			ApproverUID:  api.SystemBotID,
+			Type:         approvalType,
+			Payload:      string(imPayload),
+		}); err != nil {
+			return errors.Wrap(err, "failed to create external approval")
+		}
+		return nil
+	}
+	if currentPayload.Status == msg.status {
+		return nil
+	}
+	if err := r.updateMessage(ctx, setting, currentPayload.Channel, currentPayload.MessageID, msg); err != nil {
+		return errors.Wrap(err, "failed to update IM approval message")
+	}
+	currentPayload.Status = msg.status
+	imPayload, err := json.Marshal(currentPayload)
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal external approval payload")
+	}
+	imPayloadString := string(imPayload)
+	if _, err := r.store.UpdateExternalApprovalV2(ctx, &store.UpdateExternalApprovalMessage{
+		ID:        current.ID,
+		RowStatus: api.Normal,
+		Payload:   &imPayloadString,
+	}); err != nil {
+		return errors.Wrap(err, "failed to update external approval")
+	}
+	return nil
+}
+
+// CheckPendingStep checks if the review step of the interactive approval message is still pending,
+// so that the outdated messages cannot approve the following steps.
+func (r *Runner) CheckPendingStep(ctx context.Context, issueUID int, stepIndex int) error {
+	issue, err := r.store.GetIssueV2(ctx, &store.FindIssueMessage{UID: &issueUID})
+	if err != nil {
+		return errors.Wrapf(err, "failed to get issue %d", issueUID)
+	}
+	if issue == nil {
+		return errors.Errorf("issue %d not found", issueUID)
+	}
+	if issue.Status != api.IssueOpen {
+		return errors.Errorf("the issue is %s", strings.ToLower(string(issue.Status)))
+	}
+	payload := &storepb.IssuePayload{}
+	if err := protojson.Unmarshal([]byte(issue.Payload), payload); err != nil {
+		return errors.Wrap(err, "failed to unmarshal issue payload")
+	}
+	approval := payload.Approval
+	if approval == nil || len(approval.ApprovalTemplates) != 1 {
+		return errors.New("the review has no approval flow")
+	}
+	template := approval.ApprovalTemplates[0]
+	if utils.FindRejectedStep(template, approval.Approvers) != nil {
+		return errors.New("the review has been rejected")
+	}
+	if utils.FindNextPendingStepIndex(template, approval.Approvers) != stepIndex {
+		return errors.New("the approval step is no longer pending")
+	}
+	return nil
+}
+
+func (r *Runner) getClosedStatus(ctx context.Context, issue *store.IssueMessage, approval *storepb.IssuePayloadApproval, template *storepb.ApprovalTemplate, stepIndex int, pendingStepIndex int) (string, error) {
+	if template != nil && stepIndex < len(template.Flow.Steps) && stepIndex != pendingStepIndex {
+		stepIndexes := utils.GetApproverStepIndexes(template, approval.Approvers)
+		var approvedBy []string
+		for i, approver := range approval.Approvers {
+			if stepIndexes[i] != stepIndex {
+				continue
+			}
+			user, err := r.store.GetUserByID(ctx, int(approver.PrincipalId))
+			if err != nil {
+				return "", errors.Wrapf(err, "failed to get user %d", approver.PrincipalId)
+			}
+			name := fmt.Sprintf("user %d", approver.PrincipalId)
+			if user != nil {
+				name = user.Name
+			}
+			if approver.Status == storepb.IssuePayloadApproval_Approver_REJECTED {
+				return fmt.Sprintf("Rejected by %s.", name), nil
+			}
+			approvedBy = append(approvedBy, name)
+		}
+		// The step is approved if the following step is pending or the whole review is done.
+		if len(approvedBy) > 0 && (pendingStepIndex > stepIndex || pendingStepIndex < 0) {
+			return fmt.Sprintf("Approved by %s.", strings.Join(approvedBy, ", ")), nil
+		}
+	}
+	if issue.Status != api.IssueOpen {
+		return fmt.Sprintf("The issue is %s.", strings.ToLower(string(issue.Status))), nil
+	}
+	return "The approval step is outdated.", nil
+}
+
+func (r *Runner) postMessage(ctx context.Context, setting *api.SettingAppIMValue, msg *message) (string, error) {
+	switch setting.IMType {
+	case api.IMTypeSlack:
+		return r.slackProvider.PostMessage(ctx, setting.AppSecret, setting.Channel, toSlackContent(msg))
+	case api.IMTypeTeams:
+		return r.teamsProvider.PostMessage(ctx, GetTeamsTokenCtx(setting), GetTeamsConversation(setting, setting.Channel), toTeamsContent(msg))
+	default:
+		return "", errors.Errorf("unsupported IM type %s", setting.IMType)
+	}
+}
+
+func (r *Runner) updateMessage(ctx context.Context, setting *api.SettingAppIMValue, channel string, messageID string, msg *message) error {
+	switch setting.IMType {
+	case api.IMTypeSlack:
+		return r.slackProvider.UpdateMessage(ctx, setting.AppSecret, channel, messageID, toSlackContent(msg))
+	case api.IMTypeTeams:
+		return r.teamsProvider.UpdateMessage(ctx, GetTeamsTokenCtx(setting), GetTeamsConversation(setting, channel), messageID, toTeamsContent(msg))
+	default:
+		return errors.Errorf("unsupported IM type %s", setting.IMType)
+	}
+}
+
+// GetSetting gets the IM setting if the interactive approval of Slack or Teams is enabled, otherwise it returns nil.
+func GetSetting(ctx context.Context, s *store.Store) (*api.SettingAppIMValue, error) {
+	settingName := api.SettingAppIM
+	setting, err := s.GetSettingV2(ctx, &store.FindSettingMessage{Name: &settingName})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get IM setting by settingName %s", string(settingName))
+	}
+	if setting == nil || setting.Value == "" {
+		return nil, nil
+	}
+	value := &api.SettingAppIMValue{}
+	if err := json.Unmarshal([]byte(setting.Value), value); err != nil {
+		return nil, errors.Wrapf(err, "failed to unmarshal IM setting, settingName %s", string(settingName))
+	}
+	if value.IMType != api.IMTypeSlack && value.IMType != api.IMTypeTeams {
+		return nil, nil
+	}
+	if !value.ExternalApproval.Enabled {
+		return nil, nil
+	}
+	return value, nil
+}
+
+// GetTeamsTokenCtx returns the token context of the Teams bot.
+func GetTeamsTokenCtx(setting *api.SettingAppIMValue) teams.TokenCtx {
+	return teams.TokenCtx{
+		AppID:     setting.AppID,
+		AppSecret: setting.AppSecret,
+		TenantID:  setting.TenantID,
+	}
+}
+
+// GetTeamsConversation returns the Teams conversation of the channel.
+func GetTeamsConversation(setting *api.SettingAppIMValue, channel string) teams.Conversation {
+	return teams.Conversation{
+		ServiceURL: setting.ServiceURL,
+		ID:         channel,
+	}
+}
+
+// FormatReviewName formats the review name of the issue.
+func FormatReviewName(projectID string, issueUID int) string {
+	return fmt.Sprintf("projects/%s/reviews/%d", projectID, issueUID)
+}
+
+// GetIssueUID gets the issue UID from the review name.
+func GetIssueUID(reviewName string) (int, error) {
+	parts := strings.Split(reviewName, "/")
+	if len(parts) != 4 || parts[0] != "projects" || parts[2] != "reviews" {
+		return 0, errors.Errorf("invalid review name %q", reviewName)
+	}
+	issueUID, err := strconv.Atoi(parts[3])
+	if err != nil {
+		return 0, errors.Errorf("invalid review name %q", reviewName)
+	}
+	return issueUID, nil
+}
+
+func getExternalApprovalType(imType api.IMType) api.ExternalApprovalType {
+	if imType == api.IMTypeTeams {
+		return api.ExternalApprovalTypeTeams
+	}
+	return api.ExternalApprovalTypeSlack
+}
+
+func formatStep(stepIndex int, template *storepb.ApprovalTemplate) string {
+	if template == nil {
+		return strconv.Itoa(stepIndex + 1)
+	}
+	return fmt.Sprintf("%d of %d", stepIndex+1, len(template.Flow.Steps))
+}
+
+func toSlackContent(msg *message) slack.Content {
+	content := slack.Content{
+		Title:      msg.title,
+		Link:       msg.link,
+		Status:     msg.status,
+		Pending:    msg.pending,
+		ReviewName: msg.reviewName,
+		StepIndex:  msg.stepIndex,
+	}
+	for _, field := range msg.fields {
+		content.Fields = append(content.Fields, slack.Field{Name: field[0], Value: field[1]})
+	}
+	return content
+}
+
+func toTeamsContent(msg *message) teams.Content {
+	content := teams.Content{
+		Title:      msg.title,
+		Link:       msg.link,
+		Status:     msg.status,
+		Pending:    msg.pending,
+		ReviewName: msg.reviewName,
+		StepIndex:  msg.stepIndex,
+	}
+	for _, field := range msg.fields {
+		content.Facts = append(content.Facts, teams.Fact{Title: field[0], Value: field[1]})
+	}
+	return content
+}
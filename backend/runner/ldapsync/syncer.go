@@ -0,0 +1,259 @@
+// Package ldapsync is the runner for syncing the LDAP group membership into the project IAM policies.
+package ldapsync
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+	"google.golang.org/genproto/googleapis/type/expr"
+
+	"github.com/bytebase/bytebase/backend/common/log"
+	"github.com/bytebase/bytebase/backend/component/activity"
+	enterpriseAPI "github.com/bytebase/bytebase/backend/enterprise/api"
+	api "github.com/bytebase/bytebase/backend/legacyapi"
+	"github.com/bytebase/bytebase/backend/plugin/idp/ldap"
+	"github.com/bytebase/bytebase/backend/store"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+const (
+	ldapSyncInterval = 10 * time.Minute
+)
+
+// Syncer is the runner for syncing the LDAP group membership into the project IAM policies.
+//
+// Each group mapping of an LDAP identity provider owns a binding of the project role, which is
+// identified by the description of its condition. The members of the owned binding are replaced
+// with the group members who have signed in with the identity provider. The other bindings are
+// never touched, so the roles granted manually are kept.
+type Syncer struct {
+	store           *store.Store
+	activityManager *activity.Manager
+	licenseService  enterpriseAPI.LicenseService
+}
+
+// NewSyncer creates a new LDAP group syncer.
+func NewSyncer(store *store.Store, activityManager *activity.Manager, licenseService enterpriseAPI.LicenseService) *Syncer {
+	return &Syncer{
+		store:           store,
+		activityManager: activityManager,
+		licenseService:  licenseService,
+	}
+}
+
+// Run will run the LDAP group syncer.
+func (s *Syncer) Run(ctx context.Context, wg *sync.WaitGroup) {
+	ticker := time.NewTicker(ldapSyncInterval)
+	defer ticker.Stop()
+	defer wg.Done()
+	log.Debug(fmt.Sprintf("LDAP group syncer started and will run every %v", ldapSyncInterval))
+	for {
+		select {
+		case <-ticker.C:
+			if err := s.syncAll(ctx); err != nil {
+				log.Warn("Failed to sync LDAP groups", zap.Error(err))
+			}
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// groupBindingKey identifies the binding owned by a group mapping in a project.
+type groupBindingKey struct {
+	role        api.Role
+	description string
+}
+
+func (s *Syncer) syncAll(ctx context.Context) error {
+	if !s.licenseService.IsFeatureEnabled(api.FeatureSSO) {
+		return nil
+	}
+	identityProviders, err := s.store.ListIdentityProviders(ctx, &store.FindIdentityProviderMessage{})
+	if err != nil {
+		return errors.Wrap(err, "failed to list identity providers")
+	}
+	for _, identityProvider := range identityProviders {
+		if identityProvider.Type != storepb.IdentityProviderType_LDAP {
+			continue
+		}
+		if err := s.syncIdentityProvider(ctx, identityProvider); err != nil {
+			log.Warn("Failed to sync LDAP groups of identity provider", zap.String("idp", identityProvider.ResourceID), zap.Error(err))
+		}
+	}
+	return nil
+}
+
+func (s *Syncer) syncIdentityProvider(ctx context.Context, identityProvider *store.IdentityProviderMessage) error {
+	config := identityProvider.Config.GetLdapConfig()
+	ldapIDP, err := ldap.NewIdentityProvider(config)
+	if err != nil {
+		return errors.Wrap(err, "failed to create LDAP identity provider")
+	}
+
+	// desired is the members of the owned bindings by project.
+	// The bindings of the groups failed to list are kept unchanged.
+	desired := make(map[string]map[groupBindingKey][]*store.UserMessage)
+	failed := make(map[groupBindingKey]bool)
+	for _, groupMapping := range config.GroupMappings {
+		key := groupBindingKey{
+			role:        api.Role(groupMapping.Role),
+			description: getGroupBindingDescription(identityProvider.ResourceID, groupMapping.Group),
+		}
+		if desired[groupMapping.Project] == nil {
+			desired[groupMapping.Project] = make(map[groupBindingKey][]*store.UserMessage)
+		}
+		userInfos, err := ldapIDP.ListGroupMembers(groupMapping.Group)
+		if err != nil {
+			log.Warn("Failed to list LDAP group members", zap.String("idp", identityProvider.ResourceID), zap.String("group", groupMapping.Group), zap.Error(err))
+			failed[key] = true
+			continue
+		}
+		users, err := s.findUsers(ctx, identityProvider, userInfos)
+		if err != nil {
+			return err
+		}
+		desired[groupMapping.Project][key] = append(desired[groupMapping.Project][key], users...)
+	}
+
+	// Visit all projects so that the owned bindings of the removed group mappings are removed.
+	projects, err := s.store.ListProjectV2(ctx, &store.FindProjectMessage{})
+	if err != nil {
+		return errors.Wrap(err, "failed to list projects")
+	}
+	for _, project := range projects {
+		if err := s.syncProject(ctx, identityProvider, project, desired[project.ResourceID], failed); err != nil {
+			log.Warn("Failed to sync LDAP groups of project", zap.String("idp", identityProvider.ResourceID), zap.String("project", project.ResourceID), zap.Error(err))
+		}
+	}
+	return nil
+}
+
+// findUsers returns the active users of the group members. The members who haven't signed in are skipped.
+func (s *Syncer) findUsers(ctx context.Context, identityProvider *store.IdentityProviderMessage, userInfos []*storepb.IdentityProviderUserInfo) ([]*store.UserMessage, error) {
+	var users []*store.UserMessage
+	for _, userInfo := range userInfos {
+		email := getUserEmail(identityProvider, userInfo)
+		if email == "" {
+			continue
+		}
+		user, err := s.store.GetUser(ctx, &store.FindUserMessage{Email: &email})
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to get user %q", email)
+		}
+		if user == nil || user.MemberDeleted {
+			continue
+		}
+		users = append(users, user)
+	}
+	return users, nil
+}
+
+func (s *Syncer) syncProject(ctx context.Context, identityProvider *store.IdentityProviderMessage, project *store.ProjectMessage, desired map[groupBindingKey][]*store.UserMessage, failed map[groupBindingKey]bool) error {
+	policy, err := s.store.GetProjectPolicy(ctx, &store.GetProjectPolicyMessage{UID: &project.UID})
+	if err != nil {
+		return errors.Wrap(err, "failed to get project policy")
+	}
+
+	descriptionPrefix := getGroupBindingDescriptionPrefix(identityProvider.ResourceID)
+	current := make(map[groupBindingKey][]*store.UserMessage)
+	var bindings []*store.PolicyBinding
+	for _, binding := range policy.Bindings {
+		if binding.Condition == nil || binding.Condition.Expression != "" || !strings.HasPrefix(binding.Condition.Description, descriptionPrefix) {
+			bindings = append(bindings, binding)
+			continue
+		}
+		key := groupBindingKey{role: binding.Role, description: binding.Condition.Description}
+		if failed[key] {
+			bindings = append(bindings, binding)
+			continue
+		}
+		current[key] = append(current[key], binding.Members...)
+	}
+
+	var comments []string
+	for key, members := range desired {
+		if failed[key] {
+			continue
+		}
+		if len(members) > 0 {
+			bindings = append(bindings, &store.PolicyBinding{
+				Role:      key.role,
+				Members:   members,
+				Condition: &expr.Expr{Description: key.description},
+			})
+		}
+		if !equalMembers(current[key], members) {
+			comments = append(comments, fmt.Sprintf("Synced %d member(s) of %s as %s.", len(getMemberIDs(members)), key.description, key.role))
+		}
+	}
+	for key := range current {
+		if _, ok := desired[key]; !ok {
+			comments = append(comments, fmt.Sprintf("Removed %s as %s.", key.description, key.role))
+		}
+	}
+	if len(comments) == 0 {
+		return nil
+	}
+	sort.Strings(comments)
+
+	if _, err := s.store.SetProjectIAMPolicy(ctx, &store.IAMPolicyMessage{Bindings: bindings}, api.SystemBotID, project.UID); err != nil {
+		return errors.Wrap(err, "failed to set project policy")
+	}
+	if _, err := s.activityManager.CreateActivity(ctx, &store.ActivityMessage{
+		CreatorUID:   api.SystemBotID,
+		ContainerUID: project.UID,
+		Type:         api.ActivityProjectMemberCreate,
+		Level:        api.ActivityInfo,
+		Comment:      strings.Join(comments, " "),
+	}, &activity.Metadata{}); err != nil {
+		log.Warn("Failed to create project activity", zap.Error(err))
+	}
+	return nil
+}
+
+// getUserEmail returns the email of the user signed in with the identity provider.
+// It's the same as the one used when the user signs in.
+func getUserEmail(identityProvider *store.IdentityProviderMessage, userInfo *storepb.IdentityProviderUserInfo) string {
+	fieldMapping := identityProvider.Config.GetLdapConfig().FieldMapping
+	if fieldMapping.Identifier == fieldMapping.Email {
+		return strings.ToLower(userInfo.Email)
+	}
+	return strings.ToLower(fmt.Sprintf("%s@%s", userInfo.Identifier, identityProvider.Domain))
+}
+
+func getGroupBindingDescriptionPrefix(identityProviderID string) string {
+	return fmt.Sprintf("LDAP group of idps/%s: ", identityProviderID)
+}
+
+// getGroupBindingDescription returns the condition description of the binding owned by the group mapping.
+func getGroupBindingDescription(identityProviderID, group string) string {
+	return getGroupBindingDescriptionPrefix(identityProviderID) + group
+}
+
+func getMemberIDs(members []*store.UserMessage) map[int]bool {
+	ids := make(map[int]bool)
+	for _, member := range members {
+		ids[member.ID] = true
+	}
+	return ids
+}
+
+func equalMembers(a, b []*store.UserMessage) bool {
+	aIDs, bIDs := getMemberIDs(a), getMemberIDs(b)
+	if len(aIDs) != len(bIDs) {
+		return false
+	}
+	for id := range aIDs {
+		if !bIDs[id] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,37 @@
+package mail
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	api "github.com/bytebase/bytebase/backend/legacyapi"
+	"github.com/bytebase/bytebase/backend/store"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+// SendMail sends the HTML mail to every receiver with the workspace mail delivery setting.
+// It does nothing if the mail delivery is not configured.
+func SendMail(ctx context.Context, s *store.Store, receivers []string, subject string, body string) error {
+	name := api.SettingWorkspaceMailDelivery
+	mailSetting, err := s.GetSettingV2(ctx, &store.FindSettingMessage{Name: &name})
+	if err != nil {
+		return errors.Wrap(err, "failed to get mail setting")
+	}
+	if mailSetting == nil {
+		return nil
+	}
+	storeValue := &storepb.SMTPMailDeliverySetting{}
+	if err := protojson.Unmarshal([]byte(mailSetting.Value), storeValue); err != nil {
+		return errors.Wrap(err, "failed to unmarshal mail setting")
+	}
+	apiValue := convertStorepbToAPIMailDeliveryValue(storeValue)
+	for _, receiver := range receivers {
+		apiValue.SMTPTo = receiver
+		if err := send(apiValue, subject, body); err != nil {
+			return errors.Wrapf(err, "failed to send mail to %q", receiver)
+		}
+	}
+	return nil
+}
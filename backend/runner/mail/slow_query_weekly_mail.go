@@ -1,4 +1,4 @@
-// Package mail contains the slow query weekly mail sender.
+// Package mail contains the mail senders.
 package mail
 
 import (
@@ -404,7 +404,7 @@ func send(mailSetting *api.SettingWorkspaceMailDeliveryValue, subject string, bo
 	if err := client.SendMail(email); err != nil {
 		return err
 	}
-	log.Debug("Successfully sent need configure slow query policy email", zap.String("to", mailSetting.SMTPTo), zap.String("subject", subject))
+	log.Debug("Successfully sent email", zap.String("to", mailSetting.SMTPTo), zap.String("subject", subject))
 	return nil
 }
 
@@ -679,7 +679,7 @@ func (*SlowQueryWeeklyMailSender) sendNeedConfigSlowQueryPolicyEmail(mailSetting
 	if err := client.SendMail(email); err != nil {
 		return err
 	}
-	log.Debug("Successfully sent need configure slow query policy email", zap.String("to", mailSetting.SMTPTo))
+	log.Debug("Successfully sent email", zap.String("to", mailSetting.SMTPTo))
 	return nil
 }
 
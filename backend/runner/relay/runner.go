@@ -173,8 +173,10 @@ func getExternalApprovalByID(ctx context.Context, s *store.Store, externalApprov
 }
 
 func (r *Runner) cancelExternalApproval(ctx context.Context, issueUID int) {
+	externalApprovalType := api.ExternalApprovalTypeRelay
 	approvals, err := r.store.ListExternalApprovalV2(ctx, &store.ListExternalApprovalMessage{
 		IssueUID: &issueUID,
+		Type:     &externalApprovalType,
 	})
 	if err != nil {
 		log.Error("failed to list external approvals", zap.Error(err))
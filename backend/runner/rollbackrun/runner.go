@@ -300,7 +300,7 @@ func (r *Runner) generateMySQLRollbackSQLImpl(ctx context.Context, payload *api.
 	if err != nil {
 		return "", errors.WithMessage(err, "failed to parse the schema")
 	}
-	mysqlDriver, ok := driver.(*mysql.Driver)
+	mysqlDriver, ok := db.Unwrap(driver).(*mysql.Driver)
 	if !ok {
 		return "", errors.Errorf("failed to cast driver to mysql.Driver")
 	}
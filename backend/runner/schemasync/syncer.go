@@ -258,7 +258,13 @@ func (s *Syncer) SyncDatabaseSchema(ctx context.Context, database *store.Databas
 	if instance == nil {
 		return errors.Errorf("instance %q not found", database.InstanceID)
 	}
-	driver, err := s.dbFactory.GetAdminDatabaseDriver(ctx, instance, database)
+	// The periodic sync reads from the replica if there's one used for sync, so that the primary isn't hit.
+	// The forced sync reads from the primary because it's usually triggered by a change which may not be replicated yet.
+	getDriver := s.dbFactory.GetAdminDatabaseDriver
+	if !force {
+		getDriver = s.dbFactory.GetReplicaPreferredDatabaseDriver
+	}
+	driver, err := getDriver(ctx, instance, database)
 	if err != nil {
 		return err
 	}
@@ -9,6 +9,7 @@ import (
 	"github.com/bytebase/bytebase/backend/common"
 	"github.com/bytebase/bytebase/backend/component/dbfactory"
 	api "github.com/bytebase/bytebase/backend/legacyapi"
+	"github.com/bytebase/bytebase/backend/plugin/db"
 	"github.com/bytebase/bytebase/backend/plugin/db/mysql"
 	"github.com/bytebase/bytebase/backend/store"
 )
@@ -70,7 +71,7 @@ func (e *PITRMySQLExecutor) Run(ctx context.Context, _ *store.TaskCheckRunMessag
 		return nil, err
 	}
 	defer driver.Close(ctx)
-	mysqlDriver, ok := driver.(*mysql.Driver)
+	mysqlDriver, ok := db.Unwrap(driver).(*mysql.Driver)
 	if !ok {
 		return nil, errors.Errorf("Failed to cast driver to mysql.Driver")
 	}
@@ -124,6 +124,7 @@ func (s *Scheduler) Run(ctx context.Context, wg *sync.WaitGroup) {
 					s.stateCfg.RunningTaskChecks.Store(taskCheckRun.ID, true)
 					go func(taskCheckRun *store.TaskCheckRunMessage, task *store.TaskMessage) {
 						defer func() {
+							s.reportCommitStatus(ctx, task)
 							s.stateCfg.RunningTaskChecks.Delete(taskCheckRun.ID)
 							s.stateCfg.Lock()
 							s.stateCfg.InstanceOutstandingConnections[task.InstanceID]--
@@ -205,6 +206,19 @@ func (s *Scheduler) Run(ctx context.Context, wg *sync.WaitGroup) {
 	}
 }
 
+// reportCommitStatus reports the issue progress of the task to the VCS after the task check is done.
+func (s *Scheduler) reportCommitStatus(ctx context.Context, task *store.TaskMessage) {
+	issue, err := s.store.GetIssueV2(ctx, &store.FindIssueMessage{PipelineID: &task.PipelineID})
+	if err != nil {
+		log.Error("Failed to get issue for reporting the commit status", zap.Int("pipeline_id", task.PipelineID), zap.Error(err))
+		return
+	}
+	if issue == nil {
+		return
+	}
+	s.stateCfg.CommitStatusReporting.Store(issue.UID, issue)
+}
+
 // Register will register the task check executor.
 func (s *Scheduler) Register(taskType api.TaskCheckType, executor Executor) {
 	if executor == nil {
@@ -309,6 +323,14 @@ func (s *Scheduler) getTaskCheck(ctx context.Context, task *store.TaskMessage, c
 		createList = append(createList, create...)
 	}
 
+	create, err = getStatementLockReportTaskCheck(task, instance, creatorID)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to schedule statement lock report task check")
+	}
+	if create != nil {
+		createList = append(createList, create...)
+	}
+
 	return createList, nil
 }
 
@@ -429,6 +451,23 @@ func getStatementAffectedRowsReportTaskCheck(task *store.TaskMessage, instance *
 	}, nil
 }
 
+func getStatementLockReportTaskCheck(task *store.TaskMessage, instance *store.InstanceMessage, creatorID int) ([]*store.TaskCheckRunMessage, error) {
+	if !api.IsStatementLockReportSupported(instance.Engine) {
+		return nil, nil
+	}
+	if !api.IsTaskCheckReportNeededForTaskType(task.Type) {
+		return nil, nil
+	}
+
+	return []*store.TaskCheckRunMessage{
+		{
+			CreatorID: creatorID,
+			TaskID:    task.ID,
+			Type:      api.TaskCheckDatabaseStatementLockReport,
+		},
+	}, nil
+}
+
 // SchedulePipelineTaskCheck schedules the task checks for a pipeline.
 func (s *Scheduler) SchedulePipelineTaskCheck(ctx context.Context, pipelineID int) error {
 	var createList []*store.TaskCheckRunMessage
@@ -477,6 +516,14 @@ func (s *Scheduler) SchedulePipelineTaskCheckReport(ctx context.Context, pipelin
 		if create != nil {
 			createList = append(createList, create...)
 		}
+
+		create, err = getStatementLockReportTaskCheck(task, instance, api.SystemBotID)
+		if err != nil {
+			return errors.Wrap(err, "failed to schedule statement lock report task check")
+		}
+		if create != nil {
+			createList = append(createList, create...)
+		}
 	}
 	return s.store.CreateTaskCheckRun(ctx, createList...)
 }
@@ -151,7 +151,7 @@ func (e *StatementAdvisorCompositeExecutor) Run(ctx context.Context, taskCheckRu
 			status = api.TaskCheckStatusError
 		}
 
-		result = append(result, api.TaskCheckResult{
+		checkResult := api.TaskCheckResult{
 			Status:    status,
 			Namespace: api.AdvisorNamespace,
 			Code:      advice.Code.Int(),
@@ -159,7 +159,12 @@ func (e *StatementAdvisorCompositeExecutor) Run(ctx context.Context, taskCheckRu
 			Content:   advice.Content,
 			Line:      advice.Line,
 			Details:   advice.Details,
-		})
+		}
+		// The fix edits are based on the rendered statement, so they're only valid if nothing is rendered.
+		if renderedStatement == statement {
+			checkResult.Fix = advice.Fix
+		}
+		result = append(result, checkResult)
 	}
 
 	if len(result) == 0 {
@@ -0,0 +1,723 @@
+package taskcheck
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	tidbparser "github.com/pingcap/tidb/parser"
+	tidbast "github.com/pingcap/tidb/parser/ast"
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/backend/common"
+	api "github.com/bytebase/bytebase/backend/legacyapi"
+	"github.com/bytebase/bytebase/backend/plugin/advisor"
+	"github.com/bytebase/bytebase/backend/plugin/db"
+	parser "github.com/bytebase/bytebase/backend/plugin/parser/sql"
+	"github.com/bytebase/bytebase/backend/plugin/parser/sql/ast"
+	"github.com/bytebase/bytebase/backend/store"
+	"github.com/bytebase/bytebase/backend/utils"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+// NewStatementLockReportExecutor creates a task check statement lock report executor.
+func NewStatementLockReportExecutor(store *store.Store) Executor {
+	return &StatementLockReportExecutor{
+		store: store,
+	}
+}
+
+// StatementLockReportExecutor is the task check statement lock report executor.
+// It reports the lock mode, the rewrite behavior and the estimated rows touched of each statement.
+type StatementLockReportExecutor struct {
+	store *store.Store
+}
+
+// Run will run the task check statement lock report executor once.
+func (s *StatementLockReportExecutor) Run(ctx context.Context, _ *store.TaskCheckRunMessage, task *store.TaskMessage) ([]api.TaskCheckResult, error) {
+	if !api.IsTaskCheckReportNeededForTaskType(task.Type) {
+		return nil, nil
+	}
+	payload := &TaskPayload{}
+	if err := json.Unmarshal([]byte(task.Payload), payload); err != nil {
+		return nil, err
+	}
+	instance, err := s.store.GetInstanceV2(ctx, &store.FindInstanceMessage{UID: &task.InstanceID})
+	if err != nil {
+		return nil, err
+	}
+	if !api.IsStatementLockReportSupported(instance.Engine) {
+		return nil, nil
+	}
+	sheet, err := s.store.GetSheetV2(ctx, &store.FindSheetMessage{UID: &payload.SheetID}, api.SystemBotID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get sheet %d", payload.SheetID)
+	}
+	if sheet == nil {
+		return nil, errors.Errorf("sheet %d not found", payload.SheetID)
+	}
+	if sheet.Size > common.MaxSheetSizeForTaskCheck {
+		return []api.TaskCheckResult{
+			{
+				Status:    api.TaskCheckStatusSuccess,
+				Namespace: api.AdvisorNamespace,
+				Code:      common.Ok.Int(),
+				Title:     "Lock report is skipped for large SQL",
+				Content:   fmt.Sprintf("The statement lock report is not generated for SQL larger than %d bytes", common.MaxSheetSizeForTaskCheck),
+			},
+		}, nil
+	}
+	statement, err := s.store.GetSheetStatementByID(ctx, payload.SheetID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get sheet statement %d", payload.SheetID)
+	}
+	if task.DatabaseID == nil {
+		return nil, nil
+	}
+	dbSchema, err := s.store.GetDBSchema(ctx, *task.DatabaseID)
+	if err != nil {
+		return nil, err
+	}
+	database, err := s.store.GetDatabaseV2(ctx, &store.FindDatabaseMessage{UID: task.DatabaseID})
+	if err != nil {
+		return nil, err
+	}
+	materials := utils.GetSecretMapFromDatabaseMessage(database)
+	// To avoid leaking the rendered statement, the error message should use the original statement and not the rendered statement.
+	renderedStatement := utils.RenderStatement(statement, materials)
+
+	var metadata *storepb.DatabaseMetadata
+	if dbSchema != nil {
+		metadata = dbSchema.Metadata
+	}
+	switch instance.Engine {
+	case db.Postgres:
+		return reportStatementLockForPostgres(renderedStatement, instance.EngineVersion, metadata)
+	case db.MySQL:
+		return reportStatementLockForMySQL(renderedStatement, instance.EngineVersion, metadata)
+	default:
+		return nil, errors.New("unsupported db type")
+	}
+}
+
+// parseMajorMinorPatch parses the leading numeric parts of the server version such as "8.0.33-log" or "14.5 (Debian 14.5-1)".
+// The missing or unparsable parts are zero, so an unknown version is analyzed with the most conservative assumption.
+func parseMajorMinorPatch(version string) [3]int {
+	var result [3]int
+	version = strings.TrimSpace(version)
+	if i := strings.IndexFunc(version, func(r rune) bool { return r != '.' && (r < '0' || r > '9') }); i >= 0 {
+		version = version[:i]
+	}
+	for i, part := range strings.SplitN(version, ".", 3) {
+		n, err := strconv.Atoi(part)
+		if err != nil {
+			break
+		}
+		result[i] = n
+	}
+	return result
+}
+
+func versionAtLeast(version [3]int, major, minor, patch int) bool {
+	target := [3]int{major, minor, patch}
+	for i := range version {
+		if version[i] != target[i] {
+			return version[i] > target[i]
+		}
+	}
+	return true
+}
+
+func getTableRowCount(metadata *storepb.DatabaseMetadata, schemaName, tableName string) int64 {
+	if metadata == nil {
+		return 0
+	}
+	for _, schema := range metadata.Schemas {
+		if schema.Name != schemaName {
+			continue
+		}
+		for _, table := range schema.Tables {
+			if table.Name == tableName {
+				return table.RowCount
+			}
+		}
+	}
+	return 0
+}
+
+func convertStatementLockReportToResult(report *api.StatementLockReport) (api.TaskCheckResult, error) {
+	details, err := json.Marshal(report)
+	if err != nil {
+		return api.TaskCheckResult{}, errors.Wrap(err, "failed to marshal statement lock report")
+	}
+	status := api.TaskCheckStatusSuccess
+	title := "OK"
+	if report.BlockingRows() > 0 || (report.BlockWrites && report.Rewrite) {
+		status = api.TaskCheckStatusWarn
+		title = "Long blocking lock"
+	}
+	return api.TaskCheckResult{
+		Status:    status,
+		Namespace: api.BBNamespace,
+		Code:      common.Ok.Int(),
+		Title:     title,
+		Content:   summarizeStatementLockReport(report),
+		Details:   string(details),
+	}, nil
+}
+
+func summarizeStatementLockReport(report *api.StatementLockReport) string {
+	if report.LockMode == "" {
+		return "No lock on existing tables"
+	}
+	var parts []string
+	if report.Algorithm != "" {
+		parts = append(parts, fmt.Sprintf("ALGORITHM=%s, LOCK=%s", report.Algorithm, report.LockMode))
+	} else {
+		parts = append(parts, fmt.Sprintf("%s lock", report.LockMode))
+	}
+	if report.Table != "" {
+		parts[0] += fmt.Sprintf(" on %s", report.Table)
+	}
+	switch {
+	case report.BlockReads:
+		parts = append(parts, "blocks reads and writes")
+	case report.BlockWrites:
+		parts = append(parts, "blocks writes")
+	default:
+		parts = append(parts, "doesn't block reads or writes")
+	}
+	if report.Rewrite {
+		parts = append(parts, "rewrites the table")
+	} else if report.Scan {
+		parts = append(parts, "scans the table")
+	}
+	if report.EstimatedRows > 0 {
+		parts = append(parts, fmt.Sprintf("about %d rows", report.EstimatedRows))
+	}
+	return strings.Join(parts, ", ")
+}
+
+// pgLockMode is the PostgreSQL table-level lock mode, ordered by strength.
+// See https://www.postgresql.org/docs/current/explicit-locking.html#LOCKING-TABLES.
+type pgLockMode int
+
+const (
+	pgLockModeNone pgLockMode = iota
+	pgLockModeAccessShare
+	pgLockModeRowShare
+	pgLockModeRowExclusive
+	pgLockModeShareUpdateExclusive
+	pgLockModeShare
+	pgLockModeShareRowExclusive
+	pgLockModeExclusive
+	pgLockModeAccessExclusive
+)
+
+func (m pgLockMode) String() string {
+	switch m {
+	case pgLockModeAccessShare:
+		return "ACCESS SHARE"
+	case pgLockModeRowShare:
+		return "ROW SHARE"
+	case pgLockModeRowExclusive:
+		return "ROW EXCLUSIVE"
+	case pgLockModeShareUpdateExclusive:
+		return "SHARE UPDATE EXCLUSIVE"
+	case pgLockModeShare:
+		return "SHARE"
+	case pgLockModeShareRowExclusive:
+		return "SHARE ROW EXCLUSIVE"
+	case pgLockModeExclusive:
+		return "EXCLUSIVE"
+	case pgLockModeAccessExclusive:
+		return "ACCESS EXCLUSIVE"
+	default:
+		return ""
+	}
+}
+
+type pgLockAnalysis struct {
+	mode    pgLockMode
+	rewrite bool
+	scan    bool
+}
+
+func (a *pgLockAnalysis) merge(mode pgLockMode, rewrite, scan bool) {
+	if mode > a.mode {
+		a.mode = mode
+	}
+	a.rewrite = a.rewrite || rewrite
+	a.scan = a.scan || scan
+}
+
+var (
+	dropIndexConcurrentlyReg = regexp.MustCompile(`(?i)^\s*DROP\s+INDEX\s+CONCURRENTLY\b`)
+	// The functions whose results differ per row, so using them as the column default forces a table rewrite.
+	pgVolatileDefaultReg = regexp.MustCompile(`(?i)\b(random|clock_timestamp|timeofday|statement_timestamp|nextval|gen_random_uuid|uuid_generate_v1|uuid_generate_v1mc|uuid_generate_v4|txid_current)\s*\(`)
+)
+
+func reportStatementLockForPostgres(statement string, version string, metadata *storepb.DatabaseMetadata) ([]api.TaskCheckResult, error) {
+	stmts, err := parser.Parse(parser.Postgres, parser.ParseContext{}, statement)
+	if err != nil {
+		// nolint:nilerr
+		return []api.TaskCheckResult{
+			{
+				Status:    api.TaskCheckStatusError,
+				Namespace: api.AdvisorNamespace,
+				Code:      advisor.StatementSyntaxError.Int(),
+				Title:     "Syntax error",
+				Content:   err.Error(),
+			},
+		}, nil
+	}
+
+	serverVersion := parseMajorMinorPatch(version)
+	var result []api.TaskCheckResult
+	for _, stmt := range stmts {
+		report := analyzePostgresStatementLock(stmt, serverVersion)
+		if table := getPostgresLockedTable(stmt); table != nil && report.LockMode != "" {
+			schemaName := table.Schema
+			if schemaName == "" {
+				schemaName = "public"
+			}
+			report.Table = fmt.Sprintf("%q.%q", schemaName, table.Name)
+			report.EstimatedRows = getTableRowCount(metadata, schemaName, table.Name)
+		}
+		checkResult, err := convertStatementLockReportToResult(report)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, checkResult)
+	}
+	return result, nil
+}
+
+func analyzePostgresStatementLock(node ast.Node, version [3]int) *api.StatementLockReport {
+	analysis := &pgLockAnalysis{}
+	switch node := node.(type) {
+	case *ast.CreateIndexStmt:
+		if node.Concurrently {
+			analysis.merge(pgLockModeShareUpdateExclusive, false, true)
+		} else {
+			analysis.merge(pgLockModeShare, false, true)
+		}
+	case *ast.DropIndexStmt:
+		if dropIndexConcurrentlyReg.MatchString(node.Text()) {
+			analysis.merge(pgLockModeShareUpdateExclusive, false, false)
+		} else {
+			analysis.merge(pgLockModeAccessExclusive, false, false)
+		}
+	case *ast.RenameIndexStmt:
+		// Renaming an index takes SHARE UPDATE EXCLUSIVE lock since PostgreSQL 12.
+		if versionAtLeast(version, 12, 0, 0) {
+			analysis.merge(pgLockModeShareUpdateExclusive, false, false)
+		} else {
+			analysis.merge(pgLockModeAccessExclusive, false, false)
+		}
+	case *ast.AlterTableStmt:
+		for _, item := range node.AlterItemList {
+			analyzePostgresAlterItemLock(analysis, item, version)
+		}
+		if len(node.AlterItemList) == 0 {
+			analysis.merge(pgLockModeAccessExclusive, false, false)
+		}
+	case *ast.DropTableStmt, *ast.RenameTableStmt:
+		analysis.merge(pgLockModeAccessExclusive, false, false)
+	case *ast.InsertStmt, *ast.UpdateStmt, *ast.DeleteStmt:
+		analysis.merge(pgLockModeRowExclusive, false, false)
+	default:
+		analyzePostgresAlterItemLock(analysis, node, version)
+	}
+
+	return &api.StatementLockReport{
+		LockMode:    analysis.mode.String(),
+		BlockReads:  analysis.mode >= pgLockModeAccessExclusive,
+		BlockWrites: analysis.mode >= pgLockModeShare,
+		Rewrite:     analysis.rewrite,
+		Scan:        analysis.scan,
+	}
+}
+
+// analyzePostgresAlterItemLock analyzes the sub-command of ALTER TABLE.
+// See https://www.postgresql.org/docs/current/sql-altertable.html.
+func analyzePostgresAlterItemLock(analysis *pgLockAnalysis, item ast.Node, version [3]int) {
+	switch item := item.(type) {
+	case *ast.AddColumnListStmt:
+		analysis.merge(pgLockModeAccessExclusive, false, false)
+		for _, column := range item.ColumnList {
+			if _, ok := column.Type.(*ast.Serial); ok {
+				analysis.merge(pgLockModeAccessExclusive, true, false)
+			}
+			for _, constraint := range column.ConstraintList {
+				switch constraint.Type {
+				case ast.ConstraintTypeDefault:
+					// Since PostgreSQL 11, adding a column with a non-volatile default only changes the catalog.
+					volatile := constraint.Expression != nil && pgVolatileDefaultReg.MatchString(constraint.Expression.Text())
+					if volatile || !versionAtLeast(version, 11, 0, 0) {
+						analysis.merge(pgLockModeAccessExclusive, true, false)
+					}
+				case ast.ConstraintTypePrimary, ast.ConstraintTypeUnique, ast.ConstraintTypeCheck, ast.ConstraintTypeExclusion:
+					analysis.merge(pgLockModeAccessExclusive, false, true)
+				}
+			}
+		}
+	case *ast.AlterColumnTypeStmt:
+		analysis.merge(pgLockModeAccessExclusive, true, false)
+	case *ast.SetNotNullStmt:
+		analysis.merge(pgLockModeAccessExclusive, false, true)
+	case *ast.AddConstraintStmt:
+		switch item.Constraint.Type {
+		case ast.ConstraintTypeForeign:
+			analysis.merge(pgLockModeShareRowExclusive, false, !item.Constraint.SkipValidation)
+		case ast.ConstraintTypeCheck:
+			analysis.merge(pgLockModeAccessExclusive, false, !item.Constraint.SkipValidation)
+		case ast.ConstraintTypePrimary, ast.ConstraintTypeUnique, ast.ConstraintTypeExclusion:
+			analysis.merge(pgLockModeAccessExclusive, false, true)
+		default:
+			analysis.merge(pgLockModeAccessExclusive, false, false)
+		}
+	case *ast.DropColumnStmt, *ast.DropConstraintStmt, *ast.SetDefaultStmt, *ast.DropDefaultStmt,
+		*ast.DropNotNullStmt, *ast.RenameColumnStmt, *ast.RenameConstraintStmt, *ast.RenameTableStmt:
+		analysis.merge(pgLockModeAccessExclusive, false, false)
+	}
+}
+
+func getPostgresLockedTable(node ast.Node) *ast.TableDef {
+	switch node := node.(type) {
+	case *ast.CreateIndexStmt:
+		return node.Index.Table
+	case *ast.DropIndexStmt:
+		if len(node.IndexList) == 1 {
+			return node.IndexList[0].Table
+		}
+	case *ast.AlterTableStmt:
+		return node.Table
+	case *ast.DropTableStmt:
+		if len(node.TableList) == 1 {
+			return node.TableList[0]
+		}
+	case *ast.RenameTableStmt:
+		return node.Table
+	case *ast.InsertStmt:
+		return node.Table
+	case *ast.UpdateStmt:
+		return node.Table
+	case *ast.DeleteStmt:
+		return node.Table
+	}
+	return nil
+}
+
+// mysqlDDLAlgorithm is the MySQL online DDL algorithm, ordered by cost.
+// See https://dev.mysql.com/doc/refman/8.0/en/innodb-online-ddl-operations.html.
+type mysqlDDLAlgorithm int
+
+const (
+	mysqlAlgorithmNone mysqlDDLAlgorithm = iota
+	mysqlAlgorithmInstant
+	mysqlAlgorithmInplace
+	mysqlAlgorithmCopy
+)
+
+func (a mysqlDDLAlgorithm) String() string {
+	switch a {
+	case mysqlAlgorithmInstant:
+		return "INSTANT"
+	case mysqlAlgorithmInplace:
+		return "INPLACE"
+	case mysqlAlgorithmCopy:
+		return "COPY"
+	default:
+		return ""
+	}
+}
+
+// mysqlDDLLock is the metadata lock level held during the DDL, ordered by strength.
+type mysqlDDLLock int
+
+const (
+	mysqlLockUnknown mysqlDDLLock = iota
+	mysqlLockNone
+	mysqlLockShared
+	mysqlLockExclusive
+)
+
+func (l mysqlDDLLock) String() string {
+	switch l {
+	case mysqlLockNone:
+		return "NONE"
+	case mysqlLockShared:
+		return "SHARED"
+	case mysqlLockExclusive:
+		return "EXCLUSIVE"
+	default:
+		return ""
+	}
+}
+
+type mysqlLockAnalysis struct {
+	algorithm mysqlDDLAlgorithm
+	lock      mysqlDDLLock
+	rebuild   bool
+	scan      bool
+}
+
+func (a *mysqlLockAnalysis) merge(algorithm mysqlDDLAlgorithm, lock mysqlDDLLock, rebuild, scan bool) {
+	if algorithm > a.algorithm {
+		a.algorithm = algorithm
+	}
+	if lock > a.lock {
+		a.lock = lock
+	}
+	a.rebuild = a.rebuild || rebuild
+	a.scan = a.scan || scan
+}
+
+// override applies the ALGORITHM and LOCK clauses specified in the statement.
+func (a *mysqlLockAnalysis) override(algorithm tidbast.AlgorithmType, lock tidbast.LockType) {
+	switch algorithm {
+	case tidbast.AlgorithmTypeCopy:
+		// The COPY algorithm doesn't permit concurrent DML.
+		a.algorithm = mysqlAlgorithmCopy
+		a.rebuild = true
+		if a.lock < mysqlLockShared {
+			a.lock = mysqlLockShared
+		}
+	case tidbast.AlgorithmTypeInplace:
+		if a.algorithm < mysqlAlgorithmInplace {
+			a.algorithm = mysqlAlgorithmInplace
+		}
+	}
+	switch lock {
+	case tidbast.LockTypeShared:
+		if a.lock < mysqlLockShared {
+			a.lock = mysqlLockShared
+		}
+	case tidbast.LockTypeExclusive:
+		a.lock = mysqlLockExclusive
+	}
+}
+
+func reportStatementLockForMySQL(statement string, version string, metadata *storepb.DatabaseMetadata) ([]api.TaskCheckResult, error) {
+	singleSQLs, err := parser.SplitMultiSQL(parser.MySQL, statement)
+	if err != nil {
+		// nolint:nilerr
+		return []api.TaskCheckResult{
+			{
+				Status:    api.TaskCheckStatusError,
+				Namespace: api.AdvisorNamespace,
+				Code:      advisor.StatementSyntaxError.Int(),
+				Title:     "Syntax error",
+				Content:   err.Error(),
+			},
+		}, nil
+	}
+
+	serverVersion := parseMajorMinorPatch(version)
+	var result []api.TaskCheckResult
+
+	var charset, collation string
+	if metadata != nil {
+		charset, collation = metadata.CharacterSet, metadata.Collation
+	}
+	p := tidbparser.New()
+	p.EnableWindowFunc(true)
+
+	for _, stmt := range singleSQLs {
+		if stmt.Empty {
+			continue
+		}
+		if parser.IsTiDBUnsupportDDLStmt(stmt.Text) {
+			result = append(result, api.TaskCheckResult{
+				Status:    api.TaskCheckStatusSuccess,
+				Namespace: api.BBNamespace,
+				Code:      common.Ok.Int(),
+				Title:     "OK",
+				Content:   "UNKNOWN",
+			})
+			continue
+		}
+		root, _, err := p.Parse(stmt.Text, charset, collation)
+		if err != nil {
+			result = append(result, api.TaskCheckResult{
+				Status:    api.TaskCheckStatusError,
+				Namespace: api.AdvisorNamespace,
+				Code:      advisor.StatementSyntaxError.Int(),
+				Title:     "Syntax error",
+				Content:   err.Error(),
+			})
+			continue
+		}
+		if len(root) != 1 {
+			result = append(result, api.TaskCheckResult{
+				Status:    api.TaskCheckStatusError,
+				Namespace: api.BBNamespace,
+				Code:      common.Internal.Int(),
+				Title:     "Failed to report statement lock",
+				Content:   "Expect to get one node from parser",
+			})
+			continue
+		}
+		report := analyzeMySQLStatementLock(root[0], serverVersion)
+		if table := getMySQLLockedTable(root[0]); table != nil && report.LockMode != "" {
+			report.Table = fmt.Sprintf("`%s`", table.Name.O)
+			report.EstimatedRows = getTableRowCount(metadata, "", table.Name.O)
+		}
+		checkResult, err := convertStatementLockReportToResult(report)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, checkResult)
+	}
+
+	return result, nil
+}
+
+func analyzeMySQLStatementLock(node tidbast.StmtNode, version [3]int) *api.StatementLockReport {
+	analysis := &mysqlLockAnalysis{}
+	switch node := node.(type) {
+	case *tidbast.CreateIndexStmt:
+		if node.KeyType == tidbast.IndexKeyTypeFullText {
+			analysis.merge(mysqlAlgorithmInplace, mysqlLockShared, false, true)
+		} else {
+			analysis.merge(mysqlAlgorithmInplace, mysqlLockNone, false, true)
+		}
+		if node.LockAlg != nil {
+			analysis.override(node.LockAlg.AlgorithmTp, node.LockAlg.LockTp)
+		}
+	case *tidbast.DropIndexStmt:
+		analysis.merge(mysqlAlgorithmInplace, mysqlLockNone, false, false)
+		if node.LockAlg != nil {
+			analysis.override(node.LockAlg.AlgorithmTp, node.LockAlg.LockTp)
+		}
+	case *tidbast.AlterTableStmt:
+		for _, spec := range node.Specs {
+			analyzeMySQLAlterTableSpecLock(analysis, spec, version)
+		}
+		for _, spec := range node.Specs {
+			switch spec.Tp {
+			case tidbast.AlterTableAlgorithm:
+				analysis.override(spec.Algorithm, tidbast.LockTypeDefault)
+			case tidbast.AlterTableLock:
+				analysis.override(tidbast.AlgorithmTypeDefault, spec.LockType)
+			}
+		}
+	case *tidbast.DropTableStmt, *tidbast.TruncateTableStmt, *tidbast.RenameTableStmt:
+		analysis.merge(mysqlAlgorithmNone, mysqlLockExclusive, false, false)
+	case *tidbast.InsertStmt, *tidbast.UpdateStmt, *tidbast.DeleteStmt:
+		// DML only takes the shared metadata lock and row locks.
+		analysis.merge(mysqlAlgorithmNone, mysqlLockNone, false, false)
+	}
+
+	return &api.StatementLockReport{
+		LockMode:    analysis.lock.String(),
+		Algorithm:   analysis.algorithm.String(),
+		BlockReads:  analysis.lock >= mysqlLockExclusive,
+		BlockWrites: analysis.lock >= mysqlLockShared,
+		Rewrite:     analysis.rebuild,
+		Scan:        analysis.scan,
+	}
+}
+
+// analyzeMySQLAlterTableSpecLock analyzes the ALTER TABLE specification for InnoDB.
+// See https://dev.mysql.com/doc/refman/8.0/en/innodb-online-ddl-operations.html.
+func analyzeMySQLAlterTableSpecLock(analysis *mysqlLockAnalysis, spec *tidbast.AlterTableSpec, version [3]int) {
+	switch spec.Tp {
+	case tidbast.AlterTableAddColumns:
+		for _, column := range spec.NewColumns {
+			for _, option := range column.Options {
+				if option.Tp == tidbast.ColumnOptionAutoIncrement {
+					analysis.merge(mysqlAlgorithmCopy, mysqlLockShared, true, false)
+					return
+				}
+			}
+		}
+		atEnd := spec.Position == nil || spec.Position.Tp == tidbast.ColumnPositionNone
+		// MySQL 8.0.12 supports adding the last column instantly, and MySQL 8.0.29 supports adding a column at any position instantly.
+		if versionAtLeast(version, 8, 0, 29) || (atEnd && versionAtLeast(version, 8, 0, 12)) {
+			analysis.merge(mysqlAlgorithmInstant, mysqlLockNone, false, false)
+		} else {
+			analysis.merge(mysqlAlgorithmInplace, mysqlLockNone, true, false)
+		}
+	case tidbast.AlterTableDropColumn:
+		if versionAtLeast(version, 8, 0, 29) {
+			analysis.merge(mysqlAlgorithmInstant, mysqlLockNone, false, false)
+		} else {
+			analysis.merge(mysqlAlgorithmInplace, mysqlLockNone, true, false)
+		}
+	case tidbast.AlterTableRenameColumn:
+		if versionAtLeast(version, 8, 0, 28) {
+			analysis.merge(mysqlAlgorithmInstant, mysqlLockNone, false, false)
+		} else {
+			analysis.merge(mysqlAlgorithmInplace, mysqlLockNone, false, false)
+		}
+	case tidbast.AlterTableAlterColumn:
+		if versionAtLeast(version, 8, 0, 0) {
+			analysis.merge(mysqlAlgorithmInstant, mysqlLockNone, false, false)
+		} else {
+			analysis.merge(mysqlAlgorithmInplace, mysqlLockNone, false, false)
+		}
+	case tidbast.AlterTableModifyColumn, tidbast.AlterTableChangeColumn:
+		// Without the column definition, we can't tell if only the name or the comment changes,
+		// so assume the data type changes, which requires a table copy.
+		analysis.merge(mysqlAlgorithmCopy, mysqlLockShared, true, false)
+	case tidbast.AlterTableAddConstraint:
+		switch spec.Constraint.Tp {
+		case tidbast.ConstraintPrimaryKey:
+			analysis.merge(mysqlAlgorithmInplace, mysqlLockNone, true, false)
+		case tidbast.ConstraintForeignKey, tidbast.ConstraintCheck:
+			analysis.merge(mysqlAlgorithmCopy, mysqlLockShared, true, false)
+		case tidbast.ConstraintFulltext:
+			analysis.merge(mysqlAlgorithmInplace, mysqlLockShared, false, true)
+		default:
+			analysis.merge(mysqlAlgorithmInplace, mysqlLockNone, false, true)
+		}
+	case tidbast.AlterTableDropPrimaryKey:
+		analysis.merge(mysqlAlgorithmCopy, mysqlLockShared, true, false)
+	case tidbast.AlterTableDropIndex, tidbast.AlterTableDropForeignKey, tidbast.AlterTableRenameIndex:
+		analysis.merge(mysqlAlgorithmInplace, mysqlLockNone, false, false)
+	case tidbast.AlterTableRenameTable:
+		analysis.merge(mysqlAlgorithmInstant, mysqlLockNone, false, false)
+	case tidbast.AlterTableAlgorithm, tidbast.AlterTableLock:
+		// Handled after analyzing all specifications.
+	case tidbast.AlterTableOption:
+		for _, option := range spec.Options {
+			switch {
+			case option.Tp == tidbast.TableOptionCharset && option.UintValue == tidbast.TableOptionCharsetWithConvertTo:
+				analysis.merge(mysqlAlgorithmCopy, mysqlLockShared, true, false)
+			case option.Tp == tidbast.TableOptionEngine:
+				analysis.merge(mysqlAlgorithmInplace, mysqlLockNone, true, false)
+			default:
+				analysis.merge(mysqlAlgorithmInplace, mysqlLockNone, false, false)
+			}
+		}
+	default:
+		analysis.merge(mysqlAlgorithmCopy, mysqlLockShared, true, false)
+	}
+}
+
+func getMySQLLockedTable(node tidbast.StmtNode) *tidbast.TableName {
+	switch node := node.(type) {
+	case *tidbast.CreateIndexStmt:
+		return node.Table
+	case *tidbast.DropIndexStmt:
+		return node.Table
+	case *tidbast.AlterTableStmt:
+		return node.Table
+	case *tidbast.TruncateTableStmt:
+		return node.Table
+	case *tidbast.DropTableStmt:
+		if len(node.Tables) == 1 {
+			return node.Tables[0]
+		}
+	case *tidbast.RenameTableStmt:
+		if len(node.TableToTables) == 1 {
+			return node.TableToTables[0].OldTable
+		}
+	}
+	return nil
+}
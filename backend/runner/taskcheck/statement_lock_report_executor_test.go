@@ -0,0 +1,141 @@
+package taskcheck
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	api "github.com/bytebase/bytebase/backend/legacyapi"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+func TestReportStatementLockForPostgres(t *testing.T) {
+	metadata := &storepb.DatabaseMetadata{
+		Schemas: []*storepb.SchemaMetadata{
+			{Name: "public", Tables: []*storepb.TableMetadata{{Name: "t", RowCount: 1000}}},
+		},
+	}
+	table := `"public"."t"`
+	tests := []struct {
+		stmt    string
+		version string
+		want    api.StatementLockReport
+	}{
+		{
+			stmt:    "CREATE INDEX idx ON t(a);",
+			version: "14.5",
+			want:    api.StatementLockReport{LockMode: "SHARE", BlockWrites: true, Scan: true, Table: table, EstimatedRows: 1000},
+		},
+		{
+			stmt:    "CREATE INDEX CONCURRENTLY idx ON t(a);",
+			version: "14.5",
+			want:    api.StatementLockReport{LockMode: "SHARE UPDATE EXCLUSIVE", Scan: true, Table: table, EstimatedRows: 1000},
+		},
+		{
+			stmt:    "ALTER TABLE t ADD COLUMN a int DEFAULT 1;",
+			version: "14.5",
+			want:    api.StatementLockReport{LockMode: "ACCESS EXCLUSIVE", BlockReads: true, BlockWrites: true, Table: table, EstimatedRows: 1000},
+		},
+		{
+			stmt:    "ALTER TABLE t ADD COLUMN a int DEFAULT 1;",
+			version: "10.2",
+			want:    api.StatementLockReport{LockMode: "ACCESS EXCLUSIVE", BlockReads: true, BlockWrites: true, Rewrite: true, Table: table, EstimatedRows: 1000},
+		},
+		{
+			stmt:    "ALTER TABLE t ADD COLUMN a uuid DEFAULT gen_random_uuid();",
+			version: "14.5",
+			want:    api.StatementLockReport{LockMode: "ACCESS EXCLUSIVE", BlockReads: true, BlockWrites: true, Rewrite: true, Table: table, EstimatedRows: 1000},
+		},
+		{
+			stmt:    "ALTER TABLE t ADD CONSTRAINT c CHECK (a > 0) NOT VALID;",
+			version: "14.5",
+			want:    api.StatementLockReport{LockMode: "ACCESS EXCLUSIVE", BlockReads: true, BlockWrites: true, Table: table, EstimatedRows: 1000},
+		},
+		{
+			stmt:    "ALTER TABLE s.t ADD CONSTRAINT f FOREIGN KEY (a) REFERENCES x(b);",
+			version: "14.5",
+			want:    api.StatementLockReport{LockMode: "SHARE ROW EXCLUSIVE", BlockWrites: true, Scan: true, Table: `"s"."t"`},
+		},
+		{
+			stmt:    "UPDATE t SET a = 1;",
+			version: "14.5",
+			want:    api.StatementLockReport{LockMode: "ROW EXCLUSIVE", Table: table, EstimatedRows: 1000},
+		},
+		{
+			stmt:    "CREATE TABLE t2(a int);",
+			version: "14.5",
+			want:    api.StatementLockReport{},
+		},
+	}
+
+	for _, test := range tests {
+		result, err := reportStatementLockForPostgres(test.stmt, test.version, metadata)
+		require.NoError(t, err)
+		require.Len(t, result, 1, test.stmt)
+		var report api.StatementLockReport
+		require.NoError(t, json.Unmarshal([]byte(result[0].Details), &report))
+		require.Equal(t, test.want, report, test.stmt)
+	}
+}
+
+func TestReportStatementLockForMySQL(t *testing.T) {
+	metadata := &storepb.DatabaseMetadata{
+		Schemas: []*storepb.SchemaMetadata{
+			{Name: "", Tables: []*storepb.TableMetadata{{Name: "t", RowCount: 1000}}},
+		},
+	}
+	tests := []struct {
+		stmt    string
+		version string
+		want    api.StatementLockReport
+		status  api.TaskCheckStatus
+	}{
+		{
+			stmt:    "ALTER TABLE t ADD COLUMN a int;",
+			version: "8.0.33-log",
+			want:    api.StatementLockReport{LockMode: "NONE", Algorithm: "INSTANT", Table: "`t`", EstimatedRows: 1000},
+			status:  api.TaskCheckStatusSuccess,
+		},
+		{
+			stmt:    "ALTER TABLE t ADD COLUMN a int FIRST;",
+			version: "8.0.20",
+			want:    api.StatementLockReport{LockMode: "NONE", Algorithm: "INPLACE", Rewrite: true, Table: "`t`", EstimatedRows: 1000},
+			status:  api.TaskCheckStatusSuccess,
+		},
+		{
+			stmt:    "ALTER TABLE t MODIFY COLUMN a bigint;",
+			version: "8.0.33",
+			want:    api.StatementLockReport{LockMode: "SHARED", Algorithm: "COPY", BlockWrites: true, Rewrite: true, Table: "`t`", EstimatedRows: 1000},
+			status:  api.TaskCheckStatusWarn,
+		},
+		{
+			stmt:    "ALTER TABLE t ADD INDEX i(a), ALGORITHM=COPY;",
+			version: "8.0.33",
+			want:    api.StatementLockReport{LockMode: "SHARED", Algorithm: "COPY", BlockWrites: true, Rewrite: true, Scan: true, Table: "`t`", EstimatedRows: 1000},
+			status:  api.TaskCheckStatusWarn,
+		},
+		{
+			stmt:    "CREATE INDEX i ON t(a);",
+			version: "5.7.40",
+			want:    api.StatementLockReport{LockMode: "NONE", Algorithm: "INPLACE", Scan: true, Table: "`t`", EstimatedRows: 1000},
+			status:  api.TaskCheckStatusSuccess,
+		},
+		{
+			stmt:    "RENAME TABLE t TO t2;",
+			version: "5.7.40",
+			want:    api.StatementLockReport{LockMode: "EXCLUSIVE", BlockReads: true, BlockWrites: true, Table: "`t`", EstimatedRows: 1000},
+			status:  api.TaskCheckStatusSuccess,
+		},
+	}
+
+	for _, test := range tests {
+		result, err := reportStatementLockForMySQL(test.stmt, test.version, metadata)
+		require.NoError(t, err)
+		require.Len(t, result, 1, test.stmt)
+		require.Equal(t, test.status, result[0].Status, test.stmt)
+		var report api.StatementLockReport
+		require.NoError(t, json.Unmarshal([]byte(result[0].Details), &report))
+		require.Equal(t, test.want, report, test.stmt)
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"path/filepath"
 	"strconv"
 	"strings"
+	"sync"
 
 	"github.com/gosimple/slug"
 	"github.com/pkg/errors"
@@ -211,6 +212,7 @@ func executeMigration(ctx context.Context, stores *store.Store, dbFactory *dbfac
 		if err != nil {
 			return "", "", errors.Wrap(err, "failed to create connection")
 		}
+		defer conn.Close()
 		updatedTask, err := setMigrationIDAndEndBinlogCoordinate(ctx, conn, task, stores, migrationID)
 		if err != nil {
 			return "", "", errors.Wrap(err, "failed to update the task payload for MySQL rollback SQL")
@@ -431,7 +433,21 @@ func postMigration(ctx context.Context, stores *store.Store, activityManager *ac
 			bytebaseURL = fmt.Sprintf("%s/issue/%s-%d?stage=%d", setting.ExternalUrl, slug.Make(issue.Title), issue.UID, task.StageID)
 		}
 
-		commitID, err := writeBackLatestSchema(ctx, stores, repo, vcs, vcsPushEvent, mi, writebackBranch, latestSchemaFile, schema, bytebaseURL)
+		commitBranch := writebackBranch
+		var commitID string
+		var pullRequestResult *schemaWriteBackPullRequestResult
+		if repo.EnableSchemaWriteBackPullRequest {
+			title := fmt.Sprintf("[Bytebase] Update latest schema after rollout %d", task.PipelineID)
+			if issue != nil {
+				title = fmt.Sprintf("[Bytebase] Update latest schema after %q", issue.Title)
+			}
+			pullRequestResult, err = writeBackLatestSchemaByPullRequest(ctx, stores, repo, vcs, vcsPushEvent, mi, writebackBranch, getSchemaWriteBackBranch(task.PipelineID), title, latestSchemaFile, schema, bytebaseURL)
+			if err == nil {
+				commitID, commitBranch = pullRequestResult.commitID, pullRequestResult.branch
+			}
+		} else {
+			commitID, err = writeBackLatestSchema(ctx, stores, repo, vcs, vcsPushEvent, mi, writebackBranch, latestSchemaFile, schema, bytebaseURL)
+		}
 		if err != nil {
 			return true, nil, err
 		}
@@ -442,7 +458,7 @@ func postMigration(ctx context.Context, stores *store.Store, activityManager *ac
 				TaskID:             task.ID,
 				VCSInstanceURL:     vcs.InstanceURL,
 				RepositoryFullPath: repo.FullPath,
-				Branch:             repo.BranchFilter,
+				Branch:             commitBranch,
 				FilePath:           latestSchemaFile,
 				CommitID:           commitID,
 			})
@@ -466,6 +482,17 @@ func postMigration(ctx context.Context, stores *store.Store, activityManager *ac
 				),
 				Payload: string(payload),
 			}
+			if pullRequestResult != nil {
+				switch {
+				case pullRequestResult.pullRequestErr != nil:
+					activityCreate.Level = api.ActivityWarn
+					activityCreate.Comment += fmt.Sprintf(" Failed to open the pull request to merge branch %q, it will be opened again by the next schema write-back: %v.", commitBranch, pullRequestResult.pullRequestErr)
+				case pullRequestResult.opened:
+					activityCreate.Comment += fmt.Sprintf(" Opened pull request %s to merge branch %q.", pullRequestResult.pullRequest.URL, commitBranch)
+				case pullRequestResult.pullRequest != nil:
+					activityCreate.Comment += fmt.Sprintf(" Updated pull request %s to merge branch %q.", pullRequestResult.pullRequest.URL, commitBranch)
+				}
+			}
 
 			if _, err := activityManager.CreateActivity(ctx, activityCreate, &activity.Metadata{}); err != nil {
 				log.Error("Failed to create file commit activity after writing back the latest schema",
@@ -727,6 +754,155 @@ func writeBackLatestSchema(
 	return schemaFileMeta.LastCommitID, nil
 }
 
+// schemaWriteBackPullRequestMu serializes the schema write-back by pull requests. The tasks of a rollout
+// may run in parallel, and they commit to the same branch.
+var schemaWriteBackPullRequestMu sync.Mutex
+
+// schemaWriteBackBranchPrefix is the prefix of the branches that the latest schema files are committed to.
+const schemaWriteBackBranchPrefix = "bytebase-schema-write-back-"
+
+// getSchemaWriteBackBranch returns the branch that the latest schema files of a rollout are committed to.
+func getSchemaWriteBackBranch(pipelineUID int) string {
+	return fmt.Sprintf("%s%d", schemaWriteBackBranchPrefix, pipelineUID)
+}
+
+// schemaWriteBackPullRequestResult is the result of writing back the latest schema by a pull request.
+type schemaWriteBackPullRequestResult struct {
+	commitID string
+	// branch is the branch that the latest schema is committed to.
+	branch string
+	// pullRequest is the open pull request of the branch, which may be opened by an earlier write-back.
+	pullRequest *vcsPlugin.PullRequest
+	// opened is true if the pull request is opened by this write-back.
+	opened bool
+	// pullRequestErr is the error opening the pull request. The latest schema is committed to the branch anyway,
+	// and the next write-back to the branch tries to open the pull request again.
+	pullRequestErr error
+}
+
+// Writes back the latest schema to the repository by a pull request after migration, for the repositories
+// whose branch protection rejects direct pushes. The schema files are batched into one pull request:
+// if a write-back pull request to the base branch is open, even if it's opened by another rollout, the schema is
+// committed to its branch. Otherwise, the schema is committed to the head branch, and the pull request is opened.
+func writeBackLatestSchemaByPullRequest(
+	ctx context.Context,
+	storage *store.Store,
+	repository *store.RepositoryMessage,
+	vcs *store.ExternalVersionControlMessage,
+	pushEvent *vcsPlugin.PushEvent,
+	mi *db.MigrationInfo,
+	baseBranch, headBranch, title, latestSchemaFile, schema, bytebaseURL string,
+) (*schemaWriteBackPullRequestResult, error) {
+	schemaWriteBackPullRequestMu.Lock()
+	defer schemaWriteBackPullRequestMu.Unlock()
+
+	provider := vcsPlugin.Get(vcs.Type, vcsPlugin.ProviderConfig{})
+	oauthContext := common.OauthContext{
+		ClientID:     vcs.ApplicationID,
+		ClientSecret: vcs.Secret,
+		AccessToken:  repository.AccessToken,
+		RefreshToken: repository.RefreshToken,
+		Refresher:    utils.RefreshToken(ctx, storage, repository.WebURL),
+	}
+	pullRequests, err := provider.ListOpenPullRequests(ctx, oauthContext, vcs.InstanceURL, repository.ExternalID, baseBranch)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to list the open pull requests to branch %q", baseBranch)
+	}
+	pullRequest := findSchemaWriteBackPullRequest(pullRequests, headBranch)
+	if pullRequest != nil {
+		headBranch = pullRequest.HeadBranch
+	} else {
+		if _, err := provider.GetBranch(ctx, oauthContext, vcs.InstanceURL, repository.ExternalID, headBranch); err != nil {
+			if common.ErrorCode(err) != common.NotFound {
+				return nil, errors.Wrapf(err, "failed to get branch %q", headBranch)
+			}
+			base, err := provider.GetBranch(ctx, oauthContext, vcs.InstanceURL, repository.ExternalID, baseBranch)
+			if err != nil {
+				return nil, errors.Wrapf(err, "failed to get branch %q", baseBranch)
+			}
+			if err := provider.CreateBranch(ctx, oauthContext, vcs.InstanceURL, repository.ExternalID, &vcsPlugin.BranchInfo{
+				Name:         headBranch,
+				LastCommitID: base.LastCommitID,
+			}); err != nil {
+				return nil, errors.Wrapf(err, "failed to create branch %q", headBranch)
+			}
+		}
+	}
+
+	commitID, err := writeBackLatestSchema(ctx, storage, repository, vcs, pushEvent, mi, headBranch, latestSchemaFile, schema, bytebaseURL)
+	if err != nil {
+		return nil, err
+	}
+	result := &schemaWriteBackPullRequestResult{
+		commitID:    commitID,
+		branch:      headBranch,
+		pullRequest: pullRequest,
+	}
+	if pullRequest != nil {
+		return result, nil
+	}
+
+	// Retrieve the latest AccessToken and RefreshToken as the previous VCS call may have
+	// updated the stored token pair.
+	repo2, vcs2, err := getRepositoryAndVCS(ctx, storage, repository.UID, repository.VCSUID)
+	if err != nil {
+		return nil, err
+	}
+	body := "THIS PULL REQUEST IS AUTO-GENERATED BY BYTEBASE"
+	if bytebaseURL != "" {
+		body += "\n\n" + bytebaseURL
+	}
+	pullRequest, err = provider.CreatePullRequest(
+		ctx,
+		common.OauthContext{
+			ClientID:     vcs2.ApplicationID,
+			ClientSecret: vcs2.Secret,
+			AccessToken:  repo2.AccessToken,
+			RefreshToken: repo2.RefreshToken,
+			Refresher:    utils.RefreshToken(ctx, storage, repo2.WebURL),
+		},
+		vcs2.InstanceURL,
+		repo2.ExternalID,
+		&vcsPlugin.PullRequestCreate{
+			Title:                 title,
+			Body:                  body,
+			Head:                  headBranch,
+			Base:                  baseBranch,
+			RemoveHeadAfterMerged: true,
+		},
+	)
+	if err != nil {
+		// The latest schema is committed to the branch anyway, so we don't fail the task.
+		log.Warn("Failed to open the pull request for the schema write-back",
+			zap.String("repository", repo2.WebURL),
+			zap.String("head", headBranch),
+			zap.String("base", baseBranch),
+			zap.Error(err),
+		)
+		result.pullRequestErr = err
+		return result, nil
+	}
+	result.pullRequest = pullRequest
+	result.opened = true
+	return result, nil
+}
+
+// findSchemaWriteBackPullRequest returns the open pull request to commit the latest schema to.
+// The pull request of the head branch is preferred, then the one opened by the write-back of another rollout.
+func findSchemaWriteBackPullRequest(pullRequests []*vcsPlugin.PullRequest, headBranch string) *vcsPlugin.PullRequest {
+	for _, pullRequest := range pullRequests {
+		if pullRequest.HeadBranch == headBranch {
+			return pullRequest
+		}
+	}
+	for _, pullRequest := range pullRequests {
+		if strings.HasPrefix(pullRequest.HeadBranch, schemaWriteBackBranchPrefix) {
+			return pullRequest
+		}
+	}
+	return nil
+}
+
 func getRepositoryAndVCS(ctx context.Context, storage *store.Store, repoUID, vcsUID int) (*store.RepositoryMessage, *store.ExternalVersionControlMessage, error) {
 	repo, err := storage.GetRepositoryV2(ctx, &store.FindRepositoryMessage{
 		UID: &repoUID,
@@ -0,0 +1,22 @@
+package taskrun
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	vcsPlugin "github.com/bytebase/bytebase/backend/plugin/vcs"
+)
+
+func TestFindSchemaWriteBackPullRequest(t *testing.T) {
+	a := require.New(t)
+	feature := &vcsPlugin.PullRequest{HeadBranch: "feature"}
+	other := &vcsPlugin.PullRequest{HeadBranch: getSchemaWriteBackBranch(1)}
+	own := &vcsPlugin.PullRequest{HeadBranch: getSchemaWriteBackBranch(2)}
+
+	a.Nil(findSchemaWriteBackPullRequest(nil, getSchemaWriteBackBranch(2)))
+	a.Nil(findSchemaWriteBackPullRequest([]*vcsPlugin.PullRequest{feature}, getSchemaWriteBackBranch(2)))
+	// The pull request opened by another rollout is reused.
+	a.Equal(other, findSchemaWriteBackPullRequest([]*vcsPlugin.PullRequest{feature, other}, getSchemaWriteBackBranch(2)))
+	a.Equal(own, findSchemaWriteBackPullRequest([]*vcsPlugin.PullRequest{other, own}, getSchemaWriteBackBranch(2)))
+}
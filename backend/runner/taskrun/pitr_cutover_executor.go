@@ -240,6 +240,8 @@ func (exec *PITRCutoverExecutor) pitrCutoverPostgres(ctx context.Context, instan
 	pitrDatabaseName := util.GetPITRDatabaseName(databaseName, issue.CreatedTime.Unix())
 	pitrOldDatabaseName := util.GetPITROldDatabaseName(databaseName, issue.CreatedTime.Unix())
 
+	// Postgres can't rename the database with connections, so we close the idle pooled drivers first.
+	exec.dbFactory.ClearDriverPool(instance.UID)
 	defaultDBDriver, err := exec.dbFactory.GetAdminDatabaseDriver(ctx, instance, nil /* database */)
 	if err != nil {
 		return err
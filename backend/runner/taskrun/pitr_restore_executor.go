@@ -221,8 +221,8 @@ func (exec *PITRRestoreExecutor) doPITRRestore(ctx context.Context, dbFactory *d
 	}
 	log.Debug("Found backup list", zap.Array("backups", store.ZapBackupArray(backupList)))
 
-	mysqlSourceDriver, sourceOk := sourceDriver.(*mysql.Driver)
-	mysqlTargetDriver, targetOk := targetDriver.(*mysql.Driver)
+	mysqlSourceDriver, sourceOk := db.Unwrap(sourceDriver).(*mysql.Driver)
+	mysqlTargetDriver, targetOk := db.Unwrap(targetDriver).(*mysql.Driver)
 	if (!sourceOk) || (!targetOk) {
 		log.Error("Failed to cast driver to mysql.Driver")
 		return nil, errors.Errorf("[internal] cast driver to mysql.Driver failed")
@@ -375,7 +375,7 @@ func (*PITRRestoreExecutor) doRestoreInPlacePostgres(ctx context.Context, stores
 	}
 	defer driver.Close(ctx)
 
-	pgDriver, ok := driver.(*pg.Driver)
+	pgDriver, ok := db.Unwrap(driver).(*pg.Driver)
 	if !ok {
 		log.Error("Failed to cast driver to pg.Driver")
 		return nil, errors.Errorf("[internal] cast driver to pg.Driver failed")
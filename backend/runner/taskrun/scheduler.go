@@ -496,6 +496,17 @@ func (s *Scheduler) PatchTask(ctx context.Context, task *store.TaskMessage, task
 				log.Error("Failed to trigger task report check after changing the task statement", zap.Int("task_id", task.ID), zap.String("task_name", task.Name), zap.Error(err))
 			}
 		}
+
+		if api.IsStatementLockReportSupported(instance.Engine) && api.IsTaskCheckReportNeededForTaskType(task.Type) {
+			if err := s.store.CreateTaskCheckRun(ctx, &store.TaskCheckRunMessage{
+				CreatorID: taskPatched.CreatorID,
+				TaskID:    task.ID,
+				Type:      api.TaskCheckDatabaseStatementLockReport,
+			}); err != nil {
+				// It's OK if we failed to trigger a check, just emit an error log
+				log.Error("Failed to trigger statement lock report check after changing the task statement", zap.Int("task_id", task.ID), zap.String("task_name", task.Name), zap.Error(err))
+			}
+		}
 	}
 
 	if taskPatch.SheetID != nil {
@@ -758,6 +769,7 @@ func canUpdateTaskStatement(task *store.TaskMessage) *echo.HTTPError {
 // scheduleIfNeeded schedules the task if
 //  2. it has no blocking tasks.
 //  3. it has passed the earliest allowed time.
+//  4. its issue is not drafted from a pull request that hasn't been merged.
 func (s *Scheduler) scheduleIfNeeded(ctx context.Context, task *store.TaskMessage) error {
 	blocked, err := s.isTaskBlocked(ctx, task)
 	if err != nil {
@@ -766,6 +778,13 @@ func (s *Scheduler) scheduleIfNeeded(ctx context.Context, task *store.TaskMessag
 	if blocked {
 		return nil
 	}
+	waiting, err := s.isWaitingForPullRequestMerge(ctx, task)
+	if err != nil {
+		return errors.Wrap(err, "failed to check if task is waiting for the pull request merge")
+	}
+	if waiting {
+		return nil
+	}
 	if task.EarliestAllowedTs != 0 && time.Now().Before(time.Unix(task.EarliestAllowedTs, 0)) {
 		return nil
 	}
@@ -777,6 +796,19 @@ func (s *Scheduler) scheduleIfNeeded(ctx context.Context, task *store.TaskMessag
 	})
 }
 
+// isWaitingForPullRequestMerge returns true if the task issue is drafted from a pull request that hasn't been merged.
+// The draft issue can be reviewed and approved, but it's only rolled out after the pull request is merged.
+func (s *Scheduler) isWaitingForPullRequestMerge(ctx context.Context, task *store.TaskMessage) (bool, error) {
+	issue, err := s.store.GetIssueV2(ctx, &store.FindIssueMessage{PipelineID: &task.PipelineID})
+	if err != nil {
+		return false, errors.Wrapf(err, "failed to get issue by pipeline %d", task.PipelineID)
+	}
+	if issue == nil {
+		return false, nil
+	}
+	return utils.IsUnmergedPullRequestDraft(issue)
+}
+
 func (s *Scheduler) isTaskBlocked(ctx context.Context, task *store.TaskMessage) (bool, error) {
 	for _, block := range task.BlockedBy {
 		blockingTask, err := s.store.GetTaskV2ByID(ctx, block)
@@ -0,0 +1,180 @@
+package server
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/status"
+
+	v1 "github.com/bytebase/bytebase/backend/api/v1"
+	"github.com/bytebase/bytebase/backend/common"
+	"github.com/bytebase/bytebase/backend/common/log"
+	api "github.com/bytebase/bytebase/backend/legacyapi"
+	"github.com/bytebase/bytebase/backend/plugin/app/slack"
+	"github.com/bytebase/bytebase/backend/plugin/app/teams"
+	"github.com/bytebase/bytebase/backend/runner/imapproval"
+	"github.com/bytebase/bytebase/backend/store"
+	v1pb "github.com/bytebase/bytebase/proto/generated-go/v1"
+)
+
+// imApprovalTimeout is the timeout of processing an interaction.
+// The interaction is acknowledged immediately, because Slack and Teams require the response within a few seconds.
+const imApprovalTimeout = 1 * time.Minute
+
+// imApproval is the approve or reject button click on the interactive approval message.
+type imApproval struct {
+	approve    bool
+	reviewName string
+	stepIndex  int
+	comment    string
+	// email is the email of the IM user, which maps to the Bytebase user.
+	email string
+}
+
+// registerIMApprovalRoutes registers the endpoints receiving the interactions of the Slack and Teams approval messages.
+func (s *Server) registerIMApprovalRoutes(g *echo.Group) {
+	g.POST("/im/slack", func(c echo.Context) error {
+		ctx := c.Request().Context()
+		body, err := io.ReadAll(c.Request().Body)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, "Failed to read interaction request").SetInternal(err)
+		}
+		setting, err := imapproval.GetSetting(ctx, s.store)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusInternalServerError, "Failed to get IM setting").SetInternal(err)
+		}
+		if setting == nil || setting.IMType != api.IMTypeSlack {
+			return echo.NewHTTPError(http.StatusNotFound, "Slack approval is not enabled")
+		}
+		if err := slack.VerifySignature(setting.SigningSecret, c.Request().Header.Get("X-Slack-Request-Timestamp"), c.Request().Header.Get("X-Slack-Signature"), body, time.Now()); err != nil {
+			return echo.NewHTTPError(http.StatusUnauthorized, "Invalid Slack request").SetInternal(err)
+		}
+		interaction, err := slack.ParseInteraction(body)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, "Malformed Slack interaction").SetInternal(err)
+		}
+		if interaction == nil {
+			return c.NoContent(http.StatusOK)
+		}
+
+		go func() {
+			ctx, cancel := context.WithTimeout(context.Background(), imApprovalTimeout)
+			defer cancel()
+			err := func() error {
+				email, err := s.slackProvider.GetUserEmail(ctx, setting.AppSecret, interaction.UserID)
+				if err != nil {
+					return errors.Wrap(err, "failed to get the email of the Slack user")
+				}
+				return s.processIMApproval(ctx, &imApproval{
+					approve:    interaction.Action == slack.ActionApprove,
+					reviewName: interaction.ReviewName,
+					stepIndex:  interaction.StepIndex,
+					comment:    interaction.Comment,
+					email:      email,
+				})
+			}()
+			if err == nil {
+				return
+			}
+			if err := s.slackProvider.Respond(ctx, interaction.ResponseURL, err.Error()); err != nil {
+				log.Warn("failed to respond Slack interaction", zap.Error(err))
+			}
+		}()
+		return c.NoContent(http.StatusOK)
+	})
+
+	g.POST("/im/teams", func(c echo.Context) error {
+		ctx := c.Request().Context()
+		body, err := io.ReadAll(c.Request().Body)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, "Failed to read activity request").SetInternal(err)
+		}
+		setting, err := imapproval.GetSetting(ctx, s.store)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusInternalServerError, "Failed to get IM setting").SetInternal(err)
+		}
+		if setting == nil || setting.IMType != api.IMTypeTeams {
+			return echo.NewHTTPError(http.StatusNotFound, "Teams approval is not enabled")
+		}
+		activity, serviceURL, err := teams.ParseActivity(body)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, "Malformed Teams activity").SetInternal(err)
+		}
+		if err := s.teamsProvider.VerifyRequest(ctx, setting.AppID, c.Request().Header.Get("Authorization"), serviceURL); err != nil {
+			return echo.NewHTTPError(http.StatusUnauthorized, "Invalid Teams request").SetInternal(err)
+		}
+		if activity == nil {
+			return c.NoContent(http.StatusOK)
+		}
+
+		go func() {
+			ctx, cancel := context.WithTimeout(context.Background(), imApprovalTimeout)
+			defer cancel()
+			// Reply with the configured service URL, so that the bot token is never sent to other hosts.
+			conversation := imapproval.GetTeamsConversation(setting, activity.ConversationID)
+			err := func() error {
+				email, err := s.teamsProvider.GetMemberEmail(ctx, imapproval.GetTeamsTokenCtx(setting), conversation, activity.UserID)
+				if err != nil {
+					return errors.Wrap(err, "failed to get the email of the Teams user")
+				}
+				return s.processIMApproval(ctx, &imApproval{
+					approve:    activity.Action == teams.ActionApprove,
+					reviewName: activity.ReviewName,
+					stepIndex:  activity.StepIndex,
+					comment:    activity.Comment,
+					email:      email,
+				})
+			}()
+			if err == nil {
+				return
+			}
+			if err := s.teamsProvider.Reply(ctx, imapproval.GetTeamsTokenCtx(setting), conversation, activity.ReplyToID, err.Error()); err != nil {
+				log.Warn("failed to reply Teams activity", zap.Error(err))
+			}
+		}()
+		return c.NoContent(http.StatusOK)
+	})
+}
+
+// processIMApproval approves or rejects the review step on behalf of the Bytebase user of the IM user,
+// and then updates the approval messages in place.
+func (s *Server) processIMApproval(ctx context.Context, approval *imApproval) error {
+	issueUID, err := imapproval.GetIssueUID(approval.reviewName)
+	if err != nil {
+		return err
+	}
+	email := strings.ToLower(approval.email)
+	user, err := s.store.GetUser(ctx, &store.FindUserMessage{Email: &email})
+	if err != nil {
+		return errors.Wrapf(err, "failed to get user by email %s", email)
+	}
+	if user == nil || user.MemberDeleted {
+		return errors.Errorf("There is no active Bytebase user with email %s.", email)
+	}
+	if err := s.IMApprovalRunner.CheckPendingStep(ctx, issueUID, approval.stepIndex); err != nil {
+		return errors.Errorf("Cannot review the issue: %s.", err.Error())
+	}
+
+	reviewService := v1.NewReviewService(s.store, s.ActivityManager, s.TaskScheduler, s.TaskCheckScheduler, s.RelayRunner, s.stateCfg)
+	ctx = context.WithValue(ctx, common.PrincipalIDContextKey, user.ID)
+	if approval.approve {
+		_, err = reviewService.ApproveReview(ctx, &v1pb.ApproveReviewRequest{Name: approval.reviewName, Comment: approval.comment})
+	} else {
+		_, err = reviewService.RejectReview(ctx, &v1pb.RejectReviewRequest{Name: approval.reviewName, Comment: approval.comment})
+	}
+	if err != nil {
+		return errors.Errorf("Failed to review the issue: %s.", status.Convert(err).Message())
+	}
+
+	if err := s.IMApprovalRunner.SyncIssue(ctx, issueUID); err != nil {
+		// The runner syncs the messages again later.
+		log.Warn("failed to sync IM approval messages", zap.Int("issueID", issueUID), zap.Error(err))
+	}
+	return nil
+}
@@ -429,10 +429,17 @@ func (s *Server) createIssue(ctx context.Context, issueCreate *api.IssueCreate,
 		return nil, echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("assignee %d not found", issueCreate.AssigneeID))
 	}
 
+	labels, err := utils.NormalizeIssueLabels(issueCreate.Labels)
+	if err != nil {
+		return nil, echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid labels: %v", err))
+	}
 	issueCreatePayload := &storepb.IssuePayload{
 		Approval: &storepb.IssuePayloadApproval{
 			ApprovalFindingDone: false,
 		},
+		PullRequest: issueCreate.PullRequest,
+		VcsCommit:   issueCreate.VCSCommit,
+		Labels:      labels,
 	}
 	databaseGroup, err := isGroupingChangeIssueCreate(issueCreate)
 	if err != nil {
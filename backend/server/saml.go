@@ -0,0 +1,126 @@
+package server
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/labstack/echo/v4/middleware"
+
+	"github.com/bytebase/bytebase/backend/plugin/idp/saml"
+	"github.com/bytebase/bytebase/backend/store"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+const (
+	// samlPrefix is the prefix of the SAML service provider endpoints.
+	samlPrefix = "/saml"
+	// samlCallbackPath is the frontend path receiving the one-time code, which signs in with the SAML context.
+	samlCallbackPath = "/saml/callback"
+)
+
+// registerSAMLRoutes registers the SAML service provider endpoints.
+// The SAML response posted to the assertion consumer service is validated here and exchanged for a
+// one-time code, so the signed response never reaches the browser. The frontend then signs in with
+// the code in the SAML context via AuthService.Login.
+func (s *Server) registerSAMLRoutes(g *echo.Group) {
+	// Limit the authentication requests per client, so a client can't evict the outstanding
+	// authentication requests of the others from the SAML cache.
+	ssoRateLimiter := middleware.RateLimiterWithConfig(middleware.RateLimiterConfig{
+		Store: middleware.NewRateLimiterMemoryStoreWithConfig(
+			middleware.RateLimiterMemoryStoreConfig{Rate: 0.2, Burst: 10, ExpiresIn: 3 * time.Minute},
+		),
+		IdentifierExtractor: func(ctx echo.Context) (string, error) {
+			return ctx.RealIP(), nil
+		},
+		ErrorHandler: func(context echo.Context, err error) error {
+			return context.JSON(http.StatusForbidden, nil)
+		},
+		DenyHandler: func(context echo.Context, identifier string, err error) error {
+			return context.JSON(http.StatusTooManyRequests, nil)
+		},
+	})
+
+	g.GET("/metadata/:idp", func(c echo.Context) error {
+		identityProvider, err := s.getSAMLIdentityProvider(c)
+		if err != nil {
+			return err
+		}
+		metadata, err := identityProvider.Metadata()
+		if err != nil {
+			return echo.NewHTTPError(http.StatusInternalServerError, "Failed to generate SAML metadata").SetInternal(err)
+		}
+		return c.Blob(http.StatusOK, "application/samlmetadata+xml", metadata)
+	})
+
+	g.GET("/sso/:idp", func(c echo.Context) error {
+		identityProvider, err := s.getSAMLIdentityProvider(c)
+		if err != nil {
+			return err
+		}
+		redirectURL, err := identityProvider.AuthnRequestURL(c.QueryParam("state"))
+		if err != nil {
+			return echo.NewHTTPError(http.StatusInternalServerError, "Failed to generate SAML authentication request").SetInternal(err)
+		}
+		return c.Redirect(http.StatusFound, redirectURL)
+	}, ssoRateLimiter)
+
+	g.POST("/acs/:idp", func(c echo.Context) error {
+		ctx := c.Request().Context()
+		identityProvider, err := s.getSAMLIdentityProvider(c)
+		if err != nil {
+			return err
+		}
+		samlResponse := c.FormValue("SAMLResponse")
+		if samlResponse == "" {
+			return echo.NewHTTPError(http.StatusBadRequest, "Missing SAMLResponse")
+		}
+		relayState := c.FormValue("RelayState")
+		userInfo, err := identityProvider.UserInfo(samlResponse, relayState)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusUnauthorized, "Failed to validate SAML response").SetInternal(err)
+		}
+		code, err := s.samlCache.CreateCode(c.Param("idp"), userInfo)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusInternalServerError, "Failed to create SAML code").SetInternal(err)
+		}
+		setting, err := s.store.GetWorkspaceGeneralSetting(ctx)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusInternalServerError, "Failed to get workspace setting").SetInternal(err)
+		}
+		query := url.Values{}
+		query.Set("code", code)
+		query.Set("state", relayState)
+		return c.Redirect(http.StatusSeeOther, fmt.Sprintf("%s%s?%s", strings.TrimSuffix(setting.ExternalUrl, "/"), samlCallbackPath, query.Encode()))
+	})
+}
+
+// getSAMLIdentityProvider returns the SAML identity provider of the idp path parameter.
+func (s *Server) getSAMLIdentityProvider(c echo.Context) (*saml.IdentityProvider, error) {
+	ctx := c.Request().Context()
+	identityProviderID := c.Param("idp")
+	identityProvider, err := s.store.GetIdentityProvider(ctx, &store.FindIdentityProviderMessage{
+		ResourceID: &identityProviderID,
+	})
+	if err != nil {
+		return nil, echo.NewHTTPError(http.StatusInternalServerError, "Failed to get identity provider").SetInternal(err)
+	}
+	if identityProvider == nil || identityProvider.Deleted || identityProvider.Type != storepb.IdentityProviderType_SAML {
+		return nil, echo.NewHTTPError(http.StatusNotFound, fmt.Sprintf("SAML identity provider %q not found", identityProviderID))
+	}
+	setting, err := s.store.GetWorkspaceGeneralSetting(ctx)
+	if err != nil {
+		return nil, echo.NewHTTPError(http.StatusInternalServerError, "Failed to get workspace setting").SetInternal(err)
+	}
+	if setting.ExternalUrl == "" {
+		return nil, echo.NewHTTPError(http.StatusBadRequest, "External URL isn't configured")
+	}
+	samlIdentityProvider, err := saml.NewIdentityProvider(identityProvider.Config.GetSamlConfig(), setting.ExternalUrl, identityProvider.ResourceID, s.samlCache)
+	if err != nil {
+		return nil, echo.NewHTTPError(http.StatusInternalServerError, "Failed to create SAML identity provider").SetInternal(err)
+	}
+	return samlIdentityProvider, nil
+}
@@ -43,6 +43,7 @@ import (
 	"github.com/grpc-ecosystem/go-grpc-middleware/v2/interceptors/recovery"
 
 	"github.com/bytebase/bytebase/backend/api/auth"
+	"github.com/bytebase/bytebase/backend/api/scim"
 	v1 "github.com/bytebase/bytebase/backend/api/v1"
 	"github.com/bytebase/bytebase/backend/common"
 	"github.com/bytebase/bytebase/backend/common/log"
@@ -59,16 +60,26 @@ import (
 	"github.com/bytebase/bytebase/backend/plugin/advisor"
 	advisorDb "github.com/bytebase/bytebase/backend/plugin/advisor/db"
 	"github.com/bytebase/bytebase/backend/plugin/app/feishu"
+	"github.com/bytebase/bytebase/backend/plugin/app/slack"
+	"github.com/bytebase/bytebase/backend/plugin/app/teams"
+	"github.com/bytebase/bytebase/backend/plugin/auditlog"
 	"github.com/bytebase/bytebase/backend/plugin/db"
+	"github.com/bytebase/bytebase/backend/plugin/idp/saml"
 	metricPlugin "github.com/bytebase/bytebase/backend/plugin/metric"
+	secretPlugin "github.com/bytebase/bytebase/backend/plugin/secret"
 	bbs3 "github.com/bytebase/bytebase/backend/plugin/storage/s3"
 	"github.com/bytebase/bytebase/backend/resources/mongoutil"
 	"github.com/bytebase/bytebase/backend/resources/mysqlutil"
 	"github.com/bytebase/bytebase/backend/resources/postgres"
+	"github.com/bytebase/bytebase/backend/runner/accessgrant"
 	"github.com/bytebase/bytebase/backend/runner/anomaly"
 	"github.com/bytebase/bytebase/backend/runner/approval"
 	"github.com/bytebase/bytebase/backend/runner/apprun"
+	"github.com/bytebase/bytebase/backend/runner/auditlogexport"
 	"github.com/bytebase/bytebase/backend/runner/backuprun"
+	"github.com/bytebase/bytebase/backend/runner/commitstatus"
+	"github.com/bytebase/bytebase/backend/runner/imapproval"
+	"github.com/bytebase/bytebase/backend/runner/ldapsync"
 	"github.com/bytebase/bytebase/backend/runner/mail"
 	"github.com/bytebase/bytebase/backend/runner/metricreport"
 	"github.com/bytebase/bytebase/backend/runner/relay"
@@ -117,6 +128,10 @@ import (
 	_ "github.com/bytebase/bytebase/backend/plugin/advisor/snowflake"
 	// Register clickhouse driver.
 	_ "github.com/bytebase/bytebase/backend/plugin/db/clickhouse"
+	// Register secret providers.
+	_ "github.com/bytebase/bytebase/backend/plugin/secret/env"
+	_ "github.com/bytebase/bytebase/backend/plugin/secret/file"
+	_ "github.com/bytebase/bytebase/backend/plugin/secret/vault"
 
 	// Register mysql differ driver.
 	_ "github.com/bytebase/bytebase/backend/plugin/parser/sql/differ/mysql"
@@ -157,7 +172,13 @@ type Server struct {
 	ApplicationRunner  *apprun.Runner
 	RollbackRunner     *rollbackrun.Runner
 	ApprovalRunner     *approval.Runner
+	ApprovalSLARunner  *approval.SLARunner
+	IMApprovalRunner   *imapproval.Runner
+	AccessGrantRunner  *accessgrant.Runner
+	AuditLogExporter   *auditlogexport.Runner
 	RelayRunner        *relay.Runner
+	CommitStatusRunner *commitstatus.Runner
+	LDAPSyncer         *ldapsync.Syncer
 	runnerWG           sync.WaitGroup
 
 	ActivityManager *activity.Manager
@@ -186,6 +207,11 @@ type Server struct {
 
 	s3Client       *bbs3.Client
 	feishuProvider *feishu.Provider
+	slackProvider  *slack.Provider
+	teamsProvider  *teams.Provider
+
+	// samlCache is the in-memory state of the SAML sign-in flow.
+	samlCache *saml.Cache
 
 	// stateCfg is the shared in-momory state within the server.
 	stateCfg *state.State
@@ -311,7 +337,16 @@ func NewServer(ctx context.Context, profile config.Profile) (*Server, error) {
 		// return s so that caller can call s.Close() to shut down the postgres server if embedded.
 		return nil, errors.Wrap(err, "cannot open metadb")
 	}
-	storeInstance := store.New(storeDB)
+	var auditLogKey []byte
+	if profile.AuditLogKeyFile != "" {
+		auditLogKey, err = auditlog.ReadKeyFile(profile.AuditLogKeyFile)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		log.Warn("The audit log hash chain isn't keyed, set --audit-log-key-file to detect the audit logs rewritten with the database access.")
+	}
+	storeInstance := store.New(storeDB, auditLogKey)
 	if profile.Readonly {
 		log.Info("Database is opened in readonly mode. Skip migration and demo data setup.")
 	} else {
@@ -348,8 +383,12 @@ func NewServer(ctx context.Context, profile config.Profile) (*Server, error) {
 	}
 	s.secret = config.secret
 
-	s.ActivityManager = activity.NewManager(storeInstance)
-	s.dbFactory = dbfactory.New(s.mysqlBinDir, s.mongoBinDir, s.pgBinDir, profile.DataDir, s.secret)
+	s.ActivityManager = activity.NewManager(storeInstance, s.stateCfg)
+	s.dbFactory = dbfactory.New(s.mysqlBinDir, s.mongoBinDir, s.pgBinDir, profile.DataDir, s.secret, &secretPlugin.Allowlist{
+		FileDir:         profile.SecretFileDir,
+		EnvPrefix:       profile.SecretEnvPrefix,
+		VaultPathPrefix: profile.SecretVaultPathPrefix,
+	})
 	e := echo.New()
 	e.Debug = profile.Debug
 	e.HideBanner = true
@@ -431,16 +470,24 @@ func NewServer(ctx context.Context, profile config.Profile) (*Server, error) {
 		// TODO(p0ny): enable Feishu provider only when it is needed.
 		s.feishuProvider = feishu.NewProvider(profile.FeishuAPIURL)
 		s.ApplicationRunner = apprun.NewRunner(storeInstance, s.ActivityManager, s.feishuProvider, profile)
+		s.slackProvider = slack.NewProvider(slack.APIPath)
+		s.teamsProvider = teams.NewProvider(teams.LoginPath)
+		s.IMApprovalRunner = imapproval.NewRunner(storeInstance, s.slackProvider, s.teamsProvider)
 
 		s.RelayRunner = relay.NewRunner(storeInstance, s.ActivityManager, s.TaskScheduler, s.stateCfg)
 
 		s.BackupRunner = backuprun.NewRunner(storeInstance, s.dbFactory, s.s3Client, s.stateCfg, &profile)
 		s.RollbackRunner = rollbackrun.NewRunner(storeInstance, s.dbFactory, s.stateCfg)
 		s.ApprovalRunner = approval.NewRunner(storeInstance, s.dbFactory, s.stateCfg, s.ActivityManager, s.RelayRunner, s.licenseService)
+		s.CommitStatusRunner = commitstatus.NewRunner(storeInstance, s.stateCfg)
+		s.LDAPSyncer = ldapsync.NewSyncer(storeInstance, s.ActivityManager, s.licenseService)
+		s.AccessGrantRunner = accessgrant.NewRunner(storeInstance, s.ActivityManager)
+		s.AuditLogExporter = auditlogexport.NewRunner(storeInstance, s.licenseService, profile.AuditLogFileDir)
 
 		s.MailSender = mail.NewSender(s.store, s.stateCfg)
 
 		s.TaskScheduler = taskrun.NewScheduler(storeInstance, s.ApplicationRunner, s.SchemaSyncer, s.ActivityManager, s.licenseService, s.stateCfg, profile, s.MetricReporter)
+		s.ApprovalSLARunner = approval.NewSLARunner(storeInstance, s.ActivityManager, s.TaskScheduler, s.licenseService)
 		s.TaskScheduler.Register(api.TaskGeneral, taskrun.NewDefaultExecutor())
 		s.TaskScheduler.Register(api.TaskDatabaseCreate, taskrun.NewDatabaseCreateExecutor(storeInstance, s.dbFactory, s.SchemaSyncer, profile))
 		s.TaskScheduler.Register(api.TaskDatabaseSchemaBaseline, taskrun.NewSchemaBaselineExecutor(storeInstance, s.dbFactory, s.ActivityManager, s.licenseService, s.stateCfg, s.SchemaSyncer, profile))
@@ -471,6 +518,8 @@ func NewServer(ctx context.Context, profile config.Profile) (*Server, error) {
 		s.TaskCheckScheduler.Register(api.TaskCheckDatabaseStatementTypeReport, statementTypeReportExecutor)
 		statementAffectedRowsExecutor := taskcheck.NewStatementAffectedRowsReportExecutor(storeInstance, s.dbFactory)
 		s.TaskCheckScheduler.Register(api.TaskCheckDatabaseStatementAffectedRowsReport, statementAffectedRowsExecutor)
+		statementLockReportExecutor := taskcheck.NewStatementLockReportExecutor(storeInstance)
+		s.TaskCheckScheduler.Register(api.TaskCheckDatabaseStatementLockReport, statementLockReportExecutor)
 
 		// Anomaly scanner
 		s.AnomalyScanner = anomaly.NewScanner(storeInstance, s.dbFactory, s.licenseService)
@@ -499,6 +548,16 @@ func NewServer(ctx context.Context, profile config.Profile) (*Server, error) {
 
 	webhookGroup := e.Group(webhookAPIPrefix)
 	s.registerWebhookRoutes(webhookGroup)
+	if !profile.Readonly {
+		s.registerIMApprovalRoutes(webhookGroup)
+	}
+
+	s.samlCache = saml.NewCache()
+	samlGroup := e.Group(samlPrefix)
+	s.registerSAMLRoutes(samlGroup)
+
+	scimGroup := e.Group(scim.Prefix)
+	scim.NewService(s.store, s.ActivityManager, s.licenseService).RegisterRoutes(scimGroup)
 
 	apiGroup := e.Group(internalAPIPrefix)
 	// API JWT authentication middleware.
@@ -559,7 +618,7 @@ func NewServer(ctx context.Context, profile config.Profile) (*Server, error) {
 			recoveryStreamInterceptor,
 		),
 	)
-	v1pb.RegisterAuthServiceServer(s.grpcServer, v1.NewAuthService(s.store, s.secret, s.licenseService, s.MetricReporter, &profile,
+	v1pb.RegisterAuthServiceServer(s.grpcServer, v1.NewAuthService(s.store, s.secret, s.licenseService, s.MetricReporter, &profile, s.samlCache,
 		func(ctx context.Context, user *store.UserMessage, firstEndUser bool) error {
 			if s.profile.TestOnlySkipOnboardingData {
 				return nil
@@ -593,16 +652,20 @@ func NewServer(ctx context.Context, profile config.Profile) (*Server, error) {
 	v1pb.RegisterDatabaseServiceServer(s.grpcServer, v1.NewDatabaseService(s.store, s.BackupRunner, s.SchemaSyncer, s.licenseService))
 	v1pb.RegisterInstanceRoleServiceServer(s.grpcServer, v1.NewInstanceRoleService(s.store, s.dbFactory))
 	v1pb.RegisterOrgPolicyServiceServer(s.grpcServer, v1.NewOrgPolicyService(s.store, s.licenseService))
-	v1pb.RegisterIdentityProviderServiceServer(s.grpcServer, v1.NewIdentityProviderService(s.store, s.licenseService))
-	v1pb.RegisterSettingServiceServer(s.grpcServer, v1.NewSettingService(s.store, &s.profile, s.licenseService, s.stateCfg, s.feishuProvider))
+	v1pb.RegisterIdentityProviderServiceServer(s.grpcServer, v1.NewIdentityProviderService(s.store, s.licenseService, s.samlCache))
+	v1pb.RegisterSettingServiceServer(s.grpcServer, v1.NewSettingService(s.store, &s.profile, s.licenseService, s.stateCfg, s.feishuProvider, s.slackProvider, s.teamsProvider))
 	v1pb.RegisterAnomalyServiceServer(s.grpcServer, v1.NewAnomalyService(s.store))
-	v1pb.RegisterSQLServiceServer(s.grpcServer, v1.NewSQLService(s.store, s.SchemaSyncer, s.dbFactory, s.ActivityManager))
+	trustedProxies, err := common.ParseTrustedProxies(profile.TrustedProxies)
+	if err != nil {
+		return nil, err
+	}
+	v1pb.RegisterSQLServiceServer(s.grpcServer, v1.NewSQLService(s.store, s.SchemaSyncer, s.dbFactory, s.ActivityManager, trustedProxies))
 	v1pb.RegisterExternalVersionControlServiceServer(s.grpcServer, v1.NewExternalVersionControlService(s.store))
 	v1pb.RegisterRiskServiceServer(s.grpcServer, v1.NewRiskService(s.store, s.licenseService))
 	v1pb.RegisterReviewServiceServer(s.grpcServer, v1.NewReviewService(s.store, s.ActivityManager, s.TaskScheduler, s.TaskCheckScheduler, s.RelayRunner, s.stateCfg))
 	v1pb.RegisterRolloutServiceServer(s.grpcServer, v1.NewRolloutService(s.store, s.licenseService, s.dbFactory, s.TaskScheduler, s.TaskCheckScheduler, s.stateCfg, s.ActivityManager))
 	v1pb.RegisterRoleServiceServer(s.grpcServer, v1.NewRoleService(s.store, s.licenseService))
-	v1pb.RegisterSheetServiceServer(s.grpcServer, v1.NewSheetService(s.store, s.licenseService))
+	v1pb.RegisterSheetServiceServer(s.grpcServer, v1.NewSheetService(s.store, s.licenseService, s.dbFactory))
 	v1pb.RegisterCelServiceServer(s.grpcServer, v1.NewCelService())
 	v1pb.RegisterLoggingServiceServer(s.grpcServer, v1.NewLoggingService(s.store))
 	v1pb.RegisterBookmarkServiceServer(s.grpcServer, v1.NewBookmarkService(s.store))
@@ -720,6 +783,7 @@ func (s *Server) initMetricReporter() {
 	metricReporter.Register(metric.DatabaseCountMetricName, metricCollector.NewDatabaseCountCollector(s.store))
 	metricReporter.Register(metric.SheetCountMetricName, metricCollector.NewSheetCountCollector(s.store))
 	metricReporter.Register(metric.MemberCountMetricName, metricCollector.NewMemberCountCollector(s.store))
+	metricReporter.Register(metric.DriverPoolMetricName, metricCollector.NewDriverPoolCollector(s.dbFactory))
 	s.MetricReporter = metricReporter
 }
 
@@ -910,11 +974,26 @@ func (s *Server) Run(ctx context.Context, port int) error {
 		s.runnerWG.Add(1)
 		go s.ApprovalRunner.Run(ctx, &s.runnerWG)
 		s.runnerWG.Add(1)
+		go s.ApprovalSLARunner.Run(ctx, &s.runnerWG)
+		s.runnerWG.Add(1)
+		go s.IMApprovalRunner.Run(ctx, &s.runnerWG)
+		s.runnerWG.Add(1)
 		go s.RelayRunner.Run(ctx, &s.runnerWG)
+		s.runnerWG.Add(1)
+		go s.CommitStatusRunner.Run(ctx, &s.runnerWG)
+		s.runnerWG.Add(1)
+		go s.LDAPSyncer.Run(ctx, &s.runnerWG)
+		s.runnerWG.Add(1)
+		go s.AccessGrantRunner.Run(ctx, &s.runnerWG)
+		s.runnerWG.Add(1)
+		go s.AuditLogExporter.Run(ctx, &s.runnerWG)
 
 		s.runnerWG.Add(1)
 		go s.MetricReporter.Run(ctx, &s.runnerWG)
 	}
+	// The driver pool is used by the SQL Editor in the readonly mode too.
+	s.runnerWG.Add(1)
+	go s.dbFactory.Run(ctx, &s.runnerWG)
 
 	listen, err := net.Listen("tcp", fmt.Sprintf(":%d", port+1))
 	if err != nil {
@@ -971,6 +1050,11 @@ func (s *Server) Shutdown(ctx context.Context) error {
 	// Wait for all runners to exit.
 	s.runnerWG.Wait()
 
+	// Close the pooled database drivers.
+	if s.dbFactory != nil {
+		s.dbFactory.Close()
+	}
+
 	// Close db connection
 	if s.store != nil {
 		if err := s.store.Close(ctx); err != nil {
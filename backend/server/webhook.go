@@ -16,6 +16,7 @@ import (
 	"path/filepath"
 	"regexp"
 	"sort"
+	"strconv"
 	"strings"
 	"sync"
 
@@ -36,7 +37,9 @@ import (
 	mapperparser "github.com/bytebase/bytebase/backend/plugin/parser/mybatis/mapper"
 	"github.com/bytebase/bytebase/backend/plugin/parser/mybatis/mapper/ast"
 	"github.com/bytebase/bytebase/backend/plugin/vcs"
+	"github.com/bytebase/bytebase/backend/plugin/vcs/azure"
 	"github.com/bytebase/bytebase/backend/plugin/vcs/bitbucket"
+	"github.com/bytebase/bytebase/backend/plugin/vcs/gitea"
 	"github.com/bytebase/bytebase/backend/plugin/vcs/github"
 	"github.com/bytebase/bytebase/backend/plugin/vcs/gitlab"
 	"github.com/bytebase/bytebase/backend/store"
@@ -67,7 +70,10 @@ func (s *Server) registerWebhookRoutes(g *echo.Group) {
 		if err := json.Unmarshal(body, &pushEvent); err != nil {
 			return echo.NewHTTPError(http.StatusBadRequest, "Malformed push event").SetInternal(err)
 		}
-		// This shouldn't happen as we only setup webhook to receive push event, just in case.
+		if pushEvent.ObjectKind == gitlab.WebhookMergeRequest {
+			return s.processGitLabMergeRequestEvent(c, body)
+		}
+		// This shouldn't happen as we only setup webhook to receive push and merge request events, just in case.
 		if pushEvent.ObjectKind != gitlab.WebhookPush {
 			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid webhook event type, got %s, want push", pushEvent.ObjectKind))
 		}
@@ -119,7 +125,6 @@ func (s *Server) registerWebhookRoutes(g *echo.Group) {
 	g.POST("/github/:id", func(c echo.Context) error {
 		ctx := c.Request().Context()
 
-		// This shouldn't happen as we only setup webhook to receive push event, just in case.
 		eventType := github.WebhookType(c.Request().Header.Get("X-GitHub-Event"))
 		// https://docs.github.com/en/developers/webhooks-and-events/webhooks/about-webhooks#ping-event
 		// When we create a new webhook, GitHub will send us a simple ping event to let us know we've set up the webhook correctly.
@@ -127,6 +132,10 @@ func (s *Server) registerWebhookRoutes(g *echo.Group) {
 		if eventType == github.WebhookPing {
 			return c.String(http.StatusOK, "OK")
 		}
+		if eventType == github.WebhookPullRequest {
+			return s.processGitHubPullRequestEvent(c)
+		}
+		// This shouldn't happen as we only setup webhook to receive push and pull request events, just in case.
 		if eventType != github.WebhookPush {
 			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid webhook event type, got %s, want %s", eventType, github.WebhookPush))
 		}
@@ -185,11 +194,84 @@ func (s *Server) registerWebhookRoutes(g *echo.Group) {
 		return c.String(http.StatusOK, strings.Join(createdMessages, "\n"))
 	})
 
+	g.POST("/gitea/:id", func(c echo.Context) error {
+		ctx := c.Request().Context()
+
+		// Forgejo sends both the Gitea headers and its own headers, but we still accept
+		// the Forgejo ones in case the Gitea headers are dropped in the future.
+		eventType := gitea.WebhookType(getFirstHeader(c.Request().Header, "X-Gitea-Event", "X-Forgejo-Event"))
+		if eventType == gitea.WebhookPullRequest {
+			return s.processGiteaPullRequestEvent(c)
+		}
+		// This shouldn't happen as we only setup webhook to receive push and pull request events, just in case.
+		if eventType != gitea.WebhookPush {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid webhook event type, got %s, want %s", eventType, gitea.WebhookPush))
+		}
+
+		body, err := io.ReadAll(c.Request().Body)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, "Failed to read webhook request").SetInternal(err)
+		}
+		var pushEvent gitea.WebhookPushEvent
+		if err := json.Unmarshal(body, &pushEvent); err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, "Malformed push event").SetInternal(err)
+		}
+		repositoryID := pushEvent.Repository.FullName
+
+		nonBytebaseCommitList := filterGiteaBytebaseCommit(pushEvent.Commits)
+		if len(nonBytebaseCommitList) == 0 {
+			var commitList []string
+			for _, commit := range pushEvent.Commits {
+				commitList = append(commitList, commit.ID)
+			}
+			log.Debug("all commits are created by Bytebase",
+				zap.String("repoURL", pushEvent.Repository.HTMLURL),
+				zap.String("repoName", pushEvent.Repository.FullName),
+				zap.String("commits", strings.Join(commitList, ", ")),
+			)
+			return c.String(http.StatusOK, "OK")
+		}
+		pushEvent.Commits = nonBytebaseCommitList
+
+		signature := getFirstHeader(c.Request().Header, "X-Gitea-Signature", "X-Forgejo-Signature")
+		filter := func(repo *store.RepositoryMessage) (bool, error) {
+			// Gitea signs the payload in the same way as GitHub, except the signature has no "sha256=" prefix.
+			ok, err := validateGitHubWebhookSignature256(signature, repo.WebhookSecretToken, body)
+			if err != nil {
+				return false, echo.NewHTTPError(http.StatusInternalServerError, "Failed to validate Gitea webhook signature").SetInternal(err)
+			}
+			if !ok {
+				return false, nil
+			}
+
+			return s.isWebhookEventBranch(pushEvent.Ref, repo.BranchFilter)
+		}
+		repositoryList, err := s.filterRepository(ctx, c.Param("id"), repositoryID, filter)
+		if err != nil {
+			return err
+		}
+		if len(repositoryList) == 0 {
+			log.Debug("Empty handle repo list. Ignore this push event.")
+			return c.String(http.StatusOK, "OK")
+		}
+
+		baseVCSPushEvent := pushEvent.ToVCS()
+
+		createdMessages, err := s.processPushEvent(ctx, repositoryList, baseVCSPushEvent)
+		if err != nil {
+			return err
+		}
+		return c.String(http.StatusOK, strings.Join(createdMessages, "\n"))
+	})
+
 	g.POST("/bitbucket/:id", func(c echo.Context) error {
 		ctx := c.Request().Context()
 
-		// This shouldn't happen as we only set up webhook to receive push event, just in case.
 		eventType := c.Request().Header.Get("X-Event-Key")
+		if strings.HasPrefix(eventType, "pullrequest:") {
+			return s.processBitbucketPullRequestEvent(c, eventType)
+		}
+		// This shouldn't happen as we only set up webhook to receive push and pull request events, just in case.
 		if eventType != "repo:push" {
 			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid webhook event type, got %q, want %q", eventType, "repo:push"))
 		}
@@ -321,6 +403,50 @@ func (s *Server) registerWebhookRoutes(g *echo.Group) {
 		return c.String(http.StatusOK, strings.Join(allCreatedMessages, "\n"))
 	})
 
+	g.POST("/azure/:id", func(c echo.Context) error {
+		ctx := c.Request().Context()
+
+		body, err := io.ReadAll(c.Request().Body)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, "Failed to read webhook request").SetInternal(err)
+		}
+		var event azure.WebhookEvent
+		if err := json.Unmarshal(body, &event); err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, "Malformed service hook event").SetInternal(err)
+		}
+
+		// Azure DevOps doesn't sign the payload, the service hook sends the secret token as the
+		// basic authentication password instead.
+		_, password, _ := c.Request().BasicAuth()
+		validSecret := func(repo *store.RepositoryMessage) bool {
+			return subtle.ConstantTimeCompare([]byte(password), []byte(repo.WebhookSecretToken)) == 1
+		}
+
+		switch event.EventType {
+		case azure.WebhookPush:
+			createdMessages, err := s.processAzurePushEvent(ctx, c.Param("id"), event.Resource, validSecret)
+			if err != nil {
+				return err
+			}
+			return c.String(http.StatusOK, strings.Join(createdMessages, "\n"))
+		case azure.WebhookPullRequestCreated, azure.WebhookPullRequestUpdated:
+			if err := s.reviewAzurePullRequest(ctx, c.Param("id"), event.Resource, validSecret); err != nil {
+				return err
+			}
+			message, err := s.processAzurePullRequestEvent(ctx, c.Param("id"), event.EventType, event.Resource, validSecret)
+			if err != nil {
+				return err
+			}
+			if message == "" {
+				message = "OK"
+			}
+			return c.String(http.StatusOK, message)
+		default:
+			// This shouldn't happen as we only subscribe the push and pull request events, just in case.
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid service hook event type, got %s", event.EventType))
+		}
+	})
+
 	// id is the webhookEndpointID in repository
 	// This endpoint is generated and injected into GitHub action & GitLab CI during the VCS setup.
 	g.POST("/sql-review/:id", func(c echo.Context) error {
@@ -389,82 +515,15 @@ func (s *Server) registerWebhookRoutes(g *echo.Group) {
 		}
 		repo := repositoryList[0]
 
-		prFiles, err := vcs.Get(repo.vcs.Type, vcs.ProviderConfig{}).ListPullRequestFile(
-			ctx,
-			common.OauthContext{
-				ClientID:     repo.vcs.ApplicationID,
-				ClientSecret: repo.vcs.Secret,
-				AccessToken:  repo.repository.AccessToken,
-				RefreshToken: repo.repository.RefreshToken,
-				Refresher:    utils.RefreshToken(ctx, s.store, repo.repository.WebURL),
-			},
-			repo.vcs.InstanceURL,
-			request.RepositoryID,
-			request.PullRequestID,
-		)
+		sqlFileName2Advice, err := s.sqlAdviceForPullRequest(ctx, repositoryList, request.PullRequestID, setting.ExternalUrl)
 		if err != nil {
-			return echo.NewHTTPError(http.StatusInternalServerError, "Failed to list pull request file").SetInternal(err)
-		}
-
-		sqlFileName2Advice := s.sqlAdviceForSQLFiles(ctx, repositoryList, prFiles, setting.ExternalUrl)
-
-		if s.licenseService.IsFeatureEnabled(api.FeatureMybatisSQLReview) {
-			// If the commit file list contains the file which extension is xml and the content
-			// contains "https://mybatis.org/dtd/mybatis-3-mapper.dtd", we will try to apply
-			// sql-review to it.
-			// To apply sql-review to it, proceed as follows:
-			// 1. Look in the sibling and parent directories for directories containing similar
-			// <!DOCTYPE configuration
-			//   PUBLIC "-//mybatis.org//DTD Config 3.0//EN"
-			//   "https://mybatis.org/dtd/mybatis-3-config.dtd">
-			// of the xml file
-			// 2. If we can find it, then we will extract the sql from the mapper xml
-			// 3. match the environments in the configuration xml, look for the sql-review policy in the environment and apply it.
-			var isMybatisMapperXMLRegex = regexp.MustCompile(`(?i)http(s)?://mybatis.org/dtd/mybatis-3-mapper.dtd`)
-
-			mybatisMapperXMLFiles := make(map[string]string)
-			var commitID string
-			for _, prFile := range prFiles {
-				if !strings.HasSuffix(prFile.Path, ".xml") {
-					continue
-				}
-				fileContent, err := vcs.Get(repo.vcs.Type, vcs.ProviderConfig{}).ReadFileContent(
-					ctx,
-					common.OauthContext{
-						ClientID:     repo.vcs.ApplicationID,
-						ClientSecret: repo.vcs.Secret,
-						AccessToken:  repo.repository.AccessToken,
-						RefreshToken: repo.repository.RefreshToken,
-						Refresher:    utils.RefreshToken(ctx, s.store, repo.repository.WebURL),
-					},
-					repo.vcs.InstanceURL,
-					repo.repository.ExternalID,
-					prFile.Path,
-					prFile.LastCommitID,
-				)
-				if err != nil {
-					return echo.NewHTTPError(http.StatusInternalServerError, "Failed to read file content").SetInternal(err)
-				}
-				if !isMybatisMapperXMLRegex.MatchString(fileContent) {
-					continue
-				}
-				mybatisMapperXMLFiles[prFile.Path] = fileContent
-				commitID = prFile.LastCommitID
-			}
-			if len(mybatisMapperXMLFiles) > 0 {
-				mapperAdvices, err := s.sqlAdviceForMybatisMapperFiles(ctx, mybatisMapperXMLFiles, commitID, repo)
-				if err != nil {
-					return echo.NewHTTPError(http.StatusInternalServerError, "Failed to get sql advice for mybatis mapper files").SetInternal(err)
-				}
-				for filename, mapperAdvice := range mapperAdvices {
-					sqlFileName2Advice[filename] = mapperAdvice
-				}
-			}
+			return echo.NewHTTPError(http.StatusInternalServerError, "Failed to review SQL in the pull request").SetInternal(err)
 		}
 
 		response := &api.VCSSQLReviewResult{}
 		switch repo.vcs.Type {
-		case vcs.GitHub:
+		case vcs.GitHub, vcs.Gitea:
+			// Gitea Actions are compatible with GitHub Actions, including the workflow commands.
 			response = convertSQLAdviceToGitHubActionResult(sqlFileName2Advice)
 		case vcs.GitLab:
 			response = convertSQLAdviceToGitLabCIResult(sqlFileName2Advice)
@@ -482,6 +541,271 @@ func (s *Server) registerWebhookRoutes(g *echo.Group) {
 	})
 }
 
+// processAzurePushEvent processes the Azure DevOps push event. The event doesn't contain the changed
+// files, so we fetch the changes of each commit.
+func (s *Server) processAzurePushEvent(ctx context.Context, webhookEndpointID string, resource json.RawMessage, validSecret func(*store.RepositoryMessage) bool) ([]string, error) {
+	var pushEvent azure.WebhookPushResource
+	if err := json.Unmarshal(resource, &pushEvent); err != nil {
+		return nil, echo.NewHTTPError(http.StatusBadRequest, "Malformed push event").SetInternal(err)
+	}
+	repositoryID := pushEvent.Repository.RepositoryID()
+
+	nonBytebaseCommitList := filterAzureBytebaseCommit(pushEvent.Commits)
+	if len(nonBytebaseCommitList) == 0 {
+		var commitList []string
+		for _, commit := range pushEvent.Commits {
+			commitList = append(commitList, commit.CommitID)
+		}
+		log.Debug("all commits are created by Bytebase",
+			zap.String("repoURL", pushEvent.Repository.RemoteURL),
+			zap.String("repoName", pushEvent.Repository.Name),
+			zap.String("commits", strings.Join(commitList, ", ")),
+		)
+		return nil, nil
+	}
+
+	var allCreatedMessages []string
+	// Azure DevOps sends the commits of all updated refs together, the branch filter decides
+	// which ref update should be processed.
+	for _, refUpdate := range pushEvent.RefUpdates {
+		if !strings.HasPrefix(refUpdate.Name, "refs/heads/") {
+			continue
+		}
+		filter := func(repo *store.RepositoryMessage) (bool, error) {
+			if !validSecret(repo) {
+				return false, nil
+			}
+			return s.isWebhookEventBranch(refUpdate.Name, repo.BranchFilter)
+		}
+		repositoryList, err := s.filterRepository(ctx, webhookEndpointID, repositoryID, filter)
+		if err != nil {
+			return nil, err
+		}
+		if len(repositoryList) == 0 {
+			log.Debug("Empty handle repo list. Ignore this push event.")
+			continue
+		}
+		repo := repositoryList[0]
+		provider, ok := vcs.Get(repo.vcs.Type, vcs.ProviderConfig{}).(*azure.Provider)
+		if !ok {
+			return nil, echo.NewHTTPError(http.StatusInternalServerError, fmt.Sprintf("Unexpected VCS type %s for Azure DevOps push event", repo.vcs.Type))
+		}
+
+		var commitList []vcs.Commit
+		for _, commit := range nonBytebaseCommitList {
+			fileDiffList, err := provider.ListCommitChanges(
+				ctx,
+				common.OauthContext{
+					ClientID:     repo.vcs.ApplicationID,
+					ClientSecret: repo.vcs.Secret,
+					AccessToken:  repo.repository.AccessToken,
+					RefreshToken: repo.repository.RefreshToken,
+					Refresher:    utils.RefreshToken(ctx, s.store, repo.repository.WebURL),
+				},
+				repo.vcs.InstanceURL,
+				repo.repository.ExternalID,
+				commit.CommitID,
+			)
+			if err != nil {
+				return nil, echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Failed to get diff file list for commit %q", commit.CommitID)).SetInternal(err)
+			}
+
+			var addedList, modifiedList []string
+			for _, f := range fileDiffList {
+				switch f.Type {
+				case vcs.FileDiffTypeAdded:
+					addedList = append(addedList, f.Path)
+				case vcs.FileDiffTypeModified:
+					modifiedList = append(modifiedList, f.Path)
+				}
+			}
+
+			// Per Git convention, the message title and body are separated by two new line characters.
+			messages := strings.SplitN(commit.Comment, "\n\n", 2)
+			messageTitle := strings.TrimSpace(messages[0])
+
+			commitList = append(commitList,
+				vcs.Commit{
+					ID:           commit.CommitID,
+					Title:        messageTitle,
+					Message:      commit.Comment,
+					CreatedTs:    commit.Author.Date.Unix(),
+					URL:          commit.URL,
+					AuthorName:   commit.Author.Name,
+					AuthorEmail:  commit.Author.Email,
+					AddedList:    addedList,
+					ModifiedList: modifiedList,
+				},
+			)
+		}
+
+		createdMessages, err := s.processPushEvent(ctx, repositoryList, pushEvent.ToVCS(refUpdate, commitList))
+		if err != nil {
+			return nil, err
+		}
+		allCreatedMessages = append(allCreatedMessages, createdMessages...)
+	}
+	return allCreatedMessages, nil
+}
+
+// reviewAzurePullRequest reviews the SQL in the Azure DevOps pull request and posts the result as
+// a pull request comment thread.
+func (s *Server) reviewAzurePullRequest(ctx context.Context, webhookEndpointID string, resource json.RawMessage, validSecret func(*store.RepositoryMessage) bool) error {
+	var pullRequest azure.PullRequest
+	if err := json.Unmarshal(resource, &pullRequest); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "Malformed pull request event").SetInternal(err)
+	}
+	if pullRequest.Status != "active" {
+		return nil
+	}
+
+	filter := func(repo *store.RepositoryMessage) (bool, error) {
+		if !validSecret(repo) {
+			return false, nil
+		}
+		if !repo.EnableSQLReviewCI {
+			log.Debug("Skip repository as the SQL review CI is not enabled.",
+				zap.Int("repository_id", repo.UID),
+				zap.String("repository_external_id", repo.ExternalID),
+			)
+			return false, nil
+		}
+		return true, nil
+	}
+	repositoryList, err := s.filterRepository(ctx, webhookEndpointID, pullRequest.Repository.RepositoryID(), filter)
+	if err != nil {
+		return err
+	}
+	if len(repositoryList) == 0 {
+		log.Debug("Empty handle repo list. Ignore this pull request event.")
+		return nil
+	}
+	repo := repositoryList[0]
+	provider, ok := vcs.Get(repo.vcs.Type, vcs.ProviderConfig{}).(*azure.Provider)
+	if !ok {
+		return echo.NewHTTPError(http.StatusInternalServerError, fmt.Sprintf("Unexpected VCS type %s for Azure DevOps pull request event", repo.vcs.Type))
+	}
+
+	setting, err := s.store.GetWorkspaceGeneralSetting(ctx)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to find workspace setting").SetInternal(err)
+	}
+	pullRequestID := strconv.Itoa(pullRequest.PullRequestID)
+	sqlFileName2Advice, err := s.sqlAdviceForPullRequest(ctx, repositoryList, pullRequestID, setting.ExternalUrl)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to review SQL in the pull request").SetInternal(err)
+	}
+	status, content := convertSQLAdviceToMarkdown(sqlFileName2Advice)
+	threadStatus := azure.ThreadStatusClosed
+	if status != advisor.Success {
+		threadStatus = azure.ThreadStatusActive
+	}
+
+	if err := provider.CreatePullRequestThread(
+		ctx,
+		common.OauthContext{
+			ClientID:     repo.vcs.ApplicationID,
+			ClientSecret: repo.vcs.Secret,
+			AccessToken:  repo.repository.AccessToken,
+			RefreshToken: repo.repository.RefreshToken,
+			Refresher:    utils.RefreshToken(ctx, s.store, repo.repository.WebURL),
+		},
+		repo.vcs.InstanceURL,
+		repo.repository.ExternalID,
+		pullRequestID,
+		content,
+		threadStatus,
+	); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to post SQL review result to the pull request").SetInternal(err)
+	}
+
+	log.Debug("SQL review finished",
+		zap.String("pull_request", pullRequestID),
+		zap.String("status", string(status)),
+		zap.String("repository_id", repo.repository.ExternalID),
+		zap.String("vcs", string(repo.vcs.Type)),
+	)
+	return nil
+}
+
+// sqlAdviceForPullRequest reviews the SQL files and MyBatis mapper files changed in the pull request
+// of the first repository in the list.
+func (s *Server) sqlAdviceForPullRequest(ctx context.Context, repositoryList []*repoInfo, pullRequestID, externalURL string) (map[string][]advisor.Advice, error) {
+	repo := repositoryList[0]
+
+	prFiles, err := vcs.Get(repo.vcs.Type, vcs.ProviderConfig{}).ListPullRequestFile(
+		ctx,
+		common.OauthContext{
+			ClientID:     repo.vcs.ApplicationID,
+			ClientSecret: repo.vcs.Secret,
+			AccessToken:  repo.repository.AccessToken,
+			RefreshToken: repo.repository.RefreshToken,
+			Refresher:    utils.RefreshToken(ctx, s.store, repo.repository.WebURL),
+		},
+		repo.vcs.InstanceURL,
+		repo.repository.ExternalID,
+		pullRequestID,
+	)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list pull request file")
+	}
+
+	sqlFileName2Advice := s.sqlAdviceForSQLFiles(ctx, repositoryList, prFiles, externalURL)
+
+	if s.licenseService.IsFeatureEnabled(api.FeatureMybatisSQLReview) {
+		// If the commit file list contains the file which extension is xml and the content
+		// contains "https://mybatis.org/dtd/mybatis-3-mapper.dtd", we will try to apply
+		// sql-review to it.
+		// To apply sql-review to it, proceed as follows:
+		// 1. Look in the sibling and parent directories for directories containing similar
+		// <!DOCTYPE configuration
+		//   PUBLIC "-//mybatis.org//DTD Config 3.0//EN"
+		//   "https://mybatis.org/dtd/mybatis-3-config.dtd">
+		// of the xml file
+		// 2. If we can find it, then we will extract the sql from the mapper xml
+		// 3. match the environments in the configuration xml, look for the sql-review policy in the environment and apply it.
+		mybatisMapperXMLFiles := make(map[string]string)
+		var commitID string
+		for _, prFile := range prFiles {
+			if !strings.HasSuffix(prFile.Path, ".xml") {
+				continue
+			}
+			fileContent, err := vcs.Get(repo.vcs.Type, vcs.ProviderConfig{}).ReadFileContent(
+				ctx,
+				common.OauthContext{
+					ClientID:     repo.vcs.ApplicationID,
+					ClientSecret: repo.vcs.Secret,
+					AccessToken:  repo.repository.AccessToken,
+					RefreshToken: repo.repository.RefreshToken,
+					Refresher:    utils.RefreshToken(ctx, s.store, repo.repository.WebURL),
+				},
+				repo.vcs.InstanceURL,
+				repo.repository.ExternalID,
+				prFile.Path,
+				prFile.LastCommitID,
+			)
+			if err != nil {
+				return nil, errors.Wrapf(err, "failed to read file content of %q", prFile.Path)
+			}
+			if !isMybatisMapperXMLRegex.MatchString(fileContent) {
+				continue
+			}
+			mybatisMapperXMLFiles[prFile.Path] = fileContent
+			commitID = prFile.LastCommitID
+		}
+		if len(mybatisMapperXMLFiles) > 0 {
+			mapperAdvices, err := s.sqlAdviceForMybatisMapperFiles(ctx, mybatisMapperXMLFiles, commitID, repo)
+			if err != nil {
+				return nil, errors.Wrap(err, "failed to get sql advice for mybatis mapper files")
+			}
+			for filename, mapperAdvice := range mapperAdvices {
+				sqlFileName2Advice[filename] = mapperAdvice
+			}
+		}
+	}
+	return sqlFileName2Advice, nil
+}
+
 func (s *Server) sqlAdviceForMybatisMapperFiles(ctx context.Context, mybatisMapperContent map[string]string, commitID string, repoInfo *repoInfo) (map[string][]advisor.Advice, error) {
 	if len(mybatisMapperContent) == 0 {
 		return map[string][]advisor.Advice{}, nil
@@ -548,6 +872,14 @@ func (s *Server) sqlAdviceForMybatisMapperFile(ctx context.Context, datum *mybat
 		return nil, echo.NewHTTPError(http.StatusInternalServerError, "Failed to list environments").SetInternal(err)
 	}
 
+	// The namespaces of the <sql> elements can be referenced by the type aliases.
+	var namespaces []string
+	for id := range datum.sqlFragments {
+		if idx := strings.LastIndex(id, "."); idx >= 0 {
+			namespaces = append(namespaces, id[:idx])
+		}
+	}
+
 	for _, confEnv := range conf.Environments {
 		environmentIDs = append(environmentIDs, confEnv.ID)
 		for _, env := range allEnvironments {
@@ -576,7 +908,7 @@ func (s *Server) sqlAdviceForMybatisMapperFile(ctx context.Context, datum *mybat
 					return nil, echo.NewHTTPError(http.StatusInternalServerError, "Failed to get empty catalog").SetInternal(err)
 				}
 
-				mybatisSQLs, lineMapping, err := extractMybatisMapperSQL(datum.mapperContent)
+				mybatisSQLs, lineMapping, err := extractMybatisMapperSQL(datum.mapperContent, datum.sqlFragments, conf.ResolveTypeAliases(namespaces))
 				if err != nil {
 					return nil, echo.NewHTTPError(http.StatusInternalServerError, "Failed to extract mybatis mapper sql").SetInternal(err)
 				}
@@ -593,13 +925,21 @@ func (s *Server) sqlAdviceForMybatisMapperFile(ctx context.Context, datum *mybat
 					return nil, echo.NewHTTPError(http.StatusInternalServerError, "Failed to check sql review").SetInternal(err)
 				}
 				// Remap the line number to the original file.
+				// The variants of the same query may have the same advice, so we only keep the first one.
+				seen := make(map[advisor.Advice]bool)
 				for _, advice := range adviceList {
+					// The fix edits are based on the restored SQL instead of the mapper XML, so we cannot apply them to the file.
+					advice.Fix = nil
 					for _, line := range lineMapping {
 						if advice.Line <= line.SQLLastLine {
 							advice.Line = line.OriginalEleLine
 							break
 						}
 					}
+					if seen[advice] {
+						continue
+					}
+					seen[advice] = true
 					result = append(result, advice)
 				}
 			}
@@ -909,6 +1249,16 @@ func validateGitHubWebhookSignature256(signature, key string, body []byte) (bool
 	return subtle.ConstantTimeCompare([]byte(signature), []byte(got)) == 1, nil
 }
 
+// getFirstHeader returns the first non-empty value of the given header keys.
+func getFirstHeader(header http.Header, keys ...string) string {
+	for _, key := range keys {
+		if v := header.Get(key); v != "" {
+			return v
+		}
+	}
+	return ""
+}
+
 // parseBranchNameFromRefs parses the branch name from the refs field in the request.
 // https://docs.github.com/en/rest/git/refs
 // https://docs.gitlab.com/ee/user/project/integrations/webhook_events.html#push-events
@@ -930,6 +1280,25 @@ func (s *Server) processPushEvent(ctx context.Context, repoInfoList []*repoInfo,
 		return nil, errors.Errorf("empty repository list")
 	}
 
+	// The repositories with the pull request flow enabled roll out the changes when the pull request is merged,
+	// so the push events are ignored.
+	var pushRepoInfoList []*repoInfo
+	for _, repoInfo := range repoInfoList {
+		if repoInfo.repository.EnablePullRequestFlow {
+			log.Debug("Skip push event as the pull request flow is enabled", zap.Int("repository_id", repoInfo.repository.UID))
+			continue
+		}
+		pushRepoInfoList = append(pushRepoInfoList, repoInfo)
+	}
+	if len(pushRepoInfoList) == 0 {
+		return nil, nil
+	}
+	return s.processChangedFiles(ctx, pushRepoInfoList, baseVCSPushEvent, nil)
+}
+
+// processChangedFiles creates issues for the changed files in the push event. The issues are the drafts
+// of the pull request if pullRequest is not nil.
+func (s *Server) processChangedFiles(ctx context.Context, repoInfoList []*repoInfo, baseVCSPushEvent vcs.PushEvent, pullRequest *storepb.IssuePayloadPullRequest) ([]string, error) {
 	distinctFileList := baseVCSPushEvent.GetDistinctFileList()
 	if len(distinctFileList) == 0 {
 		var commitIDs []string
@@ -972,6 +1341,7 @@ func (s *Server) processPushEvent(ctx context.Context, repoInfoList []*repoInfo,
 				pushEvent,
 				repoInfo,
 				fileInfoListSorted,
+				pullRequest,
 			)
 			if err != nil {
 				return nil, err
@@ -1090,14 +1460,19 @@ const (
 
 // getFileInfo processes the file item against the candidate list of
 // repositories and returns the parsed migration information, file change type
-// and a single matched repository. It returns an error when none or multiple
+// and a single matched repository. In a monorepo, the base directories of the
+// projects may be nested, and the file belongs to the project with the most
+// specific base directory. It returns an error when none or multiple
 // repositories are matched.
 func getFileInfo(fileItem vcs.DistinctFileItem, repoInfoList []*repoInfo) (*db.MigrationInfo, fileType, *repoInfo, error) {
-	var migrationInfo *db.MigrationInfo
-	var fType fileType
-	var fileRepositoryList []*repoInfo
+	type fileMatch struct {
+		migrationInfo *db.MigrationInfo
+		fType         fileType
+		repoInfo      *repoInfo
+	}
+	var matchList []fileMatch
 	for _, repoInfo := range repoInfoList {
-		if !strings.HasPrefix(fileItem.FileName, repoInfo.repository.BaseDirectory) {
+		if !isFileInBaseDirectory(fileItem.FileName, repoInfo.repository.BaseDirectory) {
 			log.Debug("Ignored file outside the base directory",
 				zap.String("file", fileItem.FileName),
 				zap.String("base_directory", repoInfo.repository.BaseDirectory),
@@ -1131,10 +1506,7 @@ func getFileInfo(fileItem vcs.DistinctFileItem, repoInfoList []*repoInfo) (*db.M
 			if fileItem.IsYAML && mi.Type != db.Data {
 				return nil, fileTypeUnknown, nil, errors.New("only DML is allowed for YAML files in a tenant project")
 			}
-
-			migrationInfo = mi
-			fType = fileTypeMigration
-			fileRepositoryList = append(fileRepositoryList, repoInfo)
+			matchList = append(matchList, fileMatch{migrationInfo: mi, fType: fileTypeMigration, repoInfo: repoInfo})
 			continue
 		}
 
@@ -1147,34 +1519,57 @@ func getFileInfo(fileItem vcs.DistinctFileItem, repoInfoList []*repoInfo) (*db.M
 			continue
 		}
 		if si != nil {
-			migrationInfo = si
-			fType = fileTypeSchema
-			fileRepositoryList = append(fileRepositoryList, repoInfo)
+			matchList = append(matchList, fileMatch{migrationInfo: si, fType: fileTypeSchema, repoInfo: repoInfo})
 			continue
 		}
 	}
 
-	switch len(fileRepositoryList) {
+	// Only keep the matches with the most specific base directory.
+	var mostSpecificMatchList []fileMatch
+	for _, match := range matchList {
+		if len(mostSpecificMatchList) > 0 {
+			baseDirectory := mostSpecificMatchList[0].repoInfo.repository.BaseDirectory
+			if len(match.repoInfo.repository.BaseDirectory) < len(baseDirectory) {
+				continue
+			}
+			if len(match.repoInfo.repository.BaseDirectory) > len(baseDirectory) {
+				mostSpecificMatchList = nil
+			}
+		}
+		mostSpecificMatchList = append(mostSpecificMatchList, match)
+	}
+
+	switch len(mostSpecificMatchList) {
 	case 0:
 		return nil, fileTypeUnknown, nil, errors.Errorf("file change is not associated with any project")
 	case 1:
-		return migrationInfo, fType, fileRepositoryList[0], nil
+		match := mostSpecificMatchList[0]
+		return match.migrationInfo, match.fType, match.repoInfo, nil
 	default:
 		var projectList []string
-		for _, repoInfo := range fileRepositoryList {
-			projectList = append(projectList, repoInfo.project.Title)
+		for _, match := range mostSpecificMatchList {
+			projectList = append(projectList, match.repoInfo.project.Title)
 		}
 		return nil, fileTypeUnknown, nil, errors.Errorf("file change should be associated with exactly one project but found %s", strings.Join(projectList, ", "))
 	}
 }
 
+// isFileInBaseDirectory returns true if the file is under the base directory. The empty base directory is the repository root.
+func isFileInBaseDirectory(fileName, baseDirectory string) bool {
+	baseDirectory = strings.Trim(baseDirectory, "/")
+	if baseDirectory == "" {
+		return true
+	}
+	return strings.HasPrefix(fileName, baseDirectory+"/")
+}
+
 // processFilesInProject attempts to create new issue(s) according to the repository type.
 // 1. For a state based project, we create one issue per schema file, and one issue for all of the rest migration files (if any).
 // 2. For a migration based project, we create one issue for all of the migration files. All schema files are ignored.
 // It returns "created=true" when new issue(s) has been created,
 // along with the creation message to be presented in the UI. An *echo.HTTPError
 // is returned in case of the error during the process.
-func (s *Server) processFilesInProject(ctx context.Context, pushEvent vcs.PushEvent, repoInfo *repoInfo, fileInfoList []fileInfo) (string, bool, []*store.ActivityMessage, *echo.HTTPError) {
+func (s *Server) processFilesInProject(ctx context.Context, pushEvent vcs.PushEvent, repoInfo *repoInfo, fileInfoList []fileInfo, pullRequest *storepb.IssuePayloadPullRequest) (string, bool, []*store.ActivityMessage, *echo.HTTPError) {
 	if repoInfo.project.TenantMode == api.TenantModeTenant && !s.licenseService.IsFeatureEnabled(api.FeatureMultiTenancy) {
 		return "", false, nil, echo.NewHTTPError(http.StatusForbidden, api.FeatureMultiTenancy.AccessErrorMessage())
 	}
@@ -1195,7 +1590,7 @@ func (s *Server) processFilesInProject(ctx context.Context, pushEvent vcs.PushEv
 					databaseName := fileInfo.migrationInfo.Database
 					issueName := fmt.Sprintf(sdlIssueNameTemplate, databaseName, "Alter schema")
 					issueDescription := fmt.Sprintf("Apply schema diff by file %s", strings.TrimPrefix(fileInfo.item.FileName, repoInfo.repository.BaseDirectory+"/"))
-					if err := s.createIssueFromMigrationDetailList(ctx, issueName, issueDescription, pushEvent, creatorID, repoInfo.project.UID, migrationDetailListForFile); err != nil {
+					if err := s.createIssueFromMigrationDetailList(ctx, issueName, issueDescription, pushEvent, creatorID, repoInfo, migrationDetailListForFile, pullRequest); err != nil {
 						return "", false, activityCreateList, echo.NewHTTPError(http.StatusInternalServerError, "Failed to create issue").SetInternal(err)
 					}
 					createdIssueList = append(createdIssueList, issueName)
@@ -1236,7 +1631,7 @@ func (s *Server) processFilesInProject(ctx context.Context, pushEvent vcs.PushEv
 	description := strings.ReplaceAll(fileInfoList[0].migrationInfo.Description, "_", " ")
 	issueName := fmt.Sprintf(issueNameTemplate, databaseName, migrateType, description)
 	issueDescription := fmt.Sprintf("By VCS files:\n\n%s\n", strings.Join(fileNameList, "\n"))
-	if err := s.createIssueFromMigrationDetailList(ctx, issueName, issueDescription, pushEvent, creatorID, repoInfo.project.UID, migrationDetailList); err != nil {
+	if err := s.createIssueFromMigrationDetailList(ctx, issueName, issueDescription, pushEvent, creatorID, repoInfo, migrationDetailList, pullRequest); err != nil {
 		return "", len(createdIssueList) != 0, activityCreateList, echo.NewHTTPError(http.StatusInternalServerError, fmt.Sprintf("Failed to create issue %s", issueName)).SetInternal(err)
 	}
 	createdIssueList = append(createdIssueList, issueName)
@@ -1264,7 +1659,8 @@ func sortFilesBySchemaVersion(fileInfoList []fileInfo) []fileInfo {
 	return ret
 }
 
-func (s *Server) createIssueFromMigrationDetailList(ctx context.Context, issueName, issueDescription string, pushEvent vcs.PushEvent, creatorID, projectID int, migrationDetailList []*api.MigrationDetail) error {
+func (s *Server) createIssueFromMigrationDetailList(ctx context.Context, issueName, issueDescription string, pushEvent vcs.PushEvent, creatorID int, repoInfo *repoInfo, migrationDetailList []*api.MigrationDetail, pullRequest *storepb.IssuePayloadPullRequest) error {
+	projectID := repoInfo.project.UID
 	createContext, err := json.Marshal(
 		&api.MigrationContext{
 			VCSPushEvent: &pushEvent,
@@ -1290,6 +1686,18 @@ func (s *Server) createIssueFromMigrationDetailList(ctx context.Context, issueNa
 		AssigneeID:            api.SystemBotID,
 		AssigneeNeedAttention: true,
 		CreateContext:         string(createContext),
+		VCSCommit: &storepb.IssuePayloadVCSCommit{
+			RepositoryId: int32(repoInfo.repository.UID),
+			CommitId:     pushEvent.CommitList[len(pushEvent.CommitList)-1].ID,
+		},
+	}
+	if pullRequest != nil {
+		issueCreate.PullRequest = &storepb.IssuePayloadPullRequest{
+			RepositoryId:  int32(repoInfo.repository.UID),
+			PullRequestId: pullRequest.PullRequestId,
+			Url:           pullRequest.Url,
+			HeadCommitId:  pullRequest.HeadCommitId,
+		}
 	}
 	issue, err := s.createIssue(ctx, issueCreate, creatorID)
 	if err != nil {
@@ -1775,6 +2183,55 @@ func (s *Server) tryUpdateTasksFromModifiedFile(ctx context.Context, databases [
 	return nil
 }
 
+// convertSQLAdviceToMarkdown will convert SQL advice map to the markdown content of the
+// pull request comment, along with the overall status.
+func convertSQLAdviceToMarkdown(adviceMap map[string][]advisor.Advice) (advisor.Status, string) {
+	status := advisor.Success
+	var lines []string
+
+	fileList := []string{}
+	for filePath := range adviceMap {
+		fileList = append(fileList, filePath)
+	}
+	sort.Strings(fileList)
+
+	for _, filePath := range fileList {
+		var adviceLines []string
+		for _, advice := range adviceMap[filePath] {
+			if advice.Code == 0 || advice.Status == advisor.Success {
+				continue
+			}
+
+			line := advice.Line
+			if line <= 0 {
+				line = 1
+			}
+
+			if advice.Status == advisor.Error {
+				status = advice.Status
+			} else if advice.Status == advisor.Warn && status != advisor.Error {
+				status = advice.Status
+			}
+
+			content := fmt.Sprintf("- **%s** line %d `%s`: %s. [Doc](%s#%d)", advice.Status, line, advice.Title, advice.Content, sqlReviewDocs, advice.Code)
+			if advice.Fix != nil {
+				content = fmt.Sprintf("%s\n  - Suggested fix: %s", content, advice.Fix.Title)
+			}
+			adviceLines = append(adviceLines, content)
+		}
+		if len(adviceLines) > 0 {
+			lines = append(lines, fmt.Sprintf("`%s`", filePath))
+			lines = append(lines, adviceLines...)
+			lines = append(lines, "")
+		}
+	}
+
+	if len(lines) == 0 {
+		return status, "### Bytebase SQL Review\n\nNo SQL review issues found."
+	}
+	return status, fmt.Sprintf("### Bytebase SQL Review\n\n%s", strings.TrimSpace(strings.Join(lines, "\n")))
+}
+
 // convertSQLAdviceToGitLabCIResult will convert SQL advice map to GitLab test output format.
 // GitLab test report: https://docs.gitlab.com/ee/ci/testing/unit_test_reports.html
 // junit XML format: https://llg.cubic.org/docs/junit/
@@ -1815,6 +2272,9 @@ func convertSQLAdviceToGitLabCIResult(adviceMap map[string][]advisor.Advice) *ap
 				sqlReviewDocs,
 				advice.Code,
 			)
+			if advice.Fix != nil {
+				content = fmt.Sprintf("%s\nSuggested fix: %s", content, advice.Fix.Title)
+			}
 
 			testcase := fmt.Sprintf(
 				"<testcase name=\"%s\" classname=\"%s\" file=\"%s#L%d\">\n<failure>\n%s\n</failure>\n</testcase>",
@@ -1844,6 +2304,7 @@ func convertSQLAdviceToGitLabCIResult(adviceMap map[string][]advisor.Advice) *ap
 				strings.Join(testsuiteList, "\n"),
 			),
 		},
+		FixList: convertSQLAdviceToFixList(fileList, adviceMap),
 	}
 }
 
@@ -1893,6 +2354,9 @@ func convertSQLAdviceToGitHubActionResult(adviceMap map[string][]advisor.Advice)
 				sqlReviewDocs,
 				advice.Code,
 			)
+			if advice.Fix != nil {
+				msg = fmt.Sprintf("%s\nSuggested fix: %s", msg, advice.Fix.Title)
+			}
 			// To indent the output message in action
 			messageList = append(messageList, strings.ReplaceAll(msg, "\n", "%0A"))
 		}
@@ -1900,9 +2364,29 @@ func convertSQLAdviceToGitHubActionResult(adviceMap map[string][]advisor.Advice)
 	return &api.VCSSQLReviewResult{
 		Status:  status,
 		Content: messageList,
+		FixList: convertSQLAdviceToFixList(fileList, adviceMap),
 	}
 }
 
+// convertSQLAdviceToFixList collects the machine-applicable fixes in the SQL advice map in the file order.
+func convertSQLAdviceToFixList(fileList []string, adviceMap map[string][]advisor.Advice) []api.VCSSQLReviewFix {
+	var fixList []api.VCSSQLReviewFix
+	for _, filePath := range fileList {
+		for _, advice := range adviceMap[filePath] {
+			if advice.Fix == nil || advice.Status == advisor.Success {
+				continue
+			}
+			fixList = append(fixList, api.VCSSQLReviewFix{
+				FilePath: filePath,
+				Line:     advice.Line,
+				Code:     advice.Code.Int(),
+				Fix:      advice.Fix,
+			})
+		}
+	}
+	return fixList
+}
+
 func filterGitHubBytebaseCommit(list []github.WebhookCommit) []github.WebhookCommit {
 	var result []github.WebhookCommit
 	for _, commit := range list {
@@ -1914,6 +2398,28 @@ func filterGitHubBytebaseCommit(list []github.WebhookCommit) []github.WebhookCom
 	return result
 }
 
+func filterAzureBytebaseCommit(list []azure.WebhookCommit) []azure.WebhookCommit {
+	var result []azure.WebhookCommit
+	for _, commit := range list {
+		if commit.Author.Name == vcs.BytebaseAuthorName && commit.Author.Email == vcs.BytebaseAuthorEmail {
+			continue
+		}
+		result = append(result, commit)
+	}
+	return result
+}
+
+func filterGiteaBytebaseCommit(list []gitea.WebhookCommit) []gitea.WebhookCommit {
+	var result []gitea.WebhookCommit
+	for _, commit := range list {
+		if commit.Author.Name == vcs.BytebaseAuthorName && commit.Author.Email == vcs.BytebaseAuthorEmail {
+			continue
+		}
+		result = append(result, commit)
+	}
+	return result
+}
+
 func filterGitLabBytebaseCommit(list []gitlab.WebhookCommit) []gitlab.WebhookCommit {
 	var result []gitlab.WebhookCommit
 	for _, commit := range list {
@@ -1955,21 +2461,49 @@ func extractDBTypeFromJDBCConnectionString(jdbcURL string) (db.Type, error) {
 	return db.UnknownType, nil
 }
 
-// extractMybatisMapperSQL will extract the SQL from mybatis mapper XML.
-func extractMybatisMapperSQL(mapperContent string) (string, []*ast.MybatisSQLLineMapping, error) {
+// isMybatisMapperXMLRegex is the regex to match the mybatis mapper XML file, if it can match the file content,
+// we regard the file as the mybatis mapper XML file.
+var isMybatisMapperXMLRegex = regexp.MustCompile(`(?i)http(s)?://mybatis.org/dtd/mybatis-3-mapper.dtd`)
+
+// maxMybatisSQLVariants is the max number of the dynamic SQL branch combinations to review for each mybatis query.
+const maxMybatisSQLVariants = 64
+
+// extractMybatisMapperSQL will extract the SQL of every dynamic SQL branch combination from mybatis mapper XML.
+// The <include> elements can reference the given <sql> elements in other mapper XML files by the fully qualified id,
+// whose namespace can be the type alias.
+func extractMybatisMapperSQL(mapperContent string, sqlFragments map[string]*ast.SQLNode, typeAliases map[string]string) (string, []*ast.MybatisSQLLineMapping, error) {
 	mybatisMapperParser := mapperparser.NewParser(mapperContent)
 	mybatisMapperNode, err := mybatisMapperParser.Parse()
 	if err != nil {
 		return "", nil, errors.Wrapf(err, "failed to parse mybatis mapper xml")
 	}
+	mybatisMapperParser.AddSQLFragments(sqlFragments)
+	mybatisMapperParser.SetTypeAliases(typeAliases)
 	var sb strings.Builder
-	lineMapping, err := mybatisMapperNode.RestoreSQLWithLineMapping(mybatisMapperParser.GetRestoreContext(), &sb)
+	lineMapping, err := mybatisMapperNode.RestoreSQLVariantsWithLineMapping(mybatisMapperParser.GetRestoreContext(), &sb, maxMybatisSQLVariants)
 	if err != nil {
 		return "", nil, errors.Wrapf(err, "failed to restore mybatis mapper xml")
 	}
 	return sb.String(), lineMapping, nil
 }
 
+// extractMybatisSQLFragments extracts the <sql> elements keyed by the fully qualified id from the mybatis mapper XML files.
+// The mapper XML files which cannot be parsed are skipped, they will be reported when reviewing themselves.
+func extractMybatisSQLFragments(mapperFiles map[string]string) map[string]*ast.SQLNode {
+	sqlFragments := make(map[string]*ast.SQLNode)
+	for mapperFilePath, mapperFileContent := range mapperFiles {
+		mybatisMapperParser := mapperparser.NewParser(mapperFileContent)
+		if _, err := mybatisMapperParser.Parse(); err != nil {
+			log.Debug("Failed to parse mybatis mapper xml for sql fragments", zap.String("file", mapperFilePath), zap.Error(err))
+			continue
+		}
+		for id, fragment := range mybatisMapperParser.GetSQLFragments() {
+			sqlFragments[id] = fragment
+		}
+	}
+	return sqlFragments
+}
+
 // mybatisMapperXMLFileDatum is the metadata of mybatis mapper XML file.
 // It maintains the mybatis mapper XML file path, mapper XML file content, and the corresponding mybatis configuration XML content.
 type mybatisMapperXMLFileDatum struct {
@@ -1983,6 +2517,9 @@ type mybatisMapperXMLFileDatum struct {
 	// configContent is the content of the mybatis configuration XML file,
 	// it is empty if the mybatis configuration XML file is not found.
 	configContent string
+	// sqlFragments is the <sql> elements keyed by the fully qualified id in the mybatis mapper XML files
+	// of the pull request and the directories we look up for the configuration XML file.
+	sqlFragments map[string]*ast.SQLNode
 }
 
 // buildMybatisMapperXMLFileData will build the mybatis mapper XML file data.
@@ -2007,6 +2544,9 @@ func (s *Server) buildMybatisMapperXMLFileData(ctx context.Context, repoInfo *re
 	// the key is the mybatis configuration XML file ls-tree syntax path, and value is the mybatis configuration XML file content.
 	// each value is configPathCache must be the key of configCache.
 	configCache := make(map[string]string)
+	// otherMapperFiles is the mybatis mapper XML files found when looking up the configuration XML file,
+	// which may contain the <sql> elements referenced by the mapper XML files.
+	otherMapperFiles := make(map[string]string)
 
 	for mapperFilePath, mapperFileContent := range mapperFiles {
 		configPath := mapperFilePath
@@ -2065,6 +2605,9 @@ func (s *Server) buildMybatisMapperXMLFileData(ctx context.Context, repoInfo *re
 				if err != nil {
 					return nil, errors.Wrapf(err, "failed to read file content for repository %q commitID %q file %q", repoInfo.repository.WebURL, commitID, file.Path)
 				}
+				if isMybatisMapperXMLRegex.MatchString(fileContent) {
+					otherMapperFiles[file.Path] = fileContent
+				}
 				if !isMybatisConfigXMLRegex.MatchString(fileContent) {
 					continue
 				}
@@ -2080,5 +2623,14 @@ func (s *Server) buildMybatisMapperXMLFileData(ctx context.Context, repoInfo *re
 		}
 		mybatisMapperXMLFileData = append(mybatisMapperXMLFileData, datum)
 	}
+
+	// The mapper XML files in the pull request take precedence.
+	for mapperFilePath, mapperFileContent := range mapperFiles {
+		otherMapperFiles[mapperFilePath] = mapperFileContent
+	}
+	sqlFragments := extractMybatisSQLFragments(otherMapperFiles)
+	for _, datum := range mybatisMapperXMLFileData {
+		datum.sqlFragments = sqlFragments
+	}
 	return mybatisMapperXMLFileData, nil
 }
@@ -0,0 +1,520 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/gosimple/slug"
+	"github.com/labstack/echo/v4"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	"github.com/bytebase/bytebase/backend/common"
+	"github.com/bytebase/bytebase/backend/common/log"
+	api "github.com/bytebase/bytebase/backend/legacyapi"
+	"github.com/bytebase/bytebase/backend/plugin/advisor"
+	"github.com/bytebase/bytebase/backend/plugin/vcs"
+	"github.com/bytebase/bytebase/backend/plugin/vcs/azure"
+	"github.com/bytebase/bytebase/backend/plugin/vcs/bitbucket"
+	"github.com/bytebase/bytebase/backend/plugin/vcs/gitea"
+	"github.com/bytebase/bytebase/backend/plugin/vcs/github"
+	"github.com/bytebase/bytebase/backend/plugin/vcs/gitlab"
+	"github.com/bytebase/bytebase/backend/store"
+	"github.com/bytebase/bytebase/backend/utils"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+const (
+	// pullRequestCommitStatusContext is the context of the commit status posted by the pull request flow.
+	pullRequestCommitStatusContext = "bytebase/review"
+)
+
+// pullRequestDraft is an open issue drafted from a pull request.
+type pullRequestDraft struct {
+	issue    *store.IssueMessage
+	payload  *storepb.IssuePayload
+	repoInfo *repoInfo
+}
+
+func (s *Server) processGitHubPullRequestEvent(c echo.Context) error {
+	body, err := io.ReadAll(c.Request().Body)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "Failed to read webhook request").SetInternal(err)
+	}
+	var pullRequestEvent github.WebhookPullRequestEvent
+	if err := json.Unmarshal(body, &pullRequestEvent); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "Malformed pull request event").SetInternal(err)
+	}
+	event, ok := pullRequestEvent.ToVCS()
+	if !ok {
+		return c.String(http.StatusOK, "OK")
+	}
+
+	validSecret := func(repo *store.RepositoryMessage) (bool, error) {
+		ok, err := validateGitHubWebhookSignature256(c.Request().Header.Get("X-Hub-Signature-256"), repo.WebhookSecretToken, body)
+		if err != nil {
+			return false, echo.NewHTTPError(http.StatusInternalServerError, "Failed to validate GitHub webhook signature").SetInternal(err)
+		}
+		return ok, nil
+	}
+	message, err := s.processPullRequestEvent(c.Request().Context(), c.Param("id"), event, validSecret)
+	if err != nil {
+		return err
+	}
+	return c.String(http.StatusOK, message)
+}
+
+func (s *Server) processGitLabMergeRequestEvent(c echo.Context, body []byte) error {
+	var mergeRequestEvent gitlab.WebhookMergeRequestEvent
+	if err := json.Unmarshal(body, &mergeRequestEvent); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "Malformed merge request event").SetInternal(err)
+	}
+	event, ok := mergeRequestEvent.ToVCS()
+	if !ok {
+		return c.String(http.StatusOK, "OK")
+	}
+
+	validSecret := func(repo *store.RepositoryMessage) (bool, error) {
+		return c.Request().Header.Get("X-Gitlab-Token") == repo.WebhookSecretToken, nil
+	}
+	message, err := s.processPullRequestEvent(c.Request().Context(), c.Param("id"), event, validSecret)
+	if err != nil {
+		return err
+	}
+	return c.String(http.StatusOK, message)
+}
+
+func (s *Server) processGiteaPullRequestEvent(c echo.Context) error {
+	body, err := io.ReadAll(c.Request().Body)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "Failed to read webhook request").SetInternal(err)
+	}
+	var pullRequestEvent gitea.WebhookPullRequestEvent
+	if err := json.Unmarshal(body, &pullRequestEvent); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "Malformed pull request event").SetInternal(err)
+	}
+	event, ok := pullRequestEvent.ToVCS()
+	if !ok {
+		return c.String(http.StatusOK, "OK")
+	}
+
+	signature := getFirstHeader(c.Request().Header, "X-Gitea-Signature", "X-Forgejo-Signature")
+	validSecret := func(repo *store.RepositoryMessage) (bool, error) {
+		ok, err := validateGitHubWebhookSignature256(signature, repo.WebhookSecretToken, body)
+		if err != nil {
+			return false, echo.NewHTTPError(http.StatusInternalServerError, "Failed to validate Gitea webhook signature").SetInternal(err)
+		}
+		return ok, nil
+	}
+	message, err := s.processPullRequestEvent(c.Request().Context(), c.Param("id"), event, validSecret)
+	if err != nil {
+		return err
+	}
+	return c.String(http.StatusOK, message)
+}
+
+func (s *Server) processBitbucketPullRequestEvent(c echo.Context, eventKey string) error {
+	body, err := io.ReadAll(c.Request().Body)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "Failed to read webhook request").SetInternal(err)
+	}
+	var pullRequestEvent bitbucket.WebhookPullRequestEvent
+	if err := json.Unmarshal(body, &pullRequestEvent); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "Malformed pull request event").SetInternal(err)
+	}
+	event, ok := pullRequestEvent.ToVCS(eventKey)
+	if !ok {
+		return c.String(http.StatusOK, "OK")
+	}
+
+	// Bitbucket doesn't sign the payload, same as the push event. The event is verified against
+	// the Bitbucket API in processPullRequestEvent before taking any action.
+	validSecret := func(*store.RepositoryMessage) (bool, error) {
+		return true, nil
+	}
+	message, err := s.processPullRequestEvent(c.Request().Context(), c.Param("id"), event, validSecret)
+	if err != nil {
+		return err
+	}
+	return c.String(http.StatusOK, message)
+}
+
+// processAzurePullRequestEvent runs the pull request flow for the Azure DevOps pull request event.
+// The SQL review CI of the repository is handled by reviewAzurePullRequest separately.
+func (s *Server) processAzurePullRequestEvent(ctx context.Context, webhookEndpointID string, eventType azure.WebhookEventType, resource json.RawMessage, validSecret func(*store.RepositoryMessage) bool) (string, error) {
+	var pullRequest azure.PullRequest
+	if err := json.Unmarshal(resource, &pullRequest); err != nil {
+		return "", echo.NewHTTPError(http.StatusBadRequest, "Malformed pull request event").SetInternal(err)
+	}
+	event, ok := pullRequest.ToVCS(eventType)
+	if !ok {
+		return "", nil
+	}
+	return s.processPullRequestEvent(ctx, webhookEndpointID, event, func(repo *store.RepositoryMessage) (bool, error) {
+		return validSecret(repo), nil
+	})
+}
+
+// processPullRequestEvent runs the pull request flow for the repositories with the flow enabled.
+//  1. The pull request is opened or updated: the previous draft issues are canceled, and new draft issues
+//     are created for the migration files in the pull request. The SQL review result and the draft issues
+//     are posted as the pull request comment.
+//  2. The pull request is merged: the draft issues are marked as merged, then they are rolled out as usual.
+//  3. The pull request is closed without merging: the draft issues are canceled.
+//
+// The payload is not trusted, the pull request is fetched from the VCS API and the event is
+// ignored if it doesn't match the actual state of the pull request.
+func (s *Server) processPullRequestEvent(ctx context.Context, webhookEndpointID string, event vcs.PullRequestEvent, validSecret repositoryFilter) (string, error) {
+	filter := func(repo *store.RepositoryMessage) (bool, error) {
+		if !repo.EnablePullRequestFlow {
+			return false, nil
+		}
+		ok, err := validSecret(repo)
+		if err != nil || !ok {
+			return false, err
+		}
+		return s.isWebhookEventBranch("refs/heads/"+event.BaseBranch, repo.BranchFilter)
+	}
+	repositoryList, err := s.filterRepository(ctx, webhookEndpointID, event.RepositoryID, filter)
+	if err != nil {
+		return "", err
+	}
+	if len(repositoryList) == 0 {
+		log.Debug("Empty handle repo list. Ignore this pull request event.")
+		return "", nil
+	}
+
+	pullRequest, err := s.getPullRequest(ctx, repositoryList[0], event.PullRequestID)
+	if err != nil {
+		if common.ErrorCode(err) == common.NotFound {
+			return "", nil
+		}
+		return "", echo.NewHTTPError(http.StatusInternalServerError, "Failed to get the pull request").SetInternal(err)
+	}
+	event, ok := verifyPullRequestEvent(event, pullRequest)
+	if !ok {
+		log.Warn("The pull request event doesn't match the pull request state, ignore it",
+			zap.String("repository", event.RepositoryURL),
+			zap.String("pull_request", event.PullRequestID),
+			zap.String("action", string(event.Action)),
+			zap.String("state", string(pullRequest.State)),
+		)
+		return "", nil
+	}
+
+	setting, err := s.store.GetWorkspaceGeneralSetting(ctx)
+	if err != nil {
+		return "", echo.NewHTTPError(http.StatusInternalServerError, "Failed to find workspace setting").SetInternal(err)
+	}
+	drafts, err := s.listPullRequestDrafts(ctx, repositoryList, event.PullRequestID)
+	if err != nil {
+		return "", echo.NewHTTPError(http.StatusInternalServerError, "Failed to find the draft issues of the pull request").SetInternal(err)
+	}
+
+	switch event.Action {
+	case vcs.PullRequestEventOpened, vcs.PullRequestEventUpdated:
+		for _, draft := range drafts {
+			// The webhook may be delivered more than once.
+			if draft.payload.PullRequest.HeadCommitId == event.HeadCommitID {
+				return "", nil
+			}
+		}
+		comment := fmt.Sprintf("Superseded by the commit %s of the pull request.", event.HeadCommitID)
+		if err := s.cancelPullRequestDrafts(ctx, drafts, comment); err != nil {
+			return "", err
+		}
+		return s.draftPullRequest(ctx, repositoryList, event, setting.ExternalUrl)
+	case vcs.PullRequestEventMerged:
+		if len(drafts) == 0 {
+			return "", nil
+		}
+		for _, draft := range drafts {
+			draft.payload.PullRequest.Merged = true
+			payloadBytes, err := protojson.Marshal(draft.payload)
+			if err != nil {
+				return "", echo.NewHTTPError(http.StatusInternalServerError, "Failed to marshal issue payload").SetInternal(err)
+			}
+			payloadStr := string(payloadBytes)
+			updatedIssue, err := s.store.UpdateIssueV2(ctx, draft.issue.UID, &store.UpdateIssueMessage{Payload: &payloadStr}, api.SystemBotID)
+			if err != nil {
+				return "", echo.NewHTTPError(http.StatusInternalServerError, fmt.Sprintf("Failed to update issue %d", draft.issue.UID)).SetInternal(err)
+			}
+			s.stateCfg.CommitStatusReporting.Store(updatedIssue.UID, updatedIssue)
+		}
+		content := fmt.Sprintf("### Bytebase\n\nThe pull request is merged, the issues will be rolled out after approval.\n\n%s", formatPullRequestDrafts(drafts, setting.ExternalUrl))
+		s.commentPullRequest(ctx, repositoryList[0], event.PullRequestID, content)
+		return fmt.Sprintf("Rolling out %d issue(s) of the merged pull request", len(drafts)), nil
+	case vcs.PullRequestEventClosed:
+		if len(drafts) == 0 {
+			return "", nil
+		}
+		if err := s.cancelPullRequestDrafts(ctx, drafts, "The pull request is closed without merging."); err != nil {
+			return "", err
+		}
+		content := fmt.Sprintf("### Bytebase\n\nThe pull request is closed, the issues are canceled.\n\n%s", formatPullRequestDrafts(drafts, setting.ExternalUrl))
+		s.commentPullRequest(ctx, repositoryList[0], event.PullRequestID, content)
+		return fmt.Sprintf("Canceled %d issue(s) of the closed pull request", len(drafts)), nil
+	}
+	return "", nil
+}
+
+// verifyPullRequestEvent checks the event against the pull request fetched from the VCS API. It
+// returns false if the action doesn't match the pull request state or the target branch. The head
+// commit of the returned event is taken from the pull request.
+func verifyPullRequestEvent(event vcs.PullRequestEvent, pullRequest *vcs.PullRequest) (vcs.PullRequestEvent, bool) {
+	if pullRequest.BaseBranch != event.BaseBranch {
+		return event, false
+	}
+	switch event.Action {
+	case vcs.PullRequestEventOpened, vcs.PullRequestEventUpdated:
+		if pullRequest.State != vcs.PullRequestStateOpen {
+			return event, false
+		}
+	case vcs.PullRequestEventMerged:
+		if pullRequest.State != vcs.PullRequestStateMerged {
+			return event, false
+		}
+	case vcs.PullRequestEventClosed:
+		if pullRequest.State != vcs.PullRequestStateClosed {
+			return event, false
+		}
+	default:
+		return event, false
+	}
+	event.HeadCommitID = pullRequest.HeadCommitID
+	return event, true
+}
+
+// draftPullRequest creates the draft issues for the migration files in the pull request, and posts
+// the draft issues and the SQL review result to the pull request.
+func (s *Server) draftPullRequest(ctx context.Context, repositoryList []*repoInfo, event vcs.PullRequestEvent, externalURL string) (string, error) {
+	repo := repositoryList[0]
+	prFiles, err := vcs.Get(repo.vcs.Type, vcs.ProviderConfig{}).ListPullRequestFile(
+		ctx,
+		common.OauthContext{
+			ClientID:     repo.vcs.ApplicationID,
+			ClientSecret: repo.vcs.Secret,
+			AccessToken:  repo.repository.AccessToken,
+			RefreshToken: repo.repository.RefreshToken,
+			Refresher:    utils.RefreshToken(ctx, s.store, repo.repository.WebURL),
+		},
+		repo.vcs.InstanceURL,
+		repo.repository.ExternalID,
+		event.PullRequestID,
+	)
+	if err != nil {
+		return "", echo.NewHTTPError(http.StatusInternalServerError, "Failed to list pull request files").SetInternal(err)
+	}
+
+	createdMessages, err := s.processChangedFiles(
+		ctx,
+		repositoryList,
+		convertPullRequestToPushEvent(event, prFiles),
+		&storepb.IssuePayloadPullRequest{
+			PullRequestId: event.PullRequestID,
+			Url:           event.URL,
+			HeadCommitId:  event.HeadCommitID,
+		},
+	)
+	if err != nil {
+		return "", err
+	}
+	drafts, err := s.listPullRequestDrafts(ctx, repositoryList, event.PullRequestID)
+	if err != nil {
+		return "", echo.NewHTTPError(http.StatusInternalServerError, "Failed to find the draft issues of the pull request").SetInternal(err)
+	}
+	if len(drafts) == 0 {
+		log.Debug("No draft issue is created for the pull request", zap.String("pull_request", event.URL))
+		return "", nil
+	}
+
+	sqlFileName2Advice, err := s.sqlAdviceForPullRequest(ctx, repositoryList, event.PullRequestID, externalURL)
+	if err != nil {
+		return "", echo.NewHTTPError(http.StatusInternalServerError, "Failed to review SQL in the pull request").SetInternal(err)
+	}
+	reviewStatus, reviewContent := convertSQLAdviceToMarkdown(sqlFileName2Advice)
+
+	content := fmt.Sprintf("### Bytebase\n\nThe issues are drafted from the pull request, they will be rolled out after the pull request is merged.\n\n%s\n\n%s", formatPullRequestDrafts(drafts, externalURL), reviewContent)
+	s.commentPullRequest(ctx, repo, event.PullRequestID, content)
+
+	commitStatus := &vcs.CommitStatus{
+		State:       vcs.CommitStatusSuccess,
+		Context:     pullRequestCommitStatusContext,
+		Description: fmt.Sprintf("Drafted %d issue(s)", len(drafts)),
+		TargetURL:   getIssueLink(drafts[0].issue, externalURL),
+	}
+	if reviewStatus == advisor.Error {
+		commitStatus.State = vcs.CommitStatusFailure
+		commitStatus.Description = "SQL review failed"
+	}
+	s.setPullRequestCommitStatus(ctx, repo, event.HeadCommitID, commitStatus)
+
+	return strings.Join(createdMessages, "\n"), nil
+}
+
+// listPullRequestDrafts lists the open draft issues of the pull request in the projects of the repositories.
+func (s *Server) listPullRequestDrafts(ctx context.Context, repositoryList []*repoInfo, pullRequestID string) ([]*pullRequestDraft, error) {
+	var drafts []*pullRequestDraft
+	for _, repo := range repositoryList {
+		issues, err := s.store.ListIssueV2(ctx, &store.FindIssueMessage{
+			ProjectUID: &repo.project.UID,
+			StatusList: []api.IssueStatus{api.IssueOpen},
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, issue := range issues {
+			payload := &storepb.IssuePayload{}
+			if err := protojson.Unmarshal([]byte(issue.Payload), payload); err != nil {
+				return nil, errors.Wrapf(err, "failed to unmarshal payload of issue %d", issue.UID)
+			}
+			if !isPullRequestDraft(payload, repo.repository.UID, pullRequestID) {
+				continue
+			}
+			drafts = append(drafts, &pullRequestDraft{
+				issue:    issue,
+				payload:  payload,
+				repoInfo: repo,
+			})
+		}
+	}
+	return drafts, nil
+}
+
+// isPullRequestDraft returns true if the issue is drafted from the pull request of the repository
+// and has not been merged.
+func isPullRequestDraft(payload *storepb.IssuePayload, repositoryUID int, pullRequestID string) bool {
+	pullRequest := payload.PullRequest
+	if pullRequest == nil || pullRequest.Merged {
+		return false
+	}
+	return int(pullRequest.RepositoryId) == repositoryUID && pullRequest.PullRequestId == pullRequestID
+}
+
+func (s *Server) cancelPullRequestDrafts(ctx context.Context, drafts []*pullRequestDraft, comment string) error {
+	for _, draft := range drafts {
+		if err := s.TaskScheduler.ChangeIssueStatus(ctx, draft.issue, api.IssueCanceled, api.SystemBotID, comment); err != nil {
+			return echo.NewHTTPError(http.StatusInternalServerError, fmt.Sprintf("Failed to cancel issue %d", draft.issue.UID)).SetInternal(err)
+		}
+	}
+	return nil
+}
+
+// commentPullRequest posts the comment to the pull request. The pull request flow doesn't
+// fail on the comment error because the issues have been changed.
+func (s *Server) commentPullRequest(ctx context.Context, repo *repoInfo, pullRequestID, content string) {
+	if err := vcs.Get(repo.vcs.Type, vcs.ProviderConfig{}).CreatePullRequestComment(
+		ctx,
+		common.OauthContext{
+			ClientID:     repo.vcs.ApplicationID,
+			ClientSecret: repo.vcs.Secret,
+			AccessToken:  repo.repository.AccessToken,
+			RefreshToken: repo.repository.RefreshToken,
+			Refresher:    utils.RefreshToken(ctx, s.store, repo.repository.WebURL),
+		},
+		repo.vcs.InstanceURL,
+		repo.repository.ExternalID,
+		pullRequestID,
+		content,
+	); err != nil {
+		log.Warn("Failed to comment on the pull request",
+			zap.String("repository", repo.repository.WebURL),
+			zap.String("pull_request", pullRequestID),
+			zap.Error(err),
+		)
+	}
+}
+
+// getPullRequest gets the pull request from the VCS API.
+func (s *Server) getPullRequest(ctx context.Context, repo *repoInfo, pullRequestID string) (*vcs.PullRequest, error) {
+	return vcs.Get(repo.vcs.Type, vcs.ProviderConfig{}).GetPullRequest(
+		ctx,
+		common.OauthContext{
+			ClientID:     repo.vcs.ApplicationID,
+			ClientSecret: repo.vcs.Secret,
+			AccessToken:  repo.repository.AccessToken,
+			RefreshToken: repo.repository.RefreshToken,
+			Refresher:    utils.RefreshToken(ctx, s.store, repo.repository.WebURL),
+		},
+		repo.vcs.InstanceURL,
+		repo.repository.ExternalID,
+		pullRequestID,
+	)
+}
+
+// setPullRequestCommitStatus sets the status of the commit in the pull request, the error is logged only.
+func (s *Server) setPullRequestCommitStatus(ctx context.Context, repo *repoInfo, commitID string, commitStatus *vcs.CommitStatus) {
+	if err := vcs.Get(repo.vcs.Type, vcs.ProviderConfig{}).CreateCommitStatus(
+		ctx,
+		common.OauthContext{
+			ClientID:     repo.vcs.ApplicationID,
+			ClientSecret: repo.vcs.Secret,
+			AccessToken:  repo.repository.AccessToken,
+			RefreshToken: repo.repository.RefreshToken,
+			Refresher:    utils.RefreshToken(ctx, s.store, repo.repository.WebURL),
+		},
+		repo.vcs.InstanceURL,
+		repo.repository.ExternalID,
+		commitID,
+		commitStatus,
+	); err != nil {
+		log.Warn("Failed to set the commit status",
+			zap.String("repository", repo.repository.WebURL),
+			zap.String("commit", commitID),
+			zap.Error(err),
+		)
+	}
+}
+
+// convertPullRequestToPushEvent converts the pull request to a push event of the head commit, which
+// adds all the changed files in the pull request, so that the draft issues are created in the same
+// way as the push event.
+func convertPullRequestToPushEvent(event vcs.PullRequestEvent, prFiles []*vcs.PullRequestFile) vcs.PushEvent {
+	var addedList []string
+	for _, file := range prFiles {
+		if file.IsDeleted {
+			continue
+		}
+		addedList = append(addedList, file.Path)
+	}
+	return vcs.PushEvent{
+		VCSType: event.VCSType,
+		// The changes are rolled out after the pull request is merged into the base branch.
+		Ref: "refs/heads/" + event.BaseBranch,
+		// The pull request files are the diff already, so we skip the commits diff.
+		Before:             strings.Repeat("0", 40),
+		After:              event.HeadCommitID,
+		RepositoryID:       event.RepositoryID,
+		RepositoryURL:      event.RepositoryURL,
+		RepositoryFullPath: event.RepositoryFullPath,
+		AuthorName:         event.AuthorName,
+		CommitList: []vcs.Commit{
+			{
+				ID:          event.HeadCommitID,
+				Title:       event.Title,
+				Message:     event.Description,
+				URL:         event.URL,
+				AuthorName:  event.AuthorName,
+				AuthorEmail: event.AuthorEmail,
+				AddedList:   addedList,
+			},
+		},
+	}
+}
+
+func formatPullRequestDrafts(drafts []*pullRequestDraft, externalURL string) string {
+	var lines []string
+	for _, draft := range drafts {
+		lines = append(lines, fmt.Sprintf("- [%s](%s)", draft.issue.Title, getIssueLink(draft.issue, externalURL)))
+	}
+	return strings.Join(lines, "\n")
+}
+
+func getIssueLink(issue *store.IssueMessage, externalURL string) string {
+	return fmt.Sprintf("%s/issue/%s-%d", externalURL, slug.Make(issue.Title), issue.UID)
+}
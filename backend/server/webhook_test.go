@@ -11,6 +11,7 @@ import (
 	"github.com/bytebase/bytebase/backend/plugin/db"
 	"github.com/bytebase/bytebase/backend/plugin/vcs"
 	"github.com/bytebase/bytebase/backend/store"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
 )
 
 // TODO(d): fix the double underscore "__".
@@ -131,6 +132,20 @@ var mockSQLAdviceMap = map[string][]advisor.Advice{
 			Line:    4,
 		},
 	},
+	"file3.sql": {
+		{
+			Status:  advisor.Warn,
+			Code:    advisor.CreateIndexUnconcurrently,
+			Title:   "index.create-concurrently",
+			Content: "Creating indexes will block writes on the table, unless use CONCURRENTLY",
+			Line:    1,
+			Fix: &advisor.Fix{
+				Title: "Create the index concurrently",
+				Safe:  true,
+				Edits: []advisor.Edit{{Start: 12, End: 12, NewText: " CONCURRENTLY"}},
+			},
+		},
+	},
 }
 
 func TestVCSSQLReview_ConvertSQLAdviceToGitLabCIResult(t *testing.T) {
@@ -165,11 +180,28 @@ You can check the docs at https://www.bytebase.com/docs/reference/error-code/adv
 </failure>
 </testcase>
 </testsuite>
+<testsuite name="file3.sql">
+<testcase name="[WARN] file3.sql#L1: index.create-concurrently" classname="file3.sql" file="file3.sql#L1">
+<failure>
+Error: Creating indexes will block writes on the table, unless use CONCURRENTLY.
+You can check the docs at https://www.bytebase.com/docs/reference/error-code/advisor#814
+Suggested fix: Create the index concurrently
+</failure>
+</testcase>
+</testsuite>
 </testsuites>`
 	res := convertSQLAdviceToGitLabCIResult(mockSQLAdviceMap)
 	assert.Equal(t, advisor.Error, res.Status)
 	assert.Equal(t, 1, len(res.Content))
 	assert.Equal(t, expect, res.Content[0])
+	assert.Equal(t, []api.VCSSQLReviewFix{
+		{
+			FilePath: "file3.sql",
+			Line:     1,
+			Code:     advisor.CreateIndexUnconcurrently.Int(),
+			Fix:      mockSQLAdviceMap["file3.sql"][0].Fix,
+		},
+	}, res.FixList)
 }
 
 func TestVCSSQLReview_ConvertSQLAdviceToGitHubActionResult(t *testing.T) {
@@ -178,11 +210,35 @@ func TestVCSSQLReview_ConvertSQLAdviceToGitHubActionResult(t *testing.T) {
 		"::error file=file1.sql,line=2,col=1,endColumn=2,title=naming.index.idx (303)::Index in table \"tech_book\" mismatches the naming convention, expect \"^$|^idx_tech_book_id_name$\" but found \"tech_book_id_name\"%0ADoc: https://www.bytebase.com/docs/reference/error-code/advisor#303",
 		"::warning file=file2.sql,line=1,col=1,endColumn=2,title=naming.table (301)::\"techBook\" mismatches table naming convention, naming format should be \"^[a-z]+(_[a-z]+)*$\"%0ADoc: https://www.bytebase.com/docs/reference/error-code/advisor#301",
 		"::error file=file2.sql,line=4,col=1,endColumn=2,title=naming.index.uk (304)::Unique key in table \"tech_book\" mismatches the naming convention, expect \"^$|^uk_tech_book_id_name$\" but found \"tech_book_id_name\"%0ADoc: https://www.bytebase.com/docs/reference/error-code/advisor#304",
+		"::warning file=file3.sql,line=1,col=1,endColumn=2,title=index.create-concurrently (814)::Creating indexes will block writes on the table, unless use CONCURRENTLY%0ADoc: https://www.bytebase.com/docs/reference/error-code/advisor#814%0ASuggested fix: Create the index concurrently",
 	}
 	res := convertSQLAdviceToGitHubActionResult(mockSQLAdviceMap)
 	assert.Equal(t, advisor.Error, res.Status)
-	assert.Equal(t, 4, len(res.Content))
+	assert.Equal(t, 5, len(res.Content))
 	assert.Equal(t, expect, res.Content)
+	assert.Equal(t, 1, len(res.FixList))
+}
+
+func TestVCSSQLReview_ConvertSQLAdviceToMarkdown(t *testing.T) {
+	expect := "### Bytebase SQL Review\n\n" +
+		"`file1.sql`\n" +
+		"- **WARN** line 1 `column.no-null`: Column \"id\" in \"public\".\"book\" cannot have NULL value. [Doc](https://www.bytebase.com/docs/reference/error-code/advisor#402)\n" +
+		"- **ERROR** line 2 `naming.index.idx`: Index in table \"tech_book\" mismatches the naming convention, expect \"^$|^idx_tech_book_id_name$\" but found \"tech_book_id_name\". [Doc](https://www.bytebase.com/docs/reference/error-code/advisor#303)\n" +
+		"\n" +
+		"`file2.sql`\n" +
+		"- **WARN** line 1 `naming.table`: \"techBook\" mismatches table naming convention, naming format should be \"^[a-z]+(_[a-z]+)*$\". [Doc](https://www.bytebase.com/docs/reference/error-code/advisor#301)\n" +
+		"- **ERROR** line 4 `naming.index.uk`: Unique key in table \"tech_book\" mismatches the naming convention, expect \"^$|^uk_tech_book_id_name$\" but found \"tech_book_id_name\". [Doc](https://www.bytebase.com/docs/reference/error-code/advisor#304)\n" +
+		"\n" +
+		"`file3.sql`\n" +
+		"- **WARN** line 1 `index.create-concurrently`: Creating indexes will block writes on the table, unless use CONCURRENTLY. [Doc](https://www.bytebase.com/docs/reference/error-code/advisor#814)\n" +
+		"  - Suggested fix: Create the index concurrently"
+	status, content := convertSQLAdviceToMarkdown(mockSQLAdviceMap)
+	assert.Equal(t, advisor.Error, status)
+	assert.Equal(t, expect, content)
+
+	status, content = convertSQLAdviceToMarkdown(map[string][]advisor.Advice{})
+	assert.Equal(t, advisor.Success, status)
+	assert.Equal(t, "### Bytebase SQL Review\n\nNo SQL review issues found.", content)
 }
 
 func TestGetFileInfo(t *testing.T) {
@@ -360,6 +416,75 @@ func TestGetFileInfo(t *testing.T) {
 		)
 		require.EqualError(t, err, "file change should be associated with exactly one project but found project-1, project-2")
 	})
+
+	t.Run("monorepo routes to the most specific base directory", func(t *testing.T) {
+		repoInfoList := []*repoInfo{
+			{
+				repository: &store.RepositoryMessage{
+					UID:              1,
+					BaseDirectory:    "migrations",
+					FilePathTemplate: "{{DB_NAME}}##{{VERSION}}##{{TYPE}}.sql",
+				},
+				project: &store.ProjectMessage{},
+				vcs:     &store.ExternalVersionControlMessage{},
+			},
+			{
+				repository: &store.RepositoryMessage{
+					UID:              2,
+					BaseDirectory:    "migrations/order",
+					FilePathTemplate: "{{DB_NAME}}##{{VERSION}}##{{TYPE}}.sql",
+				},
+				project: &store.ProjectMessage{},
+				vcs:     &store.ExternalVersionControlMessage{},
+			},
+			{
+				repository: &store.RepositoryMessage{
+					UID:              3,
+					BaseDirectory:    "migrations/order-history",
+					FilePathTemplate: "{{DB_NAME}}##{{VERSION}}##{{TYPE}}.sql",
+				},
+				project: &store.ProjectMessage{},
+				vcs:     &store.ExternalVersionControlMessage{},
+			},
+		}
+		tests := []struct {
+			fileName string
+			wantUID  int
+		}{
+			{fileName: "migrations/db##0001##migrate.sql", wantUID: 1},
+			{fileName: "migrations/order/db##0001##migrate.sql", wantUID: 2},
+			{fileName: "migrations/order-history/db##0001##migrate.sql", wantUID: 3},
+		}
+		for _, test := range tests {
+			_, fileType, repoInfo, err := getFileInfo(
+				vcs.DistinctFileItem{
+					FileName: test.fileName,
+					ItemType: vcs.FileItemTypeAdded,
+				},
+				repoInfoList,
+			)
+			require.NoError(t, err, test.fileName)
+			assert.Equal(t, fileTypeMigration, fileType, test.fileName)
+			assert.Equal(t, test.wantUID, repoInfo.repository.UID, test.fileName)
+		}
+	})
+}
+
+func TestIsFileInBaseDirectory(t *testing.T) {
+	tests := []struct {
+		fileName      string
+		baseDirectory string
+		want          bool
+	}{
+		{fileName: "db##0001##migrate.sql", baseDirectory: "", want: true},
+		{fileName: "order/db##0001##migrate.sql", baseDirectory: "order", want: true},
+		{fileName: "order/db##0001##migrate.sql", baseDirectory: "/order/", want: true},
+		{fileName: "order-history/db##0001##migrate.sql", baseDirectory: "order", want: false},
+		{fileName: "order", baseDirectory: "order", want: false},
+	}
+	for _, test := range tests {
+		assert.Equal(t, test.want, isFileInBaseDirectory(test.fileName, test.baseDirectory), test.fileName)
+	}
 }
 
 func TestExtractDBTypeFromJDBCConnectionString(t *testing.T) {
@@ -388,3 +513,120 @@ func TestExtractDBTypeFromJDBCConnectionString(t *testing.T) {
 		assert.Equal(t, tc.want, dbType)
 	}
 }
+
+func TestConvertPullRequestToPushEvent(t *testing.T) {
+	event := vcs.PullRequestEvent{
+		VCSType:            vcs.GitHub,
+		Action:             vcs.PullRequestEventOpened,
+		RepositoryID:       "octocat/hello",
+		RepositoryURL:      "https://github.com/octocat/hello",
+		RepositoryFullPath: "octocat/hello",
+		PullRequestID:      "3",
+		URL:                "https://github.com/octocat/hello/pull/3",
+		Title:              "Add the email column",
+		Description:        "details",
+		AuthorName:         "octocat",
+		AuthorEmail:        "octocat@github.com",
+		HeadCommitID:       "c2",
+		BaseBranch:         "main",
+	}
+	prFiles := []*vcs.PullRequestFile{
+		{Path: "bytebase/db##0001##migrate##add_email.sql", LastCommitID: "c2"},
+		{Path: "bytebase/db##0000##migrate##init.sql", LastCommitID: "c2", IsDeleted: true},
+	}
+	want := vcs.PushEvent{
+		VCSType:            vcs.GitHub,
+		Ref:                "refs/heads/main",
+		Before:             "0000000000000000000000000000000000000000",
+		After:              "c2",
+		RepositoryID:       "octocat/hello",
+		RepositoryURL:      "https://github.com/octocat/hello",
+		RepositoryFullPath: "octocat/hello",
+		AuthorName:         "octocat",
+		CommitList: []vcs.Commit{
+			{
+				ID:          "c2",
+				Title:       "Add the email column",
+				Message:     "details",
+				URL:         "https://github.com/octocat/hello/pull/3",
+				AuthorName:  "octocat",
+				AuthorEmail: "octocat@github.com",
+				AddedList:   []string{"bytebase/db##0001##migrate##add_email.sql"},
+			},
+		},
+	}
+	assert.Equal(t, want, convertPullRequestToPushEvent(event, prFiles))
+}
+
+func TestIsPullRequestDraft(t *testing.T) {
+	tests := []struct {
+		name    string
+		payload *storepb.IssuePayload
+		want    bool
+	}{
+		{
+			name:    "not from pull request",
+			payload: &storepb.IssuePayload{},
+			want:    false,
+		},
+		{
+			name: "draft",
+			payload: &storepb.IssuePayload{
+				PullRequest: &storepb.IssuePayloadPullRequest{RepositoryId: 1, PullRequestId: "3"},
+			},
+			want: true,
+		},
+		{
+			name: "merged",
+			payload: &storepb.IssuePayload{
+				PullRequest: &storepb.IssuePayloadPullRequest{RepositoryId: 1, PullRequestId: "3", Merged: true},
+			},
+			want: false,
+		},
+		{
+			name: "another repository",
+			payload: &storepb.IssuePayload{
+				PullRequest: &storepb.IssuePayloadPullRequest{RepositoryId: 2, PullRequestId: "3"},
+			},
+			want: false,
+		},
+		{
+			name: "another pull request",
+			payload: &storepb.IssuePayload{
+				PullRequest: &storepb.IssuePayloadPullRequest{RepositoryId: 1, PullRequestId: "4"},
+			},
+			want: false,
+		},
+	}
+	for _, test := range tests {
+		assert.Equal(t, test.want, isPullRequestDraft(test.payload, 1, "3"), test.name)
+	}
+}
+
+func TestVerifyPullRequestEvent(t *testing.T) {
+	tests := []struct {
+		action vcs.PullRequestEventAction
+		state  vcs.PullRequestState
+		base   string
+		want   bool
+	}{
+		{action: vcs.PullRequestEventOpened, state: vcs.PullRequestStateOpen, base: "main", want: true},
+		{action: vcs.PullRequestEventUpdated, state: vcs.PullRequestStateOpen, base: "main", want: true},
+		{action: vcs.PullRequestEventUpdated, state: vcs.PullRequestStateMerged, base: "main", want: false},
+		{action: vcs.PullRequestEventUpdated, state: vcs.PullRequestStateOpen, base: "dev", want: false},
+		{action: vcs.PullRequestEventMerged, state: vcs.PullRequestStateMerged, base: "main", want: true},
+		{action: vcs.PullRequestEventMerged, state: vcs.PullRequestStateOpen, base: "main", want: false},
+		{action: vcs.PullRequestEventMerged, state: vcs.PullRequestStateClosed, base: "main", want: false},
+		{action: vcs.PullRequestEventClosed, state: vcs.PullRequestStateClosed, base: "main", want: true},
+		{action: vcs.PullRequestEventClosed, state: vcs.PullRequestStateMerged, base: "main", want: false},
+	}
+	for _, test := range tests {
+		event := vcs.PullRequestEvent{Action: test.action, HeadCommitID: "forged", BaseBranch: "main"}
+		pullRequest := &vcs.PullRequest{State: test.state, HeadCommitID: "abc", BaseBranch: test.base}
+		got, ok := verifyPullRequestEvent(event, pullRequest)
+		require.Equal(t, test.want, ok, "%s %s %s", test.action, test.state, test.base)
+		if ok {
+			require.Equal(t, "abc", got.HeadCommitID)
+		}
+	}
+}
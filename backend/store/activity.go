@@ -12,6 +12,7 @@ import (
 
 	"github.com/bytebase/bytebase/backend/common"
 	api "github.com/bytebase/bytebase/backend/legacyapi"
+	"github.com/bytebase/bytebase/backend/plugin/auditlog"
 	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
 )
 
@@ -247,6 +248,8 @@ type FindActivityMessage struct {
 	ContainerUID    *int
 	CreatedTsAfter  *int64
 	CreatedTsBefore *int64
+	// PayloadContains is the JSON document contained by the payload, using the jsonb @> operator.
+	PayloadContains *string
 	Limit           *int
 	Offset          *int
 	// If specified, sorts the returned list by created_ts in <<ORDER>>
@@ -281,6 +284,9 @@ func (s *Store) CreateActivityV2(ctx context.Context, create *ActivityMessage) (
 	if len(activityList) != 1 {
 		return nil, &common.Error{Code: common.Conflict, Err: errors.Errorf("found %d activities, expect 1", len(activityList))}
 	}
+	if err := appendAuditLogImpl(ctx, tx, s.auditLogKey, auditlog.ActionCreate, activityList...); err != nil {
+		return nil, errors.Wrap(err, "failed to append audit log")
+	}
 
 	if err := tx.Commit(); err != nil {
 		return nil, err
@@ -301,6 +307,9 @@ func (s *Store) BatchCreateActivityV2(ctx context.Context, creates []*ActivityMe
 	if err != nil {
 		return nil, err
 	}
+	if err := appendAuditLogImpl(ctx, tx, s.auditLogKey, auditlog.ActionCreate, activityList...); err != nil {
+		return nil, errors.Wrap(err, "failed to append audit log")
+	}
 
 	if err := tx.Commit(); err != nil {
 		return nil, err
@@ -373,6 +382,9 @@ func (s *Store) UpdateActivityV2(ctx context.Context, update *UpdateActivityMess
 	if activity.Payload, err = convertProtoPayloadToAPIPayload(activity.Type, protoPayload); err != nil {
 		return nil, err
 	}
+	if err := appendAuditLogImpl(ctx, tx, s.auditLogKey, auditlog.ActionUpdate, &activity); err != nil {
+		return nil, errors.Wrap(err, "failed to append audit log")
+	}
 
 	if err := tx.Commit(); err != nil {
 		return nil, err
@@ -470,6 +482,9 @@ func listActivityImplV2(ctx context.Context, tx *Tx, find *FindActivityMessage)
 	if v := find.CreatedTsBefore; v != nil {
 		where, args = append(where, fmt.Sprintf("created_ts <= $%d", len(args)+1)), append(args, *v)
 	}
+	if v := find.PayloadContains; v != nil {
+		where, args = append(where, fmt.Sprintf("payload @> $%d::jsonb", len(args)+1)), append(args, *v)
+	}
 
 	query := `
 		SELECT
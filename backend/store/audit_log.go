@@ -0,0 +1,161 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/bytebase/bytebase/backend/plugin/auditlog"
+)
+
+// auditLogChainLockKey is the key of the transaction-level advisory lock serializing the appends to the audit log hash chain.
+// It's the ASCII of "bbaudit" so that it doesn't collide with the other advisory locks in the same database.
+const auditLogChainLockKey int64 = 0x62626175646974
+
+// AuditLogMessage is the message for the hash chained audit log.
+type AuditLogMessage struct {
+	ID        int64
+	CreatedTs int64
+	// Entry is the JSON encoding of the auditlog.Entry.
+	Entry    string
+	PrevHash string
+	Hash     string
+}
+
+// FindAuditLogMessage is the message for finding audit logs.
+type FindAuditLogMessage struct {
+	// IDAfter lists the audit logs whose ID is greater than it.
+	IDAfter *int64
+	Limit   *int
+}
+
+// ListAuditLogs lists the audit logs in the order of the ID.
+func (s *Store) ListAuditLogs(ctx context.Context, find *FindAuditLogMessage) ([]*AuditLogMessage, error) {
+	where, args := []string{"TRUE"}, []any{}
+	if v := find.IDAfter; v != nil {
+		where, args = append(where, fmt.Sprintf("id > $%d", len(args)+1)), append(args, *v)
+	}
+	query := fmt.Sprintf(`
+		SELECT
+			id,
+			created_ts,
+			entry,
+			prev_hash,
+			hash
+		FROM audit_log
+		WHERE %s
+		ORDER BY id ASC
+	`, strings.Join(where, " AND "))
+	if v := find.Limit; v != nil {
+		query += fmt.Sprintf(" LIMIT %d", *v)
+	}
+
+	tx, err := s.db.BeginTx(ctx, &sql.TxOptions{ReadOnly: true})
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	rows, err := tx.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var auditLogs []*AuditLogMessage
+	for rows.Next() {
+		var auditLog AuditLogMessage
+		if err := rows.Scan(
+			&auditLog.ID,
+			&auditLog.CreatedTs,
+			&auditLog.Entry,
+			&auditLog.PrevHash,
+			&auditLog.Hash,
+		); err != nil {
+			return nil, err
+		}
+		auditLogs = append(auditLogs, &auditLog)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return auditLogs, nil
+}
+
+// GetAuditLogExportCursor gets the ID of the last audit log exported to the destination.
+// It returns 0 if nothing is exported.
+func (s *Store) GetAuditLogExportCursor(ctx context.Context, destination string) (int64, error) {
+	var id int64
+	if err := s.db.db.QueryRowContext(ctx, `
+		SELECT audit_log_id FROM audit_log_export_cursor WHERE destination = $1
+	`, destination).Scan(&id); err != nil {
+		if err == sql.ErrNoRows {
+			return 0, nil
+		}
+		return 0, err
+	}
+	return id, nil
+}
+
+// UpsertAuditLogExportCursor updates the ID of the last audit log exported to the destination.
+func (s *Store) UpsertAuditLogExportCursor(ctx context.Context, destination string, id int64) error {
+	if _, err := s.db.db.ExecContext(ctx, `
+		INSERT INTO audit_log_export_cursor (destination, audit_log_id)
+		VALUES ($1, $2)
+		ON CONFLICT (destination) DO UPDATE SET
+			updated_ts = extract(epoch from now()),
+			audit_log_id = EXCLUDED.audit_log_id
+	`, destination, id); err != nil {
+		return err
+	}
+	return nil
+}
+
+// appendAuditLogImpl appends the snapshots of the activities to the hash chain keyed by the key.
+// It holds the advisory lock of the chain until the transaction ends, so that the records are chained in the order of the IDs.
+// Unlike locking the audit_log table in the EXCLUSIVE mode, the advisory lock doesn't block the autovacuum and the other writes to the table.
+func appendAuditLogImpl(ctx context.Context, tx *Tx, key []byte, action auditlog.Action, activities ...*ActivityMessage) error {
+	if _, err := tx.ExecContext(ctx, "SELECT pg_advisory_xact_lock($1)", auditLogChainLockKey); err != nil {
+		return err
+	}
+	var prevHash string
+	if err := tx.QueryRowContext(ctx, "SELECT hash FROM audit_log ORDER BY id DESC LIMIT 1").Scan(&prevHash); err != nil && err != sql.ErrNoRows {
+		return err
+	}
+	for _, activity := range activities {
+		entry, err := auditlog.MarshalEntry(&auditlog.Entry{
+			Action:      action,
+			ActivityID:  activity.UID,
+			CreatorID:   activity.CreatorUID,
+			CreatedTs:   activity.CreatedTs,
+			UpdaterID:   activity.UpdaterUID,
+			UpdatedTs:   activity.UpdatedTs,
+			ContainerID: activity.ContainerUID,
+			Type:        string(activity.Type),
+			Level:       string(activity.Level),
+			Comment:     activity.Comment,
+			Payload:     json.RawMessage(activity.Payload),
+		})
+		if err != nil {
+			return err
+		}
+		var id int64
+		if err := tx.QueryRowContext(ctx, "SELECT nextval('audit_log_id_seq')").Scan(&id); err != nil {
+			return err
+		}
+		hash := auditlog.ComputeHash(key, id, prevHash, entry)
+		if _, err := tx.ExecContext(ctx, `
+			INSERT INTO audit_log (id, entry, prev_hash, hash)
+			VALUES ($1, $2, $3, $4)
+		`, id, entry, prevHash, hash); err != nil {
+			return err
+		}
+		prevHash = hash
+	}
+	return nil
+}
@@ -35,6 +35,15 @@ type DataSourceMessage struct {
 	SSHUser                 string
 	SSHObfuscatedPassword   string
 	SSHObfuscatedPrivateKey string
+	// ExternalSecret is the references to the credentials in the external secret manager.
+	ExternalSecret *storepb.DataSourceExternalSecret
+	// Short-lived credentials related.
+	AuthenticationType          storepb.DataSourceAuthenticationType
+	VaultDatabaseCredentialPath string
+	AWSRegion                   string
+	// Read replica related.
+	MaxReplicationLagSeconds int32
+	UseForSync               bool
 	// (deprecated) Output only.
 	UID        int
 	DatabaseID int
@@ -46,6 +55,8 @@ type UpdateDataSourceMessage struct {
 	InstanceUID int
 	InstanceID  string
 
+	// UID identifies the data source.
+	UID  int
 	Type api.DataSourceType
 
 	Username           *string
@@ -67,6 +78,14 @@ type UpdateDataSourceMessage struct {
 	SSHUser                 *string
 	SSHObfuscatedPassword   *string
 	SSHObfuscatedPrivateKey *string
+	ExternalSecret          *storepb.DataSourceExternalSecret
+	// Short-lived credentials related.
+	AuthenticationType          *storepb.DataSourceAuthenticationType
+	VaultDatabaseCredentialPath *string
+	AWSRegion                   *string
+	// Read replica related.
+	MaxReplicationLagSeconds *int32
+	UseForSync               *bool
 }
 
 func (*Store) listDataSourceV2(ctx context.Context, tx *Tx, instanceID string) ([]*DataSourceMessage, error) {
@@ -88,7 +107,8 @@ func (*Store) listDataSourceV2(ctx context.Context, tx *Tx, instanceID string) (
 			data_source.options
 		FROM data_source
 		LEFT JOIN instance ON instance.id = data_source.instance_id
-		WHERE instance.resource_id = $1`,
+		WHERE instance.resource_id = $1
+		ORDER BY data_source.id`,
 		instanceID,
 	)
 	if err != nil {
@@ -129,6 +149,12 @@ func (*Store) listDataSourceV2(ctx context.Context, tx *Tx, instanceID string) (
 		dataSourceMessage.SSHUser = dataSourceOptions.SshUser
 		dataSourceMessage.SSHObfuscatedPassword = dataSourceOptions.SshObfuscatedPassword
 		dataSourceMessage.SSHObfuscatedPrivateKey = dataSourceOptions.SshObfuscatedPrivateKey
+		dataSourceMessage.ExternalSecret = dataSourceOptions.ExternalSecret
+		dataSourceMessage.AuthenticationType = dataSourceOptions.AuthenticationType
+		dataSourceMessage.VaultDatabaseCredentialPath = dataSourceOptions.VaultDatabaseCredentialPath
+		dataSourceMessage.AWSRegion = dataSourceOptions.AwsRegion
+		dataSourceMessage.MaxReplicationLagSeconds = dataSourceOptions.MaxReplicationLagSeconds
+		dataSourceMessage.UseForSync = dataSourceOptions.UseForSync
 
 		dataSourceMessages = append(dataSourceMessages, &dataSourceMessage)
 	}
@@ -171,7 +197,7 @@ func (s *Store) AddDataSourceToInstanceV2(ctx context.Context, instanceUID, crea
 }
 
 // RemoveDataSourceV2 removes a RO data source from an instance.
-func (s *Store) RemoveDataSourceV2(ctx context.Context, instanceUID int, instanceID string, dataSourceTp api.DataSourceType) error {
+func (s *Store) RemoveDataSourceV2(ctx context.Context, instanceUID int, instanceID string, dataSourceUID int) error {
 	tx, err := s.db.BeginTx(ctx, nil)
 	if err != nil {
 		return errors.New("Failed to begin transaction")
@@ -179,8 +205,8 @@ func (s *Store) RemoveDataSourceV2(ctx context.Context, instanceUID int, instanc
 	defer tx.Rollback()
 
 	result, err := tx.ExecContext(ctx, `
-		DELETE FROM data_source WHERE data_source.instance_id = $1 AND data_source.type = $2;
-	`, instanceUID, dataSourceTp)
+		DELETE FROM data_source WHERE data_source.instance_id = $1 AND data_source.id = $2;
+	`, instanceUID, dataSourceUID)
 	if err != nil {
 		return err
 	}
@@ -261,6 +287,28 @@ func (s *Store) UpdateDataSourceV2(ctx context.Context, patch *UpdateDataSourceM
 	if v := patch.SSHObfuscatedPrivateKey; v != nil {
 		optionSet, args = append(optionSet, fmt.Sprintf("jsonb_build_object('sshObfuscatedPrivateKey', to_jsonb($%d::TEXT))", len(args)+1)), append(args, *v)
 	}
+	if v := patch.ExternalSecret; v != nil {
+		externalSecret, err := protojson.Marshal(v)
+		if err != nil {
+			return errors.Wrap(err, "failed to marshal external secret")
+		}
+		optionSet, args = append(optionSet, fmt.Sprintf("jsonb_build_object('externalSecret', $%d::JSONB)", len(args)+1)), append(args, string(externalSecret))
+	}
+	if v := patch.AuthenticationType; v != nil {
+		optionSet, args = append(optionSet, fmt.Sprintf("jsonb_build_object('authenticationType', to_jsonb($%d::TEXT))", len(args)+1)), append(args, v.String())
+	}
+	if v := patch.VaultDatabaseCredentialPath; v != nil {
+		optionSet, args = append(optionSet, fmt.Sprintf("jsonb_build_object('vaultDatabaseCredentialPath', to_jsonb($%d::TEXT))", len(args)+1)), append(args, *v)
+	}
+	if v := patch.AWSRegion; v != nil {
+		optionSet, args = append(optionSet, fmt.Sprintf("jsonb_build_object('awsRegion', to_jsonb($%d::TEXT))", len(args)+1)), append(args, *v)
+	}
+	if v := patch.MaxReplicationLagSeconds; v != nil {
+		optionSet, args = append(optionSet, fmt.Sprintf("jsonb_build_object('maxReplicationLagSeconds', to_jsonb($%d::INTEGER))", len(args)+1)), append(args, *v)
+	}
+	if v := patch.UseForSync; v != nil {
+		optionSet, args = append(optionSet, fmt.Sprintf("jsonb_build_object('useForSync', to_jsonb($%d::BOOLEAN))", len(args)+1)), append(args, *v)
+	}
 	if len(optionSet) != 0 {
 		set = append(set, fmt.Sprintf(`options = options || %s`, strings.Join(optionSet, "||")))
 	}
@@ -274,7 +322,7 @@ func (s *Store) UpdateDataSourceV2(ctx context.Context, patch *UpdateDataSourceM
 	// Only update the data source if the
 	query := `UPDATE data_source SET ` + strings.Join(set, ", ") +
 		` WHERE instance_id = ` + fmt.Sprintf("%d", patch.InstanceUID) +
-		` AND type = ` + fmt.Sprintf(`'%s'`, patch.Type)
+		` AND id = ` + fmt.Sprintf("%d", patch.UID)
 	result, err := tx.ExecContext(ctx, query, args...)
 	if err != nil {
 		return err
@@ -300,15 +348,21 @@ func (s *Store) UpdateDataSourceV2(ctx context.Context, patch *UpdateDataSourceM
 func (*Store) addDataSourceToInstanceImplV2(ctx context.Context, tx *Tx, instanceUID, databaseUID, creatorID int, dataSource *DataSourceMessage) error {
 	// We flatten the data source fields in DataSourceMessage, so we need to compose them in store layer before INSERT.
 	dataSourceOptions := storepb.DataSourceOptions{
-		Srv:                     dataSource.SRV,
-		AuthenticationDatabase:  dataSource.AuthenticationDatabase,
-		Sid:                     dataSource.SID,
-		ServiceName:             dataSource.ServiceName,
-		SshHost:                 dataSource.SSHHost,
-		SshPort:                 dataSource.SSHPort,
-		SshUser:                 dataSource.SSHUser,
-		SshObfuscatedPassword:   dataSource.SSHObfuscatedPassword,
-		SshObfuscatedPrivateKey: dataSource.SSHObfuscatedPrivateKey,
+		Srv:                         dataSource.SRV,
+		AuthenticationDatabase:      dataSource.AuthenticationDatabase,
+		Sid:                         dataSource.SID,
+		ServiceName:                 dataSource.ServiceName,
+		SshHost:                     dataSource.SSHHost,
+		SshPort:                     dataSource.SSHPort,
+		SshUser:                     dataSource.SSHUser,
+		SshObfuscatedPassword:       dataSource.SSHObfuscatedPassword,
+		SshObfuscatedPrivateKey:     dataSource.SSHObfuscatedPrivateKey,
+		ExternalSecret:              dataSource.ExternalSecret,
+		AuthenticationType:          dataSource.AuthenticationType,
+		VaultDatabaseCredentialPath: dataSource.VaultDatabaseCredentialPath,
+		AwsRegion:                   dataSource.AWSRegion,
+		MaxReplicationLagSeconds:    dataSource.MaxReplicationLagSeconds,
+		UseForSync:                  dataSource.UseForSync,
 	}
 	protoBytes, err := protojson.Marshal(&dataSourceOptions)
 	if err != nil {
@@ -78,6 +78,21 @@ func (s *DBSchema) CompactText() (string, error) {
 	return buf.String(), nil
 }
 
+// FindTable finds the table by name.
+func (s *DBSchema) FindTable(schemaName string, tableName string) *storepb.TableMetadata {
+	for _, schema := range s.Metadata.Schemas {
+		if schema.Name != schemaName {
+			continue
+		}
+		for _, table := range schema.Tables {
+			if table.Name == tableName {
+				return table
+			}
+		}
+	}
+	return nil
+}
+
 // FindIndex finds the index by name.
 func (s *DBSchema) FindIndex(schemaName string, tableName string, indexName string) *storepb.IndexMetadata {
 	for _, schema := range s.Metadata.Schemas {
@@ -109,14 +109,14 @@ func (s *Store) ListExternalApprovalV2(ctx context.Context, find *ListExternalAp
 	return externalApprovals, nil
 }
 
-// GetExternalApprovalByIssueIDV2 gets an ExternalApproval by IssueID.
-func (s *Store) GetExternalApprovalByIssueIDV2(ctx context.Context, issueID int) (*ExternalApprovalMessage, error) {
+// GetExternalApprovalByIssueIDV2 gets an ExternalApproval of the type by IssueID.
+func (s *Store) GetExternalApprovalByIssueIDV2(ctx context.Context, issueID int, approvalType api.ExternalApprovalType) (*ExternalApprovalMessage, error) {
 	tx, err := s.db.BeginTx(ctx, &sql.TxOptions{ReadOnly: true})
 	if err != nil {
 		return nil, errors.Wrapf(err, "failed to begin transaction")
 	}
 
-	externalApprovals, err := s.findExternalApprovalImplV2(ctx, tx, &ListExternalApprovalMessage{IssueUID: &issueID})
+	externalApprovals, err := s.findExternalApprovalImplV2(ctx, tx, &ListExternalApprovalMessage{IssueUID: &issueID, Type: &approvalType})
 	if err != nil {
 		return nil, errors.Wrapf(err, "failed to find external approval")
 	}
@@ -548,6 +548,7 @@ type FindIssueMessage struct {
 	NeedAttention *bool
 
 	StatusList []api.IssueStatus
+	TypeList   []api.IssueType
 	// If specified, only find issues whose ID is smaller that SinceID.
 	SinceID *int
 	// If specified, then it will only fetch "Limit" most recently updated issues
@@ -831,6 +832,14 @@ func (s *Store) ListIssueV2(ctx context.Context, find *FindIssueMessage) ([]*Iss
 		}
 		where = append(where, fmt.Sprintf("issue.status IN (%s)", strings.Join(list, ", ")))
 	}
+	if len(find.TypeList) != 0 {
+		var list []string
+		for _, issueType := range find.TypeList {
+			list = append(list, fmt.Sprintf("$%d", len(args)+1))
+			args = append(args, issueType)
+		}
+		where = append(where, fmt.Sprintf("issue.type IN (%s)", strings.Join(list, ", ")))
+	}
 	limitClause := ""
 	if v := find.Limit; v != nil {
 		limitClause = fmt.Sprintf(" LIMIT %d", *v)
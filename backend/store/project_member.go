@@ -11,6 +11,7 @@ import (
 	"google.golang.org/genproto/googleapis/type/expr"
 	"google.golang.org/protobuf/encoding/protojson"
 
+	"github.com/bytebase/bytebase/backend/common"
 	api "github.com/bytebase/bytebase/backend/legacyapi"
 )
 
@@ -191,6 +192,95 @@ func (s *Store) SetProjectIAMPolicy(ctx context.Context, set *IAMPolicyMessage,
 	return s.GetProjectPolicy(ctx, &GetProjectPolicyMessage{UID: &projectUID})
 }
 
+// RemoveProjectIAMPolicyMembers removes the members from the bindings of the project IAM policy.
+// Unlike SetProjectIAMPolicy, it only deletes the given members, so the concurrent changes to the other bindings are kept.
+// It returns the bindings of the removed members, the members not in the policy are ignored.
+// It fails with Conflict if no owner would be left in the policy.
+func (s *Store) RemoveProjectIAMPolicyMembers(ctx context.Context, remove *IAMPolicyMessage, projectUID int) (*IAMPolicyMessage, error) {
+	project, err := s.GetProjectV2(ctx, &FindProjectMessage{UID: &projectUID})
+	if err != nil {
+		return nil, err
+	}
+	removeMap := make(map[roleConditionMapKey]map[int]bool)
+	for _, binding := range remove.Bindings {
+		str, err := formatCondition(binding.Condition)
+		if err != nil {
+			return nil, err
+		}
+		key := roleConditionMapKey{role: binding.Role, condition: str}
+		if removeMap[key] == nil {
+			removeMap[key] = make(map[int]bool)
+		}
+		for _, member := range binding.Members {
+			removeMap[key][member.ID] = true
+		}
+	}
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	if err := lockProjectIAMPolicy(ctx, tx, projectUID); err != nil {
+		return nil, err
+	}
+	oldPolicy, err := s.getProjectPolicyImpl(ctx, tx, &GetProjectPolicyMessage{UID: &projectUID})
+	if err != nil {
+		return nil, err
+	}
+	removed := &IAMPolicyMessage{}
+	hasOwner := false
+	for _, binding := range oldPolicy.Bindings {
+		str, err := formatCondition(binding.Condition)
+		if err != nil {
+			return nil, err
+		}
+		key := roleConditionMapKey{role: binding.Role, condition: str}
+		var members []*UserMessage
+		for _, member := range binding.Members {
+			if removeMap[key][member.ID] {
+				members = append(members, member)
+			}
+		}
+		if binding.Role == api.Owner && len(members) < len(binding.Members) {
+			hasOwner = true
+		}
+		if len(members) > 0 {
+			removed.Bindings = append(removed.Bindings, &PolicyBinding{Role: binding.Role, Members: members, Condition: binding.Condition})
+		}
+	}
+	if len(removed.Bindings) == 0 {
+		return removed, nil
+	}
+	if !hasOwner {
+		return nil, common.Errorf(common.Conflict, "cannot remove the last owner of project %q", project.ResourceID)
+	}
+	if err := s.deleteProjectIAMPolicyImpl(ctx, tx, projectUID, removed); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	s.projectPolicyCache.Delete(project.ResourceID)
+	s.projectIDPolicyCache.Delete(project.UID)
+	return removed, nil
+}
+
+// lockProjectIAMPolicy locks the project row until the transaction ends, so the changes to its IAM policy are serialized.
+func lockProjectIAMPolicy(ctx context.Context, tx *Tx, projectUID int) error {
+	var id int
+	if err := tx.QueryRowContext(ctx, `SELECT id FROM project WHERE id = $1 FOR UPDATE`, projectUID).Scan(&id); err != nil {
+		if err == sql.ErrNoRows {
+			return common.Errorf(common.NotFound, "project %d not found", projectUID)
+		}
+		return err
+	}
+	return nil
+}
+
 type roleConditionMapKey struct {
 	role      api.Role
 	condition string
@@ -266,6 +356,9 @@ func (s *Store) setProjectIAMPolicyImpl(ctx context.Context, tx *Tx, set *IAMPol
 		return errors.Errorf("SetProjectPolicy must set IAMPolicyMessage")
 	}
 
+	if err := lockProjectIAMPolicy(ctx, tx, projectUID); err != nil {
+		return err
+	}
 	oldPolicy, err := s.getProjectPolicyImpl(ctx, tx, &GetProjectPolicyMessage{
 		UID: &projectUID,
 	})
@@ -22,23 +22,25 @@ type RepositoryMessage struct {
 	ProjectResourceID string
 
 	// Domain specific fields
-	Title              string
-	FullPath           string
-	WebURL             string
-	BranchFilter       string
-	BaseDirectory      string
-	FilePathTemplate   string
-	SchemaPathTemplate string
-	SheetPathTemplate  string
-	EnableSQLReviewCI  bool
-	ExternalID         string
-	ExternalWebhookID  string
-	WebhookURLHost     string
-	WebhookEndpointID  string
-	WebhookSecretToken string
-	AccessToken        string
-	ExpiresTs          int64
-	RefreshToken       string
+	Title                            string
+	FullPath                         string
+	WebURL                           string
+	BranchFilter                     string
+	BaseDirectory                    string
+	FilePathTemplate                 string
+	SchemaPathTemplate               string
+	SheetPathTemplate                string
+	EnableSQLReviewCI                bool
+	EnablePullRequestFlow            bool
+	EnableSchemaWriteBackPullRequest bool
+	ExternalID                       string
+	ExternalWebhookID                string
+	WebhookURLHost                   string
+	WebhookEndpointID                string
+	WebhookSecretToken               string
+	AccessToken                      string
+	ExpiresTs                        int64
+	RefreshToken                     string
 }
 
 // FindRepositoryMessage is the message for finding repositories.
@@ -56,15 +58,17 @@ type PatchRepositoryMessage struct {
 	WebURL *string
 
 	// Domain specific fields
-	BranchFilter       *string
-	BaseDirectory      *string
-	FilePathTemplate   *string
-	SchemaPathTemplate *string
-	SheetPathTemplate  *string
-	EnableSQLReviewCI  *bool
-	AccessToken        *string
-	ExpiresTs          *int64
-	RefreshToken       *string
+	BranchFilter                     *string
+	BaseDirectory                    *string
+	FilePathTemplate                 *string
+	SchemaPathTemplate               *string
+	SheetPathTemplate                *string
+	EnableSQLReviewCI                *bool
+	EnablePullRequestFlow            *bool
+	EnableSchemaWriteBackPullRequest *bool
+	AccessToken                      *string
+	ExpiresTs                        *int64
+	RefreshToken                     *string
 }
 
 // CreateRepositoryV2 creates the repository.
@@ -213,6 +217,8 @@ func (s *Store) createRepositoryImplV2(ctx context.Context, tx *Tx, create *Repo
 			schema_path_template,
 			sheet_path_template,
 			enable_sql_review_ci,
+			enable_pull_request_flow,
+			enable_schema_write_back_pull_request,
 			external_id,
 			external_webhook_id,
 			webhook_url_host,
@@ -222,8 +228,8 @@ func (s *Store) createRepositoryImplV2(ctx context.Context, tx *Tx, create *Repo
 			expires_ts,
 			refresh_token
 		)
-		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20, $21)
-		RETURNING id, vcs_id, name, full_path, web_url, branch_filter, base_directory, file_path_template, schema_path_template, sheet_path_template, enable_sql_review_ci, external_id, external_webhook_id, webhook_url_host, webhook_endpoint_id, webhook_secret_token, access_token, expires_ts, refresh_token
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20, $21, $22, $23)
+		RETURNING id, vcs_id, name, full_path, web_url, branch_filter, base_directory, file_path_template, schema_path_template, sheet_path_template, enable_sql_review_ci, enable_pull_request_flow, enable_schema_write_back_pull_request, external_id, external_webhook_id, webhook_url_host, webhook_endpoint_id, webhook_secret_token, access_token, expires_ts, refresh_token
 	`
 	if err := tx.QueryRowContext(ctx, query,
 		creatorID,
@@ -239,6 +245,8 @@ func (s *Store) createRepositoryImplV2(ctx context.Context, tx *Tx, create *Repo
 		create.SchemaPathTemplate,
 		create.SheetPathTemplate,
 		false, /* EnableSQLReviewCI */
+		create.EnablePullRequestFlow,
+		create.EnableSchemaWriteBackPullRequest,
 		create.ExternalID,
 		create.ExternalWebhookID,
 		create.WebhookURLHost,
@@ -259,6 +267,8 @@ func (s *Store) createRepositoryImplV2(ctx context.Context, tx *Tx, create *Repo
 		&repository.SchemaPathTemplate,
 		&repository.SheetPathTemplate,
 		&repository.EnableSQLReviewCI,
+		&repository.EnablePullRequestFlow,
+		&repository.EnableSchemaWriteBackPullRequest,
 		&repository.ExternalID,
 		&repository.ExternalWebhookID,
 		&repository.WebhookURLHost,
@@ -309,6 +319,8 @@ func (*Store) listRepositoryImplV2(ctx context.Context, tx *Tx, find *FindReposi
 			schema_path_template,
 			sheet_path_template,
 			enable_sql_review_ci,
+			enable_pull_request_flow,
+			enable_schema_write_back_pull_request,
 			external_id,
 			external_webhook_id,
 			webhook_url_host,
@@ -344,6 +356,8 @@ func (*Store) listRepositoryImplV2(ctx context.Context, tx *Tx, find *FindReposi
 			&repository.SchemaPathTemplate,
 			&repository.SheetPathTemplate,
 			&repository.EnableSQLReviewCI,
+			&repository.EnablePullRequestFlow,
+			&repository.EnableSchemaWriteBackPullRequest,
 			&repository.ExternalID,
 			&repository.ExternalWebhookID,
 			&repository.WebhookURLHost,
@@ -397,6 +411,12 @@ func (*Store) patchRepositoryImplV2(ctx context.Context, tx *Tx, patch *PatchRep
 	if v := patch.EnableSQLReviewCI; v != nil {
 		set, args = append(set, fmt.Sprintf("enable_sql_review_ci = $%d", len(args)+1)), append(args, *v)
 	}
+	if v := patch.EnablePullRequestFlow; v != nil {
+		set, args = append(set, fmt.Sprintf("enable_pull_request_flow = $%d", len(args)+1)), append(args, *v)
+	}
+	if v := patch.EnableSchemaWriteBackPullRequest; v != nil {
+		set, args = append(set, fmt.Sprintf("enable_schema_write_back_pull_request = $%d", len(args)+1)), append(args, *v)
+	}
 
 	where := []string{}
 	if v := patch.UID; v != nil {
@@ -429,6 +449,8 @@ func (*Store) patchRepositoryImplV2(ctx context.Context, tx *Tx, patch *PatchRep
 			schema_path_template,
 			sheet_path_template,
 			enable_sql_review_ci,
+			enable_pull_request_flow,
+			enable_schema_write_back_pull_request,
 			external_id,
 			external_webhook_id,
 			webhook_url_host,
@@ -452,6 +474,8 @@ func (*Store) patchRepositoryImplV2(ctx context.Context, tx *Tx, patch *PatchRep
 		&repository.SchemaPathTemplate,
 		&repository.SheetPathTemplate,
 		&repository.EnableSQLReviewCI,
+		&repository.EnablePullRequestFlow,
+		&repository.EnableSchemaWriteBackPullRequest,
 		&repository.ExternalID,
 		&repository.ExternalWebhookID,
 		&repository.WebhookURLHost,
@@ -91,6 +91,46 @@ func (s *Store) GetWorkspaceApprovalSetting(ctx context.Context) (*storepb.Works
 	return payload, nil
 }
 
+// GetWorkspaceSCIMSetting gets the workspace SCIM setting.
+// An empty setting is returned if it's not set.
+func (s *Store) GetWorkspaceSCIMSetting(ctx context.Context) (*storepb.SCIMSetting, error) {
+	settingName := api.SettingWorkspaceSCIM
+	setting, err := s.GetSettingV2(ctx, &FindSettingMessage{
+		Name: &settingName,
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get setting %s", settingName)
+	}
+	payload := new(storepb.SCIMSetting)
+	if setting == nil {
+		return payload, nil
+	}
+	if err := protojson.Unmarshal([]byte(setting.Value), payload); err != nil {
+		return nil, err
+	}
+	return payload, nil
+}
+
+// GetWorkspaceAuditLogExportSetting gets the workspace audit log export setting.
+// An empty setting is returned if it's not set.
+func (s *Store) GetWorkspaceAuditLogExportSetting(ctx context.Context) (*storepb.AuditLogExportSetting, error) {
+	settingName := api.SettingWorkspaceAuditLogExport
+	setting, err := s.GetSettingV2(ctx, &FindSettingMessage{
+		Name: &settingName,
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get setting %s", settingName)
+	}
+	payload := new(storepb.AuditLogExportSetting)
+	if setting == nil {
+		return payload, nil
+	}
+	if err := protojson.Unmarshal([]byte(setting.Value), payload); err != nil {
+		return nil, err
+	}
+	return payload, nil
+}
+
 // GetWorkspaceExternalApprovalSetting gets the workspace external approval setting.
 func (s *Store) GetWorkspaceExternalApprovalSetting(ctx context.Context) (*storepb.ExternalApprovalSetting, error) {
 	settingName := api.SettingWorkspaceExternalApproval
@@ -41,13 +41,18 @@ type Store struct {
 	// sheetStatementCache caches the statement of a sheet.
 	sheetStatementCache cache.Cache[int, string]
 	vcsIDCache          sync.Map // map[int]*ExternalVersionControlMessage
+
+	// auditLogKey is the key of the audit log hash chain. It's empty if the chain isn't keyed.
+	auditLogKey []byte
 }
 
 // New creates a new instance of Store.
-func New(db *DB) *Store {
+// The audit log key is held by the server outside the database, it can be nil if the store doesn't append audit logs.
+func New(db *DB, auditLogKey []byte) *Store {
 	return &Store{
 		db:                  db,
 		sheetStatementCache: cache.NewCache[int, string](),
+		auditLogKey:         auditLogKey,
 	}
 }
 
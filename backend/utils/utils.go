@@ -17,6 +17,7 @@ import (
 	"github.com/pkg/errors"
 	"go.uber.org/zap"
 	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/durationpb"
 
 	"github.com/bytebase/bytebase/backend/common"
 	"github.com/bytebase/bytebase/backend/common/log"
@@ -645,29 +646,129 @@ func EndMigration(ctx context.Context, storeInstance *store.Store, startedNs int
 
 // FindNextPendingStep finds the next pending step in the approval flow.
 func FindNextPendingStep(template *storepb.ApprovalTemplate, approvers []*storepb.IssuePayloadApproval_Approver) *storepb.ApprovalStep {
-	// We can do the finding like this for now because we are presuming that
-	// one step is approved by one approver.
-	// and the approver status is either
-	// APPROVED or REJECTED.
-	if len(approvers) >= len(template.Flow.Steps) {
+	next := FindNextPendingStepIndex(template, approvers)
+	if next < 0 {
 		return nil
 	}
-	return template.Flow.Steps[len(approvers)]
+	return template.Flow.Steps[next]
+}
+
+// FindNextPendingStepIndex finds the index of the next pending step in the approval flow, or -1 if there isn't one.
+func FindNextPendingStepIndex(template *storepb.ApprovalTemplate, approvers []*storepb.IssuePayloadApproval_Approver) int {
+	stepIndexes := GetApproverStepIndexes(template, approvers)
+	next := 0
+	if len(approvers) > 0 {
+		last := len(approvers) - 1
+		next = stepIndexes[last]
+		if next < 0 {
+			return -1
+		}
+		if isStepApproved(template.Flow.Steps[next], getStepApprovers(approvers, stepIndexes, next)) {
+			next++
+		}
+	}
+	if next >= len(template.Flow.Steps) {
+		return -1
+	}
+	return next
+}
+
+// FindPendingStepApprovers returns the approvers of the next pending step, who have approved the step partially.
+func FindPendingStepApprovers(template *storepb.ApprovalTemplate, approvers []*storepb.IssuePayloadApproval_Approver) []*storepb.IssuePayloadApproval_Approver {
+	step := FindNextPendingStep(template, approvers)
+	if step == nil {
+		return nil
+	}
+	stepIndexes := GetApproverStepIndexes(template, approvers)
+	for i, s := range template.Flow.Steps {
+		if s == step {
+			return getStepApprovers(approvers, stepIndexes, i)
+		}
+	}
+	return nil
 }
 
 // FindRejectedStep finds the rejected step in the approval flow.
 func FindRejectedStep(template *storepb.ApprovalTemplate, approvers []*storepb.IssuePayloadApproval_Approver) *storepb.ApprovalStep {
+	stepIndexes := GetApproverStepIndexes(template, approvers)
 	for i, approver := range approvers {
-		if i >= len(template.Flow.Steps) {
+		if stepIndexes[i] < 0 {
 			return nil
 		}
 		if approver.Status == storepb.IssuePayloadApproval_Approver_REJECTED {
-			return template.Flow.Steps[i]
+			return template.Flow.Steps[stepIndexes[i]]
 		}
 	}
 	return nil
 }
 
+// GetApproverStepIndexes returns the index of the step approved or rejected by each approver.
+// The approvers are in order, and each step takes the approvers until it's approved or rejected.
+// The index is -1 for the approvers after all steps.
+func GetApproverStepIndexes(template *storepb.ApprovalTemplate, approvers []*storepb.IssuePayloadApproval_Approver) []int {
+	stepIndexes := make([]int, len(approvers))
+	step := 0
+	var stepApprovers []*storepb.IssuePayloadApproval_Approver
+	for i, approver := range approvers {
+		if step >= len(template.Flow.Steps) {
+			stepIndexes[i] = -1
+			continue
+		}
+		stepIndexes[i] = step
+		stepApprovers = append(stepApprovers, approver)
+		if approver.Status == storepb.IssuePayloadApproval_Approver_REJECTED {
+			// The approval is closed after the rejection.
+			step = len(template.Flow.Steps)
+			continue
+		}
+		if isStepApproved(template.Flow.Steps[step], stepApprovers) {
+			step++
+			stepApprovers = nil
+		}
+	}
+	return stepIndexes
+}
+
+func getStepApprovers(approvers []*storepb.IssuePayloadApproval_Approver, stepIndexes []int, step int) []*storepb.IssuePayloadApproval_Approver {
+	var stepApprovers []*storepb.IssuePayloadApproval_Approver
+	for i, approver := range approvers {
+		if stepIndexes[i] == step {
+			stepApprovers = append(stepApprovers, approver)
+		}
+	}
+	return stepApprovers
+}
+
+// isStepApproved returns whether the step is approved by the approvers of the step.
+// - The ANY step is approved by the quorum of different users, or by the system bot skipping the step.
+// - The ALL step is approved if every node is approved.
+// - The escalated step is approved by the escalation node, whose node index is the number of the step nodes.
+func isStepApproved(step *storepb.ApprovalStep, approvers []*storepb.IssuePayloadApproval_Approver) bool {
+	principals := make(map[int32]bool)
+	nodes := make(map[int32]bool)
+	for _, approver := range approvers {
+		if approver.Status != storepb.IssuePayloadApproval_Approver_APPROVED {
+			return false
+		}
+		if int(approver.NodeIndex) >= len(step.Nodes) {
+			return true
+		}
+		principals[approver.PrincipalId] = true
+		nodes[approver.NodeIndex] = true
+	}
+	if step.Type == storepb.ApprovalStep_ALL {
+		return len(nodes) >= len(step.Nodes)
+	}
+	if principals[api.SystemBotID] {
+		return true
+	}
+	quorum := int(step.Quorum)
+	if quorum < 1 {
+		quorum = 1
+	}
+	return len(principals) >= quorum
+}
+
 // CheckApprovalApproved checks if the approval is approved.
 func CheckApprovalApproved(approval *storepb.IssuePayloadApproval) (bool, error) {
 	if approval == nil || !approval.ApprovalFindingDone {
@@ -685,15 +786,82 @@ func CheckApprovalApproved(approval *storepb.IssuePayloadApproval) (bool, error)
 	return FindRejectedStep(approval.ApprovalTemplates[0], approval.Approvers) == nil && FindNextPendingStep(approval.ApprovalTemplates[0], approval.Approvers) == nil, nil
 }
 
+// GetApprovalDueTime returns the time when the pending step of the approval is overdue,
+// which is the first SLA duration of the approval template after the step becomes pending.
+// It returns nil if there is no pending step tracked by the SLA state.
+func GetApprovalDueTime(approval *storepb.IssuePayloadApproval) *time.Time {
+	if approval == nil || len(approval.ApprovalTemplates) != 1 || approval.SlaState == nil {
+		return nil
+	}
+	template := approval.ApprovalTemplates[0]
+	sla := template.Sla
+	if sla == nil || approval.SlaState.PendingTime == nil {
+		return nil
+	}
+	if FindRejectedStep(template, approval.Approvers) != nil || FindNextPendingStepIndex(template, approval.Approvers) != int(approval.SlaState.StepIndex) {
+		return nil
+	}
+	var first time.Duration
+	for _, d := range []*durationpb.Duration{sla.ReminderInterval, sla.EscalationTimeout, sla.ExpirationTimeout} {
+		if d.AsDuration() > 0 && (first == 0 || d.AsDuration() < first) {
+			first = d.AsDuration()
+		}
+	}
+	if first == 0 {
+		return nil
+	}
+	dueTime := approval.SlaState.PendingTime.AsTime().Add(first)
+	return &dueTime
+}
+
 // CheckIssueApproved checks if the issue is approved.
+// The draft issue of an unmerged pull request is never approved, it's rolled out after the pull request is merged.
 func CheckIssueApproved(issue *store.IssueMessage) (bool, error) {
 	issuePayload := &storepb.IssuePayload{}
 	if err := protojson.Unmarshal([]byte(issue.Payload), issuePayload); err != nil {
 		return false, errors.Wrap(err, "failed to unmarshal issue payload")
 	}
+	if pullRequest := issuePayload.PullRequest; pullRequest != nil && !pullRequest.Merged {
+		return false, nil
+	}
 	return CheckApprovalApproved(issuePayload.Approval)
 }
 
+// IsUnmergedPullRequestDraft returns true if the issue is drafted from a pull request that hasn't been merged.
+func IsUnmergedPullRequestDraft(issue *store.IssueMessage) (bool, error) {
+	issuePayload := &storepb.IssuePayload{}
+	if err := protojson.Unmarshal([]byte(issue.Payload), issuePayload); err != nil {
+		return false, errors.Wrap(err, "failed to unmarshal issue payload")
+	}
+	pullRequest := issuePayload.PullRequest
+	return pullRequest != nil && !pullRequest.Merged, nil
+}
+
+// maxIssueLabelLength is the maximum length of an issue label.
+const maxIssueLabelLength = 64
+
+// NormalizeIssueLabels validates the issue labels and returns the sorted labels without duplicates.
+func NormalizeIssueLabels(labels []string) ([]string, error) {
+	seen := map[string]bool{}
+	var normalized []string
+	for _, label := range labels {
+		label = strings.TrimSpace(label)
+		if label == "" {
+			return nil, errors.New("label cannot be empty")
+		}
+		if len(label) > maxIssueLabelLength {
+			return nil, errors.Errorf("label %q exceeds %d characters", label, maxIssueLabelLength)
+		}
+		if seen[label] {
+			continue
+		}
+		seen[label] = true
+		normalized = append(normalized, label)
+	}
+	sort.Strings(normalized)
+	return normalized, nil
+}
+
 // HandleIncomingApprovalSteps handles incoming approval steps.
 // - skips approval steps if no user can approve the step
 // - creates external approvals for external approval nodes.
@@ -750,25 +918,66 @@ func HandleIncomingApprovalSteps(ctx context.Context, s *store.Store, relayClien
 			users = append(users, userMessages[0])
 		}
 	}
+	// The approvers of the previous steps are needed to find the next pending step.
+	allApprovers := append([]*storepb.IssuePayloadApproval_Approver{}, approval.Approvers...)
 	for {
-		step := FindNextPendingStep(approval.ApprovalTemplates[0], approvers)
+		template := approval.ApprovalTemplates[0]
+		step := FindNextPendingStep(template, allApprovers)
 		if step == nil {
 			break
 		}
-		if len(step.Nodes) != 1 {
-			return nil, nil, errors.Errorf("expecting one node but got %v", len(step.Nodes))
+		if len(step.Nodes) == 0 {
+			return nil, nil, errors.Errorf("expecting at least one node but got 0")
 		}
-		if step.Type != storepb.ApprovalStep_ANY {
-			return nil, nil, errors.Errorf("expecting ANY step type but got %v", step.Type)
+		if step.Type != storepb.ApprovalStep_ANY && step.Type != storepb.ApprovalStep_ALL {
+			return nil, nil, errors.Errorf("expecting ANY or ALL step type but got %v", step.Type)
 		}
-		node := step.Nodes[0]
-		if v, ok := node.GetPayload().(*storepb.ApprovalNode_ExternalNodeId); ok {
-			if err := handleApprovalNodeExternalNode(ctx, s, relayClient, issue, v.ExternalNodeId); err != nil {
+		if len(FindPendingStepApprovers(template, allApprovers)) == 0 {
+			if v, ok := step.Nodes[0].GetPayload().(*storepb.ApprovalNode_ExternalNodeId); ok {
+				if err := handleApprovalNodeExternalNode(ctx, s, relayClient, issue, v.ExternalNodeId); err != nil {
+					approver := &storepb.IssuePayloadApproval_Approver{
+						Status:      storepb.IssuePayloadApproval_Approver_REJECTED,
+						PrincipalId: api.SystemBotID,
+					}
+					approvers = append(approvers, approver)
+					activity, err := getActivityCreate(storepb.ActivityIssueCommentCreatePayload_ApprovalEvent_REJECTED, fmt.Sprintf("failed to handle external node, err: %v", err))
+					if err != nil {
+						return nil, nil, err
+					}
+					activities = append(activities, activity)
+				}
+				break
+			}
+		}
+
+		// Skip the nodes without any user who can approve.
+		// The creator is counted even if the step forbids self-approval, so that the step is rejected instead of skipped for it.
+		var skippedNodes []int32
+		for i, node := range step.Nodes {
+			if isNodeApproved(step, FindPendingStepApprovers(template, allApprovers), i) {
+				continue
+			}
+			hasApprover, err := userCanApprove(ctx, s, node, users, policy)
+			if err != nil {
+				return nil, nil, errors.Wrapf(err, "failed to check if user can approve")
+			}
+			if !hasApprover {
+				skippedNodes = append(skippedNodes, int32(i))
+			}
+		}
+		if (step.Type == storepb.ApprovalStep_ANY && len(skippedNodes) < len(step.Nodes)) || len(skippedNodes) == 0 {
+			// Reject the step which can never be approved, so that the issue isn't stuck in review.
+			// The creator can request the review again after the approvers are changed.
+			reason, err := getUnapprovableStepReason(ctx, s, issue, template, step, FindPendingStepApprovers(template, allApprovers))
+			if err != nil {
+				return nil, nil, err
+			}
+			if reason != "" {
 				approvers = append(approvers, &storepb.IssuePayloadApproval_Approver{
 					Status:      storepb.IssuePayloadApproval_Approver_REJECTED,
 					PrincipalId: api.SystemBotID,
 				})
-				activity, err := getActivityCreate(storepb.ActivityIssueCommentCreatePayload_ApprovalEvent_REJECTED, fmt.Sprintf("failed to handle external node, err: %v", err))
+				activity, err := getActivityCreate(storepb.ActivityIssueCommentCreatePayload_ApprovalEvent_REJECTED, reason)
 				if err != nil {
 					return nil, nil, err
 				}
@@ -776,24 +985,24 @@ func HandleIncomingApprovalSteps(ctx context.Context, s *store.Store, relayClien
 			}
 			break
 		}
-
-		hasApprover, err := userCanApprove(node, users, policy)
-		if err != nil {
-			return nil, nil, errors.Wrapf(err, "failed to check if user can approve")
+		if step.Type == storepb.ApprovalStep_ANY {
+			// One approval of the system bot skips the ANY step.
+			skippedNodes = skippedNodes[:1]
 		}
-		if hasApprover {
-			break
-		}
-
-		approvers = append(approvers, &storepb.IssuePayloadApproval_Approver{
-			Status:      storepb.IssuePayloadApproval_Approver_APPROVED,
-			PrincipalId: api.SystemBotID,
-		})
-		activity, err := getActivityCreate(storepb.ActivityIssueCommentCreatePayload_ApprovalEvent_APPROVED, "")
-		if err != nil {
-			return nil, nil, err
+		for _, nodeIndex := range skippedNodes {
+			approver := &storepb.IssuePayloadApproval_Approver{
+				Status:      storepb.IssuePayloadApproval_Approver_APPROVED,
+				PrincipalId: api.SystemBotID,
+				NodeIndex:   nodeIndex,
+			}
+			approvers = append(approvers, approver)
+			allApprovers = append(allApprovers, approver)
+			activity, err := getActivityCreate(storepb.ActivityIssueCommentCreatePayload_ApprovalEvent_APPROVED, "")
+			if err != nil {
+				return nil, nil, err
+			}
+			activities = append(activities, activity)
 		}
-		activities = append(activities, activity)
 	}
 	return approvers, activities, nil
 }
@@ -841,7 +1050,136 @@ func handleApprovalNodeExternalNode(ctx context.Context, s *store.Store, relayCl
 	return nil
 }
 
-func userCanApprove(node *storepb.ApprovalNode, users []*store.UserMessage, policy *store.IAMPolicyMessage) (bool, error) {
+// getUnapprovableStepReason returns the reason why the pending step can never be approved, or empty if it can.
+// The step isn't stuck if the SLA of the template expires it, or escalates it to the users who can approve it.
+func getUnapprovableStepReason(ctx context.Context, s *store.Store, issue *store.IssueMessage, template *storepb.ApprovalTemplate, step *storepb.ApprovalStep, stepApprovers []*storepb.IssuePayloadApproval_Approver) (string, error) {
+	sla := template.GetSla()
+	if sla.GetExpirationTimeout().AsDuration() > 0 {
+		return "", nil
+	}
+	if sla.GetEscalationTimeout().AsDuration() > 0 && sla.GetEscalationNode() != nil {
+		users, err := ListApprovalNodeUsers(ctx, s, sla.GetEscalationNode(), issue.Project.ResourceID)
+		if err != nil {
+			return "", errors.Wrapf(err, "failed to list escalation node users")
+		}
+		for _, user := range users {
+			if canApproveStep(step, stepApprovers, user, issue.Creator.ID) {
+				return "", nil
+			}
+		}
+	}
+
+	var nodeUsers [][]*store.UserMessage
+	for _, node := range step.Nodes {
+		if _, ok := node.Payload.(*storepb.ApprovalNode_ExternalNodeId); ok {
+			// The external node is approved by the relay service.
+			return "", nil
+		}
+		users, err := ListApprovalNodeUsers(ctx, s, node, issue.Project.ResourceID)
+		if err != nil {
+			return "", errors.Wrapf(err, "failed to list approval node users")
+		}
+		nodeUsers = append(nodeUsers, users)
+	}
+	return checkStepApprovable(step, nodeUsers, stepApprovers, issue.Creator.ID), nil
+}
+
+// canApproveStep returns whether the user can still approve the step. The users who have approved the step,
+// and the issue creator if the step forbids self-approval cannot approve it.
+func canApproveStep(step *storepb.ApprovalStep, stepApprovers []*storepb.IssuePayloadApproval_Approver, user *store.UserMessage, creatorID int) bool {
+	if user.MemberDeleted || user.ID == api.SystemBotID {
+		return false
+	}
+	if step.ForbidSelfApproval && user.ID == creatorID {
+		return false
+	}
+	for _, approver := range stepApprovers {
+		if int(approver.PrincipalId) == user.ID {
+			return false
+		}
+	}
+	return true
+}
+
+// checkStepApprovable returns the reason why the step can never be approved by the users of each node, or empty if it can.
+// - The ANY step needs enough different users for the remaining quorum.
+// - The ALL step needs a different user for every remaining node.
+func checkStepApprovable(step *storepb.ApprovalStep, nodeUsers [][]*store.UserMessage, stepApprovers []*storepb.IssuePayloadApproval_Approver, creatorID int) string {
+	approved := make(map[int32]bool)
+	approvedNodes := make(map[int]bool)
+	for _, approver := range stepApprovers {
+		approved[approver.PrincipalId] = true
+		approvedNodes[int(approver.NodeIndex)] = true
+	}
+	canApprove := func(user *store.UserMessage) bool {
+		return canApproveStep(step, stepApprovers, user, creatorID)
+	}
+	selfApprovalNote := ""
+	if step.ForbidSelfApproval {
+		selfApprovalNote = " other than the issue creator"
+	}
+
+	if step.Type == storepb.ApprovalStep_ANY {
+		quorum := int(step.Quorum)
+		if quorum < 1 {
+			quorum = 1
+		}
+		required := quorum - len(approved)
+		eligible := make(map[int]bool)
+		for _, users := range nodeUsers {
+			for _, user := range users {
+				if canApprove(user) {
+					eligible[user.ID] = true
+				}
+			}
+		}
+		if len(eligible) < required {
+			return fmt.Sprintf("The approval step requires %d more approval(s) from different users, but only %d user(s)%s can approve it.", required, len(eligible), selfApprovalNote)
+		}
+		return ""
+	}
+
+	// Find a different user for every remaining node of the ALL step by bipartite matching.
+	matchedNode := make(map[int]int)
+	var match func(node int, visited map[int]bool) bool
+	match = func(node int, visited map[int]bool) bool {
+		for _, user := range nodeUsers[node] {
+			if !canApprove(user) || visited[user.ID] {
+				continue
+			}
+			visited[user.ID] = true
+			if other, ok := matchedNode[user.ID]; !ok || match(other, visited) {
+				matchedNode[user.ID] = node
+				return true
+			}
+		}
+		return false
+	}
+	for i := range nodeUsers {
+		if approvedNodes[i] {
+			continue
+		}
+		if !match(i, make(map[int]bool)) {
+			return fmt.Sprintf("The approval step requires a different user to approve every node, but there are not enough users%s to approve node %d.", selfApprovalNote, i+1)
+		}
+	}
+	return ""
+}
+
+// isNodeApproved returns whether the node of the ALL step is approved by the approvers of the step.
+func isNodeApproved(step *storepb.ApprovalStep, stepApprovers []*storepb.IssuePayloadApproval_Approver, nodeIndex int) bool {
+	if step.Type != storepb.ApprovalStep_ALL {
+		return false
+	}
+	for _, approver := range stepApprovers {
+		if approver.Status == storepb.IssuePayloadApproval_Approver_APPROVED && int(approver.NodeIndex) == nodeIndex {
+			return true
+		}
+	}
+	return false
+}
+
+func userCanApprove(ctx context.Context, s *store.Store, node *storepb.ApprovalNode, users []*store.UserMessage, policy *store.IAMPolicyMessage) (bool, error) {
 	if node.Type != storepb.ApprovalNode_ANY_IN_GROUP {
 		return false, errors.Errorf("expecting ANY_IN_GROUP node type but got %v", node.Type)
 	}
@@ -885,6 +1223,13 @@ func userCanApprove(node *storepb.ApprovalNode, users []*store.UserMessage, poli
 		}
 	case *storepb.ApprovalNode_Role:
 		return projectRoleExist[val.Role], nil
+	case *storepb.ApprovalNode_User:
+		email := strings.TrimPrefix(val.User, "users/")
+		user, err := s.GetUser(ctx, &store.FindUserMessage{Email: &email})
+		if err != nil {
+			return false, errors.Wrapf(err, "failed to get user %q", email)
+		}
+		return user != nil && !user.MemberDeleted, nil
 	case *storepb.ApprovalNode_ExternalNodeId:
 		return true, nil
 	default:
@@ -892,6 +1237,63 @@ func userCanApprove(node *storepb.ApprovalNode, users []*store.UserMessage, poli
 	}
 }
 
+// ListApprovalNodeUsers lists the active users who can approve the node of the project. The external node has no users.
+func ListApprovalNodeUsers(ctx context.Context, s *store.Store, node *storepb.ApprovalNode, projectID string) ([]*store.UserMessage, error) {
+	listWorkspaceRole := func(role api.Role) ([]*store.UserMessage, error) {
+		return s.ListUsers(ctx, &store.FindUserMessage{Role: &role})
+	}
+	listProjectRole := func(role api.Role) ([]*store.UserMessage, error) {
+		policy, err := s.GetProjectPolicy(ctx, &store.GetProjectPolicyMessage{ProjectID: &projectID})
+		if err != nil {
+			return nil, err
+		}
+		var users []*store.UserMessage
+		for _, binding := range policy.Bindings {
+			if binding.Role != role {
+				continue
+			}
+			for _, member := range binding.Members {
+				if !member.MemberDeleted {
+					users = append(users, member)
+				}
+			}
+		}
+		return users, nil
+	}
+
+	switch val := node.Payload.(type) {
+	case *storepb.ApprovalNode_GroupValue_:
+		switch val.GroupValue {
+		case storepb.ApprovalNode_WORKSPACE_OWNER:
+			return listWorkspaceRole(api.Owner)
+		case storepb.ApprovalNode_WORKSPACE_DBA:
+			return listWorkspaceRole(api.DBA)
+		case storepb.ApprovalNode_PROJECT_OWNER:
+			return listProjectRole(api.Owner)
+		case storepb.ApprovalNode_PROJECT_MEMBER:
+			return listProjectRole(api.Developer)
+		default:
+			return nil, errors.Errorf("invalid group value")
+		}
+	case *storepb.ApprovalNode_Role:
+		return listProjectRole(api.Role(strings.TrimPrefix(val.Role, "roles/")))
+	case *storepb.ApprovalNode_User:
+		email := strings.TrimPrefix(val.User, "users/")
+		user, err := s.GetUser(ctx, &store.FindUserMessage{Email: &email})
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to get user %q", email)
+		}
+		if user == nil || user.MemberDeleted {
+			return nil, nil
+		}
+		return []*store.UserMessage{user}, nil
+	case *storepb.ApprovalNode_ExternalNodeId:
+		return nil, nil
+	default:
+		return nil, errors.Errorf("invalid node payload type")
+	}
+}
+
 func convertToRoleName(role api.Role) string {
 	return fmt.Sprintf("roles/%s", role)
 }
@@ -948,6 +1350,10 @@ func convertVcsPushEventType(vcsType vcs.Type) storepb.VcsType {
 		return storepb.VcsType_GITHUB
 	case "BITBUCKET":
 		return storepb.VcsType_BITBUCKET
+	case "GITEA":
+		return storepb.VcsType_GITEA
+	case "AZURE_DEVOPS":
+		return storepb.VcsType_AZURE_DEVOPS
 	default:
 		return storepb.VcsType_VCS_TYPE_UNSPECIFIED
 	}
@@ -1,13 +1,18 @@
 package utils
 
 import (
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	api "github.com/bytebase/bytebase/backend/legacyapi"
 	"github.com/bytebase/bytebase/backend/store"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
 )
 
 func TestGetDatabaseMatrixFromDeploymentSchedule(t *testing.T) {
@@ -417,3 +422,277 @@ func TestGetRenderedStatement(t *testing.T) {
 		assert.Equal(t, tc.expected, actual)
 	}
 }
+
+func TestApprovalSteps(t *testing.T) {
+	node := &storepb.ApprovalNode{
+		Type:    storepb.ApprovalNode_ANY_IN_GROUP,
+		Payload: &storepb.ApprovalNode_GroupValue_{GroupValue: storepb.ApprovalNode_WORKSPACE_DBA},
+	}
+	template := &storepb.ApprovalTemplate{
+		Flow: &storepb.ApprovalFlow{
+			Steps: []*storepb.ApprovalStep{
+				{Type: storepb.ApprovalStep_ANY, Nodes: []*storepb.ApprovalNode{node}},
+				{Type: storepb.ApprovalStep_ANY, Nodes: []*storepb.ApprovalNode{node}, Quorum: 2},
+				{Type: storepb.ApprovalStep_ALL, Nodes: []*storepb.ApprovalNode{node, node}},
+			},
+		},
+	}
+	approve := func(principalID, nodeIndex int32) *storepb.IssuePayloadApproval_Approver {
+		return &storepb.IssuePayloadApproval_Approver{
+			Status:      storepb.IssuePayloadApproval_Approver_APPROVED,
+			PrincipalId: principalID,
+			NodeIndex:   nodeIndex,
+		}
+	}
+	reject := &storepb.IssuePayloadApproval_Approver{
+		Status:      storepb.IssuePayloadApproval_Approver_REJECTED,
+		PrincipalId: 104,
+	}
+
+	tests := []struct {
+		approvers            []*storepb.IssuePayloadApproval_Approver
+		wantStepIndexes      []int
+		wantPendingStep      int
+		wantPendingApprovers int
+		wantRejectedStep     int
+	}{
+		{
+			approvers:        nil,
+			wantStepIndexes:  []int{},
+			wantPendingStep:  0,
+			wantRejectedStep: -1,
+		},
+		{
+			approvers:            []*storepb.IssuePayloadApproval_Approver{approve(101, 0), approve(102, 0)},
+			wantStepIndexes:      []int{0, 1},
+			wantPendingStep:      1,
+			wantPendingApprovers: 1,
+			wantRejectedStep:     -1,
+		},
+		{
+			// The same user is counted once in the quorum.
+			approvers:            []*storepb.IssuePayloadApproval_Approver{approve(101, 0), approve(102, 0), approve(102, 0)},
+			wantStepIndexes:      []int{0, 1, 1},
+			wantPendingStep:      1,
+			wantPendingApprovers: 2,
+			wantRejectedStep:     -1,
+		},
+		{
+			approvers:            []*storepb.IssuePayloadApproval_Approver{approve(101, 0), approve(102, 0), approve(103, 0), approve(104, 1)},
+			wantStepIndexes:      []int{0, 1, 1, 2},
+			wantPendingStep:      2,
+			wantPendingApprovers: 1,
+			wantRejectedStep:     -1,
+		},
+		{
+			// The system bot skips the ANY step.
+			approvers:        []*storepb.IssuePayloadApproval_Approver{approve(101, 0), approve(api.SystemBotID, 0), approve(104, 1), approve(105, 0)},
+			wantStepIndexes:  []int{0, 1, 2, 2},
+			wantPendingStep:  -1,
+			wantRejectedStep: -1,
+		},
+		{
+			// The escalation node approves the step on behalf of the step nodes.
+			approvers:        []*storepb.IssuePayloadApproval_Approver{approve(101, 0), approve(102, 1)},
+			wantStepIndexes:  []int{0, 1},
+			wantPendingStep:  2,
+			wantRejectedStep: -1,
+		},
+		{
+			approvers:            []*storepb.IssuePayloadApproval_Approver{approve(101, 0), approve(102, 0), reject},
+			wantStepIndexes:      []int{0, 1, 1},
+			wantPendingStep:      1,
+			wantPendingApprovers: 2,
+			wantRejectedStep:     1,
+		},
+	}
+	for i, test := range tests {
+		require.Equal(t, test.wantStepIndexes, GetApproverStepIndexes(template, test.approvers), i)
+		pendingStep := FindNextPendingStep(template, test.approvers)
+		if test.wantPendingStep < 0 {
+			require.Nil(t, pendingStep, i)
+		} else {
+			require.Same(t, template.Flow.Steps[test.wantPendingStep], pendingStep, i)
+		}
+		require.Len(t, FindPendingStepApprovers(template, test.approvers), test.wantPendingApprovers, i)
+		rejectedStep := FindRejectedStep(template, test.approvers)
+		if test.wantRejectedStep < 0 {
+			require.Nil(t, rejectedStep, i)
+		} else {
+			require.Same(t, template.Flow.Steps[test.wantRejectedStep], rejectedStep, i)
+		}
+	}
+}
+
+func TestCheckStepApprovable(t *testing.T) {
+	creator := &store.UserMessage{ID: 101}
+	alice := &store.UserMessage{ID: 102}
+	bob := &store.UserMessage{ID: 103}
+	deleted := &store.UserMessage{ID: 104, MemberDeleted: true}
+	approve := func(principalID, nodeIndex int32) *storepb.IssuePayloadApproval_Approver {
+		return &storepb.IssuePayloadApproval_Approver{
+			Status:      storepb.IssuePayloadApproval_Approver_APPROVED,
+			PrincipalId: principalID,
+			NodeIndex:   nodeIndex,
+		}
+	}
+
+	tests := []struct {
+		step          *storepb.ApprovalStep
+		nodeUsers     [][]*store.UserMessage
+		stepApprovers []*storepb.IssuePayloadApproval_Approver
+		wantOK        bool
+	}{
+		{
+			step:      &storepb.ApprovalStep{Type: storepb.ApprovalStep_ANY},
+			nodeUsers: [][]*store.UserMessage{{creator}},
+			wantOK:    true,
+		},
+		{
+			// The creator is the only user who can approve.
+			step:      &storepb.ApprovalStep{Type: storepb.ApprovalStep_ANY, ForbidSelfApproval: true},
+			nodeUsers: [][]*store.UserMessage{{creator, deleted}},
+			wantOK:    false,
+		},
+		{
+			// The same user in different nodes is counted once in the quorum.
+			step:      &storepb.ApprovalStep{Type: storepb.ApprovalStep_ANY, Quorum: 2},
+			nodeUsers: [][]*store.UserMessage{{alice}, {alice}},
+			wantOK:    false,
+		},
+		{
+			step:          &storepb.ApprovalStep{Type: storepb.ApprovalStep_ANY, Quorum: 2},
+			nodeUsers:     [][]*store.UserMessage{{alice}, {bob}},
+			stepApprovers: []*storepb.IssuePayloadApproval_Approver{approve(102, 0)},
+			wantOK:        true,
+		},
+		{
+			step:      &storepb.ApprovalStep{Type: storepb.ApprovalStep_ANY, Quorum: 3},
+			nodeUsers: [][]*store.UserMessage{{alice, bob}},
+			wantOK:    false,
+		},
+		{
+			// Alice approves the second node, so that Bob approves the first one.
+			step:      &storepb.ApprovalStep{Type: storepb.ApprovalStep_ALL},
+			nodeUsers: [][]*store.UserMessage{{alice, bob}, {alice}},
+			wantOK:    true,
+		},
+		{
+			step:      &storepb.ApprovalStep{Type: storepb.ApprovalStep_ALL},
+			nodeUsers: [][]*store.UserMessage{{alice}, {alice}},
+			wantOK:    false,
+		},
+		{
+			step:          &storepb.ApprovalStep{Type: storepb.ApprovalStep_ALL},
+			nodeUsers:     [][]*store.UserMessage{{alice}, {alice}},
+			stepApprovers: []*storepb.IssuePayloadApproval_Approver{approve(102, 0)},
+			wantOK:        false,
+		},
+		{
+			step:          &storepb.ApprovalStep{Type: storepb.ApprovalStep_ALL},
+			nodeUsers:     [][]*store.UserMessage{{alice}, {bob}},
+			stepApprovers: []*storepb.IssuePayloadApproval_Approver{approve(102, 0)},
+			wantOK:        true,
+		},
+		{
+			step:      &storepb.ApprovalStep{Type: storepb.ApprovalStep_ALL, ForbidSelfApproval: true},
+			nodeUsers: [][]*store.UserMessage{{alice}, {creator}},
+			wantOK:    false,
+		},
+	}
+	for i, test := range tests {
+		reason := checkStepApprovable(test.step, test.nodeUsers, test.stepApprovers, creator.ID)
+		require.Equal(t, test.wantOK, reason == "", "%d: %s", i, reason)
+	}
+}
+
+func TestNormalizeIssueLabels(t *testing.T) {
+	a := require.New(t)
+	labels, err := NormalizeIssueLabels([]string{"pii", " hotfix", "pii", "billing"})
+	a.NoError(err)
+	a.Equal([]string{"billing", "hotfix", "pii"}, labels)
+
+	_, err = NormalizeIssueLabels([]string{"pii", " "})
+	a.Error(err)
+	_, err = NormalizeIssueLabels([]string{strings.Repeat("a", 65)})
+	a.Error(err)
+}
+
+func TestGetApprovalDueTime(t *testing.T) {
+	node := &storepb.ApprovalNode{
+		Type:    storepb.ApprovalNode_ANY_IN_GROUP,
+		Payload: &storepb.ApprovalNode_GroupValue_{GroupValue: storepb.ApprovalNode_WORKSPACE_DBA},
+	}
+	pendingTime := time.Date(2023, 6, 1, 0, 0, 0, 0, time.UTC)
+	newApproval := func(sla *storepb.ApprovalSLA, stepIndex int32, approvers ...*storepb.IssuePayloadApproval_Approver) *storepb.IssuePayloadApproval {
+		return &storepb.IssuePayloadApproval{
+			ApprovalTemplates: []*storepb.ApprovalTemplate{{
+				Flow: &storepb.ApprovalFlow{
+					Steps: []*storepb.ApprovalStep{
+						{Type: storepb.ApprovalStep_ANY, Nodes: []*storepb.ApprovalNode{node}},
+						{Type: storepb.ApprovalStep_ANY, Nodes: []*storepb.ApprovalNode{node}},
+					},
+				},
+				Sla: sla,
+			}},
+			Approvers:           approvers,
+			ApprovalFindingDone: true,
+			SlaState: &storepb.IssuePayloadApproval_SLAState{
+				StepIndex:   stepIndex,
+				PendingTime: timestamppb.New(pendingTime),
+			},
+		}
+	}
+	sla := &storepb.ApprovalSLA{
+		ReminderInterval:  durationpb.New(4 * time.Hour),
+		EscalationTimeout: durationpb.New(2 * time.Hour),
+	}
+	approved := &storepb.IssuePayloadApproval_Approver{
+		Status:      storepb.IssuePayloadApproval_Approver_APPROVED,
+		PrincipalId: 101,
+	}
+
+	tests := []struct {
+		approval *storepb.IssuePayloadApproval
+		want     *time.Time
+	}{
+		{
+			approval: newApproval(nil, 0),
+			want:     nil,
+		},
+		{
+			// The first SLA duration is the escalation timeout.
+			approval: newApproval(sla, 0),
+			want:     func() *time.Time { t := pendingTime.Add(2 * time.Hour); return &t }(),
+		},
+		{
+			// The SLA state of the approved step is stale.
+			approval: newApproval(sla, 0, approved),
+			want:     nil,
+		},
+		{
+			approval: newApproval(sla, 1, approved),
+			want:     func() *time.Time { t := pendingTime.Add(2 * time.Hour); return &t }(),
+		},
+	}
+	for i, test := range tests {
+		require.Equal(t, test.want, GetApprovalDueTime(test.approval), i)
+	}
+}
+
+func TestIsUnmergedPullRequestDraft(t *testing.T) {
+	a := require.New(t)
+	tests := []struct {
+		payload string
+		want    bool
+	}{
+		{payload: `{}`, want: false},
+		{payload: `{"pullRequest":{"repositoryId":1,"pullRequestId":"7"}}`, want: true},
+		{payload: `{"pullRequest":{"repositoryId":1,"pullRequestId":"7","merged":true}}`, want: false},
+	}
+	for _, test := range tests {
+		got, err := IsUnmergedPullRequestDraft(&store.IssueMessage{Payload: test.payload})
+		a.NoError(err)
+		a.Equal(test.want, got, test.payload)
+	}
+}
@@ -4,6 +4,7 @@ import { uniq, without } from "lodash-es";
 export const NumberFactorList = [
   // Risk related factors
   "affected_rows",
+  "blocking_rows",
   "level",
   "source",
 ] as const;
@@ -14,13 +15,15 @@ export const StringFactorList = [
   "database_name",
   "db_engine",
   "sql_type",
+  "lock_mode",
 ] as const;
 
 export const FactorList = {
-  DDL: uniq([...HighLevelFactorList, ...StringFactorList]),
+  DDL: uniq([...HighLevelFactorList, "blocking_rows", ...StringFactorList]),
   DML: uniq([...HighLevelFactorList, ...NumberFactorList, ...StringFactorList]),
   CreateDatabase: without(
     [...HighLevelFactorList, ...StringFactorList],
-    "sql_type"
+    "sql_type",
+    "lock_mode"
   ),
 };
@@ -1,6 +1,7 @@
 export const NumberFactorList = [
   // Risk related factors
   "affected_rows",
+  "blocking_rows",
   "level",
   "source",
 
@@ -16,6 +17,7 @@ export const StringFactorList = [
   "database_name",
   "db_engine",
   "sql_type",
+  "lock_mode",
 
   // Grant request issue related factors
   "resource.database",
@@ -49,6 +49,7 @@ export const isStringOperator = (op: Operator): op is StringOperator => {
 /// Define supported operators for each factor
 export const OperatorList: Record<Factor, Operator[]> = {
   affected_rows: uniq([...EqualityOperatorList, ...CompareOperatorList]),
+  blocking_rows: uniq([...EqualityOperatorList, ...CompareOperatorList]),
 
   level: uniq([...EqualityOperatorList, ...CollectionOperatorList]),
   source: uniq([...EqualityOperatorList, ...CollectionOperatorList]),
@@ -74,6 +75,11 @@ export const OperatorList: Record<Factor, Operator[]> = {
     ...CollectionOperatorList,
     ...StringOperatorList,
   ]),
+  lock_mode: uniq([
+    ...EqualityOperatorList,
+    ...CollectionOperatorList,
+    ...StringOperatorList,
+  ]),
 
   // Database group related fields.
   "resource.environment_name": uniq(["_==_"]),
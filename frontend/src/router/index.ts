@@ -135,6 +135,11 @@ const routes: Array<RouteRecordRaw> = [
     name: "oidc-callback",
     component: () => import("../views/OAuthCallback.vue"),
   },
+  {
+    path: "/saml/callback",
+    name: "saml-callback",
+    component: () => import("../views/OAuthCallback.vue"),
+  },
   {
     path: "/2fa/setup",
     name: "2fa.setup",
@@ -270,7 +270,8 @@ export type TaskCheckType =
   | "bb.task-check.issue.lgtm"
   | "bb.task-check.pitr.mysql"
   | "bb.task-check.database.statement.type.report"
-  | "bb.task-check.database.statement.affected-rows.report";
+  | "bb.task-check.database.statement.affected-rows.report"
+  | "bb.task-check.database.statement.lock.report";
 
 export type TaskCheckStatus = "SUCCESS" | "WARN" | "ERROR";
 
@@ -180,7 +180,51 @@ export function activityIssueCommentCreatePayload_ApprovalEvent_StatusToJSON(
 }
 
 export interface ActivityIssueApprovalNotifyPayload {
+  /** The pending step, or the step of the escalation node for the ESCALATION type. */
   approvalStep?: ApprovalStep;
+  type: ActivityIssueApprovalNotifyPayload_Type;
+}
+
+export enum ActivityIssueApprovalNotifyPayload_Type {
+  /** TYPE_UNSPECIFIED - The step becomes pending. */
+  TYPE_UNSPECIFIED = 0,
+  /** REMINDER - The step is overdue and the approvers are reminded. */
+  REMINDER = 1,
+  /** ESCALATION - The step is escalated to the escalation node. */
+  ESCALATION = 2,
+  UNRECOGNIZED = -1,
+}
+
+export function activityIssueApprovalNotifyPayload_TypeFromJSON(object: any): ActivityIssueApprovalNotifyPayload_Type {
+  switch (object) {
+    case 0:
+    case "TYPE_UNSPECIFIED":
+      return ActivityIssueApprovalNotifyPayload_Type.TYPE_UNSPECIFIED;
+    case 1:
+    case "REMINDER":
+      return ActivityIssueApprovalNotifyPayload_Type.REMINDER;
+    case 2:
+    case "ESCALATION":
+      return ActivityIssueApprovalNotifyPayload_Type.ESCALATION;
+    case -1:
+    case "UNRECOGNIZED":
+    default:
+      return ActivityIssueApprovalNotifyPayload_Type.UNRECOGNIZED;
+  }
+}
+
+export function activityIssueApprovalNotifyPayload_TypeToJSON(object: ActivityIssueApprovalNotifyPayload_Type): string {
+  switch (object) {
+    case ActivityIssueApprovalNotifyPayload_Type.TYPE_UNSPECIFIED:
+      return "TYPE_UNSPECIFIED";
+    case ActivityIssueApprovalNotifyPayload_Type.REMINDER:
+      return "REMINDER";
+    case ActivityIssueApprovalNotifyPayload_Type.ESCALATION:
+      return "ESCALATION";
+    case ActivityIssueApprovalNotifyPayload_Type.UNRECOGNIZED:
+    default:
+      return "UNRECOGNIZED";
+  }
 }
 
 function createBaseActivityIssueCreatePayload(): ActivityIssueCreatePayload {
@@ -628,7 +672,7 @@ export const ActivityIssueCommentCreatePayload_ApprovalEvent = {
 };
 
 function createBaseActivityIssueApprovalNotifyPayload(): ActivityIssueApprovalNotifyPayload {
-  return { approvalStep: undefined };
+  return { approvalStep: undefined, type: 0 };
 }
 
 export const ActivityIssueApprovalNotifyPayload = {
@@ -636,6 +680,9 @@ export const ActivityIssueApprovalNotifyPayload = {
     if (message.approvalStep !== undefined) {
       ApprovalStep.encode(message.approvalStep, writer.uint32(10).fork()).ldelim();
     }
+    if (message.type !== 0) {
+      writer.uint32(16).int32(message.type);
+    }
     return writer;
   },
 
@@ -653,6 +700,13 @@ export const ActivityIssueApprovalNotifyPayload = {
 
           message.approvalStep = ApprovalStep.decode(reader, reader.uint32());
           continue;
+        case 2:
+          if (tag !== 16) {
+            break;
+          }
+
+          message.type = reader.int32() as any;
+          continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
         break;
@@ -663,13 +717,17 @@ export const ActivityIssueApprovalNotifyPayload = {
   },
 
   fromJSON(object: any): ActivityIssueApprovalNotifyPayload {
-    return { approvalStep: isSet(object.approvalStep) ? ApprovalStep.fromJSON(object.approvalStep) : undefined };
+    return {
+      approvalStep: isSet(object.approvalStep) ? ApprovalStep.fromJSON(object.approvalStep) : undefined,
+      type: isSet(object.type) ? activityIssueApprovalNotifyPayload_TypeFromJSON(object.type) : 0,
+    };
   },
 
   toJSON(message: ActivityIssueApprovalNotifyPayload): unknown {
     const obj: any = {};
     message.approvalStep !== undefined &&
       (obj.approvalStep = message.approvalStep ? ApprovalStep.toJSON(message.approvalStep) : undefined);
+    message.type !== undefined && (obj.type = activityIssueApprovalNotifyPayload_TypeToJSON(message.type));
     return obj;
   },
 
@@ -682,6 +740,7 @@ export const ActivityIssueApprovalNotifyPayload = {
     message.approvalStep = (object.approvalStep !== undefined && object.approvalStep !== null)
       ? ApprovalStep.fromPartial(object.approvalStep)
       : undefined;
+    message.type = object.type ?? 0;
     return message;
   },
 };
@@ -1,5 +1,7 @@
 /* eslint-disable */
 import * as _m0 from "protobufjs/minimal";
+import { Duration } from "../google/protobuf/duration";
+import { Timestamp } from "../google/protobuf/timestamp";
 
 export const protobufPackage = "bytebase.store";
 
@@ -16,6 +18,7 @@ export interface IssuePayloadApproval {
    */
   approvalFindingDone: boolean;
   approvalFindingError: string;
+  slaState?: IssuePayloadApproval_SLAState;
 }
 
 export interface IssuePayloadApproval_Approver {
@@ -23,6 +26,11 @@ export interface IssuePayloadApproval_Approver {
   status: IssuePayloadApproval_Approver_Status;
   /** The principal id of the approver. */
   principalId: number;
+  /**
+   * The index of the approved node in the step.
+   * The approvers are in order, and each step takes the approvers until it's approved or rejected.
+   */
+  nodeIndex: number;
 }
 
 export enum IssuePayloadApproval_Approver_Status {
@@ -70,11 +78,83 @@ export function issuePayloadApproval_Approver_StatusToJSON(object: IssuePayloadA
   }
 }
 
+/** SLAState tracks the SLA of the pending step. */
+export interface IssuePayloadApproval_SLAState {
+  /** The index of the pending step. */
+  stepIndex: number;
+  /** The time when the step is found pending. */
+  pendingTime?: Date;
+  /** The time when the approvers are reminded last time. */
+  lastReminderTime?: Date;
+  /** Whether the step is escalated to the escalation node. */
+  escalated: boolean;
+}
+
 export interface ApprovalTemplate {
   flow?: ApprovalFlow;
   title: string;
   description: string;
   creatorId: number;
+  sla?: ApprovalSLA;
+}
+
+/**
+ * ApprovalSLA is the service level agreement of every step in the approval flow.
+ * The durations count from the time when the step becomes pending, and zero durations are disabled.
+ */
+export interface ApprovalSLA {
+  /** The approvers of the pending step are reminded after the interval, and then every interval. */
+  reminderInterval?: Duration;
+  /**
+   * The pending step is escalated to the escalation node after the timeout.
+   * The users of the escalation node can approve or reject the step on behalf of the step nodes.
+   */
+  escalationTimeout?: Duration;
+  escalationNode?: ApprovalNode;
+  /** The pending step expires after the timeout. */
+  expirationTimeout?: Duration;
+  expirationAction: ApprovalSLA_ExpirationAction;
+}
+
+export enum ApprovalSLA_ExpirationAction {
+  EXPIRATION_ACTION_UNSPECIFIED = 0,
+  /** REJECT - The system bot rejects the step. */
+  REJECT = 1,
+  /** CANCEL - The issue is canceled. */
+  CANCEL = 2,
+  UNRECOGNIZED = -1,
+}
+
+export function approvalSLA_ExpirationActionFromJSON(object: any): ApprovalSLA_ExpirationAction {
+  switch (object) {
+    case 0:
+    case "EXPIRATION_ACTION_UNSPECIFIED":
+      return ApprovalSLA_ExpirationAction.EXPIRATION_ACTION_UNSPECIFIED;
+    case 1:
+    case "REJECT":
+      return ApprovalSLA_ExpirationAction.REJECT;
+    case 2:
+    case "CANCEL":
+      return ApprovalSLA_ExpirationAction.CANCEL;
+    case -1:
+    case "UNRECOGNIZED":
+    default:
+      return ApprovalSLA_ExpirationAction.UNRECOGNIZED;
+  }
+}
+
+export function approvalSLA_ExpirationActionToJSON(object: ApprovalSLA_ExpirationAction): string {
+  switch (object) {
+    case ApprovalSLA_ExpirationAction.EXPIRATION_ACTION_UNSPECIFIED:
+      return "EXPIRATION_ACTION_UNSPECIFIED";
+    case ApprovalSLA_ExpirationAction.REJECT:
+      return "REJECT";
+    case ApprovalSLA_ExpirationAction.CANCEL:
+      return "CANCEL";
+    case ApprovalSLA_ExpirationAction.UNRECOGNIZED:
+    default:
+      return "UNRECOGNIZED";
+  }
 }
 
 export interface ApprovalFlow {
@@ -84,11 +164,18 @@ export interface ApprovalFlow {
 export interface ApprovalStep {
   type: ApprovalStep_Type;
   nodes: ApprovalNode[];
+  /**
+   * The number of approvals from different users required by the ANY step.
+   * Zero means one approval is required.
+   */
+  quorum: number;
+  /** The issue creator cannot approve the step. */
+  forbidSelfApproval: boolean;
 }
 
 /**
  * Type of the ApprovalStep
- * ALL means every node must be approved to proceed.
+ * ALL means every node must be approved by a different user to proceed.
  * ANY means approving any node will proceed.
  */
 export enum ApprovalStep_Type {
@@ -137,13 +224,18 @@ export interface ApprovalNode {
     | undefined;
   /** Format: roles/{role} */
   role?: string | undefined;
-  externalNodeId?: string | undefined;
+  externalNodeId?:
+    | string
+    | undefined;
+  /** Format: users/{email} */
+  user?: string | undefined;
 }
 
 /**
  * Type of the ApprovalNode.
  * type determines who should approve this node.
- * ANY_IN_GROUP means the ApprovalNode can be approved by an user from our predefined user group.
+ * ANY_IN_GROUP means the ApprovalNode can be approved by an user from our predefined user group,
+ * an user with the project role, or the named user.
  * See GroupValue below for the predefined user groups.
  */
 export enum ApprovalNode_Type {
@@ -238,7 +330,13 @@ export function approvalNode_GroupValueToJSON(object: ApprovalNode_GroupValue):
 }
 
 function createBaseIssuePayloadApproval(): IssuePayloadApproval {
-  return { approvalTemplates: [], approvers: [], approvalFindingDone: false, approvalFindingError: "" };
+  return {
+    approvalTemplates: [],
+    approvers: [],
+    approvalFindingDone: false,
+    approvalFindingError: "",
+    slaState: undefined,
+  };
 }
 
 export const IssuePayloadApproval = {
@@ -255,6 +353,9 @@ export const IssuePayloadApproval = {
     if (message.approvalFindingError !== "") {
       writer.uint32(34).string(message.approvalFindingError);
     }
+    if (message.slaState !== undefined) {
+      IssuePayloadApproval_SLAState.encode(message.slaState, writer.uint32(42).fork()).ldelim();
+    }
     return writer;
   },
 
@@ -293,6 +394,13 @@ export const IssuePayloadApproval = {
 
           message.approvalFindingError = reader.string();
           continue;
+        case 5:
+          if (tag !== 42) {
+            break;
+          }
+
+          message.slaState = IssuePayloadApproval_SLAState.decode(reader, reader.uint32());
+          continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
         break;
@@ -312,6 +420,7 @@ export const IssuePayloadApproval = {
         : [],
       approvalFindingDone: isSet(object.approvalFindingDone) ? Boolean(object.approvalFindingDone) : false,
       approvalFindingError: isSet(object.approvalFindingError) ? String(object.approvalFindingError) : "",
+      slaState: isSet(object.slaState) ? IssuePayloadApproval_SLAState.fromJSON(object.slaState) : undefined,
     };
   },
 
@@ -329,6 +438,8 @@ export const IssuePayloadApproval = {
     }
     message.approvalFindingDone !== undefined && (obj.approvalFindingDone = message.approvalFindingDone);
     message.approvalFindingError !== undefined && (obj.approvalFindingError = message.approvalFindingError);
+    message.slaState !== undefined &&
+      (obj.slaState = message.slaState ? IssuePayloadApproval_SLAState.toJSON(message.slaState) : undefined);
     return obj;
   },
 
@@ -342,12 +453,15 @@ export const IssuePayloadApproval = {
     message.approvers = object.approvers?.map((e) => IssuePayloadApproval_Approver.fromPartial(e)) || [];
     message.approvalFindingDone = object.approvalFindingDone ?? false;
     message.approvalFindingError = object.approvalFindingError ?? "";
+    message.slaState = (object.slaState !== undefined && object.slaState !== null)
+      ? IssuePayloadApproval_SLAState.fromPartial(object.slaState)
+      : undefined;
     return message;
   },
 };
 
 function createBaseIssuePayloadApproval_Approver(): IssuePayloadApproval_Approver {
-  return { status: 0, principalId: 0 };
+  return { status: 0, principalId: 0, nodeIndex: 0 };
 }
 
 export const IssuePayloadApproval_Approver = {
@@ -358,6 +472,9 @@ export const IssuePayloadApproval_Approver = {
     if (message.principalId !== 0) {
       writer.uint32(16).int32(message.principalId);
     }
+    if (message.nodeIndex !== 0) {
+      writer.uint32(24).int32(message.nodeIndex);
+    }
     return writer;
   },
 
@@ -382,6 +499,13 @@ export const IssuePayloadApproval_Approver = {
 
           message.principalId = reader.int32();
           continue;
+        case 3:
+          if (tag !== 24) {
+            break;
+          }
+
+          message.nodeIndex = reader.int32();
+          continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
         break;
@@ -395,6 +519,7 @@ export const IssuePayloadApproval_Approver = {
     return {
       status: isSet(object.status) ? issuePayloadApproval_Approver_StatusFromJSON(object.status) : 0,
       principalId: isSet(object.principalId) ? Number(object.principalId) : 0,
+      nodeIndex: isSet(object.nodeIndex) ? Number(object.nodeIndex) : 0,
     };
   },
 
@@ -402,6 +527,7 @@ export const IssuePayloadApproval_Approver = {
     const obj: any = {};
     message.status !== undefined && (obj.status = issuePayloadApproval_Approver_StatusToJSON(message.status));
     message.principalId !== undefined && (obj.principalId = Math.round(message.principalId));
+    message.nodeIndex !== undefined && (obj.nodeIndex = Math.round(message.nodeIndex));
     return obj;
   },
 
@@ -413,12 +539,110 @@ export const IssuePayloadApproval_Approver = {
     const message = createBaseIssuePayloadApproval_Approver();
     message.status = object.status ?? 0;
     message.principalId = object.principalId ?? 0;
+    message.nodeIndex = object.nodeIndex ?? 0;
+    return message;
+  },
+};
+
+function createBaseIssuePayloadApproval_SLAState(): IssuePayloadApproval_SLAState {
+  return { stepIndex: 0, pendingTime: undefined, lastReminderTime: undefined, escalated: false };
+}
+
+export const IssuePayloadApproval_SLAState = {
+  encode(message: IssuePayloadApproval_SLAState, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.stepIndex !== 0) {
+      writer.uint32(8).int32(message.stepIndex);
+    }
+    if (message.pendingTime !== undefined) {
+      Timestamp.encode(toTimestamp(message.pendingTime), writer.uint32(18).fork()).ldelim();
+    }
+    if (message.lastReminderTime !== undefined) {
+      Timestamp.encode(toTimestamp(message.lastReminderTime), writer.uint32(26).fork()).ldelim();
+    }
+    if (message.escalated === true) {
+      writer.uint32(32).bool(message.escalated);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): IssuePayloadApproval_SLAState {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseIssuePayloadApproval_SLAState();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 8) {
+            break;
+          }
+
+          message.stepIndex = reader.int32();
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.pendingTime = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
+          continue;
+        case 3:
+          if (tag !== 26) {
+            break;
+          }
+
+          message.lastReminderTime = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
+          continue;
+        case 4:
+          if (tag !== 32) {
+            break;
+          }
+
+          message.escalated = reader.bool();
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): IssuePayloadApproval_SLAState {
+    return {
+      stepIndex: isSet(object.stepIndex) ? Number(object.stepIndex) : 0,
+      pendingTime: isSet(object.pendingTime) ? fromJsonTimestamp(object.pendingTime) : undefined,
+      lastReminderTime: isSet(object.lastReminderTime) ? fromJsonTimestamp(object.lastReminderTime) : undefined,
+      escalated: isSet(object.escalated) ? Boolean(object.escalated) : false,
+    };
+  },
+
+  toJSON(message: IssuePayloadApproval_SLAState): unknown {
+    const obj: any = {};
+    message.stepIndex !== undefined && (obj.stepIndex = Math.round(message.stepIndex));
+    message.pendingTime !== undefined && (obj.pendingTime = message.pendingTime.toISOString());
+    message.lastReminderTime !== undefined && (obj.lastReminderTime = message.lastReminderTime.toISOString());
+    message.escalated !== undefined && (obj.escalated = message.escalated);
+    return obj;
+  },
+
+  create(base?: DeepPartial<IssuePayloadApproval_SLAState>): IssuePayloadApproval_SLAState {
+    return IssuePayloadApproval_SLAState.fromPartial(base ?? {});
+  },
+
+  fromPartial(object: DeepPartial<IssuePayloadApproval_SLAState>): IssuePayloadApproval_SLAState {
+    const message = createBaseIssuePayloadApproval_SLAState();
+    message.stepIndex = object.stepIndex ?? 0;
+    message.pendingTime = object.pendingTime ?? undefined;
+    message.lastReminderTime = object.lastReminderTime ?? undefined;
+    message.escalated = object.escalated ?? false;
     return message;
   },
 };
 
 function createBaseApprovalTemplate(): ApprovalTemplate {
-  return { flow: undefined, title: "", description: "", creatorId: 0 };
+  return { flow: undefined, title: "", description: "", creatorId: 0, sla: undefined };
 }
 
 export const ApprovalTemplate = {
@@ -435,6 +659,9 @@ export const ApprovalTemplate = {
     if (message.creatorId !== 0) {
       writer.uint32(32).int32(message.creatorId);
     }
+    if (message.sla !== undefined) {
+      ApprovalSLA.encode(message.sla, writer.uint32(42).fork()).ldelim();
+    }
     return writer;
   },
 
@@ -473,6 +700,13 @@ export const ApprovalTemplate = {
 
           message.creatorId = reader.int32();
           continue;
+        case 5:
+          if (tag !== 42) {
+            break;
+          }
+
+          message.sla = ApprovalSLA.decode(reader, reader.uint32());
+          continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
         break;
@@ -488,6 +722,7 @@ export const ApprovalTemplate = {
       title: isSet(object.title) ? String(object.title) : "",
       description: isSet(object.description) ? String(object.description) : "",
       creatorId: isSet(object.creatorId) ? Number(object.creatorId) : 0,
+      sla: isSet(object.sla) ? ApprovalSLA.fromJSON(object.sla) : undefined,
     };
   },
 
@@ -497,6 +732,7 @@ export const ApprovalTemplate = {
     message.title !== undefined && (obj.title = message.title);
     message.description !== undefined && (obj.description = message.description);
     message.creatorId !== undefined && (obj.creatorId = Math.round(message.creatorId));
+    message.sla !== undefined && (obj.sla = message.sla ? ApprovalSLA.toJSON(message.sla) : undefined);
     return obj;
   },
 
@@ -512,6 +748,138 @@ export const ApprovalTemplate = {
     message.title = object.title ?? "";
     message.description = object.description ?? "";
     message.creatorId = object.creatorId ?? 0;
+    message.sla = (object.sla !== undefined && object.sla !== null) ? ApprovalSLA.fromPartial(object.sla) : undefined;
+    return message;
+  },
+};
+
+function createBaseApprovalSLA(): ApprovalSLA {
+  return {
+    reminderInterval: undefined,
+    escalationTimeout: undefined,
+    escalationNode: undefined,
+    expirationTimeout: undefined,
+    expirationAction: 0,
+  };
+}
+
+export const ApprovalSLA = {
+  encode(message: ApprovalSLA, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.reminderInterval !== undefined) {
+      Duration.encode(message.reminderInterval, writer.uint32(10).fork()).ldelim();
+    }
+    if (message.escalationTimeout !== undefined) {
+      Duration.encode(message.escalationTimeout, writer.uint32(18).fork()).ldelim();
+    }
+    if (message.escalationNode !== undefined) {
+      ApprovalNode.encode(message.escalationNode, writer.uint32(26).fork()).ldelim();
+    }
+    if (message.expirationTimeout !== undefined) {
+      Duration.encode(message.expirationTimeout, writer.uint32(34).fork()).ldelim();
+    }
+    if (message.expirationAction !== 0) {
+      writer.uint32(40).int32(message.expirationAction);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): ApprovalSLA {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseApprovalSLA();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.reminderInterval = Duration.decode(reader, reader.uint32());
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.escalationTimeout = Duration.decode(reader, reader.uint32());
+          continue;
+        case 3:
+          if (tag !== 26) {
+            break;
+          }
+
+          message.escalationNode = ApprovalNode.decode(reader, reader.uint32());
+          continue;
+        case 4:
+          if (tag !== 34) {
+            break;
+          }
+
+          message.expirationTimeout = Duration.decode(reader, reader.uint32());
+          continue;
+        case 5:
+          if (tag !== 40) {
+            break;
+          }
+
+          message.expirationAction = reader.int32() as any;
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): ApprovalSLA {
+    return {
+      reminderInterval: isSet(object.reminderInterval) ? Duration.fromJSON(object.reminderInterval) : undefined,
+      escalationTimeout: isSet(object.escalationTimeout) ? Duration.fromJSON(object.escalationTimeout) : undefined,
+      escalationNode: isSet(object.escalationNode) ? ApprovalNode.fromJSON(object.escalationNode) : undefined,
+      expirationTimeout: isSet(object.expirationTimeout) ? Duration.fromJSON(object.expirationTimeout) : undefined,
+      expirationAction: isSet(object.expirationAction)
+        ? approvalSLA_ExpirationActionFromJSON(object.expirationAction)
+        : 0,
+    };
+  },
+
+  toJSON(message: ApprovalSLA): unknown {
+    const obj: any = {};
+    message.reminderInterval !== undefined &&
+      (obj.reminderInterval = message.reminderInterval ? Duration.toJSON(message.reminderInterval) : undefined);
+    message.escalationTimeout !== undefined &&
+      (obj.escalationTimeout = message.escalationTimeout ? Duration.toJSON(message.escalationTimeout) : undefined);
+    message.escalationNode !== undefined &&
+      (obj.escalationNode = message.escalationNode ? ApprovalNode.toJSON(message.escalationNode) : undefined);
+    message.expirationTimeout !== undefined &&
+      (obj.expirationTimeout = message.expirationTimeout ? Duration.toJSON(message.expirationTimeout) : undefined);
+    message.expirationAction !== undefined &&
+      (obj.expirationAction = approvalSLA_ExpirationActionToJSON(message.expirationAction));
+    return obj;
+  },
+
+  create(base?: DeepPartial<ApprovalSLA>): ApprovalSLA {
+    return ApprovalSLA.fromPartial(base ?? {});
+  },
+
+  fromPartial(object: DeepPartial<ApprovalSLA>): ApprovalSLA {
+    const message = createBaseApprovalSLA();
+    message.reminderInterval = (object.reminderInterval !== undefined && object.reminderInterval !== null)
+      ? Duration.fromPartial(object.reminderInterval)
+      : undefined;
+    message.escalationTimeout = (object.escalationTimeout !== undefined && object.escalationTimeout !== null)
+      ? Duration.fromPartial(object.escalationTimeout)
+      : undefined;
+    message.escalationNode = (object.escalationNode !== undefined && object.escalationNode !== null)
+      ? ApprovalNode.fromPartial(object.escalationNode)
+      : undefined;
+    message.expirationTimeout = (object.expirationTimeout !== undefined && object.expirationTimeout !== null)
+      ? Duration.fromPartial(object.expirationTimeout)
+      : undefined;
+    message.expirationAction = object.expirationAction ?? 0;
     return message;
   },
 };
@@ -577,7 +945,7 @@ export const ApprovalFlow = {
 };
 
 function createBaseApprovalStep(): ApprovalStep {
-  return { type: 0, nodes: [] };
+  return { type: 0, nodes: [], quorum: 0, forbidSelfApproval: false };
 }
 
 export const ApprovalStep = {
@@ -588,6 +956,12 @@ export const ApprovalStep = {
     for (const v of message.nodes) {
       ApprovalNode.encode(v!, writer.uint32(18).fork()).ldelim();
     }
+    if (message.quorum !== 0) {
+      writer.uint32(24).int32(message.quorum);
+    }
+    if (message.forbidSelfApproval === true) {
+      writer.uint32(32).bool(message.forbidSelfApproval);
+    }
     return writer;
   },
 
@@ -612,6 +986,20 @@ export const ApprovalStep = {
 
           message.nodes.push(ApprovalNode.decode(reader, reader.uint32()));
           continue;
+        case 3:
+          if (tag !== 24) {
+            break;
+          }
+
+          message.quorum = reader.int32();
+          continue;
+        case 4:
+          if (tag !== 32) {
+            break;
+          }
+
+          message.forbidSelfApproval = reader.bool();
+          continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
         break;
@@ -625,6 +1013,8 @@ export const ApprovalStep = {
     return {
       type: isSet(object.type) ? approvalStep_TypeFromJSON(object.type) : 0,
       nodes: Array.isArray(object?.nodes) ? object.nodes.map((e: any) => ApprovalNode.fromJSON(e)) : [],
+      quorum: isSet(object.quorum) ? Number(object.quorum) : 0,
+      forbidSelfApproval: isSet(object.forbidSelfApproval) ? Boolean(object.forbidSelfApproval) : false,
     };
   },
 
@@ -636,6 +1026,8 @@ export const ApprovalStep = {
     } else {
       obj.nodes = [];
     }
+    message.quorum !== undefined && (obj.quorum = Math.round(message.quorum));
+    message.forbidSelfApproval !== undefined && (obj.forbidSelfApproval = message.forbidSelfApproval);
     return obj;
   },
 
@@ -647,12 +1039,14 @@ export const ApprovalStep = {
     const message = createBaseApprovalStep();
     message.type = object.type ?? 0;
     message.nodes = object.nodes?.map((e) => ApprovalNode.fromPartial(e)) || [];
+    message.quorum = object.quorum ?? 0;
+    message.forbidSelfApproval = object.forbidSelfApproval ?? false;
     return message;
   },
 };
 
 function createBaseApprovalNode(): ApprovalNode {
-  return { type: 0, groupValue: undefined, role: undefined, externalNodeId: undefined };
+  return { type: 0, groupValue: undefined, role: undefined, externalNodeId: undefined, user: undefined };
 }
 
 export const ApprovalNode = {
@@ -669,6 +1063,9 @@ export const ApprovalNode = {
     if (message.externalNodeId !== undefined) {
       writer.uint32(34).string(message.externalNodeId);
     }
+    if (message.user !== undefined) {
+      writer.uint32(42).string(message.user);
+    }
     return writer;
   },
 
@@ -707,6 +1104,13 @@ export const ApprovalNode = {
 
           message.externalNodeId = reader.string();
           continue;
+        case 5:
+          if (tag !== 42) {
+            break;
+          }
+
+          message.user = reader.string();
+          continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
         break;
@@ -722,6 +1126,7 @@ export const ApprovalNode = {
       groupValue: isSet(object.groupValue) ? approvalNode_GroupValueFromJSON(object.groupValue) : undefined,
       role: isSet(object.role) ? String(object.role) : undefined,
       externalNodeId: isSet(object.externalNodeId) ? String(object.externalNodeId) : undefined,
+      user: isSet(object.user) ? String(object.user) : undefined,
     };
   },
 
@@ -734,6 +1139,7 @@ export const ApprovalNode = {
         : undefined);
     message.role !== undefined && (obj.role = message.role);
     message.externalNodeId !== undefined && (obj.externalNodeId = message.externalNodeId);
+    message.user !== undefined && (obj.user = message.user);
     return obj;
   },
 
@@ -747,6 +1153,7 @@ export const ApprovalNode = {
     message.groupValue = object.groupValue ?? undefined;
     message.role = object.role ?? undefined;
     message.externalNodeId = object.externalNodeId ?? undefined;
+    message.user = object.user ?? undefined;
     return message;
   },
 };
@@ -758,6 +1165,28 @@ export type DeepPartial<T> = T extends Builtin ? T
   : T extends {} ? { [K in keyof T]?: DeepPartial<T[K]> }
   : Partial<T>;
 
+function toTimestamp(date: Date): Timestamp {
+  const seconds = date.getTime() / 1_000;
+  const nanos = (date.getTime() % 1_000) * 1_000_000;
+  return { seconds, nanos };
+}
+
+function fromTimestamp(t: Timestamp): Date {
+  let millis = (t.seconds || 0) * 1_000;
+  millis += (t.nanos || 0) / 1_000_000;
+  return new Date(millis);
+}
+
+function fromJsonTimestamp(o: any): Date {
+  if (o instanceof Date) {
+    return o;
+  } else if (typeof o === "string") {
+    return new Date(o);
+  } else {
+    return fromTimestamp(Timestamp.fromJSON(o));
+  }
+}
+
 function isSet(value: any): boolean {
   return value !== null && value !== undefined;
 }
@@ -9,6 +9,35 @@ export interface IssuePayload {
   approval?: IssuePayloadApproval;
   grantRequest?: GrantRequest;
   grouping?: Grouping;
+  /**
+   * The pull request that the issue is created from in the pull request flow.
+   * The issue is a draft until the pull request is merged.
+   */
+  pullRequest?: IssuePayloadPullRequest;
+  /** The VCS commit that the issue is created from. Bytebase reports the issue progress as the commit status. */
+  vcsCommit?: IssuePayloadVCSCommit;
+  /** The labels of the issue, which are used to find the approval template. */
+  labels: string[];
+}
+
+export interface IssuePayloadVCSCommit {
+  /** The UID of the Bytebase repository. */
+  repositoryId: number;
+  /** The commit ID in the VCS. */
+  commitId: string;
+}
+
+export interface IssuePayloadPullRequest {
+  /** The UID of the Bytebase repository. */
+  repositoryId: number;
+  /** The pull request ID in the VCS, e.g. the pull request number for GitHub and the merge request IID for GitLab. */
+  pullRequestId: string;
+  /** The web URL of the pull request. */
+  url: string;
+  /** The head commit of the pull request from which the issue is created. */
+  headCommitId: string;
+  /** Whether the pull request has been merged. The issue can be rolled out after the pull request is merged. */
+  merged: boolean;
 }
 
 export interface Grouping {
@@ -25,7 +54,14 @@ export interface GrantRequest {
 }
 
 function createBaseIssuePayload(): IssuePayload {
-  return { approval: undefined, grantRequest: undefined, grouping: undefined };
+  return {
+    approval: undefined,
+    grantRequest: undefined,
+    grouping: undefined,
+    pullRequest: undefined,
+    vcsCommit: undefined,
+    labels: [],
+  };
 }
 
 export const IssuePayload = {
@@ -39,6 +75,15 @@ export const IssuePayload = {
     if (message.grouping !== undefined) {
       Grouping.encode(message.grouping, writer.uint32(26).fork()).ldelim();
     }
+    if (message.pullRequest !== undefined) {
+      IssuePayloadPullRequest.encode(message.pullRequest, writer.uint32(34).fork()).ldelim();
+    }
+    if (message.vcsCommit !== undefined) {
+      IssuePayloadVCSCommit.encode(message.vcsCommit, writer.uint32(42).fork()).ldelim();
+    }
+    for (const v of message.labels) {
+      writer.uint32(50).string(v!);
+    }
     return writer;
   },
 
@@ -70,6 +115,27 @@ export const IssuePayload = {
 
           message.grouping = Grouping.decode(reader, reader.uint32());
           continue;
+        case 4:
+          if (tag !== 34) {
+            break;
+          }
+
+          message.pullRequest = IssuePayloadPullRequest.decode(reader, reader.uint32());
+          continue;
+        case 5:
+          if (tag !== 42) {
+            break;
+          }
+
+          message.vcsCommit = IssuePayloadVCSCommit.decode(reader, reader.uint32());
+          continue;
+        case 6:
+          if (tag !== 50) {
+            break;
+          }
+
+          message.labels.push(reader.string());
+          continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
         break;
@@ -84,6 +150,9 @@ export const IssuePayload = {
       approval: isSet(object.approval) ? IssuePayloadApproval.fromJSON(object.approval) : undefined,
       grantRequest: isSet(object.grantRequest) ? GrantRequest.fromJSON(object.grantRequest) : undefined,
       grouping: isSet(object.grouping) ? Grouping.fromJSON(object.grouping) : undefined,
+      pullRequest: isSet(object.pullRequest) ? IssuePayloadPullRequest.fromJSON(object.pullRequest) : undefined,
+      vcsCommit: isSet(object.vcsCommit) ? IssuePayloadVCSCommit.fromJSON(object.vcsCommit) : undefined,
+      labels: Array.isArray(object?.labels) ? object.labels.map((e: any) => String(e)) : [],
     };
   },
 
@@ -94,6 +163,15 @@ export const IssuePayload = {
     message.grantRequest !== undefined &&
       (obj.grantRequest = message.grantRequest ? GrantRequest.toJSON(message.grantRequest) : undefined);
     message.grouping !== undefined && (obj.grouping = message.grouping ? Grouping.toJSON(message.grouping) : undefined);
+    message.pullRequest !== undefined &&
+      (obj.pullRequest = message.pullRequest ? IssuePayloadPullRequest.toJSON(message.pullRequest) : undefined);
+    message.vcsCommit !== undefined &&
+      (obj.vcsCommit = message.vcsCommit ? IssuePayloadVCSCommit.toJSON(message.vcsCommit) : undefined);
+    if (message.labels) {
+      obj.labels = message.labels.map((e) => e);
+    } else {
+      obj.labels = [];
+    }
     return obj;
   },
 
@@ -112,6 +190,194 @@ export const IssuePayload = {
     message.grouping = (object.grouping !== undefined && object.grouping !== null)
       ? Grouping.fromPartial(object.grouping)
       : undefined;
+    message.pullRequest = (object.pullRequest !== undefined && object.pullRequest !== null)
+      ? IssuePayloadPullRequest.fromPartial(object.pullRequest)
+      : undefined;
+    message.vcsCommit = (object.vcsCommit !== undefined && object.vcsCommit !== null)
+      ? IssuePayloadVCSCommit.fromPartial(object.vcsCommit)
+      : undefined;
+    message.labels = object.labels?.map((e) => e) || [];
+    return message;
+  },
+};
+
+function createBaseIssuePayloadVCSCommit(): IssuePayloadVCSCommit {
+  return { repositoryId: 0, commitId: "" };
+}
+
+export const IssuePayloadVCSCommit = {
+  encode(message: IssuePayloadVCSCommit, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.repositoryId !== 0) {
+      writer.uint32(8).int32(message.repositoryId);
+    }
+    if (message.commitId !== "") {
+      writer.uint32(18).string(message.commitId);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): IssuePayloadVCSCommit {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseIssuePayloadVCSCommit();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 8) {
+            break;
+          }
+
+          message.repositoryId = reader.int32();
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.commitId = reader.string();
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): IssuePayloadVCSCommit {
+    return {
+      repositoryId: isSet(object.repositoryId) ? Number(object.repositoryId) : 0,
+      commitId: isSet(object.commitId) ? String(object.commitId) : "",
+    };
+  },
+
+  toJSON(message: IssuePayloadVCSCommit): unknown {
+    const obj: any = {};
+    message.repositoryId !== undefined && (obj.repositoryId = Math.round(message.repositoryId));
+    message.commitId !== undefined && (obj.commitId = message.commitId);
+    return obj;
+  },
+
+  create(base?: DeepPartial<IssuePayloadVCSCommit>): IssuePayloadVCSCommit {
+    return IssuePayloadVCSCommit.fromPartial(base ?? {});
+  },
+
+  fromPartial(object: DeepPartial<IssuePayloadVCSCommit>): IssuePayloadVCSCommit {
+    const message = createBaseIssuePayloadVCSCommit();
+    message.repositoryId = object.repositoryId ?? 0;
+    message.commitId = object.commitId ?? "";
+    return message;
+  },
+};
+
+function createBaseIssuePayloadPullRequest(): IssuePayloadPullRequest {
+  return { repositoryId: 0, pullRequestId: "", url: "", headCommitId: "", merged: false };
+}
+
+export const IssuePayloadPullRequest = {
+  encode(message: IssuePayloadPullRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.repositoryId !== 0) {
+      writer.uint32(8).int32(message.repositoryId);
+    }
+    if (message.pullRequestId !== "") {
+      writer.uint32(18).string(message.pullRequestId);
+    }
+    if (message.url !== "") {
+      writer.uint32(26).string(message.url);
+    }
+    if (message.headCommitId !== "") {
+      writer.uint32(34).string(message.headCommitId);
+    }
+    if (message.merged === true) {
+      writer.uint32(40).bool(message.merged);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): IssuePayloadPullRequest {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseIssuePayloadPullRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 8) {
+            break;
+          }
+
+          message.repositoryId = reader.int32();
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.pullRequestId = reader.string();
+          continue;
+        case 3:
+          if (tag !== 26) {
+            break;
+          }
+
+          message.url = reader.string();
+          continue;
+        case 4:
+          if (tag !== 34) {
+            break;
+          }
+
+          message.headCommitId = reader.string();
+          continue;
+        case 5:
+          if (tag !== 40) {
+            break;
+          }
+
+          message.merged = reader.bool();
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): IssuePayloadPullRequest {
+    return {
+      repositoryId: isSet(object.repositoryId) ? Number(object.repositoryId) : 0,
+      pullRequestId: isSet(object.pullRequestId) ? String(object.pullRequestId) : "",
+      url: isSet(object.url) ? String(object.url) : "",
+      headCommitId: isSet(object.headCommitId) ? String(object.headCommitId) : "",
+      merged: isSet(object.merged) ? Boolean(object.merged) : false,
+    };
+  },
+
+  toJSON(message: IssuePayloadPullRequest): unknown {
+    const obj: any = {};
+    message.repositoryId !== undefined && (obj.repositoryId = Math.round(message.repositoryId));
+    message.pullRequestId !== undefined && (obj.pullRequestId = message.pullRequestId);
+    message.url !== undefined && (obj.url = message.url);
+    message.headCommitId !== undefined && (obj.headCommitId = message.headCommitId);
+    message.merged !== undefined && (obj.merged = message.merged);
+    return obj;
+  },
+
+  create(base?: DeepPartial<IssuePayloadPullRequest>): IssuePayloadPullRequest {
+    return IssuePayloadPullRequest.fromPartial(base ?? {});
+  },
+
+  fromPartial(object: DeepPartial<IssuePayloadPullRequest>): IssuePayloadPullRequest {
+    const message = createBaseIssuePayloadPullRequest();
+    message.repositoryId = object.repositoryId ?? 0;
+    message.pullRequestId = object.pullRequestId ?? "";
+    message.url = object.url ?? "";
+    message.headCommitId = object.headCommitId ?? "";
+    message.merged = object.merged ?? false;
     return message;
   },
 };
@@ -1,4 +1,5 @@
 /* eslint-disable */
+import * as Long from "long";
 import * as _m0 from "protobufjs/minimal";
 import { ParsedExpr } from "../google/api/expr/v1alpha1/syntax";
 import { Expr } from "../google/type/expr";
@@ -174,6 +175,119 @@ export function sMTPMailDeliverySetting_AuthenticationToJSON(object: SMTPMailDel
   }
 }
 
+export interface SCIMSetting {
+  /**
+   * The bearer token of the SCIM client, e.g. the identity provider provisioning the users.
+   * The SCIM endpoint is disabled if it's empty.
+   */
+  token: string;
+  /** The group members are granted the project roles of the mappings. */
+  groupMappings: SCIMSetting_GroupMapping[];
+}
+
+export interface SCIMSetting_GroupMapping {
+  /** The display name of the SCIM group. */
+  group: string;
+  /** The resource ID of the project. */
+  project: string;
+  /** The project role granted to the group members, e.g. DEVELOPER. */
+  role: string;
+}
+
+export interface AuditLogExportSetting {
+  /** The hash chained audit logs are exported to all of the destinations. */
+  destinations: AuditLogExportSetting_Destination[];
+}
+
+export interface AuditLogExportSetting_Destination {
+  /** The unique name of the destination. The export progress is tracked by the name. */
+  name: string;
+  type: AuditLogExportSetting_Destination_Type;
+  syslog?: AuditLogExportSetting_Destination_SyslogConfig;
+  http?: AuditLogExportSetting_Destination_HTTPConfig;
+  file?: AuditLogExportSetting_Destination_FileConfig;
+}
+
+export enum AuditLogExportSetting_Destination_Type {
+  TYPE_UNSPECIFIED = 0,
+  /** SYSLOG - RFC 5424 syslog messages. */
+  SYSLOG = 1,
+  /** HTTP - HTTP POST requests with the JSON array of the audit logs. */
+  HTTP = 2,
+  /** FILE - JSON lines files with size based rotation. */
+  FILE = 3,
+  UNRECOGNIZED = -1,
+}
+
+export function auditLogExportSetting_Destination_TypeFromJSON(object: any): AuditLogExportSetting_Destination_Type {
+  switch (object) {
+    case 0:
+    case "TYPE_UNSPECIFIED":
+      return AuditLogExportSetting_Destination_Type.TYPE_UNSPECIFIED;
+    case 1:
+    case "SYSLOG":
+      return AuditLogExportSetting_Destination_Type.SYSLOG;
+    case 2:
+    case "HTTP":
+      return AuditLogExportSetting_Destination_Type.HTTP;
+    case 3:
+    case "FILE":
+      return AuditLogExportSetting_Destination_Type.FILE;
+    case -1:
+    case "UNRECOGNIZED":
+    default:
+      return AuditLogExportSetting_Destination_Type.UNRECOGNIZED;
+  }
+}
+
+export function auditLogExportSetting_Destination_TypeToJSON(object: AuditLogExportSetting_Destination_Type): string {
+  switch (object) {
+    case AuditLogExportSetting_Destination_Type.TYPE_UNSPECIFIED:
+      return "TYPE_UNSPECIFIED";
+    case AuditLogExportSetting_Destination_Type.SYSLOG:
+      return "SYSLOG";
+    case AuditLogExportSetting_Destination_Type.HTTP:
+      return "HTTP";
+    case AuditLogExportSetting_Destination_Type.FILE:
+      return "FILE";
+    case AuditLogExportSetting_Destination_Type.UNRECOGNIZED:
+    default:
+      return "UNRECOGNIZED";
+  }
+}
+
+export interface AuditLogExportSetting_Destination_SyslogConfig {
+  /** The network of the syslog server, one of "udp", "tcp" and "tls". */
+  network: string;
+  /** The address of the syslog server, e.g. syslog.example.com:514. */
+  address: string;
+  /** The syslog facility, 13 (log audit) is used if it's unset. */
+  facility: number;
+  /** The APP-NAME of the syslog messages, "bytebase" is used if it's empty. */
+  appName: string;
+}
+
+export interface AuditLogExportSetting_Destination_HTTPConfig {
+  /** The URL receiving the audit logs. */
+  url: string;
+  /** The headers of the requests, e.g. the Authorization header of the Splunk HTTP Event Collector. */
+  headers: { [key: string]: string };
+}
+
+export interface AuditLogExportSetting_Destination_HTTPConfig_HeadersEntry {
+  key: string;
+  value: string;
+}
+
+export interface AuditLogExportSetting_Destination_FileConfig {
+  /** The path of the audit log file. */
+  path: string;
+  /** The file is rotated once it exceeds the max size in bytes, 100 MiB is used if it's unset. */
+  maxSize: number;
+  /** The number of the rotated files to keep, all of them are kept if it's unset. */
+  maxBackups: number;
+}
+
 function createBaseWorkspaceProfileSetting(): WorkspaceProfileSetting {
   return { externalUrl: "", disallowSignup: false, require2fa: false, outboundIpList: [], gitopsWebhookUrl: "" };
 }
@@ -847,6 +961,732 @@ export const SMTPMailDeliverySetting = {
   },
 };
 
+function createBaseSCIMSetting(): SCIMSetting {
+  return { token: "", groupMappings: [] };
+}
+
+export const SCIMSetting = {
+  encode(message: SCIMSetting, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.token !== "") {
+      writer.uint32(10).string(message.token);
+    }
+    for (const v of message.groupMappings) {
+      SCIMSetting_GroupMapping.encode(v!, writer.uint32(18).fork()).ldelim();
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): SCIMSetting {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseSCIMSetting();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.token = reader.string();
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.groupMappings.push(SCIMSetting_GroupMapping.decode(reader, reader.uint32()));
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): SCIMSetting {
+    return {
+      token: isSet(object.token) ? String(object.token) : "",
+      groupMappings: Array.isArray(object?.groupMappings)
+        ? object.groupMappings.map((e: any) => SCIMSetting_GroupMapping.fromJSON(e))
+        : [],
+    };
+  },
+
+  toJSON(message: SCIMSetting): unknown {
+    const obj: any = {};
+    message.token !== undefined && (obj.token = message.token);
+    if (message.groupMappings) {
+      obj.groupMappings = message.groupMappings.map((e) => e ? SCIMSetting_GroupMapping.toJSON(e) : undefined);
+    } else {
+      obj.groupMappings = [];
+    }
+    return obj;
+  },
+
+  create(base?: DeepPartial<SCIMSetting>): SCIMSetting {
+    return SCIMSetting.fromPartial(base ?? {});
+  },
+
+  fromPartial(object: DeepPartial<SCIMSetting>): SCIMSetting {
+    const message = createBaseSCIMSetting();
+    message.token = object.token ?? "";
+    message.groupMappings = object.groupMappings?.map((e) => SCIMSetting_GroupMapping.fromPartial(e)) || [];
+    return message;
+  },
+};
+
+function createBaseSCIMSetting_GroupMapping(): SCIMSetting_GroupMapping {
+  return { group: "", project: "", role: "" };
+}
+
+export const SCIMSetting_GroupMapping = {
+  encode(message: SCIMSetting_GroupMapping, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.group !== "") {
+      writer.uint32(10).string(message.group);
+    }
+    if (message.project !== "") {
+      writer.uint32(18).string(message.project);
+    }
+    if (message.role !== "") {
+      writer.uint32(26).string(message.role);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): SCIMSetting_GroupMapping {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseSCIMSetting_GroupMapping();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.group = reader.string();
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.project = reader.string();
+          continue;
+        case 3:
+          if (tag !== 26) {
+            break;
+          }
+
+          message.role = reader.string();
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): SCIMSetting_GroupMapping {
+    return {
+      group: isSet(object.group) ? String(object.group) : "",
+      project: isSet(object.project) ? String(object.project) : "",
+      role: isSet(object.role) ? String(object.role) : "",
+    };
+  },
+
+  toJSON(message: SCIMSetting_GroupMapping): unknown {
+    const obj: any = {};
+    message.group !== undefined && (obj.group = message.group);
+    message.project !== undefined && (obj.project = message.project);
+    message.role !== undefined && (obj.role = message.role);
+    return obj;
+  },
+
+  create(base?: DeepPartial<SCIMSetting_GroupMapping>): SCIMSetting_GroupMapping {
+    return SCIMSetting_GroupMapping.fromPartial(base ?? {});
+  },
+
+  fromPartial(object: DeepPartial<SCIMSetting_GroupMapping>): SCIMSetting_GroupMapping {
+    const message = createBaseSCIMSetting_GroupMapping();
+    message.group = object.group ?? "";
+    message.project = object.project ?? "";
+    message.role = object.role ?? "";
+    return message;
+  },
+};
+
+function createBaseAuditLogExportSetting(): AuditLogExportSetting {
+  return { destinations: [] };
+}
+
+export const AuditLogExportSetting = {
+  encode(message: AuditLogExportSetting, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    for (const v of message.destinations) {
+      AuditLogExportSetting_Destination.encode(v!, writer.uint32(10).fork()).ldelim();
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): AuditLogExportSetting {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseAuditLogExportSetting();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.destinations.push(AuditLogExportSetting_Destination.decode(reader, reader.uint32()));
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): AuditLogExportSetting {
+    return {
+      destinations: Array.isArray(object?.destinations)
+        ? object.destinations.map((e: any) => AuditLogExportSetting_Destination.fromJSON(e))
+        : [],
+    };
+  },
+
+  toJSON(message: AuditLogExportSetting): unknown {
+    const obj: any = {};
+    if (message.destinations) {
+      obj.destinations = message.destinations.map((e) => e ? AuditLogExportSetting_Destination.toJSON(e) : undefined);
+    } else {
+      obj.destinations = [];
+    }
+    return obj;
+  },
+
+  create(base?: DeepPartial<AuditLogExportSetting>): AuditLogExportSetting {
+    return AuditLogExportSetting.fromPartial(base ?? {});
+  },
+
+  fromPartial(object: DeepPartial<AuditLogExportSetting>): AuditLogExportSetting {
+    const message = createBaseAuditLogExportSetting();
+    message.destinations = object.destinations?.map((e) => AuditLogExportSetting_Destination.fromPartial(e)) || [];
+    return message;
+  },
+};
+
+function createBaseAuditLogExportSetting_Destination(): AuditLogExportSetting_Destination {
+  return { name: "", type: 0, syslog: undefined, http: undefined, file: undefined };
+}
+
+export const AuditLogExportSetting_Destination = {
+  encode(message: AuditLogExportSetting_Destination, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.name !== "") {
+      writer.uint32(10).string(message.name);
+    }
+    if (message.type !== 0) {
+      writer.uint32(16).int32(message.type);
+    }
+    if (message.syslog !== undefined) {
+      AuditLogExportSetting_Destination_SyslogConfig.encode(message.syslog, writer.uint32(26).fork()).ldelim();
+    }
+    if (message.http !== undefined) {
+      AuditLogExportSetting_Destination_HTTPConfig.encode(message.http, writer.uint32(34).fork()).ldelim();
+    }
+    if (message.file !== undefined) {
+      AuditLogExportSetting_Destination_FileConfig.encode(message.file, writer.uint32(42).fork()).ldelim();
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): AuditLogExportSetting_Destination {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseAuditLogExportSetting_Destination();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.name = reader.string();
+          continue;
+        case 2:
+          if (tag !== 16) {
+            break;
+          }
+
+          message.type = reader.int32() as any;
+          continue;
+        case 3:
+          if (tag !== 26) {
+            break;
+          }
+
+          message.syslog = AuditLogExportSetting_Destination_SyslogConfig.decode(reader, reader.uint32());
+          continue;
+        case 4:
+          if (tag !== 34) {
+            break;
+          }
+
+          message.http = AuditLogExportSetting_Destination_HTTPConfig.decode(reader, reader.uint32());
+          continue;
+        case 5:
+          if (tag !== 42) {
+            break;
+          }
+
+          message.file = AuditLogExportSetting_Destination_FileConfig.decode(reader, reader.uint32());
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): AuditLogExportSetting_Destination {
+    return {
+      name: isSet(object.name) ? String(object.name) : "",
+      type: isSet(object.type) ? auditLogExportSetting_Destination_TypeFromJSON(object.type) : 0,
+      syslog: isSet(object.syslog) ? AuditLogExportSetting_Destination_SyslogConfig.fromJSON(object.syslog) : undefined,
+      http: isSet(object.http) ? AuditLogExportSetting_Destination_HTTPConfig.fromJSON(object.http) : undefined,
+      file: isSet(object.file) ? AuditLogExportSetting_Destination_FileConfig.fromJSON(object.file) : undefined,
+    };
+  },
+
+  toJSON(message: AuditLogExportSetting_Destination): unknown {
+    const obj: any = {};
+    message.name !== undefined && (obj.name = message.name);
+    message.type !== undefined && (obj.type = auditLogExportSetting_Destination_TypeToJSON(message.type));
+    message.syslog !== undefined &&
+      (obj.syslog = message.syslog ? AuditLogExportSetting_Destination_SyslogConfig.toJSON(message.syslog) : undefined);
+    message.http !== undefined &&
+      (obj.http = message.http ? AuditLogExportSetting_Destination_HTTPConfig.toJSON(message.http) : undefined);
+    message.file !== undefined &&
+      (obj.file = message.file ? AuditLogExportSetting_Destination_FileConfig.toJSON(message.file) : undefined);
+    return obj;
+  },
+
+  create(base?: DeepPartial<AuditLogExportSetting_Destination>): AuditLogExportSetting_Destination {
+    return AuditLogExportSetting_Destination.fromPartial(base ?? {});
+  },
+
+  fromPartial(object: DeepPartial<AuditLogExportSetting_Destination>): AuditLogExportSetting_Destination {
+    const message = createBaseAuditLogExportSetting_Destination();
+    message.name = object.name ?? "";
+    message.type = object.type ?? 0;
+    message.syslog = (object.syslog !== undefined && object.syslog !== null)
+      ? AuditLogExportSetting_Destination_SyslogConfig.fromPartial(object.syslog)
+      : undefined;
+    message.http = (object.http !== undefined && object.http !== null)
+      ? AuditLogExportSetting_Destination_HTTPConfig.fromPartial(object.http)
+      : undefined;
+    message.file = (object.file !== undefined && object.file !== null)
+      ? AuditLogExportSetting_Destination_FileConfig.fromPartial(object.file)
+      : undefined;
+    return message;
+  },
+};
+
+function createBaseAuditLogExportSetting_Destination_SyslogConfig(): AuditLogExportSetting_Destination_SyslogConfig {
+  return { network: "", address: "", facility: 0, appName: "" };
+}
+
+export const AuditLogExportSetting_Destination_SyslogConfig = {
+  encode(
+    message: AuditLogExportSetting_Destination_SyslogConfig,
+    writer: _m0.Writer = _m0.Writer.create(),
+  ): _m0.Writer {
+    if (message.network !== "") {
+      writer.uint32(10).string(message.network);
+    }
+    if (message.address !== "") {
+      writer.uint32(18).string(message.address);
+    }
+    if (message.facility !== 0) {
+      writer.uint32(24).int32(message.facility);
+    }
+    if (message.appName !== "") {
+      writer.uint32(34).string(message.appName);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): AuditLogExportSetting_Destination_SyslogConfig {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseAuditLogExportSetting_Destination_SyslogConfig();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.network = reader.string();
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.address = reader.string();
+          continue;
+        case 3:
+          if (tag !== 24) {
+            break;
+          }
+
+          message.facility = reader.int32();
+          continue;
+        case 4:
+          if (tag !== 34) {
+            break;
+          }
+
+          message.appName = reader.string();
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): AuditLogExportSetting_Destination_SyslogConfig {
+    return {
+      network: isSet(object.network) ? String(object.network) : "",
+      address: isSet(object.address) ? String(object.address) : "",
+      facility: isSet(object.facility) ? Number(object.facility) : 0,
+      appName: isSet(object.appName) ? String(object.appName) : "",
+    };
+  },
+
+  toJSON(message: AuditLogExportSetting_Destination_SyslogConfig): unknown {
+    const obj: any = {};
+    message.network !== undefined && (obj.network = message.network);
+    message.address !== undefined && (obj.address = message.address);
+    message.facility !== undefined && (obj.facility = Math.round(message.facility));
+    message.appName !== undefined && (obj.appName = message.appName);
+    return obj;
+  },
+
+  create(
+    base?: DeepPartial<AuditLogExportSetting_Destination_SyslogConfig>,
+  ): AuditLogExportSetting_Destination_SyslogConfig {
+    return AuditLogExportSetting_Destination_SyslogConfig.fromPartial(base ?? {});
+  },
+
+  fromPartial(
+    object: DeepPartial<AuditLogExportSetting_Destination_SyslogConfig>,
+  ): AuditLogExportSetting_Destination_SyslogConfig {
+    const message = createBaseAuditLogExportSetting_Destination_SyslogConfig();
+    message.network = object.network ?? "";
+    message.address = object.address ?? "";
+    message.facility = object.facility ?? 0;
+    message.appName = object.appName ?? "";
+    return message;
+  },
+};
+
+function createBaseAuditLogExportSetting_Destination_HTTPConfig(): AuditLogExportSetting_Destination_HTTPConfig {
+  return { url: "", headers: {} };
+}
+
+export const AuditLogExportSetting_Destination_HTTPConfig = {
+  encode(message: AuditLogExportSetting_Destination_HTTPConfig, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.url !== "") {
+      writer.uint32(10).string(message.url);
+    }
+    Object.entries(message.headers).forEach(([key, value]) => {
+      AuditLogExportSetting_Destination_HTTPConfig_HeadersEntry.encode(
+        { key: key as any, value },
+        writer.uint32(18).fork(),
+      ).ldelim();
+    });
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): AuditLogExportSetting_Destination_HTTPConfig {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseAuditLogExportSetting_Destination_HTTPConfig();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.url = reader.string();
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          const entry2 = AuditLogExportSetting_Destination_HTTPConfig_HeadersEntry.decode(reader, reader.uint32());
+          if (entry2.value !== undefined) {
+            message.headers[entry2.key] = entry2.value;
+          }
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): AuditLogExportSetting_Destination_HTTPConfig {
+    return {
+      url: isSet(object.url) ? String(object.url) : "",
+      headers: isObject(object.headers)
+        ? Object.entries(object.headers).reduce<{ [key: string]: string }>((acc, [key, value]) => {
+          acc[key] = String(value);
+          return acc;
+        }, {})
+        : {},
+    };
+  },
+
+  toJSON(message: AuditLogExportSetting_Destination_HTTPConfig): unknown {
+    const obj: any = {};
+    message.url !== undefined && (obj.url = message.url);
+    obj.headers = {};
+    if (message.headers) {
+      Object.entries(message.headers).forEach(([k, v]) => {
+        obj.headers[k] = v;
+      });
+    }
+    return obj;
+  },
+
+  create(
+    base?: DeepPartial<AuditLogExportSetting_Destination_HTTPConfig>,
+  ): AuditLogExportSetting_Destination_HTTPConfig {
+    return AuditLogExportSetting_Destination_HTTPConfig.fromPartial(base ?? {});
+  },
+
+  fromPartial(
+    object: DeepPartial<AuditLogExportSetting_Destination_HTTPConfig>,
+  ): AuditLogExportSetting_Destination_HTTPConfig {
+    const message = createBaseAuditLogExportSetting_Destination_HTTPConfig();
+    message.url = object.url ?? "";
+    message.headers = Object.entries(object.headers ?? {}).reduce<{ [key: string]: string }>((acc, [key, value]) => {
+      if (value !== undefined) {
+        acc[key] = String(value);
+      }
+      return acc;
+    }, {});
+    return message;
+  },
+};
+
+function createBaseAuditLogExportSetting_Destination_HTTPConfig_HeadersEntry(): AuditLogExportSetting_Destination_HTTPConfig_HeadersEntry {
+  return { key: "", value: "" };
+}
+
+export const AuditLogExportSetting_Destination_HTTPConfig_HeadersEntry = {
+  encode(
+    message: AuditLogExportSetting_Destination_HTTPConfig_HeadersEntry,
+    writer: _m0.Writer = _m0.Writer.create(),
+  ): _m0.Writer {
+    if (message.key !== "") {
+      writer.uint32(10).string(message.key);
+    }
+    if (message.value !== "") {
+      writer.uint32(18).string(message.value);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): AuditLogExportSetting_Destination_HTTPConfig_HeadersEntry {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseAuditLogExportSetting_Destination_HTTPConfig_HeadersEntry();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.key = reader.string();
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.value = reader.string();
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): AuditLogExportSetting_Destination_HTTPConfig_HeadersEntry {
+    return { key: isSet(object.key) ? String(object.key) : "", value: isSet(object.value) ? String(object.value) : "" };
+  },
+
+  toJSON(message: AuditLogExportSetting_Destination_HTTPConfig_HeadersEntry): unknown {
+    const obj: any = {};
+    message.key !== undefined && (obj.key = message.key);
+    message.value !== undefined && (obj.value = message.value);
+    return obj;
+  },
+
+  create(
+    base?: DeepPartial<AuditLogExportSetting_Destination_HTTPConfig_HeadersEntry>,
+  ): AuditLogExportSetting_Destination_HTTPConfig_HeadersEntry {
+    return AuditLogExportSetting_Destination_HTTPConfig_HeadersEntry.fromPartial(base ?? {});
+  },
+
+  fromPartial(
+    object: DeepPartial<AuditLogExportSetting_Destination_HTTPConfig_HeadersEntry>,
+  ): AuditLogExportSetting_Destination_HTTPConfig_HeadersEntry {
+    const message = createBaseAuditLogExportSetting_Destination_HTTPConfig_HeadersEntry();
+    message.key = object.key ?? "";
+    message.value = object.value ?? "";
+    return message;
+  },
+};
+
+function createBaseAuditLogExportSetting_Destination_FileConfig(): AuditLogExportSetting_Destination_FileConfig {
+  return { path: "", maxSize: 0, maxBackups: 0 };
+}
+
+export const AuditLogExportSetting_Destination_FileConfig = {
+  encode(message: AuditLogExportSetting_Destination_FileConfig, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.path !== "") {
+      writer.uint32(10).string(message.path);
+    }
+    if (message.maxSize !== 0) {
+      writer.uint32(16).int64(message.maxSize);
+    }
+    if (message.maxBackups !== 0) {
+      writer.uint32(24).int32(message.maxBackups);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): AuditLogExportSetting_Destination_FileConfig {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseAuditLogExportSetting_Destination_FileConfig();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.path = reader.string();
+          continue;
+        case 2:
+          if (tag !== 16) {
+            break;
+          }
+
+          message.maxSize = longToNumber(reader.int64() as Long);
+          continue;
+        case 3:
+          if (tag !== 24) {
+            break;
+          }
+
+          message.maxBackups = reader.int32();
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): AuditLogExportSetting_Destination_FileConfig {
+    return {
+      path: isSet(object.path) ? String(object.path) : "",
+      maxSize: isSet(object.maxSize) ? Number(object.maxSize) : 0,
+      maxBackups: isSet(object.maxBackups) ? Number(object.maxBackups) : 0,
+    };
+  },
+
+  toJSON(message: AuditLogExportSetting_Destination_FileConfig): unknown {
+    const obj: any = {};
+    message.path !== undefined && (obj.path = message.path);
+    message.maxSize !== undefined && (obj.maxSize = Math.round(message.maxSize));
+    message.maxBackups !== undefined && (obj.maxBackups = Math.round(message.maxBackups));
+    return obj;
+  },
+
+  create(
+    base?: DeepPartial<AuditLogExportSetting_Destination_FileConfig>,
+  ): AuditLogExportSetting_Destination_FileConfig {
+    return AuditLogExportSetting_Destination_FileConfig.fromPartial(base ?? {});
+  },
+
+  fromPartial(
+    object: DeepPartial<AuditLogExportSetting_Destination_FileConfig>,
+  ): AuditLogExportSetting_Destination_FileConfig {
+    const message = createBaseAuditLogExportSetting_Destination_FileConfig();
+    message.path = object.path ?? "";
+    message.maxSize = object.maxSize ?? 0;
+    message.maxBackups = object.maxBackups ?? 0;
+    return message;
+  },
+};
+
+declare var self: any | undefined;
+declare var window: any | undefined;
+declare var global: any | undefined;
+var tsProtoGlobalThis: any = (() => {
+  if (typeof globalThis !== "undefined") {
+    return globalThis;
+  }
+  if (typeof self !== "undefined") {
+    return self;
+  }
+  if (typeof window !== "undefined") {
+    return window;
+  }
+  if (typeof global !== "undefined") {
+    return global;
+  }
+  throw "Unable to locate global object";
+})();
+
 type Builtin = Date | Function | Uint8Array | string | number | boolean | undefined;
 
 export type DeepPartial<T> = T extends Builtin ? T
@@ -854,6 +1694,24 @@ export type DeepPartial<T> = T extends Builtin ? T
   : T extends {} ? { [K in keyof T]?: DeepPartial<T[K]> }
   : Partial<T>;
 
+function longToNumber(long: Long): number {
+  if (long.gt(Number.MAX_SAFE_INTEGER)) {
+    throw new tsProtoGlobalThis.Error("Value is larger than Number.MAX_SAFE_INTEGER");
+  }
+  return long.toNumber();
+}
+
+// If you get a compile-error about 'Constructor<Long> and ... have no overlap',
+// add '--ts_proto_opt=esModuleInterop=true' as a flag when calling 'protoc'.
+if (_m0.util.Long !== Long) {
+  _m0.util.Long = Long as any;
+  _m0.configure();
+}
+
+function isObject(value: any): boolean {
+  return typeof value === "object" && value !== null;
+}
+
 function isSet(value: any): boolean {
   return value !== null && value !== undefined;
 }
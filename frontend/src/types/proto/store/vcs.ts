@@ -9,6 +9,8 @@ export enum VcsType {
   GITLAB = 1,
   GITHUB = 2,
   BITBUCKET = 3,
+  GITEA = 4,
+  AZURE_DEVOPS = 5,
   UNRECOGNIZED = -1,
 }
 
@@ -26,6 +28,12 @@ export function vcsTypeFromJSON(object: any): VcsType {
     case 3:
     case "BITBUCKET":
       return VcsType.BITBUCKET;
+    case 4:
+    case "GITEA":
+      return VcsType.GITEA;
+    case 5:
+    case "AZURE_DEVOPS":
+      return VcsType.AZURE_DEVOPS;
     case -1:
     case "UNRECOGNIZED":
     default:
@@ -43,6 +51,10 @@ export function vcsTypeToJSON(object: VcsType): string {
       return "GITHUB";
     case VcsType.BITBUCKET:
       return "BITBUCKET";
+    case VcsType.GITEA:
+      return "GITEA";
+    case VcsType.AZURE_DEVOPS:
+      return "AZURE_DEVOPS";
     case VcsType.UNRECOGNIZED:
     default:
       return "UNRECOGNIZED";
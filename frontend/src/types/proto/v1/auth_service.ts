@@ -206,6 +206,8 @@ export interface LoginRequest {
 export interface IdentityProviderContext {
   oauth2Context?: OAuth2IdentityProviderContext | undefined;
   oidcContext?: OIDCIdentityProviderContext | undefined;
+  ldapContext?: LDAPIdentityProviderContext | undefined;
+  samlContext?: SAMLIdentityProviderContext | undefined;
 }
 
 export interface OAuth2IdentityProviderContext {
@@ -215,6 +217,17 @@ export interface OAuth2IdentityProviderContext {
 export interface OIDCIdentityProviderContext {
 }
 
+export interface LDAPIdentityProviderContext {
+  /** The username and password are verified by binding to the LDAP server. */
+  username: string;
+  password: string;
+}
+
+export interface SAMLIdentityProviderContext {
+  /** The one-time code exchanged for the SAML response validated by the assertion consumer service. */
+  code: string;
+}
+
 export interface LoginResponse {
   token: string;
   mfaTempToken?: string | undefined;
@@ -911,7 +924,7 @@ export const LoginRequest = {
 };
 
 function createBaseIdentityProviderContext(): IdentityProviderContext {
-  return { oauth2Context: undefined, oidcContext: undefined };
+  return { oauth2Context: undefined, oidcContext: undefined, ldapContext: undefined, samlContext: undefined };
 }
 
 export const IdentityProviderContext = {
@@ -922,6 +935,12 @@ export const IdentityProviderContext = {
     if (message.oidcContext !== undefined) {
       OIDCIdentityProviderContext.encode(message.oidcContext, writer.uint32(18).fork()).ldelim();
     }
+    if (message.ldapContext !== undefined) {
+      LDAPIdentityProviderContext.encode(message.ldapContext, writer.uint32(26).fork()).ldelim();
+    }
+    if (message.samlContext !== undefined) {
+      SAMLIdentityProviderContext.encode(message.samlContext, writer.uint32(34).fork()).ldelim();
+    }
     return writer;
   },
 
@@ -946,6 +965,20 @@ export const IdentityProviderContext = {
 
           message.oidcContext = OIDCIdentityProviderContext.decode(reader, reader.uint32());
           continue;
+        case 3:
+          if (tag !== 26) {
+            break;
+          }
+
+          message.ldapContext = LDAPIdentityProviderContext.decode(reader, reader.uint32());
+          continue;
+        case 4:
+          if (tag !== 34) {
+            break;
+          }
+
+          message.samlContext = SAMLIdentityProviderContext.decode(reader, reader.uint32());
+          continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
         break;
@@ -961,6 +994,8 @@ export const IdentityProviderContext = {
         ? OAuth2IdentityProviderContext.fromJSON(object.oauth2Context)
         : undefined,
       oidcContext: isSet(object.oidcContext) ? OIDCIdentityProviderContext.fromJSON(object.oidcContext) : undefined,
+      ldapContext: isSet(object.ldapContext) ? LDAPIdentityProviderContext.fromJSON(object.ldapContext) : undefined,
+      samlContext: isSet(object.samlContext) ? SAMLIdentityProviderContext.fromJSON(object.samlContext) : undefined,
     };
   },
 
@@ -971,6 +1006,10 @@ export const IdentityProviderContext = {
       : undefined);
     message.oidcContext !== undefined &&
       (obj.oidcContext = message.oidcContext ? OIDCIdentityProviderContext.toJSON(message.oidcContext) : undefined);
+    message.ldapContext !== undefined &&
+      (obj.ldapContext = message.ldapContext ? LDAPIdentityProviderContext.toJSON(message.ldapContext) : undefined);
+    message.samlContext !== undefined &&
+      (obj.samlContext = message.samlContext ? SAMLIdentityProviderContext.toJSON(message.samlContext) : undefined);
     return obj;
   },
 
@@ -986,6 +1025,12 @@ export const IdentityProviderContext = {
     message.oidcContext = (object.oidcContext !== undefined && object.oidcContext !== null)
       ? OIDCIdentityProviderContext.fromPartial(object.oidcContext)
       : undefined;
+    message.ldapContext = (object.ldapContext !== undefined && object.ldapContext !== null)
+      ? LDAPIdentityProviderContext.fromPartial(object.ldapContext)
+      : undefined;
+    message.samlContext = (object.samlContext !== undefined && object.samlContext !== null)
+      ? SAMLIdentityProviderContext.fromPartial(object.samlContext)
+      : undefined;
     return message;
   },
 };
@@ -1090,6 +1135,133 @@ export const OIDCIdentityProviderContext = {
   },
 };
 
+function createBaseLDAPIdentityProviderContext(): LDAPIdentityProviderContext {
+  return { username: "", password: "" };
+}
+
+export const LDAPIdentityProviderContext = {
+  encode(message: LDAPIdentityProviderContext, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.username !== "") {
+      writer.uint32(10).string(message.username);
+    }
+    if (message.password !== "") {
+      writer.uint32(18).string(message.password);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): LDAPIdentityProviderContext {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseLDAPIdentityProviderContext();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.username = reader.string();
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.password = reader.string();
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): LDAPIdentityProviderContext {
+    return {
+      username: isSet(object.username) ? String(object.username) : "",
+      password: isSet(object.password) ? String(object.password) : "",
+    };
+  },
+
+  toJSON(message: LDAPIdentityProviderContext): unknown {
+    const obj: any = {};
+    message.username !== undefined && (obj.username = message.username);
+    message.password !== undefined && (obj.password = message.password);
+    return obj;
+  },
+
+  create(base?: DeepPartial<LDAPIdentityProviderContext>): LDAPIdentityProviderContext {
+    return LDAPIdentityProviderContext.fromPartial(base ?? {});
+  },
+
+  fromPartial(object: DeepPartial<LDAPIdentityProviderContext>): LDAPIdentityProviderContext {
+    const message = createBaseLDAPIdentityProviderContext();
+    message.username = object.username ?? "";
+    message.password = object.password ?? "";
+    return message;
+  },
+};
+
+function createBaseSAMLIdentityProviderContext(): SAMLIdentityProviderContext {
+  return { code: "" };
+}
+
+export const SAMLIdentityProviderContext = {
+  encode(message: SAMLIdentityProviderContext, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.code !== "") {
+      writer.uint32(10).string(message.code);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): SAMLIdentityProviderContext {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseSAMLIdentityProviderContext();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.code = reader.string();
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): SAMLIdentityProviderContext {
+    return { code: isSet(object.code) ? String(object.code) : "" };
+  },
+
+  toJSON(message: SAMLIdentityProviderContext): unknown {
+    const obj: any = {};
+    message.code !== undefined && (obj.code = message.code);
+    return obj;
+  },
+
+  create(base?: DeepPartial<SAMLIdentityProviderContext>): SAMLIdentityProviderContext {
+    return SAMLIdentityProviderContext.fromPartial(base ?? {});
+  },
+
+  fromPartial(object: DeepPartial<SAMLIdentityProviderContext>): SAMLIdentityProviderContext {
+    const message = createBaseSAMLIdentityProviderContext();
+    message.code = object.code ?? "";
+    return message;
+  },
+};
+
 function createBaseLoginResponse(): LoginResponse {
   return { token: "", mfaTempToken: undefined };
 }
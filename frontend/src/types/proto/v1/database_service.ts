@@ -790,6 +790,40 @@ export interface AdviseIndexResponse {
   createIndexStatement: string;
 }
 
+/** ListIndexRecommendationsRequest is the request of listing the index recommendations. */
+export interface ListIndexRecommendationsRequest {
+  /** Format: instances/{instance}/databases/{database} */
+  parent: string;
+}
+
+/** ListIndexRecommendationsResponse is the response of listing the index recommendations. */
+export interface ListIndexRecommendationsResponse {
+  /** The index recommendations ordered by the total query time of the slow queries benefiting from them. */
+  indexRecommendations: IndexRecommendation[];
+}
+
+/** IndexRecommendation is a composite index proposed from the slow query statistics and the database metadata. */
+export interface IndexRecommendation {
+  /** The schema of the table. */
+  schema: string;
+  /** The table of the index. */
+  table: string;
+  /** The columns of the index in order. */
+  columns: string[];
+  /** The existing index serving the longest prefix of the columns, or empty if there's none. */
+  currentIndex: string;
+  /** The create index statement of the recommended index. */
+  createIndexStatement: string;
+  /** The fingerprints of the slow queries benefiting from the index. */
+  sqlFingerprints: string[];
+  /** The count of the slow queries benefiting from the index. */
+  queryCount: number;
+  /** The total query time of the slow queries benefiting from the index. */
+  totalQueryTime?: Duration;
+  /** The rows examined but not sent by the slow queries benefiting from the index. */
+  reducibleRowsExamined: number;
+}
+
 export interface ChangeHistory {
   /** Format: instances/{instance}/databases/{database}/changeHistories/{changeHistory} */
   name: string;
@@ -5147,6 +5181,310 @@ export const AdviseIndexResponse = {
   },
 };
 
+function createBaseListIndexRecommendationsRequest(): ListIndexRecommendationsRequest {
+  return { parent: "" };
+}
+
+export const ListIndexRecommendationsRequest = {
+  encode(message: ListIndexRecommendationsRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.parent !== "") {
+      writer.uint32(10).string(message.parent);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): ListIndexRecommendationsRequest {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseListIndexRecommendationsRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.parent = reader.string();
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): ListIndexRecommendationsRequest {
+    return { parent: isSet(object.parent) ? String(object.parent) : "" };
+  },
+
+  toJSON(message: ListIndexRecommendationsRequest): unknown {
+    const obj: any = {};
+    message.parent !== undefined && (obj.parent = message.parent);
+    return obj;
+  },
+
+  create(base?: DeepPartial<ListIndexRecommendationsRequest>): ListIndexRecommendationsRequest {
+    return ListIndexRecommendationsRequest.fromPartial(base ?? {});
+  },
+
+  fromPartial(object: DeepPartial<ListIndexRecommendationsRequest>): ListIndexRecommendationsRequest {
+    const message = createBaseListIndexRecommendationsRequest();
+    message.parent = object.parent ?? "";
+    return message;
+  },
+};
+
+function createBaseListIndexRecommendationsResponse(): ListIndexRecommendationsResponse {
+  return { indexRecommendations: [] };
+}
+
+export const ListIndexRecommendationsResponse = {
+  encode(message: ListIndexRecommendationsResponse, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    for (const v of message.indexRecommendations) {
+      IndexRecommendation.encode(v!, writer.uint32(10).fork()).ldelim();
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): ListIndexRecommendationsResponse {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseListIndexRecommendationsResponse();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.indexRecommendations.push(IndexRecommendation.decode(reader, reader.uint32()));
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): ListIndexRecommendationsResponse {
+    return {
+      indexRecommendations: Array.isArray(object?.indexRecommendations)
+        ? object.indexRecommendations.map((e: any) => IndexRecommendation.fromJSON(e))
+        : [],
+    };
+  },
+
+  toJSON(message: ListIndexRecommendationsResponse): unknown {
+    const obj: any = {};
+    if (message.indexRecommendations) {
+      obj.indexRecommendations = message.indexRecommendations.map((e) => e ? IndexRecommendation.toJSON(e) : undefined);
+    } else {
+      obj.indexRecommendations = [];
+    }
+    return obj;
+  },
+
+  create(base?: DeepPartial<ListIndexRecommendationsResponse>): ListIndexRecommendationsResponse {
+    return ListIndexRecommendationsResponse.fromPartial(base ?? {});
+  },
+
+  fromPartial(object: DeepPartial<ListIndexRecommendationsResponse>): ListIndexRecommendationsResponse {
+    const message = createBaseListIndexRecommendationsResponse();
+    message.indexRecommendations = object.indexRecommendations?.map((e) => IndexRecommendation.fromPartial(e)) || [];
+    return message;
+  },
+};
+
+function createBaseIndexRecommendation(): IndexRecommendation {
+  return {
+    schema: "",
+    table: "",
+    columns: [],
+    currentIndex: "",
+    createIndexStatement: "",
+    sqlFingerprints: [],
+    queryCount: 0,
+    totalQueryTime: undefined,
+    reducibleRowsExamined: 0,
+  };
+}
+
+export const IndexRecommendation = {
+  encode(message: IndexRecommendation, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.schema !== "") {
+      writer.uint32(10).string(message.schema);
+    }
+    if (message.table !== "") {
+      writer.uint32(18).string(message.table);
+    }
+    for (const v of message.columns) {
+      writer.uint32(26).string(v!);
+    }
+    if (message.currentIndex !== "") {
+      writer.uint32(34).string(message.currentIndex);
+    }
+    if (message.createIndexStatement !== "") {
+      writer.uint32(42).string(message.createIndexStatement);
+    }
+    for (const v of message.sqlFingerprints) {
+      writer.uint32(50).string(v!);
+    }
+    if (message.queryCount !== 0) {
+      writer.uint32(56).int64(message.queryCount);
+    }
+    if (message.totalQueryTime !== undefined) {
+      Duration.encode(message.totalQueryTime, writer.uint32(66).fork()).ldelim();
+    }
+    if (message.reducibleRowsExamined !== 0) {
+      writer.uint32(72).int64(message.reducibleRowsExamined);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): IndexRecommendation {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseIndexRecommendation();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.schema = reader.string();
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.table = reader.string();
+          continue;
+        case 3:
+          if (tag !== 26) {
+            break;
+          }
+
+          message.columns.push(reader.string());
+          continue;
+        case 4:
+          if (tag !== 34) {
+            break;
+          }
+
+          message.currentIndex = reader.string();
+          continue;
+        case 5:
+          if (tag !== 42) {
+            break;
+          }
+
+          message.createIndexStatement = reader.string();
+          continue;
+        case 6:
+          if (tag !== 50) {
+            break;
+          }
+
+          message.sqlFingerprints.push(reader.string());
+          continue;
+        case 7:
+          if (tag !== 56) {
+            break;
+          }
+
+          message.queryCount = longToNumber(reader.int64() as Long);
+          continue;
+        case 8:
+          if (tag !== 66) {
+            break;
+          }
+
+          message.totalQueryTime = Duration.decode(reader, reader.uint32());
+          continue;
+        case 9:
+          if (tag !== 72) {
+            break;
+          }
+
+          message.reducibleRowsExamined = longToNumber(reader.int64() as Long);
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): IndexRecommendation {
+    return {
+      schema: isSet(object.schema) ? String(object.schema) : "",
+      table: isSet(object.table) ? String(object.table) : "",
+      columns: Array.isArray(object?.columns) ? object.columns.map((e: any) => String(e)) : [],
+      currentIndex: isSet(object.currentIndex) ? String(object.currentIndex) : "",
+      createIndexStatement: isSet(object.createIndexStatement) ? String(object.createIndexStatement) : "",
+      sqlFingerprints: Array.isArray(object?.sqlFingerprints) ? object.sqlFingerprints.map((e: any) => String(e)) : [],
+      queryCount: isSet(object.queryCount) ? Number(object.queryCount) : 0,
+      totalQueryTime: isSet(object.totalQueryTime) ? Duration.fromJSON(object.totalQueryTime) : undefined,
+      reducibleRowsExamined: isSet(object.reducibleRowsExamined) ? Number(object.reducibleRowsExamined) : 0,
+    };
+  },
+
+  toJSON(message: IndexRecommendation): unknown {
+    const obj: any = {};
+    message.schema !== undefined && (obj.schema = message.schema);
+    message.table !== undefined && (obj.table = message.table);
+    if (message.columns) {
+      obj.columns = message.columns.map((e) => e);
+    } else {
+      obj.columns = [];
+    }
+    message.currentIndex !== undefined && (obj.currentIndex = message.currentIndex);
+    message.createIndexStatement !== undefined && (obj.createIndexStatement = message.createIndexStatement);
+    if (message.sqlFingerprints) {
+      obj.sqlFingerprints = message.sqlFingerprints.map((e) => e);
+    } else {
+      obj.sqlFingerprints = [];
+    }
+    message.queryCount !== undefined && (obj.queryCount = Math.round(message.queryCount));
+    message.totalQueryTime !== undefined &&
+      (obj.totalQueryTime = message.totalQueryTime ? Duration.toJSON(message.totalQueryTime) : undefined);
+    message.reducibleRowsExamined !== undefined &&
+      (obj.reducibleRowsExamined = Math.round(message.reducibleRowsExamined));
+    return obj;
+  },
+
+  create(base?: DeepPartial<IndexRecommendation>): IndexRecommendation {
+    return IndexRecommendation.fromPartial(base ?? {});
+  },
+
+  fromPartial(object: DeepPartial<IndexRecommendation>): IndexRecommendation {
+    const message = createBaseIndexRecommendation();
+    message.schema = object.schema ?? "";
+    message.table = object.table ?? "";
+    message.columns = object.columns?.map((e) => e) || [];
+    message.currentIndex = object.currentIndex ?? "";
+    message.createIndexStatement = object.createIndexStatement ?? "";
+    message.sqlFingerprints = object.sqlFingerprints?.map((e) => e) || [];
+    message.queryCount = object.queryCount ?? 0;
+    message.totalQueryTime = (object.totalQueryTime !== undefined && object.totalQueryTime !== null)
+      ? Duration.fromPartial(object.totalQueryTime)
+      : undefined;
+    message.reducibleRowsExamined = object.reducibleRowsExamined ?? 0;
+    return message;
+  },
+};
+
 function createBaseChangeHistory(): ChangeHistory {
   return {
     name: "",
@@ -6842,6 +7180,82 @@ export const DatabaseServiceDefinition = {
         },
       },
     },
+    listIndexRecommendations: {
+      name: "ListIndexRecommendations",
+      requestType: ListIndexRecommendationsRequest,
+      requestStream: false,
+      responseType: ListIndexRecommendationsResponse,
+      responseStream: false,
+      options: {
+        _unknownFields: {
+          8410: [new Uint8Array([6, 112, 97, 114, 101, 110, 116])],
+          578365826: [
+            new Uint8Array([
+              59,
+              18,
+              57,
+              47,
+              118,
+              49,
+              47,
+              123,
+              112,
+              97,
+              114,
+              101,
+              110,
+              116,
+              61,
+              105,
+              110,
+              115,
+              116,
+              97,
+              110,
+              99,
+              101,
+              115,
+              47,
+              42,
+              47,
+              100,
+              97,
+              116,
+              97,
+              98,
+              97,
+              115,
+              101,
+              115,
+              47,
+              42,
+              125,
+              47,
+              105,
+              110,
+              100,
+              101,
+              120,
+              82,
+              101,
+              99,
+              111,
+              109,
+              109,
+              101,
+              110,
+              100,
+              97,
+              116,
+              105,
+              111,
+              110,
+              115,
+            ]),
+          ],
+        },
+      },
+    },
     listChangeHistories: {
       name: "ListChangeHistories",
       requestType: ListChangeHistoriesRequest,
@@ -7042,6 +7456,10 @@ export interface DatabaseServiceImplementation<CallContextExt = {}> {
     request: AdviseIndexRequest,
     context: CallContext & CallContextExt,
   ): Promise<DeepPartial<AdviseIndexResponse>>;
+  listIndexRecommendations(
+    request: ListIndexRecommendationsRequest,
+    context: CallContext & CallContextExt,
+  ): Promise<DeepPartial<ListIndexRecommendationsResponse>>;
   listChangeHistories(
     request: ListChangeHistoriesRequest,
     context: CallContext & CallContextExt,
@@ -7110,6 +7528,10 @@ export interface DatabaseServiceClient<CallOptionsExt = {}> {
     request: DeepPartial<AdviseIndexRequest>,
     options?: CallOptions & CallOptionsExt,
   ): Promise<AdviseIndexResponse>;
+  listIndexRecommendations(
+    request: DeepPartial<ListIndexRecommendationsRequest>,
+    options?: CallOptions & CallOptionsExt,
+  ): Promise<ListIndexRecommendationsResponse>;
   listChangeHistories(
     request: DeepPartial<ListChangeHistoriesRequest>,
     options?: CallOptions & CallOptionsExt,
@@ -126,6 +126,10 @@ export enum ExternalVersionControl_Type {
   GITLAB = 2,
   /** BITBUCKET - BitBucket type. Using for BitBucket cloud or BitBucket server. */
   BITBUCKET = 3,
+  /** GITEA - Gitea type. Using for Gitea and Forgejo. */
+  GITEA = 4,
+  /** AZURE_DEVOPS - Azure DevOps type. Using for Azure DevOps Repos. */
+  AZURE_DEVOPS = 5,
   UNRECOGNIZED = -1,
 }
 
@@ -143,6 +147,12 @@ export function externalVersionControl_TypeFromJSON(object: any): ExternalVersio
     case 3:
     case "BITBUCKET":
       return ExternalVersionControl_Type.BITBUCKET;
+    case 4:
+    case "GITEA":
+      return ExternalVersionControl_Type.GITEA;
+    case 5:
+    case "AZURE_DEVOPS":
+      return ExternalVersionControl_Type.AZURE_DEVOPS;
     case -1:
     case "UNRECOGNIZED":
     default:
@@ -160,6 +170,10 @@ export function externalVersionControl_TypeToJSON(object: ExternalVersionControl
       return "GITLAB";
     case ExternalVersionControl_Type.BITBUCKET:
       return "BITBUCKET";
+    case ExternalVersionControl_Type.GITEA:
+      return "GITEA";
+    case ExternalVersionControl_Type.AZURE_DEVOPS:
+      return "AZURE_DEVOPS";
     case ExternalVersionControl_Type.UNRECOGNIZED:
     default:
       return "UNRECOGNIZED";
@@ -211,6 +225,17 @@ export interface ProjectGitOpsInfo {
   accessToken: string;
   expiresTime?: Date;
   refreshToken: string;
+  /**
+   * Set to true to review the migration files on PR/MRs and roll them out on merge.
+   * Bytebase creates draft issues for the PR/MRs targeting the branch filter, and
+   * ignores the push events of the branch filter.
+   */
+  enablePullRequestFlow: boolean;
+  /**
+   * Set to true to write back the latest schema by pull requests instead of committing to the branch directly.
+   * The schema files of a rollout are committed to one branch, and Bytebase opens one PR/MR for them.
+   */
+  enableSchemaWriteBackPullRequest: boolean;
 }
 
 export interface ExchangeTokenRequest {
@@ -1177,6 +1202,8 @@ function createBaseProjectGitOpsInfo(): ProjectGitOpsInfo {
     accessToken: "",
     expiresTime: undefined,
     refreshToken: "",
+    enablePullRequestFlow: false,
+    enableSchemaWriteBackPullRequest: false,
   };
 }
 
@@ -1230,6 +1257,12 @@ export const ProjectGitOpsInfo = {
     if (message.refreshToken !== "") {
       writer.uint32(130).string(message.refreshToken);
     }
+    if (message.enablePullRequestFlow === true) {
+      writer.uint32(136).bool(message.enablePullRequestFlow);
+    }
+    if (message.enableSchemaWriteBackPullRequest === true) {
+      writer.uint32(144).bool(message.enableSchemaWriteBackPullRequest);
+    }
     return writer;
   },
 
@@ -1352,6 +1385,20 @@ export const ProjectGitOpsInfo = {
 
           message.refreshToken = reader.string();
           continue;
+        case 17:
+          if (tag !== 136) {
+            break;
+          }
+
+          message.enablePullRequestFlow = reader.bool();
+          continue;
+        case 18:
+          if (tag !== 144) {
+            break;
+          }
+
+          message.enableSchemaWriteBackPullRequest = reader.bool();
+          continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
         break;
@@ -1379,6 +1426,10 @@ export const ProjectGitOpsInfo = {
       accessToken: isSet(object.accessToken) ? String(object.accessToken) : "",
       expiresTime: isSet(object.expiresTime) ? fromJsonTimestamp(object.expiresTime) : undefined,
       refreshToken: isSet(object.refreshToken) ? String(object.refreshToken) : "",
+      enablePullRequestFlow: isSet(object.enablePullRequestFlow) ? Boolean(object.enablePullRequestFlow) : false,
+      enableSchemaWriteBackPullRequest: isSet(object.enableSchemaWriteBackPullRequest)
+        ? Boolean(object.enableSchemaWriteBackPullRequest)
+        : false,
     };
   },
 
@@ -1400,6 +1451,9 @@ export const ProjectGitOpsInfo = {
     message.accessToken !== undefined && (obj.accessToken = message.accessToken);
     message.expiresTime !== undefined && (obj.expiresTime = message.expiresTime.toISOString());
     message.refreshToken !== undefined && (obj.refreshToken = message.refreshToken);
+    message.enablePullRequestFlow !== undefined && (obj.enablePullRequestFlow = message.enablePullRequestFlow);
+    message.enableSchemaWriteBackPullRequest !== undefined &&
+      (obj.enableSchemaWriteBackPullRequest = message.enableSchemaWriteBackPullRequest);
     return obj;
   },
 
@@ -1425,6 +1479,8 @@ export const ProjectGitOpsInfo = {
     message.accessToken = object.accessToken ?? "";
     message.expiresTime = object.expiresTime ?? undefined;
     message.refreshToken = object.refreshToken ?? "";
+    message.enablePullRequestFlow = object.enablePullRequestFlow ?? false;
+    message.enableSchemaWriteBackPullRequest = object.enableSchemaWriteBackPullRequest ?? false;
     return message;
   },
 };
@@ -11,6 +11,8 @@ export enum IdentityProviderType {
   IDENTITY_PROVIDER_TYPE_UNSPECIFIED = 0,
   OAUTH2 = 1,
   OIDC = 2,
+  LDAP = 3,
+  SAML = 4,
   UNRECOGNIZED = -1,
 }
 
@@ -25,6 +27,12 @@ export function identityProviderTypeFromJSON(object: any): IdentityProviderType
     case 2:
     case "OIDC":
       return IdentityProviderType.OIDC;
+    case 3:
+    case "LDAP":
+      return IdentityProviderType.LDAP;
+    case 4:
+    case "SAML":
+      return IdentityProviderType.SAML;
     case -1:
     case "UNRECOGNIZED":
     default:
@@ -40,12 +48,56 @@ export function identityProviderTypeToJSON(object: IdentityProviderType): string
       return "OAUTH2";
     case IdentityProviderType.OIDC:
       return "OIDC";
+    case IdentityProviderType.LDAP:
+      return "LDAP";
+    case IdentityProviderType.SAML:
+      return "SAML";
     case IdentityProviderType.UNRECOGNIZED:
     default:
       return "UNRECOGNIZED";
   }
 }
 
+export enum LDAPSecurityProtocol {
+  /** LDAP_SECURITY_PROTOCOL_UNSPECIFIED - The connection is not encrypted if the security protocol is unspecified. */
+  LDAP_SECURITY_PROTOCOL_UNSPECIFIED = 0,
+  START_TLS = 1,
+  LDAPS = 2,
+  UNRECOGNIZED = -1,
+}
+
+export function lDAPSecurityProtocolFromJSON(object: any): LDAPSecurityProtocol {
+  switch (object) {
+    case 0:
+    case "LDAP_SECURITY_PROTOCOL_UNSPECIFIED":
+      return LDAPSecurityProtocol.LDAP_SECURITY_PROTOCOL_UNSPECIFIED;
+    case 1:
+    case "START_TLS":
+      return LDAPSecurityProtocol.START_TLS;
+    case 2:
+    case "LDAPS":
+      return LDAPSecurityProtocol.LDAPS;
+    case -1:
+    case "UNRECOGNIZED":
+    default:
+      return LDAPSecurityProtocol.UNRECOGNIZED;
+  }
+}
+
+export function lDAPSecurityProtocolToJSON(object: LDAPSecurityProtocol): string {
+  switch (object) {
+    case LDAPSecurityProtocol.LDAP_SECURITY_PROTOCOL_UNSPECIFIED:
+      return "LDAP_SECURITY_PROTOCOL_UNSPECIFIED";
+    case LDAPSecurityProtocol.START_TLS:
+      return "START_TLS";
+    case LDAPSecurityProtocol.LDAPS:
+      return "LDAPS";
+    case LDAPSecurityProtocol.UNRECOGNIZED:
+    default:
+      return "UNRECOGNIZED";
+  }
+}
+
 export interface GetIdentityProviderRequest {
   name: string;
 }
@@ -125,6 +177,8 @@ export interface TestIdentityProviderRequest {
   /** The identity provider to test connection including uncreated. */
   identityProvider?: IdentityProvider;
   oauth2Context?: OAuth2IdentityProviderTestRequestContext | undefined;
+  ldapContext?: LDAPIdentityProviderTestRequestContext | undefined;
+  samlContext?: SAMLIdentityProviderTestRequestContext | undefined;
 }
 
 export interface OAuth2IdentityProviderTestRequestContext {
@@ -132,6 +186,17 @@ export interface OAuth2IdentityProviderTestRequestContext {
   code: string;
 }
 
+export interface LDAPIdentityProviderTestRequestContext {
+  /** The username and password of an LDAP user to test the authentication. */
+  username: string;
+  password: string;
+}
+
+export interface SAMLIdentityProviderTestRequestContext {
+  /** The one-time code exchanged for the SAML response validated by the assertion consumer service. */
+  code: string;
+}
+
 export interface TestIdentityProviderResponse {
 }
 
@@ -153,6 +218,8 @@ export interface IdentityProvider {
 export interface IdentityProviderConfig {
   oauth2Config?: OAuth2IdentityProviderConfig | undefined;
   oidcConfig?: OIDCIdentityProviderConfig | undefined;
+  ldapConfig?: LDAPIdentityProviderConfig | undefined;
+  samlConfig?: SAMLIdentityProviderConfig | undefined;
 }
 
 /** OAuth2IdentityProviderConfig is the structure for OAuth2 identity provider config. */
@@ -177,6 +244,76 @@ export interface OIDCIdentityProviderConfig {
   skipTlsVerify: boolean;
 }
 
+/** LDAPIdentityProviderConfig is the structure for LDAP identity provider config. */
+export interface LDAPIdentityProviderConfig {
+  /** Host is the hostname or IP address of the LDAP server, e.g. ldap.example.com. */
+  host: string;
+  /** Port is the port of the LDAP server, e.g. 389 for LDAP and StartTLS, 636 for LDAPS. */
+  port: number;
+  skipTlsVerify: boolean;
+  /**
+   * BindDN is the DN of the service account to search for the users and groups,
+   * e.g. cn=admin,dc=example,dc=com.
+   */
+  bindDn: string;
+  bindPassword: string;
+  /** BaseDN is the base DN to search for the users, e.g. ou=users,dc=example,dc=com. */
+  baseDn: string;
+  /**
+   * UserFilter is the filter to search for the user, e.g. (uid=%s).
+   * The %s is replaced by the escaped username.
+   */
+  userFilter: string;
+  securityProtocol: LDAPSecurityProtocol;
+  /**
+   * FieldMapping saves the attribute names of the user entry.
+   * e.g. the identifier is `uid`, the display_name is `cn` and the email is `mail`.
+   */
+  fieldMapping?: FieldMapping;
+  /**
+   * GroupMemberAttribute is the attribute of the group entry listing the member DNs.
+   * Default to `member` if it's empty.
+   */
+  groupMemberAttribute: string;
+  /** GroupMappings grant the project roles to the members of the LDAP groups. */
+  groupMappings: LDAPGroupMapping[];
+}
+
+/** LDAPGroupMapping is the mapping from an LDAP group to a project role. */
+export interface LDAPGroupMapping {
+  /** Group is the DN of the LDAP group, e.g. cn=dba,ou=groups,dc=example,dc=com. */
+  group: string;
+  /**
+   * The project to grant the role.
+   * Format: projects/{project}
+   */
+  project: string;
+  /**
+   * The project role granted to the group members.
+   * Format: roles/{role}
+   */
+  role: string;
+}
+
+/** SAMLIdentityProviderConfig is the structure for SAML identity provider config. */
+export interface SAMLIdentityProviderConfig {
+  /** SSOUrl is the single sign-on URL of the identity provider with the HTTP-Redirect binding. */
+  ssoUrl: string;
+  /** EntityID is the entity ID of the identity provider, which is the issuer of the assertions. */
+  entityId: string;
+  /** Certificate is the PEM encoded certificate of the identity provider to verify the signatures. */
+  certificate: string;
+  /** SPPrivateKey is the PEM encoded private key of the service provider to sign the authentication requests. */
+  spPrivateKey: string;
+  /** SPCertificate is the PEM encoded certificate of the service provider published in the metadata. */
+  spCertificate: string;
+  /**
+   * FieldMapping saves the attribute names of the assertion.
+   * The NameID of the subject is used as the identifier if the identifier is empty.
+   */
+  fieldMapping?: FieldMapping;
+}
+
 /**
  * FieldMapping saves the field names from user info API of identity provider.
  * As we save all raw json string of user info response data into `principal.idp_user_info`,
@@ -674,7 +811,7 @@ export const UndeleteIdentityProviderRequest = {
 };
 
 function createBaseTestIdentityProviderRequest(): TestIdentityProviderRequest {
-  return { identityProvider: undefined, oauth2Context: undefined };
+  return { identityProvider: undefined, oauth2Context: undefined, ldapContext: undefined, samlContext: undefined };
 }
 
 export const TestIdentityProviderRequest = {
@@ -685,6 +822,12 @@ export const TestIdentityProviderRequest = {
     if (message.oauth2Context !== undefined) {
       OAuth2IdentityProviderTestRequestContext.encode(message.oauth2Context, writer.uint32(18).fork()).ldelim();
     }
+    if (message.ldapContext !== undefined) {
+      LDAPIdentityProviderTestRequestContext.encode(message.ldapContext, writer.uint32(26).fork()).ldelim();
+    }
+    if (message.samlContext !== undefined) {
+      SAMLIdentityProviderTestRequestContext.encode(message.samlContext, writer.uint32(34).fork()).ldelim();
+    }
     return writer;
   },
 
@@ -709,6 +852,20 @@ export const TestIdentityProviderRequest = {
 
           message.oauth2Context = OAuth2IdentityProviderTestRequestContext.decode(reader, reader.uint32());
           continue;
+        case 3:
+          if (tag !== 26) {
+            break;
+          }
+
+          message.ldapContext = LDAPIdentityProviderTestRequestContext.decode(reader, reader.uint32());
+          continue;
+        case 4:
+          if (tag !== 34) {
+            break;
+          }
+
+          message.samlContext = SAMLIdentityProviderTestRequestContext.decode(reader, reader.uint32());
+          continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
         break;
@@ -724,6 +881,12 @@ export const TestIdentityProviderRequest = {
       oauth2Context: isSet(object.oauth2Context)
         ? OAuth2IdentityProviderTestRequestContext.fromJSON(object.oauth2Context)
         : undefined,
+      ldapContext: isSet(object.ldapContext)
+        ? LDAPIdentityProviderTestRequestContext.fromJSON(object.ldapContext)
+        : undefined,
+      samlContext: isSet(object.samlContext)
+        ? SAMLIdentityProviderTestRequestContext.fromJSON(object.samlContext)
+        : undefined,
     };
   },
 
@@ -734,6 +897,12 @@ export const TestIdentityProviderRequest = {
     message.oauth2Context !== undefined && (obj.oauth2Context = message.oauth2Context
       ? OAuth2IdentityProviderTestRequestContext.toJSON(message.oauth2Context)
       : undefined);
+    message.ldapContext !== undefined && (obj.ldapContext = message.ldapContext
+      ? LDAPIdentityProviderTestRequestContext.toJSON(message.ldapContext)
+      : undefined);
+    message.samlContext !== undefined && (obj.samlContext = message.samlContext
+      ? SAMLIdentityProviderTestRequestContext.toJSON(message.samlContext)
+      : undefined);
     return obj;
   },
 
@@ -749,6 +918,12 @@ export const TestIdentityProviderRequest = {
     message.oauth2Context = (object.oauth2Context !== undefined && object.oauth2Context !== null)
       ? OAuth2IdentityProviderTestRequestContext.fromPartial(object.oauth2Context)
       : undefined;
+    message.ldapContext = (object.ldapContext !== undefined && object.ldapContext !== null)
+      ? LDAPIdentityProviderTestRequestContext.fromPartial(object.ldapContext)
+      : undefined;
+    message.samlContext = (object.samlContext !== undefined && object.samlContext !== null)
+      ? SAMLIdentityProviderTestRequestContext.fromPartial(object.samlContext)
+      : undefined;
     return message;
   },
 };
@@ -809,6 +984,133 @@ export const OAuth2IdentityProviderTestRequestContext = {
   },
 };
 
+function createBaseLDAPIdentityProviderTestRequestContext(): LDAPIdentityProviderTestRequestContext {
+  return { username: "", password: "" };
+}
+
+export const LDAPIdentityProviderTestRequestContext = {
+  encode(message: LDAPIdentityProviderTestRequestContext, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.username !== "") {
+      writer.uint32(10).string(message.username);
+    }
+    if (message.password !== "") {
+      writer.uint32(18).string(message.password);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): LDAPIdentityProviderTestRequestContext {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseLDAPIdentityProviderTestRequestContext();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.username = reader.string();
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.password = reader.string();
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): LDAPIdentityProviderTestRequestContext {
+    return {
+      username: isSet(object.username) ? String(object.username) : "",
+      password: isSet(object.password) ? String(object.password) : "",
+    };
+  },
+
+  toJSON(message: LDAPIdentityProviderTestRequestContext): unknown {
+    const obj: any = {};
+    message.username !== undefined && (obj.username = message.username);
+    message.password !== undefined && (obj.password = message.password);
+    return obj;
+  },
+
+  create(base?: DeepPartial<LDAPIdentityProviderTestRequestContext>): LDAPIdentityProviderTestRequestContext {
+    return LDAPIdentityProviderTestRequestContext.fromPartial(base ?? {});
+  },
+
+  fromPartial(object: DeepPartial<LDAPIdentityProviderTestRequestContext>): LDAPIdentityProviderTestRequestContext {
+    const message = createBaseLDAPIdentityProviderTestRequestContext();
+    message.username = object.username ?? "";
+    message.password = object.password ?? "";
+    return message;
+  },
+};
+
+function createBaseSAMLIdentityProviderTestRequestContext(): SAMLIdentityProviderTestRequestContext {
+  return { code: "" };
+}
+
+export const SAMLIdentityProviderTestRequestContext = {
+  encode(message: SAMLIdentityProviderTestRequestContext, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.code !== "") {
+      writer.uint32(10).string(message.code);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): SAMLIdentityProviderTestRequestContext {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseSAMLIdentityProviderTestRequestContext();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.code = reader.string();
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): SAMLIdentityProviderTestRequestContext {
+    return { code: isSet(object.code) ? String(object.code) : "" };
+  },
+
+  toJSON(message: SAMLIdentityProviderTestRequestContext): unknown {
+    const obj: any = {};
+    message.code !== undefined && (obj.code = message.code);
+    return obj;
+  },
+
+  create(base?: DeepPartial<SAMLIdentityProviderTestRequestContext>): SAMLIdentityProviderTestRequestContext {
+    return SAMLIdentityProviderTestRequestContext.fromPartial(base ?? {});
+  },
+
+  fromPartial(object: DeepPartial<SAMLIdentityProviderTestRequestContext>): SAMLIdentityProviderTestRequestContext {
+    const message = createBaseSAMLIdentityProviderTestRequestContext();
+    message.code = object.code ?? "";
+    return message;
+  },
+};
+
 function createBaseTestIdentityProviderResponse(): TestIdentityProviderResponse {
   return {};
 }
@@ -993,7 +1295,7 @@ export const IdentityProvider = {
 };
 
 function createBaseIdentityProviderConfig(): IdentityProviderConfig {
-  return { oauth2Config: undefined, oidcConfig: undefined };
+  return { oauth2Config: undefined, oidcConfig: undefined, ldapConfig: undefined, samlConfig: undefined };
 }
 
 export const IdentityProviderConfig = {
@@ -1004,6 +1306,12 @@ export const IdentityProviderConfig = {
     if (message.oidcConfig !== undefined) {
       OIDCIdentityProviderConfig.encode(message.oidcConfig, writer.uint32(18).fork()).ldelim();
     }
+    if (message.ldapConfig !== undefined) {
+      LDAPIdentityProviderConfig.encode(message.ldapConfig, writer.uint32(26).fork()).ldelim();
+    }
+    if (message.samlConfig !== undefined) {
+      SAMLIdentityProviderConfig.encode(message.samlConfig, writer.uint32(34).fork()).ldelim();
+    }
     return writer;
   },
 
@@ -1028,6 +1336,20 @@ export const IdentityProviderConfig = {
 
           message.oidcConfig = OIDCIdentityProviderConfig.decode(reader, reader.uint32());
           continue;
+        case 3:
+          if (tag !== 26) {
+            break;
+          }
+
+          message.ldapConfig = LDAPIdentityProviderConfig.decode(reader, reader.uint32());
+          continue;
+        case 4:
+          if (tag !== 34) {
+            break;
+          }
+
+          message.samlConfig = SAMLIdentityProviderConfig.decode(reader, reader.uint32());
+          continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
         break;
@@ -1041,6 +1363,8 @@ export const IdentityProviderConfig = {
     return {
       oauth2Config: isSet(object.oauth2Config) ? OAuth2IdentityProviderConfig.fromJSON(object.oauth2Config) : undefined,
       oidcConfig: isSet(object.oidcConfig) ? OIDCIdentityProviderConfig.fromJSON(object.oidcConfig) : undefined,
+      ldapConfig: isSet(object.ldapConfig) ? LDAPIdentityProviderConfig.fromJSON(object.ldapConfig) : undefined,
+      samlConfig: isSet(object.samlConfig) ? SAMLIdentityProviderConfig.fromJSON(object.samlConfig) : undefined,
     };
   },
 
@@ -1050,6 +1374,10 @@ export const IdentityProviderConfig = {
       (obj.oauth2Config = message.oauth2Config ? OAuth2IdentityProviderConfig.toJSON(message.oauth2Config) : undefined);
     message.oidcConfig !== undefined &&
       (obj.oidcConfig = message.oidcConfig ? OIDCIdentityProviderConfig.toJSON(message.oidcConfig) : undefined);
+    message.ldapConfig !== undefined &&
+      (obj.ldapConfig = message.ldapConfig ? LDAPIdentityProviderConfig.toJSON(message.ldapConfig) : undefined);
+    message.samlConfig !== undefined &&
+      (obj.samlConfig = message.samlConfig ? SAMLIdentityProviderConfig.toJSON(message.samlConfig) : undefined);
     return obj;
   },
 
@@ -1065,6 +1393,12 @@ export const IdentityProviderConfig = {
     message.oidcConfig = (object.oidcConfig !== undefined && object.oidcConfig !== null)
       ? OIDCIdentityProviderConfig.fromPartial(object.oidcConfig)
       : undefined;
+    message.ldapConfig = (object.ldapConfig !== undefined && object.ldapConfig !== null)
+      ? LDAPIdentityProviderConfig.fromPartial(object.ldapConfig)
+      : undefined;
+    message.samlConfig = (object.samlConfig !== undefined && object.samlConfig !== null)
+      ? SAMLIdentityProviderConfig.fromPartial(object.samlConfig)
+      : undefined;
     return message;
   },
 };
@@ -1364,6 +1698,426 @@ export const OIDCIdentityProviderConfig = {
   },
 };
 
+function createBaseLDAPIdentityProviderConfig(): LDAPIdentityProviderConfig {
+  return {
+    host: "",
+    port: 0,
+    skipTlsVerify: false,
+    bindDn: "",
+    bindPassword: "",
+    baseDn: "",
+    userFilter: "",
+    securityProtocol: 0,
+    fieldMapping: undefined,
+    groupMemberAttribute: "",
+    groupMappings: [],
+  };
+}
+
+export const LDAPIdentityProviderConfig = {
+  encode(message: LDAPIdentityProviderConfig, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.host !== "") {
+      writer.uint32(10).string(message.host);
+    }
+    if (message.port !== 0) {
+      writer.uint32(16).int32(message.port);
+    }
+    if (message.skipTlsVerify === true) {
+      writer.uint32(24).bool(message.skipTlsVerify);
+    }
+    if (message.bindDn !== "") {
+      writer.uint32(34).string(message.bindDn);
+    }
+    if (message.bindPassword !== "") {
+      writer.uint32(42).string(message.bindPassword);
+    }
+    if (message.baseDn !== "") {
+      writer.uint32(50).string(message.baseDn);
+    }
+    if (message.userFilter !== "") {
+      writer.uint32(58).string(message.userFilter);
+    }
+    if (message.securityProtocol !== 0) {
+      writer.uint32(64).int32(message.securityProtocol);
+    }
+    if (message.fieldMapping !== undefined) {
+      FieldMapping.encode(message.fieldMapping, writer.uint32(74).fork()).ldelim();
+    }
+    if (message.groupMemberAttribute !== "") {
+      writer.uint32(82).string(message.groupMemberAttribute);
+    }
+    for (const v of message.groupMappings) {
+      LDAPGroupMapping.encode(v!, writer.uint32(90).fork()).ldelim();
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): LDAPIdentityProviderConfig {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseLDAPIdentityProviderConfig();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.host = reader.string();
+          continue;
+        case 2:
+          if (tag !== 16) {
+            break;
+          }
+
+          message.port = reader.int32();
+          continue;
+        case 3:
+          if (tag !== 24) {
+            break;
+          }
+
+          message.skipTlsVerify = reader.bool();
+          continue;
+        case 4:
+          if (tag !== 34) {
+            break;
+          }
+
+          message.bindDn = reader.string();
+          continue;
+        case 5:
+          if (tag !== 42) {
+            break;
+          }
+
+          message.bindPassword = reader.string();
+          continue;
+        case 6:
+          if (tag !== 50) {
+            break;
+          }
+
+          message.baseDn = reader.string();
+          continue;
+        case 7:
+          if (tag !== 58) {
+            break;
+          }
+
+          message.userFilter = reader.string();
+          continue;
+        case 8:
+          if (tag !== 64) {
+            break;
+          }
+
+          message.securityProtocol = reader.int32() as any;
+          continue;
+        case 9:
+          if (tag !== 74) {
+            break;
+          }
+
+          message.fieldMapping = FieldMapping.decode(reader, reader.uint32());
+          continue;
+        case 10:
+          if (tag !== 82) {
+            break;
+          }
+
+          message.groupMemberAttribute = reader.string();
+          continue;
+        case 11:
+          if (tag !== 90) {
+            break;
+          }
+
+          message.groupMappings.push(LDAPGroupMapping.decode(reader, reader.uint32()));
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): LDAPIdentityProviderConfig {
+    return {
+      host: isSet(object.host) ? String(object.host) : "",
+      port: isSet(object.port) ? Number(object.port) : 0,
+      skipTlsVerify: isSet(object.skipTlsVerify) ? Boolean(object.skipTlsVerify) : false,
+      bindDn: isSet(object.bindDn) ? String(object.bindDn) : "",
+      bindPassword: isSet(object.bindPassword) ? String(object.bindPassword) : "",
+      baseDn: isSet(object.baseDn) ? String(object.baseDn) : "",
+      userFilter: isSet(object.userFilter) ? String(object.userFilter) : "",
+      securityProtocol: isSet(object.securityProtocol) ? lDAPSecurityProtocolFromJSON(object.securityProtocol) : 0,
+      fieldMapping: isSet(object.fieldMapping) ? FieldMapping.fromJSON(object.fieldMapping) : undefined,
+      groupMemberAttribute: isSet(object.groupMemberAttribute) ? String(object.groupMemberAttribute) : "",
+      groupMappings: Array.isArray(object?.groupMappings)
+        ? object.groupMappings.map((e: any) => LDAPGroupMapping.fromJSON(e))
+        : [],
+    };
+  },
+
+  toJSON(message: LDAPIdentityProviderConfig): unknown {
+    const obj: any = {};
+    message.host !== undefined && (obj.host = message.host);
+    message.port !== undefined && (obj.port = Math.round(message.port));
+    message.skipTlsVerify !== undefined && (obj.skipTlsVerify = message.skipTlsVerify);
+    message.bindDn !== undefined && (obj.bindDn = message.bindDn);
+    message.bindPassword !== undefined && (obj.bindPassword = message.bindPassword);
+    message.baseDn !== undefined && (obj.baseDn = message.baseDn);
+    message.userFilter !== undefined && (obj.userFilter = message.userFilter);
+    message.securityProtocol !== undefined &&
+      (obj.securityProtocol = lDAPSecurityProtocolToJSON(message.securityProtocol));
+    message.fieldMapping !== undefined &&
+      (obj.fieldMapping = message.fieldMapping ? FieldMapping.toJSON(message.fieldMapping) : undefined);
+    message.groupMemberAttribute !== undefined && (obj.groupMemberAttribute = message.groupMemberAttribute);
+    if (message.groupMappings) {
+      obj.groupMappings = message.groupMappings.map((e) => e ? LDAPGroupMapping.toJSON(e) : undefined);
+    } else {
+      obj.groupMappings = [];
+    }
+    return obj;
+  },
+
+  create(base?: DeepPartial<LDAPIdentityProviderConfig>): LDAPIdentityProviderConfig {
+    return LDAPIdentityProviderConfig.fromPartial(base ?? {});
+  },
+
+  fromPartial(object: DeepPartial<LDAPIdentityProviderConfig>): LDAPIdentityProviderConfig {
+    const message = createBaseLDAPIdentityProviderConfig();
+    message.host = object.host ?? "";
+    message.port = object.port ?? 0;
+    message.skipTlsVerify = object.skipTlsVerify ?? false;
+    message.bindDn = object.bindDn ?? "";
+    message.bindPassword = object.bindPassword ?? "";
+    message.baseDn = object.baseDn ?? "";
+    message.userFilter = object.userFilter ?? "";
+    message.securityProtocol = object.securityProtocol ?? 0;
+    message.fieldMapping = (object.fieldMapping !== undefined && object.fieldMapping !== null)
+      ? FieldMapping.fromPartial(object.fieldMapping)
+      : undefined;
+    message.groupMemberAttribute = object.groupMemberAttribute ?? "";
+    message.groupMappings = object.groupMappings?.map((e) => LDAPGroupMapping.fromPartial(e)) || [];
+    return message;
+  },
+};
+
+function createBaseLDAPGroupMapping(): LDAPGroupMapping {
+  return { group: "", project: "", role: "" };
+}
+
+export const LDAPGroupMapping = {
+  encode(message: LDAPGroupMapping, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.group !== "") {
+      writer.uint32(10).string(message.group);
+    }
+    if (message.project !== "") {
+      writer.uint32(18).string(message.project);
+    }
+    if (message.role !== "") {
+      writer.uint32(26).string(message.role);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): LDAPGroupMapping {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseLDAPGroupMapping();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.group = reader.string();
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.project = reader.string();
+          continue;
+        case 3:
+          if (tag !== 26) {
+            break;
+          }
+
+          message.role = reader.string();
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): LDAPGroupMapping {
+    return {
+      group: isSet(object.group) ? String(object.group) : "",
+      project: isSet(object.project) ? String(object.project) : "",
+      role: isSet(object.role) ? String(object.role) : "",
+    };
+  },
+
+  toJSON(message: LDAPGroupMapping): unknown {
+    const obj: any = {};
+    message.group !== undefined && (obj.group = message.group);
+    message.project !== undefined && (obj.project = message.project);
+    message.role !== undefined && (obj.role = message.role);
+    return obj;
+  },
+
+  create(base?: DeepPartial<LDAPGroupMapping>): LDAPGroupMapping {
+    return LDAPGroupMapping.fromPartial(base ?? {});
+  },
+
+  fromPartial(object: DeepPartial<LDAPGroupMapping>): LDAPGroupMapping {
+    const message = createBaseLDAPGroupMapping();
+    message.group = object.group ?? "";
+    message.project = object.project ?? "";
+    message.role = object.role ?? "";
+    return message;
+  },
+};
+
+function createBaseSAMLIdentityProviderConfig(): SAMLIdentityProviderConfig {
+  return { ssoUrl: "", entityId: "", certificate: "", spPrivateKey: "", spCertificate: "", fieldMapping: undefined };
+}
+
+export const SAMLIdentityProviderConfig = {
+  encode(message: SAMLIdentityProviderConfig, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.ssoUrl !== "") {
+      writer.uint32(10).string(message.ssoUrl);
+    }
+    if (message.entityId !== "") {
+      writer.uint32(18).string(message.entityId);
+    }
+    if (message.certificate !== "") {
+      writer.uint32(26).string(message.certificate);
+    }
+    if (message.spPrivateKey !== "") {
+      writer.uint32(34).string(message.spPrivateKey);
+    }
+    if (message.spCertificate !== "") {
+      writer.uint32(42).string(message.spCertificate);
+    }
+    if (message.fieldMapping !== undefined) {
+      FieldMapping.encode(message.fieldMapping, writer.uint32(50).fork()).ldelim();
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): SAMLIdentityProviderConfig {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseSAMLIdentityProviderConfig();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.ssoUrl = reader.string();
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.entityId = reader.string();
+          continue;
+        case 3:
+          if (tag !== 26) {
+            break;
+          }
+
+          message.certificate = reader.string();
+          continue;
+        case 4:
+          if (tag !== 34) {
+            break;
+          }
+
+          message.spPrivateKey = reader.string();
+          continue;
+        case 5:
+          if (tag !== 42) {
+            break;
+          }
+
+          message.spCertificate = reader.string();
+          continue;
+        case 6:
+          if (tag !== 50) {
+            break;
+          }
+
+          message.fieldMapping = FieldMapping.decode(reader, reader.uint32());
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): SAMLIdentityProviderConfig {
+    return {
+      ssoUrl: isSet(object.ssoUrl) ? String(object.ssoUrl) : "",
+      entityId: isSet(object.entityId) ? String(object.entityId) : "",
+      certificate: isSet(object.certificate) ? String(object.certificate) : "",
+      spPrivateKey: isSet(object.spPrivateKey) ? String(object.spPrivateKey) : "",
+      spCertificate: isSet(object.spCertificate) ? String(object.spCertificate) : "",
+      fieldMapping: isSet(object.fieldMapping) ? FieldMapping.fromJSON(object.fieldMapping) : undefined,
+    };
+  },
+
+  toJSON(message: SAMLIdentityProviderConfig): unknown {
+    const obj: any = {};
+    message.ssoUrl !== undefined && (obj.ssoUrl = message.ssoUrl);
+    message.entityId !== undefined && (obj.entityId = message.entityId);
+    message.certificate !== undefined && (obj.certificate = message.certificate);
+    message.spPrivateKey !== undefined && (obj.spPrivateKey = message.spPrivateKey);
+    message.spCertificate !== undefined && (obj.spCertificate = message.spCertificate);
+    message.fieldMapping !== undefined &&
+      (obj.fieldMapping = message.fieldMapping ? FieldMapping.toJSON(message.fieldMapping) : undefined);
+    return obj;
+  },
+
+  create(base?: DeepPartial<SAMLIdentityProviderConfig>): SAMLIdentityProviderConfig {
+    return SAMLIdentityProviderConfig.fromPartial(base ?? {});
+  },
+
+  fromPartial(object: DeepPartial<SAMLIdentityProviderConfig>): SAMLIdentityProviderConfig {
+    const message = createBaseSAMLIdentityProviderConfig();
+    message.ssoUrl = object.ssoUrl ?? "";
+    message.entityId = object.entityId ?? "";
+    message.certificate = object.certificate ?? "";
+    message.spPrivateKey = object.spPrivateKey ?? "";
+    message.spCertificate = object.spCertificate ?? "";
+    message.fieldMapping = (object.fieldMapping !== undefined && object.fieldMapping !== null)
+      ? FieldMapping.fromPartial(object.fieldMapping)
+      : undefined;
+    return message;
+  },
+};
+
 function createBaseFieldMapping(): FieldMapping {
   return { identifier: "", displayName: "", email: "" };
 }
@@ -7,6 +7,56 @@ import { Engine, engineFromJSON, engineToJSON, State, stateFromJSON, stateToJSON
 
 export const protobufPackage = "bytebase.v1";
 
+/** DataSourceAuthenticationType is the way to obtain the credentials when connecting to the database. */
+export enum DataSourceAuthenticationType {
+  /** DATA_SOURCE_AUTHENTICATION_TYPE_UNSPECIFIED - The username and password of the data source are used. */
+  DATA_SOURCE_AUTHENTICATION_TYPE_UNSPECIFIED = 0,
+  /** VAULT_DATABASE_SECRET - The username and password are leased from the Vault database secrets engine for each connection. */
+  VAULT_DATABASE_SECRET = 1,
+  /** AWS_RDS_IAM - The password is a short-lived AWS RDS IAM authentication token. */
+  AWS_RDS_IAM = 2,
+  /** GCP_CLOUD_SQL_IAM - The password is a short-lived GCP Cloud SQL IAM access token. */
+  GCP_CLOUD_SQL_IAM = 3,
+  UNRECOGNIZED = -1,
+}
+
+export function dataSourceAuthenticationTypeFromJSON(object: any): DataSourceAuthenticationType {
+  switch (object) {
+    case 0:
+    case "DATA_SOURCE_AUTHENTICATION_TYPE_UNSPECIFIED":
+      return DataSourceAuthenticationType.DATA_SOURCE_AUTHENTICATION_TYPE_UNSPECIFIED;
+    case 1:
+    case "VAULT_DATABASE_SECRET":
+      return DataSourceAuthenticationType.VAULT_DATABASE_SECRET;
+    case 2:
+    case "AWS_RDS_IAM":
+      return DataSourceAuthenticationType.AWS_RDS_IAM;
+    case 3:
+    case "GCP_CLOUD_SQL_IAM":
+      return DataSourceAuthenticationType.GCP_CLOUD_SQL_IAM;
+    case -1:
+    case "UNRECOGNIZED":
+    default:
+      return DataSourceAuthenticationType.UNRECOGNIZED;
+  }
+}
+
+export function dataSourceAuthenticationTypeToJSON(object: DataSourceAuthenticationType): string {
+  switch (object) {
+    case DataSourceAuthenticationType.DATA_SOURCE_AUTHENTICATION_TYPE_UNSPECIFIED:
+      return "DATA_SOURCE_AUTHENTICATION_TYPE_UNSPECIFIED";
+    case DataSourceAuthenticationType.VAULT_DATABASE_SECRET:
+      return "VAULT_DATABASE_SECRET";
+    case DataSourceAuthenticationType.AWS_RDS_IAM:
+      return "AWS_RDS_IAM";
+    case DataSourceAuthenticationType.GCP_CLOUD_SQL_IAM:
+      return "GCP_CLOUD_SQL_IAM";
+    case DataSourceAuthenticationType.UNRECOGNIZED:
+    default:
+      return "UNRECOGNIZED";
+  }
+}
+
 export enum DataSourceType {
   DATA_SOURCE_UNSPECIFIED = 0,
   ADMIN = 1,
@@ -147,8 +197,8 @@ export interface AddDataSourceRequest {
    */
   instance: string;
   /**
-   * Identified by type.
-   * Only READ_ONLY data source can be added.
+   * Identified by type and title.
+   * Only READ_ONLY data source can be added. The title must be unique in the instance.
    */
   dataSource?: DataSource;
   /** Validate only also tests the data source connection. */
@@ -162,7 +212,7 @@ export interface RemoveDataSourceRequest {
    */
   instance: string;
   /**
-   * Identified by type.
+   * Identified by type and title.
    * Only READ_ONLY data source can be removed.
    */
   dataSource?: DataSource;
@@ -174,7 +224,7 @@ export interface UpdateDataSourceRequest {
    * Format: instances/{instance}
    */
   instance: string;
-  /** Identified by type. */
+  /** Identified by type and title. */
   dataSource?: DataSource;
   /** The list of fields to update. */
   updateMask?: string[];
@@ -249,6 +299,42 @@ export interface DataSource {
   sshPassword: string;
   /** The private key to login the server. If it's empty string, we will use the system default private key from os.Getenv("SSH_AUTH_SOCK"). */
   sshPrivateKey: string;
+  /**
+   * The references to the credentials in the external secret manager.
+   * The referenced credentials take precedence over the credentials above.
+   */
+  externalSecret?: DataSourceExternalSecret;
+  /**
+   * The way to obtain the credentials when connecting to the database.
+   * The password is not used for the short-lived credentials.
+   */
+  authenticationType: DataSourceAuthenticationType;
+  /** The path of the Vault database secrets engine credentials for VAULT_DATABASE_SECRET, e.g. database/creds/readonly. */
+  vaultDatabaseCredentialPath: string;
+  /** The AWS region of the RDS instance for AWS_RDS_IAM, e.g. us-east-1. */
+  awsRegion: string;
+  /**
+   * The read-only data source is skipped if its replication lag exceeds it. The default is 60 seconds.
+   * For MySQL, the user needs the REPLICATION CLIENT privilege to check the replication lag.
+   */
+  maxReplicationLagSeconds: number;
+  /** Schema sync and database anomaly scans read from the read-only data source instead of the admin data source. */
+  useForSync: boolean;
+}
+
+/**
+ * DataSourceExternalSecret is the references to the data source credentials in the external secret manager.
+ * A reference is in the format of <scheme>://<path>[#<key>], e.g. vault://secret/data/mysql#password,
+ * file:///run/secrets/mysql-password or env://MYSQL_PASSWORD.
+ * The credentials are resolved when connecting to the database, and they are never stored in Bytebase.
+ */
+export interface DataSourceExternalSecret {
+  password: string;
+  sslCa: string;
+  sslCert: string;
+  sslKey: string;
+  sshPassword: string;
+  sshPrivateKey: string;
 }
 
 function createBaseGetInstanceRequest(): GetInstanceRequest {
@@ -1380,6 +1466,12 @@ function createBaseDataSource(): DataSource {
     sshUser: "",
     sshPassword: "",
     sshPrivateKey: "",
+    externalSecret: undefined,
+    authenticationType: 0,
+    vaultDatabaseCredentialPath: "",
+    awsRegion: "",
+    maxReplicationLagSeconds: 0,
+    useForSync: false,
   };
 }
 
@@ -1442,6 +1534,24 @@ export const DataSource = {
     if (message.sshPrivateKey !== "") {
       writer.uint32(154).string(message.sshPrivateKey);
     }
+    if (message.externalSecret !== undefined) {
+      DataSourceExternalSecret.encode(message.externalSecret, writer.uint32(162).fork()).ldelim();
+    }
+    if (message.authenticationType !== 0) {
+      writer.uint32(168).int32(message.authenticationType);
+    }
+    if (message.vaultDatabaseCredentialPath !== "") {
+      writer.uint32(178).string(message.vaultDatabaseCredentialPath);
+    }
+    if (message.awsRegion !== "") {
+      writer.uint32(186).string(message.awsRegion);
+    }
+    if (message.maxReplicationLagSeconds !== 0) {
+      writer.uint32(192).int32(message.maxReplicationLagSeconds);
+    }
+    if (message.useForSync === true) {
+      writer.uint32(200).bool(message.useForSync);
+    }
     return writer;
   },
 
@@ -1585,6 +1695,48 @@ export const DataSource = {
 
           message.sshPrivateKey = reader.string();
           continue;
+        case 20:
+          if (tag !== 162) {
+            break;
+          }
+
+          message.externalSecret = DataSourceExternalSecret.decode(reader, reader.uint32());
+          continue;
+        case 21:
+          if (tag !== 168) {
+            break;
+          }
+
+          message.authenticationType = reader.int32() as any;
+          continue;
+        case 22:
+          if (tag !== 178) {
+            break;
+          }
+
+          message.vaultDatabaseCredentialPath = reader.string();
+          continue;
+        case 23:
+          if (tag !== 186) {
+            break;
+          }
+
+          message.awsRegion = reader.string();
+          continue;
+        case 24:
+          if (tag !== 192) {
+            break;
+          }
+
+          message.maxReplicationLagSeconds = reader.int32();
+          continue;
+        case 25:
+          if (tag !== 200) {
+            break;
+          }
+
+          message.useForSync = reader.bool();
+          continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
         break;
@@ -1615,6 +1767,18 @@ export const DataSource = {
       sshUser: isSet(object.sshUser) ? String(object.sshUser) : "",
       sshPassword: isSet(object.sshPassword) ? String(object.sshPassword) : "",
       sshPrivateKey: isSet(object.sshPrivateKey) ? String(object.sshPrivateKey) : "",
+      externalSecret: isSet(object.externalSecret)
+        ? DataSourceExternalSecret.fromJSON(object.externalSecret)
+        : undefined,
+      authenticationType: isSet(object.authenticationType)
+        ? dataSourceAuthenticationTypeFromJSON(object.authenticationType)
+        : 0,
+      vaultDatabaseCredentialPath: isSet(object.vaultDatabaseCredentialPath)
+        ? String(object.vaultDatabaseCredentialPath)
+        : "",
+      awsRegion: isSet(object.awsRegion) ? String(object.awsRegion) : "",
+      maxReplicationLagSeconds: isSet(object.maxReplicationLagSeconds) ? Number(object.maxReplicationLagSeconds) : 0,
+      useForSync: isSet(object.useForSync) ? Boolean(object.useForSync) : false,
     };
   },
 
@@ -1639,6 +1803,17 @@ export const DataSource = {
     message.sshUser !== undefined && (obj.sshUser = message.sshUser);
     message.sshPassword !== undefined && (obj.sshPassword = message.sshPassword);
     message.sshPrivateKey !== undefined && (obj.sshPrivateKey = message.sshPrivateKey);
+    message.externalSecret !== undefined && (obj.externalSecret = message.externalSecret
+      ? DataSourceExternalSecret.toJSON(message.externalSecret)
+      : undefined);
+    message.authenticationType !== undefined &&
+      (obj.authenticationType = dataSourceAuthenticationTypeToJSON(message.authenticationType));
+    message.vaultDatabaseCredentialPath !== undefined &&
+      (obj.vaultDatabaseCredentialPath = message.vaultDatabaseCredentialPath);
+    message.awsRegion !== undefined && (obj.awsRegion = message.awsRegion);
+    message.maxReplicationLagSeconds !== undefined &&
+      (obj.maxReplicationLagSeconds = Math.round(message.maxReplicationLagSeconds));
+    message.useForSync !== undefined && (obj.useForSync = message.useForSync);
     return obj;
   },
 
@@ -1667,6 +1842,137 @@ export const DataSource = {
     message.sshUser = object.sshUser ?? "";
     message.sshPassword = object.sshPassword ?? "";
     message.sshPrivateKey = object.sshPrivateKey ?? "";
+    message.externalSecret = (object.externalSecret !== undefined && object.externalSecret !== null)
+      ? DataSourceExternalSecret.fromPartial(object.externalSecret)
+      : undefined;
+    message.authenticationType = object.authenticationType ?? 0;
+    message.vaultDatabaseCredentialPath = object.vaultDatabaseCredentialPath ?? "";
+    message.awsRegion = object.awsRegion ?? "";
+    message.maxReplicationLagSeconds = object.maxReplicationLagSeconds ?? 0;
+    message.useForSync = object.useForSync ?? false;
+    return message;
+  },
+};
+
+function createBaseDataSourceExternalSecret(): DataSourceExternalSecret {
+  return { password: "", sslCa: "", sslCert: "", sslKey: "", sshPassword: "", sshPrivateKey: "" };
+}
+
+export const DataSourceExternalSecret = {
+  encode(message: DataSourceExternalSecret, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.password !== "") {
+      writer.uint32(10).string(message.password);
+    }
+    if (message.sslCa !== "") {
+      writer.uint32(18).string(message.sslCa);
+    }
+    if (message.sslCert !== "") {
+      writer.uint32(26).string(message.sslCert);
+    }
+    if (message.sslKey !== "") {
+      writer.uint32(34).string(message.sslKey);
+    }
+    if (message.sshPassword !== "") {
+      writer.uint32(42).string(message.sshPassword);
+    }
+    if (message.sshPrivateKey !== "") {
+      writer.uint32(50).string(message.sshPrivateKey);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): DataSourceExternalSecret {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseDataSourceExternalSecret();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.password = reader.string();
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.sslCa = reader.string();
+          continue;
+        case 3:
+          if (tag !== 26) {
+            break;
+          }
+
+          message.sslCert = reader.string();
+          continue;
+        case 4:
+          if (tag !== 34) {
+            break;
+          }
+
+          message.sslKey = reader.string();
+          continue;
+        case 5:
+          if (tag !== 42) {
+            break;
+          }
+
+          message.sshPassword = reader.string();
+          continue;
+        case 6:
+          if (tag !== 50) {
+            break;
+          }
+
+          message.sshPrivateKey = reader.string();
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): DataSourceExternalSecret {
+    return {
+      password: isSet(object.password) ? String(object.password) : "",
+      sslCa: isSet(object.sslCa) ? String(object.sslCa) : "",
+      sslCert: isSet(object.sslCert) ? String(object.sslCert) : "",
+      sslKey: isSet(object.sslKey) ? String(object.sslKey) : "",
+      sshPassword: isSet(object.sshPassword) ? String(object.sshPassword) : "",
+      sshPrivateKey: isSet(object.sshPrivateKey) ? String(object.sshPrivateKey) : "",
+    };
+  },
+
+  toJSON(message: DataSourceExternalSecret): unknown {
+    const obj: any = {};
+    message.password !== undefined && (obj.password = message.password);
+    message.sslCa !== undefined && (obj.sslCa = message.sslCa);
+    message.sslCert !== undefined && (obj.sslCert = message.sslCert);
+    message.sslKey !== undefined && (obj.sslKey = message.sslKey);
+    message.sshPassword !== undefined && (obj.sshPassword = message.sshPassword);
+    message.sshPrivateKey !== undefined && (obj.sshPrivateKey = message.sshPrivateKey);
+    return obj;
+  },
+
+  create(base?: DeepPartial<DataSourceExternalSecret>): DataSourceExternalSecret {
+    return DataSourceExternalSecret.fromPartial(base ?? {});
+  },
+
+  fromPartial(object: DeepPartial<DataSourceExternalSecret>): DataSourceExternalSecret {
+    const message = createBaseDataSourceExternalSecret();
+    message.password = object.password ?? "";
+    message.sslCa = object.sslCa ?? "";
+    message.sslCert = object.sslCert ?? "";
+    message.sslKey = object.sslKey ?? "";
+    message.sshPassword = object.sshPassword ?? "";
+    message.sshPrivateKey = object.sshPrivateKey ?? "";
     return message;
   },
 };
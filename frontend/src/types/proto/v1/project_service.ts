@@ -2,8 +2,10 @@
 import type { CallContext, CallOptions } from "nice-grpc-common";
 import * as _m0 from "protobufjs/minimal";
 import { ParsedExpr } from "../google/api/expr/v1alpha1/syntax";
+import { Duration } from "../google/protobuf/duration";
 import { Empty } from "../google/protobuf/empty";
 import { FieldMask } from "../google/protobuf/field_mask";
+import { Timestamp } from "../google/protobuf/timestamp";
 import { Expr } from "../google/type/expr";
 import { State, stateFromJSON, stateToJSON } from "./common";
 import { ProjectGitOpsInfo } from "./externalvs_service";
@@ -1178,6 +1180,74 @@ export interface SchemaGroup_Table {
   table: string;
 }
 
+export interface ListAccessGrantsRequest {
+  /**
+   * The parent resource whose access grants are to be listed.
+   * Format: projects/{project}
+   * Use "projects/-" to list the access grants of all projects.
+   */
+  parent: string;
+}
+
+export interface ListAccessGrantsResponse {
+  /** The active access grants, ordered by the expire time. */
+  accessGrants: AccessGrant[];
+}
+
+export interface RevokeAccessGrantRequest {
+  /**
+   * The name of the access grant to revoke.
+   * Format: projects/{project}/accessGrants/{accessGrant}
+   */
+  name: string;
+}
+
+/** AccessGrant is the project role granted to the user by an approved grant request issue. */
+export interface AccessGrant {
+  /**
+   * The name of the access grant, the ID is the ID of the grant request issue.
+   * Format: projects/{project}/accessGrants/{accessGrant}
+   */
+  name: string;
+  /**
+   * The granted role.
+   * Format: roles/{role}
+   */
+  role: string;
+  /**
+   * The granted user.
+   * Format: users/{uid}
+   */
+  user: string;
+  /** The condition of the IAM policy binding. */
+  condition?: Expr;
+  /**
+   * The grant request issue.
+   * Format: projects/{project}/issues/{issue}
+   */
+  issue: string;
+  /** The time when the grant request is approved. */
+  grantTime?: Date;
+  /** The expire time in the condition. It's unset if the grant never expires. */
+  expireTime?: Date;
+  /** The SQL Editor queries executed by the user on the databases of the project during the grant window. */
+  queries: AccessGrantQuery[];
+}
+
+export interface AccessGrantQuery {
+  /** The time when the query is executed. */
+  time?: Date;
+  /**
+   * The queried database.
+   * Format: instances/{instance}/databases/{database}
+   */
+  database: string;
+  statement: string;
+  duration?: Duration;
+  /** The error of the query, empty if the query succeeded. */
+  error: string;
+}
+
 function createBaseGetProjectRequest(): GetProjectRequest {
   return { name: "" };
 }
@@ -5233,6 +5303,458 @@ export const SchemaGroup_Table = {
   },
 };
 
+function createBaseListAccessGrantsRequest(): ListAccessGrantsRequest {
+  return { parent: "" };
+}
+
+export const ListAccessGrantsRequest = {
+  encode(message: ListAccessGrantsRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.parent !== "") {
+      writer.uint32(10).string(message.parent);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): ListAccessGrantsRequest {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseListAccessGrantsRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.parent = reader.string();
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): ListAccessGrantsRequest {
+    return { parent: isSet(object.parent) ? String(object.parent) : "" };
+  },
+
+  toJSON(message: ListAccessGrantsRequest): unknown {
+    const obj: any = {};
+    message.parent !== undefined && (obj.parent = message.parent);
+    return obj;
+  },
+
+  create(base?: DeepPartial<ListAccessGrantsRequest>): ListAccessGrantsRequest {
+    return ListAccessGrantsRequest.fromPartial(base ?? {});
+  },
+
+  fromPartial(object: DeepPartial<ListAccessGrantsRequest>): ListAccessGrantsRequest {
+    const message = createBaseListAccessGrantsRequest();
+    message.parent = object.parent ?? "";
+    return message;
+  },
+};
+
+function createBaseListAccessGrantsResponse(): ListAccessGrantsResponse {
+  return { accessGrants: [] };
+}
+
+export const ListAccessGrantsResponse = {
+  encode(message: ListAccessGrantsResponse, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    for (const v of message.accessGrants) {
+      AccessGrant.encode(v!, writer.uint32(10).fork()).ldelim();
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): ListAccessGrantsResponse {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseListAccessGrantsResponse();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.accessGrants.push(AccessGrant.decode(reader, reader.uint32()));
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): ListAccessGrantsResponse {
+    return {
+      accessGrants: Array.isArray(object?.accessGrants)
+        ? object.accessGrants.map((e: any) => AccessGrant.fromJSON(e))
+        : [],
+    };
+  },
+
+  toJSON(message: ListAccessGrantsResponse): unknown {
+    const obj: any = {};
+    if (message.accessGrants) {
+      obj.accessGrants = message.accessGrants.map((e) => e ? AccessGrant.toJSON(e) : undefined);
+    } else {
+      obj.accessGrants = [];
+    }
+    return obj;
+  },
+
+  create(base?: DeepPartial<ListAccessGrantsResponse>): ListAccessGrantsResponse {
+    return ListAccessGrantsResponse.fromPartial(base ?? {});
+  },
+
+  fromPartial(object: DeepPartial<ListAccessGrantsResponse>): ListAccessGrantsResponse {
+    const message = createBaseListAccessGrantsResponse();
+    message.accessGrants = object.accessGrants?.map((e) => AccessGrant.fromPartial(e)) || [];
+    return message;
+  },
+};
+
+function createBaseRevokeAccessGrantRequest(): RevokeAccessGrantRequest {
+  return { name: "" };
+}
+
+export const RevokeAccessGrantRequest = {
+  encode(message: RevokeAccessGrantRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.name !== "") {
+      writer.uint32(10).string(message.name);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): RevokeAccessGrantRequest {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseRevokeAccessGrantRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.name = reader.string();
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): RevokeAccessGrantRequest {
+    return { name: isSet(object.name) ? String(object.name) : "" };
+  },
+
+  toJSON(message: RevokeAccessGrantRequest): unknown {
+    const obj: any = {};
+    message.name !== undefined && (obj.name = message.name);
+    return obj;
+  },
+
+  create(base?: DeepPartial<RevokeAccessGrantRequest>): RevokeAccessGrantRequest {
+    return RevokeAccessGrantRequest.fromPartial(base ?? {});
+  },
+
+  fromPartial(object: DeepPartial<RevokeAccessGrantRequest>): RevokeAccessGrantRequest {
+    const message = createBaseRevokeAccessGrantRequest();
+    message.name = object.name ?? "";
+    return message;
+  },
+};
+
+function createBaseAccessGrant(): AccessGrant {
+  return {
+    name: "",
+    role: "",
+    user: "",
+    condition: undefined,
+    issue: "",
+    grantTime: undefined,
+    expireTime: undefined,
+    queries: [],
+  };
+}
+
+export const AccessGrant = {
+  encode(message: AccessGrant, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.name !== "") {
+      writer.uint32(10).string(message.name);
+    }
+    if (message.role !== "") {
+      writer.uint32(18).string(message.role);
+    }
+    if (message.user !== "") {
+      writer.uint32(26).string(message.user);
+    }
+    if (message.condition !== undefined) {
+      Expr.encode(message.condition, writer.uint32(34).fork()).ldelim();
+    }
+    if (message.issue !== "") {
+      writer.uint32(42).string(message.issue);
+    }
+    if (message.grantTime !== undefined) {
+      Timestamp.encode(toTimestamp(message.grantTime), writer.uint32(50).fork()).ldelim();
+    }
+    if (message.expireTime !== undefined) {
+      Timestamp.encode(toTimestamp(message.expireTime), writer.uint32(58).fork()).ldelim();
+    }
+    for (const v of message.queries) {
+      AccessGrantQuery.encode(v!, writer.uint32(66).fork()).ldelim();
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): AccessGrant {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseAccessGrant();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.name = reader.string();
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.role = reader.string();
+          continue;
+        case 3:
+          if (tag !== 26) {
+            break;
+          }
+
+          message.user = reader.string();
+          continue;
+        case 4:
+          if (tag !== 34) {
+            break;
+          }
+
+          message.condition = Expr.decode(reader, reader.uint32());
+          continue;
+        case 5:
+          if (tag !== 42) {
+            break;
+          }
+
+          message.issue = reader.string();
+          continue;
+        case 6:
+          if (tag !== 50) {
+            break;
+          }
+
+          message.grantTime = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
+          continue;
+        case 7:
+          if (tag !== 58) {
+            break;
+          }
+
+          message.expireTime = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
+          continue;
+        case 8:
+          if (tag !== 66) {
+            break;
+          }
+
+          message.queries.push(AccessGrantQuery.decode(reader, reader.uint32()));
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): AccessGrant {
+    return {
+      name: isSet(object.name) ? String(object.name) : "",
+      role: isSet(object.role) ? String(object.role) : "",
+      user: isSet(object.user) ? String(object.user) : "",
+      condition: isSet(object.condition) ? Expr.fromJSON(object.condition) : undefined,
+      issue: isSet(object.issue) ? String(object.issue) : "",
+      grantTime: isSet(object.grantTime) ? fromJsonTimestamp(object.grantTime) : undefined,
+      expireTime: isSet(object.expireTime) ? fromJsonTimestamp(object.expireTime) : undefined,
+      queries: Array.isArray(object?.queries) ? object.queries.map((e: any) => AccessGrantQuery.fromJSON(e)) : [],
+    };
+  },
+
+  toJSON(message: AccessGrant): unknown {
+    const obj: any = {};
+    message.name !== undefined && (obj.name = message.name);
+    message.role !== undefined && (obj.role = message.role);
+    message.user !== undefined && (obj.user = message.user);
+    message.condition !== undefined && (obj.condition = message.condition ? Expr.toJSON(message.condition) : undefined);
+    message.issue !== undefined && (obj.issue = message.issue);
+    message.grantTime !== undefined && (obj.grantTime = message.grantTime.toISOString());
+    message.expireTime !== undefined && (obj.expireTime = message.expireTime.toISOString());
+    if (message.queries) {
+      obj.queries = message.queries.map((e) => e ? AccessGrantQuery.toJSON(e) : undefined);
+    } else {
+      obj.queries = [];
+    }
+    return obj;
+  },
+
+  create(base?: DeepPartial<AccessGrant>): AccessGrant {
+    return AccessGrant.fromPartial(base ?? {});
+  },
+
+  fromPartial(object: DeepPartial<AccessGrant>): AccessGrant {
+    const message = createBaseAccessGrant();
+    message.name = object.name ?? "";
+    message.role = object.role ?? "";
+    message.user = object.user ?? "";
+    message.condition = (object.condition !== undefined && object.condition !== null)
+      ? Expr.fromPartial(object.condition)
+      : undefined;
+    message.issue = object.issue ?? "";
+    message.grantTime = object.grantTime ?? undefined;
+    message.expireTime = object.expireTime ?? undefined;
+    message.queries = object.queries?.map((e) => AccessGrantQuery.fromPartial(e)) || [];
+    return message;
+  },
+};
+
+function createBaseAccessGrantQuery(): AccessGrantQuery {
+  return { time: undefined, database: "", statement: "", duration: undefined, error: "" };
+}
+
+export const AccessGrantQuery = {
+  encode(message: AccessGrantQuery, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.time !== undefined) {
+      Timestamp.encode(toTimestamp(message.time), writer.uint32(10).fork()).ldelim();
+    }
+    if (message.database !== "") {
+      writer.uint32(18).string(message.database);
+    }
+    if (message.statement !== "") {
+      writer.uint32(26).string(message.statement);
+    }
+    if (message.duration !== undefined) {
+      Duration.encode(message.duration, writer.uint32(34).fork()).ldelim();
+    }
+    if (message.error !== "") {
+      writer.uint32(42).string(message.error);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): AccessGrantQuery {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseAccessGrantQuery();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.time = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.database = reader.string();
+          continue;
+        case 3:
+          if (tag !== 26) {
+            break;
+          }
+
+          message.statement = reader.string();
+          continue;
+        case 4:
+          if (tag !== 34) {
+            break;
+          }
+
+          message.duration = Duration.decode(reader, reader.uint32());
+          continue;
+        case 5:
+          if (tag !== 42) {
+            break;
+          }
+
+          message.error = reader.string();
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): AccessGrantQuery {
+    return {
+      time: isSet(object.time) ? fromJsonTimestamp(object.time) : undefined,
+      database: isSet(object.database) ? String(object.database) : "",
+      statement: isSet(object.statement) ? String(object.statement) : "",
+      duration: isSet(object.duration) ? Duration.fromJSON(object.duration) : undefined,
+      error: isSet(object.error) ? String(object.error) : "",
+    };
+  },
+
+  toJSON(message: AccessGrantQuery): unknown {
+    const obj: any = {};
+    message.time !== undefined && (obj.time = message.time.toISOString());
+    message.database !== undefined && (obj.database = message.database);
+    message.statement !== undefined && (obj.statement = message.statement);
+    message.duration !== undefined && (obj.duration = message.duration ? Duration.toJSON(message.duration) : undefined);
+    message.error !== undefined && (obj.error = message.error);
+    return obj;
+  },
+
+  create(base?: DeepPartial<AccessGrantQuery>): AccessGrantQuery {
+    return AccessGrantQuery.fromPartial(base ?? {});
+  },
+
+  fromPartial(object: DeepPartial<AccessGrantQuery>): AccessGrantQuery {
+    const message = createBaseAccessGrantQuery();
+    message.time = object.time ?? undefined;
+    message.database = object.database ?? "";
+    message.statement = object.statement ?? "";
+    message.duration = (object.duration !== undefined && object.duration !== null)
+      ? Duration.fromPartial(object.duration)
+      : undefined;
+    message.error = object.error ?? "";
+    return message;
+  },
+};
+
 export type ProjectServiceDefinition = typeof ProjectServiceDefinition;
 export const ProjectServiceDefinition = {
   name: "ProjectService",
@@ -7205,6 +7727,128 @@ export const ProjectServiceDefinition = {
         },
       },
     },
+    /** ListAccessGrants lists the active access grants of the approved grant requests, for the access review. */
+    listAccessGrants: {
+      name: "ListAccessGrants",
+      requestType: ListAccessGrantsRequest,
+      requestStream: false,
+      responseType: ListAccessGrantsResponse,
+      responseStream: false,
+      options: {
+        _unknownFields: {
+          8410: [new Uint8Array([6, 112, 97, 114, 101, 110, 116])],
+          578365826: [
+            new Uint8Array([
+              38,
+              18,
+              36,
+              47,
+              118,
+              49,
+              47,
+              123,
+              112,
+              97,
+              114,
+              101,
+              110,
+              116,
+              61,
+              112,
+              114,
+              111,
+              106,
+              101,
+              99,
+              116,
+              115,
+              47,
+              42,
+              125,
+              47,
+              97,
+              99,
+              99,
+              101,
+              115,
+              115,
+              71,
+              114,
+              97,
+              110,
+              116,
+              115,
+            ]),
+          ],
+        },
+      },
+    },
+    /** RevokeAccessGrant removes the access grant from the project IAM policy. */
+    revokeAccessGrant: {
+      name: "RevokeAccessGrant",
+      requestType: RevokeAccessGrantRequest,
+      requestStream: false,
+      responseType: AccessGrant,
+      responseStream: false,
+      options: {
+        _unknownFields: {
+          8410: [new Uint8Array([4, 110, 97, 109, 101])],
+          578365826: [
+            new Uint8Array([
+              48,
+              58,
+              1,
+              42,
+              34,
+              43,
+              47,
+              118,
+              49,
+              47,
+              123,
+              110,
+              97,
+              109,
+              101,
+              61,
+              112,
+              114,
+              111,
+              106,
+              101,
+              99,
+              116,
+              115,
+              47,
+              42,
+              47,
+              97,
+              99,
+              99,
+              101,
+              115,
+              115,
+              71,
+              114,
+              97,
+              110,
+              116,
+              115,
+              47,
+              42,
+              125,
+              58,
+              114,
+              101,
+              118,
+              111,
+              107,
+              101,
+            ]),
+          ],
+        },
+      },
+    },
   },
 } as const;
 
@@ -7303,6 +7947,16 @@ export interface ProjectServiceImplementation<CallContextExt = {}> {
     request: DeleteSchemaGroupRequest,
     context: CallContext & CallContextExt,
   ): Promise<DeepPartial<Empty>>;
+  /** ListAccessGrants lists the active access grants of the approved grant requests, for the access review. */
+  listAccessGrants(
+    request: ListAccessGrantsRequest,
+    context: CallContext & CallContextExt,
+  ): Promise<DeepPartial<ListAccessGrantsResponse>>;
+  /** RevokeAccessGrant removes the access grant from the project IAM policy. */
+  revokeAccessGrant(
+    request: RevokeAccessGrantRequest,
+    context: CallContext & CallContextExt,
+  ): Promise<DeepPartial<AccessGrant>>;
 }
 
 export interface ProjectServiceClient<CallOptionsExt = {}> {
@@ -7400,6 +8054,16 @@ export interface ProjectServiceClient<CallOptionsExt = {}> {
     request: DeepPartial<DeleteSchemaGroupRequest>,
     options?: CallOptions & CallOptionsExt,
   ): Promise<Empty>;
+  /** ListAccessGrants lists the active access grants of the approved grant requests, for the access review. */
+  listAccessGrants(
+    request: DeepPartial<ListAccessGrantsRequest>,
+    options?: CallOptions & CallOptionsExt,
+  ): Promise<ListAccessGrantsResponse>;
+  /** RevokeAccessGrant removes the access grant from the project IAM policy. */
+  revokeAccessGrant(
+    request: DeepPartial<RevokeAccessGrantRequest>,
+    options?: CallOptions & CallOptionsExt,
+  ): Promise<AccessGrant>;
 }
 
 type Builtin = Date | Function | Uint8Array | string | number | boolean | undefined;
@@ -7409,6 +8073,28 @@ export type DeepPartial<T> = T extends Builtin ? T
   : T extends {} ? { [K in keyof T]?: DeepPartial<T[K]> }
   : Partial<T>;
 
+function toTimestamp(date: Date): Timestamp {
+  const seconds = date.getTime() / 1_000;
+  const nanos = (date.getTime() % 1_000) * 1_000_000;
+  return { seconds, nanos };
+}
+
+function fromTimestamp(t: Timestamp): Date {
+  let millis = (t.seconds || 0) * 1_000;
+  millis += (t.nanos || 0) / 1_000_000;
+  return new Date(millis);
+}
+
+function fromJsonTimestamp(o: any): Date {
+  if (o instanceof Date) {
+    return o;
+  } else if (typeof o === "string") {
+    return new Date(o);
+  } else {
+    return fromTimestamp(Timestamp.fromJSON(o));
+  }
+}
+
 function isSet(value: any): boolean {
   return value !== null && value !== undefined;
 }
@@ -1,6 +1,7 @@
 /* eslint-disable */
 import type { CallContext, CallOptions } from "nice-grpc-common";
 import * as _m0 from "protobufjs/minimal";
+import { Duration } from "../google/protobuf/duration";
 import { FieldMask } from "../google/protobuf/field_mask";
 import { Timestamp } from "../google/protobuf/timestamp";
 
@@ -104,6 +105,20 @@ export interface ListReviewsResponse {
   nextPageToken: string;
 }
 
+export interface ListOverdueReviewsRequest {
+  /**
+   * The parent, which owns this collection of reviews.
+   * Format: projects/{project}
+   * Use "projects/-" to list the overdue reviews from all projects.
+   */
+  parent: string;
+}
+
+export interface ListOverdueReviewsResponse {
+  /** The overdue reviews ordered by the approval due time. */
+  reviews: Review[];
+}
+
 export interface UpdateReviewRequest {
   /**
    * The review to update.
@@ -207,6 +222,18 @@ export interface Review {
   creator: string;
   createTime?: Date;
   updateTime?: Date;
+  /**
+   * The labels of the review, which are used to find the approval template.
+   * Updating the labels finds the approval template again.
+   */
+  labels: string[];
+  /**
+   * The time when the pending approval step is overdue, i.e. the first SLA duration of the approval template passes.
+   * Empty if there is no pending step or the approval template has no SLA.
+   */
+  approvalDueTime?: Date;
+  /** Whether the pending approval step is escalated to the escalation node of the approval template. */
+  approvalEscalated: boolean;
 }
 
 export interface Review_Approver {
@@ -214,6 +241,10 @@ export interface Review_Approver {
   status: Review_Approver_Status;
   /** Format: users/hello@world.com */
   principal: string;
+  /** The index of the step approved or rejected by the approver. */
+  stepIndex: number;
+  /** The index of the approved node in the step. */
+  nodeIndex: number;
 }
 
 export enum Review_Approver_Status {
@@ -270,6 +301,66 @@ export interface ApprovalTemplate {
    * TODO: we should mark it as OUTPUT_ONLY, but currently the frontend will post the approval setting with creator.
    */
   creator: string;
+  sla?: ApprovalSLA;
+}
+
+/**
+ * ApprovalSLA is the service level agreement of every step in the approval flow.
+ * The durations count from the time when the step becomes pending, and zero durations are disabled.
+ */
+export interface ApprovalSLA {
+  /** The approvers of the pending step are reminded after the interval, and then every interval. */
+  reminderInterval?: Duration;
+  /**
+   * The pending step is escalated to the escalation node after the timeout.
+   * The users of the escalation node can approve or reject the step on behalf of the step nodes.
+   */
+  escalationTimeout?: Duration;
+  escalationNode?: ApprovalNode;
+  /** The pending step expires after the timeout. */
+  expirationTimeout?: Duration;
+  expirationAction: ApprovalSLA_ExpirationAction;
+}
+
+export enum ApprovalSLA_ExpirationAction {
+  EXPIRATION_ACTION_UNSPECIFIED = 0,
+  /** REJECT - The system bot rejects the step. */
+  REJECT = 1,
+  /** CANCEL - The review is canceled. */
+  CANCEL = 2,
+  UNRECOGNIZED = -1,
+}
+
+export function approvalSLA_ExpirationActionFromJSON(object: any): ApprovalSLA_ExpirationAction {
+  switch (object) {
+    case 0:
+    case "EXPIRATION_ACTION_UNSPECIFIED":
+      return ApprovalSLA_ExpirationAction.EXPIRATION_ACTION_UNSPECIFIED;
+    case 1:
+    case "REJECT":
+      return ApprovalSLA_ExpirationAction.REJECT;
+    case 2:
+    case "CANCEL":
+      return ApprovalSLA_ExpirationAction.CANCEL;
+    case -1:
+    case "UNRECOGNIZED":
+    default:
+      return ApprovalSLA_ExpirationAction.UNRECOGNIZED;
+  }
+}
+
+export function approvalSLA_ExpirationActionToJSON(object: ApprovalSLA_ExpirationAction): string {
+  switch (object) {
+    case ApprovalSLA_ExpirationAction.EXPIRATION_ACTION_UNSPECIFIED:
+      return "EXPIRATION_ACTION_UNSPECIFIED";
+    case ApprovalSLA_ExpirationAction.REJECT:
+      return "REJECT";
+    case ApprovalSLA_ExpirationAction.CANCEL:
+      return "CANCEL";
+    case ApprovalSLA_ExpirationAction.UNRECOGNIZED:
+    default:
+      return "UNRECOGNIZED";
+  }
 }
 
 export interface ApprovalFlow {
@@ -279,11 +370,18 @@ export interface ApprovalFlow {
 export interface ApprovalStep {
   type: ApprovalStep_Type;
   nodes: ApprovalNode[];
+  /**
+   * The number of approvals from different users required by the ANY step.
+   * Zero means one approval is required.
+   */
+  quorum: number;
+  /** The issue creator cannot approve the step. */
+  forbidSelfApproval: boolean;
 }
 
 /**
  * Type of the ApprovalStep
- * ALL means every node must be approved to proceed.
+ * ALL means every node must be approved by a different user to proceed.
  * ANY means approving any node will proceed.
  */
 export enum ApprovalStep_Type {
@@ -332,13 +430,18 @@ export interface ApprovalNode {
     | undefined;
   /** Format: roles/{role} */
   role?: string | undefined;
-  externalNodeId?: string | undefined;
+  externalNodeId?:
+    | string
+    | undefined;
+  /** Format: users/{email} */
+  user?: string | undefined;
 }
 
 /**
  * Type of the ApprovalNode.
  * type determines who should approve this node.
- * ANY_IN_GROUP means the ApprovalNode can be approved by an user from our predefined user group.
+ * ANY_IN_GROUP means the ApprovalNode can be approved by an user from our predefined user group,
+ * an user with the project role, or the named user.
  * See GroupValue below for the predefined user groups.
  */
 export enum ApprovalNode_Type {
@@ -764,6 +867,122 @@ export const ListReviewsResponse = {
   },
 };
 
+function createBaseListOverdueReviewsRequest(): ListOverdueReviewsRequest {
+  return { parent: "" };
+}
+
+export const ListOverdueReviewsRequest = {
+  encode(message: ListOverdueReviewsRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.parent !== "") {
+      writer.uint32(10).string(message.parent);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): ListOverdueReviewsRequest {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseListOverdueReviewsRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.parent = reader.string();
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): ListOverdueReviewsRequest {
+    return { parent: isSet(object.parent) ? String(object.parent) : "" };
+  },
+
+  toJSON(message: ListOverdueReviewsRequest): unknown {
+    const obj: any = {};
+    message.parent !== undefined && (obj.parent = message.parent);
+    return obj;
+  },
+
+  create(base?: DeepPartial<ListOverdueReviewsRequest>): ListOverdueReviewsRequest {
+    return ListOverdueReviewsRequest.fromPartial(base ?? {});
+  },
+
+  fromPartial(object: DeepPartial<ListOverdueReviewsRequest>): ListOverdueReviewsRequest {
+    const message = createBaseListOverdueReviewsRequest();
+    message.parent = object.parent ?? "";
+    return message;
+  },
+};
+
+function createBaseListOverdueReviewsResponse(): ListOverdueReviewsResponse {
+  return { reviews: [] };
+}
+
+export const ListOverdueReviewsResponse = {
+  encode(message: ListOverdueReviewsResponse, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    for (const v of message.reviews) {
+      Review.encode(v!, writer.uint32(10).fork()).ldelim();
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): ListOverdueReviewsResponse {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseListOverdueReviewsResponse();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.reviews.push(Review.decode(reader, reader.uint32()));
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): ListOverdueReviewsResponse {
+    return { reviews: Array.isArray(object?.reviews) ? object.reviews.map((e: any) => Review.fromJSON(e)) : [] };
+  },
+
+  toJSON(message: ListOverdueReviewsResponse): unknown {
+    const obj: any = {};
+    if (message.reviews) {
+      obj.reviews = message.reviews.map((e) => e ? Review.toJSON(e) : undefined);
+    } else {
+      obj.reviews = [];
+    }
+    return obj;
+  },
+
+  create(base?: DeepPartial<ListOverdueReviewsResponse>): ListOverdueReviewsResponse {
+    return ListOverdueReviewsResponse.fromPartial(base ?? {});
+  },
+
+  fromPartial(object: DeepPartial<ListOverdueReviewsResponse>): ListOverdueReviewsResponse {
+    const message = createBaseListOverdueReviewsResponse();
+    message.reviews = object.reviews?.map((e) => Review.fromPartial(e)) || [];
+    return message;
+  },
+};
+
 function createBaseUpdateReviewRequest(): UpdateReviewRequest {
   return { review: undefined, updateMask: undefined };
 }
@@ -1204,6 +1423,9 @@ function createBaseReview(): Review {
     creator: "",
     createTime: undefined,
     updateTime: undefined,
+    labels: [],
+    approvalDueTime: undefined,
+    approvalEscalated: false,
   };
 }
 
@@ -1260,6 +1482,15 @@ export const Review = {
     if (message.updateTime !== undefined) {
       Timestamp.encode(toTimestamp(message.updateTime), writer.uint32(122).fork()).ldelim();
     }
+    for (const v of message.labels) {
+      writer.uint32(146).string(v!);
+    }
+    if (message.approvalDueTime !== undefined) {
+      Timestamp.encode(toTimestamp(message.approvalDueTime), writer.uint32(154).fork()).ldelim();
+    }
+    if (message.approvalEscalated === true) {
+      writer.uint32(160).bool(message.approvalEscalated);
+    }
     return writer;
   },
 
@@ -1389,6 +1620,27 @@ export const Review = {
 
           message.updateTime = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
           continue;
+        case 18:
+          if (tag !== 146) {
+            break;
+          }
+
+          message.labels.push(reader.string());
+          continue;
+        case 19:
+          if (tag !== 154) {
+            break;
+          }
+
+          message.approvalDueTime = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
+          continue;
+        case 20:
+          if (tag !== 160) {
+            break;
+          }
+
+          message.approvalEscalated = reader.bool();
+          continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
         break;
@@ -1419,6 +1671,9 @@ export const Review = {
       creator: isSet(object.creator) ? String(object.creator) : "",
       createTime: isSet(object.createTime) ? fromJsonTimestamp(object.createTime) : undefined,
       updateTime: isSet(object.updateTime) ? fromJsonTimestamp(object.updateTime) : undefined,
+      labels: Array.isArray(object?.labels) ? object.labels.map((e: any) => String(e)) : [],
+      approvalDueTime: isSet(object.approvalDueTime) ? fromJsonTimestamp(object.approvalDueTime) : undefined,
+      approvalEscalated: isSet(object.approvalEscalated) ? Boolean(object.approvalEscalated) : false,
     };
   },
 
@@ -1453,6 +1708,13 @@ export const Review = {
     message.creator !== undefined && (obj.creator = message.creator);
     message.createTime !== undefined && (obj.createTime = message.createTime.toISOString());
     message.updateTime !== undefined && (obj.updateTime = message.updateTime.toISOString());
+    if (message.labels) {
+      obj.labels = message.labels.map((e) => e);
+    } else {
+      obj.labels = [];
+    }
+    message.approvalDueTime !== undefined && (obj.approvalDueTime = message.approvalDueTime.toISOString());
+    message.approvalEscalated !== undefined && (obj.approvalEscalated = message.approvalEscalated);
     return obj;
   },
 
@@ -1479,12 +1741,15 @@ export const Review = {
     message.creator = object.creator ?? "";
     message.createTime = object.createTime ?? undefined;
     message.updateTime = object.updateTime ?? undefined;
+    message.labels = object.labels?.map((e) => e) || [];
+    message.approvalDueTime = object.approvalDueTime ?? undefined;
+    message.approvalEscalated = object.approvalEscalated ?? false;
     return message;
   },
 };
 
 function createBaseReview_Approver(): Review_Approver {
-  return { status: 0, principal: "" };
+  return { status: 0, principal: "", stepIndex: 0, nodeIndex: 0 };
 }
 
 export const Review_Approver = {
@@ -1495,6 +1760,12 @@ export const Review_Approver = {
     if (message.principal !== "") {
       writer.uint32(18).string(message.principal);
     }
+    if (message.stepIndex !== 0) {
+      writer.uint32(24).int32(message.stepIndex);
+    }
+    if (message.nodeIndex !== 0) {
+      writer.uint32(32).int32(message.nodeIndex);
+    }
     return writer;
   },
 
@@ -1519,6 +1790,20 @@ export const Review_Approver = {
 
           message.principal = reader.string();
           continue;
+        case 3:
+          if (tag !== 24) {
+            break;
+          }
+
+          message.stepIndex = reader.int32();
+          continue;
+        case 4:
+          if (tag !== 32) {
+            break;
+          }
+
+          message.nodeIndex = reader.int32();
+          continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
         break;
@@ -1532,6 +1817,8 @@ export const Review_Approver = {
     return {
       status: isSet(object.status) ? review_Approver_StatusFromJSON(object.status) : 0,
       principal: isSet(object.principal) ? String(object.principal) : "",
+      stepIndex: isSet(object.stepIndex) ? Number(object.stepIndex) : 0,
+      nodeIndex: isSet(object.nodeIndex) ? Number(object.nodeIndex) : 0,
     };
   },
 
@@ -1539,6 +1826,8 @@ export const Review_Approver = {
     const obj: any = {};
     message.status !== undefined && (obj.status = review_Approver_StatusToJSON(message.status));
     message.principal !== undefined && (obj.principal = message.principal);
+    message.stepIndex !== undefined && (obj.stepIndex = Math.round(message.stepIndex));
+    message.nodeIndex !== undefined && (obj.nodeIndex = Math.round(message.nodeIndex));
     return obj;
   },
 
@@ -1550,12 +1839,14 @@ export const Review_Approver = {
     const message = createBaseReview_Approver();
     message.status = object.status ?? 0;
     message.principal = object.principal ?? "";
+    message.stepIndex = object.stepIndex ?? 0;
+    message.nodeIndex = object.nodeIndex ?? 0;
     return message;
   },
 };
 
 function createBaseApprovalTemplate(): ApprovalTemplate {
-  return { flow: undefined, title: "", description: "", creator: "" };
+  return { flow: undefined, title: "", description: "", creator: "", sla: undefined };
 }
 
 export const ApprovalTemplate = {
@@ -1572,6 +1863,9 @@ export const ApprovalTemplate = {
     if (message.creator !== "") {
       writer.uint32(34).string(message.creator);
     }
+    if (message.sla !== undefined) {
+      ApprovalSLA.encode(message.sla, writer.uint32(42).fork()).ldelim();
+    }
     return writer;
   },
 
@@ -1610,6 +1904,13 @@ export const ApprovalTemplate = {
 
           message.creator = reader.string();
           continue;
+        case 5:
+          if (tag !== 42) {
+            break;
+          }
+
+          message.sla = ApprovalSLA.decode(reader, reader.uint32());
+          continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
         break;
@@ -1625,6 +1926,7 @@ export const ApprovalTemplate = {
       title: isSet(object.title) ? String(object.title) : "",
       description: isSet(object.description) ? String(object.description) : "",
       creator: isSet(object.creator) ? String(object.creator) : "",
+      sla: isSet(object.sla) ? ApprovalSLA.fromJSON(object.sla) : undefined,
     };
   },
 
@@ -1634,6 +1936,7 @@ export const ApprovalTemplate = {
     message.title !== undefined && (obj.title = message.title);
     message.description !== undefined && (obj.description = message.description);
     message.creator !== undefined && (obj.creator = message.creator);
+    message.sla !== undefined && (obj.sla = message.sla ? ApprovalSLA.toJSON(message.sla) : undefined);
     return obj;
   },
 
@@ -1649,6 +1952,138 @@ export const ApprovalTemplate = {
     message.title = object.title ?? "";
     message.description = object.description ?? "";
     message.creator = object.creator ?? "";
+    message.sla = (object.sla !== undefined && object.sla !== null) ? ApprovalSLA.fromPartial(object.sla) : undefined;
+    return message;
+  },
+};
+
+function createBaseApprovalSLA(): ApprovalSLA {
+  return {
+    reminderInterval: undefined,
+    escalationTimeout: undefined,
+    escalationNode: undefined,
+    expirationTimeout: undefined,
+    expirationAction: 0,
+  };
+}
+
+export const ApprovalSLA = {
+  encode(message: ApprovalSLA, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.reminderInterval !== undefined) {
+      Duration.encode(message.reminderInterval, writer.uint32(10).fork()).ldelim();
+    }
+    if (message.escalationTimeout !== undefined) {
+      Duration.encode(message.escalationTimeout, writer.uint32(18).fork()).ldelim();
+    }
+    if (message.escalationNode !== undefined) {
+      ApprovalNode.encode(message.escalationNode, writer.uint32(26).fork()).ldelim();
+    }
+    if (message.expirationTimeout !== undefined) {
+      Duration.encode(message.expirationTimeout, writer.uint32(34).fork()).ldelim();
+    }
+    if (message.expirationAction !== 0) {
+      writer.uint32(40).int32(message.expirationAction);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): ApprovalSLA {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseApprovalSLA();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.reminderInterval = Duration.decode(reader, reader.uint32());
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.escalationTimeout = Duration.decode(reader, reader.uint32());
+          continue;
+        case 3:
+          if (tag !== 26) {
+            break;
+          }
+
+          message.escalationNode = ApprovalNode.decode(reader, reader.uint32());
+          continue;
+        case 4:
+          if (tag !== 34) {
+            break;
+          }
+
+          message.expirationTimeout = Duration.decode(reader, reader.uint32());
+          continue;
+        case 5:
+          if (tag !== 40) {
+            break;
+          }
+
+          message.expirationAction = reader.int32() as any;
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): ApprovalSLA {
+    return {
+      reminderInterval: isSet(object.reminderInterval) ? Duration.fromJSON(object.reminderInterval) : undefined,
+      escalationTimeout: isSet(object.escalationTimeout) ? Duration.fromJSON(object.escalationTimeout) : undefined,
+      escalationNode: isSet(object.escalationNode) ? ApprovalNode.fromJSON(object.escalationNode) : undefined,
+      expirationTimeout: isSet(object.expirationTimeout) ? Duration.fromJSON(object.expirationTimeout) : undefined,
+      expirationAction: isSet(object.expirationAction)
+        ? approvalSLA_ExpirationActionFromJSON(object.expirationAction)
+        : 0,
+    };
+  },
+
+  toJSON(message: ApprovalSLA): unknown {
+    const obj: any = {};
+    message.reminderInterval !== undefined &&
+      (obj.reminderInterval = message.reminderInterval ? Duration.toJSON(message.reminderInterval) : undefined);
+    message.escalationTimeout !== undefined &&
+      (obj.escalationTimeout = message.escalationTimeout ? Duration.toJSON(message.escalationTimeout) : undefined);
+    message.escalationNode !== undefined &&
+      (obj.escalationNode = message.escalationNode ? ApprovalNode.toJSON(message.escalationNode) : undefined);
+    message.expirationTimeout !== undefined &&
+      (obj.expirationTimeout = message.expirationTimeout ? Duration.toJSON(message.expirationTimeout) : undefined);
+    message.expirationAction !== undefined &&
+      (obj.expirationAction = approvalSLA_ExpirationActionToJSON(message.expirationAction));
+    return obj;
+  },
+
+  create(base?: DeepPartial<ApprovalSLA>): ApprovalSLA {
+    return ApprovalSLA.fromPartial(base ?? {});
+  },
+
+  fromPartial(object: DeepPartial<ApprovalSLA>): ApprovalSLA {
+    const message = createBaseApprovalSLA();
+    message.reminderInterval = (object.reminderInterval !== undefined && object.reminderInterval !== null)
+      ? Duration.fromPartial(object.reminderInterval)
+      : undefined;
+    message.escalationTimeout = (object.escalationTimeout !== undefined && object.escalationTimeout !== null)
+      ? Duration.fromPartial(object.escalationTimeout)
+      : undefined;
+    message.escalationNode = (object.escalationNode !== undefined && object.escalationNode !== null)
+      ? ApprovalNode.fromPartial(object.escalationNode)
+      : undefined;
+    message.expirationTimeout = (object.expirationTimeout !== undefined && object.expirationTimeout !== null)
+      ? Duration.fromPartial(object.expirationTimeout)
+      : undefined;
+    message.expirationAction = object.expirationAction ?? 0;
     return message;
   },
 };
@@ -1714,7 +2149,7 @@ export const ApprovalFlow = {
 };
 
 function createBaseApprovalStep(): ApprovalStep {
-  return { type: 0, nodes: [] };
+  return { type: 0, nodes: [], quorum: 0, forbidSelfApproval: false };
 }
 
 export const ApprovalStep = {
@@ -1725,6 +2160,12 @@ export const ApprovalStep = {
     for (const v of message.nodes) {
       ApprovalNode.encode(v!, writer.uint32(18).fork()).ldelim();
     }
+    if (message.quorum !== 0) {
+      writer.uint32(24).int32(message.quorum);
+    }
+    if (message.forbidSelfApproval === true) {
+      writer.uint32(32).bool(message.forbidSelfApproval);
+    }
     return writer;
   },
 
@@ -1749,6 +2190,20 @@ export const ApprovalStep = {
 
           message.nodes.push(ApprovalNode.decode(reader, reader.uint32()));
           continue;
+        case 3:
+          if (tag !== 24) {
+            break;
+          }
+
+          message.quorum = reader.int32();
+          continue;
+        case 4:
+          if (tag !== 32) {
+            break;
+          }
+
+          message.forbidSelfApproval = reader.bool();
+          continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
         break;
@@ -1762,6 +2217,8 @@ export const ApprovalStep = {
     return {
       type: isSet(object.type) ? approvalStep_TypeFromJSON(object.type) : 0,
       nodes: Array.isArray(object?.nodes) ? object.nodes.map((e: any) => ApprovalNode.fromJSON(e)) : [],
+      quorum: isSet(object.quorum) ? Number(object.quorum) : 0,
+      forbidSelfApproval: isSet(object.forbidSelfApproval) ? Boolean(object.forbidSelfApproval) : false,
     };
   },
 
@@ -1773,6 +2230,8 @@ export const ApprovalStep = {
     } else {
       obj.nodes = [];
     }
+    message.quorum !== undefined && (obj.quorum = Math.round(message.quorum));
+    message.forbidSelfApproval !== undefined && (obj.forbidSelfApproval = message.forbidSelfApproval);
     return obj;
   },
 
@@ -1784,12 +2243,14 @@ export const ApprovalStep = {
     const message = createBaseApprovalStep();
     message.type = object.type ?? 0;
     message.nodes = object.nodes?.map((e) => ApprovalNode.fromPartial(e)) || [];
+    message.quorum = object.quorum ?? 0;
+    message.forbidSelfApproval = object.forbidSelfApproval ?? false;
     return message;
   },
 };
 
 function createBaseApprovalNode(): ApprovalNode {
-  return { type: 0, groupValue: undefined, role: undefined, externalNodeId: undefined };
+  return { type: 0, groupValue: undefined, role: undefined, externalNodeId: undefined, user: undefined };
 }
 
 export const ApprovalNode = {
@@ -1806,6 +2267,9 @@ export const ApprovalNode = {
     if (message.externalNodeId !== undefined) {
       writer.uint32(34).string(message.externalNodeId);
     }
+    if (message.user !== undefined) {
+      writer.uint32(42).string(message.user);
+    }
     return writer;
   },
 
@@ -1844,6 +2308,13 @@ export const ApprovalNode = {
 
           message.externalNodeId = reader.string();
           continue;
+        case 5:
+          if (tag !== 42) {
+            break;
+          }
+
+          message.user = reader.string();
+          continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
         break;
@@ -1859,6 +2330,7 @@ export const ApprovalNode = {
       groupValue: isSet(object.groupValue) ? approvalNode_GroupValueFromJSON(object.groupValue) : undefined,
       role: isSet(object.role) ? String(object.role) : undefined,
       externalNodeId: isSet(object.externalNodeId) ? String(object.externalNodeId) : undefined,
+      user: isSet(object.user) ? String(object.user) : undefined,
     };
   },
 
@@ -1871,6 +2343,7 @@ export const ApprovalNode = {
         : undefined);
     message.role !== undefined && (obj.role = message.role);
     message.externalNodeId !== undefined && (obj.externalNodeId = message.externalNodeId);
+    message.user !== undefined && (obj.user = message.user);
     return obj;
   },
 
@@ -1884,6 +2357,7 @@ export const ApprovalNode = {
     message.groupValue = object.groupValue ?? undefined;
     message.role = object.role ?? undefined;
     message.externalNodeId = object.externalNodeId ?? undefined;
+    message.user = object.user ?? undefined;
     return message;
   },
 };
@@ -2322,6 +2796,69 @@ export const ReviewServiceDefinition = {
         },
       },
     },
+    /** ListOverdueReviews lists the open reviews whose pending approval step is overdue. */
+    listOverdueReviews: {
+      name: "ListOverdueReviews",
+      requestType: ListOverdueReviewsRequest,
+      requestStream: false,
+      responseType: ListOverdueReviewsResponse,
+      responseStream: false,
+      options: {
+        _unknownFields: {
+          8410: [new Uint8Array([6, 112, 97, 114, 101, 110, 116])],
+          578365826: [
+            new Uint8Array([
+              45,
+              18,
+              43,
+              47,
+              118,
+              49,
+              47,
+              123,
+              112,
+              97,
+              114,
+              101,
+              110,
+              116,
+              61,
+              112,
+              114,
+              111,
+              106,
+              101,
+              99,
+              116,
+              115,
+              47,
+              42,
+              125,
+              47,
+              114,
+              101,
+              118,
+              105,
+              101,
+              119,
+              115,
+              58,
+              108,
+              105,
+              115,
+              116,
+              79,
+              118,
+              101,
+              114,
+              100,
+              117,
+              101,
+            ]),
+          ],
+        },
+      },
+    },
     updateReview: {
       name: "UpdateReview",
       requestType: UpdateReviewRequest,
@@ -2856,6 +3393,11 @@ export interface ReviewServiceImplementation<CallContextExt = {}> {
     request: ListReviewsRequest,
     context: CallContext & CallContextExt,
   ): Promise<DeepPartial<ListReviewsResponse>>;
+  /** ListOverdueReviews lists the open reviews whose pending approval step is overdue. */
+  listOverdueReviews(
+    request: ListOverdueReviewsRequest,
+    context: CallContext & CallContextExt,
+  ): Promise<DeepPartial<ListOverdueReviewsResponse>>;
   updateReview(request: UpdateReviewRequest, context: CallContext & CallContextExt): Promise<DeepPartial<Review>>;
   createReviewComment(
     request: CreateReviewCommentRequest,
@@ -2881,6 +3423,11 @@ export interface ReviewServiceClient<CallOptionsExt = {}> {
     request: DeepPartial<ListReviewsRequest>,
     options?: CallOptions & CallOptionsExt,
   ): Promise<ListReviewsResponse>;
+  /** ListOverdueReviews lists the open reviews whose pending approval step is overdue. */
+  listOverdueReviews(
+    request: DeepPartial<ListOverdueReviewsRequest>,
+    options?: CallOptions & CallOptionsExt,
+  ): Promise<ListOverdueReviewsResponse>;
   updateReview(request: DeepPartial<UpdateReviewRequest>, options?: CallOptions & CallOptionsExt): Promise<Review>;
   createReviewComment(
     request: DeepPartial<CreateReviewCommentRequest>,
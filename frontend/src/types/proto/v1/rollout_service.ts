@@ -4,6 +4,7 @@ import type { CallContext, CallOptions } from "nice-grpc-common";
 import * as _m0 from "protobufjs/minimal";
 import { FieldMask } from "../google/protobuf/field_mask";
 import { Timestamp } from "../google/protobuf/timestamp";
+import { Fix } from "./sql_service";
 
 export const protobufPackage = "bytebase.v1";
 
@@ -459,6 +460,8 @@ export interface PlanCheckRun_Result {
   content: string;
   line: number;
   detail: string;
+  /** The machine-applicable fix for the advisor result, if any. */
+  fix?: Fix;
 }
 
 export enum PlanCheckRun_Result_Namespace {
@@ -2567,7 +2570,7 @@ export const PlanCheckRun = {
 };
 
 function createBasePlanCheckRun_Result(): PlanCheckRun_Result {
-  return { namespace: 0, code: 0, status: 0, title: "", content: "", line: 0, detail: "" };
+  return { namespace: 0, code: 0, status: 0, title: "", content: "", line: 0, detail: "", fix: undefined };
 }
 
 export const PlanCheckRun_Result = {
@@ -2593,6 +2596,9 @@ export const PlanCheckRun_Result = {
     if (message.detail !== "") {
       writer.uint32(58).string(message.detail);
     }
+    if (message.fix !== undefined) {
+      Fix.encode(message.fix, writer.uint32(66).fork()).ldelim();
+    }
     return writer;
   },
 
@@ -2652,6 +2658,13 @@ export const PlanCheckRun_Result = {
 
           message.detail = reader.string();
           continue;
+        case 8:
+          if (tag !== 66) {
+            break;
+          }
+
+          message.fix = Fix.decode(reader, reader.uint32());
+          continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
         break;
@@ -2670,6 +2683,7 @@ export const PlanCheckRun_Result = {
       content: isSet(object.content) ? String(object.content) : "",
       line: isSet(object.line) ? Number(object.line) : 0,
       detail: isSet(object.detail) ? String(object.detail) : "",
+      fix: isSet(object.fix) ? Fix.fromJSON(object.fix) : undefined,
     };
   },
 
@@ -2682,6 +2696,7 @@ export const PlanCheckRun_Result = {
     message.content !== undefined && (obj.content = message.content);
     message.line !== undefined && (obj.line = Math.round(message.line));
     message.detail !== undefined && (obj.detail = message.detail);
+    message.fix !== undefined && (obj.fix = message.fix ? Fix.toJSON(message.fix) : undefined);
     return obj;
   },
 
@@ -2698,6 +2713,7 @@ export const PlanCheckRun_Result = {
     message.content = object.content ?? "";
     message.line = object.line ?? 0;
     message.detail = object.detail ?? "";
+    message.fix = (object.fix !== undefined && object.fix !== null) ? Fix.fromPartial(object.fix) : undefined;
     return message;
   },
 };
@@ -5,6 +5,7 @@ import * as _m0 from "protobufjs/minimal";
 import { Empty } from "../google/protobuf/empty";
 import { FieldMask } from "../google/protobuf/field_mask";
 import { Timestamp } from "../google/protobuf/timestamp";
+import { Advice } from "./sql_service";
 
 export const protobufPackage = "bytebase.v1";
 
@@ -128,6 +129,29 @@ export interface SearchSheetsResponse {
   nextPageToken: string;
 }
 
+export interface ApplySheetFixesRequest {
+  /**
+   * The name of the sheet to fix.
+   * Format: projects/{project}/sheets/{sheet}
+   */
+  name: string;
+  /**
+   * The database whose SQL review policy is used to review the sheet.
+   * Defaults to the database of the sheet.
+   * Format: instances/{instance}/databases/{database}
+   */
+  database: string;
+  /** If set, the fixed content is returned without updating the sheet. */
+  validateOnly: boolean;
+}
+
+export interface ApplySheetFixesResponse {
+  /** The sheet with the fixed content. */
+  sheet?: Sheet;
+  /** The advices whose fixes are applied. */
+  appliedAdvices: Advice[];
+}
+
 export interface SyncSheetsRequest {
   /**
    * The name of the project to sync sheets.
@@ -240,6 +264,10 @@ export enum Sheet_Source {
   SOURCE_GITHUB = 4,
   /** SOURCE_BITBUCKET - BITBUCKET is the sheet synced from Bitbucket (for both Bitbucket.org and Bitbucket Server). */
   SOURCE_BITBUCKET = 5,
+  /** SOURCE_GITEA - GITEA is the sheet synced from Gitea (for both Gitea and Forgejo). */
+  SOURCE_GITEA = 6,
+  /** SOURCE_AZURE_DEVOPS - AZURE_DEVOPS is the sheet synced from Azure DevOps Repos. */
+  SOURCE_AZURE_DEVOPS = 7,
   UNRECOGNIZED = -1,
 }
 
@@ -263,6 +291,12 @@ export function sheet_SourceFromJSON(object: any): Sheet_Source {
     case 5:
     case "SOURCE_BITBUCKET":
       return Sheet_Source.SOURCE_BITBUCKET;
+    case 6:
+    case "SOURCE_GITEA":
+      return Sheet_Source.SOURCE_GITEA;
+    case 7:
+    case "SOURCE_AZURE_DEVOPS":
+      return Sheet_Source.SOURCE_AZURE_DEVOPS;
     case -1:
     case "UNRECOGNIZED":
     default:
@@ -284,6 +318,10 @@ export function sheet_SourceToJSON(object: Sheet_Source): string {
       return "SOURCE_GITHUB";
     case Sheet_Source.SOURCE_BITBUCKET:
       return "SOURCE_BITBUCKET";
+    case Sheet_Source.SOURCE_GITEA:
+      return "SOURCE_GITEA";
+    case Sheet_Source.SOURCE_AZURE_DEVOPS:
+      return "SOURCE_AZURE_DEVOPS";
     case Sheet_Source.UNRECOGNIZED:
     default:
       return "UNRECOGNIZED";
@@ -922,6 +960,167 @@ export const SearchSheetsResponse = {
   },
 };
 
+function createBaseApplySheetFixesRequest(): ApplySheetFixesRequest {
+  return { name: "", database: "", validateOnly: false };
+}
+
+export const ApplySheetFixesRequest = {
+  encode(message: ApplySheetFixesRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.name !== "") {
+      writer.uint32(10).string(message.name);
+    }
+    if (message.database !== "") {
+      writer.uint32(18).string(message.database);
+    }
+    if (message.validateOnly === true) {
+      writer.uint32(24).bool(message.validateOnly);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): ApplySheetFixesRequest {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseApplySheetFixesRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.name = reader.string();
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.database = reader.string();
+          continue;
+        case 3:
+          if (tag !== 24) {
+            break;
+          }
+
+          message.validateOnly = reader.bool();
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): ApplySheetFixesRequest {
+    return {
+      name: isSet(object.name) ? String(object.name) : "",
+      database: isSet(object.database) ? String(object.database) : "",
+      validateOnly: isSet(object.validateOnly) ? Boolean(object.validateOnly) : false,
+    };
+  },
+
+  toJSON(message: ApplySheetFixesRequest): unknown {
+    const obj: any = {};
+    message.name !== undefined && (obj.name = message.name);
+    message.database !== undefined && (obj.database = message.database);
+    message.validateOnly !== undefined && (obj.validateOnly = message.validateOnly);
+    return obj;
+  },
+
+  create(base?: DeepPartial<ApplySheetFixesRequest>): ApplySheetFixesRequest {
+    return ApplySheetFixesRequest.fromPartial(base ?? {});
+  },
+
+  fromPartial(object: DeepPartial<ApplySheetFixesRequest>): ApplySheetFixesRequest {
+    const message = createBaseApplySheetFixesRequest();
+    message.name = object.name ?? "";
+    message.database = object.database ?? "";
+    message.validateOnly = object.validateOnly ?? false;
+    return message;
+  },
+};
+
+function createBaseApplySheetFixesResponse(): ApplySheetFixesResponse {
+  return { sheet: undefined, appliedAdvices: [] };
+}
+
+export const ApplySheetFixesResponse = {
+  encode(message: ApplySheetFixesResponse, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.sheet !== undefined) {
+      Sheet.encode(message.sheet, writer.uint32(10).fork()).ldelim();
+    }
+    for (const v of message.appliedAdvices) {
+      Advice.encode(v!, writer.uint32(18).fork()).ldelim();
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): ApplySheetFixesResponse {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseApplySheetFixesResponse();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.sheet = Sheet.decode(reader, reader.uint32());
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.appliedAdvices.push(Advice.decode(reader, reader.uint32()));
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): ApplySheetFixesResponse {
+    return {
+      sheet: isSet(object.sheet) ? Sheet.fromJSON(object.sheet) : undefined,
+      appliedAdvices: Array.isArray(object?.appliedAdvices)
+        ? object.appliedAdvices.map((e: any) => Advice.fromJSON(e))
+        : [],
+    };
+  },
+
+  toJSON(message: ApplySheetFixesResponse): unknown {
+    const obj: any = {};
+    message.sheet !== undefined && (obj.sheet = message.sheet ? Sheet.toJSON(message.sheet) : undefined);
+    if (message.appliedAdvices) {
+      obj.appliedAdvices = message.appliedAdvices.map((e) => e ? Advice.toJSON(e) : undefined);
+    } else {
+      obj.appliedAdvices = [];
+    }
+    return obj;
+  },
+
+  create(base?: DeepPartial<ApplySheetFixesResponse>): ApplySheetFixesResponse {
+    return ApplySheetFixesResponse.fromPartial(base ?? {});
+  },
+
+  fromPartial(object: DeepPartial<ApplySheetFixesResponse>): ApplySheetFixesResponse {
+    const message = createBaseApplySheetFixesResponse();
+    message.sheet = (object.sheet !== undefined && object.sheet !== null) ? Sheet.fromPartial(object.sheet) : undefined;
+    message.appliedAdvices = object.appliedAdvices?.map((e) => Advice.fromPartial(e)) || [];
+    return message;
+  },
+};
+
 function createBaseSyncSheetsRequest(): SyncSheetsRequest {
   return { parent: "" };
 }
@@ -1643,6 +1842,70 @@ export const SheetServiceDefinition = {
         },
       },
     },
+    /** ApplySheetFixes applies the safe SQL review fixes to the sheet content. */
+    applySheetFixes: {
+      name: "ApplySheetFixes",
+      requestType: ApplySheetFixesRequest,
+      requestStream: false,
+      responseType: ApplySheetFixesResponse,
+      responseStream: false,
+      options: {
+        _unknownFields: {
+          8410: [new Uint8Array([4, 110, 97, 109, 101])],
+          578365826: [
+            new Uint8Array([
+              46,
+              58,
+              1,
+              42,
+              34,
+              41,
+              47,
+              118,
+              49,
+              47,
+              123,
+              110,
+              97,
+              109,
+              101,
+              61,
+              112,
+              114,
+              111,
+              106,
+              101,
+              99,
+              116,
+              115,
+              47,
+              42,
+              47,
+              115,
+              104,
+              101,
+              101,
+              116,
+              115,
+              47,
+              42,
+              125,
+              58,
+              97,
+              112,
+              112,
+              108,
+              121,
+              70,
+              105,
+              120,
+              101,
+              115,
+            ]),
+          ],
+        },
+      },
+    },
   },
 } as const;
 
@@ -1660,6 +1923,11 @@ export interface SheetServiceImplementation<CallContextExt = {}> {
   ): Promise<DeepPartial<SheetOrganizer>>;
   deleteSheet(request: DeleteSheetRequest, context: CallContext & CallContextExt): Promise<DeepPartial<Empty>>;
   syncSheets(request: SyncSheetsRequest, context: CallContext & CallContextExt): Promise<DeepPartial<Empty>>;
+  /** ApplySheetFixes applies the safe SQL review fixes to the sheet content. */
+  applySheetFixes(
+    request: ApplySheetFixesRequest,
+    context: CallContext & CallContextExt,
+  ): Promise<DeepPartial<ApplySheetFixesResponse>>;
 }
 
 export interface SheetServiceClient<CallOptionsExt = {}> {
@@ -1676,6 +1944,11 @@ export interface SheetServiceClient<CallOptionsExt = {}> {
   ): Promise<SheetOrganizer>;
   deleteSheet(request: DeepPartial<DeleteSheetRequest>, options?: CallOptions & CallOptionsExt): Promise<Empty>;
   syncSheets(request: DeepPartial<SyncSheetsRequest>, options?: CallOptions & CallOptionsExt): Promise<Empty>;
+  /** ApplySheetFixes applies the safe SQL review fixes to the sheet content. */
+  applySheetFixes(
+    request: DeepPartial<ApplySheetFixesRequest>,
+    options?: CallOptions & CallOptionsExt,
+  ): Promise<ApplySheetFixesResponse>;
 }
 
 declare var self: any | undefined;
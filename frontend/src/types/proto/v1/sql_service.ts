@@ -170,6 +170,8 @@ export interface Advice {
   line: number;
   /** The advice detail. */
   detail: string;
+  /** The machine-applicable fix for the advice, if any. */
+  fix?: Fix;
 }
 
 export enum Advice_Status {
@@ -218,6 +220,30 @@ export function advice_StatusToJSON(object: Advice_Status): string {
   }
 }
 
+export interface Fix {
+  /** The title of the fix. */
+  title: string;
+  /**
+   * Safe is true if the fix only resolves the advice and doesn't change the intent of the statement.
+   * Only safe fixes are applied automatically.
+   */
+  safe: boolean;
+  /** The text edits of the fix. */
+  edits: Edit[];
+}
+
+export interface Edit {
+  /** The byte offset in the statement where the replaced range begins. */
+  start: number;
+  /**
+   * The byte offset in the statement where the replaced range ends, exclusive.
+   * The edit is an insertion if end equals start.
+   */
+  end: number;
+  /** The text replacing the range. */
+  newText: string;
+}
+
 export interface PrettyRequest {
   engine: Engine;
   /**
@@ -1137,7 +1163,7 @@ export const RowValue = {
 };
 
 function createBaseAdvice(): Advice {
-  return { status: 0, code: 0, title: "", content: "", line: 0, detail: "" };
+  return { status: 0, code: 0, title: "", content: "", line: 0, detail: "", fix: undefined };
 }
 
 export const Advice = {
@@ -1160,6 +1186,9 @@ export const Advice = {
     if (message.detail !== "") {
       writer.uint32(50).string(message.detail);
     }
+    if (message.fix !== undefined) {
+      Fix.encode(message.fix, writer.uint32(58).fork()).ldelim();
+    }
     return writer;
   },
 
@@ -1212,6 +1241,13 @@ export const Advice = {
 
           message.detail = reader.string();
           continue;
+        case 7:
+          if (tag !== 58) {
+            break;
+          }
+
+          message.fix = Fix.decode(reader, reader.uint32());
+          continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
         break;
@@ -1229,6 +1265,7 @@ export const Advice = {
       content: isSet(object.content) ? String(object.content) : "",
       line: isSet(object.line) ? Number(object.line) : 0,
       detail: isSet(object.detail) ? String(object.detail) : "",
+      fix: isSet(object.fix) ? Fix.fromJSON(object.fix) : undefined,
     };
   },
 
@@ -1240,6 +1277,7 @@ export const Advice = {
     message.content !== undefined && (obj.content = message.content);
     message.line !== undefined && (obj.line = Math.round(message.line));
     message.detail !== undefined && (obj.detail = message.detail);
+    message.fix !== undefined && (obj.fix = message.fix ? Fix.toJSON(message.fix) : undefined);
     return obj;
   },
 
@@ -1255,6 +1293,179 @@ export const Advice = {
     message.content = object.content ?? "";
     message.line = object.line ?? 0;
     message.detail = object.detail ?? "";
+    message.fix = (object.fix !== undefined && object.fix !== null) ? Fix.fromPartial(object.fix) : undefined;
+    return message;
+  },
+};
+
+function createBaseFix(): Fix {
+  return { title: "", safe: false, edits: [] };
+}
+
+export const Fix = {
+  encode(message: Fix, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.title !== "") {
+      writer.uint32(10).string(message.title);
+    }
+    if (message.safe === true) {
+      writer.uint32(16).bool(message.safe);
+    }
+    for (const v of message.edits) {
+      Edit.encode(v!, writer.uint32(26).fork()).ldelim();
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): Fix {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseFix();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.title = reader.string();
+          continue;
+        case 2:
+          if (tag !== 16) {
+            break;
+          }
+
+          message.safe = reader.bool();
+          continue;
+        case 3:
+          if (tag !== 26) {
+            break;
+          }
+
+          message.edits.push(Edit.decode(reader, reader.uint32()));
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): Fix {
+    return {
+      title: isSet(object.title) ? String(object.title) : "",
+      safe: isSet(object.safe) ? Boolean(object.safe) : false,
+      edits: Array.isArray(object?.edits) ? object.edits.map((e: any) => Edit.fromJSON(e)) : [],
+    };
+  },
+
+  toJSON(message: Fix): unknown {
+    const obj: any = {};
+    message.title !== undefined && (obj.title = message.title);
+    message.safe !== undefined && (obj.safe = message.safe);
+    if (message.edits) {
+      obj.edits = message.edits.map((e) => e ? Edit.toJSON(e) : undefined);
+    } else {
+      obj.edits = [];
+    }
+    return obj;
+  },
+
+  create(base?: DeepPartial<Fix>): Fix {
+    return Fix.fromPartial(base ?? {});
+  },
+
+  fromPartial(object: DeepPartial<Fix>): Fix {
+    const message = createBaseFix();
+    message.title = object.title ?? "";
+    message.safe = object.safe ?? false;
+    message.edits = object.edits?.map((e) => Edit.fromPartial(e)) || [];
+    return message;
+  },
+};
+
+function createBaseEdit(): Edit {
+  return { start: 0, end: 0, newText: "" };
+}
+
+export const Edit = {
+  encode(message: Edit, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.start !== 0) {
+      writer.uint32(8).int32(message.start);
+    }
+    if (message.end !== 0) {
+      writer.uint32(16).int32(message.end);
+    }
+    if (message.newText !== "") {
+      writer.uint32(26).string(message.newText);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): Edit {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseEdit();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 8) {
+            break;
+          }
+
+          message.start = reader.int32();
+          continue;
+        case 2:
+          if (tag !== 16) {
+            break;
+          }
+
+          message.end = reader.int32();
+          continue;
+        case 3:
+          if (tag !== 26) {
+            break;
+          }
+
+          message.newText = reader.string();
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): Edit {
+    return {
+      start: isSet(object.start) ? Number(object.start) : 0,
+      end: isSet(object.end) ? Number(object.end) : 0,
+      newText: isSet(object.newText) ? String(object.newText) : "",
+    };
+  },
+
+  toJSON(message: Edit): unknown {
+    const obj: any = {};
+    message.start !== undefined && (obj.start = Math.round(message.start));
+    message.end !== undefined && (obj.end = Math.round(message.end));
+    message.newText !== undefined && (obj.newText = message.newText);
+    return obj;
+  },
+
+  create(base?: DeepPartial<Edit>): Edit {
+    return Edit.fromPartial(base ?? {});
+  },
+
+  fromPartial(object: DeepPartial<Edit>): Edit {
+    const message = createBaseEdit();
+    message.start = object.start ?? 0;
+    message.end = object.end ?? 0;
+    message.newText = object.newText ?? "";
     return message;
   },
 };
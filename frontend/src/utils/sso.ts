@@ -7,6 +7,7 @@ import {
   IdentityProviderType,
 } from "@/types/proto/v1/idp_service";
 import { OAuthState } from "@/types";
+import { getIdentityProviderResourceId } from "@/store/modules/v1/common";
 
 export const SSOConfigSessionKey = "sso-config";
 
@@ -69,6 +70,14 @@ export async function openWindowForSSO(
       scope: oidcConfig.scopes.join(" "),
       redirect_uri: `${window.location.origin}/oidc/callback`,
     });
+  } else if (identityProvider.type === IdentityProviderType.SAML) {
+    // The backend sends the authentication request, and redirects to /saml/callback
+    // with a one-time code after validating the SAML response.
+    const resourceId = getIdentityProviderResourceId(identityProvider.name);
+    uri.basePath = `${window.location.origin}/saml/sso/${resourceId}`;
+    uri.query = {
+      state: uri.query.state,
+    };
   } else {
     throw new Error(
       `identity provider type ${identityProvider.type.toString()} is not supported`
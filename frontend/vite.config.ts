@@ -62,6 +62,11 @@ export default defineConfig(() => {
           changeOrigin: true,
           rewrite: (path: string) => path.replace(/^\/v1/, ""),
         },
+        // The SAML endpoints except /saml/callback, which is the frontend page.
+        "^/saml/(metadata|sso|acs)/": {
+          target: "http://localhost:8080/",
+          changeOrigin: true,
+        },
       },
       hmr: {
         port: IS_RUNNING_GITPOD ? HTTPS_PORT : SERVER_PORT,
@@ -167,6 +167,58 @@ func (ActivityIssueCommentCreatePayload_ApprovalEvent_Status) EnumDescriptor() (
 	return file_store_activity_proto_rawDescGZIP(), []int{1, 2, 0}
 }
 
+type ActivityIssueApprovalNotifyPayload_Type int32
+
+const (
+	// The step becomes pending.
+	ActivityIssueApprovalNotifyPayload_TYPE_UNSPECIFIED ActivityIssueApprovalNotifyPayload_Type = 0
+	// The step is overdue and the approvers are reminded.
+	ActivityIssueApprovalNotifyPayload_REMINDER ActivityIssueApprovalNotifyPayload_Type = 1
+	// The step is escalated to the escalation node.
+	ActivityIssueApprovalNotifyPayload_ESCALATION ActivityIssueApprovalNotifyPayload_Type = 2
+)
+
+// Enum value maps for ActivityIssueApprovalNotifyPayload_Type.
+var (
+	ActivityIssueApprovalNotifyPayload_Type_name = map[int32]string{
+		0: "TYPE_UNSPECIFIED",
+		1: "REMINDER",
+		2: "ESCALATION",
+	}
+	ActivityIssueApprovalNotifyPayload_Type_value = map[string]int32{
+		"TYPE_UNSPECIFIED": 0,
+		"REMINDER":         1,
+		"ESCALATION":       2,
+	}
+)
+
+func (x ActivityIssueApprovalNotifyPayload_Type) Enum() *ActivityIssueApprovalNotifyPayload_Type {
+	p := new(ActivityIssueApprovalNotifyPayload_Type)
+	*p = x
+	return p
+}
+
+func (x ActivityIssueApprovalNotifyPayload_Type) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ActivityIssueApprovalNotifyPayload_Type) Descriptor() protoreflect.EnumDescriptor {
+	return file_store_activity_proto_enumTypes[3].Descriptor()
+}
+
+func (ActivityIssueApprovalNotifyPayload_Type) Type() protoreflect.EnumType {
+	return &file_store_activity_proto_enumTypes[3]
+}
+
+func (x ActivityIssueApprovalNotifyPayload_Type) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ActivityIssueApprovalNotifyPayload_Type.Descriptor instead.
+func (ActivityIssueApprovalNotifyPayload_Type) EnumDescriptor() ([]byte, []int) {
+	return file_store_activity_proto_rawDescGZIP(), []int{2, 0}
+}
+
 // ActivityIssueCreatePayload is the payloads for creating issues.
 // These payload types are only used when marshalling to the json format for saving into the database.
 // So we annotate with json tag using camelCase naming which is consistent with normal
@@ -333,7 +385,9 @@ type ActivityIssueApprovalNotifyPayload struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ApprovalStep *ApprovalStep `protobuf:"bytes,1,opt,name=approval_step,json=approvalStep,proto3" json:"approval_step,omitempty"`
+	// The pending step, or the step of the escalation node for the ESCALATION type.
+	ApprovalStep *ApprovalStep                           `protobuf:"bytes,1,opt,name=approval_step,json=approvalStep,proto3" json:"approval_step,omitempty"`
+	Type         ActivityIssueApprovalNotifyPayload_Type `protobuf:"varint,2,opt,name=type,proto3,enum=bytebase.store.ActivityIssueApprovalNotifyPayload_Type" json:"type,omitempty"`
 }
 
 func (x *ActivityIssueApprovalNotifyPayload) Reset() {
@@ -375,6 +429,13 @@ func (x *ActivityIssueApprovalNotifyPayload) GetApprovalStep() *ApprovalStep {
 	return nil
 }
 
+func (x *ActivityIssueApprovalNotifyPayload) GetType() ActivityIssueApprovalNotifyPayload_Type {
+	if x != nil {
+		return x.Type
+	}
+	return ActivityIssueApprovalNotifyPayload_TYPE_UNSPECIFIED
+}
+
 // TaskRollbackBy records an issue rollback activity.
 // The task with taskID in IssueID is rollbacked by the task with RollbackByTaskID in RollbackByIssueID.
 type ActivityIssueCommentCreatePayload_TaskRollbackBy struct {
@@ -642,15 +703,24 @@ var file_store_activity_proto_rawDesc = []byte{
 	0x12, 0x0b, 0x0a, 0x07, 0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x0c, 0x0a,
 	0x08, 0x41, 0x50, 0x50, 0x52, 0x4f, 0x56, 0x45, 0x44, 0x10, 0x02, 0x12, 0x0c, 0x0a, 0x08, 0x52,
 	0x45, 0x4a, 0x45, 0x43, 0x54, 0x45, 0x44, 0x10, 0x03, 0x42, 0x07, 0x0a, 0x05, 0x65, 0x76, 0x65,
-	0x6e, 0x74, 0x22, 0x67, 0x0a, 0x22, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x49, 0x73,
-	0x73, 0x75, 0x65, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x4e, 0x6f, 0x74, 0x69, 0x66,
-	0x79, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x41, 0x0a, 0x0d, 0x61, 0x70, 0x70, 0x72,
-	0x6f, 0x76, 0x61, 0x6c, 0x5f, 0x73, 0x74, 0x65, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x1c, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65,
-	0x2e, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x53, 0x74, 0x65, 0x70, 0x52, 0x0c, 0x61,
-	0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x53, 0x74, 0x65, 0x70, 0x42, 0x14, 0x5a, 0x12, 0x67,
-	0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x64, 0x2d, 0x67, 0x6f, 0x2f, 0x73, 0x74, 0x6f, 0x72,
-	0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x6e, 0x74, 0x22, 0xf0, 0x01, 0x0a, 0x22, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x49,
+	0x73, 0x73, 0x75, 0x65, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x4e, 0x6f, 0x74, 0x69,
+	0x66, 0x79, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x41, 0x0a, 0x0d, 0x61, 0x70, 0x70,
+	0x72, 0x6f, 0x76, 0x61, 0x6c, 0x5f, 0x73, 0x74, 0x65, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1c, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x73, 0x74, 0x6f, 0x72,
+	0x65, 0x2e, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x53, 0x74, 0x65, 0x70, 0x52, 0x0c,
+	0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x53, 0x74, 0x65, 0x70, 0x12, 0x4b, 0x0a, 0x04,
+	0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x37, 0x2e, 0x62, 0x79, 0x74,
+	0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x41, 0x63, 0x74, 0x69,
+	0x76, 0x69, 0x74, 0x79, 0x49, 0x73, 0x73, 0x75, 0x65, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61,
+	0x6c, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x2e, 0x54,
+	0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x0a, 0x04, 0x54, 0x79, 0x70,
+	0x65, 0x12, 0x14, 0x0a, 0x10, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43,
+	0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08, 0x52, 0x45, 0x4d, 0x49, 0x4e,
+	0x44, 0x45, 0x52, 0x10, 0x01, 0x12, 0x0e, 0x0a, 0x0a, 0x45, 0x53, 0x43, 0x41, 0x4c, 0x41, 0x54,
+	0x49, 0x4f, 0x4e, 0x10, 0x02, 0x42, 0x14, 0x5a, 0x12, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74,
+	0x65, 0x64, 0x2d, 0x67, 0x6f, 0x2f, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x33,
 }
 
 var (
@@ -665,33 +735,35 @@ func file_store_activity_proto_rawDescGZIP() []byte {
 	return file_store_activity_proto_rawDescData
 }
 
-var file_store_activity_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_store_activity_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
 var file_store_activity_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
 var file_store_activity_proto_goTypes = []interface{}{
 	(ActivityIssueCommentCreatePayload_ExternalApprovalEvent_Type)(0),   // 0: bytebase.store.ActivityIssueCommentCreatePayload.ExternalApprovalEvent.Type
 	(ActivityIssueCommentCreatePayload_ExternalApprovalEvent_Action)(0), // 1: bytebase.store.ActivityIssueCommentCreatePayload.ExternalApprovalEvent.Action
 	(ActivityIssueCommentCreatePayload_ApprovalEvent_Status)(0),         // 2: bytebase.store.ActivityIssueCommentCreatePayload.ApprovalEvent.Status
-	(*ActivityIssueCreatePayload)(nil),                                  // 3: bytebase.store.ActivityIssueCreatePayload
-	(*ActivityIssueCommentCreatePayload)(nil),                           // 4: bytebase.store.ActivityIssueCommentCreatePayload
-	(*ActivityIssueApprovalNotifyPayload)(nil),                          // 5: bytebase.store.ActivityIssueApprovalNotifyPayload
-	(*ActivityIssueCommentCreatePayload_TaskRollbackBy)(nil),            // 6: bytebase.store.ActivityIssueCommentCreatePayload.TaskRollbackBy
-	(*ActivityIssueCommentCreatePayload_ExternalApprovalEvent)(nil),     // 7: bytebase.store.ActivityIssueCommentCreatePayload.ExternalApprovalEvent
-	(*ActivityIssueCommentCreatePayload_ApprovalEvent)(nil),             // 8: bytebase.store.ActivityIssueCommentCreatePayload.ApprovalEvent
-	(*ApprovalStep)(nil), // 9: bytebase.store.ApprovalStep
+	(ActivityIssueApprovalNotifyPayload_Type)(0),                        // 3: bytebase.store.ActivityIssueApprovalNotifyPayload.Type
+	(*ActivityIssueCreatePayload)(nil),                                  // 4: bytebase.store.ActivityIssueCreatePayload
+	(*ActivityIssueCommentCreatePayload)(nil),                           // 5: bytebase.store.ActivityIssueCommentCreatePayload
+	(*ActivityIssueApprovalNotifyPayload)(nil),                          // 6: bytebase.store.ActivityIssueApprovalNotifyPayload
+	(*ActivityIssueCommentCreatePayload_TaskRollbackBy)(nil),            // 7: bytebase.store.ActivityIssueCommentCreatePayload.TaskRollbackBy
+	(*ActivityIssueCommentCreatePayload_ExternalApprovalEvent)(nil),     // 8: bytebase.store.ActivityIssueCommentCreatePayload.ExternalApprovalEvent
+	(*ActivityIssueCommentCreatePayload_ApprovalEvent)(nil),             // 9: bytebase.store.ActivityIssueCommentCreatePayload.ApprovalEvent
+	(*ApprovalStep)(nil), // 10: bytebase.store.ApprovalStep
 }
 var file_store_activity_proto_depIdxs = []int32{
-	7, // 0: bytebase.store.ActivityIssueCommentCreatePayload.external_approval_event:type_name -> bytebase.store.ActivityIssueCommentCreatePayload.ExternalApprovalEvent
-	6, // 1: bytebase.store.ActivityIssueCommentCreatePayload.task_rollback_by:type_name -> bytebase.store.ActivityIssueCommentCreatePayload.TaskRollbackBy
-	8, // 2: bytebase.store.ActivityIssueCommentCreatePayload.approval_event:type_name -> bytebase.store.ActivityIssueCommentCreatePayload.ApprovalEvent
-	9, // 3: bytebase.store.ActivityIssueApprovalNotifyPayload.approval_step:type_name -> bytebase.store.ApprovalStep
-	0, // 4: bytebase.store.ActivityIssueCommentCreatePayload.ExternalApprovalEvent.type:type_name -> bytebase.store.ActivityIssueCommentCreatePayload.ExternalApprovalEvent.Type
-	1, // 5: bytebase.store.ActivityIssueCommentCreatePayload.ExternalApprovalEvent.action:type_name -> bytebase.store.ActivityIssueCommentCreatePayload.ExternalApprovalEvent.Action
-	2, // 6: bytebase.store.ActivityIssueCommentCreatePayload.ApprovalEvent.status:type_name -> bytebase.store.ActivityIssueCommentCreatePayload.ApprovalEvent.Status
-	7, // [7:7] is the sub-list for method output_type
-	7, // [7:7] is the sub-list for method input_type
-	7, // [7:7] is the sub-list for extension type_name
-	7, // [7:7] is the sub-list for extension extendee
-	0, // [0:7] is the sub-list for field type_name
+	8,  // 0: bytebase.store.ActivityIssueCommentCreatePayload.external_approval_event:type_name -> bytebase.store.ActivityIssueCommentCreatePayload.ExternalApprovalEvent
+	7,  // 1: bytebase.store.ActivityIssueCommentCreatePayload.task_rollback_by:type_name -> bytebase.store.ActivityIssueCommentCreatePayload.TaskRollbackBy
+	9,  // 2: bytebase.store.ActivityIssueCommentCreatePayload.approval_event:type_name -> bytebase.store.ActivityIssueCommentCreatePayload.ApprovalEvent
+	10, // 3: bytebase.store.ActivityIssueApprovalNotifyPayload.approval_step:type_name -> bytebase.store.ApprovalStep
+	3,  // 4: bytebase.store.ActivityIssueApprovalNotifyPayload.type:type_name -> bytebase.store.ActivityIssueApprovalNotifyPayload.Type
+	0,  // 5: bytebase.store.ActivityIssueCommentCreatePayload.ExternalApprovalEvent.type:type_name -> bytebase.store.ActivityIssueCommentCreatePayload.ExternalApprovalEvent.Type
+	1,  // 6: bytebase.store.ActivityIssueCommentCreatePayload.ExternalApprovalEvent.action:type_name -> bytebase.store.ActivityIssueCommentCreatePayload.ExternalApprovalEvent.Action
+	2,  // 7: bytebase.store.ActivityIssueCommentCreatePayload.ApprovalEvent.status:type_name -> bytebase.store.ActivityIssueCommentCreatePayload.ApprovalEvent.Status
+	8,  // [8:8] is the sub-list for method output_type
+	8,  // [8:8] is the sub-list for method input_type
+	8,  // [8:8] is the sub-list for extension type_name
+	8,  // [8:8] is the sub-list for extension extendee
+	0,  // [0:8] is the sub-list for field type_name
 }
 
 func init() { file_store_activity_proto_init() }
@@ -784,7 +856,7 @@ func file_store_activity_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_store_activity_proto_rawDesc,
-			NumEnums:      3,
+			NumEnums:      4,
 			NumMessages:   6,
 			NumExtensions: 0,
 			NumServices:   0,
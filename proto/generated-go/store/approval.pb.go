@@ -9,6 +9,8 @@ package store
 import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 )
@@ -72,8 +74,59 @@ func (IssuePayloadApproval_Approver_Status) EnumDescriptor() ([]byte, []int) {
 	return file_store_approval_proto_rawDescGZIP(), []int{0, 0, 0}
 }
 
+type ApprovalSLA_ExpirationAction int32
+
+const (
+	ApprovalSLA_EXPIRATION_ACTION_UNSPECIFIED ApprovalSLA_ExpirationAction = 0
+	// The system bot rejects the step.
+	ApprovalSLA_REJECT ApprovalSLA_ExpirationAction = 1
+	// The issue is canceled.
+	ApprovalSLA_CANCEL ApprovalSLA_ExpirationAction = 2
+)
+
+// Enum value maps for ApprovalSLA_ExpirationAction.
+var (
+	ApprovalSLA_ExpirationAction_name = map[int32]string{
+		0: "EXPIRATION_ACTION_UNSPECIFIED",
+		1: "REJECT",
+		2: "CANCEL",
+	}
+	ApprovalSLA_ExpirationAction_value = map[string]int32{
+		"EXPIRATION_ACTION_UNSPECIFIED": 0,
+		"REJECT":                        1,
+		"CANCEL":                        2,
+	}
+)
+
+func (x ApprovalSLA_ExpirationAction) Enum() *ApprovalSLA_ExpirationAction {
+	p := new(ApprovalSLA_ExpirationAction)
+	*p = x
+	return p
+}
+
+func (x ApprovalSLA_ExpirationAction) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ApprovalSLA_ExpirationAction) Descriptor() protoreflect.EnumDescriptor {
+	return file_store_approval_proto_enumTypes[1].Descriptor()
+}
+
+func (ApprovalSLA_ExpirationAction) Type() protoreflect.EnumType {
+	return &file_store_approval_proto_enumTypes[1]
+}
+
+func (x ApprovalSLA_ExpirationAction) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ApprovalSLA_ExpirationAction.Descriptor instead.
+func (ApprovalSLA_ExpirationAction) EnumDescriptor() ([]byte, []int) {
+	return file_store_approval_proto_rawDescGZIP(), []int{2, 0}
+}
+
 // Type of the ApprovalStep
-// ALL means every node must be approved to proceed.
+// ALL means every node must be approved by a different user to proceed.
 // ANY means approving any node will proceed.
 type ApprovalStep_Type int32
 
@@ -108,11 +161,11 @@ func (x ApprovalStep_Type) String() string {
 }
 
 func (ApprovalStep_Type) Descriptor() protoreflect.EnumDescriptor {
-	return file_store_approval_proto_enumTypes[1].Descriptor()
+	return file_store_approval_proto_enumTypes[2].Descriptor()
 }
 
 func (ApprovalStep_Type) Type() protoreflect.EnumType {
-	return &file_store_approval_proto_enumTypes[1]
+	return &file_store_approval_proto_enumTypes[2]
 }
 
 func (x ApprovalStep_Type) Number() protoreflect.EnumNumber {
@@ -121,12 +174,13 @@ func (x ApprovalStep_Type) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use ApprovalStep_Type.Descriptor instead.
 func (ApprovalStep_Type) EnumDescriptor() ([]byte, []int) {
-	return file_store_approval_proto_rawDescGZIP(), []int{3, 0}
+	return file_store_approval_proto_rawDescGZIP(), []int{4, 0}
 }
 
 // Type of the ApprovalNode.
 // type determines who should approve this node.
-// ANY_IN_GROUP means the ApprovalNode can be approved by an user from our predefined user group.
+// ANY_IN_GROUP means the ApprovalNode can be approved by an user from our predefined user group,
+// an user with the project role, or the named user.
 // See GroupValue below for the predefined user groups.
 type ApprovalNode_Type int32
 
@@ -158,11 +212,11 @@ func (x ApprovalNode_Type) String() string {
 }
 
 func (ApprovalNode_Type) Descriptor() protoreflect.EnumDescriptor {
-	return file_store_approval_proto_enumTypes[2].Descriptor()
+	return file_store_approval_proto_enumTypes[3].Descriptor()
 }
 
 func (ApprovalNode_Type) Type() protoreflect.EnumType {
-	return &file_store_approval_proto_enumTypes[2]
+	return &file_store_approval_proto_enumTypes[3]
 }
 
 func (x ApprovalNode_Type) Number() protoreflect.EnumNumber {
@@ -171,7 +225,7 @@ func (x ApprovalNode_Type) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use ApprovalNode_Type.Descriptor instead.
 func (ApprovalNode_Type) EnumDescriptor() ([]byte, []int) {
-	return file_store_approval_proto_rawDescGZIP(), []int{4, 0}
+	return file_store_approval_proto_rawDescGZIP(), []int{5, 0}
 }
 
 // The predefined user groups are:
@@ -218,11 +272,11 @@ func (x ApprovalNode_GroupValue) String() string {
 }
 
 func (ApprovalNode_GroupValue) Descriptor() protoreflect.EnumDescriptor {
-	return file_store_approval_proto_enumTypes[3].Descriptor()
+	return file_store_approval_proto_enumTypes[4].Descriptor()
 }
 
 func (ApprovalNode_GroupValue) Type() protoreflect.EnumType {
-	return &file_store_approval_proto_enumTypes[3]
+	return &file_store_approval_proto_enumTypes[4]
 }
 
 func (x ApprovalNode_GroupValue) Number() protoreflect.EnumNumber {
@@ -231,7 +285,7 @@ func (x ApprovalNode_GroupValue) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use ApprovalNode_GroupValue.Descriptor instead.
 func (ApprovalNode_GroupValue) EnumDescriptor() ([]byte, []int) {
-	return file_store_approval_proto_rawDescGZIP(), []int{4, 1}
+	return file_store_approval_proto_rawDescGZIP(), []int{5, 1}
 }
 
 // IssuePayloadApproval is a part of the payload of an issue.
@@ -245,8 +299,9 @@ type IssuePayloadApproval struct {
 	Approvers         []*IssuePayloadApproval_Approver `protobuf:"bytes,2,rep,name=approvers,proto3" json:"approvers,omitempty"`
 	// If the value is `false`, it means that the backend is still finding matching approval templates.
 	// If `true`, other fields are available.
-	ApprovalFindingDone  bool   `protobuf:"varint,3,opt,name=approval_finding_done,json=approvalFindingDone,proto3" json:"approval_finding_done,omitempty"`
-	ApprovalFindingError string `protobuf:"bytes,4,opt,name=approval_finding_error,json=approvalFindingError,proto3" json:"approval_finding_error,omitempty"`
+	ApprovalFindingDone  bool                           `protobuf:"varint,3,opt,name=approval_finding_done,json=approvalFindingDone,proto3" json:"approval_finding_done,omitempty"`
+	ApprovalFindingError string                         `protobuf:"bytes,4,opt,name=approval_finding_error,json=approvalFindingError,proto3" json:"approval_finding_error,omitempty"`
+	SlaState             *IssuePayloadApproval_SLAState `protobuf:"bytes,5,opt,name=sla_state,json=slaState,proto3" json:"sla_state,omitempty"`
 }
 
 func (x *IssuePayloadApproval) Reset() {
@@ -309,6 +364,13 @@ func (x *IssuePayloadApproval) GetApprovalFindingError() string {
 	return ""
 }
 
+func (x *IssuePayloadApproval) GetSlaState() *IssuePayloadApproval_SLAState {
+	if x != nil {
+		return x.SlaState
+	}
+	return nil
+}
+
 type ApprovalTemplate struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -318,6 +380,7 @@ type ApprovalTemplate struct {
 	Title       string        `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
 	Description string        `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
 	CreatorId   int32         `protobuf:"varint,4,opt,name=creator_id,json=creatorId,proto3" json:"creator_id,omitempty"`
+	Sla         *ApprovalSLA  `protobuf:"bytes,5,opt,name=sla,proto3" json:"sla,omitempty"`
 }
 
 func (x *ApprovalTemplate) Reset() {
@@ -380,6 +443,98 @@ func (x *ApprovalTemplate) GetCreatorId() int32 {
 	return 0
 }
 
+func (x *ApprovalTemplate) GetSla() *ApprovalSLA {
+	if x != nil {
+		return x.Sla
+	}
+	return nil
+}
+
+// ApprovalSLA is the service level agreement of every step in the approval flow.
+// The durations count from the time when the step becomes pending, and zero durations are disabled.
+type ApprovalSLA struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The approvers of the pending step are reminded after the interval, and then every interval.
+	ReminderInterval *durationpb.Duration `protobuf:"bytes,1,opt,name=reminder_interval,json=reminderInterval,proto3" json:"reminder_interval,omitempty"`
+	// The pending step is escalated to the escalation node after the timeout.
+	// The users of the escalation node can approve or reject the step on behalf of the step nodes.
+	EscalationTimeout *durationpb.Duration `protobuf:"bytes,2,opt,name=escalation_timeout,json=escalationTimeout,proto3" json:"escalation_timeout,omitempty"`
+	EscalationNode    *ApprovalNode        `protobuf:"bytes,3,opt,name=escalation_node,json=escalationNode,proto3" json:"escalation_node,omitempty"`
+	// The pending step expires after the timeout.
+	ExpirationTimeout *durationpb.Duration         `protobuf:"bytes,4,opt,name=expiration_timeout,json=expirationTimeout,proto3" json:"expiration_timeout,omitempty"`
+	ExpirationAction  ApprovalSLA_ExpirationAction `protobuf:"varint,5,opt,name=expiration_action,json=expirationAction,proto3,enum=bytebase.store.ApprovalSLA_ExpirationAction" json:"expiration_action,omitempty"`
+}
+
+func (x *ApprovalSLA) Reset() {
+	*x = ApprovalSLA{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_store_approval_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ApprovalSLA) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApprovalSLA) ProtoMessage() {}
+
+func (x *ApprovalSLA) ProtoReflect() protoreflect.Message {
+	mi := &file_store_approval_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApprovalSLA.ProtoReflect.Descriptor instead.
+func (*ApprovalSLA) Descriptor() ([]byte, []int) {
+	return file_store_approval_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ApprovalSLA) GetReminderInterval() *durationpb.Duration {
+	if x != nil {
+		return x.ReminderInterval
+	}
+	return nil
+}
+
+func (x *ApprovalSLA) GetEscalationTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.EscalationTimeout
+	}
+	return nil
+}
+
+func (x *ApprovalSLA) GetEscalationNode() *ApprovalNode {
+	if x != nil {
+		return x.EscalationNode
+	}
+	return nil
+}
+
+func (x *ApprovalSLA) GetExpirationTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.ExpirationTimeout
+	}
+	return nil
+}
+
+func (x *ApprovalSLA) GetExpirationAction() ApprovalSLA_ExpirationAction {
+	if x != nil {
+		return x.ExpirationAction
+	}
+	return ApprovalSLA_EXPIRATION_ACTION_UNSPECIFIED
+}
+
 type ApprovalFlow struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -391,7 +546,7 @@ type ApprovalFlow struct {
 func (x *ApprovalFlow) Reset() {
 	*x = ApprovalFlow{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_store_approval_proto_msgTypes[2]
+		mi := &file_store_approval_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -404,7 +559,7 @@ func (x *ApprovalFlow) String() string {
 func (*ApprovalFlow) ProtoMessage() {}
 
 func (x *ApprovalFlow) ProtoReflect() protoreflect.Message {
-	mi := &file_store_approval_proto_msgTypes[2]
+	mi := &file_store_approval_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -417,7 +572,7 @@ func (x *ApprovalFlow) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ApprovalFlow.ProtoReflect.Descriptor instead.
 func (*ApprovalFlow) Descriptor() ([]byte, []int) {
-	return file_store_approval_proto_rawDescGZIP(), []int{2}
+	return file_store_approval_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *ApprovalFlow) GetSteps() []*ApprovalStep {
@@ -434,12 +589,17 @@ type ApprovalStep struct {
 
 	Type  ApprovalStep_Type `protobuf:"varint,1,opt,name=type,proto3,enum=bytebase.store.ApprovalStep_Type" json:"type,omitempty"`
 	Nodes []*ApprovalNode   `protobuf:"bytes,2,rep,name=nodes,proto3" json:"nodes,omitempty"`
+	// The number of approvals from different users required by the ANY step.
+	// Zero means one approval is required.
+	Quorum int32 `protobuf:"varint,3,opt,name=quorum,proto3" json:"quorum,omitempty"`
+	// The issue creator cannot approve the step.
+	ForbidSelfApproval bool `protobuf:"varint,4,opt,name=forbid_self_approval,json=forbidSelfApproval,proto3" json:"forbid_self_approval,omitempty"`
 }
 
 func (x *ApprovalStep) Reset() {
 	*x = ApprovalStep{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_store_approval_proto_msgTypes[3]
+		mi := &file_store_approval_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -452,7 +612,7 @@ func (x *ApprovalStep) String() string {
 func (*ApprovalStep) ProtoMessage() {}
 
 func (x *ApprovalStep) ProtoReflect() protoreflect.Message {
-	mi := &file_store_approval_proto_msgTypes[3]
+	mi := &file_store_approval_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -465,7 +625,7 @@ func (x *ApprovalStep) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ApprovalStep.ProtoReflect.Descriptor instead.
 func (*ApprovalStep) Descriptor() ([]byte, []int) {
-	return file_store_approval_proto_rawDescGZIP(), []int{3}
+	return file_store_approval_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *ApprovalStep) GetType() ApprovalStep_Type {
@@ -482,6 +642,20 @@ func (x *ApprovalStep) GetNodes() []*ApprovalNode {
 	return nil
 }
 
+func (x *ApprovalStep) GetQuorum() int32 {
+	if x != nil {
+		return x.Quorum
+	}
+	return 0
+}
+
+func (x *ApprovalStep) GetForbidSelfApproval() bool {
+	if x != nil {
+		return x.ForbidSelfApproval
+	}
+	return false
+}
+
 type ApprovalNode struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -493,13 +667,14 @@ type ApprovalNode struct {
 	//	*ApprovalNode_GroupValue_
 	//	*ApprovalNode_Role
 	//	*ApprovalNode_ExternalNodeId
+	//	*ApprovalNode_User
 	Payload isApprovalNode_Payload `protobuf_oneof:"payload"`
 }
 
 func (x *ApprovalNode) Reset() {
 	*x = ApprovalNode{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_store_approval_proto_msgTypes[4]
+		mi := &file_store_approval_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -512,7 +687,7 @@ func (x *ApprovalNode) String() string {
 func (*ApprovalNode) ProtoMessage() {}
 
 func (x *ApprovalNode) ProtoReflect() protoreflect.Message {
-	mi := &file_store_approval_proto_msgTypes[4]
+	mi := &file_store_approval_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -525,7 +700,7 @@ func (x *ApprovalNode) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ApprovalNode.ProtoReflect.Descriptor instead.
 func (*ApprovalNode) Descriptor() ([]byte, []int) {
-	return file_store_approval_proto_rawDescGZIP(), []int{4}
+	return file_store_approval_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *ApprovalNode) GetType() ApprovalNode_Type {
@@ -563,6 +738,13 @@ func (x *ApprovalNode) GetExternalNodeId() string {
 	return ""
 }
 
+func (x *ApprovalNode) GetUser() string {
+	if x, ok := x.GetPayload().(*ApprovalNode_User); ok {
+		return x.User
+	}
+	return ""
+}
+
 type isApprovalNode_Payload interface {
 	isApprovalNode_Payload()
 }
@@ -580,12 +762,19 @@ type ApprovalNode_ExternalNodeId struct {
 	ExternalNodeId string `protobuf:"bytes,4,opt,name=external_node_id,json=externalNodeId,proto3,oneof"`
 }
 
+type ApprovalNode_User struct {
+	// Format: users/{email}
+	User string `protobuf:"bytes,5,opt,name=user,proto3,oneof"`
+}
+
 func (*ApprovalNode_GroupValue_) isApprovalNode_Payload() {}
 
 func (*ApprovalNode_Role) isApprovalNode_Payload() {}
 
 func (*ApprovalNode_ExternalNodeId) isApprovalNode_Payload() {}
 
+func (*ApprovalNode_User) isApprovalNode_Payload() {}
+
 type IssuePayloadApproval_Approver struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -595,12 +784,15 @@ type IssuePayloadApproval_Approver struct {
 	Status IssuePayloadApproval_Approver_Status `protobuf:"varint,1,opt,name=status,proto3,enum=bytebase.store.IssuePayloadApproval_Approver_Status" json:"status,omitempty"`
 	// The principal id of the approver.
 	PrincipalId int32 `protobuf:"varint,2,opt,name=principal_id,json=principalId,proto3" json:"principal_id,omitempty"`
+	// The index of the approved node in the step.
+	// The approvers are in order, and each step takes the approvers until it's approved or rejected.
+	NodeIndex int32 `protobuf:"varint,3,opt,name=node_index,json=nodeIndex,proto3" json:"node_index,omitempty"`
 }
 
 func (x *IssuePayloadApproval_Approver) Reset() {
 	*x = IssuePayloadApproval_Approver{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_store_approval_proto_msgTypes[5]
+		mi := &file_store_approval_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -613,7 +805,7 @@ func (x *IssuePayloadApproval_Approver) String() string {
 func (*IssuePayloadApproval_Approver) ProtoMessage() {}
 
 func (x *IssuePayloadApproval_Approver) ProtoReflect() protoreflect.Message {
-	mi := &file_store_approval_proto_msgTypes[5]
+	mi := &file_store_approval_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -643,94 +835,240 @@ func (x *IssuePayloadApproval_Approver) GetPrincipalId() int32 {
 	return 0
 }
 
+func (x *IssuePayloadApproval_Approver) GetNodeIndex() int32 {
+	if x != nil {
+		return x.NodeIndex
+	}
+	return 0
+}
+
+// SLAState tracks the SLA of the pending step.
+type IssuePayloadApproval_SLAState struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The index of the pending step.
+	StepIndex int32 `protobuf:"varint,1,opt,name=step_index,json=stepIndex,proto3" json:"step_index,omitempty"`
+	// The time when the step is found pending.
+	PendingTime *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=pending_time,json=pendingTime,proto3" json:"pending_time,omitempty"`
+	// The time when the approvers are reminded last time.
+	LastReminderTime *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=last_reminder_time,json=lastReminderTime,proto3" json:"last_reminder_time,omitempty"`
+	// Whether the step is escalated to the escalation node.
+	Escalated bool `protobuf:"varint,4,opt,name=escalated,proto3" json:"escalated,omitempty"`
+}
+
+func (x *IssuePayloadApproval_SLAState) Reset() {
+	*x = IssuePayloadApproval_SLAState{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_store_approval_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IssuePayloadApproval_SLAState) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IssuePayloadApproval_SLAState) ProtoMessage() {}
+
+func (x *IssuePayloadApproval_SLAState) ProtoReflect() protoreflect.Message {
+	mi := &file_store_approval_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IssuePayloadApproval_SLAState.ProtoReflect.Descriptor instead.
+func (*IssuePayloadApproval_SLAState) Descriptor() ([]byte, []int) {
+	return file_store_approval_proto_rawDescGZIP(), []int{0, 1}
+}
+
+func (x *IssuePayloadApproval_SLAState) GetStepIndex() int32 {
+	if x != nil {
+		return x.StepIndex
+	}
+	return 0
+}
+
+func (x *IssuePayloadApproval_SLAState) GetPendingTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PendingTime
+	}
+	return nil
+}
+
+func (x *IssuePayloadApproval_SLAState) GetLastReminderTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastReminderTime
+	}
+	return nil
+}
+
+func (x *IssuePayloadApproval_SLAState) GetEscalated() bool {
+	if x != nil {
+		return x.Escalated
+	}
+	return false
+}
+
 var File_store_approval_proto protoreflect.FileDescriptor
 
 var file_store_approval_proto_rawDesc = []byte{
 	0x0a, 0x14, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2f, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c,
 	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65,
-	0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x22, 0xe7, 0x03, 0x0a, 0x14, 0x49, 0x73, 0x73, 0x75, 0x65,
-	0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x12,
-	0x4f, 0x0a, 0x12, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x5f, 0x74, 0x65, 0x6d, 0x70,
-	0x6c, 0x61, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x62, 0x79,
-	0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x41, 0x70, 0x70,
-	0x72, 0x6f, 0x76, 0x61, 0x6c, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x11, 0x61,
-	0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x73,
-	0x12, 0x4b, 0x0a, 0x09, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x73,
-	0x74, 0x6f, 0x72, 0x65, 0x2e, 0x49, 0x73, 0x73, 0x75, 0x65, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61,
-	0x64, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x2e, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76,
-	0x65, 0x72, 0x52, 0x09, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x72, 0x73, 0x12, 0x32, 0x0a,
-	0x15, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x5f, 0x66, 0x69, 0x6e, 0x64, 0x69, 0x6e,
-	0x67, 0x5f, 0x64, 0x6f, 0x6e, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x13, 0x61, 0x70,
-	0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x46, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x44, 0x6f, 0x6e,
-	0x65, 0x12, 0x34, 0x0a, 0x16, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x5f, 0x66, 0x69,
-	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x14, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x46, 0x69, 0x6e, 0x64, 0x69,
-	0x6e, 0x67, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x1a, 0xc6, 0x01, 0x0a, 0x08, 0x41, 0x70, 0x70, 0x72,
-	0x6f, 0x76, 0x65, 0x72, 0x12, 0x4c, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0e, 0x32, 0x34, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e,
+	0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xa5, 0x06, 0x0a, 0x14, 0x49, 0x73, 0x73, 0x75,
+	0x65, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c,
+	0x12, 0x4f, 0x0a, 0x12, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x5f, 0x74, 0x65, 0x6d,
+	0x70, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x62,
+	0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x41, 0x70,
+	0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x11,
+	0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65,
+	0x73, 0x12, 0x4b, 0x0a, 0x09, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x72, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e,
 	0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x49, 0x73, 0x73, 0x75, 0x65, 0x50, 0x61, 0x79, 0x6c, 0x6f,
 	0x61, 0x64, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x2e, 0x41, 0x70, 0x70, 0x72, 0x6f,
-	0x76, 0x65, 0x72, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x72, 0x69, 0x6e, 0x63, 0x69, 0x70, 0x61, 0x6c, 0x5f,
-	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x70, 0x72, 0x69, 0x6e, 0x63, 0x69,
-	0x70, 0x61, 0x6c, 0x49, 0x64, 0x22, 0x49, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
-	0x16, 0x0a, 0x12, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43,
-	0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x50, 0x45, 0x4e, 0x44, 0x49,
-	0x4e, 0x47, 0x10, 0x01, 0x12, 0x0c, 0x0a, 0x08, 0x41, 0x50, 0x50, 0x52, 0x4f, 0x56, 0x45, 0x44,
-	0x10, 0x02, 0x12, 0x0c, 0x0a, 0x08, 0x52, 0x45, 0x4a, 0x45, 0x43, 0x54, 0x45, 0x44, 0x10, 0x03,
-	0x22, 0x9b, 0x01, 0x0a, 0x10, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x54, 0x65, 0x6d,
-	0x70, 0x6c, 0x61, 0x74, 0x65, 0x12, 0x30, 0x0a, 0x04, 0x66, 0x6c, 0x6f, 0x77, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x73,
-	0x74, 0x6f, 0x72, 0x65, 0x2e, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x46, 0x6c, 0x6f,
-	0x77, 0x52, 0x04, 0x66, 0x6c, 0x6f, 0x77, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x20, 0x0a,
-	0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12,
-	0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x49, 0x64, 0x22, 0x42,
-	0x0a, 0x0c, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x46, 0x6c, 0x6f, 0x77, 0x12, 0x32,
-	0x0a, 0x05, 0x73, 0x74, 0x65, 0x70, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e,
-	0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x41,
-	0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x53, 0x74, 0x65, 0x70, 0x52, 0x05, 0x73, 0x74, 0x65,
-	0x70, 0x73, 0x22, 0xa9, 0x01, 0x0a, 0x0c, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x53,
-	0x74, 0x65, 0x70, 0x12, 0x35, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0e, 0x32, 0x21, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x73, 0x74, 0x6f,
-	0x72, 0x65, 0x2e, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x53, 0x74, 0x65, 0x70, 0x2e,
-	0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x32, 0x0a, 0x05, 0x6e, 0x6f,
-	0x64, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x62, 0x79, 0x74, 0x65,
-	0x62, 0x61, 0x73, 0x65, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x41, 0x70, 0x70, 0x72, 0x6f,
-	0x76, 0x61, 0x6c, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x05, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x22, 0x2e,
-	0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x10, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55,
-	0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x07, 0x0a, 0x03,
-	0x41, 0x4c, 0x4c, 0x10, 0x01, 0x12, 0x07, 0x0a, 0x03, 0x41, 0x4e, 0x59, 0x10, 0x02, 0x22, 0x89,
-	0x03, 0x0a, 0x0c, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x4e, 0x6f, 0x64, 0x65, 0x12,
-	0x35, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x21, 0x2e,
-	0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x41,
-	0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x4e, 0x6f, 0x64, 0x65, 0x2e, 0x54, 0x79, 0x70, 0x65,
-	0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x4a, 0x0a, 0x0b, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f,
-	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x27, 0x2e, 0x62, 0x79,
-	0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x41, 0x70, 0x70,
-	0x72, 0x6f, 0x76, 0x61, 0x6c, 0x4e, 0x6f, 0x64, 0x65, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x56,
-	0x61, 0x6c, 0x75, 0x65, 0x48, 0x00, 0x52, 0x0a, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x56, 0x61, 0x6c,
-	0x75, 0x65, 0x12, 0x14, 0x0a, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
-	0x48, 0x00, 0x52, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x12, 0x2a, 0x0a, 0x10, 0x65, 0x78, 0x74, 0x65,
-	0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x09, 0x48, 0x00, 0x52, 0x0e, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x4e, 0x6f,
-	0x64, 0x65, 0x49, 0x64, 0x22, 0x2e, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x10,
-	0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44,
-	0x10, 0x00, 0x12, 0x10, 0x0a, 0x0c, 0x41, 0x4e, 0x59, 0x5f, 0x49, 0x4e, 0x5f, 0x47, 0x52, 0x4f,
-	0x55, 0x50, 0x10, 0x01, 0x22, 0x79, 0x0a, 0x0a, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x56, 0x61, 0x6c,
-	0x75, 0x65, 0x12, 0x1c, 0x0a, 0x18, 0x47, 0x52, 0x4f, 0x55, 0x50, 0x5f, 0x56, 0x41, 0x4c, 0x55,
-	0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x00,
-	0x12, 0x13, 0x0a, 0x0f, 0x57, 0x4f, 0x52, 0x4b, 0x53, 0x50, 0x41, 0x43, 0x45, 0x5f, 0x4f, 0x57,
-	0x4e, 0x45, 0x52, 0x10, 0x01, 0x12, 0x11, 0x0a, 0x0d, 0x57, 0x4f, 0x52, 0x4b, 0x53, 0x50, 0x41,
-	0x43, 0x45, 0x5f, 0x44, 0x42, 0x41, 0x10, 0x02, 0x12, 0x11, 0x0a, 0x0d, 0x50, 0x52, 0x4f, 0x4a,
-	0x45, 0x43, 0x54, 0x5f, 0x4f, 0x57, 0x4e, 0x45, 0x52, 0x10, 0x03, 0x12, 0x12, 0x0a, 0x0e, 0x50,
-	0x52, 0x4f, 0x4a, 0x45, 0x43, 0x54, 0x5f, 0x4d, 0x45, 0x4d, 0x42, 0x45, 0x52, 0x10, 0x04, 0x42,
-	0x09, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x42, 0x14, 0x5a, 0x12, 0x67, 0x65,
-	0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x64, 0x2d, 0x67, 0x6f, 0x2f, 0x73, 0x74, 0x6f, 0x72, 0x65,
-	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x76, 0x65, 0x72, 0x52, 0x09, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x72, 0x73, 0x12, 0x32,
+	0x0a, 0x15, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x5f, 0x66, 0x69, 0x6e, 0x64, 0x69,
+	0x6e, 0x67, 0x5f, 0x64, 0x6f, 0x6e, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x13, 0x61,
+	0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x46, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x44, 0x6f,
+	0x6e, 0x65, 0x12, 0x34, 0x0a, 0x16, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x5f, 0x66,
+	0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x14, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x46, 0x69, 0x6e, 0x64,
+	0x69, 0x6e, 0x67, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x4a, 0x0a, 0x09, 0x73, 0x6c, 0x61, 0x5f,
+	0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x62, 0x79,
+	0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x49, 0x73, 0x73,
+	0x75, 0x65, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61,
+	0x6c, 0x2e, 0x53, 0x4c, 0x41, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x08, 0x73, 0x6c, 0x61, 0x53,
+	0x74, 0x61, 0x74, 0x65, 0x1a, 0xe5, 0x01, 0x0a, 0x08, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65,
+	0x72, 0x12, 0x4c, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x34, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x73, 0x74, 0x6f,
+	0x72, 0x65, 0x2e, 0x49, 0x73, 0x73, 0x75, 0x65, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x41,
+	0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x2e, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x72,
+	0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
+	0x21, 0x0a, 0x0c, 0x70, 0x72, 0x69, 0x6e, 0x63, 0x69, 0x70, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x70, 0x72, 0x69, 0x6e, 0x63, 0x69, 0x70, 0x61, 0x6c,
+	0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x6e, 0x6f, 0x64, 0x65, 0x49, 0x6e, 0x64, 0x65,
+	0x78, 0x22, 0x49, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x0a, 0x12, 0x53,
+	0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45,
+	0x44, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x10, 0x01,
+	0x12, 0x0c, 0x0a, 0x08, 0x41, 0x50, 0x50, 0x52, 0x4f, 0x56, 0x45, 0x44, 0x10, 0x02, 0x12, 0x0c,
+	0x0a, 0x08, 0x52, 0x45, 0x4a, 0x45, 0x43, 0x54, 0x45, 0x44, 0x10, 0x03, 0x1a, 0xd0, 0x01, 0x0a,
+	0x08, 0x53, 0x4c, 0x41, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x65,
+	0x70, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x73,
+	0x74, 0x65, 0x70, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x3d, 0x0a, 0x0c, 0x70, 0x65, 0x6e, 0x64,
+	0x69, 0x6e, 0x67, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b, 0x70, 0x65, 0x6e, 0x64,
+	0x69, 0x6e, 0x67, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x48, 0x0a, 0x12, 0x6c, 0x61, 0x73, 0x74, 0x5f,
+	0x72, 0x65, 0x6d, 0x69, 0x6e, 0x64, 0x65, 0x72, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x10, 0x6c, 0x61, 0x73, 0x74, 0x52, 0x65, 0x6d, 0x69, 0x6e, 0x64, 0x65, 0x72, 0x54, 0x69, 0x6d,
+	0x65, 0x12, 0x1c, 0x0a, 0x09, 0x65, 0x73, 0x63, 0x61, 0x6c, 0x61, 0x74, 0x65, 0x64, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x65, 0x73, 0x63, 0x61, 0x6c, 0x61, 0x74, 0x65, 0x64, 0x22,
+	0xca, 0x01, 0x0a, 0x10, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x54, 0x65, 0x6d, 0x70,
+	0x6c, 0x61, 0x74, 0x65, 0x12, 0x30, 0x0a, 0x04, 0x66, 0x6c, 0x6f, 0x77, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x73, 0x74,
+	0x6f, 0x72, 0x65, 0x2e, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x46, 0x6c, 0x6f, 0x77,
+	0x52, 0x04, 0x66, 0x6c, 0x6f, 0x77, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x20, 0x0a, 0x0b,
+	0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1d,
+	0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x49, 0x64, 0x12, 0x2d, 0x0a,
+	0x03, 0x73, 0x6c, 0x61, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x62, 0x79, 0x74,
+	0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x41, 0x70, 0x70, 0x72,
+	0x6f, 0x76, 0x61, 0x6c, 0x53, 0x4c, 0x41, 0x52, 0x03, 0x73, 0x6c, 0x61, 0x22, 0xda, 0x03, 0x0a,
+	0x0b, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x53, 0x4c, 0x41, 0x12, 0x46, 0x0a, 0x11,
+	0x72, 0x65, 0x6d, 0x69, 0x6e, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61,
+	0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x10, 0x72, 0x65, 0x6d, 0x69, 0x6e, 0x64, 0x65, 0x72, 0x49, 0x6e, 0x74, 0x65,
+	0x72, 0x76, 0x61, 0x6c, 0x12, 0x48, 0x0a, 0x12, 0x65, 0x73, 0x63, 0x61, 0x6c, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x11, 0x65, 0x73, 0x63,
+	0x61, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x45,
+	0x0a, 0x0f, 0x65, 0x73, 0x63, 0x61, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6e, 0x6f, 0x64,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61,
+	0x73, 0x65, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61,
+	0x6c, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x0e, 0x65, 0x73, 0x63, 0x61, 0x6c, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x48, 0x0a, 0x12, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x11, 0x65, 0x78,
+	0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12,
+	0x59, 0x0a, 0x11, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2c, 0x2e, 0x62, 0x79, 0x74,
+	0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x41, 0x70, 0x70, 0x72,
+	0x6f, 0x76, 0x61, 0x6c, 0x53, 0x4c, 0x41, 0x2e, 0x45, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x10, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x4d, 0x0a, 0x10, 0x45, 0x78,
+	0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x21,
+	0x0a, 0x1d, 0x45, 0x58, 0x50, 0x49, 0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x41, 0x43, 0x54,
+	0x49, 0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10,
+	0x00, 0x12, 0x0a, 0x0a, 0x06, 0x52, 0x45, 0x4a, 0x45, 0x43, 0x54, 0x10, 0x01, 0x12, 0x0a, 0x0a,
+	0x06, 0x43, 0x41, 0x4e, 0x43, 0x45, 0x4c, 0x10, 0x02, 0x22, 0x42, 0x0a, 0x0c, 0x41, 0x70, 0x70,
+	0x72, 0x6f, 0x76, 0x61, 0x6c, 0x46, 0x6c, 0x6f, 0x77, 0x12, 0x32, 0x0a, 0x05, 0x73, 0x74, 0x65,
+	0x70, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62,
+	0x61, 0x73, 0x65, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76,
+	0x61, 0x6c, 0x53, 0x74, 0x65, 0x70, 0x52, 0x05, 0x73, 0x74, 0x65, 0x70, 0x73, 0x22, 0xf3, 0x01,
+	0x0a, 0x0c, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x53, 0x74, 0x65, 0x70, 0x12, 0x35,
+	0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x21, 0x2e, 0x62,
+	0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x41, 0x70,
+	0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x53, 0x74, 0x65, 0x70, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x52,
+	0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x32, 0x0a, 0x05, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e,
+	0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x4e, 0x6f,
+	0x64, 0x65, 0x52, 0x05, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x71, 0x75, 0x6f,
+	0x72, 0x75, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x71, 0x75, 0x6f, 0x72, 0x75,
+	0x6d, 0x12, 0x30, 0x0a, 0x14, 0x66, 0x6f, 0x72, 0x62, 0x69, 0x64, 0x5f, 0x73, 0x65, 0x6c, 0x66,
+	0x5f, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x12, 0x66, 0x6f, 0x72, 0x62, 0x69, 0x64, 0x53, 0x65, 0x6c, 0x66, 0x41, 0x70, 0x70, 0x72, 0x6f,
+	0x76, 0x61, 0x6c, 0x22, 0x2e, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x10, 0x54,
+	0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10,
+	0x00, 0x12, 0x07, 0x0a, 0x03, 0x41, 0x4c, 0x4c, 0x10, 0x01, 0x12, 0x07, 0x0a, 0x03, 0x41, 0x4e,
+	0x59, 0x10, 0x02, 0x22, 0x9f, 0x03, 0x0a, 0x0c, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c,
+	0x4e, 0x6f, 0x64, 0x65, 0x12, 0x35, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x21, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x73, 0x74,
+	0x6f, 0x72, 0x65, 0x2e, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x4e, 0x6f, 0x64, 0x65,
+	0x2e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x4a, 0x0a, 0x0b, 0x67,
+	0x72, 0x6f, 0x75, 0x70, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x27, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x73, 0x74, 0x6f, 0x72,
+	0x65, 0x2e, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x4e, 0x6f, 0x64, 0x65, 0x2e, 0x47,
+	0x72, 0x6f, 0x75, 0x70, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x48, 0x00, 0x52, 0x0a, 0x67, 0x72, 0x6f,
+	0x75, 0x70, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x14, 0x0a, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x12, 0x2a, 0x0a,
+	0x10, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x69,
+	0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0e, 0x65, 0x78, 0x74, 0x65, 0x72,
+	0x6e, 0x61, 0x6c, 0x4e, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x04, 0x75, 0x73, 0x65,
+	0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x22,
+	0x2e, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x10, 0x54, 0x59, 0x50, 0x45, 0x5f,
+	0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x10, 0x0a,
+	0x0c, 0x41, 0x4e, 0x59, 0x5f, 0x49, 0x4e, 0x5f, 0x47, 0x52, 0x4f, 0x55, 0x50, 0x10, 0x01, 0x22,
+	0x79, 0x0a, 0x0a, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x1c, 0x0a,
+	0x18, 0x47, 0x52, 0x4f, 0x55, 0x50, 0x5f, 0x56, 0x41, 0x4c, 0x55, 0x45, 0x5f, 0x55, 0x4e, 0x53,
+	0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x00, 0x12, 0x13, 0x0a, 0x0f, 0x57,
+	0x4f, 0x52, 0x4b, 0x53, 0x50, 0x41, 0x43, 0x45, 0x5f, 0x4f, 0x57, 0x4e, 0x45, 0x52, 0x10, 0x01,
+	0x12, 0x11, 0x0a, 0x0d, 0x57, 0x4f, 0x52, 0x4b, 0x53, 0x50, 0x41, 0x43, 0x45, 0x5f, 0x44, 0x42,
+	0x41, 0x10, 0x02, 0x12, 0x11, 0x0a, 0x0d, 0x50, 0x52, 0x4f, 0x4a, 0x45, 0x43, 0x54, 0x5f, 0x4f,
+	0x57, 0x4e, 0x45, 0x52, 0x10, 0x03, 0x12, 0x12, 0x0a, 0x0e, 0x50, 0x52, 0x4f, 0x4a, 0x45, 0x43,
+	0x54, 0x5f, 0x4d, 0x45, 0x4d, 0x42, 0x45, 0x52, 0x10, 0x04, 0x42, 0x09, 0x0a, 0x07, 0x70, 0x61,
+	0x79, 0x6c, 0x6f, 0x61, 0x64, 0x42, 0x14, 0x5a, 0x12, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74,
+	0x65, 0x64, 0x2d, 0x67, 0x6f, 0x2f, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x33,
 }
 
 var (
@@ -745,35 +1083,49 @@ func file_store_approval_proto_rawDescGZIP() []byte {
 	return file_store_approval_proto_rawDescData
 }
 
-var file_store_approval_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
-var file_store_approval_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_store_approval_proto_enumTypes = make([]protoimpl.EnumInfo, 5)
+var file_store_approval_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
 var file_store_approval_proto_goTypes = []interface{}{
 	(IssuePayloadApproval_Approver_Status)(0), // 0: bytebase.store.IssuePayloadApproval.Approver.Status
-	(ApprovalStep_Type)(0),                    // 1: bytebase.store.ApprovalStep.Type
-	(ApprovalNode_Type)(0),                    // 2: bytebase.store.ApprovalNode.Type
-	(ApprovalNode_GroupValue)(0),              // 3: bytebase.store.ApprovalNode.GroupValue
-	(*IssuePayloadApproval)(nil),              // 4: bytebase.store.IssuePayloadApproval
-	(*ApprovalTemplate)(nil),                  // 5: bytebase.store.ApprovalTemplate
-	(*ApprovalFlow)(nil),                      // 6: bytebase.store.ApprovalFlow
-	(*ApprovalStep)(nil),                      // 7: bytebase.store.ApprovalStep
-	(*ApprovalNode)(nil),                      // 8: bytebase.store.ApprovalNode
-	(*IssuePayloadApproval_Approver)(nil),     // 9: bytebase.store.IssuePayloadApproval.Approver
+	(ApprovalSLA_ExpirationAction)(0),         // 1: bytebase.store.ApprovalSLA.ExpirationAction
+	(ApprovalStep_Type)(0),                    // 2: bytebase.store.ApprovalStep.Type
+	(ApprovalNode_Type)(0),                    // 3: bytebase.store.ApprovalNode.Type
+	(ApprovalNode_GroupValue)(0),              // 4: bytebase.store.ApprovalNode.GroupValue
+	(*IssuePayloadApproval)(nil),              // 5: bytebase.store.IssuePayloadApproval
+	(*ApprovalTemplate)(nil),                  // 6: bytebase.store.ApprovalTemplate
+	(*ApprovalSLA)(nil),                       // 7: bytebase.store.ApprovalSLA
+	(*ApprovalFlow)(nil),                      // 8: bytebase.store.ApprovalFlow
+	(*ApprovalStep)(nil),                      // 9: bytebase.store.ApprovalStep
+	(*ApprovalNode)(nil),                      // 10: bytebase.store.ApprovalNode
+	(*IssuePayloadApproval_Approver)(nil),     // 11: bytebase.store.IssuePayloadApproval.Approver
+	(*IssuePayloadApproval_SLAState)(nil),     // 12: bytebase.store.IssuePayloadApproval.SLAState
+	(*durationpb.Duration)(nil),               // 13: google.protobuf.Duration
+	(*timestamppb.Timestamp)(nil),             // 14: google.protobuf.Timestamp
 }
 var file_store_approval_proto_depIdxs = []int32{
-	5, // 0: bytebase.store.IssuePayloadApproval.approval_templates:type_name -> bytebase.store.ApprovalTemplate
-	9, // 1: bytebase.store.IssuePayloadApproval.approvers:type_name -> bytebase.store.IssuePayloadApproval.Approver
-	6, // 2: bytebase.store.ApprovalTemplate.flow:type_name -> bytebase.store.ApprovalFlow
-	7, // 3: bytebase.store.ApprovalFlow.steps:type_name -> bytebase.store.ApprovalStep
-	1, // 4: bytebase.store.ApprovalStep.type:type_name -> bytebase.store.ApprovalStep.Type
-	8, // 5: bytebase.store.ApprovalStep.nodes:type_name -> bytebase.store.ApprovalNode
-	2, // 6: bytebase.store.ApprovalNode.type:type_name -> bytebase.store.ApprovalNode.Type
-	3, // 7: bytebase.store.ApprovalNode.group_value:type_name -> bytebase.store.ApprovalNode.GroupValue
-	0, // 8: bytebase.store.IssuePayloadApproval.Approver.status:type_name -> bytebase.store.IssuePayloadApproval.Approver.Status
-	9, // [9:9] is the sub-list for method output_type
-	9, // [9:9] is the sub-list for method input_type
-	9, // [9:9] is the sub-list for extension type_name
-	9, // [9:9] is the sub-list for extension extendee
-	0, // [0:9] is the sub-list for field type_name
+	6,  // 0: bytebase.store.IssuePayloadApproval.approval_templates:type_name -> bytebase.store.ApprovalTemplate
+	11, // 1: bytebase.store.IssuePayloadApproval.approvers:type_name -> bytebase.store.IssuePayloadApproval.Approver
+	12, // 2: bytebase.store.IssuePayloadApproval.sla_state:type_name -> bytebase.store.IssuePayloadApproval.SLAState
+	8,  // 3: bytebase.store.ApprovalTemplate.flow:type_name -> bytebase.store.ApprovalFlow
+	7,  // 4: bytebase.store.ApprovalTemplate.sla:type_name -> bytebase.store.ApprovalSLA
+	13, // 5: bytebase.store.ApprovalSLA.reminder_interval:type_name -> google.protobuf.Duration
+	13, // 6: bytebase.store.ApprovalSLA.escalation_timeout:type_name -> google.protobuf.Duration
+	10, // 7: bytebase.store.ApprovalSLA.escalation_node:type_name -> bytebase.store.ApprovalNode
+	13, // 8: bytebase.store.ApprovalSLA.expiration_timeout:type_name -> google.protobuf.Duration
+	1,  // 9: bytebase.store.ApprovalSLA.expiration_action:type_name -> bytebase.store.ApprovalSLA.ExpirationAction
+	9,  // 10: bytebase.store.ApprovalFlow.steps:type_name -> bytebase.store.ApprovalStep
+	2,  // 11: bytebase.store.ApprovalStep.type:type_name -> bytebase.store.ApprovalStep.Type
+	10, // 12: bytebase.store.ApprovalStep.nodes:type_name -> bytebase.store.ApprovalNode
+	3,  // 13: bytebase.store.ApprovalNode.type:type_name -> bytebase.store.ApprovalNode.Type
+	4,  // 14: bytebase.store.ApprovalNode.group_value:type_name -> bytebase.store.ApprovalNode.GroupValue
+	0,  // 15: bytebase.store.IssuePayloadApproval.Approver.status:type_name -> bytebase.store.IssuePayloadApproval.Approver.Status
+	14, // 16: bytebase.store.IssuePayloadApproval.SLAState.pending_time:type_name -> google.protobuf.Timestamp
+	14, // 17: bytebase.store.IssuePayloadApproval.SLAState.last_reminder_time:type_name -> google.protobuf.Timestamp
+	18, // [18:18] is the sub-list for method output_type
+	18, // [18:18] is the sub-list for method input_type
+	18, // [18:18] is the sub-list for extension type_name
+	18, // [18:18] is the sub-list for extension extendee
+	0,  // [0:18] is the sub-list for field type_name
 }
 
 func init() { file_store_approval_proto_init() }
@@ -807,7 +1159,7 @@ func file_store_approval_proto_init() {
 			}
 		}
 		file_store_approval_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ApprovalFlow); i {
+			switch v := v.(*ApprovalSLA); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -819,7 +1171,7 @@ func file_store_approval_proto_init() {
 			}
 		}
 		file_store_approval_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ApprovalStep); i {
+			switch v := v.(*ApprovalFlow); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -831,7 +1183,7 @@ func file_store_approval_proto_init() {
 			}
 		}
 		file_store_approval_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ApprovalNode); i {
+			switch v := v.(*ApprovalStep); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -843,6 +1195,18 @@ func file_store_approval_proto_init() {
 			}
 		}
 		file_store_approval_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ApprovalNode); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_store_approval_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*IssuePayloadApproval_Approver); i {
 			case 0:
 				return &v.state
@@ -854,19 +1218,32 @@ func file_store_approval_proto_init() {
 				return nil
 			}
 		}
+		file_store_approval_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IssuePayloadApproval_SLAState); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
-	file_store_approval_proto_msgTypes[4].OneofWrappers = []interface{}{
+	file_store_approval_proto_msgTypes[5].OneofWrappers = []interface{}{
 		(*ApprovalNode_GroupValue_)(nil),
 		(*ApprovalNode_Role)(nil),
 		(*ApprovalNode_ExternalNodeId)(nil),
+		(*ApprovalNode_User)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_store_approval_proto_rawDesc,
-			NumEnums:      4,
-			NumMessages:   6,
+			NumEnums:      5,
+			NumMessages:   8,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -20,6 +20,64 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// DataSourceAuthenticationType is the way to obtain the credentials when connecting to the database.
+type DataSourceAuthenticationType int32
+
+const (
+	// The username and password of the data source are used.
+	DataSourceAuthenticationType_DATA_SOURCE_AUTHENTICATION_TYPE_UNSPECIFIED DataSourceAuthenticationType = 0
+	// The username and password are leased from the Vault database secrets engine for each connection.
+	// The lease is renewed while the connection is open and revoked when it's closed.
+	DataSourceAuthenticationType_VAULT_DATABASE_SECRET DataSourceAuthenticationType = 1
+	// The password is a short-lived AWS RDS IAM authentication token signed by the default AWS credentials.
+	DataSourceAuthenticationType_AWS_RDS_IAM DataSourceAuthenticationType = 2
+	// The password is a short-lived GCP Cloud SQL IAM access token of the application default credentials.
+	DataSourceAuthenticationType_GCP_CLOUD_SQL_IAM DataSourceAuthenticationType = 3
+)
+
+// Enum value maps for DataSourceAuthenticationType.
+var (
+	DataSourceAuthenticationType_name = map[int32]string{
+		0: "DATA_SOURCE_AUTHENTICATION_TYPE_UNSPECIFIED",
+		1: "VAULT_DATABASE_SECRET",
+		2: "AWS_RDS_IAM",
+		3: "GCP_CLOUD_SQL_IAM",
+	}
+	DataSourceAuthenticationType_value = map[string]int32{
+		"DATA_SOURCE_AUTHENTICATION_TYPE_UNSPECIFIED": 0,
+		"VAULT_DATABASE_SECRET":                       1,
+		"AWS_RDS_IAM":                                 2,
+		"GCP_CLOUD_SQL_IAM":                           3,
+	}
+)
+
+func (x DataSourceAuthenticationType) Enum() *DataSourceAuthenticationType {
+	p := new(DataSourceAuthenticationType)
+	*p = x
+	return p
+}
+
+func (x DataSourceAuthenticationType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DataSourceAuthenticationType) Descriptor() protoreflect.EnumDescriptor {
+	return file_store_data_source_proto_enumTypes[0].Descriptor()
+}
+
+func (DataSourceAuthenticationType) Type() protoreflect.EnumType {
+	return &file_store_data_source_proto_enumTypes[0]
+}
+
+func (x DataSourceAuthenticationType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DataSourceAuthenticationType.Descriptor instead.
+func (DataSourceAuthenticationType) EnumDescriptor() ([]byte, []int) {
+	return file_store_data_source_proto_rawDescGZIP(), []int{0}
+}
+
 type DataSourceOptions struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -43,6 +101,19 @@ type DataSourceOptions struct {
 	SshObfuscatedPassword string `protobuf:"bytes,8,opt,name=ssh_obfuscated_password,json=sshObfuscatedPassword,proto3" json:"ssh_obfuscated_password,omitempty"`
 	// The private key to login the server. If it's empty string, we will use the system default private key from os.Getenv("SSH_AUTH_SOCK").
 	SshObfuscatedPrivateKey string `protobuf:"bytes,9,opt,name=ssh_obfuscated_private_key,json=sshObfuscatedPrivateKey,proto3" json:"ssh_obfuscated_private_key,omitempty"`
+	// The references to the credentials in the external secret manager.
+	ExternalSecret *DataSourceExternalSecret `protobuf:"bytes,10,opt,name=external_secret,json=externalSecret,proto3" json:"external_secret,omitempty"`
+	// The way to obtain the credentials when connecting to the database.
+	AuthenticationType DataSourceAuthenticationType `protobuf:"varint,11,opt,name=authentication_type,json=authenticationType,proto3,enum=bytebase.store.DataSourceAuthenticationType" json:"authentication_type,omitempty"`
+	// The path of the Vault database secrets engine credentials for VAULT_DATABASE_SECRET, e.g. database/creds/readonly.
+	VaultDatabaseCredentialPath string `protobuf:"bytes,12,opt,name=vault_database_credential_path,json=vaultDatabaseCredentialPath,proto3" json:"vault_database_credential_path,omitempty"`
+	// The AWS region of the RDS instance for AWS_RDS_IAM, e.g. us-east-1.
+	AwsRegion string `protobuf:"bytes,13,opt,name=aws_region,json=awsRegion,proto3" json:"aws_region,omitempty"`
+	// The read-only data source is skipped if its replication lag exceeds it. The default is 60 seconds.
+	// For MySQL, the user needs the REPLICATION CLIENT privilege to check the replication lag.
+	MaxReplicationLagSeconds int32 `protobuf:"varint,14,opt,name=max_replication_lag_seconds,json=maxReplicationLagSeconds,proto3" json:"max_replication_lag_seconds,omitempty"`
+	// Schema sync and database anomaly scans read from the read-only data source instead of the admin data source.
+	UseForSync bool `protobuf:"varint,15,opt,name=use_for_sync,json=useForSync,proto3" json:"use_for_sync,omitempty"`
 }
 
 func (x *DataSourceOptions) Reset() {
@@ -140,12 +211,146 @@ func (x *DataSourceOptions) GetSshObfuscatedPrivateKey() string {
 	return ""
 }
 
+func (x *DataSourceOptions) GetExternalSecret() *DataSourceExternalSecret {
+	if x != nil {
+		return x.ExternalSecret
+	}
+	return nil
+}
+
+func (x *DataSourceOptions) GetAuthenticationType() DataSourceAuthenticationType {
+	if x != nil {
+		return x.AuthenticationType
+	}
+	return DataSourceAuthenticationType_DATA_SOURCE_AUTHENTICATION_TYPE_UNSPECIFIED
+}
+
+func (x *DataSourceOptions) GetVaultDatabaseCredentialPath() string {
+	if x != nil {
+		return x.VaultDatabaseCredentialPath
+	}
+	return ""
+}
+
+func (x *DataSourceOptions) GetAwsRegion() string {
+	if x != nil {
+		return x.AwsRegion
+	}
+	return ""
+}
+
+func (x *DataSourceOptions) GetMaxReplicationLagSeconds() int32 {
+	if x != nil {
+		return x.MaxReplicationLagSeconds
+	}
+	return 0
+}
+
+func (x *DataSourceOptions) GetUseForSync() bool {
+	if x != nil {
+		return x.UseForSync
+	}
+	return false
+}
+
+// DataSourceExternalSecret is the references to the data source credentials in the external secret manager.
+// A reference is in the format of <scheme>://<path>[#<key>], e.g. vault://secret/data/mysql#password,
+// file:///run/secrets/mysql-password or env://MYSQL_PASSWORD.
+// The credentials are resolved when connecting to the database, and they are never stored in Bytebase.
+// The credential stored in the data source is used if the reference is empty.
+type DataSourceExternalSecret struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Password      string `protobuf:"bytes,1,opt,name=password,proto3" json:"password,omitempty"`
+	SslCa         string `protobuf:"bytes,2,opt,name=ssl_ca,json=sslCa,proto3" json:"ssl_ca,omitempty"`
+	SslCert       string `protobuf:"bytes,3,opt,name=ssl_cert,json=sslCert,proto3" json:"ssl_cert,omitempty"`
+	SslKey        string `protobuf:"bytes,4,opt,name=ssl_key,json=sslKey,proto3" json:"ssl_key,omitempty"`
+	SshPassword   string `protobuf:"bytes,5,opt,name=ssh_password,json=sshPassword,proto3" json:"ssh_password,omitempty"`
+	SshPrivateKey string `protobuf:"bytes,6,opt,name=ssh_private_key,json=sshPrivateKey,proto3" json:"ssh_private_key,omitempty"`
+}
+
+func (x *DataSourceExternalSecret) Reset() {
+	*x = DataSourceExternalSecret{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_store_data_source_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DataSourceExternalSecret) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DataSourceExternalSecret) ProtoMessage() {}
+
+func (x *DataSourceExternalSecret) ProtoReflect() protoreflect.Message {
+	mi := &file_store_data_source_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DataSourceExternalSecret.ProtoReflect.Descriptor instead.
+func (*DataSourceExternalSecret) Descriptor() ([]byte, []int) {
+	return file_store_data_source_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *DataSourceExternalSecret) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *DataSourceExternalSecret) GetSslCa() string {
+	if x != nil {
+		return x.SslCa
+	}
+	return ""
+}
+
+func (x *DataSourceExternalSecret) GetSslCert() string {
+	if x != nil {
+		return x.SslCert
+	}
+	return ""
+}
+
+func (x *DataSourceExternalSecret) GetSslKey() string {
+	if x != nil {
+		return x.SslKey
+	}
+	return ""
+}
+
+func (x *DataSourceExternalSecret) GetSshPassword() string {
+	if x != nil {
+		return x.SshPassword
+	}
+	return ""
+}
+
+func (x *DataSourceExternalSecret) GetSshPrivateKey() string {
+	if x != nil {
+		return x.SshPrivateKey
+	}
+	return ""
+}
+
 var File_store_data_source_proto protoreflect.FileDescriptor
 
 var file_store_data_source_proto_rawDesc = []byte{
 	0x0a, 0x17, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2f, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x73, 0x6f, 0x75,
 	0x72, 0x63, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0e, 0x62, 0x79, 0x74, 0x65, 0x62,
-	0x61, 0x73, 0x65, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x22, 0xd9, 0x02, 0x0a, 0x11, 0x44, 0x61,
+	0x61, 0x73, 0x65, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x22, 0xd0, 0x05, 0x0a, 0x11, 0x44, 0x61,
 	0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12,
 	0x10, 0x0a, 0x03, 0x73, 0x72, 0x76, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x03, 0x73, 0x72,
 	0x76, 0x12, 0x37, 0x0a, 0x17, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74,
@@ -167,9 +372,54 @@ var file_store_data_source_proto_rawDesc = []byte{
 	0x6f, 0x62, 0x66, 0x75, 0x73, 0x63, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x70, 0x72, 0x69, 0x76, 0x61,
 	0x74, 0x65, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x17, 0x73, 0x73,
 	0x68, 0x4f, 0x62, 0x66, 0x75, 0x73, 0x63, 0x61, 0x74, 0x65, 0x64, 0x50, 0x72, 0x69, 0x76, 0x61,
-	0x74, 0x65, 0x4b, 0x65, 0x79, 0x42, 0x14, 0x5a, 0x12, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74,
-	0x65, 0x64, 0x2d, 0x67, 0x6f, 0x2f, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x33,
+	0x74, 0x65, 0x4b, 0x65, 0x79, 0x12, 0x51, 0x0a, 0x0f, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61,
+	0x6c, 0x5f, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28,
+	0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e,
+	0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e,
+	0x61, 0x6c, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x52, 0x0e, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e,
+	0x61, 0x6c, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x5d, 0x0a, 0x13, 0x61, 0x75, 0x74, 0x68,
+	0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18,
+	0x0b, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2c, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65,
+	0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54,
+	0x79, 0x70, 0x65, 0x52, 0x12, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x43, 0x0a, 0x1e, 0x76, 0x61, 0x75, 0x6c, 0x74,
+	0x5f, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e,
+	0x74, 0x69, 0x61, 0x6c, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x1b, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x43, 0x72,
+	0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x50, 0x61, 0x74, 0x68, 0x12, 0x1d, 0x0a, 0x0a,
+	0x61, 0x77, 0x73, 0x5f, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x61, 0x77, 0x73, 0x52, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x12, 0x3d, 0x0a, 0x1b, 0x6d,
+	0x61, 0x78, 0x5f, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6c,
+	0x61, 0x67, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x18, 0x6d, 0x61, 0x78, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x4c, 0x61, 0x67, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x20, 0x0a, 0x0c, 0x75, 0x73,
+	0x65, 0x5f, 0x66, 0x6f, 0x72, 0x5f, 0x73, 0x79, 0x6e, 0x63, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x0a, 0x75, 0x73, 0x65, 0x46, 0x6f, 0x72, 0x53, 0x79, 0x6e, 0x63, 0x22, 0xcc, 0x01, 0x0a,
+	0x18, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x45, 0x78, 0x74, 0x65, 0x72,
+	0x6e, 0x61, 0x6c, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73,
+	0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73,
+	0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x15, 0x0a, 0x06, 0x73, 0x73, 0x6c, 0x5f, 0x63, 0x61, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x73, 0x6c, 0x43, 0x61, 0x12, 0x19, 0x0a, 0x08,
+	0x73, 0x73, 0x6c, 0x5f, 0x63, 0x65, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x73, 0x73, 0x6c, 0x43, 0x65, 0x72, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x73, 0x73, 0x6c, 0x5f, 0x6b,
+	0x65, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x73, 0x6c, 0x4b, 0x65, 0x79,
+	0x12, 0x21, 0x0a, 0x0c, 0x73, 0x73, 0x68, 0x5f, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x73, 0x68, 0x50, 0x61, 0x73, 0x73, 0x77,
+	0x6f, 0x72, 0x64, 0x12, 0x26, 0x0a, 0x0f, 0x73, 0x73, 0x68, 0x5f, 0x70, 0x72, 0x69, 0x76, 0x61,
+	0x74, 0x65, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x73, 0x73,
+	0x68, 0x50, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x4b, 0x65, 0x79, 0x2a, 0x92, 0x01, 0x0a, 0x1c,
+	0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e,
+	0x74, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x2f, 0x0a, 0x2b,
+	0x44, 0x41, 0x54, 0x41, 0x5f, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x41, 0x55, 0x54, 0x48,
+	0x45, 0x4e, 0x54, 0x49, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f,
+	0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x19, 0x0a,
+	0x15, 0x56, 0x41, 0x55, 0x4c, 0x54, 0x5f, 0x44, 0x41, 0x54, 0x41, 0x42, 0x41, 0x53, 0x45, 0x5f,
+	0x53, 0x45, 0x43, 0x52, 0x45, 0x54, 0x10, 0x01, 0x12, 0x0f, 0x0a, 0x0b, 0x41, 0x57, 0x53, 0x5f,
+	0x52, 0x44, 0x53, 0x5f, 0x49, 0x41, 0x4d, 0x10, 0x02, 0x12, 0x15, 0x0a, 0x11, 0x47, 0x43, 0x50,
+	0x5f, 0x43, 0x4c, 0x4f, 0x55, 0x44, 0x5f, 0x53, 0x51, 0x4c, 0x5f, 0x49, 0x41, 0x4d, 0x10, 0x03,
+	0x42, 0x14, 0x5a, 0x12, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x64, 0x2d, 0x67, 0x6f,
+	0x2f, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -184,16 +434,21 @@ func file_store_data_source_proto_rawDescGZIP() []byte {
 	return file_store_data_source_proto_rawDescData
 }
 
-var file_store_data_source_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_store_data_source_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_store_data_source_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
 var file_store_data_source_proto_goTypes = []interface{}{
-	(*DataSourceOptions)(nil), // 0: bytebase.store.DataSourceOptions
+	(DataSourceAuthenticationType)(0), // 0: bytebase.store.DataSourceAuthenticationType
+	(*DataSourceOptions)(nil),         // 1: bytebase.store.DataSourceOptions
+	(*DataSourceExternalSecret)(nil),  // 2: bytebase.store.DataSourceExternalSecret
 }
 var file_store_data_source_proto_depIdxs = []int32{
-	0, // [0:0] is the sub-list for method output_type
-	0, // [0:0] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	2, // 0: bytebase.store.DataSourceOptions.external_secret:type_name -> bytebase.store.DataSourceExternalSecret
+	0, // 1: bytebase.store.DataSourceOptions.authentication_type:type_name -> bytebase.store.DataSourceAuthenticationType
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
 }
 
 func init() { file_store_data_source_proto_init() }
@@ -214,19 +469,32 @@ func file_store_data_source_proto_init() {
 				return nil
 			}
 		}
+		file_store_data_source_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DataSourceExternalSecret); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_store_data_source_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   1,
+			NumEnums:      1,
+			NumMessages:   2,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
 		GoTypes:           file_store_data_source_proto_goTypes,
 		DependencyIndexes: file_store_data_source_proto_depIdxs,
+		EnumInfos:         file_store_data_source_proto_enumTypes,
 		MessageInfos:      file_store_data_source_proto_msgTypes,
 	}.Build()
 	File_store_data_source_proto = out.File
@@ -131,6 +131,61 @@ func (SMTPMailDeliverySetting_Authentication) EnumDescriptor() ([]byte, []int) {
 	return file_store_setting_proto_rawDescGZIP(), []int{4, 1}
 }
 
+type AuditLogExportSetting_Destination_Type int32
+
+const (
+	AuditLogExportSetting_Destination_TYPE_UNSPECIFIED AuditLogExportSetting_Destination_Type = 0
+	// RFC 5424 syslog messages.
+	AuditLogExportSetting_Destination_SYSLOG AuditLogExportSetting_Destination_Type = 1
+	// HTTP POST requests with the JSON array of the audit logs.
+	AuditLogExportSetting_Destination_HTTP AuditLogExportSetting_Destination_Type = 2
+	// JSON lines files with size based rotation.
+	AuditLogExportSetting_Destination_FILE AuditLogExportSetting_Destination_Type = 3
+)
+
+// Enum value maps for AuditLogExportSetting_Destination_Type.
+var (
+	AuditLogExportSetting_Destination_Type_name = map[int32]string{
+		0: "TYPE_UNSPECIFIED",
+		1: "SYSLOG",
+		2: "HTTP",
+		3: "FILE",
+	}
+	AuditLogExportSetting_Destination_Type_value = map[string]int32{
+		"TYPE_UNSPECIFIED": 0,
+		"SYSLOG":           1,
+		"HTTP":             2,
+		"FILE":             3,
+	}
+)
+
+func (x AuditLogExportSetting_Destination_Type) Enum() *AuditLogExportSetting_Destination_Type {
+	p := new(AuditLogExportSetting_Destination_Type)
+	*p = x
+	return p
+}
+
+func (x AuditLogExportSetting_Destination_Type) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AuditLogExportSetting_Destination_Type) Descriptor() protoreflect.EnumDescriptor {
+	return file_store_setting_proto_enumTypes[2].Descriptor()
+}
+
+func (AuditLogExportSetting_Destination_Type) Type() protoreflect.EnumType {
+	return &file_store_setting_proto_enumTypes[2]
+}
+
+func (x AuditLogExportSetting_Destination_Type) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AuditLogExportSetting_Destination_Type.Descriptor instead.
+func (AuditLogExportSetting_Destination_Type) EnumDescriptor() ([]byte, []int) {
+	return file_store_setting_proto_rawDescGZIP(), []int{6, 0, 0}
+}
+
 type WorkspaceProfileSetting struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -494,6 +549,112 @@ func (x *SMTPMailDeliverySetting) GetFrom() string {
 	return ""
 }
 
+type SCIMSetting struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The bearer token of the SCIM client, e.g. the identity provider provisioning the users.
+	// The SCIM endpoint is disabled if it's empty.
+	Token string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	// The group members are granted the project roles of the mappings.
+	GroupMappings []*SCIMSetting_GroupMapping `protobuf:"bytes,2,rep,name=group_mappings,json=groupMappings,proto3" json:"group_mappings,omitempty"`
+}
+
+func (x *SCIMSetting) Reset() {
+	*x = SCIMSetting{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_store_setting_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SCIMSetting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SCIMSetting) ProtoMessage() {}
+
+func (x *SCIMSetting) ProtoReflect() protoreflect.Message {
+	mi := &file_store_setting_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SCIMSetting.ProtoReflect.Descriptor instead.
+func (*SCIMSetting) Descriptor() ([]byte, []int) {
+	return file_store_setting_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *SCIMSetting) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *SCIMSetting) GetGroupMappings() []*SCIMSetting_GroupMapping {
+	if x != nil {
+		return x.GroupMappings
+	}
+	return nil
+}
+
+type AuditLogExportSetting struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The hash chained audit logs are exported to all of the destinations.
+	Destinations []*AuditLogExportSetting_Destination `protobuf:"bytes,1,rep,name=destinations,proto3" json:"destinations,omitempty"`
+}
+
+func (x *AuditLogExportSetting) Reset() {
+	*x = AuditLogExportSetting{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_store_setting_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AuditLogExportSetting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditLogExportSetting) ProtoMessage() {}
+
+func (x *AuditLogExportSetting) ProtoReflect() protoreflect.Message {
+	mi := &file_store_setting_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditLogExportSetting.ProtoReflect.Descriptor instead.
+func (*AuditLogExportSetting) Descriptor() ([]byte, []int) {
+	return file_store_setting_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *AuditLogExportSetting) GetDestinations() []*AuditLogExportSetting_Destination {
+	if x != nil {
+		return x.Destinations
+	}
+	return nil
+}
+
 type WorkspaceApprovalSetting_Rule struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -507,7 +668,7 @@ type WorkspaceApprovalSetting_Rule struct {
 func (x *WorkspaceApprovalSetting_Rule) Reset() {
 	*x = WorkspaceApprovalSetting_Rule{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_store_setting_proto_msgTypes[5]
+		mi := &file_store_setting_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -520,7 +681,7 @@ func (x *WorkspaceApprovalSetting_Rule) String() string {
 func (*WorkspaceApprovalSetting_Rule) ProtoMessage() {}
 
 func (x *WorkspaceApprovalSetting_Rule) ProtoReflect() protoreflect.Message {
-	mi := &file_store_setting_proto_msgTypes[5]
+	mi := &file_store_setting_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -574,7 +735,7 @@ type ExternalApprovalSetting_Node struct {
 func (x *ExternalApprovalSetting_Node) Reset() {
 	*x = ExternalApprovalSetting_Node{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_store_setting_proto_msgTypes[6]
+		mi := &file_store_setting_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -587,7 +748,7 @@ func (x *ExternalApprovalSetting_Node) String() string {
 func (*ExternalApprovalSetting_Node) ProtoMessage() {}
 
 func (x *ExternalApprovalSetting_Node) ProtoReflect() protoreflect.Message {
-	mi := &file_store_setting_proto_msgTypes[6]
+	mi := &file_store_setting_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -624,6 +785,350 @@ func (x *ExternalApprovalSetting_Node) GetEndpoint() string {
 	return ""
 }
 
+type SCIMSetting_GroupMapping struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The display name of the SCIM group.
+	Group string `protobuf:"bytes,1,opt,name=group,proto3" json:"group,omitempty"`
+	// The resource ID of the project.
+	Project string `protobuf:"bytes,2,opt,name=project,proto3" json:"project,omitempty"`
+	// The project role granted to the group members, e.g. DEVELOPER.
+	Role string `protobuf:"bytes,3,opt,name=role,proto3" json:"role,omitempty"`
+}
+
+func (x *SCIMSetting_GroupMapping) Reset() {
+	*x = SCIMSetting_GroupMapping{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_store_setting_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SCIMSetting_GroupMapping) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SCIMSetting_GroupMapping) ProtoMessage() {}
+
+func (x *SCIMSetting_GroupMapping) ProtoReflect() protoreflect.Message {
+	mi := &file_store_setting_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SCIMSetting_GroupMapping.ProtoReflect.Descriptor instead.
+func (*SCIMSetting_GroupMapping) Descriptor() ([]byte, []int) {
+	return file_store_setting_proto_rawDescGZIP(), []int{5, 0}
+}
+
+func (x *SCIMSetting_GroupMapping) GetGroup() string {
+	if x != nil {
+		return x.Group
+	}
+	return ""
+}
+
+func (x *SCIMSetting_GroupMapping) GetProject() string {
+	if x != nil {
+		return x.Project
+	}
+	return ""
+}
+
+func (x *SCIMSetting_GroupMapping) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+type AuditLogExportSetting_Destination struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The unique name of the destination. The export progress is tracked by the name.
+	Name   string                                          `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Type   AuditLogExportSetting_Destination_Type          `protobuf:"varint,2,opt,name=type,proto3,enum=bytebase.store.AuditLogExportSetting_Destination_Type" json:"type,omitempty"`
+	Syslog *AuditLogExportSetting_Destination_SyslogConfig `protobuf:"bytes,3,opt,name=syslog,proto3" json:"syslog,omitempty"`
+	Http   *AuditLogExportSetting_Destination_HTTPConfig   `protobuf:"bytes,4,opt,name=http,proto3" json:"http,omitempty"`
+	File   *AuditLogExportSetting_Destination_FileConfig   `protobuf:"bytes,5,opt,name=file,proto3" json:"file,omitempty"`
+}
+
+func (x *AuditLogExportSetting_Destination) Reset() {
+	*x = AuditLogExportSetting_Destination{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_store_setting_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AuditLogExportSetting_Destination) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditLogExportSetting_Destination) ProtoMessage() {}
+
+func (x *AuditLogExportSetting_Destination) ProtoReflect() protoreflect.Message {
+	mi := &file_store_setting_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditLogExportSetting_Destination.ProtoReflect.Descriptor instead.
+func (*AuditLogExportSetting_Destination) Descriptor() ([]byte, []int) {
+	return file_store_setting_proto_rawDescGZIP(), []int{6, 0}
+}
+
+func (x *AuditLogExportSetting_Destination) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AuditLogExportSetting_Destination) GetType() AuditLogExportSetting_Destination_Type {
+	if x != nil {
+		return x.Type
+	}
+	return AuditLogExportSetting_Destination_TYPE_UNSPECIFIED
+}
+
+func (x *AuditLogExportSetting_Destination) GetSyslog() *AuditLogExportSetting_Destination_SyslogConfig {
+	if x != nil {
+		return x.Syslog
+	}
+	return nil
+}
+
+func (x *AuditLogExportSetting_Destination) GetHttp() *AuditLogExportSetting_Destination_HTTPConfig {
+	if x != nil {
+		return x.Http
+	}
+	return nil
+}
+
+func (x *AuditLogExportSetting_Destination) GetFile() *AuditLogExportSetting_Destination_FileConfig {
+	if x != nil {
+		return x.File
+	}
+	return nil
+}
+
+type AuditLogExportSetting_Destination_SyslogConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The network of the syslog server, one of "udp", "tcp" and "tls".
+	Network string `protobuf:"bytes,1,opt,name=network,proto3" json:"network,omitempty"`
+	// The address of the syslog server, e.g. syslog.example.com:514.
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	// The syslog facility, 13 (log audit) is used if it's unset.
+	Facility int32 `protobuf:"varint,3,opt,name=facility,proto3" json:"facility,omitempty"`
+	// The APP-NAME of the syslog messages, "bytebase" is used if it's empty.
+	AppName string `protobuf:"bytes,4,opt,name=app_name,json=appName,proto3" json:"app_name,omitempty"`
+}
+
+func (x *AuditLogExportSetting_Destination_SyslogConfig) Reset() {
+	*x = AuditLogExportSetting_Destination_SyslogConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_store_setting_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AuditLogExportSetting_Destination_SyslogConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditLogExportSetting_Destination_SyslogConfig) ProtoMessage() {}
+
+func (x *AuditLogExportSetting_Destination_SyslogConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_store_setting_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditLogExportSetting_Destination_SyslogConfig.ProtoReflect.Descriptor instead.
+func (*AuditLogExportSetting_Destination_SyslogConfig) Descriptor() ([]byte, []int) {
+	return file_store_setting_proto_rawDescGZIP(), []int{6, 0, 0}
+}
+
+func (x *AuditLogExportSetting_Destination_SyslogConfig) GetNetwork() string {
+	if x != nil {
+		return x.Network
+	}
+	return ""
+}
+
+func (x *AuditLogExportSetting_Destination_SyslogConfig) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *AuditLogExportSetting_Destination_SyslogConfig) GetFacility() int32 {
+	if x != nil {
+		return x.Facility
+	}
+	return 0
+}
+
+func (x *AuditLogExportSetting_Destination_SyslogConfig) GetAppName() string {
+	if x != nil {
+		return x.AppName
+	}
+	return ""
+}
+
+type AuditLogExportSetting_Destination_HTTPConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The URL receiving the audit logs.
+	Url string `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	// The headers of the requests, e.g. the Authorization header of the Splunk HTTP Event Collector.
+	Headers map[string]string `protobuf:"bytes,2,rep,name=headers,proto3" json:"headers,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *AuditLogExportSetting_Destination_HTTPConfig) Reset() {
+	*x = AuditLogExportSetting_Destination_HTTPConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_store_setting_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AuditLogExportSetting_Destination_HTTPConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditLogExportSetting_Destination_HTTPConfig) ProtoMessage() {}
+
+func (x *AuditLogExportSetting_Destination_HTTPConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_store_setting_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditLogExportSetting_Destination_HTTPConfig.ProtoReflect.Descriptor instead.
+func (*AuditLogExportSetting_Destination_HTTPConfig) Descriptor() ([]byte, []int) {
+	return file_store_setting_proto_rawDescGZIP(), []int{6, 0, 1}
+}
+
+func (x *AuditLogExportSetting_Destination_HTTPConfig) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *AuditLogExportSetting_Destination_HTTPConfig) GetHeaders() map[string]string {
+	if x != nil {
+		return x.Headers
+	}
+	return nil
+}
+
+type AuditLogExportSetting_Destination_FileConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The path of the audit log file.
+	Path string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	// The file is rotated once it exceeds the max size in bytes, 100 MiB is used if it's unset.
+	MaxSize int64 `protobuf:"varint,2,opt,name=max_size,json=maxSize,proto3" json:"max_size,omitempty"`
+	// The number of the rotated files to keep, all of them are kept if it's unset.
+	MaxBackups int32 `protobuf:"varint,3,opt,name=max_backups,json=maxBackups,proto3" json:"max_backups,omitempty"`
+}
+
+func (x *AuditLogExportSetting_Destination_FileConfig) Reset() {
+	*x = AuditLogExportSetting_Destination_FileConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_store_setting_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AuditLogExportSetting_Destination_FileConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditLogExportSetting_Destination_FileConfig) ProtoMessage() {}
+
+func (x *AuditLogExportSetting_Destination_FileConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_store_setting_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditLogExportSetting_Destination_FileConfig.ProtoReflect.Descriptor instead.
+func (*AuditLogExportSetting_Destination_FileConfig) Descriptor() ([]byte, []int) {
+	return file_store_setting_proto_rawDescGZIP(), []int{6, 0, 2}
+}
+
+func (x *AuditLogExportSetting_Destination_FileConfig) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *AuditLogExportSetting_Destination_FileConfig) GetMaxSize() int64 {
+	if x != nil {
+		return x.MaxSize
+	}
+	return 0
+}
+
+func (x *AuditLogExportSetting_Destination_FileConfig) GetMaxBackups() int32 {
+	if x != nil {
+		return x.MaxBackups
+	}
+	return 0
+}
+
 var File_store_setting_proto protoreflect.FileDescriptor
 
 var file_store_setting_proto_rawDesc = []byte{
@@ -721,9 +1226,81 @@ var file_store_setting_proto_rawDesc = []byte{
 	0x50, 0x4c, 0x41, 0x49, 0x4e, 0x10, 0x02, 0x12, 0x18, 0x0a, 0x14, 0x41, 0x55, 0x54, 0x48, 0x45,
 	0x4e, 0x54, 0x49, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4c, 0x4f, 0x47, 0x49, 0x4e, 0x10,
 	0x03, 0x12, 0x1b, 0x0a, 0x17, 0x41, 0x55, 0x54, 0x48, 0x45, 0x4e, 0x54, 0x49, 0x43, 0x41, 0x54,
-	0x49, 0x4f, 0x4e, 0x5f, 0x43, 0x52, 0x41, 0x4d, 0x5f, 0x4d, 0x44, 0x35, 0x10, 0x04, 0x42, 0x14,
-	0x5a, 0x12, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x64, 0x2d, 0x67, 0x6f, 0x2f, 0x73,
-	0x74, 0x6f, 0x72, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x49, 0x4f, 0x4e, 0x5f, 0x43, 0x52, 0x41, 0x4d, 0x5f, 0x4d, 0x44, 0x35, 0x10, 0x04, 0x22, 0xc8,
+	0x01, 0x0a, 0x0b, 0x53, 0x43, 0x49, 0x4d, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x14,
+	0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x4f, 0x0a, 0x0e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x6d, 0x61,
+	0x70, 0x70, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x62,
+	0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x53, 0x43,
+	0x49, 0x4d, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4d,
+	0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x52, 0x0d, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x4d, 0x61, 0x70,
+	0x70, 0x69, 0x6e, 0x67, 0x73, 0x1a, 0x52, 0x0a, 0x0c, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4d, 0x61,
+	0x70, 0x70, 0x69, 0x6e, 0x67, 0x12, 0x14, 0x0a, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x18, 0x0a, 0x07, 0x70,
+	0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x72,
+	0x6f, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x22, 0xb3, 0x07, 0x0a, 0x15, 0x41, 0x75,
+	0x64, 0x69, 0x74, 0x4c, 0x6f, 0x67, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x53, 0x65, 0x74, 0x74,
+	0x69, 0x6e, 0x67, 0x12, 0x55, 0x0a, 0x0c, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x31, 0x2e, 0x62, 0x79, 0x74, 0x65,
+	0x62, 0x61, 0x73, 0x65, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x41, 0x75, 0x64, 0x69, 0x74,
+	0x4c, 0x6f, 0x67, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67,
+	0x2e, 0x44, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x64, 0x65,
+	0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x1a, 0xc2, 0x06, 0x0a, 0x0b, 0x44,
+	0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x4a,
+	0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x36, 0x2e, 0x62,
+	0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x41, 0x75,
+	0x64, 0x69, 0x74, 0x4c, 0x6f, 0x67, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x53, 0x65, 0x74, 0x74,
+	0x69, 0x6e, 0x67, 0x2e, 0x44, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e,
+	0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x56, 0x0a, 0x06, 0x73, 0x79,
+	0x73, 0x6c, 0x6f, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3e, 0x2e, 0x62, 0x79, 0x74,
+	0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x41, 0x75, 0x64, 0x69,
+	0x74, 0x4c, 0x6f, 0x67, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e,
+	0x67, 0x2e, 0x44, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x53, 0x79,
+	0x73, 0x6c, 0x6f, 0x67, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x06, 0x73, 0x79, 0x73, 0x6c,
+	0x6f, 0x67, 0x12, 0x50, 0x0a, 0x04, 0x68, 0x74, 0x74, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x3c, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x73, 0x74, 0x6f, 0x72,
+	0x65, 0x2e, 0x41, 0x75, 0x64, 0x69, 0x74, 0x4c, 0x6f, 0x67, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74,
+	0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x44, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x2e, 0x48, 0x54, 0x54, 0x50, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x04,
+	0x68, 0x74, 0x74, 0x70, 0x12, 0x50, 0x0a, 0x04, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x3c, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x73, 0x74,
+	0x6f, 0x72, 0x65, 0x2e, 0x41, 0x75, 0x64, 0x69, 0x74, 0x4c, 0x6f, 0x67, 0x45, 0x78, 0x70, 0x6f,
+	0x72, 0x74, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x44, 0x65, 0x73, 0x74, 0x69, 0x6e,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x52, 0x04, 0x66, 0x69, 0x6c, 0x65, 0x1a, 0x79, 0x0a, 0x0c, 0x53, 0x79, 0x73, 0x6c, 0x6f, 0x67,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x18, 0x0a, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72,
+	0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b,
+	0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x61,
+	0x63, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x66, 0x61,
+	0x63, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x70, 0x70, 0x5f, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x70, 0x70, 0x4e, 0x61, 0x6d,
+	0x65, 0x1a, 0xbf, 0x01, 0x0a, 0x0a, 0x48, 0x54, 0x54, 0x50, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75,
+	0x72, 0x6c, 0x12, 0x63, 0x0a, 0x07, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x49, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x73,
+	0x74, 0x6f, 0x72, 0x65, 0x2e, 0x41, 0x75, 0x64, 0x69, 0x74, 0x4c, 0x6f, 0x67, 0x45, 0x78, 0x70,
+	0x6f, 0x72, 0x74, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x44, 0x65, 0x73, 0x74, 0x69,
+	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x48, 0x54, 0x54, 0x50, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07,
+	0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x1a, 0x3a, 0x0a, 0x0c, 0x48, 0x65, 0x61, 0x64, 0x65,
+	0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a,
+	0x02, 0x38, 0x01, 0x1a, 0x5c, 0x0a, 0x0a, 0x46, 0x69, 0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x19, 0x0a, 0x08, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x69, 0x7a,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x6d, 0x61, 0x78, 0x53, 0x69, 0x7a, 0x65,
+	0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x61, 0x78, 0x5f, 0x62, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x73, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x6d, 0x61, 0x78, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70,
+	0x73, 0x22, 0x3c, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x10, 0x54, 0x59, 0x50,
+	0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12,
+	0x0a, 0x0a, 0x06, 0x53, 0x59, 0x53, 0x4c, 0x4f, 0x47, 0x10, 0x01, 0x12, 0x08, 0x0a, 0x04, 0x48,
+	0x54, 0x54, 0x50, 0x10, 0x02, 0x12, 0x08, 0x0a, 0x04, 0x46, 0x49, 0x4c, 0x45, 0x10, 0x03, 0x42,
+	0x14, 0x5a, 0x12, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x64, 0x2d, 0x67, 0x6f, 0x2f,
+	0x73, 0x74, 0x6f, 0x72, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -738,35 +1315,51 @@ func file_store_setting_proto_rawDescGZIP() []byte {
 	return file_store_setting_proto_rawDescData
 }
 
-var file_store_setting_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_store_setting_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_store_setting_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_store_setting_proto_msgTypes = make([]protoimpl.MessageInfo, 15)
 var file_store_setting_proto_goTypes = []interface{}{
-	(SMTPMailDeliverySetting_Encryption)(0),     // 0: bytebase.store.SMTPMailDeliverySetting.Encryption
-	(SMTPMailDeliverySetting_Authentication)(0), // 1: bytebase.store.SMTPMailDeliverySetting.Authentication
-	(*WorkspaceProfileSetting)(nil),             // 2: bytebase.store.WorkspaceProfileSetting
-	(*AgentPluginSetting)(nil),                  // 3: bytebase.store.AgentPluginSetting
-	(*WorkspaceApprovalSetting)(nil),            // 4: bytebase.store.WorkspaceApprovalSetting
-	(*ExternalApprovalSetting)(nil),             // 5: bytebase.store.ExternalApprovalSetting
-	(*SMTPMailDeliverySetting)(nil),             // 6: bytebase.store.SMTPMailDeliverySetting
-	(*WorkspaceApprovalSetting_Rule)(nil),       // 7: bytebase.store.WorkspaceApprovalSetting.Rule
-	(*ExternalApprovalSetting_Node)(nil),        // 8: bytebase.store.ExternalApprovalSetting.Node
-	(*v1alpha1.ParsedExpr)(nil),                 // 9: google.api.expr.v1alpha1.ParsedExpr
-	(*ApprovalTemplate)(nil),                    // 10: bytebase.store.ApprovalTemplate
-	(*expr.Expr)(nil),                           // 11: google.type.Expr
+	(SMTPMailDeliverySetting_Encryption)(0),                // 0: bytebase.store.SMTPMailDeliverySetting.Encryption
+	(SMTPMailDeliverySetting_Authentication)(0),            // 1: bytebase.store.SMTPMailDeliverySetting.Authentication
+	(AuditLogExportSetting_Destination_Type)(0),            // 2: bytebase.store.AuditLogExportSetting.Destination.Type
+	(*WorkspaceProfileSetting)(nil),                        // 3: bytebase.store.WorkspaceProfileSetting
+	(*AgentPluginSetting)(nil),                             // 4: bytebase.store.AgentPluginSetting
+	(*WorkspaceApprovalSetting)(nil),                       // 5: bytebase.store.WorkspaceApprovalSetting
+	(*ExternalApprovalSetting)(nil),                        // 6: bytebase.store.ExternalApprovalSetting
+	(*SMTPMailDeliverySetting)(nil),                        // 7: bytebase.store.SMTPMailDeliverySetting
+	(*SCIMSetting)(nil),                                    // 8: bytebase.store.SCIMSetting
+	(*AuditLogExportSetting)(nil),                          // 9: bytebase.store.AuditLogExportSetting
+	(*WorkspaceApprovalSetting_Rule)(nil),                  // 10: bytebase.store.WorkspaceApprovalSetting.Rule
+	(*ExternalApprovalSetting_Node)(nil),                   // 11: bytebase.store.ExternalApprovalSetting.Node
+	(*SCIMSetting_GroupMapping)(nil),                       // 12: bytebase.store.SCIMSetting.GroupMapping
+	(*AuditLogExportSetting_Destination)(nil),              // 13: bytebase.store.AuditLogExportSetting.Destination
+	(*AuditLogExportSetting_Destination_SyslogConfig)(nil), // 14: bytebase.store.AuditLogExportSetting.Destination.SyslogConfig
+	(*AuditLogExportSetting_Destination_HTTPConfig)(nil),   // 15: bytebase.store.AuditLogExportSetting.Destination.HTTPConfig
+	(*AuditLogExportSetting_Destination_FileConfig)(nil),   // 16: bytebase.store.AuditLogExportSetting.Destination.FileConfig
+	nil,                         // 17: bytebase.store.AuditLogExportSetting.Destination.HTTPConfig.HeadersEntry
+	(*v1alpha1.ParsedExpr)(nil), // 18: google.api.expr.v1alpha1.ParsedExpr
+	(*ApprovalTemplate)(nil),    // 19: bytebase.store.ApprovalTemplate
+	(*expr.Expr)(nil),           // 20: google.type.Expr
 }
 var file_store_setting_proto_depIdxs = []int32{
-	7,  // 0: bytebase.store.WorkspaceApprovalSetting.rules:type_name -> bytebase.store.WorkspaceApprovalSetting.Rule
-	8,  // 1: bytebase.store.ExternalApprovalSetting.nodes:type_name -> bytebase.store.ExternalApprovalSetting.Node
+	10, // 0: bytebase.store.WorkspaceApprovalSetting.rules:type_name -> bytebase.store.WorkspaceApprovalSetting.Rule
+	11, // 1: bytebase.store.ExternalApprovalSetting.nodes:type_name -> bytebase.store.ExternalApprovalSetting.Node
 	0,  // 2: bytebase.store.SMTPMailDeliverySetting.encryption:type_name -> bytebase.store.SMTPMailDeliverySetting.Encryption
 	1,  // 3: bytebase.store.SMTPMailDeliverySetting.authentication:type_name -> bytebase.store.SMTPMailDeliverySetting.Authentication
-	9,  // 4: bytebase.store.WorkspaceApprovalSetting.Rule.expression:type_name -> google.api.expr.v1alpha1.ParsedExpr
-	10, // 5: bytebase.store.WorkspaceApprovalSetting.Rule.template:type_name -> bytebase.store.ApprovalTemplate
-	11, // 6: bytebase.store.WorkspaceApprovalSetting.Rule.condition:type_name -> google.type.Expr
-	7,  // [7:7] is the sub-list for method output_type
-	7,  // [7:7] is the sub-list for method input_type
-	7,  // [7:7] is the sub-list for extension type_name
-	7,  // [7:7] is the sub-list for extension extendee
-	0,  // [0:7] is the sub-list for field type_name
+	12, // 4: bytebase.store.SCIMSetting.group_mappings:type_name -> bytebase.store.SCIMSetting.GroupMapping
+	13, // 5: bytebase.store.AuditLogExportSetting.destinations:type_name -> bytebase.store.AuditLogExportSetting.Destination
+	18, // 6: bytebase.store.WorkspaceApprovalSetting.Rule.expression:type_name -> google.api.expr.v1alpha1.ParsedExpr
+	19, // 7: bytebase.store.WorkspaceApprovalSetting.Rule.template:type_name -> bytebase.store.ApprovalTemplate
+	20, // 8: bytebase.store.WorkspaceApprovalSetting.Rule.condition:type_name -> google.type.Expr
+	2,  // 9: bytebase.store.AuditLogExportSetting.Destination.type:type_name -> bytebase.store.AuditLogExportSetting.Destination.Type
+	14, // 10: bytebase.store.AuditLogExportSetting.Destination.syslog:type_name -> bytebase.store.AuditLogExportSetting.Destination.SyslogConfig
+	15, // 11: bytebase.store.AuditLogExportSetting.Destination.http:type_name -> bytebase.store.AuditLogExportSetting.Destination.HTTPConfig
+	16, // 12: bytebase.store.AuditLogExportSetting.Destination.file:type_name -> bytebase.store.AuditLogExportSetting.Destination.FileConfig
+	17, // 13: bytebase.store.AuditLogExportSetting.Destination.HTTPConfig.headers:type_name -> bytebase.store.AuditLogExportSetting.Destination.HTTPConfig.HeadersEntry
+	14, // [14:14] is the sub-list for method output_type
+	14, // [14:14] is the sub-list for method input_type
+	14, // [14:14] is the sub-list for extension type_name
+	14, // [14:14] is the sub-list for extension extendee
+	0,  // [0:14] is the sub-list for field type_name
 }
 
 func init() { file_store_setting_proto_init() }
@@ -837,7 +1430,7 @@ func file_store_setting_proto_init() {
 			}
 		}
 		file_store_setting_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*WorkspaceApprovalSetting_Rule); i {
+			switch v := v.(*SCIMSetting); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -849,6 +1442,30 @@ func file_store_setting_proto_init() {
 			}
 		}
 		file_store_setting_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuditLogExportSetting); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_store_setting_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WorkspaceApprovalSetting_Rule); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_store_setting_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ExternalApprovalSetting_Node); i {
 			case 0:
 				return &v.state
@@ -860,14 +1477,74 @@ func file_store_setting_proto_init() {
 				return nil
 			}
 		}
+		file_store_setting_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SCIMSetting_GroupMapping); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_store_setting_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuditLogExportSetting_Destination); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_store_setting_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuditLogExportSetting_Destination_SyslogConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_store_setting_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuditLogExportSetting_Destination_HTTPConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_store_setting_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuditLogExportSetting_Destination_FileConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_store_setting_proto_rawDesc,
-			NumEnums:      2,
-			NumMessages:   7,
+			NumEnums:      3,
+			NumMessages:   15,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -20,25 +20,26 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	DatabaseService_GetDatabase_FullMethodName          = "/bytebase.v1.DatabaseService/GetDatabase"
-	DatabaseService_ListDatabases_FullMethodName        = "/bytebase.v1.DatabaseService/ListDatabases"
-	DatabaseService_SearchDatabases_FullMethodName      = "/bytebase.v1.DatabaseService/SearchDatabases"
-	DatabaseService_UpdateDatabase_FullMethodName       = "/bytebase.v1.DatabaseService/UpdateDatabase"
-	DatabaseService_BatchUpdateDatabases_FullMethodName = "/bytebase.v1.DatabaseService/BatchUpdateDatabases"
-	DatabaseService_SyncDatabase_FullMethodName         = "/bytebase.v1.DatabaseService/SyncDatabase"
-	DatabaseService_GetDatabaseMetadata_FullMethodName  = "/bytebase.v1.DatabaseService/GetDatabaseMetadata"
-	DatabaseService_GetDatabaseSchema_FullMethodName    = "/bytebase.v1.DatabaseService/GetDatabaseSchema"
-	DatabaseService_GetBackupSetting_FullMethodName     = "/bytebase.v1.DatabaseService/GetBackupSetting"
-	DatabaseService_UpdateBackupSetting_FullMethodName  = "/bytebase.v1.DatabaseService/UpdateBackupSetting"
-	DatabaseService_CreateBackup_FullMethodName         = "/bytebase.v1.DatabaseService/CreateBackup"
-	DatabaseService_ListBackups_FullMethodName          = "/bytebase.v1.DatabaseService/ListBackups"
-	DatabaseService_ListSlowQueries_FullMethodName      = "/bytebase.v1.DatabaseService/ListSlowQueries"
-	DatabaseService_ListSecrets_FullMethodName          = "/bytebase.v1.DatabaseService/ListSecrets"
-	DatabaseService_UpdateSecret_FullMethodName         = "/bytebase.v1.DatabaseService/UpdateSecret"
-	DatabaseService_DeleteSecret_FullMethodName         = "/bytebase.v1.DatabaseService/DeleteSecret"
-	DatabaseService_AdviseIndex_FullMethodName          = "/bytebase.v1.DatabaseService/AdviseIndex"
-	DatabaseService_ListChangeHistories_FullMethodName  = "/bytebase.v1.DatabaseService/ListChangeHistories"
-	DatabaseService_GetChangeHistory_FullMethodName     = "/bytebase.v1.DatabaseService/GetChangeHistory"
+	DatabaseService_GetDatabase_FullMethodName              = "/bytebase.v1.DatabaseService/GetDatabase"
+	DatabaseService_ListDatabases_FullMethodName            = "/bytebase.v1.DatabaseService/ListDatabases"
+	DatabaseService_SearchDatabases_FullMethodName          = "/bytebase.v1.DatabaseService/SearchDatabases"
+	DatabaseService_UpdateDatabase_FullMethodName           = "/bytebase.v1.DatabaseService/UpdateDatabase"
+	DatabaseService_BatchUpdateDatabases_FullMethodName     = "/bytebase.v1.DatabaseService/BatchUpdateDatabases"
+	DatabaseService_SyncDatabase_FullMethodName             = "/bytebase.v1.DatabaseService/SyncDatabase"
+	DatabaseService_GetDatabaseMetadata_FullMethodName      = "/bytebase.v1.DatabaseService/GetDatabaseMetadata"
+	DatabaseService_GetDatabaseSchema_FullMethodName        = "/bytebase.v1.DatabaseService/GetDatabaseSchema"
+	DatabaseService_GetBackupSetting_FullMethodName         = "/bytebase.v1.DatabaseService/GetBackupSetting"
+	DatabaseService_UpdateBackupSetting_FullMethodName      = "/bytebase.v1.DatabaseService/UpdateBackupSetting"
+	DatabaseService_CreateBackup_FullMethodName             = "/bytebase.v1.DatabaseService/CreateBackup"
+	DatabaseService_ListBackups_FullMethodName              = "/bytebase.v1.DatabaseService/ListBackups"
+	DatabaseService_ListSlowQueries_FullMethodName          = "/bytebase.v1.DatabaseService/ListSlowQueries"
+	DatabaseService_ListSecrets_FullMethodName              = "/bytebase.v1.DatabaseService/ListSecrets"
+	DatabaseService_UpdateSecret_FullMethodName             = "/bytebase.v1.DatabaseService/UpdateSecret"
+	DatabaseService_DeleteSecret_FullMethodName             = "/bytebase.v1.DatabaseService/DeleteSecret"
+	DatabaseService_AdviseIndex_FullMethodName              = "/bytebase.v1.DatabaseService/AdviseIndex"
+	DatabaseService_ListIndexRecommendations_FullMethodName = "/bytebase.v1.DatabaseService/ListIndexRecommendations"
+	DatabaseService_ListChangeHistories_FullMethodName      = "/bytebase.v1.DatabaseService/ListChangeHistories"
+	DatabaseService_GetChangeHistory_FullMethodName         = "/bytebase.v1.DatabaseService/GetChangeHistory"
 )
 
 // DatabaseServiceClient is the client API for DatabaseService service.
@@ -63,6 +64,7 @@ type DatabaseServiceClient interface {
 	UpdateSecret(ctx context.Context, in *UpdateSecretRequest, opts ...grpc.CallOption) (*Secret, error)
 	DeleteSecret(ctx context.Context, in *DeleteSecretRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	AdviseIndex(ctx context.Context, in *AdviseIndexRequest, opts ...grpc.CallOption) (*AdviseIndexResponse, error)
+	ListIndexRecommendations(ctx context.Context, in *ListIndexRecommendationsRequest, opts ...grpc.CallOption) (*ListIndexRecommendationsResponse, error)
 	ListChangeHistories(ctx context.Context, in *ListChangeHistoriesRequest, opts ...grpc.CallOption) (*ListChangeHistoriesResponse, error)
 	GetChangeHistory(ctx context.Context, in *GetChangeHistoryRequest, opts ...grpc.CallOption) (*ChangeHistory, error)
 }
@@ -228,6 +230,15 @@ func (c *databaseServiceClient) AdviseIndex(ctx context.Context, in *AdviseIndex
 	return out, nil
 }
 
+func (c *databaseServiceClient) ListIndexRecommendations(ctx context.Context, in *ListIndexRecommendationsRequest, opts ...grpc.CallOption) (*ListIndexRecommendationsResponse, error) {
+	out := new(ListIndexRecommendationsResponse)
+	err := c.cc.Invoke(ctx, DatabaseService_ListIndexRecommendations_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *databaseServiceClient) ListChangeHistories(ctx context.Context, in *ListChangeHistoriesRequest, opts ...grpc.CallOption) (*ListChangeHistoriesResponse, error) {
 	out := new(ListChangeHistoriesResponse)
 	err := c.cc.Invoke(ctx, DatabaseService_ListChangeHistories_FullMethodName, in, out, opts...)
@@ -268,6 +279,7 @@ type DatabaseServiceServer interface {
 	UpdateSecret(context.Context, *UpdateSecretRequest) (*Secret, error)
 	DeleteSecret(context.Context, *DeleteSecretRequest) (*emptypb.Empty, error)
 	AdviseIndex(context.Context, *AdviseIndexRequest) (*AdviseIndexResponse, error)
+	ListIndexRecommendations(context.Context, *ListIndexRecommendationsRequest) (*ListIndexRecommendationsResponse, error)
 	ListChangeHistories(context.Context, *ListChangeHistoriesRequest) (*ListChangeHistoriesResponse, error)
 	GetChangeHistory(context.Context, *GetChangeHistoryRequest) (*ChangeHistory, error)
 	mustEmbedUnimplementedDatabaseServiceServer()
@@ -328,6 +340,9 @@ func (UnimplementedDatabaseServiceServer) DeleteSecret(context.Context, *DeleteS
 func (UnimplementedDatabaseServiceServer) AdviseIndex(context.Context, *AdviseIndexRequest) (*AdviseIndexResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AdviseIndex not implemented")
 }
+func (UnimplementedDatabaseServiceServer) ListIndexRecommendations(context.Context, *ListIndexRecommendationsRequest) (*ListIndexRecommendationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListIndexRecommendations not implemented")
+}
 func (UnimplementedDatabaseServiceServer) ListChangeHistories(context.Context, *ListChangeHistoriesRequest) (*ListChangeHistoriesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListChangeHistories not implemented")
 }
@@ -653,6 +668,24 @@ func _DatabaseService_AdviseIndex_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DatabaseService_ListIndexRecommendations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListIndexRecommendationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DatabaseServiceServer).ListIndexRecommendations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DatabaseService_ListIndexRecommendations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DatabaseServiceServer).ListIndexRecommendations(ctx, req.(*ListIndexRecommendationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _DatabaseService_ListChangeHistories_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListChangeHistoriesRequest)
 	if err := dec(in); err != nil {
@@ -764,6 +797,10 @@ var DatabaseService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "AdviseIndex",
 			Handler:    _DatabaseService_AdviseIndex_Handler,
 		},
+		{
+			MethodName: "ListIndexRecommendations",
+			Handler:    _DatabaseService_ListIndexRecommendations_Handler,
+		},
 		{
 			MethodName: "ListChangeHistories",
 			Handler:    _DatabaseService_ListChangeHistories_Handler,
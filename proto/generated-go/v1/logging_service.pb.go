@@ -245,8 +245,19 @@ type ListLogsRequest struct {
 	//   - create_time <= "2022-01-01T12:00:00.000Z"
 	//   - create_time >= "2022-01-01T12:00:00.000Z"
 	//
+	// - database, the SQL editor queries and exports accessing the database, example:
+	//   - database = "instances/{instance id}/databases/{database name}"
+	//
+	// - table, the SQL editor queries and exports accessing the table, example:
+	//   - table = "customers"
+	//   - table = "public.customers"
+	//
+	// - column, the SQL editor queries and exports returning the table column, example:
+	//   - column = "ssn"
+	//
 	// For example:
 	// List the logs of type 'ACTION_ISSUE_COMMENT_CREATE' in issue/123: 'action="ACTION_ISSUE_COMMENT_CREATE", resource="issue/123"'
+	// List the logs reading the column customers.ssn in October 2023: 'table="customers" && column="ssn" && create_time >= "2023-10-01T00:00:00Z" && create_time <= "2023-11-01T00:00:00Z"'
 	Filter string `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
 	// The order by of the log.
 	// Only support order by create_time.
@@ -50,6 +50,8 @@ const (
 	ProjectService_CreateSchemaGroup_FullMethodName       = "/bytebase.v1.ProjectService/CreateSchemaGroup"
 	ProjectService_UpdateSchemaGroup_FullMethodName       = "/bytebase.v1.ProjectService/UpdateSchemaGroup"
 	ProjectService_DeleteSchemaGroup_FullMethodName       = "/bytebase.v1.ProjectService/DeleteSchemaGroup"
+	ProjectService_ListAccessGrants_FullMethodName        = "/bytebase.v1.ProjectService/ListAccessGrants"
+	ProjectService_RevokeAccessGrant_FullMethodName       = "/bytebase.v1.ProjectService/RevokeAccessGrant"
 )
 
 // ProjectServiceClient is the client API for ProjectService service.
@@ -87,6 +89,10 @@ type ProjectServiceClient interface {
 	CreateSchemaGroup(ctx context.Context, in *CreateSchemaGroupRequest, opts ...grpc.CallOption) (*SchemaGroup, error)
 	UpdateSchemaGroup(ctx context.Context, in *UpdateSchemaGroupRequest, opts ...grpc.CallOption) (*SchemaGroup, error)
 	DeleteSchemaGroup(ctx context.Context, in *DeleteSchemaGroupRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// ListAccessGrants lists the active access grants of the approved grant requests, for the access review.
+	ListAccessGrants(ctx context.Context, in *ListAccessGrantsRequest, opts ...grpc.CallOption) (*ListAccessGrantsResponse, error)
+	// RevokeAccessGrant removes the access grant from the project IAM policy.
+	RevokeAccessGrant(ctx context.Context, in *RevokeAccessGrantRequest, opts ...grpc.CallOption) (*AccessGrant, error)
 }
 
 type projectServiceClient struct {
@@ -367,6 +373,24 @@ func (c *projectServiceClient) DeleteSchemaGroup(ctx context.Context, in *Delete
 	return out, nil
 }
 
+func (c *projectServiceClient) ListAccessGrants(ctx context.Context, in *ListAccessGrantsRequest, opts ...grpc.CallOption) (*ListAccessGrantsResponse, error) {
+	out := new(ListAccessGrantsResponse)
+	err := c.cc.Invoke(ctx, ProjectService_ListAccessGrants_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *projectServiceClient) RevokeAccessGrant(ctx context.Context, in *RevokeAccessGrantRequest, opts ...grpc.CallOption) (*AccessGrant, error) {
+	out := new(AccessGrant)
+	err := c.cc.Invoke(ctx, ProjectService_RevokeAccessGrant_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ProjectServiceServer is the server API for ProjectService service.
 // All implementations must embed UnimplementedProjectServiceServer
 // for forward compatibility
@@ -402,6 +426,10 @@ type ProjectServiceServer interface {
 	CreateSchemaGroup(context.Context, *CreateSchemaGroupRequest) (*SchemaGroup, error)
 	UpdateSchemaGroup(context.Context, *UpdateSchemaGroupRequest) (*SchemaGroup, error)
 	DeleteSchemaGroup(context.Context, *DeleteSchemaGroupRequest) (*emptypb.Empty, error)
+	// ListAccessGrants lists the active access grants of the approved grant requests, for the access review.
+	ListAccessGrants(context.Context, *ListAccessGrantsRequest) (*ListAccessGrantsResponse, error)
+	// RevokeAccessGrant removes the access grant from the project IAM policy.
+	RevokeAccessGrant(context.Context, *RevokeAccessGrantRequest) (*AccessGrant, error)
 	mustEmbedUnimplementedProjectServiceServer()
 }
 
@@ -499,6 +527,12 @@ func (UnimplementedProjectServiceServer) UpdateSchemaGroup(context.Context, *Upd
 func (UnimplementedProjectServiceServer) DeleteSchemaGroup(context.Context, *DeleteSchemaGroupRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteSchemaGroup not implemented")
 }
+func (UnimplementedProjectServiceServer) ListAccessGrants(context.Context, *ListAccessGrantsRequest) (*ListAccessGrantsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAccessGrants not implemented")
+}
+func (UnimplementedProjectServiceServer) RevokeAccessGrant(context.Context, *RevokeAccessGrantRequest) (*AccessGrant, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeAccessGrant not implemented")
+}
 func (UnimplementedProjectServiceServer) mustEmbedUnimplementedProjectServiceServer() {}
 
 // UnsafeProjectServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -1052,6 +1086,42 @@ func _ProjectService_DeleteSchemaGroup_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ProjectService_ListAccessGrants_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAccessGrantsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProjectServiceServer).ListAccessGrants(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProjectService_ListAccessGrants_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProjectServiceServer).ListAccessGrants(ctx, req.(*ListAccessGrantsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProjectService_RevokeAccessGrant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeAccessGrantRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProjectServiceServer).RevokeAccessGrant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProjectService_RevokeAccessGrant_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProjectServiceServer).RevokeAccessGrant(ctx, req.(*RevokeAccessGrantRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // ProjectService_ServiceDesc is the grpc.ServiceDesc for ProjectService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -1179,6 +1249,14 @@ var ProjectService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteSchemaGroup",
 			Handler:    _ProjectService_DeleteSchemaGroup_Handler,
 		},
+		{
+			MethodName: "ListAccessGrants",
+			Handler:    _ProjectService_ListAccessGrants_Handler,
+		},
+		{
+			MethodName: "RevokeAccessGrant",
+			Handler:    _ProjectService_RevokeAccessGrant_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "v1/project_service.proto",
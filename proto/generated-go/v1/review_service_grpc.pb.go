@@ -22,6 +22,7 @@ const (
 	ReviewService_GetReview_FullMethodName           = "/bytebase.v1.ReviewService/GetReview"
 	ReviewService_CreateReview_FullMethodName        = "/bytebase.v1.ReviewService/CreateReview"
 	ReviewService_ListReviews_FullMethodName         = "/bytebase.v1.ReviewService/ListReviews"
+	ReviewService_ListOverdueReviews_FullMethodName  = "/bytebase.v1.ReviewService/ListOverdueReviews"
 	ReviewService_UpdateReview_FullMethodName        = "/bytebase.v1.ReviewService/UpdateReview"
 	ReviewService_CreateReviewComment_FullMethodName = "/bytebase.v1.ReviewService/CreateReviewComment"
 	ReviewService_UpdateReviewComment_FullMethodName = "/bytebase.v1.ReviewService/UpdateReviewComment"
@@ -38,6 +39,8 @@ type ReviewServiceClient interface {
 	GetReview(ctx context.Context, in *GetReviewRequest, opts ...grpc.CallOption) (*Review, error)
 	CreateReview(ctx context.Context, in *CreateReviewRequest, opts ...grpc.CallOption) (*Review, error)
 	ListReviews(ctx context.Context, in *ListReviewsRequest, opts ...grpc.CallOption) (*ListReviewsResponse, error)
+	// ListOverdueReviews lists the open reviews whose pending approval step is overdue.
+	ListOverdueReviews(ctx context.Context, in *ListOverdueReviewsRequest, opts ...grpc.CallOption) (*ListOverdueReviewsResponse, error)
 	UpdateReview(ctx context.Context, in *UpdateReviewRequest, opts ...grpc.CallOption) (*Review, error)
 	CreateReviewComment(ctx context.Context, in *CreateReviewCommentRequest, opts ...grpc.CallOption) (*ReviewComment, error)
 	UpdateReviewComment(ctx context.Context, in *UpdateReviewCommentRequest, opts ...grpc.CallOption) (*ReviewComment, error)
@@ -82,6 +85,15 @@ func (c *reviewServiceClient) ListReviews(ctx context.Context, in *ListReviewsRe
 	return out, nil
 }
 
+func (c *reviewServiceClient) ListOverdueReviews(ctx context.Context, in *ListOverdueReviewsRequest, opts ...grpc.CallOption) (*ListOverdueReviewsResponse, error) {
+	out := new(ListOverdueReviewsResponse)
+	err := c.cc.Invoke(ctx, ReviewService_ListOverdueReviews_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *reviewServiceClient) UpdateReview(ctx context.Context, in *UpdateReviewRequest, opts ...grpc.CallOption) (*Review, error) {
 	out := new(Review)
 	err := c.cc.Invoke(ctx, ReviewService_UpdateReview_FullMethodName, in, out, opts...)
@@ -152,6 +164,8 @@ type ReviewServiceServer interface {
 	GetReview(context.Context, *GetReviewRequest) (*Review, error)
 	CreateReview(context.Context, *CreateReviewRequest) (*Review, error)
 	ListReviews(context.Context, *ListReviewsRequest) (*ListReviewsResponse, error)
+	// ListOverdueReviews lists the open reviews whose pending approval step is overdue.
+	ListOverdueReviews(context.Context, *ListOverdueReviewsRequest) (*ListOverdueReviewsResponse, error)
 	UpdateReview(context.Context, *UpdateReviewRequest) (*Review, error)
 	CreateReviewComment(context.Context, *CreateReviewCommentRequest) (*ReviewComment, error)
 	UpdateReviewComment(context.Context, *UpdateReviewCommentRequest) (*ReviewComment, error)
@@ -175,6 +189,9 @@ func (UnimplementedReviewServiceServer) CreateReview(context.Context, *CreateRev
 func (UnimplementedReviewServiceServer) ListReviews(context.Context, *ListReviewsRequest) (*ListReviewsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListReviews not implemented")
 }
+func (UnimplementedReviewServiceServer) ListOverdueReviews(context.Context, *ListOverdueReviewsRequest) (*ListOverdueReviewsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListOverdueReviews not implemented")
+}
 func (UnimplementedReviewServiceServer) UpdateReview(context.Context, *UpdateReviewRequest) (*Review, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateReview not implemented")
 }
@@ -263,6 +280,24 @@ func _ReviewService_ListReviews_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ReviewService_ListOverdueReviews_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListOverdueReviewsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReviewServiceServer).ListOverdueReviews(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReviewService_ListOverdueReviews_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReviewServiceServer).ListOverdueReviews(ctx, req.(*ListOverdueReviewsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ReviewService_UpdateReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(UpdateReviewRequest)
 	if err := dec(in); err != nil {
@@ -408,6 +443,10 @@ var ReviewService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListReviews",
 			Handler:    _ReviewService_ListReviews_Handler,
 		},
+		{
+			MethodName: "ListOverdueReviews",
+			Handler:    _ReviewService_ListOverdueReviews_Handler,
+		},
 		{
 			MethodName: "UpdateReview",
 			Handler:    _ReviewService_UpdateReview_Handler,
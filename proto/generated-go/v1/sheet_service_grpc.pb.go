@@ -27,6 +27,7 @@ const (
 	SheetService_UpdateSheetOrganizer_FullMethodName = "/bytebase.v1.SheetService/UpdateSheetOrganizer"
 	SheetService_DeleteSheet_FullMethodName          = "/bytebase.v1.SheetService/DeleteSheet"
 	SheetService_SyncSheets_FullMethodName           = "/bytebase.v1.SheetService/SyncSheets"
+	SheetService_ApplySheetFixes_FullMethodName      = "/bytebase.v1.SheetService/ApplySheetFixes"
 )
 
 // SheetServiceClient is the client API for SheetService service.
@@ -40,6 +41,8 @@ type SheetServiceClient interface {
 	UpdateSheetOrganizer(ctx context.Context, in *UpdateSheetOrganizerRequest, opts ...grpc.CallOption) (*SheetOrganizer, error)
 	DeleteSheet(ctx context.Context, in *DeleteSheetRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	SyncSheets(ctx context.Context, in *SyncSheetsRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// ApplySheetFixes applies the safe SQL review fixes to the sheet content.
+	ApplySheetFixes(ctx context.Context, in *ApplySheetFixesRequest, opts ...grpc.CallOption) (*ApplySheetFixesResponse, error)
 }
 
 type sheetServiceClient struct {
@@ -113,6 +116,15 @@ func (c *sheetServiceClient) SyncSheets(ctx context.Context, in *SyncSheetsReque
 	return out, nil
 }
 
+func (c *sheetServiceClient) ApplySheetFixes(ctx context.Context, in *ApplySheetFixesRequest, opts ...grpc.CallOption) (*ApplySheetFixesResponse, error) {
+	out := new(ApplySheetFixesResponse)
+	err := c.cc.Invoke(ctx, SheetService_ApplySheetFixes_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // SheetServiceServer is the server API for SheetService service.
 // All implementations must embed UnimplementedSheetServiceServer
 // for forward compatibility
@@ -124,6 +136,8 @@ type SheetServiceServer interface {
 	UpdateSheetOrganizer(context.Context, *UpdateSheetOrganizerRequest) (*SheetOrganizer, error)
 	DeleteSheet(context.Context, *DeleteSheetRequest) (*emptypb.Empty, error)
 	SyncSheets(context.Context, *SyncSheetsRequest) (*emptypb.Empty, error)
+	// ApplySheetFixes applies the safe SQL review fixes to the sheet content.
+	ApplySheetFixes(context.Context, *ApplySheetFixesRequest) (*ApplySheetFixesResponse, error)
 	mustEmbedUnimplementedSheetServiceServer()
 }
 
@@ -152,6 +166,9 @@ func (UnimplementedSheetServiceServer) DeleteSheet(context.Context, *DeleteSheet
 func (UnimplementedSheetServiceServer) SyncSheets(context.Context, *SyncSheetsRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SyncSheets not implemented")
 }
+func (UnimplementedSheetServiceServer) ApplySheetFixes(context.Context, *ApplySheetFixesRequest) (*ApplySheetFixesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApplySheetFixes not implemented")
+}
 func (UnimplementedSheetServiceServer) mustEmbedUnimplementedSheetServiceServer() {}
 
 // UnsafeSheetServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -291,6 +308,24 @@ func _SheetService_SyncSheets_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SheetService_ApplySheetFixes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplySheetFixesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SheetServiceServer).ApplySheetFixes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SheetService_ApplySheetFixes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SheetServiceServer).ApplySheetFixes(ctx, req.(*ApplySheetFixesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // SheetService_ServiceDesc is the grpc.ServiceDesc for SheetService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -326,6 +361,10 @@ var SheetService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SyncSheets",
 			Handler:    _SheetService_SyncSheets_Handler,
 		},
+		{
+			MethodName: "ApplySheetFixes",
+			Handler:    _SheetService_ApplySheetFixes_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "v1/sheet_service.proto",